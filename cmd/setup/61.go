@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 61.sql
+	addRequirePushedAuthorizationRequests string
+)
+
+type Apps7OIDCConfigsRequirePushedAuthorizationRequests struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsRequirePushedAuthorizationRequests) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addRequirePushedAuthorizationRequests)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsRequirePushedAuthorizationRequests) String() string {
+	return "61_apps7_oidc_configs_add_require_pushed_authorization_requests"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 63.sql
+	addTLSClientAuth string
+)
+
+type Apps7TLSClientAuth struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7TLSClientAuth) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addTLSClientAuth)
+	return err
+}
+
+func (mig *Apps7TLSClientAuth) String() string {
+	return "63_apps7_add_tls_client_auth"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 64.sql
+	addBackChannelClientNotificationEndpoint string
+)
+
+type Apps7OIDCConfigsCIBANotificationEndpoint struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsCIBANotificationEndpoint) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addBackChannelClientNotificationEndpoint)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsCIBANotificationEndpoint) String() string {
+	return "64_apps7_oidc_configs_add_back_channel_client_notification_endpoint"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 65.sql
+	addAuthRequestAuthorizationDetails string
+)
+
+type AuthRequestsAuthorizationDetails struct {
+	dbClient *database.DB
+}
+
+func (mig *AuthRequestsAuthorizationDetails) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addAuthRequestAuthorizationDetails)
+	return err
+}
+
+func (mig *AuthRequestsAuthorizationDetails) String() string {
+	return "65_auth_requests_add_authorization_details"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 66.sql
+	addSAMLSigningAndEncryption string
+)
+
+type Apps7SAMLConfigsSigningAndEncryption struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7SAMLConfigsSigningAndEncryption) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSAMLSigningAndEncryption)
+	return err
+}
+
+func (mig *Apps7SAMLConfigsSigningAndEncryption) String() string {
+	return "66_apps7_saml_configs_add_signing_and_encryption"
+}
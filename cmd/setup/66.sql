@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS signature_algorithm SMALLINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS digest_algorithm SMALLINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.apps7_saml_configs ADD COLUMN IF NOT EXISTS assertion_encryption SMALLINT DEFAULT 0;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 67.sql
+	addSAMLNameIDFormatAndAttributeMapping string
+)
+
+type Apps7SAMLConfigsNameIDFormatAndAttributeMapping struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7SAMLConfigsNameIDFormatAndAttributeMapping) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSAMLNameIDFormatAndAttributeMapping)
+	return err
+}
+
+func (mig *Apps7SAMLConfigsNameIDFormatAndAttributeMapping) String() string {
+	return "67_apps7_saml_configs_add_name_id_format_and_attribute_mapping"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 68.sql
+	addIDPTemplateClaimMapping string
+)
+
+type IDPTemplates6ClaimMapping struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplates6ClaimMapping) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addIDPTemplateClaimMapping)
+	return err
+}
+
+func (mig *IDPTemplates6ClaimMapping) String() string {
+	return "68_idp_templates6_add_claim_mapping"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.idp_templates6_oauth2 ADD COLUMN IF NOT EXISTS claim_mapping JSONB;
+ALTER TABLE IF EXISTS projections.idp_templates6_oidc ADD COLUMN IF NOT EXISTS claim_mapping JSONB;
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 69.sql
+	addIDPTemplateGroupMapping string
+)
+
+type IDPTemplates6GroupMapping struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplates6GroupMapping) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addIDPTemplateGroupMapping)
+	return err
+}
+
+func (mig *IDPTemplates6GroupMapping) String() string {
+	return "69_idp_templates6_add_group_mapping"
+}
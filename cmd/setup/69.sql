@@ -0,0 +1 @@
+ALTER TABLE IF EXISTS projections.idp_templates6 ADD COLUMN IF NOT EXISTS group_mapping JSONB;
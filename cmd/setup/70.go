@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 70.sql
+	addIDPTemplateProviderTables string
+)
+
+type IDPTemplates6ProviderTables struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplates6ProviderTables) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addIDPTemplateProviderTables)
+	return err
+}
+
+func (mig *IDPTemplates6ProviderTables) String() string {
+	return "70_idp_templates6_add_provider_tables"
+}
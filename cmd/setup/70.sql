@@ -0,0 +1,46 @@
+CREATE TABLE IF NOT EXISTS projections.idp_templates6_okta (
+    idp_id TEXT NOT NULL,
+    instance_id TEXT NOT NULL,
+    domain TEXT NOT NULL,
+    authorization_server_id TEXT,
+    client_id TEXT NOT NULL,
+    client_secret JSONB NOT NULL,
+    scopes TEXT[],
+    PRIMARY KEY (instance_id, idp_id),
+    CONSTRAINT fk_okta_ref_idp_templates6 FOREIGN KEY (instance_id, idp_id) REFERENCES projections.idp_templates6 ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS projections.idp_templates6_auth0 (
+    idp_id TEXT NOT NULL,
+    instance_id TEXT NOT NULL,
+    domain TEXT NOT NULL,
+    client_id TEXT NOT NULL,
+    client_secret JSONB NOT NULL,
+    scopes TEXT[],
+    PRIMARY KEY (instance_id, idp_id),
+    CONSTRAINT fk_auth0_ref_idp_templates6 FOREIGN KEY (instance_id, idp_id) REFERENCES projections.idp_templates6 ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS projections.idp_templates6_keycloak (
+    idp_id TEXT NOT NULL,
+    instance_id TEXT NOT NULL,
+    base_url TEXT NOT NULL,
+    realm TEXT NOT NULL,
+    client_id TEXT NOT NULL,
+    client_secret JSONB NOT NULL,
+    scopes TEXT[],
+    PRIMARY KEY (instance_id, idp_id),
+    CONSTRAINT fk_keycloak_ref_idp_templates6 FOREIGN KEY (instance_id, idp_id) REFERENCES projections.idp_templates6 ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS projections.idp_templates6_entra_external_id (
+    idp_id TEXT NOT NULL,
+    instance_id TEXT NOT NULL,
+    tenant_id TEXT NOT NULL,
+    domain TEXT,
+    client_id TEXT NOT NULL,
+    client_secret JSONB NOT NULL,
+    scopes TEXT[],
+    PRIMARY KEY (instance_id, idp_id),
+    CONSTRAINT fk_entra_external_id_ref_idp_templates6 FOREIGN KEY (instance_id, idp_id) REFERENCES projections.idp_templates6 ON DELETE CASCADE
+);
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 71.sql
+	addIDPTemplateFederatedLogout string
+)
+
+type IDPTemplates6FederatedLogout struct {
+	dbClient *database.DB
+}
+
+func (mig *IDPTemplates6FederatedLogout) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addIDPTemplateFederatedLogout)
+	return err
+}
+
+func (mig *IDPTemplates6FederatedLogout) String() string {
+	return "71_idp_templates6_add_federated_logout"
+}
@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 72.sql
+	addOIDCTokenLifetimes string
+)
+
+type Apps7OIDCConfigsTokenLifetimes struct {
+	dbClient *database.DB
+}
+
+func (mig *Apps7OIDCConfigsTokenLifetimes) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addOIDCTokenLifetimes)
+	return err
+}
+
+func (mig *Apps7OIDCConfigsTokenLifetimes) String() string {
+	return "72_apps7_oidc_configs_add_token_lifetimes"
+}
@@ -0,0 +1,3 @@
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS access_token_lifetime BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS id_token_lifetime BIGINT DEFAULT 0;
+ALTER TABLE IF EXISTS projections.apps7_oidc_configs ADD COLUMN IF NOT EXISTS refresh_token_lifetime BIGINT DEFAULT 0;
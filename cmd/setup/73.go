@@ -0,0 +1,27 @@
+package setup
+
+import (
+	"context"
+	_ "embed"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+var (
+	//go:embed 73.sql
+	addSessionIntentIDP string
+)
+
+type Sessions8IntentIDP struct {
+	dbClient *database.DB
+}
+
+func (mig *Sessions8IntentIDP) Execute(ctx context.Context, _ eventstore.Event) error {
+	_, err := mig.dbClient.ExecContext(ctx, addSessionIntentIDP)
+	return err
+}
+
+func (mig *Sessions8IntentIDP) String() string {
+	return "73_sessions8_add_intent_idp"
+}
@@ -0,0 +1,2 @@
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS intent_idp_id TEXT;
+ALTER TABLE IF EXISTS projections.sessions8 ADD COLUMN IF NOT EXISTS intent_idp_session_id TEXT;
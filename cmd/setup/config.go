@@ -157,6 +157,19 @@ type Steps struct {
 	s58ReplaceLoginNames3View               *ReplaceLoginNames3View
 	s59SetupWebkeys                         *SetupWebkeys
 	s60GenerateSystemID                     *GenerateSystemID
+	s61Apps7OIDCConfigsRequirePAR           *Apps7OIDCConfigsRequirePushedAuthorizationRequests
+	s62Apps7OIDCConfigsRequireDPoP          *Apps7OIDCConfigsRequireDPoP
+	s63Apps7TLSClientAuth                   *Apps7TLSClientAuth
+	s64Apps7OIDCConfigsCIBAEndpoint         *Apps7OIDCConfigsCIBANotificationEndpoint
+	s65AuthRequestsAuthorizationDetails     *AuthRequestsAuthorizationDetails
+	s66Apps7SAMLSigningAndEncryption        *Apps7SAMLConfigsSigningAndEncryption
+	s67Apps7SAMLNameIDAndAttributeMapping   *Apps7SAMLConfigsNameIDFormatAndAttributeMapping
+	s68IDPTemplates6ClaimMapping            *IDPTemplates6ClaimMapping
+	s69IDPTemplates6GroupMapping            *IDPTemplates6GroupMapping
+	s70IDPTemplates6ProviderTables          *IDPTemplates6ProviderTables
+	s71IDPTemplates6FederatedLogout         *IDPTemplates6FederatedLogout
+	s72Apps7OIDCConfigsTokenLifetimes       *Apps7OIDCConfigsTokenLifetimes
+	s73Sessions8IntentIDP                   *Sessions8IntentIDP
 }
 
 func MustNewSteps(v *viper.Viper) *Steps {
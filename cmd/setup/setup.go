@@ -218,6 +218,19 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 	steps.s57CreateResourceCounts = &CreateResourceCounts{dbClient: dbClient}
 	steps.s58ReplaceLoginNames3View = &ReplaceLoginNames3View{dbClient: dbClient}
 	steps.s60GenerateSystemID = &GenerateSystemID{eventstore: eventstoreClient}
+	steps.s61Apps7OIDCConfigsRequirePAR = &Apps7OIDCConfigsRequirePushedAuthorizationRequests{dbClient: dbClient}
+	steps.s62Apps7OIDCConfigsRequireDPoP = &Apps7OIDCConfigsRequireDPoP{dbClient: dbClient}
+	steps.s63Apps7TLSClientAuth = &Apps7TLSClientAuth{dbClient: dbClient}
+	steps.s64Apps7OIDCConfigsCIBAEndpoint = &Apps7OIDCConfigsCIBANotificationEndpoint{dbClient: dbClient}
+	steps.s65AuthRequestsAuthorizationDetails = &AuthRequestsAuthorizationDetails{dbClient: dbClient}
+	steps.s66Apps7SAMLSigningAndEncryption = &Apps7SAMLConfigsSigningAndEncryption{dbClient: dbClient}
+	steps.s67Apps7SAMLNameIDAndAttributeMapping = &Apps7SAMLConfigsNameIDFormatAndAttributeMapping{dbClient: dbClient}
+	steps.s68IDPTemplates6ClaimMapping = &IDPTemplates6ClaimMapping{dbClient: dbClient}
+	steps.s69IDPTemplates6GroupMapping = &IDPTemplates6GroupMapping{dbClient: dbClient}
+	steps.s70IDPTemplates6ProviderTables = &IDPTemplates6ProviderTables{dbClient: dbClient}
+	steps.s71IDPTemplates6FederatedLogout = &IDPTemplates6FederatedLogout{dbClient: dbClient}
+	steps.s72Apps7OIDCConfigsTokenLifetimes = &Apps7OIDCConfigsTokenLifetimes{dbClient: dbClient}
+	steps.s73Sessions8IntentIDP = &Sessions8IntentIDP{dbClient: dbClient}
 
 	err = projection.Create(ctx, dbClient, eventstoreClient, config.Projections, nil, nil, nil)
 	logging.OnError(err).Fatal("unable to start projections")
@@ -266,6 +279,19 @@ func Setup(ctx context.Context, config *Config, steps *Steps, masterKey string)
 		steps.s57CreateResourceCounts,
 		steps.s58ReplaceLoginNames3View,
 		steps.s60GenerateSystemID,
+		steps.s61Apps7OIDCConfigsRequirePAR,
+		steps.s62Apps7OIDCConfigsRequireDPoP,
+		steps.s63Apps7TLSClientAuth,
+		steps.s64Apps7OIDCConfigsCIBAEndpoint,
+		steps.s65AuthRequestsAuthorizationDetails,
+		steps.s66Apps7SAMLSigningAndEncryption,
+		steps.s67Apps7SAMLNameIDAndAttributeMapping,
+		steps.s68IDPTemplates6ClaimMapping,
+		steps.s69IDPTemplates6GroupMapping,
+		steps.s70IDPTemplates6ProviderTables,
+		steps.s71IDPTemplates6FederatedLogout,
+		steps.s72Apps7OIDCConfigsTokenLifetimes,
+		steps.s73Sessions8IntentIDP,
 	} {
 		setupErr = executeMigration(ctx, eventstoreClient, step, "migration failed")
 		if setupErr != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/zitadel/zitadel/internal/config/network"
 	"github.com/zitadel/zitadel/internal/config/systemdefaults"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/directorysync"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/execution"
@@ -83,6 +84,7 @@ type Config struct {
 	Quotas              *QuotasConfig
 	Telemetry           *handlers.TelemetryPusherConfig
 	ServicePing         *serviceping.Config
+	DirectorySync       *directorysync.Config
 }
 
 type QuotasConfig struct {
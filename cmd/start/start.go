@@ -41,6 +41,7 @@ import (
 	authorization_v2beta "github.com/zitadel/zitadel/internal/api/grpc/authorization/v2beta"
 	feature_v2 "github.com/zitadel/zitadel/internal/api/grpc/feature/v2"
 	feature_v2beta "github.com/zitadel/zitadel/internal/api/grpc/feature/v2beta"
+	group_v2 "github.com/zitadel/zitadel/internal/api/grpc/group/v2"
 	idp_v2 "github.com/zitadel/zitadel/internal/api/grpc/idp/v2"
 	instance "github.com/zitadel/zitadel/internal/api/grpc/instance/v2beta"
 	internal_permission_v2beta "github.com/zitadel/zitadel/internal/api/grpc/internal_permission/v2beta"
@@ -84,8 +85,10 @@ import (
 	"github.com/zitadel/zitadel/internal/crypto"
 	cryptoDB "github.com/zitadel/zitadel/internal/crypto/database"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/directorysync"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
+	"github.com/zitadel/zitadel/internal/domain/tokenreplay"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_es "github.com/zitadel/zitadel/internal/eventstore/repository/sql"
 	new_es "github.com/zitadel/zitadel/internal/eventstore/v3"
@@ -326,6 +329,8 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 		return err
 	}
 
+	directorysync.Register(q, queries, commands, keys.User, config.DirectorySync)
+
 	if err = q.Start(ctx); err != nil {
 		return err
 	}
@@ -334,6 +339,9 @@ func startZitadel(ctx context.Context, config *Config, masterKey string, server
 	if err = serviceping.Start(config.ServicePing, q); err != nil {
 		return err
 	}
+	if err = directorysync.Start(config.DirectorySync, q); err != nil {
+		return err
+	}
 
 	router := mux.NewRouter()
 	tlsConfig, err := config.TLS.Config()
@@ -407,7 +415,7 @@ func startAPIs(
 		authZRepo,
 		queries,
 	}
-	oidcPrefixes := []string{"/.well-known/openid-configuration", "/oidc/v1", "/oauth/v2"}
+	oidcPrefixes := []string{"/.well-known/openid-configuration", oidc.CredentialIssuerMetadataEndpoint, "/oidc/v1", "/oauth/v2"}
 	// always set the origin in the context if available in the http headers, no matter for what protocol
 	router.Use(middleware.WithOrigin(config.ExternalSecure, config.HTTP1HostHeader, config.HTTP2HostHeader, config.InstanceHostHeaders, config.PublicHostHeaders))
 	systemTokenVerifier, err := internal_authz.StartSystemTokenVerifierFromConfig(http_util.BuildHTTP(config.ExternalDomain, config.ExternalPort, config.ExternalSecure), config.SystemAPIUsers)
@@ -491,7 +499,7 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, feature_v2beta.CreateServer(commands, queries)); err != nil {
 		return nil, err
 	}
-	if err := apis.RegisterService(ctx, session_v2.CreateServer(commands, queries, permissionCheck)); err != nil {
+	if err := apis.RegisterService(ctx, session_v2.CreateServer(commands, queries, permissionCheck, saml.SingleLogoutPath(config.SAML))); err != nil {
 		return nil, err
 	}
 	if err := apis.RegisterService(ctx, settings_v2.CreateServer(commands, queries)); err != nil {
@@ -533,6 +541,9 @@ func startAPIs(
 	if err := apis.RegisterService(ctx, authorization_v2beta.CreateServer(config.SystemDefaults, commands, queries, permissionCheck)); err != nil {
 		return nil, err
 	}
+	if err := apis.RegisterService(ctx, group_v2.CreateServer(config.SystemDefaults, commands, queries, permissionCheck)); err != nil {
+		return nil, err
+	}
 	if err := apis.RegisterService(ctx, app.CreateServer(commands, queries, permissionCheck)); err != nil {
 		return nil, err
 	}
@@ -546,7 +557,12 @@ func startAPIs(
 		return nil, err
 	}
 
-	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, instanceInterceptor.Handler, federatedLogoutsCache))
+	tokenReplaysCache, err := connector.StartCache[tokenreplay.Index, string, *tokenreplay.Token](ctx, []tokenreplay.Index{tokenreplay.IndexKey}, cache.PurposeTokenReplay, cacheConnectors.Config.TokenReplays, cacheConnectors)
+	if err != nil {
+		return nil, err
+	}
+
+	apis.RegisterHandlerOnPrefix(idp.HandlerPrefix, idp.NewHandler(commands, queries, keys.IDPConfig, instanceInterceptor.Handler, federatedLogoutsCache, tokenReplaysCache))
 
 	userAgentInterceptor, err := middleware.NewUserAgentHandler(config.UserAgentCookie, keys.UserAgentCookieKey, id.SonyFlakeGenerator(), config.ExternalSecure, login.EndpointResources, login.EndpointExternalLoginCallbackFormPost, login.EndpointSAMLACS)
 	if err != nil {
@@ -571,6 +587,7 @@ func startAPIs(
 		ctx,
 		config.OIDC,
 		login.DefaultLoggedOutPath,
+		saml.SingleLogoutPath(config.SAML),
 		config.ExternalSecure,
 		commands,
 		queries,
@@ -583,7 +600,9 @@ func startAPIs(
 		limitingAccessInterceptor,
 		config.Log.Slog(),
 		config.SystemDefaults.SecretHasher,
+		cacheConnectors,
 		federatedLogoutsCache,
+		tokenReplaysCache,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start oidc provider: %w", err)
@@ -425,6 +425,15 @@ module.exports = {
               categoryLinkSource: "auto",
             },
           },
+          group_v2: {
+            specPath:
+              ".artifacts/openapi3/zitadel/group/v2/group_service.openapi.yaml",
+            outputDir: "docs/apis/resources/group_service_v2",
+            sidebarOptions: {
+              groupPathsBy: "tag",
+              categoryLinkSource: "auto",
+            },
+          },
           internal_permission_v2: {
             specPath:
               ".artifacts/openapi3/zitadel/internal_permission/v2beta/internal_permission_service.openapi.yaml",
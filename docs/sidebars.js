@@ -19,6 +19,7 @@ const sidebar_api_instance_service_v2 = require("./docs/apis/resources/instance_
 const sidebar_api_authorization_service_v2 = require("./docs/apis/resources/authorization_service_v2/sidebar.ts").default
 const sidebar_api_permission_service_v2 = require("./docs/apis/resources/internal_permission_service_v2/sidebar.ts").default
 const sidebar_api_app_v2 = require("./docs/apis/resources/application_service_v2/sidebar.ts").default
+const sidebar_api_group_service_v2 = require("./docs/apis/resources/group_service_v2/sidebar.ts").default
 
 module.exports = {
   guides: [
@@ -884,6 +885,20 @@ module.exports = {
               },
               items: sidebar_api_permission_service_v2,
             },
+            {
+              type: "category",
+              label: "Groups",
+              link: {
+                type: "generated-index",
+                title: "Group Service API",
+                slug: "/apis/resources/group_service_v2",
+                description:
+                    "This API is intended to manage groups, their members and the roles granted to them in a ZITADEL organization.\n" +
+                    "\n" +
+                    "Roles granted to a group apply to all its effective members, which are the direct members and the members of all nested groups.\n"
+              },
+              items: sidebar_api_group_service_v2,
+            },
           ],
         },
         {
@@ -0,0 +1,63 @@
+package action
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	action "github.com/zitadel/zitadel/pkg/grpc/action/v2beta"
+)
+
+func (s *Server) ListFailedDeliveries(ctx context.Context, req *connect.Request[action.ListFailedDeliveriesRequest]) (*connect.Response[action.ListFailedDeliveriesResponse], error) {
+	resp, err := execution.ListFailedDeliveries(ctx, req.Msg.GetTargetId(), int(req.Msg.GetLimit()), req.Msg.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&action.ListFailedDeliveriesResponse{
+		Result: failedDeliveriesToPb(resp.Deliveries),
+		Cursor: resp.Cursor,
+	}), nil
+}
+
+func (s *Server) RetryFailedDelivery(ctx context.Context, req *connect.Request[action.RetryFailedDeliveryRequest]) (*connect.Response[action.RetryFailedDeliveryResponse], error) {
+	id, err := strconv.ParseInt(req.Msg.GetId(), 10, 64)
+	if err != nil {
+		return nil, zerrors.ThrowNotFound(err, "ACTION-w7dkq3n1xb", "Errors.Execution.FailedDelivery.NotFound")
+	}
+	if err := execution.RetryFailedDelivery(ctx, id); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&action.RetryFailedDeliveryResponse{
+		RetryDate: timestamppb.New(time.Now()),
+	}), nil
+}
+
+func failedDeliveriesToPb(deliveries []*execution.FailedDelivery) []*action.FailedDelivery {
+	d := make([]*action.FailedDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		d[i] = failedDeliveryToPb(delivery)
+	}
+	return d
+}
+
+func failedDeliveryToPb(d *execution.FailedDelivery) *action.FailedDelivery {
+	delivery := &action.FailedDelivery{
+		Id:          strconv.FormatInt(d.ID, 10),
+		TargetId:    d.TargetID,
+		ExecutionId: d.ExecutionID,
+		Attempts:    uint32(d.Attempts),
+		Error:       d.Error,
+	}
+	if !d.CreatedAt.IsZero() {
+		delivery.CreationDate = timestamppb.New(d.CreatedAt)
+	}
+	if !d.FailedAt.IsZero() {
+		delivery.FailureDate = timestamppb.New(d.FailedAt)
+	}
+	return delivery
+}
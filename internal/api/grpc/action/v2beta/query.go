@@ -99,6 +99,14 @@ func targetToPb(t *query.Target) *action.Target {
 		target.TargetType = nil
 	}
 
+	if t.RetryPolicy != nil {
+		target.RetryPolicy = &action.RetryPolicy{
+			MaxAttempts:    uint32(t.RetryPolicy.MaxAttempts),
+			InitialBackoff: durationpb.New(t.RetryPolicy.InitialBackoff),
+			MaxBackoff:     durationpb.New(t.RetryPolicy.MaxBackoff),
+			Jitter:         t.RetryPolicy.Jitter,
+		}
+	}
 	if !t.ObjectDetails.EventDate.IsZero() {
 		target.ChangeDate = timestamppb.New(t.ObjectDetails.EventDate)
 	}
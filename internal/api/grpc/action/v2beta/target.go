@@ -85,6 +85,7 @@ func createTargetToCommand(req *action.CreateTargetRequest) *command.AddTarget {
 		Endpoint:         req.GetEndpoint(),
 		Timeout:          req.GetTimeout().AsDuration(),
 		InterruptOnError: interruptOnError,
+		RetryPolicy:      retryPolicyToDomain(req.GetRetryPolicy()),
 	}
 }
 
@@ -122,5 +123,21 @@ func updateTargetToCommand(req *action.UpdateTargetRequest) *command.ChangeTarge
 	if req.Timeout != nil {
 		target.Timeout = gu.Ptr(req.GetTimeout().AsDuration())
 	}
+	if req.RetryPolicy != nil {
+		// an empty retry policy removes it from the target
+		target.RetryPolicy = retryPolicyToDomain(req.GetRetryPolicy())
+	}
 	return target
 }
+
+func retryPolicyToDomain(policy *action.RetryPolicy) *domain.TargetRetryPolicy {
+	if policy == nil {
+		return nil
+	}
+	return &domain.TargetRetryPolicy{
+		MaxAttempts:    uint8(policy.GetMaxAttempts()),
+		InitialBackoff: policy.GetInitialBackoff().AsDuration(),
+		MaxBackoff:     policy.GetMaxBackoff().AsDuration(),
+		Jitter:         policy.GetJitter(),
+	}
+}
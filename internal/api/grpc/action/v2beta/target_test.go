@@ -88,6 +88,35 @@ func Test_createTargetToCommand(t *testing.T) {
 				InterruptOnError: true,
 			},
 		},
+		{
+			name: "retry policy",
+			args: args{&action.CreateTargetRequest{
+				Name:     "target 1",
+				Endpoint: "https://example.com/hooks/1",
+				TargetType: &action.CreateTargetRequest_RestAsync{
+					RestAsync: &action.RESTAsync{},
+				},
+				Timeout: durationpb.New(10 * time.Second),
+				RetryPolicy: &action.RetryPolicy{
+					MaxAttempts:    5,
+					InitialBackoff: durationpb.New(time.Second),
+					MaxBackoff:     durationpb.New(time.Minute),
+					Jitter:         0.2,
+				},
+			}},
+			want: &command.AddTarget{
+				Name:       "target 1",
+				TargetType: domain.TargetTypeAsync,
+				Endpoint:   "https://example.com/hooks/1",
+				Timeout:    10 * time.Second,
+				RetryPolicy: &domain.TargetRetryPolicy{
+					MaxAttempts:    5,
+					InitialBackoff: time.Second,
+					MaxBackoff:     time.Minute,
+					Jitter:         0.2,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -219,6 +248,30 @@ func Test_updateTargetToCommand(t *testing.T) {
 				InterruptOnError: gu.Ptr(true),
 			},
 		},
+		{
+			name: "retry policy",
+			args: args{&action.UpdateTargetRequest{
+				RetryPolicy: &action.RetryPolicy{
+					MaxAttempts:    3,
+					InitialBackoff: durationpb.New(time.Second),
+				},
+			}},
+			want: &command.ChangeTarget{
+				RetryPolicy: &domain.TargetRetryPolicy{
+					MaxAttempts:    3,
+					InitialBackoff: time.Second,
+				},
+			},
+		},
+		{
+			name: "remove retry policy",
+			args: args{&action.UpdateTargetRequest{
+				RetryPolicy: &action.RetryPolicy{},
+			}},
+			want: &command.ChangeTarget{
+				RetryPolicy: &domain.TargetRetryPolicy{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -6,8 +6,10 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
 	object_pb "github.com/zitadel/zitadel/internal/api/grpc/object"
+	"github.com/zitadel/zitadel/internal/directorysync"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 	admin_pb "github.com/zitadel/zitadel/pkg/grpc/admin"
 )
 
@@ -405,6 +407,44 @@ func (s *Server) UpdateLDAPProvider(ctx context.Context, req *admin_pb.UpdateLDA
 	}, nil
 }
 
+func (s *Server) SyncLDAPProvider(ctx context.Context, req *admin_pb.SyncLDAPProviderRequest) (*admin_pb.SyncLDAPProviderResponse, error) {
+	if err := s.checkInstanceLDAPProvider(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	if err := directorysync.Trigger(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &admin_pb.SyncLDAPProviderResponse{}, nil
+}
+
+func (s *Server) ListLDAPProviderSyncs(ctx context.Context, req *admin_pb.ListLDAPProviderSyncsRequest) (*admin_pb.ListLDAPProviderSyncsResponse, error) {
+	if err := s.checkInstanceLDAPProvider(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	syncs, err := directorysync.ListSyncs(ctx, req.Id, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.ListLDAPProviderSyncsResponse{
+		Result: ldapProviderSyncsToPb(syncs),
+	}, nil
+}
+
+func (s *Server) checkInstanceLDAPProvider(ctx context.Context, id string) error {
+	instanceIDQuery, err := query.NewIDPTemplateResourceOwnerSearchQuery(authz.GetInstance(ctx).InstanceID())
+	if err != nil {
+		return err
+	}
+	idp, err := s.query.IDPTemplateByID(ctx, true, id, false, nil, instanceIDQuery)
+	if err != nil {
+		return err
+	}
+	if idp.Type != domain.IDPTypeLDAP {
+		return zerrors.ThrowPreconditionFailed(nil, "ADMIN-Kc8sh", "Errors.IDPConfig.DirectorySync.NotActiveLDAP")
+	}
+	return nil
+}
+
 func (s *Server) AddAppleProvider(ctx context.Context, req *admin_pb.AddAppleProviderRequest) (*admin_pb.AddAppleProviderResponse, error) {
 	id, details, err := s.command.AddInstanceAppleProvider(ctx, addAppleProviderToCommand(req))
 	if err != nil {
@@ -457,6 +497,90 @@ func (s *Server) RegenerateSAMLProviderCertificate(ctx context.Context, req *adm
 	}, nil
 }
 
+func (s *Server) AddOktaProvider(ctx context.Context, req *admin_pb.AddOktaProviderRequest) (*admin_pb.AddOktaProviderResponse, error) {
+	id, details, err := s.command.AddInstanceOktaProvider(ctx, addOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddOktaProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateOktaProvider(ctx context.Context, req *admin_pb.UpdateOktaProviderRequest) (*admin_pb.UpdateOktaProviderResponse, error) {
+	details, err := s.command.UpdateInstanceOktaProvider(ctx, req.Id, updateOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateOktaProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddAuth0Provider(ctx context.Context, req *admin_pb.AddAuth0ProviderRequest) (*admin_pb.AddAuth0ProviderResponse, error) {
+	id, details, err := s.command.AddInstanceAuth0Provider(ctx, addAuth0ProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddAuth0ProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateAuth0Provider(ctx context.Context, req *admin_pb.UpdateAuth0ProviderRequest) (*admin_pb.UpdateAuth0ProviderResponse, error) {
+	details, err := s.command.UpdateInstanceAuth0Provider(ctx, req.Id, updateAuth0ProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateAuth0ProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddKeycloakProvider(ctx context.Context, req *admin_pb.AddKeycloakProviderRequest) (*admin_pb.AddKeycloakProviderResponse, error) {
+	id, details, err := s.command.AddInstanceKeycloakProvider(ctx, addKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddKeycloakProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateKeycloakProvider(ctx context.Context, req *admin_pb.UpdateKeycloakProviderRequest) (*admin_pb.UpdateKeycloakProviderResponse, error) {
+	details, err := s.command.UpdateInstanceKeycloakProvider(ctx, req.Id, updateKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateKeycloakProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddEntraExternalIDProvider(ctx context.Context, req *admin_pb.AddEntraExternalIDProviderRequest) (*admin_pb.AddEntraExternalIDProviderResponse, error) {
+	id, details, err := s.command.AddInstanceEntraExternalIDProvider(ctx, addEntraExternalIDProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.AddEntraExternalIDProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateEntraExternalIDProvider(ctx context.Context, req *admin_pb.UpdateEntraExternalIDProviderRequest) (*admin_pb.UpdateEntraExternalIDProviderResponse, error) {
+	details, err := s.command.UpdateInstanceEntraExternalIDProvider(ctx, req.Id, updateEntraExternalIDProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &admin_pb.UpdateEntraExternalIDProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) DeleteProvider(ctx context.Context, req *admin_pb.DeleteProviderRequest) (*admin_pb.DeleteProviderResponse, error) {
 	details, err := s.command.DeleteInstanceProvider(ctx, req.Id)
 	if err != nil {
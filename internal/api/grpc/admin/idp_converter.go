@@ -1,12 +1,16 @@
 package admin
 
 import (
+	"strconv"
+
 	"github.com/crewjam/saml"
 	"github.com/muhlemmer/gu"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	idp_grpc "github.com/zitadel/zitadel/internal/api/grpc/idp"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/directorysync"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/query"
@@ -216,6 +220,7 @@ func addGenericOAuthProviderToCommand(req *admin_pb.AddGenericOAuthProviderReque
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
 		UsePKCE:               req.UsePkce,
+		ClaimMapping:          idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -231,6 +236,7 @@ func updateGenericOAuthProviderToCommand(req *admin_pb.UpdateGenericOAuthProvide
 		Scopes:                req.Scopes,
 		IDAttribute:           req.IdAttribute,
 		UsePKCE:               req.UsePkce,
+		ClaimMapping:          idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -244,6 +250,7 @@ func addGenericOIDCProviderToCommand(req *admin_pb.AddGenericOIDCProviderRequest
 		Scopes:           req.Scopes,
 		IsIDTokenMapping: req.IsIdTokenMapping,
 		UsePKCE:          req.UsePkce,
+		ClaimMapping:     idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 		IDPOptions:       idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -257,6 +264,7 @@ func updateGenericOIDCProviderToCommand(req *admin_pb.UpdateGenericOIDCProviderR
 		Scopes:           req.Scopes,
 		IsIDTokenMapping: req.IsIdTokenMapping,
 		UsePKCE:          req.UsePkce,
+		ClaimMapping:     idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 		IDPOptions:       idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -511,6 +519,100 @@ func updateSAMLProviderToCommand(req *admin_pb.UpdateSAMLProviderRequest) *comma
 	}
 }
 
+func addOktaProviderToCommand(req *admin_pb.AddOktaProviderRequest) command.OktaProvider {
+	return command.OktaProvider{
+		Name:                  req.Name,
+		Domain:                req.Domain,
+		AuthorizationServerID: req.AuthorizationServerId,
+		ClientID:              req.ClientId,
+		ClientSecret:          req.ClientSecret,
+		Scopes:                req.Scopes,
+		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateOktaProviderToCommand(req *admin_pb.UpdateOktaProviderRequest) command.OktaProvider {
+	return command.OktaProvider{
+		Name:                  req.Name,
+		Domain:                req.Domain,
+		AuthorizationServerID: req.AuthorizationServerId,
+		ClientID:              req.ClientId,
+		ClientSecret:          req.ClientSecret,
+		Scopes:                req.Scopes,
+		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addAuth0ProviderToCommand(req *admin_pb.AddAuth0ProviderRequest) command.Auth0Provider {
+	return command.Auth0Provider{
+		Name:         req.Name,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateAuth0ProviderToCommand(req *admin_pb.UpdateAuth0ProviderRequest) command.Auth0Provider {
+	return command.Auth0Provider{
+		Name:         req.Name,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addKeycloakProviderToCommand(req *admin_pb.AddKeycloakProviderRequest) command.KeycloakProvider {
+	return command.KeycloakProvider{
+		Name:         req.Name,
+		BaseURL:      req.BaseUrl,
+		Realm:        req.Realm,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateKeycloakProviderToCommand(req *admin_pb.UpdateKeycloakProviderRequest) command.KeycloakProvider {
+	return command.KeycloakProvider{
+		Name:         req.Name,
+		BaseURL:      req.BaseUrl,
+		Realm:        req.Realm,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addEntraExternalIDProviderToCommand(req *admin_pb.AddEntraExternalIDProviderRequest) command.EntraExternalIDProvider {
+	return command.EntraExternalIDProvider{
+		Name:         req.Name,
+		TenantID:     req.TenantId,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateEntraExternalIDProviderToCommand(req *admin_pb.UpdateEntraExternalIDProviderRequest) command.EntraExternalIDProvider {
+	return command.EntraExternalIDProvider{
+		Name:         req.Name,
+		TenantID:     req.TenantId,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
 func bindingToCommand(binding idp_pb.SAMLBinding) string {
 	switch binding {
 	case idp_pb.SAMLBinding_SAML_BINDING_UNSPECIFIED:
@@ -525,3 +627,55 @@ func bindingToCommand(binding idp_pb.SAMLBinding) string {
 		return ""
 	}
 }
+
+func ldapProviderSyncsToPb(syncs []*directorysync.SyncResult) []*admin_pb.LDAPProviderSync {
+	result := make([]*admin_pb.LDAPProviderSync, len(syncs))
+	for i, sync := range syncs {
+		result[i] = ldapProviderSyncToPb(sync)
+	}
+	return result
+}
+
+func ldapProviderSyncToPb(sync *directorysync.SyncResult) *admin_pb.LDAPProviderSync {
+	pb := &admin_pb.LDAPProviderSync{
+		Id:           strconv.FormatInt(sync.ID, 10),
+		State:        ldapProviderSyncStateToPb(sync.State),
+		CreationDate: timestamppb.New(sync.CreatedAt),
+		Error:        sync.Error,
+	}
+	if !sync.FinishedAt.IsZero() {
+		pb.FinishDate = timestamppb.New(sync.FinishedAt)
+	}
+	if sync.Summary != nil {
+		pb.Summary = &admin_pb.LDAPProviderSyncSummary{
+			DirectoryUsers: uint32(sync.Summary.DirectoryUsers),
+			Created:        uint32(sync.Summary.Created),
+			Updated:        uint32(sync.Summary.Updated),
+			Deactivated:    uint32(sync.Summary.Deactivated),
+			Reactivated:    uint32(sync.Summary.Reactivated),
+			Unchanged:      uint32(sync.Summary.Unchanged),
+			Skipped:        uint32(sync.Summary.Skipped),
+			Failed:         uint32(sync.Summary.Failed),
+			Groups:         uint32(sync.Summary.Groups),
+			Errors:         sync.Summary.Errors,
+		}
+	}
+	return pb
+}
+
+func ldapProviderSyncStateToPb(state directorysync.SyncState) admin_pb.LDAPProviderSyncState {
+	switch state {
+	case directorysync.SyncStatePending:
+		return admin_pb.LDAPProviderSyncState_LDAP_PROVIDER_SYNC_STATE_PENDING
+	case directorysync.SyncStateRunning:
+		return admin_pb.LDAPProviderSyncState_LDAP_PROVIDER_SYNC_STATE_RUNNING
+	case directorysync.SyncStateSucceeded:
+		return admin_pb.LDAPProviderSyncState_LDAP_PROVIDER_SYNC_STATE_SUCCEEDED
+	case directorysync.SyncStateFailed:
+		return admin_pb.LDAPProviderSyncState_LDAP_PROVIDER_SYNC_STATE_FAILED
+	case directorysync.SyncStateUnspecified:
+		fallthrough
+	default:
+		return admin_pb.LDAPProviderSyncState_LDAP_PROVIDER_SYNC_STATE_UNSPECIFIED
+	}
+}
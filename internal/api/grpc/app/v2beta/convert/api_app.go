@@ -14,9 +14,11 @@ func CreateAPIApplicationRequestToDomain(name, projectID, appID string, app *app
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppName:        name,
-		AppID:          appID,
-		AuthMethodType: apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		AppName:                   name,
+		AppID:                     appID,
+		AuthMethodType:            apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		TLSClientAuthSubjectDN:    app.GetTlsClientAuthSubjectDn(),
+		TLSClientAuthPublicKeyPin: app.GetTlsClientAuthPublicKeyPin(),
 	}
 }
 
@@ -25,16 +27,20 @@ func UpdateAPIApplicationConfigurationRequestToDomain(appID, projectID string, a
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppID:          appID,
-		AuthMethodType: apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		AppID:                     appID,
+		AuthMethodType:            apiAuthMethodTypeToDomain(app.GetAuthMethodType()),
+		TLSClientAuthSubjectDN:    app.GetTlsClientAuthSubjectDn(),
+		TLSClientAuthPublicKeyPin: app.GetTlsClientAuthPublicKeyPin(),
 	}
 }
 
 func appAPIConfigToPb(apiApp *query.APIApp) app.ApplicationConfig {
 	return &app.Application_ApiConfig{
 		ApiConfig: &app.APIConfig{
-			ClientId:       apiApp.ClientID,
-			AuthMethodType: apiAuthMethodTypeToPb(apiApp.AuthMethodType),
+			ClientId:                  apiApp.ClientID,
+			AuthMethodType:            apiAuthMethodTypeToPb(apiApp.AuthMethodType),
+			TlsClientAuthSubjectDn:    apiApp.TLSClientAuthSubjectDN,
+			TlsClientAuthPublicKeyPin: apiApp.TLSClientAuthPublicKeyPin,
 		},
 	}
 }
@@ -45,6 +51,10 @@ func apiAuthMethodTypeToDomain(authType app.APIAuthMethodType) domain.APIAuthMet
 		return domain.APIAuthMethodTypeBasic
 	case app.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.APIAuthMethodTypePrivateKeyJWT
+	case app.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeTLSClientAuth
+	case app.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.APIAuthMethodTypeBasic
 	}
@@ -56,6 +66,10 @@ func apiAuthMethodTypeToPb(methodType domain.APIAuthMethodType) app.APIAuthMetho
 		return app.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	case domain.APIAuthMethodTypePrivateKeyJWT:
 		return app.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.APIAuthMethodTypeTLSClientAuth:
+		return app.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.APIAuthMethodTypeSelfSignedTLSClientAuth:
+		return app.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	}
@@ -50,6 +50,21 @@ func TestCreateAPIApplicationRequestToDomain(t *testing.T) {
 				AuthMethodType: domain.APIAuthMethodTypePrivateKeyJWT,
 			},
 		},
+		{
+			name:      "tls client auth",
+			appName:   "mtls-app",
+			projectID: "proj-3",
+			req: &app.CreateAPIApplicationRequest{
+				AuthMethodType:         app.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+				TlsClientAuthSubjectDn: "CN=client,O=ZITADEL",
+			},
+			want: &domain.APIApp{
+				ObjectRoot:             models.ObjectRoot{AggregateID: "proj-3"},
+				AppName:                "mtls-app",
+				AuthMethodType:         domain.APIAuthMethodTypeTLSClientAuth,
+				TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -99,6 +114,21 @@ func TestUpdateAPIApplicationConfigurationRequestToDomain(t *testing.T) {
 				AuthMethodType: domain.APIAuthMethodTypePrivateKeyJWT,
 			},
 		},
+		{
+			name:      "self signed tls client auth",
+			appID:     "app-3",
+			projectID: "proj-3",
+			req: &app.UpdateAPIApplicationConfigurationRequest{
+				AuthMethodType:            app.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH,
+				TlsClientAuthPublicKeyPin: "pin",
+			},
+			want: &domain.APIApp{
+				ObjectRoot:                models.ObjectRoot{AggregateID: "proj-3"},
+				AppID:                     "app-3",
+				AuthMethodType:            domain.APIAuthMethodTypeSelfSignedTLSClientAuth,
+				TLSClientAuthPublicKeyPin: "pin",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -131,6 +161,11 @@ func Test_apiAuthMethodTypeToPb(t *testing.T) {
 			methodType:     domain.APIAuthMethodTypePrivateKeyJWT,
 			expectedResult: app.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT,
 		},
+		{
+			name:           "tls client auth",
+			methodType:     domain.APIAuthMethodTypeTLSClientAuth,
+			expectedResult: app.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+		},
 		{
 			name:           "unknown auth method defaults to basic",
 			expectedResult: app.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC,
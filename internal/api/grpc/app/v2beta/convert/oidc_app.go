@@ -19,25 +19,30 @@ func CreateOIDCAppRequestToDomain(name, projectID string, req *app.CreateOIDCApp
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppName:                  name,
-		OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-		RedirectUris:             req.GetRedirectUris(),
-		ResponseTypes:            oidcResponseTypesToDomain(req.GetResponseTypes()),
-		GrantTypes:               oidcGrantTypesToDomain(req.GetGrantTypes()),
-		ApplicationType:          gu.Ptr(oidcApplicationTypeToDomain(req.GetAppType())),
-		AuthMethodType:           gu.Ptr(oidcAuthMethodTypeToDomain(req.GetAuthMethodType())),
-		PostLogoutRedirectUris:   req.GetPostLogoutRedirectUris(),
-		DevMode:                  &req.DevMode,
-		AccessTokenType:          gu.Ptr(oidcTokenTypeToDomain(req.GetAccessTokenType())),
-		AccessTokenRoleAssertion: gu.Ptr(req.GetAccessTokenRoleAssertion()),
-		IDTokenRoleAssertion:     gu.Ptr(req.GetIdTokenRoleAssertion()),
-		IDTokenUserinfoAssertion: gu.Ptr(req.GetIdTokenUserinfoAssertion()),
-		ClockSkew:                gu.Ptr(req.GetClockSkew().AsDuration()),
-		AdditionalOrigins:        req.GetAdditionalOrigins(),
-		SkipNativeAppSuccessPage: gu.Ptr(req.GetSkipNativeAppSuccessPage()),
-		BackChannelLogoutURI:     gu.Ptr(req.GetBackChannelLogoutUri()),
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		AppName:                               name,
+		OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+		RedirectUris:                          req.GetRedirectUris(),
+		ResponseTypes:                         oidcResponseTypesToDomain(req.GetResponseTypes()),
+		GrantTypes:                            oidcGrantTypesToDomain(req.GetGrantTypes()),
+		ApplicationType:                       gu.Ptr(oidcApplicationTypeToDomain(req.GetAppType())),
+		AuthMethodType:                        gu.Ptr(oidcAuthMethodTypeToDomain(req.GetAuthMethodType())),
+		PostLogoutRedirectUris:                req.GetPostLogoutRedirectUris(),
+		DevMode:                               &req.DevMode,
+		AccessTokenType:                       gu.Ptr(oidcTokenTypeToDomain(req.GetAccessTokenType())),
+		AccessTokenRoleAssertion:              gu.Ptr(req.GetAccessTokenRoleAssertion()),
+		IDTokenRoleAssertion:                  gu.Ptr(req.GetIdTokenRoleAssertion()),
+		IDTokenUserinfoAssertion:              gu.Ptr(req.GetIdTokenUserinfoAssertion()),
+		ClockSkew:                             gu.Ptr(req.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                     req.GetAdditionalOrigins(),
+		SkipNativeAppSuccessPage:              gu.Ptr(req.GetSkipNativeAppSuccessPage()),
+		BackChannelLogoutURI:                  gu.Ptr(req.GetBackChannelLogoutUri()),
+		LoginVersion:                          loginVersion,
+		LoginBaseURI:                          loginBaseURI,
+		RequirePushedAuthorizationRequests:    gu.Ptr(req.GetRequirePushedAuthorizationRequests()),
+		RequireDPoP:                           gu.Ptr(req.GetRequireDpop()),
+		TLSClientAuthSubjectDN:                gu.Ptr(req.GetTlsClientAuthSubjectDn()),
+		TLSClientAuthPublicKeyPin:             gu.Ptr(req.GetTlsClientAuthPublicKeyPin()),
+		BackChannelClientNotificationEndpoint: gu.Ptr(req.GetBackChannelClientNotificationEndpoint()),
 	}, nil
 }
 
@@ -50,24 +55,29 @@ func UpdateOIDCAppConfigRequestToDomain(appID, projectID string, app *app.Update
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: projectID,
 		},
-		AppID:                    appID,
-		RedirectUris:             app.RedirectUris,
-		ResponseTypes:            oidcResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:               oidcGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:          oidcApplicationTypeToDomainPtr(app.AppType),
-		AuthMethodType:           oidcAuthMethodTypeToDomainPtr(app.AuthMethodType),
-		PostLogoutRedirectUris:   app.PostLogoutRedirectUris,
-		DevMode:                  app.DevMode,
-		AccessTokenType:          oidcTokenTypeToDomainPtr(app.AccessTokenType),
-		AccessTokenRoleAssertion: app.AccessTokenRoleAssertion,
-		IDTokenRoleAssertion:     app.IdTokenRoleAssertion,
-		IDTokenUserinfoAssertion: app.IdTokenUserinfoAssertion,
-		ClockSkew:                gu.Ptr(app.GetClockSkew().AsDuration()),
-		AdditionalOrigins:        app.AdditionalOrigins,
-		SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-		BackChannelLogoutURI:     app.BackChannelLogoutUri,
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		AppID:                                 appID,
+		RedirectUris:                          app.RedirectUris,
+		ResponseTypes:                         oidcResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                            oidcGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                       oidcApplicationTypeToDomainPtr(app.AppType),
+		AuthMethodType:                        oidcAuthMethodTypeToDomainPtr(app.AuthMethodType),
+		PostLogoutRedirectUris:                app.PostLogoutRedirectUris,
+		DevMode:                               app.DevMode,
+		AccessTokenType:                       oidcTokenTypeToDomainPtr(app.AccessTokenType),
+		AccessTokenRoleAssertion:              app.AccessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  app.IdTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              app.IdTokenUserinfoAssertion,
+		ClockSkew:                             gu.Ptr(app.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                     app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  app.BackChannelLogoutUri,
+		LoginVersion:                          loginVersion,
+		LoginBaseURI:                          loginBaseURI,
+		RequirePushedAuthorizationRequests:    app.RequirePushedAuthorizationRequests,
+		RequireDPoP:                           app.RequireDpop,
+		TLSClientAuthSubjectDN:                app.TlsClientAuthSubjectDn,
+		TLSClientAuthPublicKeyPin:             app.TlsClientAuthPublicKeyPin,
+		BackChannelClientNotificationEndpoint: app.BackChannelClientNotificationEndpoint,
 	}, nil
 }
 
@@ -108,6 +118,8 @@ func oidcGrantTypesToDomain(grantTypes []app.OIDCGrantType) []domain.OIDCGrantTy
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -153,6 +165,10 @@ func oidcAuthMethodTypeToDomain(authType app.OIDCAuthMethodType) domain.OIDCAuth
 		return domain.OIDCAuthMethodTypeNone
 	case app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -190,27 +206,32 @@ func ComplianceProblemsToLocalizedMessages(complianceProblems []string) []*app.O
 func appOIDCConfigToPb(oidcApp *query.OIDCApp) *app.Application_OidcConfig {
 	return &app.Application_OidcConfig{
 		OidcConfig: &app.OIDCConfig{
-			RedirectUris:             oidcApp.RedirectURIs,
-			ResponseTypes:            oidcResponseTypesFromModel(oidcApp.ResponseTypes),
-			GrantTypes:               oidcGrantTypesFromModel(oidcApp.GrantTypes),
-			AppType:                  oidcApplicationTypeToPb(oidcApp.AppType),
-			ClientId:                 oidcApp.ClientID,
-			AuthMethodType:           oidcAuthMethodTypeToPb(oidcApp.AuthMethodType),
-			PostLogoutRedirectUris:   oidcApp.PostLogoutRedirectURIs,
-			Version:                  app.OIDCVersion_OIDC_VERSION_1_0,
-			NoneCompliant:            len(oidcApp.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(oidcApp.ComplianceProblems),
-			DevMode:                  oidcApp.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(oidcApp.AccessTokenType),
-			AccessTokenRoleAssertion: oidcApp.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     oidcApp.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: oidcApp.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(oidcApp.ClockSkew),
-			AdditionalOrigins:        oidcApp.AdditionalOrigins,
-			AllowedOrigins:           oidcApp.AllowedOrigins,
-			SkipNativeAppSuccessPage: oidcApp.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     oidcApp.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(oidcApp.LoginVersion, oidcApp.LoginBaseURI),
+			RedirectUris:                          oidcApp.RedirectURIs,
+			ResponseTypes:                         oidcResponseTypesFromModel(oidcApp.ResponseTypes),
+			GrantTypes:                            oidcGrantTypesFromModel(oidcApp.GrantTypes),
+			AppType:                               oidcApplicationTypeToPb(oidcApp.AppType),
+			ClientId:                              oidcApp.ClientID,
+			AuthMethodType:                        oidcAuthMethodTypeToPb(oidcApp.AuthMethodType),
+			PostLogoutRedirectUris:                oidcApp.PostLogoutRedirectURIs,
+			Version:                               app.OIDCVersion_OIDC_VERSION_1_0,
+			NoneCompliant:                         len(oidcApp.ComplianceProblems) != 0,
+			ComplianceProblems:                    ComplianceProblemsToLocalizedMessages(oidcApp.ComplianceProblems),
+			DevMode:                               oidcApp.IsDevMode,
+			AccessTokenType:                       oidcTokenTypeToPb(oidcApp.AccessTokenType),
+			AccessTokenRoleAssertion:              oidcApp.AssertAccessTokenRole,
+			IdTokenRoleAssertion:                  oidcApp.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:              oidcApp.AssertIDTokenUserinfo,
+			ClockSkew:                             durationpb.New(oidcApp.ClockSkew),
+			AdditionalOrigins:                     oidcApp.AdditionalOrigins,
+			AllowedOrigins:                        oidcApp.AllowedOrigins,
+			SkipNativeAppSuccessPage:              oidcApp.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:                  oidcApp.BackChannelLogoutURI,
+			LoginVersion:                          loginVersionToPb(oidcApp.LoginVersion, oidcApp.LoginBaseURI),
+			RequirePushedAuthorizationRequests:    oidcApp.RequirePushedAuthorizationRequests,
+			RequireDpop:                           oidcApp.RequireDPoP,
+			TlsClientAuthSubjectDn:                oidcApp.TLSClientAuthSubjectDN,
+			TlsClientAuthPublicKeyPin:             oidcApp.TLSClientAuthPublicKeyPin,
+			BackChannelClientNotificationEndpoint: oidcApp.BackChannelClientNotificationEndpoint,
 		},
 	}
 }
@@ -246,6 +267,8 @@ func oidcGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app.OIDCGrantT
 			oidcGrantTypes[i] = app.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -274,6 +297,10 @@ func oidcAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) app.OIDCAuthMeth
 		return app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
@@ -45,46 +45,56 @@ func TestCreateOIDCAppRequestToDomain(t *testing.T) {
 			testName:  "all fields set",
 			projectID: "project1",
 			req: &app.CreateOIDCApplicationRequest{
-				RedirectUris:             []string{"https://redirect"},
-				ResponseTypes:            []app.OIDCResponseType{app.OIDCResponseType_OIDC_RESPONSE_TYPE_CODE},
-				GrantTypes:               []app.OIDCGrantType{app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE},
-				AppType:                  app.OIDCAppType_OIDC_APP_TYPE_WEB,
-				AuthMethodType:           app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC,
-				PostLogoutRedirectUris:   []string{"https://logout"},
-				DevMode:                  true,
-				AccessTokenType:          app.OIDCTokenType_OIDC_TOKEN_TYPE_BEARER,
-				AccessTokenRoleAssertion: true,
-				IdTokenRoleAssertion:     true,
-				IdTokenUserinfoAssertion: true,
-				ClockSkew:                durationpb.New(5 * time.Second),
-				AdditionalOrigins:        []string{"https://origin"},
-				SkipNativeAppSuccessPage: true,
-				BackChannelLogoutUri:     "https://backchannel",
+				RedirectUris:                          []string{"https://redirect"},
+				ResponseTypes:                         []app.OIDCResponseType{app.OIDCResponseType_OIDC_RESPONSE_TYPE_CODE},
+				GrantTypes:                            []app.OIDCGrantType{app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE},
+				AppType:                               app.OIDCAppType_OIDC_APP_TYPE_WEB,
+				AuthMethodType:                        app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC,
+				PostLogoutRedirectUris:                []string{"https://logout"},
+				DevMode:                               true,
+				AccessTokenType:                       app.OIDCTokenType_OIDC_TOKEN_TYPE_BEARER,
+				AccessTokenRoleAssertion:              true,
+				IdTokenRoleAssertion:                  true,
+				IdTokenUserinfoAssertion:              true,
+				ClockSkew:                             durationpb.New(5 * time.Second),
+				AdditionalOrigins:                     []string{"https://origin"},
+				SkipNativeAppSuccessPage:              true,
+				BackChannelLogoutUri:                  "https://backchannel",
+				RequirePushedAuthorizationRequests:    true,
+				RequireDpop:                           true,
+				TlsClientAuthSubjectDn:                "CN=client,O=ZITADEL",
+				TlsClientAuthPublicKeyPin:             "pin",
+				BackChannelClientNotificationEndpoint: "https://ciba.example.com/notify",
 				LoginVersion: &app.LoginVersion{Version: &app.LoginVersion_LoginV2{LoginV2: &app.LoginV2{
 					BaseUri: gu.Ptr("https://login"),
 				}}},
 			},
 			expectedModel: &domain.OIDCApp{
-				ObjectRoot:               models.ObjectRoot{AggregateID: "project1"},
-				AppName:                  "all fields set",
-				OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-				RedirectUris:             []string{"https://redirect"},
-				ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-				GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-				ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-				AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypeBasic),
-				PostLogoutRedirectUris:   []string{"https://logout"},
-				DevMode:                  gu.Ptr(true),
-				AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-				AccessTokenRoleAssertion: gu.Ptr(true),
-				IDTokenRoleAssertion:     gu.Ptr(true),
-				IDTokenUserinfoAssertion: gu.Ptr(true),
-				ClockSkew:                gu.Ptr(5 * time.Second),
-				AdditionalOrigins:        []string{"https://origin"},
-				SkipNativeAppSuccessPage: gu.Ptr(true),
-				BackChannelLogoutURI:     gu.Ptr("https://backchannel"),
-				LoginVersion:             gu.Ptr(domain.LoginVersion2),
-				LoginBaseURI:             gu.Ptr("https://login"),
+				ObjectRoot:                            models.ObjectRoot{AggregateID: "project1"},
+				AppName:                               "all fields set",
+				OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+				RedirectUris:                          []string{"https://redirect"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+				AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeBasic),
+				PostLogoutRedirectUris:                []string{"https://logout"},
+				DevMode:                               gu.Ptr(true),
+				AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+				AccessTokenRoleAssertion:              gu.Ptr(true),
+				IDTokenRoleAssertion:                  gu.Ptr(true),
+				IDTokenUserinfoAssertion:              gu.Ptr(true),
+				ClockSkew:                             gu.Ptr(5 * time.Second),
+				AdditionalOrigins:                     []string{"https://origin"},
+				SkipNativeAppSuccessPage:              gu.Ptr(true),
+				BackChannelLogoutURI:                  gu.Ptr("https://backchannel"),
+				RequirePushedAuthorizationRequests:    gu.Ptr(true),
+				RequireDPoP:                           gu.Ptr(true),
+				TLSClientAuthSubjectDN:                gu.Ptr("CN=client,O=ZITADEL"),
+				TLSClientAuthPublicKeyPin:             gu.Ptr("pin"),
+				BackChannelClientNotificationEndpoint: gu.Ptr("https://ciba.example.com/notify"),
+				LoginVersion:                          gu.Ptr(domain.LoginVersion2),
+				LoginBaseURI:                          gu.Ptr("https://login"),
 			},
 		},
 	}
@@ -137,45 +147,55 @@ func TestUpdateOIDCAppConfigRequestToDomain(t *testing.T) {
 			appID:     "app1",
 			projectID: "proj1",
 			req: &app.UpdateOIDCApplicationConfigurationRequest{
-				RedirectUris:             []string{"https://redirect"},
-				ResponseTypes:            []app.OIDCResponseType{app.OIDCResponseType_OIDC_RESPONSE_TYPE_CODE},
-				GrantTypes:               []app.OIDCGrantType{app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE},
-				AppType:                  gu.Ptr(app.OIDCAppType_OIDC_APP_TYPE_WEB),
-				AuthMethodType:           gu.Ptr(app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC),
-				PostLogoutRedirectUris:   []string{"https://logout"},
-				DevMode:                  gu.Ptr(true),
-				AccessTokenType:          gu.Ptr(app.OIDCTokenType_OIDC_TOKEN_TYPE_BEARER),
-				AccessTokenRoleAssertion: gu.Ptr(true),
-				IdTokenRoleAssertion:     gu.Ptr(true),
-				IdTokenUserinfoAssertion: gu.Ptr(true),
-				ClockSkew:                durationpb.New(5 * time.Second),
-				AdditionalOrigins:        []string{"https://origin"},
-				SkipNativeAppSuccessPage: gu.Ptr(true),
-				BackChannelLogoutUri:     gu.Ptr("https://backchannel"),
+				RedirectUris:                          []string{"https://redirect"},
+				ResponseTypes:                         []app.OIDCResponseType{app.OIDCResponseType_OIDC_RESPONSE_TYPE_CODE},
+				GrantTypes:                            []app.OIDCGrantType{app.OIDCGrantType_OIDC_GRANT_TYPE_AUTHORIZATION_CODE},
+				AppType:                               gu.Ptr(app.OIDCAppType_OIDC_APP_TYPE_WEB),
+				AuthMethodType:                        gu.Ptr(app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC),
+				PostLogoutRedirectUris:                []string{"https://logout"},
+				DevMode:                               gu.Ptr(true),
+				AccessTokenType:                       gu.Ptr(app.OIDCTokenType_OIDC_TOKEN_TYPE_BEARER),
+				AccessTokenRoleAssertion:              gu.Ptr(true),
+				IdTokenRoleAssertion:                  gu.Ptr(true),
+				IdTokenUserinfoAssertion:              gu.Ptr(true),
+				ClockSkew:                             durationpb.New(5 * time.Second),
+				AdditionalOrigins:                     []string{"https://origin"},
+				SkipNativeAppSuccessPage:              gu.Ptr(true),
+				BackChannelLogoutUri:                  gu.Ptr("https://backchannel"),
+				RequirePushedAuthorizationRequests:    gu.Ptr(true),
+				RequireDpop:                           gu.Ptr(true),
+				TlsClientAuthSubjectDn:                gu.Ptr("CN=client,O=ZITADEL"),
+				TlsClientAuthPublicKeyPin:             gu.Ptr("pin"),
+				BackChannelClientNotificationEndpoint: gu.Ptr("https://ciba.example.com/notify"),
 				LoginVersion: &app.LoginVersion{Version: &app.LoginVersion_LoginV2{
 					LoginV2: &app.LoginV2{BaseUri: gu.Ptr("https://login")},
 				}},
 			},
 			expectedModel: &domain.OIDCApp{
-				ObjectRoot:               models.ObjectRoot{AggregateID: "proj1"},
-				AppID:                    "app1",
-				RedirectUris:             []string{"https://redirect"},
-				ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-				GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-				ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-				AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypeBasic),
-				PostLogoutRedirectUris:   []string{"https://logout"},
-				DevMode:                  gu.Ptr(true),
-				AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-				AccessTokenRoleAssertion: gu.Ptr(true),
-				IDTokenRoleAssertion:     gu.Ptr(true),
-				IDTokenUserinfoAssertion: gu.Ptr(true),
-				ClockSkew:                gu.Ptr(5 * time.Second),
-				AdditionalOrigins:        []string{"https://origin"},
-				SkipNativeAppSuccessPage: gu.Ptr(true),
-				BackChannelLogoutURI:     gu.Ptr("https://backchannel"),
-				LoginVersion:             gu.Ptr(domain.LoginVersion2),
-				LoginBaseURI:             gu.Ptr("https://login"),
+				ObjectRoot:                            models.ObjectRoot{AggregateID: "proj1"},
+				AppID:                                 "app1",
+				RedirectUris:                          []string{"https://redirect"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+				AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeBasic),
+				PostLogoutRedirectUris:                []string{"https://logout"},
+				DevMode:                               gu.Ptr(true),
+				AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+				AccessTokenRoleAssertion:              gu.Ptr(true),
+				IDTokenRoleAssertion:                  gu.Ptr(true),
+				IDTokenUserinfoAssertion:              gu.Ptr(true),
+				ClockSkew:                             gu.Ptr(5 * time.Second),
+				AdditionalOrigins:                     []string{"https://origin"},
+				SkipNativeAppSuccessPage:              gu.Ptr(true),
+				BackChannelLogoutURI:                  gu.Ptr("https://backchannel"),
+				RequirePushedAuthorizationRequests:    gu.Ptr(true),
+				RequireDPoP:                           gu.Ptr(true),
+				TLSClientAuthSubjectDN:                gu.Ptr("CN=client,O=ZITADEL"),
+				TLSClientAuthPublicKeyPin:             gu.Ptr("pin"),
+				BackChannelClientNotificationEndpoint: gu.Ptr("https://ciba.example.com/notify"),
+				LoginVersion:                          gu.Ptr(domain.LoginVersion2),
+				LoginBaseURI:                          gu.Ptr("https://login"),
 			},
 		},
 	}
@@ -370,6 +390,16 @@ func TestOIDCAuthMethodTypeToDomain(t *testing.T) {
 			authType:         app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT,
 			expectedResponse: domain.OIDCAuthMethodTypePrivateKeyJWT,
 		},
+		{
+			name:             "tls client auth type",
+			authType:         app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+			expectedResponse: domain.OIDCAuthMethodTypeTLSClientAuth,
+		},
+		{
+			name:             "self signed tls client auth type",
+			authType:         app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH,
+			expectedResponse: domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+		},
 		{
 			name:             "unspecified auth type defaults to basic",
 			expectedResponse: domain.OIDCAuthMethodTypeBasic,
@@ -449,26 +479,31 @@ func TestAppOIDCConfigToPb(t *testing.T) {
 		{
 			name: "full config",
 			input: &query.OIDCApp{
-				RedirectURIs:             []string{"https://example.com/callback"},
-				ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-				GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-				AppType:                  domain.OIDCApplicationTypeWeb,
-				ClientID:                 "client123",
-				AuthMethodType:           domain.OIDCAuthMethodTypeBasic,
-				PostLogoutRedirectURIs:   []string{"https://example.com/logout"},
-				ComplianceProblems:       []string{"problem1", "problem2"},
-				IsDevMode:                true,
-				AccessTokenType:          domain.OIDCTokenTypeBearer,
-				AssertAccessTokenRole:    true,
-				AssertIDTokenRole:        true,
-				AssertIDTokenUserinfo:    true,
-				ClockSkew:                5 * time.Second,
-				AdditionalOrigins:        []string{"https://app.example.com"},
-				AllowedOrigins:           []string{"https://allowed.example.com"},
-				SkipNativeAppSuccessPage: true,
-				BackChannelLogoutURI:     "https://example.com/backchannel",
-				LoginVersion:             domain.LoginVersion2,
-				LoginBaseURI:             gu.Ptr("https://login.example.com"),
+				RedirectURIs:                          []string{"https://example.com/callback"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				AppType:                               domain.OIDCApplicationTypeWeb,
+				ClientID:                              "client123",
+				AuthMethodType:                        domain.OIDCAuthMethodTypeBasic,
+				PostLogoutRedirectURIs:                []string{"https://example.com/logout"},
+				ComplianceProblems:                    []string{"problem1", "problem2"},
+				IsDevMode:                             true,
+				AccessTokenType:                       domain.OIDCTokenTypeBearer,
+				AssertAccessTokenRole:                 true,
+				AssertIDTokenRole:                     true,
+				AssertIDTokenUserinfo:                 true,
+				ClockSkew:                             5 * time.Second,
+				AdditionalOrigins:                     []string{"https://app.example.com"},
+				AllowedOrigins:                        []string{"https://allowed.example.com"},
+				SkipNativeAppSuccessPage:              true,
+				BackChannelLogoutURI:                  "https://example.com/backchannel",
+				RequirePushedAuthorizationRequests:    true,
+				RequireDPoP:                           true,
+				TLSClientAuthSubjectDN:                "CN=client,O=ZITADEL",
+				TLSClientAuthPublicKeyPin:             "pin",
+				BackChannelClientNotificationEndpoint: "https://ciba.example.com/notify",
+				LoginVersion:                          domain.LoginVersion2,
+				LoginBaseURI:                          gu.Ptr("https://login.example.com"),
 			},
 			expected: &app.Application_OidcConfig{
 				OidcConfig: &app.OIDCConfig{
@@ -485,16 +520,21 @@ func TestAppOIDCConfigToPb(t *testing.T) {
 						{Key: "problem1"},
 						{Key: "problem2"},
 					},
-					DevMode:                  true,
-					AccessTokenType:          app.OIDCTokenType_OIDC_TOKEN_TYPE_BEARER,
-					AccessTokenRoleAssertion: true,
-					IdTokenRoleAssertion:     true,
-					IdTokenUserinfoAssertion: true,
-					ClockSkew:                durationpb.New(5 * time.Second),
-					AdditionalOrigins:        []string{"https://app.example.com"},
-					AllowedOrigins:           []string{"https://allowed.example.com"},
-					SkipNativeAppSuccessPage: true,
-					BackChannelLogoutUri:     "https://example.com/backchannel",
+					DevMode:                               true,
+					AccessTokenType:                       app.OIDCTokenType_OIDC_TOKEN_TYPE_BEARER,
+					AccessTokenRoleAssertion:              true,
+					IdTokenRoleAssertion:                  true,
+					IdTokenUserinfoAssertion:              true,
+					ClockSkew:                             durationpb.New(5 * time.Second),
+					AdditionalOrigins:                     []string{"https://app.example.com"},
+					AllowedOrigins:                        []string{"https://allowed.example.com"},
+					SkipNativeAppSuccessPage:              true,
+					BackChannelLogoutUri:                  "https://example.com/backchannel",
+					RequirePushedAuthorizationRequests:    true,
+					RequireDpop:                           true,
+					TlsClientAuthSubjectDn:                "CN=client,O=ZITADEL",
+					TlsClientAuthPublicKeyPin:             "pin",
+					BackChannelClientNotificationEndpoint: "https://ciba.example.com/notify",
 					LoginVersion: &app.LoginVersion{
 						Version: &app.LoginVersion_LoginV2{
 							LoginV2: &app.LoginV2{
@@ -696,6 +736,16 @@ func TestOIDCAuthMethodTypeToPb(t *testing.T) {
 			authType: domain.OIDCAuthMethodTypePrivateKeyJWT,
 			expected: app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT,
 		},
+		{
+			name:     "tls client auth type",
+			authType: domain.OIDCAuthMethodTypeTLSClientAuth,
+			expected: app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH,
+		},
+		{
+			name:     "self signed tls client auth type",
+			authType: domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+			expected: app.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH,
+		},
 		{
 			name:     "unknown auth type defaults to basic",
 			authType: domain.OIDCAuthMethodType(999),
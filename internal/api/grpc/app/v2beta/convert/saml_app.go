@@ -23,6 +23,12 @@ func CreateSAMLAppRequestToDomain(name, projectID string, req *app.CreateSAMLApp
 		MetadataURL:  gu.Ptr(req.GetMetadataUrl()),
 		LoginVersion: loginVersion,
 		LoginBaseURI: loginBaseURI,
+
+		SignatureAlgorithm:  gu.Ptr(samlSignatureAlgorithmToDomain(req.GetSignatureAlgorithm())),
+		DigestAlgorithm:     gu.Ptr(samlDigestAlgorithmToDomain(req.GetDigestAlgorithm())),
+		AssertionEncryption: gu.Ptr(samlAssertionEncryptionToDomain(req.GetAssertionEncryption())),
+		NameIDFormat:        samlNameIDFormatToDomainPtr(req.NameIdFormat),
+		AttributeMapping:    samlAttributeMappingToDomain(req.GetAttributeMapping()),
 	}, nil
 }
 
@@ -42,6 +48,12 @@ func UpdateSAMLAppConfigRequestToDomain(appID, projectID string, app *app.Update
 		MetadataURL:  metasURL,
 		LoginVersion: loginVersion,
 		LoginBaseURI: loginBaseURI,
+
+		SignatureAlgorithm:  samlSignatureAlgorithmToDomainPtr(app.SignatureAlgorithm),
+		DigestAlgorithm:     samlDigestAlgorithmToDomainPtr(app.DigestAlgorithm),
+		AssertionEncryption: samlAssertionEncryptionToDomainPtr(app.AssertionEncryption),
+		NameIDFormat:        samlNameIDFormatToDomainPtr(app.NameIdFormat),
+		AttributeMapping:    samlAttributeMappingToDomain(app.GetAttributeMapping()),
 	}, nil
 }
 
@@ -72,6 +84,210 @@ func appSAMLConfigToPb(samlApp *query.SAMLApp) app.ApplicationConfig {
 		SamlConfig: &app.SAMLConfig{
 			Metadata:     &app.SAMLConfig_MetadataXml{MetadataXml: samlApp.Metadata},
 			LoginVersion: loginVersionToPb(samlApp.LoginVersion, samlApp.LoginBaseURI),
+
+			SignatureAlgorithm:  samlSignatureAlgorithmToPb(samlApp.SignatureAlgorithm),
+			DigestAlgorithm:     samlDigestAlgorithmToPb(samlApp.DigestAlgorithm),
+			AssertionEncryption: samlAssertionEncryptionToPb(samlApp.AssertionEncryption),
+			NameIdFormat:        samlNameIDFormatToPb(samlApp.NameIDFormat),
+			AttributeMapping:    samlAttributeMappingToPb(samlApp.AttributeMapping),
 		},
 	}
 }
+
+func samlSignatureAlgorithmToDomainPtr(algorithm *app.SAMLSignatureAlgorithm) *domain.SAMLSignatureAlgorithm {
+	if algorithm == nil {
+		return nil
+	}
+	return gu.Ptr(samlSignatureAlgorithmToDomain(*algorithm))
+}
+
+func samlDigestAlgorithmToDomainPtr(algorithm *app.SAMLDigestAlgorithm) *domain.SAMLDigestAlgorithm {
+	if algorithm == nil {
+		return nil
+	}
+	return gu.Ptr(samlDigestAlgorithmToDomain(*algorithm))
+}
+
+func samlAssertionEncryptionToDomainPtr(encryption *app.SAMLAssertionEncryption) *domain.SAMLAssertionEncryption {
+	if encryption == nil {
+		return nil
+	}
+	return gu.Ptr(samlAssertionEncryptionToDomain(*encryption))
+}
+
+func samlSignatureAlgorithmToPb(algorithm domain.SAMLSignatureAlgorithm) app.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case domain.SAMLSignatureAlgorithmRSASHA256:
+		return app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256
+	case domain.SAMLSignatureAlgorithmRSASHA512:
+		return app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512
+	case domain.SAMLSignatureAlgorithmUnspecified:
+		fallthrough
+	default:
+		return app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func samlSignatureAlgorithmToDomain(algorithm app.SAMLSignatureAlgorithm) domain.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256:
+		return domain.SAMLSignatureAlgorithmRSASHA256
+	case app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512:
+		return domain.SAMLSignatureAlgorithmRSASHA512
+	case app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLSignatureAlgorithmUnspecified
+	}
+}
+
+func samlDigestAlgorithmToPb(algorithm domain.SAMLDigestAlgorithm) app.SAMLDigestAlgorithm {
+	switch algorithm {
+	case domain.SAMLDigestAlgorithmSHA256:
+		return app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256
+	case domain.SAMLDigestAlgorithmSHA512:
+		return app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512
+	case domain.SAMLDigestAlgorithmUnspecified:
+		fallthrough
+	default:
+		return app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func samlDigestAlgorithmToDomain(algorithm app.SAMLDigestAlgorithm) domain.SAMLDigestAlgorithm {
+	switch algorithm {
+	case app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256:
+		return domain.SAMLDigestAlgorithmSHA256
+	case app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512:
+		return domain.SAMLDigestAlgorithmSHA512
+	case app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLDigestAlgorithmUnspecified
+	}
+}
+
+func samlAssertionEncryptionToPb(encryption domain.SAMLAssertionEncryption) app.SAMLAssertionEncryption {
+	switch encryption {
+	case domain.SAMLAssertionEncryptionAES128GCM:
+		return app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES128_GCM
+	case domain.SAMLAssertionEncryptionAES256GCM:
+		return app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES256_GCM
+	case domain.SAMLAssertionEncryptionNone:
+		fallthrough
+	default:
+		return app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_NONE
+	}
+}
+
+func samlAssertionEncryptionToDomain(encryption app.SAMLAssertionEncryption) domain.SAMLAssertionEncryption {
+	switch encryption {
+	case app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES128_GCM:
+		return domain.SAMLAssertionEncryptionAES128GCM
+	case app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES256_GCM:
+		return domain.SAMLAssertionEncryptionAES256GCM
+	case app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_NONE:
+		fallthrough
+	default:
+		return domain.SAMLAssertionEncryptionNone
+	}
+}
+
+func samlNameIDFormatToDomainPtr(format *app.SAMLNameIDFormat) *domain.SAMLNameIDFormat {
+	if format == nil {
+		return nil
+	}
+	switch *format {
+	case app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		return gu.Ptr(domain.SAMLNameIDFormatEmailAddress)
+	case app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT:
+		return gu.Ptr(domain.SAMLNameIDFormatPersistent)
+	case app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT:
+		return gu.Ptr(domain.SAMLNameIDFormatTransient)
+	case app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED:
+		fallthrough
+	default:
+		return gu.Ptr(domain.SAMLNameIDFormatUnspecified)
+	}
+}
+
+func samlNameIDFormatToPb(format *domain.SAMLNameIDFormat) *app.SAMLNameIDFormat {
+	if format == nil {
+		return nil
+	}
+	switch *format {
+	case domain.SAMLNameIDFormatEmailAddress:
+		return app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS.Enum()
+	case domain.SAMLNameIDFormatPersistent:
+		return app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT.Enum()
+	case domain.SAMLNameIDFormatTransient:
+		return app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT.Enum()
+	case domain.SAMLNameIDFormatUnspecified:
+		fallthrough
+	default:
+		return app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED.Enum()
+	}
+}
+
+// samlAttributeMappingToDomain returns nil for an empty mapping, so an update leaves the mapping unchanged.
+func samlAttributeMappingToDomain(mappings []*app.SAMLAttributeMapping) []*domain.SAMLAttributeMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	domainMappings := make([]*domain.SAMLAttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		domainMappings[i] = &domain.SAMLAttributeMapping{
+			Name:       mapping.GetName(),
+			NameFormat: mapping.GetNameFormat(),
+			Source:     samlAttributeSourceToDomain(mapping.GetSource()),
+			Key:        mapping.GetKey(),
+		}
+	}
+	return domainMappings
+}
+
+func samlAttributeMappingToPb(mappings []*domain.SAMLAttributeMapping) []*app.SAMLAttributeMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	pbMappings := make([]*app.SAMLAttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		pbMappings[i] = &app.SAMLAttributeMapping{
+			Name:       mapping.Name,
+			NameFormat: mapping.NameFormat,
+			Source:     samlAttributeSourceToPb(mapping.Source),
+			Key:        mapping.Key,
+		}
+	}
+	return pbMappings
+}
+
+func samlAttributeSourceToDomain(source app.SAMLAttributeSource) domain.SAMLAttributeSource {
+	switch source {
+	case app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_USER_FIELD:
+		return domain.SAMLAttributeSourceUserField
+	case app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_METADATA:
+		return domain.SAMLAttributeSourceMetadata
+	case app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_ROLES:
+		return domain.SAMLAttributeSourceRoles
+	case app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLAttributeSourceUnspecified
+	}
+}
+
+func samlAttributeSourceToPb(source domain.SAMLAttributeSource) app.SAMLAttributeSource {
+	switch source {
+	case domain.SAMLAttributeSourceUserField:
+		return app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_USER_FIELD
+	case domain.SAMLAttributeSourceMetadata:
+		return app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_METADATA
+	case domain.SAMLAttributeSourceRoles:
+		return app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_ROLES
+	case domain.SAMLAttributeSourceUnspecified:
+		fallthrough
+	default:
+		return app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_UNSPECIFIED
+	}
+}
@@ -77,17 +77,23 @@ func TestCreateSAMLAppRequestToDomain(t *testing.T) {
 				Metadata: &app.CreateSAMLApplicationRequest_MetadataXml{
 					MetadataXml: genMetaForValidRequest,
 				},
-				LoginVersion: nil,
+				LoginVersion:        nil,
+				SignatureAlgorithm:  app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512,
+				DigestAlgorithm:     app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512,
+				AssertionEncryption: app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES256_GCM,
 			},
 
 			expectedResponse: &domain.SAMLApp{
-				ObjectRoot:   models.ObjectRoot{AggregateID: "proj-1"},
-				AppName:      "test-app",
-				Metadata:     genMetaForValidRequest,
-				MetadataURL:  gu.Ptr(""),
-				LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-				LoginBaseURI: gu.Ptr(""),
-				State:        0,
+				ObjectRoot:          models.ObjectRoot{AggregateID: "proj-1"},
+				AppName:             "test-app",
+				Metadata:            genMetaForValidRequest,
+				MetadataURL:         gu.Ptr(""),
+				LoginVersion:        gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:        gu.Ptr(""),
+				SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA512),
+				DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmSHA512),
+				AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES256GCM),
+				State:               0,
 			},
 		},
 		{
@@ -97,11 +103,14 @@ func TestCreateSAMLAppRequestToDomain(t *testing.T) {
 			req:       nil,
 
 			expectedResponse: &domain.SAMLApp{
-				AppName:      "test-app",
-				ObjectRoot:   models.ObjectRoot{AggregateID: "proj-1"},
-				MetadataURL:  gu.Ptr(""),
-				LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
-				LoginBaseURI: gu.Ptr(""),
+				AppName:             "test-app",
+				ObjectRoot:          models.ObjectRoot{AggregateID: "proj-1"},
+				MetadataURL:         gu.Ptr(""),
+				LoginVersion:        gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:        gu.Ptr(""),
+				SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+				DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+				AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
 			},
 		},
 	}
@@ -171,6 +180,56 @@ func TestUpdateSAMLAppConfigRequestToDomain(t *testing.T) {
 				LoginBaseURI: gu.Ptr(""),
 			},
 		},
+		{
+			testName:  "valid request with signing and encryption",
+			appID:     "app-1",
+			projectID: "proj-1",
+			req: &app.UpdateSAMLApplicationConfigurationRequest{
+				Metadata: &app.UpdateSAMLApplicationConfigurationRequest_MetadataXml{
+					MetadataXml: genMetaForValidRequest,
+				},
+				SignatureAlgorithm:  gu.Ptr(app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256),
+				DigestAlgorithm:     gu.Ptr(app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512),
+				AssertionEncryption: gu.Ptr(app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES128_GCM),
+			},
+			expectedResponse: &domain.SAMLApp{
+				ObjectRoot:          models.ObjectRoot{AggregateID: "proj-1"},
+				AppID:               "app-1",
+				Metadata:            genMetaForValidRequest,
+				LoginVersion:        gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI:        gu.Ptr(""),
+				SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA256),
+				DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmSHA512),
+				AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES128GCM),
+			},
+		},
+		{
+			testName:  "valid request with name id format and attribute mapping",
+			appID:     "app-1",
+			projectID: "proj-1",
+			req: &app.UpdateSAMLApplicationConfigurationRequest{
+				Metadata: &app.UpdateSAMLApplicationConfigurationRequest_MetadataXml{
+					MetadataXml: genMetaForValidRequest,
+				},
+				NameIdFormat: gu.Ptr(app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT),
+				AttributeMapping: []*app.SAMLAttributeMapping{
+					{Name: "mail", Source: app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_USER_FIELD, Key: "email"},
+					{Name: "groups", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", Source: app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_ROLES},
+				},
+			},
+			expectedResponse: &domain.SAMLApp{
+				ObjectRoot:   models.ObjectRoot{AggregateID: "proj-1"},
+				AppID:        "app-1",
+				Metadata:     genMetaForValidRequest,
+				LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
+				LoginBaseURI: gu.Ptr(""),
+				NameIDFormat: gu.Ptr(domain.SAMLNameIDFormatPersistent),
+				AttributeMapping: []*domain.SAMLAttributeMapping{
+					{Name: "mail", Source: domain.SAMLAttributeSourceUserField, Key: "email"},
+					{Name: "groups", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", Source: domain.SAMLAttributeSourceRoles},
+				},
+			},
+		},
 		{
 			testName:  "nil request",
 			appID:     "app-1",
@@ -213,9 +272,16 @@ func TestAppSAMLConfigToPb(t *testing.T) {
 		{
 			name: "valid conversion",
 			inputSAMLApp: &query.SAMLApp{
-				Metadata:     metadata,
-				LoginVersion: domain.LoginVersion2,
-				LoginBaseURI: gu.Ptr("https://example.com"),
+				Metadata:            metadata,
+				LoginVersion:        domain.LoginVersion2,
+				LoginBaseURI:        gu.Ptr("https://example.com"),
+				SignatureAlgorithm:  domain.SAMLSignatureAlgorithmRSASHA512,
+				DigestAlgorithm:     domain.SAMLDigestAlgorithmSHA512,
+				AssertionEncryption: domain.SAMLAssertionEncryptionAES256GCM,
+				NameIDFormat:        gu.Ptr(domain.SAMLNameIDFormatTransient),
+				AttributeMapping: []*domain.SAMLAttributeMapping{
+					{Name: "department", Source: domain.SAMLAttributeSourceMetadata, Key: "department"},
+				},
 			},
 			expectedPbApp: &app.Application_SamlConfig{
 				SamlConfig: &app.SAMLConfig{
@@ -227,6 +293,13 @@ func TestAppSAMLConfigToPb(t *testing.T) {
 							LoginV2: &app.LoginV2{BaseUri: gu.Ptr("https://example.com")},
 						},
 					},
+					SignatureAlgorithm:  app.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512,
+					DigestAlgorithm:     app.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512,
+					AssertionEncryption: app.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES256_GCM,
+					NameIdFormat:        app.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT.Enum(),
+					AttributeMapping: []*app.SAMLAttributeMapping{
+						{Name: "department", Source: app.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_METADATA, Key: "department"},
+					},
 				},
 			},
 		},
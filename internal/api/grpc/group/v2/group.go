@@ -0,0 +1,137 @@
+package group
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	group "github.com/zitadel/zitadel/pkg/grpc/group/v2"
+)
+
+func (s *Server) CreateGroup(ctx context.Context, req *connect.Request[group.CreateGroupRequest]) (*connect.Response[group.CreateGroupResponse], error) {
+	details, err := s.command.AddGroup(ctx, &command.AddGroup{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.Msg.GetGroupId(),
+			ResourceOwner: req.Msg.GetOrganizationId(),
+		},
+		Name:        req.Msg.GetName(),
+		Description: req.Msg.GetDescription(),
+		ExternalID:  req.Msg.GetExternalId(),
+		Members:     req.Msg.GetMemberUserIds(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.CreateGroupResponse{
+		Id:           details.ID,
+		CreationDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) UpdateGroup(ctx context.Context, req *connect.Request[group.UpdateGroupRequest]) (*connect.Response[group.UpdateGroupResponse], error) {
+	details, err := s.command.ChangeGroup(ctx, &command.ChangeGroup{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   req.Msg.GetGroupId(),
+			ResourceOwner: req.Msg.GetOrganizationId(),
+		},
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+		ExternalID:  req.Msg.ExternalId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.UpdateGroupResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) DeleteGroup(ctx context.Context, req *connect.Request[group.DeleteGroupRequest]) (*connect.Response[group.DeleteGroupResponse], error) {
+	details, err := s.command.RemoveGroup(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.DeleteGroupResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) AddGroupMembers(ctx context.Context, req *connect.Request[group.AddGroupMembersRequest]) (*connect.Response[group.AddGroupMembersResponse], error) {
+	details, err := s.command.AddGroupMembers(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId(), req.Msg.GetUserIds()...)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.AddGroupMembersResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) RemoveGroupMembers(ctx context.Context, req *connect.Request[group.RemoveGroupMembersRequest]) (*connect.Response[group.RemoveGroupMembersResponse], error) {
+	details, err := s.command.RemoveGroupMembers(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId(), req.Msg.GetUserIds()...)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.RemoveGroupMembersResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) AddNestedGroups(ctx context.Context, req *connect.Request[group.AddNestedGroupsRequest]) (*connect.Response[group.AddNestedGroupsResponse], error) {
+	details, err := s.command.AddNestedGroups(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId(), req.Msg.GetNestedGroupIds()...)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.AddNestedGroupsResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) RemoveNestedGroups(ctx context.Context, req *connect.Request[group.RemoveNestedGroupsRequest]) (*connect.Response[group.RemoveNestedGroupsResponse], error) {
+	details, err := s.command.RemoveNestedGroups(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId(), req.Msg.GetNestedGroupIds()...)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.RemoveNestedGroupsResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) CreateGroupGrant(ctx context.Context, req *connect.Request[group.CreateGroupGrantRequest]) (*connect.Response[group.CreateGroupGrantResponse], error) {
+	add := &command.AddGroupGrant{
+		GroupID:       req.Msg.GetGroupId(),
+		ResourceOwner: req.Msg.GetOrganizationId(),
+		ProjectID:     req.Msg.GetProjectId(),
+		RoleKeys:      req.Msg.GetRoleKeys(),
+	}
+	details, err := s.command.AddGroupGrant(ctx, add)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.CreateGroupGrantResponse{
+		Id:           add.GrantID,
+		CreationDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) UpdateGroupGrant(ctx context.Context, req *connect.Request[group.UpdateGroupGrantRequest]) (*connect.Response[group.UpdateGroupGrantResponse], error) {
+	details, err := s.command.ChangeGroupGrant(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId(), req.Msg.GetGrantId(), req.Msg.GetRoleKeys())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.UpdateGroupGrantResponse{
+		ChangeDate: timestamppb.New(details.EventDate),
+	}), nil
+}
+
+func (s *Server) DeleteGroupGrant(ctx context.Context, req *connect.Request[group.DeleteGroupGrantRequest]) (*connect.Response[group.DeleteGroupGrantResponse], error) {
+	details, err := s.command.RemoveGroupGrant(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId(), req.Msg.GetGrantId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.DeleteGroupGrantResponse{
+		DeletionDate: timestamppb.New(details.EventDate),
+	}), nil
+}
@@ -0,0 +1,274 @@
+package group
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	filter "github.com/zitadel/zitadel/internal/api/grpc/filter/v2"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	group "github.com/zitadel/zitadel/pkg/grpc/group/v2"
+)
+
+func (s *Server) GetGroup(ctx context.Context, req *connect.Request[group.GetGroupRequest]) (*connect.Response[group.GetGroupResponse], error) {
+	g, err := s.getGroup(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.GetGroupResponse{
+		Group: groupToPb(g),
+	}), nil
+}
+
+func (s *Server) ListGroups(ctx context.Context, req *connect.Request[group.ListGroupsRequest]) (*connect.Response[group.ListGroupsResponse], error) {
+	if err := s.checkPermission(ctx, domain.PermissionGroupRead, req.Msg.GetOrganizationId(), ""); err != nil {
+		return nil, err
+	}
+	queries, err := s.listGroupsRequestToModel(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchGroups(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.ListGroupsResponse{
+		Groups:     groupsToPb(resp.Groups),
+		Pagination: filter.QueryToPaginationPb(queries.SearchRequest, resp.SearchResponse),
+	}), nil
+}
+
+func (s *Server) ListGroupMembers(ctx context.Context, req *connect.Request[group.ListGroupMembersRequest]) (*connect.Response[group.ListGroupMembersResponse], error) {
+	g, err := s.getGroup(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.query.GroupMembersByGroupIDs(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.ListGroupMembersResponse{
+		Members: groupMembersToPb(members.Members),
+	}), nil
+}
+
+func (s *Server) ListNestedGroups(ctx context.Context, req *connect.Request[group.ListNestedGroupsRequest]) (*connect.Response[group.ListNestedGroupsResponse], error) {
+	g, err := s.getGroup(ctx, req.Msg.GetGroupId(), req.Msg.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	nestedGroups, err := s.query.NestedGroupsByGroupIDs(ctx, g.ID)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.ListNestedGroupsResponse{
+		NestedGroups: nestedGroupsToPb(nestedGroups.NestedGroups),
+	}), nil
+}
+
+func (s *Server) ListGroupGrants(ctx context.Context, req *connect.Request[group.ListGroupGrantsRequest]) (*connect.Response[group.ListGroupGrantsResponse], error) {
+	if err := s.checkPermission(ctx, domain.PermissionGroupRead, req.Msg.GetOrganizationId(), ""); err != nil {
+		return nil, err
+	}
+	queries, err := s.listGroupGrantsRequestToModel(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.query.SearchGroupGrants(ctx, queries)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&group.ListGroupGrantsResponse{
+		GroupGrants: groupGrantsToPb(resp.GroupGrants),
+		Pagination:  filter.QueryToPaginationPb(queries.SearchRequest, resp.SearchResponse),
+	}), nil
+}
+
+func (s *Server) getGroup(ctx context.Context, groupID, orgID string) (*query.Group, error) {
+	if err := s.checkPermission(ctx, domain.PermissionGroupRead, orgID, groupID); err != nil {
+		return nil, err
+	}
+	return s.query.GetGroupByID(ctx, groupID, orgID)
+}
+
+func (s *Server) listGroupsRequestToModel(req *group.ListGroupsRequest) (*query.GroupSearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	queries, err := groupFiltersToQuery(req.GetFilters())
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupResourceOwnerSearchQuery(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: groupFieldNameToSortingColumn(req.GetSortingColumn()),
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func groupFieldNameToSortingColumn(field group.GroupFieldName) query.Column {
+	switch field {
+	case group.GroupFieldName_GROUP_FIELD_NAME_ID:
+		return query.GroupColumnID
+	case group.GroupFieldName_GROUP_FIELD_NAME_NAME:
+		return query.GroupColumnName
+	case group.GroupFieldName_GROUP_FIELD_NAME_CHANGE_DATE:
+		return query.GroupColumnChangeDate
+	case group.GroupFieldName_GROUP_FIELD_NAME_CREATION_DATE,
+		group.GroupFieldName_GROUP_FIELD_NAME_UNSPECIFIED:
+		return query.GroupColumnCreationDate
+	default:
+		return query.GroupColumnCreationDate
+	}
+}
+
+func groupFiltersToQuery(filters []*group.GroupsSearchFilter) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(filters))
+	for i, f := range filters {
+		q[i], err = groupFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func groupFilterToQuery(f *group.GroupsSearchFilter) (query.SearchQuery, error) {
+	switch q := f.GetFilter().(type) {
+	case *group.GroupsSearchFilter_GroupIds:
+		return query.NewGroupIDsSearchQuery(q.GroupIds.GetIds())
+	case *group.GroupsSearchFilter_Name:
+		return query.NewGroupNameSearchQuery(filter.TextMethodPbToQuery(q.Name.GetMethod()), q.Name.GetName())
+	case *group.GroupsSearchFilter_MemberUserId:
+		return query.NewGroupMemberSearchQuery(q.MemberUserId.GetId())
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GROUP-Wb7zs", "List.Query.Invalid")
+	}
+}
+
+func (s *Server) listGroupGrantsRequestToModel(req *group.ListGroupGrantsRequest) (*query.GroupGrantSearchQueries, error) {
+	offset, limit, asc, err := filter.PaginationPbToQuery(s.systemDefaults, req.GetPagination())
+	if err != nil {
+		return nil, err
+	}
+	queries, err := groupGrantFiltersToQuery(req.GetFilters())
+	if err != nil {
+		return nil, err
+	}
+	ownerQuery, err := query.NewGroupGrantResourceOwnerSearchQuery(req.GetOrganizationId())
+	if err != nil {
+		return nil, err
+	}
+	return &query.GroupGrantSearchQueries{
+		SearchRequest: query.SearchRequest{
+			Offset:        offset,
+			Limit:         limit,
+			Asc:           asc,
+			SortingColumn: query.GroupGrantColumnCreationDate,
+		},
+		Queries: append(queries, ownerQuery),
+	}, nil
+}
+
+func groupGrantFiltersToQuery(filters []*group.GroupGrantsSearchFilter) (_ []query.SearchQuery, err error) {
+	q := make([]query.SearchQuery, len(filters))
+	for i, f := range filters {
+		q[i], err = groupGrantFilterToQuery(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func groupGrantFilterToQuery(f *group.GroupGrantsSearchFilter) (query.SearchQuery, error) {
+	switch q := f.GetFilter().(type) {
+	case *group.GroupGrantsSearchFilter_GroupId:
+		return query.NewGroupGrantGroupIDSearchQuery(q.GroupId.GetId())
+	case *group.GroupGrantsSearchFilter_ProjectId:
+		return query.NewGroupGrantProjectIDSearchQuery(q.ProjectId.GetId())
+	case *group.GroupGrantsSearchFilter_ProjectGrantId:
+		return query.NewGroupGrantProjectGrantIDSearchQuery(q.ProjectGrantId.GetId())
+	case *group.GroupGrantsSearchFilter_RoleKey:
+		return query.NewGroupGrantRoleKeySearchQuery(q.RoleKey.GetKey())
+	default:
+		return nil, zerrors.ThrowInvalidArgument(nil, "GROUP-Lm4qf", "List.Query.Invalid")
+	}
+}
+
+func groupsToPb(groups []*query.Group) []*group.Group {
+	g := make([]*group.Group, len(groups))
+	for i, grp := range groups {
+		g[i] = groupToPb(grp)
+	}
+	return g
+}
+
+func groupToPb(g *query.Group) *group.Group {
+	return &group.Group{
+		Id:             g.ID,
+		OrganizationId: g.ResourceOwner,
+		CreationDate:   timestamppb.New(g.CreationDate),
+		ChangeDate:     timestamppb.New(g.EventDate),
+		Name:           g.Name,
+		Description:    g.Description,
+		ExternalId:     g.ExternalID,
+	}
+}
+
+func groupMembersToPb(members []*query.GroupMember) []*group.GroupMember {
+	m := make([]*group.GroupMember, len(members))
+	for i, member := range members {
+		m[i] = &group.GroupMember{
+			UserId:       member.UserID,
+			Username:     member.Username,
+			CreationDate: timestamppb.New(member.CreationDate),
+		}
+	}
+	return m
+}
+
+func nestedGroupsToPb(nestedGroups []*query.NestedGroup) []*group.NestedGroup {
+	n := make([]*group.NestedGroup, len(nestedGroups))
+	for i, nested := range nestedGroups {
+		n[i] = &group.NestedGroup{
+			GroupId:      nested.NestedGroupID,
+			Name:         nested.Name,
+			CreationDate: timestamppb.New(nested.CreationDate),
+		}
+	}
+	return n
+}
+
+func groupGrantsToPb(grants []*query.GroupGrant) []*group.GroupGrant {
+	g := make([]*group.GroupGrant, len(grants))
+	for i, grant := range grants {
+		var projectGrantID *string
+		if grant.ProjectGrantID != "" {
+			projectGrantID = &grant.ProjectGrantID
+		}
+		g[i] = &group.GroupGrant{
+			Id:             grant.ID,
+			GroupId:        grant.GroupID,
+			OrganizationId: grant.ResourceOwner,
+			ProjectId:      grant.ProjectID,
+			ProjectGrantId: projectGrantID,
+			RoleKeys:       grant.RoleKeys,
+			CreationDate:   timestamppb.New(grant.CreationDate),
+			ChangeDate:     timestamppb.New(grant.EventDate),
+		}
+	}
+	return g
+}
@@ -271,11 +271,31 @@ func OptionsToCommand(options *idp_pb.Options) idp.Options {
 		return idp.Options{}
 	}
 	return idp.Options{
-		IsCreationAllowed: options.IsCreationAllowed,
-		IsLinkingAllowed:  options.IsLinkingAllowed,
-		IsAutoCreation:    options.IsAutoCreation,
-		IsAutoUpdate:      options.IsAutoUpdate,
-		AutoLinkingOption: autoLinkingOptionToCommand(options.AutoLinking),
+		IsCreationAllowed:        options.IsCreationAllowed,
+		IsLinkingAllowed:         options.IsLinkingAllowed,
+		IsAutoCreation:           options.IsAutoCreation,
+		IsAutoUpdate:             options.IsAutoUpdate,
+		AutoLinkingOption:        autoLinkingOptionToCommand(options.AutoLinking),
+		GroupMapping:             groupMappingToCommand(options.GroupMapping),
+		IsFederatedLogoutEnabled: options.IsFederatedLogoutEnabled,
+	}
+}
+
+func groupMappingToCommand(mapping *idp_pb.GroupMapping) *domain.IDPGroupMapping {
+	if mapping == nil {
+		return nil
+	}
+	mappings := make([]*domain.IDPGroupRoleMapping, len(mapping.GetMappings()))
+	for i, roleMapping := range mapping.GetMappings() {
+		mappings[i] = &domain.IDPGroupRoleMapping{
+			Group:     roleMapping.GetGroup(),
+			ProjectID: roleMapping.GetProjectId(),
+			RoleKeys:  roleMapping.GetRoleKeys(),
+		}
+	}
+	return &domain.IDPGroupMapping{
+		Claim:    mapping.GetClaim(),
+		Mappings: mappings,
 	}
 }
 
@@ -313,6 +333,28 @@ func LDAPAttributesToCommand(attributes *idp_pb.LDAPAttributes) idp.LDAPAttribut
 	}
 }
 
+func ClaimMappingToCommand(mapping *idp_pb.ClaimMapping) *domain.IDPClaimMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &domain.IDPClaimMapping{
+		ID:                mapping.GetId(),
+		FirstName:         mapping.GetFirstName(),
+		LastName:          mapping.GetLastName(),
+		DisplayName:       mapping.GetDisplayName(),
+		NickName:          mapping.GetNickName(),
+		PreferredUsername: mapping.GetPreferredUsername(),
+		Email:             mapping.GetEmail(),
+		EmailVerified:     mapping.GetEmailVerified(),
+		Phone:             mapping.GetPhone(),
+		PhoneVerified:     mapping.GetPhoneVerified(),
+		PreferredLanguage: mapping.GetPreferredLanguage(),
+		AvatarURL:         mapping.GetAvatarUrl(),
+		Profile:           mapping.GetProfile(),
+		Metadata:          mapping.GetMetadata(),
+	}
+}
+
 func AzureADTenantToCommand(tenant *idp_pb.AzureADTenant) string {
 	if tenant == nil {
 		return string(azuread.CommonTenant)
@@ -414,6 +456,14 @@ func providerTypeToPb(idpType domain.IDPType) idp_pb.ProviderType {
 		return idp_pb.ProviderType_PROVIDER_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return idp_pb.ProviderType_PROVIDER_TYPE_SAML
+	case domain.IDPTypeOkta:
+		return idp_pb.ProviderType_PROVIDER_TYPE_OKTA
+	case domain.IDPTypeAuth0:
+		return idp_pb.ProviderType_PROVIDER_TYPE_AUTH0
+	case domain.IDPTypeKeycloak:
+		return idp_pb.ProviderType_PROVIDER_TYPE_KEYCLOAK
+	case domain.IDPTypeEntraExternalID:
+		return idp_pb.ProviderType_PROVIDER_TYPE_ENTRA_EXTERNAL_ID
 	case domain.IDPTypeUnspecified:
 		return idp_pb.ProviderType_PROVIDER_TYPE_UNSPECIFIED
 	default:
@@ -424,11 +474,13 @@ func providerTypeToPb(idpType domain.IDPType) idp_pb.ProviderType {
 func configToPb(config *query.IDPTemplate) *idp_pb.ProviderConfig {
 	providerConfig := &idp_pb.ProviderConfig{
 		Options: &idp_pb.Options{
-			IsLinkingAllowed:  config.IsLinkingAllowed,
-			IsCreationAllowed: config.IsCreationAllowed,
-			IsAutoCreation:    config.IsAutoCreation,
-			IsAutoUpdate:      config.IsAutoUpdate,
-			AutoLinking:       autoLinkingOptionToPb(config.AutoLinking),
+			IsLinkingAllowed:         config.IsLinkingAllowed,
+			IsCreationAllowed:        config.IsCreationAllowed,
+			IsAutoCreation:           config.IsAutoCreation,
+			IsAutoUpdate:             config.IsAutoUpdate,
+			AutoLinking:              autoLinkingOptionToPb(config.AutoLinking),
+			GroupMapping:             groupMappingToPb(config.GroupMapping),
+			IsFederatedLogoutEnabled: config.IsFederatedLogoutEnabled,
 		},
 	}
 	if config.OAuthIDPTemplate != nil {
@@ -479,6 +531,22 @@ func configToPb(config *query.IDPTemplate) *idp_pb.ProviderConfig {
 		samlConfigToPb(providerConfig, config.SAMLIDPTemplate)
 		return providerConfig
 	}
+	if config.OktaIDPTemplate != nil {
+		oktaConfigToPb(providerConfig, config.OktaIDPTemplate)
+		return providerConfig
+	}
+	if config.Auth0IDPTemplate != nil {
+		auth0ConfigToPb(providerConfig, config.Auth0IDPTemplate)
+		return providerConfig
+	}
+	if config.KeycloakIDPTemplate != nil {
+		keycloakConfigToPb(providerConfig, config.KeycloakIDPTemplate)
+		return providerConfig
+	}
+	if config.EntraExternalIDIDPTemplate != nil {
+		entraExternalIDConfigToPb(providerConfig, config.EntraExternalIDIDPTemplate)
+		return providerConfig
+	}
 	return providerConfig
 }
 
@@ -505,6 +573,7 @@ func oauthConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OAut
 			Scopes:                template.Scopes,
 			IdAttribute:           template.IDAttribute,
 			UsePkce:               template.UsePKCE,
+			ClaimMapping:          claimMappingToPb(template.ClaimMapping),
 		},
 	}
 }
@@ -517,10 +586,51 @@ func oidcConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OIDCI
 			Scopes:           template.Scopes,
 			IsIdTokenMapping: template.IsIDTokenMapping,
 			UsePkce:          template.UsePKCE,
+			ClaimMapping:     claimMappingToPb(template.ClaimMapping),
 		},
 	}
 }
 
+func claimMappingToPb(mapping *domain.IDPClaimMapping) *idp_pb.ClaimMapping {
+	if mapping.IsZero() {
+		return nil
+	}
+	return &idp_pb.ClaimMapping{
+		Id:                mapping.ID,
+		FirstName:         mapping.FirstName,
+		LastName:          mapping.LastName,
+		DisplayName:       mapping.DisplayName,
+		NickName:          mapping.NickName,
+		PreferredUsername: mapping.PreferredUsername,
+		Email:             mapping.Email,
+		EmailVerified:     mapping.EmailVerified,
+		Phone:             mapping.Phone,
+		PhoneVerified:     mapping.PhoneVerified,
+		PreferredLanguage: mapping.PreferredLanguage,
+		AvatarUrl:         mapping.AvatarURL,
+		Profile:           mapping.Profile,
+		Metadata:          mapping.Metadata,
+	}
+}
+
+func groupMappingToPb(mapping *domain.IDPGroupMapping) *idp_pb.GroupMapping {
+	if mapping.IsZero() {
+		return nil
+	}
+	mappings := make([]*idp_pb.GroupRoleMapping, len(mapping.Mappings))
+	for i, roleMapping := range mapping.Mappings {
+		mappings[i] = &idp_pb.GroupRoleMapping{
+			Group:     roleMapping.Group,
+			ProjectId: roleMapping.ProjectID,
+			RoleKeys:  roleMapping.RoleKeys,
+		}
+	}
+	return &idp_pb.GroupMapping{
+		Claim:    mapping.Claim,
+		Mappings: mappings,
+	}
+}
+
 func jwtConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.JWTIDPTemplate) {
 	providerConfig.Config = &idp_pb.ProviderConfig_Jwt{
 		Jwt: &idp_pb.JWTConfig{
@@ -674,6 +784,49 @@ func samlConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.SAMLI
 	}
 }
 
+func oktaConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.OktaIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Okta{
+		Okta: &idp_pb.OktaConfig{
+			Domain:                template.Domain,
+			AuthorizationServerId: template.AuthorizationServerID,
+			ClientId:              template.ClientID,
+			Scopes:                template.Scopes,
+		},
+	}
+}
+
+func auth0ConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.Auth0IDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Auth0{
+		Auth0: &idp_pb.Auth0Config{
+			Domain:   template.Domain,
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func keycloakConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.KeycloakIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_Keycloak{
+		Keycloak: &idp_pb.KeycloakConfig{
+			BaseUrl:  template.BaseURL,
+			Realm:    template.Realm,
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func entraExternalIDConfigToPb(providerConfig *idp_pb.ProviderConfig, template *query.EntraExternalIDIDPTemplate) {
+	providerConfig.Config = &idp_pb.ProviderConfig_EntraExternalId{
+		EntraExternalId: &idp_pb.EntraExternalIDConfig{
+			TenantId: template.TenantID,
+			Domain:   template.Domain,
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
 func bindingToPb(binding string) idp_pb.SAMLBinding {
 	switch binding {
 	case "":
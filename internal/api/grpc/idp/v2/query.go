@@ -84,6 +84,14 @@ func idpTypeToPb(idpType domain.IDPType) idp_pb.IDPType {
 		return idp_pb.IDPType_IDP_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return idp_pb.IDPType_IDP_TYPE_SAML
+	case domain.IDPTypeOkta:
+		return idp_pb.IDPType_IDP_TYPE_OKTA
+	case domain.IDPTypeAuth0:
+		return idp_pb.IDPType_IDP_TYPE_AUTH0
+	case domain.IDPTypeKeycloak:
+		return idp_pb.IDPType_IDP_TYPE_KEYCLOAK
+	case domain.IDPTypeEntraExternalID:
+		return idp_pb.IDPType_IDP_TYPE_ENTRA_EXTERNAL_ID
 	case domain.IDPTypeUnspecified:
 		return idp_pb.IDPType_IDP_TYPE_UNSPECIFIED
 	default:
@@ -94,11 +102,12 @@ func idpTypeToPb(idpType domain.IDPType) idp_pb.IDPType {
 func configToPb(config *query.IDPTemplate) *idp_pb.IDPConfig {
 	idpConfig := &idp_pb.IDPConfig{
 		Options: &idp_pb.Options{
-			IsLinkingAllowed:  config.IsLinkingAllowed,
-			IsCreationAllowed: config.IsCreationAllowed,
-			IsAutoCreation:    config.IsAutoCreation,
-			IsAutoUpdate:      config.IsAutoUpdate,
-			AutoLinking:       AutoLinkingOptionToPb(config.AutoLinking),
+			IsLinkingAllowed:         config.IsLinkingAllowed,
+			IsCreationAllowed:        config.IsCreationAllowed,
+			IsAutoCreation:           config.IsAutoCreation,
+			IsAutoUpdate:             config.IsAutoUpdate,
+			AutoLinking:              AutoLinkingOptionToPb(config.AutoLinking),
+			IsFederatedLogoutEnabled: config.IsFederatedLogoutEnabled,
 		},
 	}
 	if config.OAuthIDPTemplate != nil {
@@ -149,6 +158,22 @@ func configToPb(config *query.IDPTemplate) *idp_pb.IDPConfig {
 		samlConfigToPb(idpConfig, config.SAMLIDPTemplate)
 		return idpConfig
 	}
+	if config.OktaIDPTemplate != nil {
+		oktaConfigToPb(idpConfig, config.OktaIDPTemplate)
+		return idpConfig
+	}
+	if config.Auth0IDPTemplate != nil {
+		auth0ConfigToPb(idpConfig, config.Auth0IDPTemplate)
+		return idpConfig
+	}
+	if config.KeycloakIDPTemplate != nil {
+		keycloakConfigToPb(idpConfig, config.KeycloakIDPTemplate)
+		return idpConfig
+	}
+	if config.EntraExternalIDIDPTemplate != nil {
+		entraExternalIDConfigToPb(idpConfig, config.EntraExternalIDIDPTemplate)
+		return idpConfig
+	}
 	return idpConfig
 }
 
@@ -342,6 +367,49 @@ func samlConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.SAMLIDPTemplate
 	}
 }
 
+func oktaConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.OktaIDPTemplate) {
+	idpConfig.Config = &idp_pb.IDPConfig_Okta{
+		Okta: &idp_pb.OktaConfig{
+			Domain:                template.Domain,
+			AuthorizationServerId: template.AuthorizationServerID,
+			ClientId:              template.ClientID,
+			Scopes:                template.Scopes,
+		},
+	}
+}
+
+func auth0ConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.Auth0IDPTemplate) {
+	idpConfig.Config = &idp_pb.IDPConfig_Auth0{
+		Auth0: &idp_pb.Auth0Config{
+			Domain:   template.Domain,
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func keycloakConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.KeycloakIDPTemplate) {
+	idpConfig.Config = &idp_pb.IDPConfig_Keycloak{
+		Keycloak: &idp_pb.KeycloakConfig{
+			BaseUrl:  template.BaseURL,
+			Realm:    template.Realm,
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
+func entraExternalIDConfigToPb(idpConfig *idp_pb.IDPConfig, template *query.EntraExternalIDIDPTemplate) {
+	idpConfig.Config = &idp_pb.IDPConfig_EntraExternalId{
+		EntraExternalId: &idp_pb.EntraExternalIDConfig{
+			TenantId: template.TenantID,
+			Domain:   template.Domain,
+			ClientId: template.ClientID,
+			Scopes:   template.Scopes,
+		},
+	}
+}
+
 func bindingToPb(binding string) idp_pb.SAMLBinding {
 	switch binding {
 	case "":
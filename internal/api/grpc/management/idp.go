@@ -449,6 +449,90 @@ func (s *Server) RegenerateSAMLProviderCertificate(ctx context.Context, req *mgm
 	}, nil
 }
 
+func (s *Server) AddOktaProvider(ctx context.Context, req *mgmt_pb.AddOktaProviderRequest) (*mgmt_pb.AddOktaProviderResponse, error) {
+	id, details, err := s.command.AddOrgOktaProvider(ctx, authz.GetCtxData(ctx).OrgID, addOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOktaProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateOktaProvider(ctx context.Context, req *mgmt_pb.UpdateOktaProviderRequest) (*mgmt_pb.UpdateOktaProviderResponse, error) {
+	details, err := s.command.UpdateOrgOktaProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateOktaProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateOktaProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddAuth0Provider(ctx context.Context, req *mgmt_pb.AddAuth0ProviderRequest) (*mgmt_pb.AddAuth0ProviderResponse, error) {
+	id, details, err := s.command.AddOrgAuth0Provider(ctx, authz.GetCtxData(ctx).OrgID, addAuth0ProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddAuth0ProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateAuth0Provider(ctx context.Context, req *mgmt_pb.UpdateAuth0ProviderRequest) (*mgmt_pb.UpdateAuth0ProviderResponse, error) {
+	details, err := s.command.UpdateOrgAuth0Provider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateAuth0ProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateAuth0ProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddKeycloakProvider(ctx context.Context, req *mgmt_pb.AddKeycloakProviderRequest) (*mgmt_pb.AddKeycloakProviderResponse, error) {
+	id, details, err := s.command.AddOrgKeycloakProvider(ctx, authz.GetCtxData(ctx).OrgID, addKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddKeycloakProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateKeycloakProvider(ctx context.Context, req *mgmt_pb.UpdateKeycloakProviderRequest) (*mgmt_pb.UpdateKeycloakProviderResponse, error) {
+	details, err := s.command.UpdateOrgKeycloakProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateKeycloakProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateKeycloakProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) AddEntraExternalIDProvider(ctx context.Context, req *mgmt_pb.AddEntraExternalIDProviderRequest) (*mgmt_pb.AddEntraExternalIDProviderResponse, error) {
+	id, details, err := s.command.AddOrgEntraExternalIDProvider(ctx, authz.GetCtxData(ctx).OrgID, addEntraExternalIDProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddEntraExternalIDProviderResponse{
+		Id:      id,
+		Details: object_pb.DomainToAddDetailsPb(details),
+	}, nil
+}
+
+func (s *Server) UpdateEntraExternalIDProvider(ctx context.Context, req *mgmt_pb.UpdateEntraExternalIDProviderRequest) (*mgmt_pb.UpdateEntraExternalIDProviderResponse, error) {
+	details, err := s.command.UpdateOrgEntraExternalIDProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id, updateEntraExternalIDProviderToCommand(req))
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.UpdateEntraExternalIDProviderResponse{
+		Details: object_pb.DomainToChangeDetailsPb(details),
+	}, nil
+}
+
 func (s *Server) DeleteProvider(ctx context.Context, req *mgmt_pb.DeleteProviderRequest) (*mgmt_pb.DeleteProviderResponse, error) {
 	details, err := s.command.DeleteOrgProvider(ctx, authz.GetCtxData(ctx).OrgID, req.Id)
 	if err != nil {
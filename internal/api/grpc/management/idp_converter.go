@@ -210,6 +210,7 @@ func addGenericOAuthProviderToCommand(req *mgmt_pb.AddGenericOAuthProviderReques
 		IDAttribute:           req.IdAttribute,
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 		UsePKCE:               req.UsePkce,
+		ClaimMapping:          idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 	}
 }
 
@@ -225,6 +226,7 @@ func updateGenericOAuthProviderToCommand(req *mgmt_pb.UpdateGenericOAuthProvider
 		IDAttribute:           req.IdAttribute,
 		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
 		UsePKCE:               req.UsePkce,
+		ClaimMapping:          idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 	}
 }
 
@@ -237,6 +239,7 @@ func addGenericOIDCProviderToCommand(req *mgmt_pb.AddGenericOIDCProviderRequest)
 		Scopes:           req.Scopes,
 		IsIDTokenMapping: req.IsIdTokenMapping,
 		UsePKCE:          req.UsePkce,
+		ClaimMapping:     idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 		IDPOptions:       idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -250,6 +253,7 @@ func updateGenericOIDCProviderToCommand(req *mgmt_pb.UpdateGenericOIDCProviderRe
 		Scopes:           req.Scopes,
 		IsIDTokenMapping: req.IsIdTokenMapping,
 		UsePKCE:          req.UsePkce,
+		ClaimMapping:     idp_grpc.ClaimMappingToCommand(req.ClaimMapping),
 		IDPOptions:       idp_grpc.OptionsToCommand(req.ProviderOptions),
 	}
 }
@@ -504,6 +508,100 @@ func updateSAMLProviderToCommand(req *mgmt_pb.UpdateSAMLProviderRequest) *comman
 	}
 }
 
+func addOktaProviderToCommand(req *mgmt_pb.AddOktaProviderRequest) command.OktaProvider {
+	return command.OktaProvider{
+		Name:                  req.Name,
+		Domain:                req.Domain,
+		AuthorizationServerID: req.AuthorizationServerId,
+		ClientID:              req.ClientId,
+		ClientSecret:          req.ClientSecret,
+		Scopes:                req.Scopes,
+		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateOktaProviderToCommand(req *mgmt_pb.UpdateOktaProviderRequest) command.OktaProvider {
+	return command.OktaProvider{
+		Name:                  req.Name,
+		Domain:                req.Domain,
+		AuthorizationServerID: req.AuthorizationServerId,
+		ClientID:              req.ClientId,
+		ClientSecret:          req.ClientSecret,
+		Scopes:                req.Scopes,
+		IDPOptions:            idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addAuth0ProviderToCommand(req *mgmt_pb.AddAuth0ProviderRequest) command.Auth0Provider {
+	return command.Auth0Provider{
+		Name:         req.Name,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateAuth0ProviderToCommand(req *mgmt_pb.UpdateAuth0ProviderRequest) command.Auth0Provider {
+	return command.Auth0Provider{
+		Name:         req.Name,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addKeycloakProviderToCommand(req *mgmt_pb.AddKeycloakProviderRequest) command.KeycloakProvider {
+	return command.KeycloakProvider{
+		Name:         req.Name,
+		BaseURL:      req.BaseUrl,
+		Realm:        req.Realm,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateKeycloakProviderToCommand(req *mgmt_pb.UpdateKeycloakProviderRequest) command.KeycloakProvider {
+	return command.KeycloakProvider{
+		Name:         req.Name,
+		BaseURL:      req.BaseUrl,
+		Realm:        req.Realm,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func addEntraExternalIDProviderToCommand(req *mgmt_pb.AddEntraExternalIDProviderRequest) command.EntraExternalIDProvider {
+	return command.EntraExternalIDProvider{
+		Name:         req.Name,
+		TenantID:     req.TenantId,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
+func updateEntraExternalIDProviderToCommand(req *mgmt_pb.UpdateEntraExternalIDProviderRequest) command.EntraExternalIDProvider {
+	return command.EntraExternalIDProvider{
+		Name:         req.Name,
+		TenantID:     req.TenantId,
+		Domain:       req.Domain,
+		ClientID:     req.ClientId,
+		ClientSecret: req.ClientSecret,
+		Scopes:       req.Scopes,
+		IDPOptions:   idp_grpc.OptionsToCommand(req.ProviderOptions),
+	}
+}
+
 func bindingToCommand(binding idp_pb.SAMLBinding) string {
 	switch binding {
 	case idp_pb.SAMLBinding_SAML_BINDING_UNSPECIFIED:
@@ -387,3 +387,26 @@ func (s *Server) BulkRemoveOrgMetadata(ctx context.Context, req *mgmt_pb.BulkRem
 		Details: obj_grpc.DomainToChangeDetailsPb(result),
 	}, nil
 }
+
+func (s *Server) AddOrgInitialAccessToken(ctx context.Context, req *mgmt_pb.AddOrgInitialAccessTokenRequest) (*mgmt_pb.AddOrgInitialAccessTokenResponse, error) {
+	token := AddOrgInitialAccessTokenRequestToCommand(req, authz.GetCtxData(ctx).OrgID)
+	details, err := s.command.AddInitialAccessToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.AddOrgInitialAccessTokenResponse{
+		Id:      token.TokenID,
+		Details: obj_grpc.DomainToAddDetailsPb(details),
+		Token:   token.Token,
+	}, nil
+}
+
+func (s *Server) RemoveOrgInitialAccessToken(ctx context.Context, req *mgmt_pb.RemoveOrgInitialAccessTokenRequest) (*mgmt_pb.RemoveOrgInitialAccessTokenResponse, error) {
+	details, err := s.command.RemoveInitialAccessToken(ctx, authz.GetCtxData(ctx).OrgID, req.TokenId)
+	if err != nil {
+		return nil, err
+	}
+	return &mgmt_pb.RemoveOrgInitialAccessTokenResponse{
+		Details: obj_grpc.DomainToChangeDetailsPb(details),
+	}, nil
+}
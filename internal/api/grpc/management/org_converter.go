@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/grpc/metadata"
 	"github.com/zitadel/zitadel/internal/api/grpc/object"
 	org_grpc "github.com/zitadel/zitadel/internal/api/grpc/org"
+	app_grpc "github.com/zitadel/zitadel/internal/api/grpc/project"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
@@ -136,3 +137,18 @@ func ListOrgMetadataToDomain(req *mgmt_pb.ListOrgMetadataRequest) (*query.OrgMet
 		Queries: queries,
 	}, nil
 }
+
+func AddOrgInitialAccessTokenRequestToCommand(req *mgmt_pb.AddOrgInitialAccessTokenRequest, orgID string) *command.InitialAccessToken {
+	return &command.InitialAccessToken{
+		OrgID:          orgID,
+		ExpirationDate: req.ExpirationDate.AsTime(),
+		Policy: domain.ClientRegistrationPolicy{
+			AllowedGrantTypes:               app_grpc.OIDCGrantTypesToDomain(req.AllowedGrantTypes),
+			RedirectURIPatterns:             req.RedirectUriPatterns,
+			RegistrationAccessTokenLifetime: req.RegistrationAccessTokenLifetime.AsDuration(),
+			MaxAccessTokenLifetime:          req.MaxAccessTokenLifetime.AsDuration(),
+			MaxIDTokenLifetime:              req.MaxIdTokenLifetime.AsDuration(),
+			MaxRefreshTokenLifetime:         req.MaxRefreshTokenLifetime.AsDuration(),
+		},
+	}
+}
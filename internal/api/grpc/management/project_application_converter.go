@@ -47,25 +47,30 @@ func AddOIDCAppRequestToDomain(req *mgmt_pb.AddOIDCAppRequest) (*domain.OIDCApp,
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: req.ProjectId,
 		},
-		AppName:                  req.Name,
-		OIDCVersion:              gu.Ptr(app_grpc.OIDCVersionToDomain(req.Version)),
-		RedirectUris:             req.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
-		ApplicationType:          gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(req.AppType)),
-		AuthMethodType:           gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType)),
-		PostLogoutRedirectUris:   req.PostLogoutRedirectUris,
-		DevMode:                  gu.Ptr(req.GetDevMode()),
-		AccessTokenType:          gu.Ptr(app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType)),
-		AccessTokenRoleAssertion: gu.Ptr(req.GetAccessTokenRoleAssertion()),
-		IDTokenRoleAssertion:     gu.Ptr(req.GetIdTokenRoleAssertion()),
-		IDTokenUserinfoAssertion: gu.Ptr(req.GetIdTokenUserinfoAssertion()),
-		ClockSkew:                gu.Ptr(req.GetClockSkew().AsDuration()),
-		AdditionalOrigins:        req.AdditionalOrigins,
-		SkipNativeAppSuccessPage: gu.Ptr(req.GetSkipNativeAppSuccessPage()),
-		BackChannelLogoutURI:     gu.Ptr(req.GetBackChannelLogoutUri()),
-		LoginVersion:             gu.Ptr(loginVersion),
-		LoginBaseURI:             gu.Ptr(loginBaseURI),
+		AppName:                               req.Name,
+		OIDCVersion:                           gu.Ptr(app_grpc.OIDCVersionToDomain(req.Version)),
+		RedirectUris:                          req.RedirectUris,
+		ResponseTypes:                         app_grpc.OIDCResponseTypesToDomain(req.ResponseTypes),
+		GrantTypes:                            app_grpc.OIDCGrantTypesToDomain(req.GrantTypes),
+		ApplicationType:                       gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(req.AppType)),
+		AuthMethodType:                        gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(req.AuthMethodType)),
+		PostLogoutRedirectUris:                req.PostLogoutRedirectUris,
+		DevMode:                               gu.Ptr(req.GetDevMode()),
+		AccessTokenType:                       gu.Ptr(app_grpc.OIDCTokenTypeToDomain(req.AccessTokenType)),
+		AccessTokenRoleAssertion:              gu.Ptr(req.GetAccessTokenRoleAssertion()),
+		IDTokenRoleAssertion:                  gu.Ptr(req.GetIdTokenRoleAssertion()),
+		IDTokenUserinfoAssertion:              gu.Ptr(req.GetIdTokenUserinfoAssertion()),
+		ClockSkew:                             gu.Ptr(req.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                     req.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              gu.Ptr(req.GetSkipNativeAppSuccessPage()),
+		BackChannelLogoutURI:                  gu.Ptr(req.GetBackChannelLogoutUri()),
+		LoginVersion:                          gu.Ptr(loginVersion),
+		LoginBaseURI:                          gu.Ptr(loginBaseURI),
+		RequirePushedAuthorizationRequests:    gu.Ptr(req.GetRequirePushedAuthorizationRequests()),
+		RequireDPoP:                           gu.Ptr(req.GetRequireDpop()),
+		TLSClientAuthSubjectDN:                gu.Ptr(req.GetTlsClientAuthSubjectDn()),
+		TLSClientAuthPublicKeyPin:             gu.Ptr(req.GetTlsClientAuthPublicKeyPin()),
+		BackChannelClientNotificationEndpoint: gu.Ptr(req.GetBackChannelClientNotificationEndpoint()),
 	}, nil
 }
 
@@ -83,6 +88,12 @@ func AddSAMLAppRequestToDomain(req *mgmt_pb.AddSAMLAppRequest) (*domain.SAMLApp,
 		MetadataURL:  gu.Ptr(req.GetMetadataUrl()),
 		LoginVersion: gu.Ptr(loginVersion),
 		LoginBaseURI: gu.Ptr(loginBaseURI),
+
+		SignatureAlgorithm:  gu.Ptr(app_grpc.SAMLSignatureAlgorithmToDomain(req.GetSignatureAlgorithm())),
+		DigestAlgorithm:     gu.Ptr(app_grpc.SAMLDigestAlgorithmToDomain(req.GetDigestAlgorithm())),
+		AssertionEncryption: gu.Ptr(app_grpc.SAMLAssertionEncryptionToDomain(req.GetAssertionEncryption())),
+		NameIDFormat:        app_grpc.SAMLNameIDFormatToDomain(req.NameIdFormat),
+		AttributeMapping:    app_grpc.SAMLAttributeMappingToDomain(req.GetAttributeMapping()),
 	}, nil
 }
 
@@ -91,8 +102,10 @@ func AddAPIAppRequestToDomain(app *mgmt_pb.AddAPIAppRequest) *domain.APIApp {
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppName:        app.Name,
-		AuthMethodType: app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		AppName:                   app.Name,
+		AuthMethodType:            app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		TLSClientAuthSubjectDN:    app.GetTlsClientAuthSubjectDn(),
+		TLSClientAuthPublicKeyPin: app.GetTlsClientAuthPublicKeyPin(),
 	}
 }
 
@@ -112,24 +125,29 @@ func UpdateOIDCAppConfigRequestToDomain(app *mgmt_pb.UpdateOIDCAppConfigRequest)
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:                    app.AppId,
-		RedirectUris:             app.RedirectUris,
-		ResponseTypes:            app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
-		GrantTypes:               app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
-		ApplicationType:          gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(app.AppType)),
-		AuthMethodType:           gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType)),
-		PostLogoutRedirectUris:   app.PostLogoutRedirectUris,
-		DevMode:                  gu.Ptr(app.GetDevMode()),
-		AccessTokenType:          gu.Ptr(app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType)),
-		AccessTokenRoleAssertion: gu.Ptr(app.GetAccessTokenRoleAssertion()),
-		IDTokenRoleAssertion:     gu.Ptr(app.GetIdTokenRoleAssertion()),
-		IDTokenUserinfoAssertion: gu.Ptr(app.GetIdTokenUserinfoAssertion()),
-		ClockSkew:                gu.Ptr(app.GetClockSkew().AsDuration()),
-		AdditionalOrigins:        app.AdditionalOrigins,
-		SkipNativeAppSuccessPage: gu.Ptr(app.GetSkipNativeAppSuccessPage()),
-		BackChannelLogoutURI:     gu.Ptr(app.GetBackChannelLogoutUri()),
-		LoginVersion:             gu.Ptr(loginVersion),
-		LoginBaseURI:             gu.Ptr(loginBaseURI),
+		AppID:                                 app.AppId,
+		RedirectUris:                          app.RedirectUris,
+		ResponseTypes:                         app_grpc.OIDCResponseTypesToDomain(app.ResponseTypes),
+		GrantTypes:                            app_grpc.OIDCGrantTypesToDomain(app.GrantTypes),
+		ApplicationType:                       gu.Ptr(app_grpc.OIDCApplicationTypeToDomain(app.AppType)),
+		AuthMethodType:                        gu.Ptr(app_grpc.OIDCAuthMethodTypeToDomain(app.AuthMethodType)),
+		PostLogoutRedirectUris:                app.PostLogoutRedirectUris,
+		DevMode:                               gu.Ptr(app.GetDevMode()),
+		AccessTokenType:                       gu.Ptr(app_grpc.OIDCTokenTypeToDomain(app.AccessTokenType)),
+		AccessTokenRoleAssertion:              gu.Ptr(app.GetAccessTokenRoleAssertion()),
+		IDTokenRoleAssertion:                  gu.Ptr(app.GetIdTokenRoleAssertion()),
+		IDTokenUserinfoAssertion:              gu.Ptr(app.GetIdTokenUserinfoAssertion()),
+		ClockSkew:                             gu.Ptr(app.GetClockSkew().AsDuration()),
+		AdditionalOrigins:                     app.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              gu.Ptr(app.GetSkipNativeAppSuccessPage()),
+		BackChannelLogoutURI:                  gu.Ptr(app.GetBackChannelLogoutUri()),
+		LoginVersion:                          gu.Ptr(loginVersion),
+		LoginBaseURI:                          gu.Ptr(loginBaseURI),
+		RequirePushedAuthorizationRequests:    gu.Ptr(app.GetRequirePushedAuthorizationRequests()),
+		RequireDPoP:                           gu.Ptr(app.GetRequireDpop()),
+		TLSClientAuthSubjectDN:                gu.Ptr(app.GetTlsClientAuthSubjectDn()),
+		TLSClientAuthPublicKeyPin:             gu.Ptr(app.GetTlsClientAuthPublicKeyPin()),
+		BackChannelClientNotificationEndpoint: gu.Ptr(app.GetBackChannelClientNotificationEndpoint()),
 	}, nil
 }
 
@@ -147,6 +165,12 @@ func UpdateSAMLAppConfigRequestToDomain(app *mgmt_pb.UpdateSAMLAppConfigRequest)
 		MetadataURL:  gu.Ptr(app.GetMetadataUrl()),
 		LoginVersion: gu.Ptr(loginVersion),
 		LoginBaseURI: gu.Ptr(loginBaseURI),
+
+		SignatureAlgorithm:  gu.Ptr(app_grpc.SAMLSignatureAlgorithmToDomain(app.GetSignatureAlgorithm())),
+		DigestAlgorithm:     gu.Ptr(app_grpc.SAMLDigestAlgorithmToDomain(app.GetDigestAlgorithm())),
+		AssertionEncryption: gu.Ptr(app_grpc.SAMLAssertionEncryptionToDomain(app.GetAssertionEncryption())),
+		NameIDFormat:        app_grpc.SAMLNameIDFormatToDomain(app.NameIdFormat),
+		AttributeMapping:    app_grpc.SAMLAttributeMappingToDomain(app.GetAttributeMapping()),
 	}, nil
 }
 
@@ -155,8 +179,10 @@ func UpdateAPIAppConfigRequestToDomain(app *mgmt_pb.UpdateAPIAppConfigRequest) *
 		ObjectRoot: models.ObjectRoot{
 			AggregateID: app.ProjectId,
 		},
-		AppID:          app.AppId,
-		AuthMethodType: app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		AppID:                     app.AppId,
+		AuthMethodType:            app_grpc.APIAuthMethodTypeToDomain(app.AuthMethodType),
+		TLSClientAuthSubjectDN:    app.GetTlsClientAuthSubjectDn(),
+		TLSClientAuthPublicKeyPin: app.GetTlsClientAuthPublicKeyPin(),
 	}
 }
 
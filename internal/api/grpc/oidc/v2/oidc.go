@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/logging"
 	"github.com/zitadel/oidc/v3/pkg/op"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
@@ -25,8 +26,12 @@ func (s *Server) GetAuthRequest(ctx context.Context, req *connect.Request[oidc_p
 		logging.WithError(err).Error("query authRequest by ID")
 		return nil, err
 	}
+	authRequestPb, err := authRequestToPb(authRequest)
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&oidc_pb.GetAuthRequestResponse{
-		AuthRequest: authRequestToPb(authRequest),
+		AuthRequest: authRequestPb,
 	}), nil
 }
 
@@ -78,7 +83,79 @@ func (s *Server) AuthorizeOrDenyDeviceAuthorization(ctx context.Context, req *co
 	return connect.NewResponse(&oidc_pb.AuthorizeOrDenyDeviceAuthorizationResponse{}), nil
 }
 
-func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
+func (s *Server) ListBackChannelAuthenticationRequests(ctx context.Context, req *connect.Request[oidc_pb.ListBackChannelAuthenticationRequestsRequest]) (*connect.Response[oidc_pb.ListBackChannelAuthenticationRequestsResponse], error) {
+	authReqs, err := s.query.BackChannelAuthRequestsByUserID(ctx, req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	pbReqs := make([]*oidc_pb.BackChannelAuthenticationRequest, len(authReqs))
+	for i, authReq := range authReqs {
+		pbReqs[i], err = s.backChannelAuthRequestToPb(authReq)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&oidc_pb.ListBackChannelAuthenticationRequestsResponse{
+		BackchannelAuthenticationRequests: pbReqs,
+	}), nil
+}
+
+func (s *Server) GetBackChannelAuthenticationRequest(ctx context.Context, req *connect.Request[oidc_pb.GetBackChannelAuthenticationRequestRequest]) (*connect.Response[oidc_pb.GetBackChannelAuthenticationRequestResponse], error) {
+	authReqID, err := s.idFromEncrypted(req.Msg.GetBackchannelAuthenticationId())
+	if err != nil {
+		return nil, err
+	}
+	authReq, err := s.query.BackChannelAuthRequestByID(ctx, authReqID)
+	if err != nil {
+		return nil, err
+	}
+	pbReq, err := s.backChannelAuthRequestToPb(authReq)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&oidc_pb.GetBackChannelAuthenticationRequestResponse{
+		BackchannelAuthenticationRequest: pbReq,
+	}), nil
+}
+
+func (s *Server) AuthorizeOrDenyBackChannelAuthentication(ctx context.Context, req *connect.Request[oidc_pb.AuthorizeOrDenyBackChannelAuthenticationRequest]) (*connect.Response[oidc_pb.AuthorizeOrDenyBackChannelAuthenticationResponse], error) {
+	authReqID, err := s.idFromEncrypted(req.Msg.GetBackchannelAuthenticationId())
+	if err != nil {
+		return nil, err
+	}
+	switch req.Msg.GetDecision().(type) {
+	case *oidc_pb.AuthorizeOrDenyBackChannelAuthenticationRequest_Session:
+		_, err = s.command.ApproveBackChannelAuthWithSession(ctx, authReqID, req.Msg.GetSession().GetSessionId(), req.Msg.GetSession().GetSessionToken())
+	case *oidc_pb.AuthorizeOrDenyBackChannelAuthenticationRequest_Deny:
+		_, err = s.command.CancelBackChannelAuth(ctx, authReqID, domain.BackChannelAuthCanceledDenied)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&oidc_pb.AuthorizeOrDenyBackChannelAuthenticationResponse{}), nil
+}
+
+// backChannelAuthRequestToPb converts the request and encrypts the auth_req_id,
+// since it must only be known to the client, which is able to redeem it on the token endpoint.
+func (s *Server) backChannelAuthRequestToPb(authReq *domain.BackChannelAuthRequest) (*oidc_pb.BackChannelAuthenticationRequest, error) {
+	encrypted, err := s.encryption.Encrypt([]byte(authReq.AuthReqID))
+	if err != nil {
+		return nil, err
+	}
+	return &oidc_pb.BackChannelAuthenticationRequest{
+		Id:             base64.RawURLEncoding.EncodeToString(encrypted),
+		ClientId:       authReq.ClientID,
+		Scope:          authReq.Scopes,
+		AppName:        authReq.AppName,
+		ProjectName:    authReq.ProjectName,
+		UserId:         authReq.UserID,
+		BindingMessage: authReq.BindingMessage,
+		CreationDate:   timestamppb.New(authReq.CreationDate),
+		ExpirationDate: timestamppb.New(authReq.Expires),
+	}, nil
+}
+
+func authRequestToPb(a *query.AuthRequest) (_ *oidc_pb.AuthRequest, err error) {
 	pba := &oidc_pb.AuthRequest{
 		Id:           a.ID,
 		CreationDate: timestamppb.New(a.CreationDate),
@@ -93,7 +170,26 @@ func authRequestToPb(a *query.AuthRequest) *oidc_pb.AuthRequest {
 	if a.MaxAge != nil {
 		pba.MaxAge = durationpb.New(*a.MaxAge)
 	}
-	return pba
+	pba.AuthorizationDetails, err = authorizationDetailsToPb(a.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+	return pba, nil
+}
+
+func authorizationDetailsToPb(details domain.AuthorizationDetails) ([]*structpb.Struct, error) {
+	if len(details) == 0 {
+		return nil, nil
+	}
+	out := make([]*structpb.Struct, len(details))
+	for i, detail := range details {
+		detailPb, err := structpb.NewStruct(detail)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "OIDCv2-Oa5ie", "Errors.Internal")
+		}
+		out[i] = detailPb
+	}
+	return out, nil
 }
 
 func promptsToPb(promps []domain.Prompt) []oidc_pb.Prompt {
@@ -260,7 +356,11 @@ func errorReasonToOIDC(reason oidc_pb.ErrorReason) string {
 }
 
 func (s *Server) deviceCodeFromID(deviceAuthID string) (string, error) {
-	decoded, err := base64.RawURLEncoding.DecodeString(deviceAuthID)
+	return s.idFromEncrypted(deviceAuthID)
+}
+
+func (s *Server) idFromEncrypted(encryptedID string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(encryptedID)
 	if err != nil {
 		return "", err
 	}
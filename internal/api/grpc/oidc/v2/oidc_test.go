@@ -6,8 +6,10 @@ import (
 
 	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/zitadel/zitadel/internal/domain"
@@ -36,6 +38,9 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     gu.Ptr(time.Minute),
 		HintUserID: gu.Ptr("userID"),
+		AuthorizationDetails: domain.AuthorizationDetails{
+			{"type": "payment_initiation", "actions": []any{"initiate"}},
+		},
 	}
 	want := &oidc_pb.AuthRequest{
 		Id:           "authID",
@@ -56,8 +61,17 @@ func Test_authRequestToPb(t *testing.T) {
 		LoginHint:  gu.Ptr("foo@bar.com"),
 		MaxAge:     durationpb.New(time.Minute),
 		HintUserId: gu.Ptr("userID"),
+		AuthorizationDetails: []*structpb.Struct{
+			{
+				Fields: map[string]*structpb.Value{
+					"type":    structpb.NewStringValue("payment_initiation"),
+					"actions": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewStringValue("initiate")}}),
+				},
+			},
+		},
 	}
-	got := authRequestToPb(arg)
+	got, err := authRequestToPb(arg)
+	require.NoError(t, err)
 	if !proto.Equal(want, got) {
 		t.Errorf("authRequestToPb() =\n%v\nwant\n%v\n", got, want)
 	}
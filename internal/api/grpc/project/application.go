@@ -3,6 +3,7 @@ package project
 import (
 	"net/url"
 
+	"github.com/muhlemmer/gu"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	object_grpc "github.com/zitadel/zitadel/internal/api/grpc/object"
@@ -44,27 +45,32 @@ func AppConfigToPb(app *query.App) app_pb.AppConfig {
 func AppOIDCConfigToPb(app *query.OIDCApp) *app_pb.App_OidcConfig {
 	return &app_pb.App_OidcConfig{
 		OidcConfig: &app_pb.OIDCConfig{
-			RedirectUris:             app.RedirectURIs,
-			ResponseTypes:            OIDCResponseTypesFromModel(app.ResponseTypes),
-			GrantTypes:               OIDCGrantTypesFromModel(app.GrantTypes),
-			AppType:                  OIDCApplicationTypeToPb(app.AppType),
-			ClientId:                 app.ClientID,
-			AuthMethodType:           OIDCAuthMethodTypeToPb(app.AuthMethodType),
-			PostLogoutRedirectUris:   app.PostLogoutRedirectURIs,
-			Version:                  OIDCVersionToPb(domain.OIDCVersion(app.Version)),
-			NoneCompliant:            len(app.ComplianceProblems) != 0,
-			ComplianceProblems:       ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
-			DevMode:                  app.IsDevMode,
-			AccessTokenType:          oidcTokenTypeToPb(app.AccessTokenType),
-			AccessTokenRoleAssertion: app.AssertAccessTokenRole,
-			IdTokenRoleAssertion:     app.AssertIDTokenRole,
-			IdTokenUserinfoAssertion: app.AssertIDTokenUserinfo,
-			ClockSkew:                durationpb.New(app.ClockSkew),
-			AdditionalOrigins:        app.AdditionalOrigins,
-			AllowedOrigins:           app.AllowedOrigins,
-			SkipNativeAppSuccessPage: app.SkipNativeAppSuccessPage,
-			BackChannelLogoutUri:     app.BackChannelLogoutURI,
-			LoginVersion:             loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			RedirectUris:                          app.RedirectURIs,
+			ResponseTypes:                         OIDCResponseTypesFromModel(app.ResponseTypes),
+			GrantTypes:                            OIDCGrantTypesFromModel(app.GrantTypes),
+			AppType:                               OIDCApplicationTypeToPb(app.AppType),
+			ClientId:                              app.ClientID,
+			AuthMethodType:                        OIDCAuthMethodTypeToPb(app.AuthMethodType),
+			PostLogoutRedirectUris:                app.PostLogoutRedirectURIs,
+			Version:                               OIDCVersionToPb(domain.OIDCVersion(app.Version)),
+			NoneCompliant:                         len(app.ComplianceProblems) != 0,
+			ComplianceProblems:                    ComplianceProblemsToLocalizedMessages(app.ComplianceProblems),
+			DevMode:                               app.IsDevMode,
+			AccessTokenType:                       oidcTokenTypeToPb(app.AccessTokenType),
+			AccessTokenRoleAssertion:              app.AssertAccessTokenRole,
+			IdTokenRoleAssertion:                  app.AssertIDTokenRole,
+			IdTokenUserinfoAssertion:              app.AssertIDTokenUserinfo,
+			ClockSkew:                             durationpb.New(app.ClockSkew),
+			AdditionalOrigins:                     app.AdditionalOrigins,
+			AllowedOrigins:                        app.AllowedOrigins,
+			SkipNativeAppSuccessPage:              app.SkipNativeAppSuccessPage,
+			BackChannelLogoutUri:                  app.BackChannelLogoutURI,
+			LoginVersion:                          loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+			RequirePushedAuthorizationRequests:    app.RequirePushedAuthorizationRequests,
+			RequireDpop:                           app.RequireDPoP,
+			TlsClientAuthSubjectDn:                app.TLSClientAuthSubjectDN,
+			TlsClientAuthPublicKeyPin:             app.TLSClientAuthPublicKeyPin,
+			BackChannelClientNotificationEndpoint: app.BackChannelClientNotificationEndpoint,
 		},
 	}
 }
@@ -87,6 +93,12 @@ func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 		SamlConfig: &app_pb.SAMLConfig{
 			Metadata:     &app_pb.SAMLConfig_MetadataXml{MetadataXml: app.Metadata},
 			LoginVersion: loginVersionToPb(app.LoginVersion, app.LoginBaseURI),
+
+			SignatureAlgorithm:  SAMLSignatureAlgorithmToPb(app.SignatureAlgorithm),
+			DigestAlgorithm:     SAMLDigestAlgorithmToPb(app.DigestAlgorithm),
+			AssertionEncryption: SAMLAssertionEncryptionToPb(app.AssertionEncryption),
+			NameIdFormat:        SAMLNameIDFormatToPb(app.NameIDFormat),
+			AttributeMapping:    SAMLAttributeMappingToPb(app.AttributeMapping),
 		},
 	}
 }
@@ -94,8 +106,10 @@ func AppSAMLConfigToPb(app *query.SAMLApp) app_pb.AppConfig {
 func AppAPIConfigToPb(app *query.APIApp) app_pb.AppConfig {
 	return &app_pb.App_ApiConfig{
 		ApiConfig: &app_pb.APIConfig{
-			ClientId:       app.ClientID,
-			AuthMethodType: APIAuthMethodeTypeToPb(app.AuthMethodType),
+			ClientId:                  app.ClientID,
+			AuthMethodType:            APIAuthMethodeTypeToPb(app.AuthMethodType),
+			TlsClientAuthSubjectDn:    app.TLSClientAuthSubjectDN,
+			TlsClientAuthPublicKeyPin: app.TLSClientAuthPublicKeyPin,
 		},
 	}
 }
@@ -158,6 +172,8 @@ func OIDCGrantTypesFromModel(grantTypes []domain.OIDCGrantType) []app_pb.OIDCGra
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_DEVICE_CODE
 		case domain.OIDCGrantTypeTokenExchange:
 			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE
+		case domain.OIDCGrantTypeCIBA:
+			oidcGrantTypes[i] = app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA
 		}
 	}
 	return oidcGrantTypes
@@ -180,6 +196,8 @@ func OIDCGrantTypesToDomain(grantTypes []app_pb.OIDCGrantType) []domain.OIDCGran
 			oidcGrantTypes[i] = domain.OIDCGrantTypeDeviceCode
 		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_TOKEN_EXCHANGE:
 			oidcGrantTypes[i] = domain.OIDCGrantTypeTokenExchange
+		case app_pb.OIDCGrantType_OIDC_GRANT_TYPE_CIBA:
+			oidcGrantTypes[i] = domain.OIDCGrantTypeCIBA
 		}
 	}
 	return oidcGrantTypes
@@ -220,6 +238,10 @@ func OIDCAuthMethodTypeToPb(authType domain.OIDCAuthMethodType) app_pb.OIDCAuthM
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_NONE
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_BASIC
 	}
@@ -235,6 +257,10 @@ func OIDCAuthMethodTypeToDomain(authType app_pb.OIDCAuthMethodType) domain.OIDCA
 		return domain.OIDCAuthMethodTypeNone
 	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.OIDCAuthMethodTypePrivateKeyJWT
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeTLSClientAuth
+	case app_pb.OIDCAuthMethodType_OIDC_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.OIDCAuthMethodTypeBasic
 	}
@@ -293,6 +319,10 @@ func APIAuthMethodeTypeToPb(methodType domain.APIAuthMethodType) app_pb.APIAuthM
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	case domain.APIAuthMethodTypePrivateKeyJWT:
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT
+	case domain.APIAuthMethodTypeTLSClientAuth:
+		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH
+	case domain.APIAuthMethodTypeSelfSignedTLSClientAuth:
+		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH
 	default:
 		return app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_BASIC
 	}
@@ -304,11 +334,188 @@ func APIAuthMethodTypeToDomain(authType app_pb.APIAuthMethodType) domain.APIAuth
 		return domain.APIAuthMethodTypeBasic
 	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_PRIVATE_KEY_JWT:
 		return domain.APIAuthMethodTypePrivateKeyJWT
+	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeTLSClientAuth
+	case app_pb.APIAuthMethodType_API_AUTH_METHOD_TYPE_SELF_SIGNED_TLS_CLIENT_AUTH:
+		return domain.APIAuthMethodTypeSelfSignedTLSClientAuth
 	default:
 		return domain.APIAuthMethodTypeBasic
 	}
 }
 
+func SAMLSignatureAlgorithmToPb(algorithm domain.SAMLSignatureAlgorithm) app_pb.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case domain.SAMLSignatureAlgorithmRSASHA256:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256
+	case domain.SAMLSignatureAlgorithmRSASHA512:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512
+	case domain.SAMLSignatureAlgorithmUnspecified:
+		fallthrough
+	default:
+		return app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func SAMLSignatureAlgorithmToDomain(algorithm app_pb.SAMLSignatureAlgorithm) domain.SAMLSignatureAlgorithm {
+	switch algorithm {
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256:
+		return domain.SAMLSignatureAlgorithmRSASHA256
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512:
+		return domain.SAMLSignatureAlgorithmRSASHA512
+	case app_pb.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLSignatureAlgorithmUnspecified
+	}
+}
+
+func SAMLDigestAlgorithmToPb(algorithm domain.SAMLDigestAlgorithm) app_pb.SAMLDigestAlgorithm {
+	switch algorithm {
+	case domain.SAMLDigestAlgorithmSHA256:
+		return app_pb.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256
+	case domain.SAMLDigestAlgorithmSHA512:
+		return app_pb.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512
+	case domain.SAMLDigestAlgorithmUnspecified:
+		fallthrough
+	default:
+		return app_pb.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_UNSPECIFIED
+	}
+}
+
+func SAMLDigestAlgorithmToDomain(algorithm app_pb.SAMLDigestAlgorithm) domain.SAMLDigestAlgorithm {
+	switch algorithm {
+	case app_pb.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256:
+		return domain.SAMLDigestAlgorithmSHA256
+	case app_pb.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512:
+		return domain.SAMLDigestAlgorithmSHA512
+	case app_pb.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLDigestAlgorithmUnspecified
+	}
+}
+
+func SAMLAssertionEncryptionToPb(encryption domain.SAMLAssertionEncryption) app_pb.SAMLAssertionEncryption {
+	switch encryption {
+	case domain.SAMLAssertionEncryptionAES128GCM:
+		return app_pb.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES128_GCM
+	case domain.SAMLAssertionEncryptionAES256GCM:
+		return app_pb.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES256_GCM
+	case domain.SAMLAssertionEncryptionNone:
+		fallthrough
+	default:
+		return app_pb.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_NONE
+	}
+}
+
+func SAMLAssertionEncryptionToDomain(encryption app_pb.SAMLAssertionEncryption) domain.SAMLAssertionEncryption {
+	switch encryption {
+	case app_pb.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES128_GCM:
+		return domain.SAMLAssertionEncryptionAES128GCM
+	case app_pb.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_AES256_GCM:
+		return domain.SAMLAssertionEncryptionAES256GCM
+	case app_pb.SAMLAssertionEncryption_SAML_ASSERTION_ENCRYPTION_NONE:
+		fallthrough
+	default:
+		return domain.SAMLAssertionEncryptionNone
+	}
+}
+
+func SAMLNameIDFormatToPb(format *domain.SAMLNameIDFormat) *app_pb.SAMLNameIDFormat {
+	if format == nil {
+		return nil
+	}
+	switch *format {
+	case domain.SAMLNameIDFormatEmailAddress:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS.Enum()
+	case domain.SAMLNameIDFormatPersistent:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT.Enum()
+	case domain.SAMLNameIDFormatTransient:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT.Enum()
+	case domain.SAMLNameIDFormatUnspecified:
+		fallthrough
+	default:
+		return app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED.Enum()
+	}
+}
+
+func SAMLNameIDFormatToDomain(format *app_pb.SAMLNameIDFormat) *domain.SAMLNameIDFormat {
+	if format == nil {
+		return nil
+	}
+	switch *format {
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_EMAIL_ADDRESS:
+		return gu.Ptr(domain.SAMLNameIDFormatEmailAddress)
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_PERSISTENT:
+		return gu.Ptr(domain.SAMLNameIDFormatPersistent)
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_TRANSIENT:
+		return gu.Ptr(domain.SAMLNameIDFormatTransient)
+	case app_pb.SAMLNameIDFormat_SAML_NAME_ID_FORMAT_UNSPECIFIED:
+		fallthrough
+	default:
+		return gu.Ptr(domain.SAMLNameIDFormatUnspecified)
+	}
+}
+
+func SAMLAttributeMappingToPb(mappings []*domain.SAMLAttributeMapping) []*app_pb.SAMLAttributeMapping {
+	if len(mappings) == 0 {
+		return nil
+	}
+	pbMappings := make([]*app_pb.SAMLAttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		pbMappings[i] = &app_pb.SAMLAttributeMapping{
+			Name:       mapping.Name,
+			NameFormat: mapping.NameFormat,
+			Source:     samlAttributeSourceToPb(mapping.Source),
+			Key:        mapping.Key,
+		}
+	}
+	return pbMappings
+}
+
+func SAMLAttributeMappingToDomain(mappings []*app_pb.SAMLAttributeMapping) []*domain.SAMLAttributeMapping {
+	domainMappings := make([]*domain.SAMLAttributeMapping, len(mappings))
+	for i, mapping := range mappings {
+		domainMappings[i] = &domain.SAMLAttributeMapping{
+			Name:       mapping.GetName(),
+			NameFormat: mapping.GetNameFormat(),
+			Source:     samlAttributeSourceToDomain(mapping.GetSource()),
+			Key:        mapping.GetKey(),
+		}
+	}
+	return domainMappings
+}
+
+func samlAttributeSourceToPb(source domain.SAMLAttributeSource) app_pb.SAMLAttributeSource {
+	switch source {
+	case domain.SAMLAttributeSourceUserField:
+		return app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_USER_FIELD
+	case domain.SAMLAttributeSourceMetadata:
+		return app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_METADATA
+	case domain.SAMLAttributeSourceRoles:
+		return app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_ROLES
+	case domain.SAMLAttributeSourceUnspecified:
+		fallthrough
+	default:
+		return app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_UNSPECIFIED
+	}
+}
+
+func samlAttributeSourceToDomain(source app_pb.SAMLAttributeSource) domain.SAMLAttributeSource {
+	switch source {
+	case app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_USER_FIELD:
+		return domain.SAMLAttributeSourceUserField
+	case app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_METADATA:
+		return domain.SAMLAttributeSourceMetadata
+	case app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_ROLES:
+		return domain.SAMLAttributeSourceRoles
+	case app_pb.SAMLAttributeSource_SAML_ATTRIBUTE_SOURCE_UNSPECIFIED:
+		fallthrough
+	default:
+		return domain.SAMLAttributeSourceUnspecified
+	}
+}
+
 func AppQueriesToModel(queries []*app_pb.AppQuery) (q []query.SearchQuery, err error) {
 	q = make([]query.SearchQuery, len(queries))
 	for i, query := range queries {
@@ -31,6 +31,7 @@ type mockExecutionTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       string
+	RetryPolicy      *domain.TargetRetryPolicy
 }
 
 func (e *mockExecutionTarget) SetEndpoint(endpoint string) {
@@ -57,6 +58,9 @@ func (e *mockExecutionTarget) GetExecutionID() string {
 func (e *mockExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockExecutionTarget) GetRetryPolicy() *domain.TargetRetryPolicy {
+	return e.RetryPolicy
+}
 
 func newMockContentRequest(content string) *connect.Request[structpb.Struct] {
 	return connect.NewRequest(&structpb.Struct{
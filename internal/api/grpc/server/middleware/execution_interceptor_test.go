@@ -30,6 +30,7 @@ type mockExecutionTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       string
+	RetryPolicy      *domain.TargetRetryPolicy
 }
 
 func (e *mockExecutionTarget) SetEndpoint(endpoint string) {
@@ -56,6 +57,9 @@ func (e *mockExecutionTarget) GetExecutionID() string {
 func (e *mockExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockExecutionTarget) GetRetryPolicy() *domain.TargetRetryPolicy {
+	return e.RetryPolicy
+}
 
 func newMockContentRequest(content string) proto.Message {
 	return &structpb.Struct{
@@ -22,6 +22,7 @@ type Server struct {
 	query   *query.Queries
 
 	checkPermission domain.PermissionCheck
+	samlLogoutPath  string
 }
 
 type Config struct{}
@@ -30,11 +31,13 @@ func CreateServer(
 	command *command.Commands,
 	query *query.Queries,
 	checkPermission domain.PermissionCheck,
+	samlLogoutPath string,
 ) *Server {
 	return &Server{
 		command:         command,
 		query:           query,
 		checkPermission: checkPermission,
+		samlLogoutPath:  samlLogoutPath,
 	}
 }
 
@@ -7,10 +7,14 @@ import (
 	"time"
 
 	"connectrpc.com/connect"
+	"github.com/muhlemmer/gu"
+	"github.com/zitadel/logging"
 	"golang.org/x/text/language"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/zitadel/zitadel/internal/api/grpc/object/v2"
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/api/saml"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -32,6 +36,7 @@ func (s *Server) CreateSession(ctx context.Context, req *connect.Request[session
 	if err != nil {
 		return nil, err
 	}
+	s.syncIDPUserGrants(ctx, set.ID, req.Msg.GetChecks())
 
 	return connect.NewResponse(&session.CreateSessionResponse{
 		Details:      object.DomainToDetailsPb(set.ObjectDetails),
@@ -55,6 +60,7 @@ func (s *Server) SetSession(ctx context.Context, req *connect.Request[session.Se
 	if err != nil {
 		return nil, err
 	}
+	s.syncIDPUserGrants(ctx, req.Msg.GetSessionId(), req.Msg.GetChecks())
 	return connect.NewResponse(&session.SetSessionResponse{
 		Details:      object.DomainToDetailsPb(set.ObjectDetails),
 		SessionToken: set.NewToken,
@@ -62,16 +68,42 @@ func (s *Server) SetSession(ctx context.Context, req *connect.Request[session.Se
 	}), nil
 }
 
+// syncIDPUserGrants synchronizes the user grants of the session user with the groups of a checked IdP intent.
+// Since the grants are not required for the session itself, an error is only logged.
+func (s *Server) syncIDPUserGrants(ctx context.Context, sessionID string, checks *session.Checks) {
+	intent := checks.GetIdpIntent()
+	if intent == nil {
+		return
+	}
+	err := s.command.SyncIDPIntentUserGrants(ctx, sessionID, intent.GetIdpIntentId())
+	logging.WithFields("sessionID", sessionID, "intentID", intent.GetIdpIntentId()).OnError(err).Warn("unable to synchronize user grants of idp groups")
+}
+
 func (s *Server) DeleteSession(ctx context.Context, req *connect.Request[session.DeleteSessionRequest]) (*connect.Response[session.DeleteSessionResponse], error) {
 	details, err := s.command.TerminateSession(ctx, req.Msg.GetSessionId(), req.Msg.GetSessionToken())
 	if err != nil {
 		return nil, err
 	}
+	samlLogoutURI, err := s.samlLogoutURI(ctx, req.Msg.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
 	return connect.NewResponse(&session.DeleteSessionResponse{
-		Details: object.DomainToDetailsPb(details),
+		Details:       object.DomainToDetailsPb(details),
+		SamlLogoutUri: samlLogoutURI,
 	}), nil
 }
 
+// samlLogoutURI starts the Single Logout of the SAML service providers the user was logged in through the terminated session.
+// If there are any, the URI of the SAML logout endpoint is returned, where the user agent must be redirected to.
+func (s *Server) samlLogoutURI(ctx context.Context, sessionID string) (*string, error) {
+	started, err := s.command.StartSAMLLogout(ctx, sessionID, "")
+	if err != nil || !started {
+		return nil, err
+	}
+	return gu.Ptr(http_utils.DomainContext(ctx).Origin() + saml.LogoutPath(s.samlLogoutPath, sessionID)), nil
+}
+
 func (s *Server) createSessionRequestToCommand(ctx context.Context, req *session.CreateSessionRequest) ([]command.SessionCommand, map[string][]byte, *domain.UserAgent, time.Duration, error) {
 	checks, err := s.checksToCommand(ctx, req.Checks)
 	if err != nil {
@@ -229,6 +229,14 @@ func idpTypeToPb(idpType domain.IDPType) settings.IdentityProviderType {
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_APPLE
 	case domain.IDPTypeSAML:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML
+	case domain.IDPTypeOkta:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_OKTA
+	case domain.IDPTypeAuth0:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_AUTH0
+	case domain.IDPTypeKeycloak:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_KEYCLOAK
+	case domain.IDPTypeEntraExternalID:
+		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_ENTRA_EXTERNAL_ID
 	default:
 		return settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED
 	}
@@ -499,6 +499,22 @@ func Test_idpTypeToPb(t *testing.T) {
 			args: args{domain.IDPTypeSAML},
 			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_SAML,
 		},
+		{
+			args: args{domain.IDPTypeOkta},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_OKTA,
+		},
+		{
+			args: args{domain.IDPTypeAuth0},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_AUTH0,
+		},
+		{
+			args: args{domain.IDPTypeKeycloak},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_KEYCLOAK,
+		},
+		{
+			args: args{domain.IDPTypeEntraExternalID},
+			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_ENTRA_EXTERNAL_ID,
+		},
 		{
 			args: args{99},
 			want: settings.IdentityProviderType_IDENTITY_PROVIDER_TYPE_UNSPECIFIED,
@@ -0,0 +1,49 @@
+package system
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/zerrors"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func (s *Server) ListCaches(ctx context.Context, _ *system_pb.ListCachesRequest) (*system_pb.ListCachesResponse, error) {
+	caches, err := s.query.ListCaches(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.ListCachesResponse{Result: CachesToPb(caches)}, nil
+}
+
+func (s *Server) ListCacheKeys(ctx context.Context, req *system_pb.ListCacheKeysRequest) (*system_pb.ListCacheKeysResponse, error) {
+	purpose, err := cachePurposeFromPb(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.query.CacheInstanceKeys(ctx, purpose, req.GetInstanceId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.ListCacheKeysResponse{Keys: keys}, nil
+}
+
+func (s *Server) InvalidateCache(ctx context.Context, req *system_pb.InvalidateCacheRequest) (*system_pb.InvalidateCacheResponse, error) {
+	purpose, err := cachePurposeFromPb(req.GetPurpose())
+	if err != nil {
+		return nil, err
+	}
+	invalidated, err := s.query.InvalidateCache(ctx, purpose, req.GetInstanceId())
+	if err != nil {
+		return nil, err
+	}
+	return &system_pb.InvalidateCacheResponse{InvalidatedKeys: uint64(invalidated)}, nil
+}
+
+func cachePurposeFromPb(purpose string) (cache.Purpose, error) {
+	p, err := cache.PurposeString(purpose)
+	if err != nil || p == cache.PurposeUnspecified {
+		return cache.PurposeUnspecified, zerrors.ThrowInvalidArgument(err, "SYSTEM-Phe3u", "Errors.Cache.NotFound")
+	}
+	return p, nil
+}
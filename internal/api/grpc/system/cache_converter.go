@@ -0,0 +1,22 @@
+package system
+
+import (
+	"github.com/zitadel/zitadel/internal/query"
+	system_pb "github.com/zitadel/zitadel/pkg/grpc/system"
+)
+
+func CachesToPb(caches []*query.CacheInfo) []*system_pb.Cache {
+	c := make([]*system_pb.Cache, len(caches))
+	for i, info := range caches {
+		c[i] = CacheToPb(info)
+	}
+	return c
+}
+
+func CacheToPb(info *query.CacheInfo) *system_pb.Cache {
+	return &system_pb.Cache{
+		Purpose:   info.Purpose.String(),
+		Connector: info.Connector.String(),
+		Size:      info.Size,
+	}
+}
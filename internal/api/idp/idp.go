@@ -23,17 +23,22 @@ import (
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
+	"github.com/zitadel/zitadel/internal/domain/tokenreplay"
 	"github.com/zitadel/zitadel/internal/form"
 	"github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
+	"github.com/zitadel/zitadel/internal/idp/providers/auth0"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/entraexternalid"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -51,6 +56,8 @@ const (
 	sloPath         = idpPrefix + "/saml/slo"
 	jwtPath         = "/jwt"
 
+	backChannelLogoutPath = idpPrefix + "/oidc/backchannel-logout"
+
 	paramIntentID         = "id"
 	paramToken            = "token"
 	paramUserID           = "user"
@@ -58,6 +65,7 @@ const (
 	paramErrorDescription = "error_description"
 	varIDPID              = "idpid"
 	paramInternalUI       = "internalUI"
+	paramLogoutToken      = "logout_token"
 )
 
 type Handler struct {
@@ -112,6 +120,7 @@ func NewHandler(
 	encryptionAlgorithm crypto.EncryptionAlgorithm,
 	instanceInterceptor func(next http.Handler) http.Handler,
 	federatedLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	tokenReplaysCache cache.Cache[tokenreplay.Index, string, *tokenreplay.Token],
 ) http.Handler {
 	h := &Handler{
 		commands:            commands,
@@ -121,7 +130,10 @@ func NewHandler(
 		callbackURL:         CallbackURL(),
 		samlRootURL:         SAMLRootURL(),
 		loginSAMLRootURL:    LoginSAMLRootURL(),
-		caches:              &Caches{federatedLogouts: federatedLogoutCache},
+		caches: &Caches{
+			federatedLogouts: federatedLogoutCache,
+			tokenReplays:     tokenReplaysCache,
+		},
 	}
 
 	router := mux.NewRouter()
@@ -132,11 +144,13 @@ func NewHandler(
 	router.HandleFunc(acsPath, h.handleACS)
 	router.HandleFunc(sloPath, h.handleSLO)
 	router.HandleFunc(jwtPath, h.handleJWT)
+	router.HandleFunc(backChannelLogoutPath, h.handleBackChannelLogout).Methods(http.MethodPost)
 	return router
 }
 
 type Caches struct {
 	federatedLogouts cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]
+	tokenReplays     cache.Cache[tokenreplay.Index, string, *tokenreplay.Token]
 }
 
 func parseSAMLRequest(r *http.Request) *externalSAMLIDPCallbackData {
@@ -481,6 +495,105 @@ func (h *Handler) handleSLO(w http.ResponseWriter, r *http.Request) {
 	http.Redirect(w, r, logoutState.PostLogoutRedirectURI, http.StatusFound)
 }
 
+// handleBackChannelLogout handles the logout token sent by an OIDC identity provider (OpenID Connect Back-Channel Logout)
+// and terminates the sessions of the linked user, which were authenticated using the identity provider.
+// Logout tokens are only accepted, if the federated logout is enabled on the IDP template.
+func (h *Handler) handleBackChannelLogout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	idpID := mux.Vars(r)[varIDPID]
+	w.Header().Set("Cache-Control", "no-store")
+
+	template, err := h.queries.IDPTemplateByID(ctx, false, idpID, false, nil)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !template.IsFederatedLogoutEnabled {
+		err = zerrors.ThrowPreconditionFailed(nil, "IDP-Phoo5", "Errors.IDPConfig.LogoutNotSupported")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	provider, err := h.getProvider(ctx, idpID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	logoutProvider, ok := provider.(idp.BackChannelLogoutProvider)
+	if !ok {
+		err = zerrors.ThrowInvalidArgument(nil, "IDP-ieC2o", "Errors.IDPConfig.LogoutNotSupported")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	logoutToken, err := logoutProvider.VerifyLogoutToken(ctx, r.FormValue(paramLogoutToken))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !tokenreplay.Use(ctx, h.caches.tokenReplays, tokenreplay.Key(authz.GetInstance(ctx).InstanceID(), idpID, logoutToken.JWTID)) {
+		err = zerrors.ThrowInvalidArgument(nil, "IDP-Ohb5a", "Errors.IDPConfig.LogoutTokenInvalid")
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var userID string
+	if logoutToken.Subject != "" {
+		userID, err = h.checkExternalUser(ctx, idpID, logoutToken.Subject)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// the user is not linked, so there are no sessions to terminate
+		if userID == "" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	if err = h.terminateIDPSessions(ctx, idpID, userID, logoutToken.SessionID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// terminateIDPSessions signs the user out of the (V1) user agents authenticated by the identity provider
+// and terminates the active (V2) sessions, which were checked using an intent of the identity provider.
+// If the logout token contains a session ID (sid), only the sessions of this session of the identity provider are terminated.
+// Without a userID (the token only contains the sid), the sessions of all users signed in with it are terminated.
+func (h *Handler) terminateIDPSessions(ctx context.Context, idpID, userID, idpSessionID string) error {
+	if err := h.commands.UserIDPSignOut(authz.SetCtxData(ctx, authz.CtxData{UserID: userID}), userID, idpID, idpSessionID); err != nil {
+		return err
+	}
+	idpIDQuery, err := query.NewSessionIntentIDPIDSearchQuery(idpID)
+	if err != nil {
+		return err
+	}
+	queries := []query.SearchQuery{idpIDQuery}
+	if userID != "" {
+		userIDQuery, err := query.NewUserIDSearchQuery(userID)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, userIDQuery)
+	}
+	if idpSessionID != "" {
+		idpSessionIDQuery, err := query.NewSessionIntentIDPSessionIDSearchQuery(idpSessionID)
+		if err != nil {
+			return err
+		}
+		queries = append(queries, idpSessionIDQuery)
+	}
+	sessions, err := h.queries.SearchSessions(ctx, &query.SessionsSearchQueries{Queries: queries}, nil)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions.Sessions {
+		sessionCtx := authz.SetCtxData(ctx, authz.CtxData{UserID: session.UserFactor.UserID})
+		if _, err = h.commands.TerminateSessionWithoutTokenCheck(sessionCtx, session.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (h *Handler) tryMigrateExternalUser(ctx context.Context, idpID string, idpUser idp.User, idpSession idp.Session) (userID string, err error) {
 	migration, ok := idpSession.(idp.SessionSupportsMigration)
 	if !ok {
@@ -555,6 +668,14 @@ func (h *Handler) fetchIDPUserFromCode(ctx context.Context, identityProvider idp
 		session = openid.NewSession(provider.Provider, code, idpArguments)
 	case *google.Provider:
 		session = openid.NewSession(provider.Provider, code, idpArguments)
+	case *okta.Provider:
+		session = openid.NewSession(provider.Provider, code, idpArguments)
+	case *auth0.Provider:
+		session = openid.NewSession(provider.Provider, code, idpArguments)
+	case *keycloak.Provider:
+		session = openid.NewSession(provider.Provider, code, idpArguments)
+	case *entraexternalid.Provider:
+		session = openid.NewSession(provider.Provider, code, idpArguments)
 	case *apple.Provider:
 		session = apple.NewSession(provider, code, appleUser)
 	case *jwt.Provider, *ldap.Provider, *saml2.Provider:
@@ -20,20 +20,23 @@ import (
 )
 
 type accessToken struct {
-	tokenID           string
-	userID            string
-	resourceOwner     string
-	subject           string
-	preferredLanguage *language.Tag
-	clientID          string
-	audience          []string
-	scope             []string
-	authMethods       []domain.UserAuthMethodType
-	authTime          time.Time
-	tokenCreation     time.Time
-	tokenExpiration   time.Time
-	isPAT             bool
-	actor             *domain.TokenActor
+	tokenID               string
+	userID                string
+	resourceOwner         string
+	subject               string
+	preferredLanguage     *language.Tag
+	clientID              string
+	audience              []string
+	scope                 []string
+	authMethods           []domain.UserAuthMethodType
+	authTime              time.Time
+	tokenCreation         time.Time
+	tokenExpiration       time.Time
+	isPAT                 bool
+	actor                 *domain.TokenActor
+	dpopJKT               string
+	certificateThumbprint string
+	authorizationDetails  domain.AuthorizationDetails
 }
 
 var ErrInvalidTokenFormat = errors.New("invalid token format")
@@ -99,19 +102,22 @@ func accessTokenV1(tokenID, subject string, token *model.TokenView) *accessToken
 
 func accessTokenV2(tokenID, subject string, token *query.OIDCSessionAccessTokenReadModel) *accessToken {
 	return &accessToken{
-		tokenID:           tokenID,
-		userID:            token.UserID,
-		resourceOwner:     token.ResourceOwner,
-		subject:           subject,
-		preferredLanguage: token.PreferredLanguage,
-		clientID:          token.ClientID,
-		audience:          token.Audience,
-		scope:             token.Scope,
-		authMethods:       token.AuthMethods,
-		authTime:          token.AuthTime,
-		tokenCreation:     token.AccessTokenCreation,
-		tokenExpiration:   token.AccessTokenExpiration,
-		actor:             token.Actor,
+		tokenID:               tokenID,
+		userID:                token.UserID,
+		resourceOwner:         token.ResourceOwner,
+		subject:               subject,
+		preferredLanguage:     token.PreferredLanguage,
+		clientID:              token.ClientID,
+		audience:              token.Audience,
+		scope:                 token.Scope,
+		authMethods:           token.AuthMethods,
+		authTime:              token.AuthTime,
+		tokenCreation:         token.AccessTokenCreation,
+		tokenExpiration:       token.AccessTokenExpiration,
+		actor:                 token.Actor,
+		dpopJKT:               token.DPoPJKT,
+		certificateThumbprint: token.CertificateThumbprint,
+		authorizationDetails:  token.AuthorizationDetails,
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/http/middleware"
+	"github.com/zitadel/zitadel/internal/api/saml"
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository/eventsourcing/handler"
 	"github.com/zitadel/zitadel/internal/command"
@@ -112,6 +113,8 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 		UILocales:        UILocalesToBusiness(req.UILocales),
 		MaxAge:           MaxAgeToBusiness(req.MaxAge),
 		Issuer:           o.contextToIssuer(ctx),
+
+		AuthorizationDetails: authorizationDetailsFromContext(ctx),
 	}
 	if req.LoginHint != "" {
 		authRequest.LoginHint = &req.LoginHint
@@ -128,6 +131,10 @@ func (o *OPStorage) createAuthRequestLoginClient(ctx context.Context, req *oidc.
 }
 
 func (o *OPStorage) createAuthRequest(ctx context.Context, req *oidc.AuthRequest, userID string) (_ op.AuthRequest, err error) {
+	// the v1 login is not able to render and persist the consent of authorization details
+	if len(authorizationDetailsFromContext(ctx)) > 0 {
+		return nil, errInvalidAuthorizationDetails().WithDescription("authorization_details are only supported with login v2")
+	}
 	userAgentID, ok := middleware.UserAgentIDFromCtx(ctx)
 	if !ok {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-sd436", "no user agent id")
@@ -331,11 +338,32 @@ func (o *OPStorage) TerminateSessionFromRequest(ctx context.Context, endSessionR
 	if err != nil {
 		return "", err
 	}
-	return v2PostLogoutRedirectURI(endSessionRequest.RedirectURI), nil
+	redirectURI = v2PostLogoutRedirectURI(endSessionRequest.RedirectURI)
+	if path := o.samlLogout(ctx, endSessionRequest.IDTokenHintClaims.SessionID, redirectURI); path != "" {
+		return path, nil
+	}
+	return redirectURI, nil
+}
+
+// samlLogout starts the Single Logout of the SAML service providers the user was logged in through the terminated session.
+// If there are any, the path of the SAML logout endpoint is returned,
+// which logs the user out of them before redirecting to the postLogoutRedirectURI.
+func (o *OPStorage) samlLogout(ctx context.Context, sessionID, postLogoutRedirectURI string) string {
+	started, err := o.command.StartSAMLLogout(ctx, sessionID, postLogoutRedirectURI)
+	if err != nil {
+		logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "sessionID", sessionID).
+			WithError(err).Error("error starting saml logout")
+		return ""
+	}
+	if !started {
+		return ""
+	}
+	return saml.LogoutPath(o.samlLogoutPath, sessionID)
 }
 
 // federatedLogout checks whether the session has an idp session linked and the IDP template is configured for federated logout.
 // If so, it creates a federated logout request and stores it in the cache and returns the logout path.
+// Besides the SAML specific setting, the federated logout can be enabled for any template through its options.
 func (o *OPStorage) federatedLogout(ctx context.Context, sessionID string, postLogoutRedirectURI string) string {
 	session, err := o.repo.UserSessionByID(ctx, sessionID)
 	if err != nil {
@@ -352,9 +380,12 @@ func (o *OPStorage) federatedLogout(ctx context.Context, sessionID string, postL
 			WithError(err).Error("error retrieving idp template")
 		return ""
 	}
-	if identityProvider.SAMLIDPTemplate == nil || !identityProvider.FederatedLogoutEnabled {
+	if !isFederatedLogoutEnabled(identityProvider) {
 		return ""
 	}
+	idTokenHint, err := o.query.UserIDPIDToken(ctx, session.UserID, session.UserAgentID, session.SelectedIDPConfigID.String)
+	logging.WithFields("instanceID", authz.GetInstance(ctx).InstanceID(), "idpID", session.SelectedIDPConfigID.String, "sessionID", sessionID).
+		OnError(err).Error("error retrieving id_token of the idp")
 	o.federateLogoutCache.Set(ctx, &federatedlogout.FederatedLogout{
 		InstanceID:            authz.GetInstance(ctx).InstanceID(),
 		FingerPrintID:         authz.GetCtxData(ctx).AgentID,
@@ -362,11 +393,19 @@ func (o *OPStorage) federatedLogout(ctx context.Context, sessionID string, postL
 		IDPID:                 session.SelectedIDPConfigID.String,
 		UserID:                session.UserID,
 		PostLogoutRedirectURI: postLogoutRedirectURI,
+		IDTokenHint:           idTokenHint,
 		State:                 federatedlogout.StateCreated,
 	})
 	return login.ExternalLogoutPath(sessionID)
 }
 
+func isFederatedLogoutEnabled(identityProvider *query.IDPTemplate) bool {
+	if identityProvider.IsFederatedLogoutEnabled {
+		return true
+	}
+	return identityProvider.SAMLIDPTemplate != nil && identityProvider.SAMLIDPTemplate.FederatedLogoutEnabled
+}
+
 func buildLoginV2LogoutURL(baseURI *url.URL, redirectURI string) string {
 	baseURI.JoinPath(LogoutPath)
 	q := baseURI.Query()
@@ -572,6 +611,9 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 		implicitFlowComplianceChecker(),
 		slices.Contains(client.GrantTypes(), oidc.GrantTypeRefreshToken),
 		client.client.BackChannelLogoutURI,
+		"", // tokens returned from the authorization endpoint cannot be DPoP bound
+		"", // tokens returned from the authorization endpoint cannot be certificate bound
+		client.tokenLifetimes(),
 	)
 	if err != nil {
 		return "", err
@@ -580,7 +622,11 @@ func (s *Server) CreateTokenCallbackURL(ctx context.Context, req op.AuthRequest)
 	if err != nil {
 		return "", err
 	}
-	callback, err := op.AuthResponseURL(req.GetRedirectURI(), req.GetResponseType(), req.GetResponseMode(), resp, provider.Encoder())
+	authResp, err := resp.authorizationResponse()
+	if err != nil {
+		return "", err
+	}
+	callback, err := op.AuthResponseURL(req.GetRedirectURI(), req.GetResponseType(), req.GetResponseMode(), authResp, provider.Encoder())
 	if err != nil {
 		return "", err
 	}
@@ -671,6 +717,10 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		authReq.SessionID,
 		authReq.oidc().ResponseType,
+		"",  // tokens returned from the authorization endpoint cannot be DPoP bound
+		"",  // tokens returned from the authorization endpoint cannot be certificate bound
+		nil, // auth requests of the v1 login cannot contain authorization details (see [OPStorage.createAuthRequest])
+		client.tokenLifetimes(),
 	)
 	if err != nil {
 		op.AuthRequestError(w, r, authReq, err, authorizer)
@@ -681,16 +731,21 @@ func (s *Server) authResponseToken(authReq *AuthRequest, authorizer op.Authorize
 		op.AuthRequestError(w, r, authReq, err, authorizer)
 		return err
 	}
+	authResp, err := resp.authorizationResponse()
+	if err != nil {
+		op.AuthRequestError(w, r, authReq, err, authorizer)
+		return err
+	}
 
 	if authReq.GetResponseMode() == oidc.ResponseModeFormPost {
-		if err = op.AuthResponseFormPost(w, authReq.GetRedirectURI(), resp, authorizer.Encoder()); err != nil {
+		if err = op.AuthResponseFormPost(w, authReq.GetRedirectURI(), authResp, authorizer.Encoder()); err != nil {
 			op.AuthRequestError(w, r, authReq, err, authorizer)
 			return err
 		}
 		return nil
 	}
 
-	callback, err := op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), authReq.GetResponseMode(), resp, authorizer.Encoder())
+	callback, err := op.AuthResponseURL(authReq.GetRedirectURI(), authReq.GetResponseType(), authReq.GetResponseMode(), authResp, authorizer.Encoder())
 	if err != nil {
 		op.AuthRequestError(w, r, authReq, err, authorizer)
 		return err
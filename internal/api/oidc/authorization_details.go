@@ -0,0 +1,113 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"slices"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	// authorizationDetailsParam is the request parameter and response / claim name
+	// of OAuth 2.0 Rich Authorization Requests (RFC 9396).
+	authorizationDetailsParam = "authorization_details"
+
+	// errorInvalidAuthorizationDetails is the error type defined in RFC 9396, section 5.
+	errorInvalidAuthorizationDetails = "invalid_authorization_details"
+)
+
+func errInvalidAuthorizationDetails() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: errorInvalidAuthorizationDetails,
+	}
+}
+
+// authorizationDetailsFromForm parses and validates the authorization_details parameter of the request.
+// If the supported types are restricted by configuration, any other type is rejected.
+func (s *Server) authorizationDetailsFromForm(form url.Values) (domain.AuthorizationDetails, error) {
+	details, err := domain.ParseAuthorizationDetails(form.Get(authorizationDetailsParam))
+	if err != nil {
+		return nil, errInvalidAuthorizationDetails().WithParent(err).WithDescription("authorization_details are invalid")
+	}
+	if len(s.authorizationDetailsTypesSupported) == 0 {
+		return details, nil
+	}
+	for _, detailType := range details.Types() {
+		if !slices.Contains(s.authorizationDetailsTypesSupported, detailType) {
+			return nil, errInvalidAuthorizationDetails().WithDescription("authorization_details type %q is not supported", detailType)
+		}
+	}
+	return details, nil
+}
+
+// requestedAuthorizationDetails returns the authorization details requested on the token endpoint (refresh token and token exchange),
+// which must be a subset of the already granted ones.
+// If none are requested, the granted authorization details are returned.
+func (s *Server) requestedAuthorizationDetails(form url.Values, granted domain.AuthorizationDetails) (domain.AuthorizationDetails, error) {
+	requested, err := s.authorizationDetailsFromForm(form)
+	if err != nil {
+		return nil, err
+	}
+	if len(requested) == 0 {
+		return granted, nil
+	}
+	if !granted.Contains(requested) {
+		return nil, errInvalidAuthorizationDetails().WithDescription("authorization_details exceed the granted authorization_details")
+	}
+	return requested, nil
+}
+
+type authorizationDetailsKey struct{}
+
+// withAuthorizationDetails passes the authorization details of the authorization request
+// from [Server.Authorize] to [OPStorage.CreateAuthRequest], as the oidc library does not know them.
+func withAuthorizationDetails(ctx context.Context, details domain.AuthorizationDetails) context.Context {
+	if len(details) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, authorizationDetailsKey{}, details)
+}
+
+func authorizationDetailsFromContext(ctx context.Context) domain.AuthorizationDetails {
+	details, _ := ctx.Value(authorizationDetailsKey{}).(domain.AuthorizationDetails)
+	return details
+}
+
+// accessTokenResponse extends the [oidc.AccessTokenResponse] with the granted authorization details (RFC 9396, section 7).
+type accessTokenResponse struct {
+	*oidc.AccessTokenResponse
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+}
+
+// authorizationTokenResponse extends the [oidc.AccessTokenResponse] returned from the authorization endpoint
+// (implicit and hybrid flow) with the granted authorization details, encoded as JSON like the request parameter.
+type authorizationTokenResponse struct {
+	*oidc.AccessTokenResponse
+	AuthorizationDetails string `schema:"authorization_details,omitempty"`
+}
+
+// authorizationResponse converts the token response for the redirect to the client.
+func (r *accessTokenResponse) authorizationResponse() (*authorizationTokenResponse, error) {
+	resp := &authorizationTokenResponse{
+		AccessTokenResponse: r.AccessTokenResponse,
+	}
+	if len(r.AuthorizationDetails) == 0 {
+		return resp, nil
+	}
+	details, err := json.Marshal(r.AuthorizationDetails)
+	if err != nil {
+		return nil, err
+	}
+	resp.AuthorizationDetails = string(details)
+	return resp, nil
+}
+
+// tokenExchangeResponse extends the [oidc.TokenExchangeResponse] with the granted authorization details (RFC 9396, section 7).
+type tokenExchangeResponse struct {
+	*oidc.TokenExchangeResponse
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
+}
@@ -0,0 +1,180 @@
+package oidc
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/schema"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func TestServer_authorizationDetailsFromForm(t *testing.T) {
+	tests := []struct {
+		name           string
+		supportedTypes []string
+		form           url.Values
+		want           domain.AuthorizationDetails
+		wantErrorType  string
+	}{
+		{
+			name: "no authorization details",
+			form: url.Values{},
+			want: nil,
+		},
+		{
+			name:          "invalid authorization details",
+			form:          url.Values{authorizationDetailsParam: {`{"type":"payment_initiation"}`}},
+			wantErrorType: errorInvalidAuthorizationDetails,
+		},
+		{
+			name:          "missing type",
+			form:          url.Values{authorizationDetailsParam: {`[{"actions":["initiate"]}]`}},
+			wantErrorType: errorInvalidAuthorizationDetails,
+		},
+		{
+			name:           "unsupported type",
+			supportedTypes: []string{"account_information"},
+			form:           url.Values{authorizationDetailsParam: {`[{"type":"payment_initiation"}]`}},
+			wantErrorType:  errorInvalidAuthorizationDetails,
+		},
+		{
+			name: "any type",
+			form: url.Values{authorizationDetailsParam: {`[{"type":"payment_initiation","actions":["initiate"]}]`}},
+			want: domain.AuthorizationDetails{
+				{"type": "payment_initiation", "actions": []any{"initiate"}},
+			},
+		},
+		{
+			name:           "supported type",
+			supportedTypes: []string{"account_information", "payment_initiation"},
+			form:           url.Values{authorizationDetailsParam: {`[{"type":"payment_initiation"}]`}},
+			want: domain.AuthorizationDetails{
+				{"type": "payment_initiation"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{authorizationDetailsTypesSupported: tt.supportedTypes}
+			got, err := s.authorizationDetailsFromForm(tt.form)
+			if tt.wantErrorType != "" {
+				var oidcErr *oidc.Error
+				if assert.ErrorAs(t, err, &oidcErr) {
+					assert.Equal(t, tt.wantErrorType, string(oidcErr.ErrorType))
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestServer_requestedAuthorizationDetails(t *testing.T) {
+	granted := domain.AuthorizationDetails{
+		{"type": "payment_initiation", "actions": []any{"initiate"}},
+		{"type": "account_information"},
+	}
+	tests := []struct {
+		name          string
+		form          url.Values
+		granted       domain.AuthorizationDetails
+		want          domain.AuthorizationDetails
+		wantErrorType string
+	}{
+		{
+			name:    "none requested",
+			form:    url.Values{},
+			granted: granted,
+			want:    granted,
+		},
+		{
+			name:    "subset requested",
+			form:    url.Values{authorizationDetailsParam: {`[{"type":"account_information"}]`}},
+			granted: granted,
+			want: domain.AuthorizationDetails{
+				{"type": "account_information"},
+			},
+		},
+		{
+			name:          "exceeding requested",
+			form:          url.Values{authorizationDetailsParam: {`[{"type":"payment_initiation","actions":["initiate","cancel"]}]`}},
+			granted:       granted,
+			wantErrorType: errorInvalidAuthorizationDetails,
+		},
+		{
+			name:          "none granted",
+			form:          url.Values{authorizationDetailsParam: {`[{"type":"account_information"}]`}},
+			granted:       nil,
+			wantErrorType: errorInvalidAuthorizationDetails,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := new(Server).requestedAuthorizationDetails(tt.form, tt.granted)
+			if tt.wantErrorType != "" {
+				var oidcErr *oidc.Error
+				if assert.ErrorAs(t, err, &oidcErr) {
+					assert.Equal(t, tt.wantErrorType, string(oidcErr.ErrorType))
+				}
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_accessTokenResponse_authorizationResponse(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *accessTokenResponse
+		want url.Values
+	}{
+		{
+			name: "without authorization details",
+			resp: &accessTokenResponse{
+				AccessTokenResponse: &oidc.AccessTokenResponse{
+					AccessToken: "accessToken",
+					TokenType:   oidc.BearerToken,
+					State:       "state",
+				},
+			},
+			want: url.Values{
+				"access_token": {"accessToken"},
+				"token_type":   {oidc.BearerToken},
+				"state":        {"state"},
+			},
+		},
+		{
+			name: "with authorization details",
+			resp: &accessTokenResponse{
+				AccessTokenResponse: &oidc.AccessTokenResponse{
+					AccessToken: "accessToken",
+					TokenType:   oidc.BearerToken,
+					State:       "state",
+				},
+				AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
+			},
+			want: url.Values{
+				"access_token":            {"accessToken"},
+				"token_type":              {oidc.BearerToken},
+				"state":                   {"state"},
+				authorizationDetailsParam: {`[{"type":"payment_initiation"}]`},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.resp.authorizationResponse()
+			require.NoError(t, err)
+			values := make(url.Values)
+			require.NoError(t, schema.NewEncoder().Encode(got, values))
+			assert.Equal(t, tt.want, values)
+		})
+	}
+}
@@ -0,0 +1,400 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-chi/chi/v5"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthDefaultLifetime     = 5 * time.Minute
+	BackChannelAuthDefaultPollInterval = 5 * time.Second
+
+	// grantTypeCIBA is the grant type of the Client Initiated Backchannel Authentication (CIBA) token request.
+	grantTypeCIBA oidc.GrantType = "urn:openid:params:grant-type:ciba"
+
+	backChannelAuthReqIDBytes          = 32
+	backChannelBindingMessageMaxLength = 100
+
+	// error types of the backchannel authentication endpoint, defined in
+	// https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.13
+	backChannelErrorUnknownUserID         = "unknown_user_id"
+	backChannelErrorInvalidBindingMessage = "invalid_binding_message"
+)
+
+type BackChannelAuthConfig struct {
+	Lifetime     time.Duration
+	PollInterval time.Duration
+}
+
+// lifetimeAndPollInterval returns the configured lifetime and poll interval,
+// setting sane defaults for empty values.
+// Safe to call when c is nil.
+func (c *BackChannelAuthConfig) lifetimeAndPollInterval() (lifetime, pollInterval time.Duration) {
+	lifetime, pollInterval = BackChannelAuthDefaultLifetime, BackChannelAuthDefaultPollInterval
+	if c == nil {
+		return lifetime, pollInterval
+	}
+	if c.Lifetime != 0 {
+		lifetime = c.Lifetime
+	}
+	if c.PollInterval != 0 {
+		pollInterval = c.PollInterval
+	}
+	return lifetime, pollInterval
+}
+
+// backChannelAuthRequest are the parameters of the authentication request of the client,
+// defined in https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.7.1
+type backChannelAuthRequest struct {
+	Scopes                  oidc.SpaceDelimitedArray `schema:"scope"`
+	ClientNotificationToken string                   `schema:"client_notification_token"`
+	ACRValues               oidc.SpaceDelimitedArray `schema:"acr_values"`
+	LoginHintToken          string                   `schema:"login_hint_token"`
+	IDTokenHint             string                   `schema:"id_token_hint"`
+	LoginHint               string                   `schema:"login_hint"`
+	BindingMessage          string                   `schema:"binding_message"`
+	UserCode                string                   `schema:"user_code"`
+	RequestedExpiry         int64                    `schema:"requested_expiry"`
+	Request                 string                   `schema:"request"`
+}
+
+type backChannelAuthResponse struct {
+	AuthReqID string `json:"auth_req_id"`
+	ExpiresIn int64  `json:"expires_in"`
+	Interval  int64  `json:"interval,omitempty"`
+}
+
+type backChannelAuthPollIndex int
+
+const (
+	backChannelAuthPollIndexUnspecified backChannelAuthPollIndex = iota
+	backChannelAuthPollIndexAuthReqID
+)
+
+// backChannelAuthPoll is the last token request of a client for an auth_req_id,
+// used to enforce the poll interval.
+type backChannelAuthPoll struct {
+	InstanceID string
+	AuthReqID  string
+	PolledAt   time.Time
+}
+
+// Keys implements cache.Entry
+func (p *backChannelAuthPoll) Keys(i backChannelAuthPollIndex) []string {
+	if i == backChannelAuthPollIndexAuthReqID {
+		return []string{backChannelAuthPollKey(p.InstanceID, p.AuthReqID)}
+	}
+	return nil
+}
+
+func backChannelAuthPollKey(instanceID, authReqID string) string {
+	return instanceID + "-" + authReqID
+}
+
+func startBackChannelAuthPollCache(background context.Context, connectors connector.Connectors) (cache.Cache[backChannelAuthPollIndex, string, *backChannelAuthPoll], error) {
+	return connector.StartCache[backChannelAuthPollIndex, string, *backChannelAuthPoll](background, []backChannelAuthPollIndex{backChannelAuthPollIndexAuthReqID}, cache.PurposeBackChannelAuthPoll, connectors.Config.BackChannelAuthPolls, connectors)
+}
+
+// backChannelAuthEndpoint returns the endpoint of the backchannel authentication endpoint,
+// defaulting to /oauth/v2/bc-authorize.
+func backChannelAuthEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.BackChannelAuth == nil {
+		return op.NewEndpoint("/oauth/v2/bc-authorize")
+	}
+	return op.NewEndpointWithURL(endpointConfig.BackChannelAuth.Path, endpointConfig.BackChannelAuth.URL)
+}
+
+// registerBackChannelAuthHandler adds the backchannel authentication endpoint to the router of the OP.
+func (s *Server) registerBackChannelAuthHandler(router chi.Router) {
+	router.Post(s.backChannelAuthEndpoint.Relative(), s.backChannelAuthHandler)
+}
+
+// backChannelAuthHandler implements the backchannel authentication endpoint of
+// Client Initiated Backchannel Authentication (CIBA).
+// The client authenticates the same way as on the token endpoint and provides a hint of the user.
+// The request is stored and can be approved or denied by the user through the login UI using the session API.
+// Depending on the registration of the client, it will then either poll the token endpoint
+// or receive a ping on its notification endpoint.
+func (s *Server) backChannelAuthHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.backChannelAuth(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (s *Server) backChannelAuth(ctx context.Context, r *http.Request) (_ *backChannelAuthResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	client, err := s.backChannelAuthClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	authReq := new(backChannelAuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, r.PostForm); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if err = validateBackChannelAuthRequest(authReq, client); err != nil {
+		return nil, err
+	}
+	user, err := s.backChannelAuthUser(ctx, authReq)
+	if err != nil {
+		return nil, err
+	}
+	storage, ok := s.Provider().Storage().(*OPStorage)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-ieL3a", "Errors.Internal")
+	}
+	scope, audience, err := storage.createAuthRequestScopeAndAudience(ctx, client.GetID(), authReq.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	authReqID, err := newBackChannelAuthReqID()
+	if err != nil {
+		return nil, err
+	}
+	lifetime := s.backChannelAuthLifetime
+	if requested := time.Duration(authReq.RequestedExpiry) * time.Second; requested > 0 && requested < lifetime {
+		lifetime = requested
+	}
+	_, err = s.command.AddBackChannelAuth(ctx, &command.BackChannelAuthRequest{
+		AuthReqID:                  authReqID,
+		ClientID:                   client.GetID(),
+		UserID:                     user.ID,
+		UserOrgID:                  user.ResourceOwner,
+		Scopes:                     scope,
+		Audience:                   audience,
+		BindingMessage:             authReq.BindingMessage,
+		Expires:                    time.Now().Add(lifetime),
+		NeedRefreshToken:           slices.Contains(scope, oidc.ScopeOfflineAccess),
+		ClientNotificationEndpoint: client.client.BackChannelClientNotificationEndpoint,
+		ClientNotificationToken:    authReq.ClientNotificationToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &backChannelAuthResponse{
+		AuthReqID: authReqID,
+		ExpiresIn: int64(lifetime / time.Second),
+	}
+	if domain.BackChannelTokenDeliveryModeFromEndpoint(client.client.BackChannelClientNotificationEndpoint) == domain.BackChannelTokenDeliveryModePoll {
+		resp.Interval = int64(s.backChannelAuthPollInterval / time.Second)
+	}
+	return resp, nil
+}
+
+// backChannelAuthClient authenticates the client of the request
+// and ensures it is a confidential client allowed to use the CIBA grant.
+func (s *Server) backChannelAuthClient(ctx context.Context, r *http.Request) (*Client, error) {
+	credentials, err := s.clientCredentialsFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	opClient, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.Form,
+		Data:   credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+	client, ok := opClient.(*Client)
+	if !ok {
+		return nil, zerrors.ThrowInternal(nil, "OIDC-Ohph7", "Errors.Internal")
+	}
+	if client.AuthMethod() == oidc.AuthMethodNone {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("backchannel authentication requires client authentication")
+	}
+	if !slices.Contains(client.GrantTypes(), grantTypeCIBA) {
+		return nil, oidc.ErrUnauthorizedClient().WithDescription("grant_type %q not allowed", grantTypeCIBA)
+	}
+	return client, nil
+}
+
+func validateBackChannelAuthRequest(authReq *backChannelAuthRequest, client *Client) error {
+	if authReq.Request != "" {
+		return oidc.ErrRequestNotSupported()
+	}
+	if !slices.Contains(authReq.Scopes, oidc.ScopeOpenID) {
+		return oidc.ErrInvalidScope().WithDescription("the scope openid is required")
+	}
+	var hints int
+	for _, hint := range []string{authReq.LoginHintToken, authReq.IDTokenHint, authReq.LoginHint} {
+		if hint != "" {
+			hints++
+		}
+	}
+	if hints != 1 {
+		return oidc.ErrInvalidRequest().WithDescription("exactly one of login_hint, id_token_hint or login_hint_token must be provided")
+	}
+	if authReq.LoginHintToken != "" {
+		return oidc.ErrInvalidRequest().WithDescription("login_hint_token is not supported")
+	}
+	if utf8.RuneCountInString(authReq.BindingMessage) > backChannelBindingMessageMaxLength {
+		return &oidc.Error{
+			ErrorType:   backChannelErrorInvalidBindingMessage,
+			Description: "binding_message is too long",
+		}
+	}
+	if authReq.RequestedExpiry < 0 {
+		return oidc.ErrInvalidRequest().WithDescription("requested_expiry must be positive")
+	}
+	if domain.BackChannelTokenDeliveryModeFromEndpoint(client.client.BackChannelClientNotificationEndpoint) == domain.BackChannelTokenDeliveryModePing &&
+		authReq.ClientNotificationToken == "" {
+		return oidc.ErrInvalidRequest().WithDescription("client_notification_token is required for the ping mode")
+	}
+	return nil
+}
+
+// backChannelAuthUser resolves the user the authentication is requested for,
+// either by the login name (login_hint) or an ID token previously issued to the client (id_token_hint).
+func (s *Server) backChannelAuthUser(ctx context.Context, authReq *backChannelAuthRequest) (user *query.User, err error) {
+	if authReq.IDTokenHint != "" {
+		claims, err := op.VerifyIDTokenHint[*oidc.IDTokenClaims](ctx, authReq.IDTokenHint, s.Provider().IDTokenHintVerifier(ctx))
+		if err != nil {
+			return nil, oidc.ErrInvalidRequest().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError).WithDescription("invalid id_token_hint")
+		}
+		user, err = s.query.GetUserByID(ctx, true, claims.Subject)
+	} else {
+		user, err = s.query.GetUserByLoginName(ctx, true, authReq.LoginHint)
+	}
+	if zerrors.IsNotFound(err) || (err == nil && user.State != domain.UserStateActive) {
+		return nil, (&oidc.Error{
+			ErrorType:   backChannelErrorUnknownUserID,
+			Description: "the user could not be identified",
+		}).WithParent(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func backChannelTokenDeliveryModesSupported() []string {
+	return []string{
+		string(domain.BackChannelTokenDeliveryModePoll),
+		string(domain.BackChannelTokenDeliveryModePing),
+	}
+}
+
+func newBackChannelAuthReqID() (string, error) {
+	id := make([]byte, backChannelAuthReqIDBytes)
+	if _, err := rand.Read(id); err != nil {
+		return "", zerrors.ThrowInternal(err, "OIDC-aeW8o", "Errors.Internal")
+	}
+	return base64.RawURLEncoding.EncodeToString(id), nil
+}
+
+// backChannelAuthTokenHandler handles the token requests of the CIBA grant type,
+// which is not supported by the oidc library.
+// Any other request is passed to the next handler.
+func (s *Server) backChannelAuthTokenHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != s.Endpoints().Token.Relative() {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			op.WriteError(w, r, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err), s.getLogger(r.Context()))
+			return
+		}
+		if oidc.GrantType(r.PostForm.Get("grant_type")) != grantTypeCIBA {
+			next.ServeHTTP(w, r)
+			return
+		}
+		resp, err := s.backChannelAuthToken(r.Context(), r)
+		if err != nil {
+			op.WriteError(w, r, err, s.getLogger(r.Context()))
+			return
+		}
+		httphelper.MarshalJSON(w, resp)
+	})
+}
+
+func (s *Server) backChannelAuthToken(ctx context.Context, r *http.Request) (_ *accessTokenResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		span.EndWithError(err)
+		err = oidcError(err)
+	}()
+
+	client, err := s.backChannelAuthClient(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	authReqID := r.PostForm.Get("auth_req_id")
+	if authReqID == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("auth_req_id missing")
+	}
+	if err = s.checkBackChannelAuthPollInterval(ctx, authReqID); err != nil {
+		return nil, err
+	}
+	dpopJKT, err := s.tokenRequestDPoPKey(ctx, r.Method, r.Header, client.client.RequireDPoP)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.command.CreateOIDCSessionFromBackChannelAuth(ctx, authReqID, client.GetID(), client.client.BackChannelLogoutURI, dpopJKT, tokenRequestCertificateThumbprint(ctx, client), client.tokenLifetimes())
+	if err == nil {
+		return s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion)
+	}
+	var target command.BackChannelAuthStateError
+	if errors.As(err, &target) {
+		switch domain.BackChannelAuthState(target) {
+		case domain.BackChannelAuthStateInitiated:
+			return nil, oidc.ErrAuthorizationPending()
+		case domain.BackChannelAuthStateExpired:
+			return nil, oidc.ErrExpiredDeviceCode().WithDescription("auth_req_id has expired")
+		case domain.BackChannelAuthStateDenied:
+			return nil, oidc.ErrAccessDenied()
+		}
+	}
+	return nil, oidc.ErrInvalidGrant().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+}
+
+// checkBackChannelAuthPollInterval returns a slow_down error if the client requests the token for the auth_req_id
+// more frequently than the interval returned by the backchannel authentication endpoint.
+// Each token request is recorded, so a client must increase its interval after a slow_down error.
+func (s *Server) checkBackChannelAuthPollInterval(ctx context.Context, authReqID string) error {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	now := time.Now()
+	lastPoll, ok := s.backChannelAuthPolls.Get(ctx, backChannelAuthPollIndexAuthReqID, backChannelAuthPollKey(instanceID, authReqID))
+	s.backChannelAuthPolls.Set(ctx, &backChannelAuthPoll{
+		InstanceID: instanceID,
+		AuthReqID:  authReqID,
+		PolledAt:   now,
+	})
+	if ok && now.Before(lastPoll.PolledAt.Add(s.backChannelAuthPollInterval)) {
+		return oidc.ErrSlowDown()
+	}
+	return nil
+}
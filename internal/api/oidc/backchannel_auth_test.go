@@ -0,0 +1,169 @@
+package oidc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func TestBackChannelAuthConfig_lifetimeAndPollInterval(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           *BackChannelAuthConfig
+		wantLifetime     time.Duration
+		wantPollInterval time.Duration
+	}{
+		{
+			name:             "nil config",
+			config:           nil,
+			wantLifetime:     BackChannelAuthDefaultLifetime,
+			wantPollInterval: BackChannelAuthDefaultPollInterval,
+		},
+		{
+			name:             "empty config",
+			config:           &BackChannelAuthConfig{},
+			wantLifetime:     BackChannelAuthDefaultLifetime,
+			wantPollInterval: BackChannelAuthDefaultPollInterval,
+		},
+		{
+			name: "custom config",
+			config: &BackChannelAuthConfig{
+				Lifetime:     10 * time.Minute,
+				PollInterval: 2 * time.Second,
+			},
+			wantLifetime:     10 * time.Minute,
+			wantPollInterval: 2 * time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lifetime, pollInterval := tt.config.lifetimeAndPollInterval()
+			assert.Equal(t, tt.wantLifetime, lifetime)
+			assert.Equal(t, tt.wantPollInterval, pollInterval)
+		})
+	}
+}
+
+func Test_validateBackChannelAuthRequest(t *testing.T) {
+	pollClient := &Client{client: &query.OIDCClient{}}
+	pingClient := &Client{client: &query.OIDCClient{BackChannelClientNotificationEndpoint: "https://client.com/notify"}}
+
+	tests := []struct {
+		name          string
+		authReq       *backChannelAuthRequest
+		client        *Client
+		wantErrorType string
+	}{
+		{
+			name: "request object",
+			authReq: &backChannelAuthRequest{
+				Scopes:    []string{oidc.ScopeOpenID},
+				LoginHint: "user@example.com",
+				Request:   "request",
+			},
+			client:        pollClient,
+			wantErrorType: "request_not_supported",
+		},
+		{
+			name: "missing openid scope",
+			authReq: &backChannelAuthRequest{
+				Scopes:    []string{oidc.ScopeProfile},
+				LoginHint: "user@example.com",
+			},
+			client:        pollClient,
+			wantErrorType: "invalid_scope",
+		},
+		{
+			name: "missing hint",
+			authReq: &backChannelAuthRequest{
+				Scopes: []string{oidc.ScopeOpenID},
+			},
+			client:        pollClient,
+			wantErrorType: "invalid_request",
+		},
+		{
+			name: "multiple hints",
+			authReq: &backChannelAuthRequest{
+				Scopes:      []string{oidc.ScopeOpenID},
+				LoginHint:   "user@example.com",
+				IDTokenHint: "idToken",
+			},
+			client:        pollClient,
+			wantErrorType: "invalid_request",
+		},
+		{
+			name: "login hint token",
+			authReq: &backChannelAuthRequest{
+				Scopes:         []string{oidc.ScopeOpenID},
+				LoginHintToken: "token",
+			},
+			client:        pollClient,
+			wantErrorType: "invalid_request",
+		},
+		{
+			name: "binding message too long",
+			authReq: &backChannelAuthRequest{
+				Scopes:         []string{oidc.ScopeOpenID},
+				LoginHint:      "user@example.com",
+				BindingMessage: strings.Repeat("a", backChannelBindingMessageMaxLength+1),
+			},
+			client:        pollClient,
+			wantErrorType: "invalid_binding_message",
+		},
+		{
+			name: "negative requested expiry",
+			authReq: &backChannelAuthRequest{
+				Scopes:          []string{oidc.ScopeOpenID},
+				LoginHint:       "user@example.com",
+				RequestedExpiry: -1,
+			},
+			client:        pollClient,
+			wantErrorType: "invalid_request",
+		},
+		{
+			name: "ping without notification token",
+			authReq: &backChannelAuthRequest{
+				Scopes:    []string{oidc.ScopeOpenID},
+				LoginHint: "user@example.com",
+			},
+			client:        pingClient,
+			wantErrorType: "invalid_request",
+		},
+		{
+			name: "poll, ok",
+			authReq: &backChannelAuthRequest{
+				Scopes:         []string{oidc.ScopeOpenID},
+				LoginHint:      "user@example.com",
+				BindingMessage: "W4SCT",
+			},
+			client: pollClient,
+		},
+		{
+			name: "ping, ok",
+			authReq: &backChannelAuthRequest{
+				Scopes:                  []string{oidc.ScopeOpenID},
+				IDTokenHint:             "idToken",
+				ClientNotificationToken: "notificationToken",
+			},
+			client: pingClient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBackChannelAuthRequest(tt.authReq, tt.client)
+			if tt.wantErrorType == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var oidcErr *oidc.Error
+			if assert.ErrorAs(t, err, &oidcErr) {
+				assert.Equal(t, tt.wantErrorType, string(oidcErr.ErrorType))
+			}
+		})
+	}
+}
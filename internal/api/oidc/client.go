@@ -49,7 +49,7 @@ func (o *OPStorage) GetClientByClientID(ctx context.Context, id string) (_ op.Cl
 	if err != nil {
 		return nil, err
 	}
-	return ClientFromBusiness(client, o.defaultLoginURL, o.defaultLoginURLV2), nil
+	return ClientFromBusiness(client, o.defaultLoginURL, o.defaultLoginURLV2, &o.credentialIssuer), nil
 }
 
 func (o *OPStorage) GetKeyByIDAndClientID(context.Context, string, string) (*jose.JSONWebKey, error) {
@@ -215,13 +215,15 @@ func (s *Server) VerifyClient(ctx context.Context, r *op.Request[op.ClientCreden
 		err = s.verifyClientSecret(ctx, client, r.Data.ClientSecret)
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		err = s.verifyClientAssertion(ctx, client, r.Data.ClientAssertion)
+	case domain.OIDCAuthMethodTypeTLSClientAuth, domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		err = verifyTLSClientCertificate(ctx, client.AuthMethodType == domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth, client.TLSClientAuthSubjectDN, client.TLSClientAuthPublicKeyPin)
 	case domain.OIDCAuthMethodTypeNone:
 	}
 	if err != nil {
 		return nil, err
 	}
 
-	return ClientFromBusiness(client, s.defaultLoginURL, s.defaultLoginURLV2), nil
+	return ClientFromBusiness(client, s.defaultLoginURL, s.defaultLoginURLV2, &s.credentialIssuer), nil
 }
 
 func (s *Server) verifyClientAssertion(ctx context.Context, client *query.OIDCClient, assertion string) (err error) {
@@ -26,11 +26,14 @@ type Client struct {
 	allowedScopes     []string
 }
 
-func ClientFromBusiness(client *query.OIDCClient, defaultLoginURL, defaultLoginURLV2 string) op.Client {
-	allowedScopes := make([]string, len(client.ProjectRoleKeys))
+func ClientFromBusiness(client *query.OIDCClient, defaultLoginURL, defaultLoginURLV2 string, credentialIssuer *CredentialIssuerConfig) op.Client {
+	allowedScopes := make([]string, len(client.ProjectRoleKeys), len(client.ProjectRoleKeys)+1)
 	for i, roleKey := range client.ProjectRoleKeys {
 		allowedScopes[i] = ScopeProjectRolePrefix + roleKey
 	}
+	if credentialIssuer.allowsClient(client) {
+		allowedScopes = append(allowedScopes, ScopeIdentityCredential)
+	}
 
 	return &Client{
 		client:            client,
@@ -108,14 +111,31 @@ func (c *Client) RestrictAdditionalAccessTokenScopes() func(scopes []string) []s
 	}
 }
 
+// AccessTokenLifetime returns the lifetime configured on the client, defaulting to the one of the OIDC settings.
 func (c *Client) AccessTokenLifetime() time.Duration {
+	if c.client.AccessTokenLifetime > 0 {
+		return c.client.AccessTokenLifetime
+	}
 	return c.client.Settings.AccessTokenLifetime
 }
 
+// IDTokenLifetime returns the lifetime configured on the client, defaulting to the one of the OIDC settings.
 func (c *Client) IDTokenLifetime() time.Duration {
+	if c.client.IDTokenLifetime > 0 {
+		return c.client.IDTokenLifetime
+	}
 	return c.client.Settings.IdTokenLifetime
 }
 
+// tokenLifetimes returns the access and refresh token lifetimes configured on the client,
+// which take precedence over the ones of the OIDC settings when creating or refreshing the session.
+func (c *Client) tokenLifetimes() command.ClientTokenLifetimes {
+	return command.ClientTokenLifetimes{
+		AccessToken:  c.client.AccessTokenLifetime,
+		RefreshToken: c.client.RefreshTokenLifetime,
+	}
+}
+
 func (c *Client) AccessTokenType() op.AccessTokenType {
 	return accessTokenTypeToOIDC(c.client.AccessTokenType)
 }
@@ -167,6 +187,10 @@ func authMethodToOIDC(authType domain.OIDCAuthMethodType) oidc.AuthMethod {
 		return oidc.AuthMethodNone
 	case domain.OIDCAuthMethodTypePrivateKeyJWT:
 		return oidc.AuthMethodPrivateKeyJWT
+	case domain.OIDCAuthMethodTypeTLSClientAuth:
+		return authMethodTLSClientAuth
+	case domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth:
+		return authMethodSelfSignedTLSClientAuth
 	default:
 		return oidc.AuthMethodBasic
 	}
@@ -213,6 +237,8 @@ func grantTypeToOIDC(grantType domain.OIDCGrantType) oidc.GrantType {
 		return oidc.GrantTypeDeviceCode
 	case domain.OIDCGrantTypeTokenExchange:
 		return oidc.GrantTypeTokenExchange
+	case domain.OIDCGrantTypeCIBA:
+		return grantTypeCIBA
 	default:
 		return oidc.GrantTypeCode
 	}
@@ -0,0 +1,457 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/muhlemmer/gu"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	clientIDParam = "client_id"
+
+	// error types of the client registration endpoint, defined in RFC 7591, section 3.2.2
+	clientRegistrationErrorInvalidRedirectURI    = "invalid_redirect_uri"
+	clientRegistrationErrorInvalidClientMetadata = "invalid_client_metadata"
+	// errorInvalidToken is the error type of an invalid bearer token, defined in RFC 6750, section 3.1
+	errorInvalidToken = "invalid_token"
+
+	applicationTypeWeb    = "web"
+	applicationTypeNative = "native"
+
+	backChannelTokenDeliveryModePoll = "poll"
+	backChannelTokenDeliveryModePing = "ping"
+)
+
+// clientMetadata are the metadata of a client defined in RFC 7591, section 2
+// and the extensions for back-channel logout, mutual TLS (RFC 8705), CIBA, PAR (RFC 9126) and DPoP (RFC 9449),
+// supported by ZITADEL.
+type clientMetadata struct {
+	ClientID                              string   `json:"client_id,omitempty"`
+	ClientSecret                          string   `json:"client_secret,omitempty"`
+	ClientName                            string   `json:"client_name,omitempty"`
+	ApplicationType                       string   `json:"application_type,omitempty"`
+	RedirectURIs                          []string `json:"redirect_uris,omitempty"`
+	PostLogoutRedirectURIs                []string `json:"post_logout_redirect_uris,omitempty"`
+	ResponseTypes                         []string `json:"response_types,omitempty"`
+	GrantTypes                            []string `json:"grant_types,omitempty"`
+	TokenEndpointAuthMethod               string   `json:"token_endpoint_auth_method,omitempty"`
+	TLSClientAuthSubjectDN                string   `json:"tls_client_auth_subject_dn,omitempty"`
+	BackChannelLogoutURI                  string   `json:"backchannel_logout_uri,omitempty"`
+	BackChannelTokenDeliveryMode          string   `json:"backchannel_token_delivery_mode,omitempty"`
+	BackChannelClientNotificationEndpoint string   `json:"backchannel_client_notification_endpoint,omitempty"`
+	RequirePushedAuthorizationRequests    bool     `json:"require_pushed_authorization_requests,omitempty"`
+	DPoPBoundAccessTokens                 bool     `json:"dpop_bound_access_tokens,omitempty"`
+	AccessTokenLifetime                   int64    `json:"access_token_lifetime,omitempty"`
+	IDTokenLifetime                       int64    `json:"id_token_lifetime,omitempty"`
+	RefreshTokenLifetime                  int64    `json:"refresh_token_lifetime,omitempty"`
+}
+
+// clientInformationResponse is the response of the client registration endpoint (RFC 7591, section 3.2.1)
+// and the client configuration endpoint (RFC 7592, section 3).
+type clientInformationResponse struct {
+	clientMetadata
+	ClientIDIssuedAt        int64  `json:"client_id_issued_at,omitempty"`
+	ClientSecretExpiresAt   *int64 `json:"client_secret_expires_at,omitempty"`
+	RegistrationAccessToken string `json:"registration_access_token,omitempty"`
+	RegistrationClientURI   string `json:"registration_client_uri"`
+}
+
+// clientRegistrationEndpoint returns the endpoint of the dynamic client registration endpoint,
+// defaulting to /oauth/v2/register.
+// The client configuration endpoint of each client is the registration endpoint followed by the client ID.
+func clientRegistrationEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.ClientRegistration == nil {
+		return op.NewEndpoint("/oauth/v2/register")
+	}
+	return op.NewEndpointWithURL(endpointConfig.ClientRegistration.Path, endpointConfig.ClientRegistration.URL)
+}
+
+// registerClientRegistrationHandler adds the client registration (RFC 7591)
+// and client configuration (RFC 7592) endpoints to the router of the OP.
+func (s *Server) registerClientRegistrationHandler(router chi.Router) {
+	path := s.clientRegistrationEndpoint.Relative()
+	router.Post(path, s.clientRegistrationHandler)
+	router.Get(path+"/{"+clientIDParam+"}", s.clientConfigurationHandler(s.readRegisteredClient))
+	router.Put(path+"/{"+clientIDParam+"}", s.clientConfigurationHandler(s.updateRegisteredClient))
+	router.Delete(path+"/{"+clientIDParam+"}", s.deleteRegisteredClientHandler)
+}
+
+// clientRegistrationHandler implements the client registration endpoint of
+// OAuth 2.0 Dynamic Client Registration (RFC 7591).
+// The request must be authorized with an initial access token of an organization,
+// which defines the policy the metadata of the client must comply with.
+func (s *Server) clientRegistrationHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.registerClient(r.Context(), r)
+	if err != nil {
+		writeClientRegistrationError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+// clientConfigurationHandler implements the read and update requests of the client configuration endpoint of
+// OAuth 2.0 Dynamic Client Registration Management (RFC 7592).
+// The request must be authorized with the registration access token returned on registration.
+func (s *Server) clientConfigurationHandler(handle func(ctx context.Context, r *http.Request, token, clientID string) (*clientInformationResponse, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		token, err := bearerToken(r)
+		if err != nil {
+			writeClientRegistrationError(w, r, err, s.getLogger(r.Context()))
+			return
+		}
+		resp, err := handle(r.Context(), r, token, chi.URLParam(r, clientIDParam))
+		if err != nil {
+			writeClientRegistrationError(w, r, err, s.getLogger(r.Context()))
+			return
+		}
+		httphelper.MarshalJSON(w, resp)
+	}
+}
+
+// deleteRegisteredClientHandler implements the delete request of the client configuration endpoint (RFC 7592, section 2.3).
+func (s *Server) deleteRegisteredClientHandler(w http.ResponseWriter, r *http.Request) {
+	token, err := bearerToken(r)
+	if err == nil {
+		err = s.deleteRegisteredClient(r.Context(), token, chi.URLParam(r, clientIDParam))
+	}
+	if err != nil {
+		writeClientRegistrationError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) registerClient(ctx context.Context, r *http.Request) (_ *clientInformationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	token, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	metadata, err := clientMetadataFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	app, err := clientMetadataToOIDCApp(metadata)
+	if err != nil {
+		return nil, err
+	}
+	registration, err := s.command.RegisterOIDCClient(ctx, token, app)
+	if err != nil {
+		return nil, err
+	}
+	resp := s.clientInformationResponse(ctx, registration.App)
+	resp.ClientIDIssuedAt = registration.App.ChangeDate.Unix()
+	resp.RegistrationAccessToken = registration.RegistrationAccessToken
+	if registration.App.ClientSecretString != "" {
+		resp.ClientSecret = registration.App.ClientSecretString
+		// client secrets do not expire
+		resp.ClientSecretExpiresAt = gu.Ptr[int64](0)
+	}
+	return resp, nil
+}
+
+func (s *Server) readRegisteredClient(ctx context.Context, _ *http.Request, token, clientID string) (_ *clientInformationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	app, err := s.command.RegisteredOIDCClient(ctx, token, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return s.clientInformationResponse(ctx, app), nil
+}
+
+// updateRegisteredClient replaces the metadata of the client with the metadata of the request (RFC 7592, section 2.2).
+// Omitted metadata is reset to its default value.
+func (s *Server) updateRegisteredClient(ctx context.Context, r *http.Request, token, clientID string) (_ *clientInformationResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	metadata, err := clientMetadataFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if metadata.ClientID != clientID {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match")
+	}
+	app, err := clientMetadataToOIDCApp(metadata)
+	if err != nil {
+		return nil, err
+	}
+	app.ClientID = clientID
+	// ensure omitted URIs are removed, as nil would keep the existing ones
+	if app.RedirectUris == nil {
+		app.RedirectUris = []string{}
+	}
+	if app.PostLogoutRedirectUris == nil {
+		app.PostLogoutRedirectUris = []string{}
+	}
+	app, err = s.command.UpdateRegisteredOIDCClient(ctx, token, app)
+	if err != nil {
+		return nil, err
+	}
+	return s.clientInformationResponse(ctx, app), nil
+}
+
+func (s *Server) deleteRegisteredClient(ctx context.Context, token, clientID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	return s.command.RemoveRegisteredOIDCClient(ctx, token, clientID)
+}
+
+func (s *Server) clientInformationResponse(ctx context.Context, app *domain.OIDCApp) *clientInformationResponse {
+	return &clientInformationResponse{
+		clientMetadata:        oidcAppToClientMetadata(app),
+		RegistrationClientURI: s.clientRegistrationEndpoint.Absolute(op.IssuerFromContext(ctx)) + "/" + url.PathEscape(app.ClientID),
+	}
+}
+
+func bearerToken(r *http.Request) (string, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), oidc.PrefixBearer)
+	if !ok || token == "" {
+		return "", op.NewStatusError(errInvalidToken().WithDescription("bearer token missing"), http.StatusUnauthorized)
+	}
+	return token, nil
+}
+
+func clientMetadataFromRequest(r *http.Request) (*clientMetadata, error) {
+	metadata := new(clientMetadata)
+	if err := json.NewDecoder(r.Body).Decode(metadata); err != nil {
+		return nil, errInvalidClientMetadata().WithParent(err).WithDescription("invalid client metadata")
+	}
+	return metadata, nil
+}
+
+func clientMetadataToOIDCApp(metadata *clientMetadata) (*domain.OIDCApp, error) {
+	for _, uri := range slices.Concat(metadata.RedirectURIs, metadata.PostLogoutRedirectURIs) {
+		if parsed, err := url.Parse(uri); err != nil || !parsed.IsAbs() || parsed.Fragment != "" {
+			return nil, errInvalidRedirectURI().WithDescription("invalid redirect uri %q", uri)
+		}
+	}
+	responseTypes, err := responseTypesFromOIDC(metadata.ResponseTypes)
+	if err != nil {
+		return nil, err
+	}
+	grantTypes, err := grantTypesFromOIDC(metadata.GrantTypes)
+	if err != nil {
+		return nil, err
+	}
+	authMethod, err := clientRegistrationAuthMethodFromOIDC(metadata.TokenEndpointAuthMethod)
+	if err != nil {
+		return nil, err
+	}
+	applicationType, err := applicationTypeFromOIDC(metadata.ApplicationType, authMethod)
+	if err != nil {
+		return nil, err
+	}
+	switch metadata.BackChannelTokenDeliveryMode {
+	case "", backChannelTokenDeliveryModePoll:
+		if metadata.BackChannelClientNotificationEndpoint != "" {
+			return nil, errInvalidClientMetadata().WithDescription("backchannel_client_notification_endpoint requires the ping token delivery mode")
+		}
+	case backChannelTokenDeliveryModePing:
+		if metadata.BackChannelClientNotificationEndpoint == "" {
+			return nil, errInvalidClientMetadata().WithDescription("ping token delivery mode requires a backchannel_client_notification_endpoint")
+		}
+	default:
+		return nil, errInvalidClientMetadata().WithDescription("backchannel_token_delivery_mode %q is not supported", metadata.BackChannelTokenDeliveryMode)
+	}
+	if metadata.AccessTokenLifetime < 0 || metadata.IDTokenLifetime < 0 || metadata.RefreshTokenLifetime < 0 {
+		return nil, errInvalidClientMetadata().WithDescription("token lifetimes must not be negative")
+	}
+	return &domain.OIDCApp{
+		AppName:                               metadata.ClientName,
+		RedirectUris:                          metadata.RedirectURIs,
+		ResponseTypes:                         responseTypes,
+		GrantTypes:                            grantTypes,
+		ApplicationType:                       gu.Ptr(applicationType),
+		AuthMethodType:                        gu.Ptr(authMethod),
+		PostLogoutRedirectUris:                metadata.PostLogoutRedirectURIs,
+		OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+		DevMode:                               gu.Ptr(false),
+		AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+		BackChannelLogoutURI:                  gu.Ptr(metadata.BackChannelLogoutURI),
+		RequirePushedAuthorizationRequests:    gu.Ptr(metadata.RequirePushedAuthorizationRequests),
+		RequireDPoP:                           gu.Ptr(metadata.DPoPBoundAccessTokens),
+		TLSClientAuthSubjectDN:                gu.Ptr(metadata.TLSClientAuthSubjectDN),
+		BackChannelClientNotificationEndpoint: gu.Ptr(metadata.BackChannelClientNotificationEndpoint),
+		AccessTokenLifetime:                   gu.Ptr(time.Duration(metadata.AccessTokenLifetime) * time.Second),
+		IDTokenLifetime:                       gu.Ptr(time.Duration(metadata.IDTokenLifetime) * time.Second),
+		RefreshTokenLifetime:                  gu.Ptr(time.Duration(metadata.RefreshTokenLifetime) * time.Second),
+	}, nil
+}
+
+func oidcAppToClientMetadata(app *domain.OIDCApp) clientMetadata {
+	responseTypes := make([]string, len(app.ResponseTypes))
+	for i, responseType := range app.ResponseTypes {
+		responseTypes[i] = string(responseTypeToOIDC(responseType))
+	}
+	grantTypes := make([]string, len(app.GrantTypes))
+	for i, grantType := range app.GrantTypes {
+		grantTypes[i] = string(grantTypeToOIDC(grantType))
+	}
+	applicationType := applicationTypeWeb
+	if gu.Value(app.ApplicationType) == domain.OIDCApplicationTypeNative {
+		applicationType = applicationTypeNative
+	}
+	deliveryMode := backChannelTokenDeliveryModePoll
+	if gu.Value(app.BackChannelClientNotificationEndpoint) != "" {
+		deliveryMode = backChannelTokenDeliveryModePing
+	}
+	return clientMetadata{
+		ClientID:                              app.ClientID,
+		ClientName:                            app.AppName,
+		ApplicationType:                       applicationType,
+		RedirectURIs:                          app.RedirectUris,
+		PostLogoutRedirectURIs:                app.PostLogoutRedirectUris,
+		ResponseTypes:                         responseTypes,
+		GrantTypes:                            grantTypes,
+		TokenEndpointAuthMethod:               string(authMethodToOIDC(gu.Value(app.AuthMethodType))),
+		TLSClientAuthSubjectDN:                gu.Value(app.TLSClientAuthSubjectDN),
+		BackChannelLogoutURI:                  gu.Value(app.BackChannelLogoutURI),
+		BackChannelTokenDeliveryMode:          deliveryMode,
+		BackChannelClientNotificationEndpoint: gu.Value(app.BackChannelClientNotificationEndpoint),
+		RequirePushedAuthorizationRequests:    gu.Value(app.RequirePushedAuthorizationRequests),
+		DPoPBoundAccessTokens:                 gu.Value(app.RequireDPoP),
+		AccessTokenLifetime:                   int64(gu.Value(app.AccessTokenLifetime).Seconds()),
+		IDTokenLifetime:                       int64(gu.Value(app.IDTokenLifetime).Seconds()),
+		RefreshTokenLifetime:                  int64(gu.Value(app.RefreshTokenLifetime).Seconds()),
+	}
+}
+
+// responseTypesFromOIDC maps the response types of the client metadata,
+// defaulting to code (RFC 7591, section 2).
+func responseTypesFromOIDC(responseTypes []string) ([]domain.OIDCResponseType, error) {
+	if len(responseTypes) == 0 {
+		return []domain.OIDCResponseType{domain.OIDCResponseTypeCode}, nil
+	}
+	supported := []domain.OIDCResponseType{domain.OIDCResponseTypeCode, domain.OIDCResponseTypeIDToken, domain.OIDCResponseTypeIDTokenToken}
+	types := make([]domain.OIDCResponseType, len(responseTypes))
+	for i, responseType := range responseTypes {
+		index := slices.IndexFunc(supported, func(t domain.OIDCResponseType) bool {
+			return string(responseTypeToOIDC(t)) == responseType
+		})
+		if index < 0 {
+			return nil, errInvalidClientMetadata().WithDescription("response_type %q is not supported", responseType)
+		}
+		types[i] = supported[index]
+	}
+	return types, nil
+}
+
+// grantTypesFromOIDC maps the grant types of the client metadata,
+// defaulting to authorization_code (RFC 7591, section 2).
+func grantTypesFromOIDC(grantTypes []string) ([]domain.OIDCGrantType, error) {
+	if len(grantTypes) == 0 {
+		return []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode}, nil
+	}
+	supported := []domain.OIDCGrantType{
+		domain.OIDCGrantTypeAuthorizationCode,
+		domain.OIDCGrantTypeImplicit,
+		domain.OIDCGrantTypeRefreshToken,
+		domain.OIDCGrantTypeDeviceCode,
+		domain.OIDCGrantTypeTokenExchange,
+		domain.OIDCGrantTypeCIBA,
+	}
+	types := make([]domain.OIDCGrantType, len(grantTypes))
+	for i, grantType := range grantTypes {
+		index := slices.IndexFunc(supported, func(t domain.OIDCGrantType) bool {
+			return string(grantTypeToOIDC(t)) == grantType
+		})
+		if index < 0 {
+			return nil, errInvalidClientMetadata().WithDescription("grant_type %q is not supported", grantType)
+		}
+		types[i] = supported[index]
+	}
+	return types, nil
+}
+
+// clientRegistrationAuthMethodFromOIDC maps the token endpoint auth method of the client metadata,
+// defaulting to client_secret_basic (RFC 7591, section 2).
+// Methods based on keys of the client (private_key_jwt and self_signed_tls_client_auth) are not supported,
+// as the keys must be managed through the API.
+func clientRegistrationAuthMethodFromOIDC(authMethod string) (domain.OIDCAuthMethodType, error) {
+	switch oidc.AuthMethod(authMethod) {
+	case "", oidc.AuthMethodBasic:
+		return domain.OIDCAuthMethodTypeBasic, nil
+	case oidc.AuthMethodPost:
+		return domain.OIDCAuthMethodTypePost, nil
+	case oidc.AuthMethodNone:
+		return domain.OIDCAuthMethodTypeNone, nil
+	case authMethodTLSClientAuth:
+		return domain.OIDCAuthMethodTypeTLSClientAuth, nil
+	default:
+		return 0, errInvalidClientMetadata().WithDescription("token_endpoint_auth_method %q is not supported", authMethod)
+	}
+}
+
+// applicationTypeFromOIDC maps the application type of the client metadata, defaulting to web.
+// Web applications without client authentication are considered user agent (browser based) applications.
+func applicationTypeFromOIDC(applicationType string, authMethod domain.OIDCAuthMethodType) (domain.OIDCApplicationType, error) {
+	switch applicationType {
+	case "", applicationTypeWeb:
+		if authMethod == domain.OIDCAuthMethodTypeNone {
+			return domain.OIDCApplicationTypeUserAgent, nil
+		}
+		return domain.OIDCApplicationTypeWeb, nil
+	case applicationTypeNative:
+		return domain.OIDCApplicationTypeNative, nil
+	default:
+		return 0, errInvalidClientMetadata().WithDescription("application_type %q is not supported", applicationType)
+	}
+}
+
+func errInvalidClientMetadata() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: clientRegistrationErrorInvalidClientMetadata,
+	}
+}
+
+func errInvalidRedirectURI() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: clientRegistrationErrorInvalidRedirectURI,
+	}
+}
+
+func errInvalidToken() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: errorInvalidToken,
+	}
+}
+
+// writeClientRegistrationError maps the errors of the commands to the error types of RFC 7591 and RFC 7592.
+// An invalid token is returned with status 401 (RFC 6750), which includes unknown clients (RFC 7592, section 2.1).
+func writeClientRegistrationError(w http.ResponseWriter, r *http.Request, err error, logger *slog.Logger) {
+	switch {
+	case errors.Is(err, zerrors.ThrowInvalidArgument(nil, "", "Errors.ClientRegistration.RedirectURINotAllowed")):
+		err = errInvalidRedirectURI().WithParent(err).WithDescription("redirect uri not allowed")
+	case errors.Is(err, zerrors.ThrowInvalidArgument(nil, "", "Errors.ClientRegistration.TokenLifetimeNotAllowed")):
+		err = errInvalidClientMetadata().WithParent(err).WithDescription("token lifetime not allowed")
+	case zerrors.IsErrorInvalidArgument(err), zerrors.IsErrorAlreadyExists(err):
+		err = errInvalidClientMetadata().WithParent(err).WithDescription("invalid client metadata")
+	case zerrors.IsUnauthenticated(err):
+		err = op.NewStatusError(errInvalidToken().WithParent(err).WithDescription("invalid token"), http.StatusUnauthorized)
+	default:
+		err = oidcError(err)
+	}
+	op.WriteError(w, r, err, logger)
+}
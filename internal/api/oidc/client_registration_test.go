@@ -0,0 +1,192 @@
+package oidc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func Test_clientMetadataToOIDCApp(t *testing.T) {
+	tests := []struct {
+		name          string
+		metadata      *clientMetadata
+		want          *domain.OIDCApp
+		wantErrorType string
+	}{
+		{
+			name: "defaults",
+			metadata: &clientMetadata{
+				RedirectURIs: []string{"https://example.com/callback"},
+			},
+			want: &domain.OIDCApp{
+				RedirectUris:                          []string{"https://example.com/callback"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+				AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeBasic),
+				OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+				DevMode:                               gu.Ptr(false),
+				AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+				BackChannelLogoutURI:                  gu.Ptr(""),
+				RequirePushedAuthorizationRequests:    gu.Ptr(false),
+				RequireDPoP:                           gu.Ptr(false),
+				TLSClientAuthSubjectDN:                gu.Ptr(""),
+				BackChannelClientNotificationEndpoint: gu.Ptr(""),
+				AccessTokenLifetime:                   gu.Ptr(time.Duration(0)),
+				IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+				RefreshTokenLifetime:                  gu.Ptr(time.Duration(0)),
+			},
+		},
+		{
+			name: "public native client",
+			metadata: &clientMetadata{
+				ClientName:              "app",
+				ApplicationType:         applicationTypeNative,
+				RedirectURIs:            []string{"com.example.app:/callback"},
+				GrantTypes:              []string{"authorization_code", "refresh_token"},
+				TokenEndpointAuthMethod: "none",
+				DPoPBoundAccessTokens:   true,
+				AccessTokenLifetime:     300,
+				RefreshTokenLifetime:    86400,
+			},
+			want: &domain.OIDCApp{
+				AppName:                               "app",
+				RedirectUris:                          []string{"com.example.app:/callback"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeRefreshToken},
+				ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeNative),
+				AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeNone),
+				OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+				DevMode:                               gu.Ptr(false),
+				AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+				BackChannelLogoutURI:                  gu.Ptr(""),
+				RequirePushedAuthorizationRequests:    gu.Ptr(false),
+				RequireDPoP:                           gu.Ptr(true),
+				TLSClientAuthSubjectDN:                gu.Ptr(""),
+				BackChannelClientNotificationEndpoint: gu.Ptr(""),
+				AccessTokenLifetime:                   gu.Ptr(5 * time.Minute),
+				IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+				RefreshTokenLifetime:                  gu.Ptr(24 * time.Hour),
+			},
+		},
+		{
+			name: "public web client is user agent",
+			metadata: &clientMetadata{
+				RedirectURIs:            []string{"https://example.com/callback"},
+				TokenEndpointAuthMethod: "none",
+			},
+			want: &domain.OIDCApp{
+				RedirectUris:                          []string{"https://example.com/callback"},
+				ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeUserAgent),
+				AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeNone),
+				OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+				DevMode:                               gu.Ptr(false),
+				AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+				BackChannelLogoutURI:                  gu.Ptr(""),
+				RequirePushedAuthorizationRequests:    gu.Ptr(false),
+				RequireDPoP:                           gu.Ptr(false),
+				TLSClientAuthSubjectDN:                gu.Ptr(""),
+				BackChannelClientNotificationEndpoint: gu.Ptr(""),
+				AccessTokenLifetime:                   gu.Ptr(time.Duration(0)),
+				IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+				RefreshTokenLifetime:                  gu.Ptr(time.Duration(0)),
+			},
+		},
+		{
+			name: "relative redirect uri",
+			metadata: &clientMetadata{
+				RedirectURIs: []string{"/callback"},
+			},
+			wantErrorType: clientRegistrationErrorInvalidRedirectURI,
+		},
+		{
+			name: "redirect uri with fragment",
+			metadata: &clientMetadata{
+				RedirectURIs: []string{"https://example.com/callback#fragment"},
+			},
+			wantErrorType: clientRegistrationErrorInvalidRedirectURI,
+		},
+		{
+			name: "unsupported grant type",
+			metadata: &clientMetadata{
+				RedirectURIs: []string{"https://example.com/callback"},
+				GrantTypes:   []string{"password"},
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name: "unsupported auth method",
+			metadata: &clientMetadata{
+				RedirectURIs:            []string{"https://example.com/callback"},
+				TokenEndpointAuthMethod: "private_key_jwt",
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name: "negative token lifetime",
+			metadata: &clientMetadata{
+				RedirectURIs:        []string{"https://example.com/callback"},
+				AccessTokenLifetime: -1,
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+		{
+			name: "ping mode without notification endpoint",
+			metadata: &clientMetadata{
+				RedirectURIs:                 []string{"https://example.com/callback"},
+				BackChannelTokenDeliveryMode: backChannelTokenDeliveryModePing,
+			},
+			wantErrorType: clientRegistrationErrorInvalidClientMetadata,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := clientMetadataToOIDCApp(tt.metadata)
+			if tt.wantErrorType != "" {
+				var oidcErr *oidc.Error
+				require.ErrorAs(t, err, &oidcErr)
+				assert.Equal(t, tt.wantErrorType, string(oidcErr.ErrorType))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_oidcAppToClientMetadata(t *testing.T) {
+	app := &domain.OIDCApp{
+		AppName:                               "app",
+		ClientID:                              "clientID",
+		RedirectUris:                          []string{"https://example.com/callback"},
+		ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+		GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeCIBA},
+		ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+		AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeTLSClientAuth),
+		TLSClientAuthSubjectDN:                gu.Ptr("CN=client"),
+		BackChannelClientNotificationEndpoint: gu.Ptr("https://example.com/notify"),
+		AccessTokenLifetime:                   gu.Ptr(time.Hour),
+	}
+	want := clientMetadata{
+		ClientID:                              "clientID",
+		ClientName:                            "app",
+		ApplicationType:                       applicationTypeWeb,
+		RedirectURIs:                          []string{"https://example.com/callback"},
+		ResponseTypes:                         []string{"code"},
+		GrantTypes:                            []string{"authorization_code", string(grantTypeCIBA)},
+		TokenEndpointAuthMethod:               string(authMethodTLSClientAuth),
+		TLSClientAuthSubjectDN:                "CN=client",
+		BackChannelTokenDeliveryMode:          backChannelTokenDeliveryModePing,
+		BackChannelClientNotificationEndpoint: "https://example.com/notify",
+		AccessTokenLifetime:                   3600,
+	}
+	assert.Equal(t, want, oidcAppToClientMetadata(app))
+}
@@ -0,0 +1,580 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-jose/go-jose/v4"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	// CredentialIssuerMetadataEndpoint is the path of the credential issuer metadata (OpenID4VCI, section 12.2).
+	CredentialIssuerMetadataEndpoint = "/.well-known/openid-credential-issuer"
+	// ScopeIdentityCredential is the scope a wallet requests to obtain an access token for the identity credential.
+	ScopeIdentityCredential = "urn:zitadel:iam:credential:identity"
+
+	CredentialDefaultLifetime      = 30 * 24 * time.Hour
+	CredentialNonceDefaultLifetime = 5 * time.Minute
+	CredentialDefaultVCT           = "urn:zitadel:vct:identity"
+
+	identityCredentialConfigurationID = "identity_credential"
+	sdJWTVCFormat                     = "dc+sd-jwt"
+	sdJWTHashAlgorithm                = "sha-256"
+	sdJWTSeparator                    = "~"
+	credentialProofJWTType            = "openid4vci-proof+jwt"
+	credentialProofTypeJWT            = "jwt"
+	credentialBindingMethodJWK        = "jwk"
+	credentialNoncePrefix             = "c_nonce."
+
+	claimIssuer        = "iss"
+	claimSubject       = "sub"
+	claimIssuedAt      = "iat"
+	claimExpiration    = "exp"
+	claimEmail         = "email"
+	claimPhoneNumber   = "phone_number"
+	claimMetadata      = "metadata"
+	claimSD            = "_sd"
+	claimSDAlg         = "_sd_alg"
+	claimVCT           = "vct"
+	claimConfirmation  = confirmationClaim
+	confirmationJWKKey = "jwk"
+
+	// error types of the credential endpoint, defined in OpenID4VCI, section 8.3.1.2
+	credentialErrorInvalidRequest       = "invalid_credential_request"
+	credentialErrorUnknownConfiguration = "unknown_credential_configuration"
+	credentialErrorInvalidProof         = "invalid_proof"
+	credentialErrorInvalidNonce         = "invalid_nonce"
+	// errorInsufficientScope is the error type of a token without the required scope, defined in RFC 6750, section 3.1
+	errorInsufficientScope = "insufficient_scope"
+)
+
+// CredentialIssuerConfig configures the issuance of verifiable credentials
+// through OpenID for Verifiable Credential Issuance (OpenID4VCI).
+type CredentialIssuerConfig struct {
+	// Enabled registers the credential issuer metadata, credential and nonce endpoints.
+	Enabled bool
+	// VCT is the verifiable credential type of the issued SD-JWT VC.
+	VCT string
+	// Lifetime of the issued credentials.
+	Lifetime time.Duration
+	// NonceLifetime is the lifetime of a c_nonce returned by the nonce endpoint.
+	NonceLifetime time.Duration
+	// MetadataKeys are the keys of the user metadata included in the credential.
+	MetadataKeys []string
+	// ClientIDs are the clients allowed to request the identity credential scope.
+	ClientIDs []string
+	// ProjectIDs are the projects, whose clients are allowed to request the identity credential scope.
+	ProjectIDs []string
+}
+
+// withDefaults returns a copy of the config with sane defaults for empty values.
+// Safe to call when c is nil, which results in a disabled credential issuer.
+func (c *CredentialIssuerConfig) withDefaults() CredentialIssuerConfig {
+	config := CredentialIssuerConfig{
+		VCT:           CredentialDefaultVCT,
+		Lifetime:      CredentialDefaultLifetime,
+		NonceLifetime: CredentialNonceDefaultLifetime,
+	}
+	if c == nil {
+		return config
+	}
+	config.Enabled = c.Enabled
+	config.MetadataKeys = c.MetadataKeys
+	config.ClientIDs = c.ClientIDs
+	config.ProjectIDs = c.ProjectIDs
+	if c.VCT != "" {
+		config.VCT = c.VCT
+	}
+	if c.Lifetime != 0 {
+		config.Lifetime = c.Lifetime
+	}
+	if c.NonceLifetime != 0 {
+		config.NonceLifetime = c.NonceLifetime
+	}
+	return config
+}
+
+// allowsClient returns true if the credential issuer is enabled
+// and the client or its project opted in to request the identity credential scope.
+func (c *CredentialIssuerConfig) allowsClient(client *query.OIDCClient) bool {
+	if !c.Enabled {
+		return false
+	}
+	return slices.Contains(c.ClientIDs, client.ClientID) || slices.Contains(c.ProjectIDs, client.ProjectID)
+}
+
+// credentialIssuerMetadata is the metadata of the credential issuer defined in OpenID4VCI, section 12.2.4.
+type credentialIssuerMetadata struct {
+	CredentialIssuer                  string                                     `json:"credential_issuer"`
+	CredentialEndpoint                string                                     `json:"credential_endpoint"`
+	NonceEndpoint                     string                                     `json:"nonce_endpoint"`
+	CredentialConfigurationsSupported map[string]*credentialConfigurationSupport `json:"credential_configurations_supported"`
+}
+
+type credentialConfigurationSupport struct {
+	Format                               string                                 `json:"format"`
+	Scope                                string                                 `json:"scope"`
+	VCT                                  string                                 `json:"vct"`
+	CryptographicBindingMethodsSupported []string                               `json:"cryptographic_binding_methods_supported"`
+	CredentialSigningAlgValuesSupported  []string                               `json:"credential_signing_alg_values_supported"`
+	ProofTypesSupported                  map[string]*credentialProofTypeSupport `json:"proof_types_supported"`
+	Claims                               []*credentialClaimDescription          `json:"claims"`
+}
+
+type credentialProofTypeSupport struct {
+	ProofSigningAlgValuesSupported []string `json:"proof_signing_alg_values_supported"`
+}
+
+type credentialClaimDescription struct {
+	Path []string `json:"path"`
+}
+
+// credentialRequest is the request of the credential endpoint defined in OpenID4VCI, section 8.2.
+// The single proof parameter of earlier drafts is accepted as well.
+type credentialRequest struct {
+	CredentialConfigurationID string            `json:"credential_configuration_id"`
+	Proof                     *credentialProof  `json:"proof,omitempty"`
+	Proofs                    *credentialProofs `json:"proofs,omitempty"`
+}
+
+type credentialProof struct {
+	ProofType string `json:"proof_type"`
+	JWT       string `json:"jwt"`
+}
+
+type credentialProofs struct {
+	JWT []string `json:"jwt"`
+}
+
+type credentialResponse struct {
+	Credentials []*issuedCredential `json:"credentials"`
+}
+
+type issuedCredential struct {
+	Credential string `json:"credential"`
+}
+
+type credentialNonceResponse struct {
+	CNonce string `json:"c_nonce"`
+}
+
+type credentialProofClaims struct {
+	Issuer   string        `json:"iss,omitempty"`
+	Audience oidc.Audience `json:"aud"`
+	IssuedAt oidc.Time     `json:"iat"`
+	Nonce    string        `json:"nonce"`
+}
+
+// credentialEndpoint returns the endpoint of the credential endpoint,
+// defaulting to /oidc/v1/credential.
+func credentialEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.Credential == nil {
+		return op.NewEndpoint("/oidc/v1/credential")
+	}
+	return op.NewEndpointWithURL(endpointConfig.Credential.Path, endpointConfig.Credential.URL)
+}
+
+// credentialNonceEndpoint returns the endpoint of the nonce endpoint of the credential issuer,
+// defaulting to /oidc/v1/credential_nonce.
+func credentialNonceEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.CredentialNonce == nil {
+		return op.NewEndpoint("/oidc/v1/credential_nonce")
+	}
+	return op.NewEndpointWithURL(endpointConfig.CredentialNonce.Path, endpointConfig.CredentialNonce.URL)
+}
+
+// registerCredentialIssuerHandler adds the credential issuer metadata, credential and nonce endpoints
+// of OpenID4VCI to the router of the OP, if the credential issuer is enabled.
+func (s *Server) registerCredentialIssuerHandler(router chi.Router) {
+	if !s.credentialIssuer.Enabled {
+		return
+	}
+	router.Get(CredentialIssuerMetadataEndpoint, s.credentialIssuerMetadataHandler)
+	router.Post(s.credentialEndpoint.Relative(), s.credentialHandler)
+	router.Post(s.credentialNonceEndpoint.Relative(), s.credentialNonceHandler)
+}
+
+func (s *Server) credentialIssuerMetadataHandler(w http.ResponseWriter, r *http.Request) {
+	httphelper.MarshalJSON(w, s.createCredentialIssuerMetadata(r.Context()))
+}
+
+func (s *Server) createCredentialIssuerMetadata(ctx context.Context) *credentialIssuerMetadata {
+	issuer := op.IssuerFromContext(ctx)
+	claims := []*credentialClaimDescription{
+		{Path: []string{claimEmail}},
+		{Path: []string{claimPhoneNumber}},
+	}
+	for _, key := range s.credentialIssuer.MetadataKeys {
+		claims = append(claims, &credentialClaimDescription{Path: []string{claimMetadata, key}})
+	}
+	return &credentialIssuerMetadata{
+		CredentialIssuer:   issuer,
+		CredentialEndpoint: s.credentialEndpoint.Absolute(issuer),
+		NonceEndpoint:      s.credentialNonceEndpoint.Absolute(issuer),
+		CredentialConfigurationsSupported: map[string]*credentialConfigurationSupport{
+			identityCredentialConfigurationID: {
+				Format:                               sdJWTVCFormat,
+				Scope:                                ScopeIdentityCredential,
+				VCT:                                  s.credentialIssuer.VCT,
+				CryptographicBindingMethodsSupported: []string{credentialBindingMethodJWK},
+				CredentialSigningAlgValuesSupported:  supportedSigningAlgs(),
+				ProofTypesSupported: map[string]*credentialProofTypeSupport{
+					credentialProofTypeJWT: {ProofSigningAlgValuesSupported: dpopSigningAlgValuesSupported()},
+				},
+				Claims: claims,
+			},
+		},
+	}
+}
+
+// credentialNonceHandler implements the nonce endpoint of OpenID4VCI, section 7.
+// The c_nonce is stateless: it contains its expiry encrypted with the key of the OP.
+func (s *Server) credentialNonceHandler(w http.ResponseWriter, r *http.Request) {
+	nonce, err := s.opCrypto.Encrypt(credentialNoncePrefix + strconv.FormatInt(time.Now().Add(s.credentialIssuer.NonceLifetime).Unix(), 10))
+	if err != nil {
+		op.WriteError(w, r, oidcError(zerrors.ThrowInternal(err, "OIDC-ooY5e", "Errors.Internal")), s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSON(w, &credentialNonceResponse{CNonce: nonce})
+}
+
+// verifyCredentialNonce checks that the nonce was issued by the nonce endpoint and is not expired.
+func verifyCredentialNonce(crypto op.Crypto, nonce string, now time.Time) error {
+	decrypted, err := crypto.Decrypt(nonce)
+	if err != nil {
+		return errInvalidNonce().WithParent(err).WithDescription("invalid c_nonce")
+	}
+	expiry, ok := strings.CutPrefix(decrypted, credentialNoncePrefix)
+	if !ok {
+		return errInvalidNonce().WithDescription("invalid c_nonce")
+	}
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return errInvalidNonce().WithParent(err).WithDescription("invalid c_nonce")
+	}
+	if now.After(time.Unix(unix, 0)) {
+		return errInvalidNonce().WithDescription("c_nonce expired")
+	}
+	return nil
+}
+
+// credentialHandler implements the credential endpoint of OpenID4VCI, section 8.
+// The wallet presents an access token with the identity credential scope and a proof of possession
+// of the key the credential is bound to for each credential.
+// The issued SD-JWT VC contains the verified email and phone number and the configured metadata of the user
+// as selectively disclosable claims.
+func (s *Server) credentialHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.issueCredentials(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSON(w, resp)
+}
+
+func (s *Server) issueCredentials(ctx context.Context, r *http.Request) (_ *credentialResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	rawToken, err := bearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+	token, err := s.verifyAccessToken(ctx, rawToken)
+	if err != nil {
+		return nil, op.NewStatusError(errInvalidToken().WithParent(err).WithDescription("access token invalid"), http.StatusUnauthorized)
+	}
+	if err = s.verifyDPoPBoundAccessToken(ctx, token, rawToken, r.Method, r.Header, s.credentialEndpoint); err != nil {
+		return nil, op.NewStatusError(err, http.StatusUnauthorized)
+	}
+	if err = verifyCertificateBoundAccessToken(ctx, token); err != nil {
+		return nil, op.NewStatusError(err, http.StatusUnauthorized)
+	}
+	if !slices.Contains(token.scope, ScopeIdentityCredential) {
+		return nil, op.NewStatusError(&oidc.Error{ErrorType: errorInsufficientScope}, http.StatusForbidden)
+	}
+
+	request := new(credentialRequest)
+	if err = json.NewDecoder(r.Body).Decode(request); err != nil {
+		return nil, errInvalidCredentialRequest().WithParent(err).WithDescription("malformed credential request")
+	}
+	if request.CredentialConfigurationID != identityCredentialConfigurationID {
+		return nil, errUnknownCredentialConfiguration()
+	}
+	proofs, err := request.proofJWTs()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	issuer := op.IssuerFromContext(ctx)
+	holderKeys := make([]*jose.JSONWebKey, len(proofs))
+	for i, proof := range proofs {
+		holderKeys[i], err = parseCredentialProof(proof, issuer, now, s.dpopProofLifetime, func(nonce string) error {
+			return verifyCredentialNonce(s.opCrypto, nonce, now)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	userInfo, err := s.query.GetOIDCUserInfo(ctx, token.userID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if userInfo.User.State != domain.UserStateActive {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDC-Xee5u", "Errors.User.NotActive")
+	}
+	webKey, err := s.query.GetActiveSigningWebKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	disclosures := identityCredentialDisclosures(userInfo.User, userInfo.Metadata, s.credentialIssuer.MetadataKeys)
+	resp := &credentialResponse{Credentials: make([]*issuedCredential, len(holderKeys))}
+	for i, holderKey := range holderKeys {
+		credential, err := createSDJWTVC(webKey, &sdJWTVCClaims{
+			issuer:      issuer,
+			subject:     userInfo.User.ID,
+			vct:         s.credentialIssuer.VCT,
+			issuedAt:    now,
+			expiration:  now.Add(s.credentialIssuer.Lifetime),
+			holderKey:   holderKey,
+			disclosures: disclosures,
+		})
+		if err != nil {
+			return nil, err
+		}
+		resp.Credentials[i] = &issuedCredential{Credential: credential}
+	}
+	return resp, nil
+}
+
+// proofJWTs returns the proof JWTs of the request, at least one is required.
+func (r *credentialRequest) proofJWTs() ([]string, error) {
+	if r.Proof != nil && r.Proofs != nil {
+		return nil, errInvalidCredentialRequest().WithDescription("proof and proofs must not be used together")
+	}
+	if r.Proofs != nil {
+		if len(r.Proofs.JWT) == 0 {
+			return nil, errInvalidProof().WithDescription("proof missing")
+		}
+		return r.Proofs.JWT, nil
+	}
+	if r.Proof == nil || r.Proof.JWT == "" {
+		return nil, errInvalidProof().WithDescription("proof missing")
+	}
+	if r.Proof.ProofType != credentialProofTypeJWT {
+		return nil, errInvalidProof().WithDescription("unsupported proof type")
+	}
+	return []string{r.Proof.JWT}, nil
+}
+
+// parseCredentialProof validates a JWT proof of possession (OpenID4VCI, appendix F.1) and returns the public key
+// of its header, which the credential is bound to.
+// The audience must be the credential issuer and the iat must be within the lifetime from now.
+func parseCredentialProof(proof, issuer string, now time.Time, lifetime time.Duration, verifyNonce func(string) error) (*jose.JSONWebKey, error) {
+	jws, err := jose.ParseSignedCompact(proof, dpopSigningAlgs)
+	if err != nil {
+		return nil, errInvalidProof().WithParent(err).WithDescription("malformed proof")
+	}
+	if len(jws.Signatures) != 1 {
+		return nil, errInvalidProof().WithDescription("proof must have exactly one signature")
+	}
+	header := jws.Signatures[0].Header
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != credentialProofJWTType {
+		return nil, errInvalidProof().WithDescription("invalid proof typ")
+	}
+	key := header.JSONWebKey
+	if key == nil || !key.Valid() || !key.IsPublic() {
+		return nil, errInvalidProof().WithDescription("proof must contain a public jwk")
+	}
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return nil, errInvalidProof().WithParent(err).WithDescription("invalid proof signature")
+	}
+	claims := new(credentialProofClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return nil, errInvalidProof().WithParent(err).WithDescription("malformed proof claims")
+	}
+	if !slices.Contains(claims.Audience, issuer) {
+		return nil, errInvalidProof().WithDescription("proof aud does not match the credential issuer")
+	}
+	issuedAt := claims.IssuedAt.AsTime()
+	if issuedAt.Before(now.Add(-lifetime)) || issuedAt.After(now.Add(lifetime)) {
+		return nil, errInvalidProof().WithDescription("proof iat is outside the acceptable window")
+	}
+	if claims.Nonce == "" {
+		return nil, errInvalidNonce().WithDescription("c_nonce missing")
+	}
+	if err = verifyNonce(claims.Nonce); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// sdJWTDisclosure is a selectively disclosable claim of an SD-JWT (RFC 9901, section 4.2).
+// Claims of the metadata are disclosed within the metadata object.
+type sdJWTDisclosure struct {
+	name     string
+	value    any
+	metadata bool
+}
+
+// identityCredentialDisclosures returns the claims of the identity credential:
+// the email and phone number of a human user if they are verified and the values of the metadata keys.
+func identityCredentialDisclosures(user *query.User, metadata []query.UserMetadata, metadataKeys []string) []*sdJWTDisclosure {
+	disclosures := make([]*sdJWTDisclosure, 0, 2+len(metadataKeys))
+	if user.Human != nil {
+		if user.Human.IsEmailVerified && user.Human.Email != "" {
+			disclosures = append(disclosures, &sdJWTDisclosure{name: claimEmail, value: string(user.Human.Email)})
+		}
+		if user.Human.IsPhoneVerified && user.Human.Phone != "" {
+			disclosures = append(disclosures, &sdJWTDisclosure{name: claimPhoneNumber, value: string(user.Human.Phone)})
+		}
+	}
+	for _, md := range metadata {
+		if slices.Contains(metadataKeys, md.Key) {
+			disclosures = append(disclosures, &sdJWTDisclosure{name: md.Key, value: string(md.Value), metadata: true})
+		}
+	}
+	return disclosures
+}
+
+type sdJWTVCClaims struct {
+	issuer      string
+	subject     string
+	vct         string
+	issuedAt    time.Time
+	expiration  time.Time
+	holderKey   *jose.JSONWebKey
+	disclosures []*sdJWTDisclosure
+}
+
+// createSDJWTVC creates an SD-JWT VC (draft-ietf-oauth-sd-jwt-vc) signed with the web key of the instance.
+// Each disclosure gets a new salt, so the digests of credentials of the same user cannot be correlated.
+// The result is the issuer-signed JWT followed by the encoded disclosures, each terminated by a tilde.
+func createSDJWTVC(webKey *jose.JSONWebKey, claims *sdJWTVCClaims) (string, error) {
+	encoded := make([]string, len(claims.disclosures))
+	digests := make([]string, 0, len(claims.disclosures))
+	metadataDigests := make([]string, 0, len(claims.disclosures))
+	for i, disclosure := range claims.disclosures {
+		var digest string
+		var err error
+		encoded[i], digest, err = encodeDisclosure(disclosure.name, disclosure.value)
+		if err != nil {
+			return "", err
+		}
+		if disclosure.metadata {
+			metadataDigests = append(metadataDigests, digest)
+			continue
+		}
+		digests = append(digests, digest)
+	}
+	// the digests are sorted, so their order does not reveal the order of the claims
+	slices.Sort(digests)
+	slices.Sort(metadataDigests)
+
+	payload := map[string]any{
+		claimIssuer:       claims.issuer,
+		claimSubject:      claims.subject,
+		claimVCT:          claims.vct,
+		claimIssuedAt:     claims.issuedAt.Unix(),
+		claimExpiration:   claims.expiration.Unix(),
+		claimConfirmation: map[string]any{confirmationJWKKey: claims.holderKey},
+		claimSDAlg:        sdJWTHashAlgorithm,
+	}
+	if len(digests) > 0 {
+		payload[claimSD] = digests
+	}
+	if len(metadataDigests) > 0 {
+		payload[claimMetadata] = map[string]any{claimSD: metadataDigests}
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "OIDC-Ohz3a", "Errors.Internal")
+	}
+	signer, err := jose.NewSigner(
+		jose.SigningKey{
+			Algorithm: jose.SignatureAlgorithm(webKey.Algorithm),
+			Key:       webKey,
+		},
+		(&jose.SignerOptions{}).WithType(sdJWTVCFormat),
+	)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "OIDC-Ia7ai", "Errors.Internal")
+	}
+	jws, err := signer.Sign(data)
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "OIDC-Eeth3", "Errors.Internal")
+	}
+	jwt, err := jws.CompactSerialize()
+	if err != nil {
+		return "", zerrors.ThrowInternal(err, "OIDC-ooS5i", "Errors.Internal")
+	}
+	var credential strings.Builder
+	credential.WriteString(jwt)
+	credential.WriteString(sdJWTSeparator)
+	for _, disclosure := range encoded {
+		credential.WriteString(disclosure)
+		credential.WriteString(sdJWTSeparator)
+	}
+	return credential.String(), nil
+}
+
+// encodeDisclosure returns the base64url encoded disclosure [salt, name, value] and its SHA-256 digest.
+func encodeDisclosure(name string, value any) (encoded, digest string, err error) {
+	salt := make([]byte, 16)
+	if _, err = rand.Read(salt); err != nil {
+		return "", "", zerrors.ThrowInternal(err, "OIDC-Ahv4o", "Errors.Internal")
+	}
+	data, err := json.Marshal([]any{base64.RawURLEncoding.EncodeToString(salt), name, value})
+	if err != nil {
+		return "", "", zerrors.ThrowInternal(err, "OIDC-Aex9o", "Errors.Internal")
+	}
+	encoded = base64.RawURLEncoding.EncodeToString(data)
+	hash := sha256.Sum256([]byte(encoded))
+	return encoded, base64.RawURLEncoding.EncodeToString(hash[:]), nil
+}
+
+func errInvalidCredentialRequest() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: credentialErrorInvalidRequest,
+	}
+}
+
+func errUnknownCredentialConfiguration() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: credentialErrorUnknownConfiguration,
+	}
+}
+
+func errInvalidProof() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: credentialErrorInvalidProof,
+	}
+}
+
+func errInvalidNonce() *oidc.Error {
+	return &oidc.Error{
+		ErrorType: credentialErrorInvalidNonce,
+	}
+}
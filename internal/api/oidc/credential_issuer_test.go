@@ -0,0 +1,422 @@
+package oidc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func createCredentialProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims *credentialProofClaims) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{EmbedJWK: true}).WithType(jose.ContentType(typ)),
+	)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
+
+func TestCredentialIssuerConfig_withDefaults(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *CredentialIssuerConfig
+		want   CredentialIssuerConfig
+	}{
+		{
+			name:   "nil",
+			config: nil,
+			want: CredentialIssuerConfig{
+				VCT:           CredentialDefaultVCT,
+				Lifetime:      CredentialDefaultLifetime,
+				NonceLifetime: CredentialNonceDefaultLifetime,
+			},
+		},
+		{
+			name: "configured",
+			config: &CredentialIssuerConfig{
+				Enabled:       true,
+				VCT:           "https://example.com/vct",
+				Lifetime:      time.Hour,
+				NonceLifetime: time.Minute,
+				MetadataKeys:  []string{"department"},
+				ClientIDs:     []string{"clientID"},
+				ProjectIDs:    []string{"projectID"},
+			},
+			want: CredentialIssuerConfig{
+				Enabled:       true,
+				VCT:           "https://example.com/vct",
+				Lifetime:      time.Hour,
+				NonceLifetime: time.Minute,
+				MetadataKeys:  []string{"department"},
+				ClientIDs:     []string{"clientID"},
+				ProjectIDs:    []string{"projectID"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.withDefaults())
+		})
+	}
+}
+
+func TestCredentialIssuerConfig_allowsClient(t *testing.T) {
+	client := &query.OIDCClient{
+		ClientID:  "clientID",
+		ProjectID: "projectID",
+	}
+	tests := []struct {
+		name   string
+		config *CredentialIssuerConfig
+		want   bool
+	}{
+		{
+			name: "disabled",
+			config: &CredentialIssuerConfig{
+				ClientIDs:  []string{"clientID"},
+				ProjectIDs: []string{"projectID"},
+			},
+			want: false,
+		},
+		{
+			name: "not opted in",
+			config: &CredentialIssuerConfig{
+				Enabled:    true,
+				ClientIDs:  []string{"otherClientID"},
+				ProjectIDs: []string{"otherProjectID"},
+			},
+			want: false,
+		},
+		{
+			name: "client opted in",
+			config: &CredentialIssuerConfig{
+				Enabled:   true,
+				ClientIDs: []string{"clientID"},
+			},
+			want: true,
+		},
+		{
+			name: "project opted in",
+			config: &CredentialIssuerConfig{
+				Enabled:    true,
+				ProjectIDs: []string{"projectID"},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.allowsClient(client))
+		})
+	}
+}
+
+func Test_verifyCredentialNonce(t *testing.T) {
+	crypto := op.NewAESCrypto([32]byte{1, 2, 3})
+	now := time.Now()
+	valid, err := crypto.Encrypt(credentialNoncePrefix + strconv.FormatInt(now.Add(time.Minute).Unix(), 10))
+	require.NoError(t, err)
+	expired, err := crypto.Encrypt(credentialNoncePrefix + strconv.FormatInt(now.Add(-time.Minute).Unix(), 10))
+	require.NoError(t, err)
+	accessToken, err := crypto.Encrypt("tokenID:userID")
+	require.NoError(t, err)
+
+	assert.NoError(t, verifyCredentialNonce(crypto, valid, now))
+	assert.Error(t, verifyCredentialNonce(crypto, expired, now))
+	assert.Error(t, verifyCredentialNonce(crypto, accessToken, now))
+	assert.Error(t, verifyCredentialNonce(crypto, "foo", now))
+}
+
+func Test_credentialRequest_proofJWTs(t *testing.T) {
+	tests := []struct {
+		name    string
+		request *credentialRequest
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "no proof",
+			request: &credentialRequest{},
+			wantErr: true,
+		},
+		{
+			name: "proof",
+			request: &credentialRequest{
+				Proof: &credentialProof{ProofType: credentialProofTypeJWT, JWT: "jwt"},
+			},
+			want: []string{"jwt"},
+		},
+		{
+			name: "unsupported proof type",
+			request: &credentialRequest{
+				Proof: &credentialProof{ProofType: "ldp_vp", JWT: "jwt"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "proofs",
+			request: &credentialRequest{
+				Proofs: &credentialProofs{JWT: []string{"jwt1", "jwt2"}},
+			},
+			want: []string{"jwt1", "jwt2"},
+		},
+		{
+			name: "proof and proofs",
+			request: &credentialRequest{
+				Proof:  &credentialProof{ProofType: credentialProofTypeJWT, JWT: "jwt"},
+				Proofs: &credentialProofs{JWT: []string{"jwt1"}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.request.proofJWTs()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_parseCredentialProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	now := time.Now()
+	validNonce := func(nonce string) error {
+		if nonce != "nonce" {
+			return errInvalidNonce()
+		}
+		return nil
+	}
+
+	tests := []struct {
+		name    string
+		proof   string
+		wantErr *oidc.Error
+	}{
+		{
+			name:    "malformed",
+			proof:   "foo",
+			wantErr: errInvalidProof(),
+		},
+		{
+			name: "wrong typ",
+			proof: createCredentialProof(t, key, "JWT", &credentialProofClaims{
+				Audience: oidc.Audience{"https://issuer.com"},
+				IssuedAt: oidc.FromTime(now),
+				Nonce:    "nonce",
+			}),
+			wantErr: errInvalidProof(),
+		},
+		{
+			name: "wrong audience",
+			proof: createCredentialProof(t, key, credentialProofJWTType, &credentialProofClaims{
+				Audience: oidc.Audience{"https://other.com"},
+				IssuedAt: oidc.FromTime(now),
+				Nonce:    "nonce",
+			}),
+			wantErr: errInvalidProof(),
+		},
+		{
+			name: "iat too old",
+			proof: createCredentialProof(t, key, credentialProofJWTType, &credentialProofClaims{
+				Audience: oidc.Audience{"https://issuer.com"},
+				IssuedAt: oidc.FromTime(now.Add(-time.Hour)),
+				Nonce:    "nonce",
+			}),
+			wantErr: errInvalidProof(),
+		},
+		{
+			name: "nonce missing",
+			proof: createCredentialProof(t, key, credentialProofJWTType, &credentialProofClaims{
+				Audience: oidc.Audience{"https://issuer.com"},
+				IssuedAt: oidc.FromTime(now),
+			}),
+			wantErr: errInvalidNonce(),
+		},
+		{
+			name: "nonce invalid",
+			proof: createCredentialProof(t, key, credentialProofJWTType, &credentialProofClaims{
+				Audience: oidc.Audience{"https://issuer.com"},
+				IssuedAt: oidc.FromTime(now),
+				Nonce:    "other",
+			}),
+			wantErr: errInvalidNonce(),
+		},
+		{
+			name: "valid",
+			proof: createCredentialProof(t, key, credentialProofJWTType, &credentialProofClaims{
+				Issuer:   "client",
+				Audience: oidc.Audience{"https://issuer.com"},
+				IssuedAt: oidc.FromTime(now),
+				Nonce:    "nonce",
+			}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCredentialProof(tt.proof, "https://issuer.com", now, time.Minute, validNonce)
+			if tt.wantErr != nil {
+				require.Error(t, err)
+				var oidcErr *oidc.Error
+				require.True(t, errors.As(err, &oidcErr))
+				assert.Equal(t, tt.wantErr.ErrorType, oidcErr.ErrorType)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, got.Valid())
+			assert.True(t, got.IsPublic())
+			assert.Equal(t, key.Public(), got.Key)
+		})
+	}
+}
+
+func Test_identityCredentialDisclosures(t *testing.T) {
+	metadata := []query.UserMetadata{
+		{Key: "department", Value: []byte("engineering")},
+		{Key: "secret", Value: []byte("value")},
+	}
+	tests := []struct {
+		name string
+		user *query.User
+		want []*sdJWTDisclosure
+	}{
+		{
+			name: "verified",
+			user: &query.User{
+				Human: &query.Human{
+					Email:           "email@example.com",
+					IsEmailVerified: true,
+					Phone:           "+41791234567",
+					IsPhoneVerified: true,
+				},
+			},
+			want: []*sdJWTDisclosure{
+				{name: claimEmail, value: "email@example.com"},
+				{name: claimPhoneNumber, value: "+41791234567"},
+				{name: "department", value: "engineering", metadata: true},
+			},
+		},
+		{
+			name: "not verified",
+			user: &query.User{
+				Human: &query.Human{
+					Email: "email@example.com",
+					Phone: "+41791234567",
+				},
+			},
+			want: []*sdJWTDisclosure{
+				{name: "department", value: "engineering", metadata: true},
+			},
+		},
+		{
+			name: "machine",
+			user: &query.User{
+				Machine: &query.Machine{Name: "machine"},
+			},
+			want: []*sdJWTDisclosure{
+				{name: "department", value: "engineering", metadata: true},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, identityCredentialDisclosures(tt.user, metadata, []string{"department"}))
+		})
+	}
+}
+
+func Test_createSDJWTVC(t *testing.T) {
+	issuerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	holderKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	webKey := &jose.JSONWebKey{Key: issuerKey, KeyID: "key1", Algorithm: string(jose.ES256), Use: "sig"}
+	now := time.Now()
+
+	credential, err := createSDJWTVC(webKey, &sdJWTVCClaims{
+		issuer:     "https://issuer.com",
+		subject:    "user1",
+		vct:        CredentialDefaultVCT,
+		issuedAt:   now,
+		expiration: now.Add(time.Hour),
+		holderKey:  &jose.JSONWebKey{Key: holderKey.Public(), Algorithm: string(jose.ES256)},
+		disclosures: []*sdJWTDisclosure{
+			{name: claimEmail, value: "email@example.com"},
+			{name: "department", value: "engineering", metadata: true},
+		},
+	})
+	require.NoError(t, err)
+
+	parts := strings.Split(credential, sdJWTSeparator)
+	require.Len(t, parts, 4)
+	assert.Empty(t, parts[3])
+
+	jws, err := jose.ParseSignedCompact(parts[0], []jose.SignatureAlgorithm{jose.ES256})
+	require.NoError(t, err)
+	assert.Equal(t, sdJWTVCFormat, jws.Signatures[0].Header.ExtraHeaders[jose.HeaderType])
+	assert.Equal(t, "key1", jws.Signatures[0].Header.KeyID)
+	payload, err := jws.Verify(issuerKey.Public())
+	require.NoError(t, err)
+	var claims struct {
+		Issuer       string   `json:"iss"`
+		Subject      string   `json:"sub"`
+		VCT          string   `json:"vct"`
+		Expiration   int64    `json:"exp"`
+		SDAlg        string   `json:"_sd_alg"`
+		SD           []string `json:"_sd"`
+		Confirmation struct {
+			JWK *jose.JSONWebKey `json:"jwk"`
+		} `json:"cnf"`
+		Metadata struct {
+			SD []string `json:"_sd"`
+		} `json:"metadata"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &claims))
+	assert.Equal(t, "https://issuer.com", claims.Issuer)
+	assert.Equal(t, "user1", claims.Subject)
+	assert.Equal(t, CredentialDefaultVCT, claims.VCT)
+	assert.Equal(t, now.Add(time.Hour).Unix(), claims.Expiration)
+	assert.Equal(t, sdJWTHashAlgorithm, claims.SDAlg)
+	assert.Equal(t, holderKey.Public(), claims.Confirmation.JWK.Key)
+
+	disclosureDigest := func(encoded string) string {
+		hash := sha256.Sum256([]byte(encoded))
+		return base64.RawURLEncoding.EncodeToString(hash[:])
+	}
+	assert.Equal(t, []string{disclosureDigest(parts[1])}, claims.SD)
+	assert.Equal(t, []string{disclosureDigest(parts[2])}, claims.Metadata.SD)
+
+	decoded, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+	var disclosure []any
+	require.NoError(t, json.Unmarshal(decoded, &disclosure))
+	require.Len(t, disclosure, 3)
+	assert.Equal(t, claimEmail, disclosure[1])
+	assert.Equal(t, "email@example.com", disclosure[2])
+}
@@ -0,0 +1,236 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain/tokenreplay"
+)
+
+const (
+	// DPoPHeader is the HTTP header carrying the DPoP proof JWT (RFC 9449, section 4.1).
+	DPoPHeader = "DPoP"
+	// DPoPTokenType is the token_type of DPoP bound access tokens and the authorization scheme to present them.
+	DPoPTokenType = "DPoP"
+
+	DPoPProofDefaultLifetime = time.Minute
+
+	dpopProofType          = "dpop+jwt"
+	invalidDPoPProofError  = "invalid_dpop_proof"
+	confirmationClaim      = "cnf"
+	confirmationClaimJKT   = "jkt"
+	dpopAuthorizationValue = DPoPTokenType + " "
+)
+
+// dpopSigningAlgs are the supported (asymmetric) algorithms for DPoP proofs.
+var dpopSigningAlgs = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+func dpopSigningAlgValuesSupported() []string {
+	algs := make([]string, len(dpopSigningAlgs))
+	for i, alg := range dpopSigningAlgs {
+		algs[i] = string(alg)
+	}
+	return algs
+}
+
+type dpopProofClaims struct {
+	JWTID           string    `json:"jti"`
+	HTTPMethod      string    `json:"htm"`
+	HTTPURI         string    `json:"htu"`
+	IssuedAt        oidc.Time `json:"iat"`
+	AccessTokenHash string    `json:"ath,omitempty"`
+}
+
+func errInvalidDPoPProof() *oidc.Error {
+	return &oidc.Error{ErrorType: invalidDPoPProofError}
+}
+
+// verifyDPoPProof verifies the DPoP proof of the request, if any, for the passed endpoint.
+// If an accessToken is passed, the proof must contain its hash.
+// It returns the JWK thumbprint of the key the proof was signed with, or an empty string if no proof was sent.
+func (s *Server) verifyDPoPProof(ctx context.Context, method string, header http.Header, endpoint *op.Endpoint, accessToken string) (string, error) {
+	proofs := header.Values(DPoPHeader)
+	switch len(proofs) {
+	case 0:
+		return "", nil
+	case 1:
+		jkt, jti, err := parseDPoPProof(proofs[0], method, endpoint.Absolute(op.IssuerFromContext(ctx)), accessToken, time.Now(), s.dpopProofLifetime)
+		if err != nil {
+			return "", err
+		}
+		// the proof is kept until the cache prunes it, which is configured to exceed the accepted iat window
+		if !tokenreplay.Use(ctx, s.dpopProofReplays, tokenreplay.Key(authz.GetInstance(ctx).InstanceID(), jkt, jti)) {
+			return "", errInvalidDPoPProof().WithDescription("DPoP proof jti already used")
+		}
+		return jkt, nil
+	default:
+		return "", errInvalidDPoPProof().WithDescription("multiple DPoP proofs")
+	}
+}
+
+// tokenRequestDPoPKey verifies the DPoP proof of a token request.
+// It returns the JWK thumbprint the issued tokens must be bound to.
+// If the client requires DPoP, a request without a proof is rejected.
+func (s *Server) tokenRequestDPoPKey(ctx context.Context, method string, header http.Header, requireDPoP bool) (string, error) {
+	jkt, err := s.verifyDPoPProof(ctx, method, header, s.Endpoints().Token, "")
+	if err != nil {
+		return "", err
+	}
+	if jkt == "" && requireDPoP {
+		return "", errInvalidDPoPProof().WithDescription("DPoP proof required")
+	}
+	return jkt, nil
+}
+
+// verifyDPoPBoundAccessToken ensures that a DPoP bound access token is presented with the DPoP authorization scheme
+// and a proof of possession of the bound key. Bearer tokens must not be presented with the DPoP scheme.
+func (s *Server) verifyDPoPBoundAccessToken(ctx context.Context, token *accessToken, rawToken, method string, header http.Header, endpoint *op.Endpoint) error {
+	usesDPoPScheme := dpopSchemeFromContext(ctx)
+	if token.dpopJKT == "" {
+		if usesDPoPScheme {
+			return errInvalidDPoPProof().WithDescription("access token is not DPoP bound")
+		}
+		return nil
+	}
+	if !usesDPoPScheme {
+		return errInvalidDPoPProof().WithDescription("DPoP bound access token requires the DPoP authorization scheme")
+	}
+	jkt, err := s.verifyDPoPProof(ctx, method, header, endpoint, rawToken)
+	if err != nil {
+		return err
+	}
+	if jkt != token.dpopJKT {
+		return errInvalidDPoPProof().WithDescription("DPoP proof does not match the bound key")
+	}
+	return nil
+}
+
+// parseDPoPProof validates a DPoP proof JWT (RFC 9449, section 4.3) and returns the JWK SHA-256 thumbprint (RFC 7638) of its key
+// and the jti of the proof, which the caller must check for replays.
+// The proof must be signed with the public key embedded in its header and
+// match the HTTP method and URI (without query and fragment) of the request.
+// The iat must be within the lifetime from now.
+func parseDPoPProof(proof, method, uri, accessToken string, now time.Time, lifetime time.Duration) (jkt, jti string, err error) {
+	jws, err := jose.ParseSignedCompact(proof, dpopSigningAlgs)
+	if err != nil {
+		return "", "", errInvalidDPoPProof().WithParent(err).WithDescription("malformed DPoP proof")
+	}
+	if len(jws.Signatures) != 1 {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof must have exactly one signature")
+	}
+	header := jws.Signatures[0].Header
+	if typ, _ := header.ExtraHeaders[jose.HeaderType].(string); typ != dpopProofType {
+		return "", "", errInvalidDPoPProof().WithDescription("invalid DPoP proof typ")
+	}
+	key := header.JSONWebKey
+	if key == nil || !key.Valid() || !key.IsPublic() {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof must contain a public jwk")
+	}
+	payload, err := jws.Verify(key)
+	if err != nil {
+		return "", "", errInvalidDPoPProof().WithParent(err).WithDescription("invalid DPoP proof signature")
+	}
+	claims := new(dpopProofClaims)
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return "", "", errInvalidDPoPProof().WithParent(err).WithDescription("malformed DPoP proof claims")
+	}
+	if claims.JWTID == "" {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof jti missing")
+	}
+	if claims.HTTPMethod != method {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof htm does not match")
+	}
+	if !dpopURIMatches(claims.HTTPURI, uri) {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof htu does not match")
+	}
+	issuedAt := claims.IssuedAt.AsTime()
+	if issuedAt.Before(now.Add(-lifetime)) || issuedAt.After(now.Add(lifetime)) {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof iat is outside the acceptable window")
+	}
+	if accessToken != "" && claims.AccessTokenHash != dpopAccessTokenHash(accessToken) {
+		return "", "", errInvalidDPoPProof().WithDescription("DPoP proof ath does not match")
+	}
+	thumbprint, err := key.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return "", "", errInvalidDPoPProof().WithParent(err).WithDescription("invalid DPoP proof jwk")
+	}
+	return base64.RawURLEncoding.EncodeToString(thumbprint), claims.JWTID, nil
+}
+
+// dpopURIMatches compares the htu of a proof to the endpoint URI, ignoring query and fragment.
+func dpopURIMatches(htu, uri string) bool {
+	proofURI, err := url.Parse(htu)
+	if err != nil {
+		return false
+	}
+	endpointURI, err := url.Parse(uri)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(proofURI.Scheme, endpointURI.Scheme) &&
+		strings.EqualFold(proofURI.Host, endpointURI.Host) &&
+		proofURI.Path == endpointURI.Path
+}
+
+func dpopAccessTokenHash(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// tokenConfirmation returns the confirmation (cnf) claim value for the JWK thumbprint of a DPoP key (RFC 9449, section 6.1)
+// and the thumbprint of a client certificate (RFC 8705, section 3.1) the token is bound to.
+// It returns nil if the token is not bound.
+func tokenConfirmation(jkt, x5t string) map[string]any {
+	if jkt == "" && x5t == "" {
+		return nil
+	}
+	confirmation := make(map[string]any, 2)
+	if jkt != "" {
+		confirmation[confirmationClaimJKT] = jkt
+	}
+	if x5t != "" {
+		confirmation[confirmationClaimX5T] = x5t
+	}
+	return confirmation
+}
+
+type dpopSchemeKey struct{}
+
+func dpopSchemeFromContext(ctx context.Context) bool {
+	usesDPoPScheme, _ := ctx.Value(dpopSchemeKey{}).(bool)
+	return usesDPoPScheme
+}
+
+// dpopAuthorizationHandler rewrites an authorization header with the DPoP scheme to the Bearer scheme,
+// as the oidc library only extracts bearer tokens.
+// The use of the DPoP scheme is kept in the context, so bound tokens can be enforced to use it.
+func dpopAuthorizationHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), dpopAuthorizationValue)
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+		r = r.WithContext(context.WithValue(r.Context(), dpopSchemeKey{}, true))
+		r.Header = r.Header.Clone()
+		r.Header.Set("Authorization", oidc.PrefixBearer+token)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,260 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/domain/tokenreplay"
+)
+
+func createDPoPProof(t *testing.T, key *ecdsa.PrivateKey, typ string, claims *dpopProofClaims) string {
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.ES256, Key: key},
+		(&jose.SignerOptions{EmbedJWK: true}).WithType(jose.ContentType(typ)),
+	)
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	proof, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return proof
+}
+
+func Test_parseDPoPProof(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	thumbprint, err := (&jose.JSONWebKey{Key: key.Public()}).Thumbprint(crypto.SHA256)
+	require.NoError(t, err)
+	jkt := base64.RawURLEncoding.EncodeToString(thumbprint)
+	now := time.Now()
+
+	type args struct {
+		proof       string
+		method      string
+		uri         string
+		accessToken string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "malformed",
+			args: args{
+				proof:  "foo",
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong typ",
+			args: args{
+				proof: createDPoPProof(t, key, "JWT", &dpopProofClaims{
+					JWTID:      "id",
+					HTTPMethod: http.MethodPost,
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				}),
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing jti",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					HTTPMethod: http.MethodPost,
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				}),
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong method",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					JWTID:      "id",
+					HTTPMethod: http.MethodGet,
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				}),
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "wrong uri",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					JWTID:      "id",
+					HTTPMethod: http.MethodPost,
+					HTTPURI:    "https://other.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now),
+				}),
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "expired",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					JWTID:      "id",
+					HTTPMethod: http.MethodPost,
+					HTTPURI:    "https://issuer.com/oauth/v2/token",
+					IssuedAt:   oidc.FromTime(now.Add(-2 * time.Minute)),
+				}),
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing ath",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					JWTID:      "id",
+					HTTPMethod: http.MethodGet,
+					HTTPURI:    "https://issuer.com/oidc/v1/userinfo",
+					IssuedAt:   oidc.FromTime(now),
+				}),
+				method:      http.MethodGet,
+				uri:         "https://issuer.com/oidc/v1/userinfo",
+				accessToken: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name: "token request",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					JWTID:      "id",
+					HTTPMethod: http.MethodPost,
+					HTTPURI:    "https://ISSUER.com/oauth/v2/token?foo=bar",
+					IssuedAt:   oidc.FromTime(now),
+				}),
+				method: http.MethodPost,
+				uri:    "https://issuer.com/oauth/v2/token",
+			},
+			want: jkt,
+		},
+		{
+			name: "resource request",
+			args: args{
+				proof: createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+					JWTID:           "id",
+					HTTPMethod:      http.MethodGet,
+					HTTPURI:         "https://issuer.com/oidc/v1/userinfo",
+					IssuedAt:        oidc.FromTime(now),
+					AccessTokenHash: dpopAccessTokenHash("token"),
+				}),
+				method:      http.MethodGet,
+				uri:         "https://issuer.com/oidc/v1/userinfo",
+				accessToken: "token",
+			},
+			want: jkt,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, jti, err := parseDPoPProof(tt.args.proof, tt.args.method, tt.args.uri, tt.args.accessToken, now, time.Minute)
+			if tt.wantErr {
+				var target *oidc.Error
+				require.ErrorAs(t, err, &target)
+				assert.Equal(t, invalidDPoPProofError, string(target.ErrorType))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, "id", jti)
+		})
+	}
+}
+
+func TestServer_verifyDPoPProof_replay(t *testing.T) {
+	ctx := op.ContextWithIssuer(authz.NewMockContext("instanceID", "orgID", "userID"), "https://issuer.com")
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	s := &Server{
+		dpopProofLifetime: time.Minute,
+		dpopProofReplays:  gomap.NewCache[tokenreplay.Index, string, *tokenreplay.Token](ctx, []tokenreplay.Index{tokenreplay.IndexKey}, cache.Config{}),
+	}
+	header := http.Header{}
+	header.Set(DPoPHeader, createDPoPProof(t, key, dpopProofType, &dpopProofClaims{
+		JWTID:      "id",
+		HTTPMethod: http.MethodPost,
+		HTTPURI:    "https://issuer.com/oauth/v2/token",
+		IssuedAt:   oidc.FromTime(time.Now()),
+	}))
+	endpoint := op.NewEndpoint("oauth/v2/token")
+
+	jkt, err := s.verifyDPoPProof(ctx, http.MethodPost, header, endpoint, "")
+	require.NoError(t, err)
+	assert.NotEmpty(t, jkt)
+
+	_, err = s.verifyDPoPProof(ctx, http.MethodPost, header, endpoint, "")
+	var target *oidc.Error
+	require.ErrorAs(t, err, &target)
+	assert.Equal(t, invalidDPoPProofError, string(target.ErrorType))
+}
+
+func Test_dpopAuthorizationHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantHeader    string
+		wantDPoP      bool
+	}{
+		{
+			name:          "bearer",
+			authorization: "Bearer token",
+			wantHeader:    "Bearer token",
+			wantDPoP:      false,
+		},
+		{
+			name:          "dpop",
+			authorization: "DPoP token",
+			wantHeader:    "Bearer token",
+			wantDPoP:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/oidc/v1/userinfo", nil)
+			req.Header.Set("Authorization", tt.authorization)
+			dpopAuthorizationHandler(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantHeader, r.Header.Get("Authorization"))
+				assert.Equal(t, tt.wantDPoP, dpopSchemeFromContext(r.Context()))
+			})).ServeHTTP(httptest.NewRecorder(), req)
+		})
+	}
+}
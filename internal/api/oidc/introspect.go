@@ -123,6 +123,24 @@ func (s *Server) Introspect(ctx context.Context, r *op.Request[op.IntrospectionR
 		Actor:                           actorDomainToClaims(token.actor),
 	}
 	introspectionResp.SetUserInfo(userInfo)
+	// let the resource server verify the DPoP proof against the bound key (RFC 9449, section 6.2)
+	// and the client certificate against the bound certificate (RFC 8705, section 3.2)
+	if token.dpopJKT != "" {
+		introspectionResp.TokenType = DPoPTokenType
+	}
+	if confirmation := tokenConfirmation(token.dpopJKT, token.certificateThumbprint); confirmation != nil {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims[confirmationClaim] = confirmation
+	}
+	// resource servers decide about the access based on the granted authorization details (RFC 9396, section 9.2)
+	if len(token.authorizationDetails) > 0 {
+		if introspectionResp.Claims == nil {
+			introspectionResp.Claims = make(map[string]any, 1)
+		}
+		introspectionResp.Claims[authorizationDetailsParam] = token.authorizationDetails
+	}
 	return op.NewResponse(introspectionResp), nil
 }
 
@@ -144,6 +162,12 @@ func (s *Server) introspectionClientAuth(ctx context.Context, cc *op.ClientCrede
 			return "", "", false, err
 		}
 
+		if tlsClientAuth, selfSigned := client.TLSClientAuth(); tlsClientAuth {
+			if err := verifyTLSClientCertificate(ctx, selfSigned, client.TLSClientAuthSubjectDN, client.TLSClientAuthPublicKeyPin); err != nil {
+				return "", "", false, oidc.ErrUnauthorizedClient().WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError)
+			}
+			return client.ClientID, client.ProjectID, client.ProjectRoleAssertion, nil
+		}
 		if cc.ClientAssertion != "" {
 			verifier := op.NewJWTProfileVerifierKeySet(keySetMap(client.PublicKeys), op.IssuerFromContext(ctx), time.Hour, time.Second)
 			if _, err := op.VerifyJWTAssertion(ctx, cc.ClientAssertion, verifier); err != nil {
@@ -0,0 +1,124 @@
+package oidc
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+const (
+	// authMethodTLSClientAuth is the PKI mutual TLS client authentication method (RFC 8705, section 2.1).
+	authMethodTLSClientAuth oidc.AuthMethod = "tls_client_auth"
+	// authMethodSelfSignedTLSClientAuth is the self-signed certificate mutual TLS client authentication method (RFC 8705, section 2.2).
+	authMethodSelfSignedTLSClientAuth oidc.AuthMethod = "self_signed_tls_client_auth"
+
+	confirmationClaimX5T = "x5t#S256"
+)
+
+var errInvalidClientCertificate = errors.New("invalid client certificate")
+
+// tlsClientAuthMethods are the mutual TLS client authentication methods,
+// which are supported if the client certificate is forwarded by the ingress.
+var tlsClientAuthMethods = []oidc.AuthMethod{authMethodTLSClientAuth, authMethodSelfSignedTLSClientAuth}
+
+type tlsClientCertificateKey struct{}
+
+func tlsClientCertificateFromContext(ctx context.Context) *x509.Certificate {
+	cert, _ := ctx.Value(tlsClientCertificateKey{}).(*x509.Certificate)
+	return cert
+}
+
+// tlsClientCertificateHandler keeps the client certificate of the request in the context.
+// The certificate is taken from the header, where it is forwarded by the TLS terminating ingress.
+// The ingress must verify the certificate chain and must always overwrite the header, so it can't be set by a client.
+// Invalid certificates are ignored, so the client authentication fails.
+func tlsClientCertificateHandler(header string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value := r.Header.Get(header)
+			if value == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if cert, err := parseForwardedClientCertificate(value); err == nil {
+				r = r.WithContext(context.WithValue(r.Context(), tlsClientCertificateKey{}, cert))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// parseForwardedClientCertificate parses the client certificate forwarded by an ingress.
+// The certificate can be PEM encoded (optionally URL escaped, e.g. nginx' $ssl_client_escaped_cert)
+// or base64 encoded DER (e.g. traefik's passTLSClientCert). Only the first certificate of a chain is used.
+func parseForwardedClientCertificate(value string) (*x509.Certificate, error) {
+	value = strings.TrimSpace(value)
+	if strings.Contains(value, "%") {
+		unescaped, err := url.PathUnescape(value)
+		if err != nil {
+			return nil, err
+		}
+		value = unescaped
+	}
+	if strings.Contains(value, "-----BEGIN") {
+		block, _ := pem.Decode([]byte(value))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return nil, errInvalidClientCertificate
+		}
+		return x509.ParseCertificate(block.Bytes)
+	}
+	value, _, _ = strings.Cut(value, ",")
+	der, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+// verifyTLSClientCertificate verifies that the client certificate of the request
+// matches the registered subject DN or public key pin of the client (RFC 8705, section 2).
+func verifyTLSClientCertificate(ctx context.Context, selfSigned bool, subjectDN, publicKeyPin string) error {
+	cert := tlsClientCertificateFromContext(ctx)
+	if cert == nil {
+		return oidc.ErrInvalidClient().WithDescription("client certificate required")
+	}
+	if !domain.TLSClientAuthCertificateMatches(cert, selfSigned, subjectDN, publicKeyPin) {
+		return oidc.ErrInvalidClient().WithDescription("client certificate does not match the registered certificate")
+	}
+	return nil
+}
+
+// tokenRequestCertificateThumbprint returns the thumbprint of the client certificate the issued tokens must be bound to.
+// Only tokens of clients authenticated by mutual TLS are bound to their certificate (RFC 8705, section 3).
+func tokenRequestCertificateThumbprint(ctx context.Context, client *Client) string {
+	if !client.client.AuthMethodType.IsTLSClientAuth() {
+		return ""
+	}
+	cert := tlsClientCertificateFromContext(ctx)
+	if cert == nil {
+		return ""
+	}
+	return domain.TLSClientCertificateThumbprint(cert)
+}
+
+// verifyCertificateBoundAccessToken ensures that a certificate bound access token
+// is presented over a connection with the same client certificate (RFC 8705, section 3).
+func verifyCertificateBoundAccessToken(ctx context.Context, token *accessToken) error {
+	if token.certificateThumbprint == "" {
+		return nil
+	}
+	cert := tlsClientCertificateFromContext(ctx)
+	if cert == nil || domain.TLSClientCertificateThumbprint(cert) != token.certificateThumbprint {
+		return oidc.ErrAccessDenied().WithDescription("access token is bound to a different client certificate")
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+)
+
+func newTestClientCertificate(t *testing.T) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "client", Organization: []string{"ZITADEL"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func Test_parseForwardedClientCertificate(t *testing.T) {
+	cert := newTestClientCertificate(t)
+	pemCert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+	tests := []struct {
+		name    string
+		value   string
+		wantErr bool
+	}{
+		{
+			name:  "pem",
+			value: pemCert,
+		},
+		{
+			name:  "url escaped pem",
+			value: url.PathEscape(pemCert),
+		},
+		{
+			name:  "base64 der",
+			value: base64.StdEncoding.EncodeToString(cert.Raw),
+		},
+		{
+			name:  "base64 der chain",
+			value: base64.StdEncoding.EncodeToString(cert.Raw) + "," + base64.StdEncoding.EncodeToString([]byte("issuer")),
+		},
+		{
+			name:    "pem without certificate",
+			value:   string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: cert.RawSubjectPublicKeyInfo})),
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			value:   "invalid",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseForwardedClientCertificate(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cert.Raw, got.Raw)
+		})
+	}
+}
+
+func Test_tlsClientCertificateHandler(t *testing.T) {
+	cert := newTestClientCertificate(t)
+	tests := []struct {
+		name  string
+		value string
+		want  *x509.Certificate
+	}{
+		{
+			name: "no header",
+		},
+		{
+			name:  "invalid certificate",
+			value: "invalid",
+		},
+		{
+			name:  "certificate",
+			value: base64.StdEncoding.EncodeToString(cert.Raw),
+			want:  cert,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *x509.Certificate
+			handler := tlsClientCertificateHandler("X-SSL-Client-Cert")(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = tlsClientCertificateFromContext(r.Context())
+			}))
+			r := httptest.NewRequest(http.MethodPost, "/oauth/v2/token", nil)
+			if tt.value != "" {
+				r.Header.Set("X-SSL-Client-Cert", tt.value)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), r)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_verifyCertificateBoundAccessToken(t *testing.T) {
+	cert := newTestClientCertificate(t)
+	otherCert := newTestClientCertificate(t)
+	tests := []struct {
+		name    string
+		cert    *x509.Certificate
+		token   *accessToken
+		wantErr bool
+	}{
+		{
+			name:  "not bound",
+			token: &accessToken{},
+		},
+		{
+			name:    "bound, no certificate",
+			token:   &accessToken{certificateThumbprint: domain.TLSClientCertificateThumbprint(cert)},
+			wantErr: true,
+		},
+		{
+			name:    "bound, other certificate",
+			cert:    otherCert,
+			token:   &accessToken{certificateThumbprint: domain.TLSClientCertificateThumbprint(cert)},
+			wantErr: true,
+		},
+		{
+			name:  "bound, same certificate",
+			cert:  cert,
+			token: &accessToken{certificateThumbprint: domain.TLSClientCertificateThumbprint(cert)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.cert != nil {
+				ctx = context.WithValue(ctx, tlsClientCertificateKey{}, tt.cert)
+			}
+			err := verifyCertificateBoundAccessToken(ctx, tt.token)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_tokenConfirmation(t *testing.T) {
+	tests := []struct {
+		name string
+		jkt  string
+		x5t  string
+		want map[string]any
+	}{
+		{
+			name: "not bound",
+		},
+		{
+			name: "dpop",
+			jkt:  "jkt",
+			want: map[string]any{"jkt": "jkt"},
+		},
+		{
+			name: "certificate",
+			x5t:  "x5t",
+			want: map[string]any{"x5t#S256": "x5t"},
+		},
+		{
+			name: "dpop and certificate",
+			jkt:  "jkt",
+			x5t:  "x5t",
+			want: map[string]any{"jkt": "jkt", "x5t#S256": "x5t"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tokenConfirmation(tt.jkt, tt.x5t))
+		})
+	}
+}
@@ -16,9 +16,11 @@ import (
 	"github.com/zitadel/zitadel/internal/api/ui/login"
 	"github.com/zitadel/zitadel/internal/auth/repository"
 	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain/federatedlogout"
+	"github.com/zitadel/zitadel/internal/domain/tokenreplay"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/metrics"
@@ -38,10 +40,19 @@ type Config struct {
 	JWKSCacheControlMaxAge            time.Duration
 	CustomEndpoints                   *EndpointConfig
 	DeviceAuth                        *DeviceAuthorizationConfig
+	BackChannelAuth                   *BackChannelAuthConfig
+	PushedAuthRequestLifetime         time.Duration
+	RequirePushedAuthRequests         bool
+	DPoPProofLifetime                 time.Duration
+	TLSClientCertificateHeader        string
 	DefaultLoginURLV2                 string
 	DefaultLogoutURLV2                string
 	PublicKeyCacheMaxAge              time.Duration
 	DefaultBackChannelLogoutLifetime  time.Duration
+	// AuthorizationDetailsTypesSupported restricts the types of authorization_details (RFC 9396) clients can request.
+	// If empty, any type is accepted.
+	AuthorizationDetailsTypesSupported []string
+	CredentialIssuer                   *CredentialIssuerConfig
 }
 
 type EndpointConfig struct {
@@ -53,6 +64,16 @@ type EndpointConfig struct {
 	EndSession    *Endpoint
 	Keys          *Endpoint
 	DeviceAuth    *Endpoint
+	// PushedAuthRequest is the OAuth 2.0 Pushed Authorization Request endpoint (RFC 9126)
+	PushedAuthRequest *Endpoint
+	// BackChannelAuth is the Client Initiated Backchannel Authentication (CIBA) endpoint
+	BackChannelAuth *Endpoint
+	// ClientRegistration is the OAuth 2.0 Dynamic Client Registration endpoint (RFC 7591)
+	ClientRegistration *Endpoint
+	// Credential is the credential endpoint of the credential issuer (OpenID4VCI)
+	Credential *Endpoint
+	// CredentialNonce is the nonce endpoint of the credential issuer (OpenID4VCI)
+	CredentialNonce *Endpoint
 }
 
 type Endpoint struct {
@@ -76,6 +97,8 @@ type OPStorage struct {
 	assetAPIPrefix                    func(ctx context.Context) string
 	contextToIssuer                   func(context.Context) string
 	federateLogoutCache               cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout]
+	samlLogoutPath                    string
+	credentialIssuer                  CredentialIssuerConfig
 }
 
 // Provider is used to overload certain [op.Provider] methods
@@ -103,6 +126,7 @@ func NewServer(
 	ctx context.Context,
 	config Config,
 	defaultLogoutRedirectURI string,
+	samlLogoutPath string,
 	externalSecure bool,
 	command *command.Commands,
 	query *query.Queries,
@@ -114,13 +138,15 @@ func NewServer(
 	accessHandler *middleware.AccessInterceptor,
 	fallbackLogger *slog.Logger,
 	hashConfig crypto.HashConfig,
+	cacheConnectors connector.Connectors,
 	federatedLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	tokenReplayCache cache.Cache[tokenreplay.Index, string, *tokenreplay.Token],
 ) (*Server, error) {
 	opConfig, err := createOPConfig(config, defaultLogoutRedirectURI, cryptoKey)
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "OIDC-EGrqd", "cannot create op config: %w")
 	}
-	storage := newStorage(config, command, query, repo, encryptionAlg, es, ContextToIssuer, federatedLogoutCache)
+	storage := newStorage(config, command, query, repo, encryptionAlg, es, ContextToIssuer, federatedLogoutCache, samlLogoutPath)
 	keyCache := newPublicKeyCache(ctx, config.PublicKeyCacheMaxAge, queryKeyFunc(query))
 	accessTokenKeySet := newOidcKeySet(keyCache, withKeyExpiryCheck(true))
 	idTokenHintKeySet := newOidcKeySet(keyCache)
@@ -164,21 +190,58 @@ func NewServer(
 		encAlg:                     encryptionAlg,
 		opCrypto:                   op.NewAESCrypto(opConfig.CryptoKey),
 		assetAPIPrefix:             assets.AssetAPI(),
+		pushedAuthRequestEndpoint:  pushedAuthRequestEndpoint(config.CustomEndpoints),
+		pushedAuthRequestLifetime:  config.PushedAuthRequestLifetime,
+		requirePushedAuthRequests:  config.RequirePushedAuthRequests,
+		dpopProofLifetime:          config.DPoPProofLifetime,
+		dpopProofReplays:           tokenReplayCache,
+		tlsClientCertificateHeader: config.TLSClientCertificateHeader,
+		backChannelAuthEndpoint:    backChannelAuthEndpoint(config.CustomEndpoints),
+		clientRegistrationEndpoint: clientRegistrationEndpoint(config.CustomEndpoints),
+		credentialEndpoint:         credentialEndpoint(config.CustomEndpoints),
+		credentialNonceEndpoint:    credentialNonceEndpoint(config.CustomEndpoints),
+		credentialIssuer:           config.CredentialIssuer.withDefaults(),
+
+		authorizationDetailsTypesSupported: config.AuthorizationDetailsTypesSupported,
+	}
+	if server.pushedAuthRequestLifetime == 0 {
+		server.pushedAuthRequestLifetime = PushedAuthRequestDefaultLifetime
+	}
+	if server.dpopProofLifetime == 0 {
+		server.dpopProofLifetime = DPoPProofDefaultLifetime
+	}
+	server.backChannelAuthLifetime, server.backChannelAuthPollInterval = config.BackChannelAuth.lifetimeAndPollInterval()
+	server.backChannelAuthPolls, err = startBackChannelAuthPollCache(ctx, cacheConnectors)
+	if err != nil {
+		return nil, err
 	}
 	metricTypes := []metrics.MetricType{metrics.MetricTypeRequestCount, metrics.MetricTypeStatusCode, metrics.MetricTypeTotalCount}
+	httpMiddleware := []func(http.Handler) http.Handler{
+		middleware.MetricsHandler(metricTypes),
+		middleware.TelemetryHandler(),
+		middleware.NoCacheInterceptor().Handler,
+		instanceHandler,
+		userAgentCookie,
+		http_utils.CopyHeadersToContext,
+		accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
+		middleware.ActivityHandler,
+		dpopAuthorizationHandler,
+	}
+	if config.TLSClientCertificateHeader != "" {
+		httpMiddleware = append(httpMiddleware, tlsClientCertificateHandler(config.TLSClientCertificateHeader))
+	}
+	// the CIBA token requests are answered without calling the next handler,
+	// so the client certificate must already be in the context to authenticate and bind to it.
+	httpMiddleware = append(httpMiddleware, server.backChannelAuthTokenHandler)
 	server.Handler = op.RegisterLegacyServer(server,
 		server.authorizeCallbackHandler,
 		op.WithFallbackLogger(fallbackLogger),
-		op.WithHTTPMiddleware(
-			middleware.MetricsHandler(metricTypes),
-			middleware.TelemetryHandler(),
-			middleware.NoCacheInterceptor().Handler,
-			instanceHandler,
-			userAgentCookie,
-			http_utils.CopyHeadersToContext,
-			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(config.CustomEndpoints)),
-			middleware.ActivityHandler,
-		))
+		op.WithHTTPMiddleware(httpMiddleware...),
+		op.WithSetRouter(server.registerPushedAuthRequestHandler),
+		op.WithSetRouter(server.registerBackChannelAuthHandler),
+		op.WithSetRouter(server.registerClientRegistrationHandler),
+		op.WithSetRouter(server.registerCredentialIssuerHandler),
+	)
 
 	return server, nil
 }
@@ -197,7 +260,7 @@ func publicAuthPathPrefixes(endpoints *EndpointConfig) []string {
 	authURL := op.DefaultEndpoints.Authorization.Relative()
 	keysURL := op.DefaultEndpoints.JwksURI.Relative()
 	if endpoints == nil {
-		return []string{oidc.DiscoveryEndpoint, authURL, keysURL}
+		return []string{oidc.DiscoveryEndpoint, CredentialIssuerMetadataEndpoint, authURL, keysURL}
 	}
 	if endpoints.Auth != nil && endpoints.Auth.Path != "" {
 		authURL = endpoints.Auth.Path
@@ -205,7 +268,7 @@ func publicAuthPathPrefixes(endpoints *EndpointConfig) []string {
 	if endpoints.Keys != nil && endpoints.Keys.Path != "" {
 		keysURL = endpoints.Keys.Path
 	}
-	return []string{oidc.DiscoveryEndpoint, authURL, keysURL}
+	return []string{oidc.DiscoveryEndpoint, CredentialIssuerMetadataEndpoint, authURL, keysURL}
 }
 
 func createOPConfig(config Config, defaultLogoutRedirectURI string, cryptoKey []byte) (*op.Config, error) {
@@ -234,6 +297,7 @@ func newStorage(
 	es *eventstore.Eventstore,
 	contextToIssuer func(context.Context) string,
 	federateLogoutCache cache.Cache[federatedlogout.Index, string, *federatedlogout.FederatedLogout],
+	samlLogoutPath string,
 ) *OPStorage {
 	return &OPStorage{
 		repo:                              repo,
@@ -251,6 +315,8 @@ func newStorage(
 		assetAPIPrefix:                    assets.AssetAPI(),
 		contextToIssuer:                   contextToIssuer,
 		federateLogoutCache:               federateLogoutCache,
+		samlLogoutPath:                    samlLogoutPath,
+		credentialIssuer:                  config.CredentialIssuer.withDefaults(),
 	}
 }
 
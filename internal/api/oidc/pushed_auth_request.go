@@ -0,0 +1,224 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	httphelper "github.com/zitadel/oidc/v3/pkg/http"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+)
+
+const (
+	PushedAuthRequestDefaultLifetime = time.Minute
+	// RequestURIPrefix is prepended to the ID of a pushed authorization request
+	// to build the request_uri returned to the client (RFC 9126, section 2.2).
+	RequestURIPrefix = "urn:ietf:params:oauth:request_uri:"
+)
+
+type pushedAuthRequestResponse struct {
+	RequestURI string `json:"request_uri"`
+	ExpiresIn  int64  `json:"expires_in"`
+}
+
+// pushedAuthRequestEndpoint returns the endpoint of the Pushed Authorization Request (PAR) endpoint,
+// defaulting to /oauth/v2/par.
+func pushedAuthRequestEndpoint(endpointConfig *EndpointConfig) *op.Endpoint {
+	if endpointConfig == nil || endpointConfig.PushedAuthRequest == nil {
+		return op.NewEndpoint("/oauth/v2/par")
+	}
+	return op.NewEndpointWithURL(endpointConfig.PushedAuthRequest.Path, endpointConfig.PushedAuthRequest.URL)
+}
+
+// registerPushedAuthRequestHandler adds the PAR endpoint to the router of the OP.
+func (s *Server) registerPushedAuthRequestHandler(router chi.Router) {
+	router.Post(s.pushedAuthRequestEndpoint.Relative(), s.pushedAuthRequestHandler)
+}
+
+// pushedAuthRequestHandler implements the Pushed Authorization Request endpoint (RFC 9126).
+// The client authenticates the same way as on the token endpoint and pushes the parameters of the authorization request.
+// The request is validated and stored, the returned request_uri can then be used (once) on the authorization endpoint.
+func (s *Server) pushedAuthRequestHandler(w http.ResponseWriter, r *http.Request) {
+	resp, err := s.pushedAuthRequest(r.Context(), r)
+	if err != nil {
+		op.WriteError(w, r, err, s.getLogger(r.Context()))
+		return
+	}
+	httphelper.MarshalJSONWithStatus(w, resp, http.StatusCreated)
+}
+
+func (s *Server) pushedAuthRequest(ctx context.Context, r *http.Request) (_ *pushedAuthRequestResponse, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() {
+		err = oidcError(err)
+		span.EndWithError(err)
+	}()
+
+	if err = r.ParseForm(); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error parsing form").WithParent(err)
+	}
+	credentials, err := s.clientCredentialsFromRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	client, err := s.VerifyClient(ctx, &op.Request[op.ClientCredentials]{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header,
+		Form:   r.Form,
+		Data:   credentials,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authReq := new(oidc.AuthRequest)
+	if err = s.Provider().Decoder().Decode(authReq, r.PostForm); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if r.PostForm.Has("request_uri") {
+		return nil, oidc.ErrInvalidRequest().WithDescription("request_uri is not allowed in pushed authorization requests")
+	}
+	if authReq.RequestParam != "" {
+		if !s.Provider().RequestObjectSupported() {
+			return nil, oidc.ErrRequestNotSupported()
+		}
+		if err = op.ParseRequestObject(ctx, authReq, s.Provider().Storage(), op.IssuerFromContext(ctx)); err != nil {
+			return nil, err
+		}
+	}
+	if authReq.ClientID == "" {
+		authReq.ClientID = client.GetID()
+	}
+	if authReq.ClientID != client.GetID() {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id does not match the authenticated client")
+	}
+	if _, err = op.ValidateAuthRequestClient(ctx, authReq, client, s.Provider().IDTokenHintVerifier(ctx)); err != nil {
+		return nil, err
+	}
+
+	// the authorization details are validated now, but stored as provided and parsed again when the request is used
+	if _, err = s.authorizationDetailsFromForm(r.PostForm); err != nil {
+		return nil, err
+	}
+	request := authRequestToPushedAuthRequest(authReq)
+	request.AuthorizationDetails = r.PostForm.Get(authorizationDetailsParam)
+	pushed, err := s.command.AddPushedAuthRequest(ctx, request, s.pushedAuthRequestLifetime)
+	if err != nil {
+		return nil, err
+	}
+	return &pushedAuthRequestResponse{
+		RequestURI: RequestURIPrefix + pushed.ID,
+		ExpiresIn:  int64(s.pushedAuthRequestLifetime / time.Second),
+	}, nil
+}
+
+// clientCredentialsFromRequest reads the client credentials from the form and the basic auth header,
+// where the latter takes precedence.
+func (s *Server) clientCredentialsFromRequest(r *http.Request) (_ *op.ClientCredentials, err error) {
+	credentials := new(op.ClientCredentials)
+	if err = s.Provider().Decoder().Decode(credentials, r.PostForm); err != nil {
+		return nil, oidc.ErrInvalidRequest().WithDescription("error decoding form").WithParent(err)
+	}
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		credentials.ClientID, err = url.QueryUnescape(clientID)
+		if err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+		credentials.ClientSecret, err = url.QueryUnescape(clientSecret)
+		if err != nil {
+			return nil, oidc.ErrInvalidClient().WithDescription("invalid basic auth header").WithParent(err)
+		}
+	}
+	if credentials.ClientID == "" && credentials.ClientAssertion == "" {
+		return nil, oidc.ErrInvalidRequest().WithDescription("client_id or client_assertion must be provided")
+	}
+	if credentials.ClientAssertion != "" && credentials.ClientAssertionType != oidc.ClientAssertionTypeJWTAssertion {
+		return nil, oidc.ErrInvalidRequest().WithDescription("invalid client_assertion_type %s", credentials.ClientAssertionType)
+	}
+	return credentials, nil
+}
+
+// pushedAuthRequestFromRequestURI checks for a request_uri parameter on the authorization request.
+// If present, the referenced pushed authorization request is consumed and returned.
+// Parameters of the authorization request, except the client_id, are ignored in that case.
+// As the oidc library does not know the authorization_details parameter,
+// the form is updated with the pushed authorization details.
+func (s *Server) pushedAuthRequestFromRequestURI(ctx context.Context, r *op.Request[oidc.AuthRequest]) (_ *oidc.AuthRequest, ok bool, err error) {
+	requestURI := r.Form.Get("request_uri")
+	if requestURI == "" {
+		return nil, false, nil
+	}
+	id, found := strings.CutPrefix(requestURI, RequestURIPrefix)
+	if !found || id == "" {
+		return nil, true, oidc.ErrInvalidRequest().WithDescription("invalid request_uri")
+	}
+	if r.Data.ClientID == "" {
+		return nil, true, oidc.ErrInvalidRequest().WithParent(op.ErrAuthReqMissingClientID).WithDescription(op.ErrAuthReqMissingClientID.Error())
+	}
+	pushed, err := s.command.ConsumePushedAuthRequest(ctx, id, r.Data.ClientID)
+	if err != nil {
+		return nil, true, oidc.ErrInvalidRequest().WithParent(err).WithDescription("invalid request_uri")
+	}
+	if r.Form == nil {
+		r.Form = make(url.Values)
+	}
+	r.Form.Del(authorizationDetailsParam)
+	if pushed.AuthorizationDetails != "" {
+		r.Form.Set(authorizationDetailsParam, pushed.AuthorizationDetails)
+	}
+	return pushedAuthRequestToAuthRequest(pushed), true, nil
+}
+
+func authRequestToPushedAuthRequest(authReq *oidc.AuthRequest) *command.PushedAuthRequest {
+	uiLocales := make([]string, len(authReq.UILocales))
+	for i, locale := range authReq.UILocales {
+		uiLocales[i] = locale.String()
+	}
+	return &command.PushedAuthRequest{
+		ClientID:            authReq.ClientID,
+		RedirectURI:         authReq.RedirectURI,
+		State:               authReq.State,
+		Nonce:               authReq.Nonce,
+		Scope:               authReq.Scopes,
+		ResponseType:        string(authReq.ResponseType),
+		ResponseMode:        string(authReq.ResponseMode),
+		Display:             string(authReq.Display),
+		Prompt:              authReq.Prompt,
+		MaxAge:              authReq.MaxAge,
+		UILocales:           uiLocales,
+		IDTokenHint:         authReq.IDTokenHint,
+		LoginHint:           authReq.LoginHint,
+		ACRValues:           authReq.ACRValues,
+		CodeChallenge:       authReq.CodeChallenge,
+		CodeChallengeMethod: string(authReq.CodeChallengeMethod),
+	}
+}
+
+func pushedAuthRequestToAuthRequest(pushed *command.PushedAuthRequest) *oidc.AuthRequest {
+	return &oidc.AuthRequest{
+		Scopes:              pushed.Scope,
+		ResponseType:        oidc.ResponseType(pushed.ResponseType),
+		ClientID:            pushed.ClientID,
+		RedirectURI:         pushed.RedirectURI,
+		State:               pushed.State,
+		Nonce:               pushed.Nonce,
+		ResponseMode:        oidc.ResponseMode(pushed.ResponseMode),
+		Display:             oidc.Display(pushed.Display),
+		Prompt:              pushed.Prompt,
+		MaxAge:              pushed.MaxAge,
+		UILocales:           oidc.ParseLocales(pushed.UILocales),
+		IDTokenHint:         pushed.IDTokenHint,
+		LoginHint:           pushed.LoginHint,
+		ACRValues:           pushed.ACRValues,
+		CodeChallenge:       pushed.CodeChallenge,
+		CodeChallengeMethod: oidc.CodeChallengeMethod(pushed.CodeChallengeMethod),
+	}
+}
@@ -0,0 +1,65 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+	"github.com/zitadel/oidc/v3/pkg/op"
+	"golang.org/x/text/language"
+)
+
+func Test_pushedAuthRequestEndpoint(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *EndpointConfig
+		want   *op.Endpoint
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+			want:   op.NewEndpoint("/oauth/v2/par"),
+		},
+		{
+			name:   "default",
+			config: &EndpointConfig{},
+			want:   op.NewEndpoint("/oauth/v2/par"),
+		},
+		{
+			name: "custom",
+			config: &EndpointConfig{
+				PushedAuthRequest: &Endpoint{Path: "/par", URL: "https://example.com/par"},
+			},
+			want: op.NewEndpointWithURL("/par", "https://example.com/par"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pushedAuthRequestEndpoint(tt.config))
+		})
+	}
+}
+
+func Test_pushedAuthRequestConversion(t *testing.T) {
+	authReq := &oidc.AuthRequest{
+		Scopes:              oidc.SpaceDelimitedArray{oidc.ScopeOpenID, oidc.ScopeProfile},
+		ResponseType:        oidc.ResponseTypeCode,
+		ClientID:            "clientID",
+		RedirectURI:         "https://example.com/callback",
+		State:               "state",
+		Nonce:               "nonce",
+		ResponseMode:        oidc.ResponseModeQuery,
+		Display:             oidc.DisplayPage,
+		Prompt:              oidc.SpaceDelimitedArray{oidc.PromptLogin},
+		MaxAge:              gu.Ptr[uint](300),
+		UILocales:           oidc.Locales{language.English, language.German},
+		LoginHint:           "user@example.com",
+		ACRValues:           oidc.SpaceDelimitedArray{"acr"},
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: oidc.CodeChallengeMethodS256,
+	}
+	pushed := authRequestToPushedAuthRequest(authReq)
+	assert.Equal(t, []string{"en", "de"}, pushed.UILocales)
+	assert.Equal(t, authReq, pushedAuthRequestToAuthRequest(pushed))
+}
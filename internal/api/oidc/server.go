@@ -12,8 +12,10 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/auth/repository"
+	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain/tokenreplay"
 	"github.com/zitadel/zitadel/internal/i18n"
 	"github.com/zitadel/zitadel/internal/query"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -29,12 +31,28 @@ type Server struct {
 	accessTokenKeySet *oidcKeySet
 	idTokenHintKeySet *oidcKeySet
 
-	defaultLoginURL            string
-	defaultLoginURLV2          string
-	defaultLogoutURLV2         string
-	defaultAccessTokenLifetime time.Duration
-	defaultIdTokenLifetime     time.Duration
-	jwksCacheControlMaxAge     time.Duration
+	defaultLoginURL             string
+	defaultLoginURLV2           string
+	defaultLogoutURLV2          string
+	defaultAccessTokenLifetime  time.Duration
+	defaultIdTokenLifetime      time.Duration
+	jwksCacheControlMaxAge      time.Duration
+	pushedAuthRequestEndpoint   *op.Endpoint
+	pushedAuthRequestLifetime   time.Duration
+	requirePushedAuthRequests   bool
+	dpopProofLifetime           time.Duration
+	dpopProofReplays            cache.Cache[tokenreplay.Index, string, *tokenreplay.Token]
+	tlsClientCertificateHeader  string
+	backChannelAuthEndpoint     *op.Endpoint
+	backChannelAuthLifetime     time.Duration
+	backChannelAuthPollInterval time.Duration
+	backChannelAuthPolls        cache.Cache[backChannelAuthPollIndex, string, *backChannelAuthPoll]
+	clientRegistrationEndpoint  *op.Endpoint
+	credentialEndpoint          *op.Endpoint
+	credentialNonceEndpoint     *op.Endpoint
+	credentialIssuer            CredentialIssuerConfig
+
+	authorizationDetailsTypesSupported []string
 
 	fallbackLogger      *slog.Logger
 	hasher              *crypto.Hasher
@@ -134,14 +152,46 @@ func (s *Server) VerifyAuthRequest(ctx context.Context, r *op.Request[oidc.AuthR
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.VerifyAuthRequest(ctx, r)
+	pushed, usesRequestURI, err := s.pushedAuthRequestFromRequestURI(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if usesRequestURI {
+		r.Data = pushed
+	}
+	request, err := s.LegacyServer.VerifyAuthRequest(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if s.requirePushedAuthRequest(request.Client) && !usesRequestURI {
+		return nil, oidc.ErrInvalidRequest().WithDescription("pushed authorization request required")
+	}
+	// tokens returned directly from the authorization endpoint (implicit and hybrid flows) cannot be DPoP bound
+	if client, ok := request.Client.(*Client); ok && client.client.RequireDPoP && request.Data.ResponseType != oidc.ResponseTypeCode {
+		return nil, oidc.ErrInvalidRequest().WithDescription("DPoP required, only the code response type is supported")
+	}
+	return request, nil
+}
+
+// requirePushedAuthRequest returns if the client must use the Pushed Authorization Request endpoint,
+// either because it's required for all clients or by the setting of the app.
+func (s *Server) requirePushedAuthRequest(client op.Client) bool {
+	if s.requirePushedAuthRequests {
+		return true
+	}
+	c, ok := client.(*Client)
+	return ok && c.client.RequirePushedAuthorizationRequests
 }
 
 func (s *Server) Authorize(ctx context.Context, r *op.ClientRequest[oidc.AuthRequest]) (_ *op.Redirect, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	return s.LegacyServer.Authorize(ctx, r)
+	details, err := s.authorizationDetailsFromForm(r.Form)
+	if err != nil {
+		return op.TryErrorRedirect(ctx, r.Data, err, s.Provider().Encoder(), s.getLogger(ctx))
+	}
+	return s.LegacyServer.Authorize(withAuthorizationDetails(ctx, details), r)
 }
 
 func (s *Server) DeviceAuthorization(ctx context.Context, r *op.ClientRequest[oidc.DeviceAuthorizationRequest]) (_ *op.Response, err error) {
@@ -165,11 +215,27 @@ func (s *Server) EndSession(ctx context.Context, r *op.Request[oidc.EndSessionRe
 	return s.LegacyServer.EndSession(ctx, r)
 }
 
-func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *oidc.DiscoveryConfiguration {
+// discoveryConfiguration extends the [oidc.DiscoveryConfiguration] with the metadata of the
+// Pushed Authorization Request endpoint (RFC 9126), DPoP (RFC 9449), mutual TLS (RFC 8705),
+// Client Initiated Backchannel Authentication (CIBA) and Rich Authorization Requests (RFC 9396),
+// which are not part of the oidc library.
+type discoveryConfiguration struct {
+	*oidc.DiscoveryConfiguration
+	PushedAuthorizationRequestEndpoint     string   `json:"pushed_authorization_request_endpoint,omitempty"`
+	RequirePushedAuthorizationRequests     bool     `json:"require_pushed_authorization_requests"`
+	DPoPSigningAlgValuesSupported          []string `json:"dpop_signing_alg_values_supported,omitempty"`
+	TLSClientCertificateBoundAccessTokens  bool     `json:"tls_client_certificate_bound_access_tokens,omitempty"`
+	BackChannelAuthenticationEndpoint      string   `json:"backchannel_authentication_endpoint,omitempty"`
+	BackChannelTokenDeliveryModesSupported []string `json:"backchannel_token_delivery_modes_supported,omitempty"`
+	BackChannelUserCodeParameterSupported  bool     `json:"backchannel_user_code_parameter_supported"`
+	AuthorizationDetailsTypesSupported     []string `json:"authorization_details_types_supported,omitempty"`
+}
+
+func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales oidc.Locales) *discoveryConfiguration {
 	issuer := op.IssuerFromContext(ctx)
 	backChannelLogoutSupported := authz.GetInstance(ctx).Features().EnableBackChannelLogout
 
-	return &oidc.DiscoveryConfiguration{
+	config := &oidc.DiscoveryConfiguration{
 		Issuer:                      issuer,
 		AuthorizationEndpoint:       s.Endpoints().Authorization.Absolute(issuer),
 		TokenEndpoint:               s.Endpoints().Token.Absolute(issuer),
@@ -202,6 +268,23 @@ func (s *Server) createDiscoveryConfig(ctx context.Context, supportedUILocales o
 		RequestParameterSupported:                          s.Provider().RequestObjectSupported(),
 		BackChannelLogoutSupported:                         backChannelLogoutSupported,
 		BackChannelLogoutSessionSupported:                  backChannelLogoutSupported,
+		RegistrationEndpoint:                               s.clientRegistrationEndpoint.Absolute(issuer),
+	}
+	tlsClientAuthSupported := s.tlsClientCertificateHeader != ""
+	if tlsClientAuthSupported {
+		config.TokenEndpointAuthMethodsSupported = append(config.TokenEndpointAuthMethodsSupported, tlsClientAuthMethods...)
+		config.IntrospectionEndpointAuthMethodsSupported = append(config.IntrospectionEndpointAuthMethodsSupported, tlsClientAuthMethods...)
+	}
+	config.GrantTypesSupported = append(config.GrantTypesSupported, grantTypeCIBA)
+	return &discoveryConfiguration{
+		DiscoveryConfiguration:                 config,
+		PushedAuthorizationRequestEndpoint:     s.pushedAuthRequestEndpoint.Absolute(issuer),
+		RequirePushedAuthorizationRequests:     s.requirePushedAuthRequests,
+		DPoPSigningAlgValuesSupported:          dpopSigningAlgValuesSupported(),
+		TLSClientCertificateBoundAccessTokens:  tlsClientAuthSupported,
+		BackChannelAuthenticationEndpoint:      s.backChannelAuthEndpoint.Absolute(issuer),
+		BackChannelTokenDeliveryModesSupported: backChannelTokenDeliveryModesSupported(),
+		AuthorizationDetailsTypesSupported:     s.authorizationDetailsTypesSupported,
 	}
 }
 
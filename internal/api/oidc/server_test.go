@@ -12,8 +12,13 @@ import (
 
 func TestServer_createDiscoveryConfig(t *testing.T) {
 	type fields struct {
-		LegacyServer        *op.LegacyServer
-		signingKeyAlgorithm string
+		LegacyServer               *op.LegacyServer
+		signingKeyAlgorithm        string
+		pushedAuthRequestEndpoint  *op.Endpoint
+		requirePushedAuthRequests  bool
+		tlsClientCertificateHeader string
+		backChannelAuthEndpoint    *op.Endpoint
+		clientRegistrationEndpoint *op.Endpoint
 	}
 	type args struct {
 		ctx                context.Context
@@ -23,7 +28,7 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 		name   string
 		fields fields
 		args   args
-		want   *oidc.DiscoveryConfiguration
+		want   *discoveryConfiguration
 	}{
 		{
 			"config",
@@ -54,66 +59,176 @@ func TestServer_createDiscoveryConfig(t *testing.T) {
 						DeviceAuthorization: op.NewEndpoint("device"),
 					},
 				),
-				signingKeyAlgorithm: "RS256",
+				signingKeyAlgorithm:        "RS256",
+				pushedAuthRequestEndpoint:  op.NewEndpoint("par"),
+				backChannelAuthEndpoint:    op.NewEndpoint("bc-authorize"),
+				clientRegistrationEndpoint: op.NewEndpoint("register"),
 			},
 			args{
 				ctx:                op.ContextWithIssuer(context.Background(), "https://issuer.com"),
 				supportedUILocales: []language.Tag{language.English, language.German},
 			},
-			&oidc.DiscoveryConfiguration{
-				Issuer:                                             "https://issuer.com",
-				AuthorizationEndpoint:                              "https://issuer.com/auth",
-				TokenEndpoint:                                      "https://issuer.com/token",
-				IntrospectionEndpoint:                              "https://issuer.com/introspect",
-				UserinfoEndpoint:                                   "https://issuer.com/userinfo",
-				RevocationEndpoint:                                 "https://issuer.com/revoke",
-				EndSessionEndpoint:                                 "https://issuer.com/logout",
-				DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
-				CheckSessionIframe:                                 "",
-				JwksURI:                                            "https://issuer.com/keys",
-				RegistrationEndpoint:                               "",
-				ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
-				ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
-				ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
-				GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer},
-				ACRValuesSupported:                                 nil,
-				SubjectTypesSupported:                              []string{"public"},
-				IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
-				IDTokenEncryptionAlgValuesSupported:                nil,
-				IDTokenEncryptionEncValuesSupported:                nil,
-				UserinfoSigningAlgValuesSupported:                  nil,
-				UserinfoEncryptionAlgValuesSupported:               nil,
-				UserinfoEncryptionEncValuesSupported:               nil,
-				RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
-				RequestObjectEncryptionAlgValuesSupported:          nil,
-				RequestObjectEncryptionEncValuesSupported:          nil,
-				TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
-				RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
-				RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
-				IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
-				IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
-				DisplayValuesSupported:                             nil,
-				ClaimTypesSupported:                                nil,
-				ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
-				ClaimsParameterSupported:                           false,
-				CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
-				ServiceDocumentation:                               "",
-				ClaimsLocalesSupported:                             nil,
-				UILocalesSupported:                                 []language.Tag{language.English, language.German},
-				RequestParameterSupported:                          true,
-				RequestURIParameterSupported:                       false,
-				RequireRequestURIRegistration:                      false,
-				OPPolicyURI:                                        "",
-				OPTermsOfServiceURI:                                "",
+			&discoveryConfiguration{
+				DiscoveryConfiguration: &oidc.DiscoveryConfiguration{
+					Issuer:                                             "https://issuer.com",
+					AuthorizationEndpoint:                              "https://issuer.com/auth",
+					TokenEndpoint:                                      "https://issuer.com/token",
+					IntrospectionEndpoint:                              "https://issuer.com/introspect",
+					UserinfoEndpoint:                                   "https://issuer.com/userinfo",
+					RevocationEndpoint:                                 "https://issuer.com/revoke",
+					EndSessionEndpoint:                                 "https://issuer.com/logout",
+					DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
+					CheckSessionIframe:                                 "",
+					JwksURI:                                            "https://issuer.com/keys",
+					RegistrationEndpoint:                               "https://issuer.com/register",
+					ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
+					ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
+					ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
+					GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer, grantTypeCIBA},
+					ACRValuesSupported:                                 nil,
+					SubjectTypesSupported:                              []string{"public"},
+					IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
+					IDTokenEncryptionAlgValuesSupported:                nil,
+					IDTokenEncryptionEncValuesSupported:                nil,
+					UserinfoSigningAlgValuesSupported:                  nil,
+					UserinfoEncryptionAlgValuesSupported:               nil,
+					UserinfoEncryptionEncValuesSupported:               nil,
+					RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
+					RequestObjectEncryptionAlgValuesSupported:          nil,
+					RequestObjectEncryptionEncValuesSupported:          nil,
+					TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
+					RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
+					IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT},
+					IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
+					DisplayValuesSupported:                             nil,
+					ClaimTypesSupported:                                nil,
+					ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
+					ClaimsParameterSupported:                           false,
+					CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
+					ServiceDocumentation:                               "",
+					ClaimsLocalesSupported:                             nil,
+					UILocalesSupported:                                 []language.Tag{language.English, language.German},
+					RequestParameterSupported:                          true,
+					RequestURIParameterSupported:                       false,
+					RequireRequestURIRegistration:                      false,
+					OPPolicyURI:                                        "",
+					OPTermsOfServiceURI:                                "",
+				},
+				PushedAuthorizationRequestEndpoint:     "https://issuer.com/par",
+				DPoPSigningAlgValuesSupported:          []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"},
+				BackChannelAuthenticationEndpoint:      "https://issuer.com/bc-authorize",
+				BackChannelTokenDeliveryModesSupported: []string{"poll", "ping"},
+			},
+		},
+		{
+			"config with tls client certificate header and required pushed authorization requests",
+			fields{
+				LegacyServer: op.NewLegacyServer(
+					func() *op.Provider {
+						//nolint:staticcheck
+						provider, _ := op.NewForwardedOpenIDProvider("path",
+							&op.Config{
+								CodeMethodS256:          true,
+								AuthMethodPost:          true,
+								AuthMethodPrivateKeyJWT: true,
+								GrantTypeRefreshToken:   true,
+								RequestObjectSupported:  true,
+							},
+							nil,
+						)
+						return provider
+					}(),
+					op.Endpoints{
+						Authorization:       op.NewEndpoint("auth"),
+						Token:               op.NewEndpoint("token"),
+						Introspection:       op.NewEndpoint("introspect"),
+						Userinfo:            op.NewEndpoint("userinfo"),
+						Revocation:          op.NewEndpoint("revoke"),
+						EndSession:          op.NewEndpoint("logout"),
+						JwksURI:             op.NewEndpoint("keys"),
+						DeviceAuthorization: op.NewEndpoint("device"),
+					},
+				),
+				signingKeyAlgorithm:        "RS256",
+				pushedAuthRequestEndpoint:  op.NewEndpoint("par"),
+				requirePushedAuthRequests:  true,
+				tlsClientCertificateHeader: "X-SSL-Client-Cert",
+				backChannelAuthEndpoint:    op.NewEndpoint("bc-authorize"),
+				clientRegistrationEndpoint: op.NewEndpoint("register"),
+			},
+			args{
+				ctx:                op.ContextWithIssuer(context.Background(), "https://issuer.com"),
+				supportedUILocales: []language.Tag{language.English, language.German},
+			},
+			&discoveryConfiguration{
+				DiscoveryConfiguration: &oidc.DiscoveryConfiguration{
+					Issuer:                                             "https://issuer.com",
+					AuthorizationEndpoint:                              "https://issuer.com/auth",
+					TokenEndpoint:                                      "https://issuer.com/token",
+					IntrospectionEndpoint:                              "https://issuer.com/introspect",
+					UserinfoEndpoint:                                   "https://issuer.com/userinfo",
+					RevocationEndpoint:                                 "https://issuer.com/revoke",
+					EndSessionEndpoint:                                 "https://issuer.com/logout",
+					DeviceAuthorizationEndpoint:                        "https://issuer.com/device",
+					CheckSessionIframe:                                 "",
+					JwksURI:                                            "https://issuer.com/keys",
+					RegistrationEndpoint:                               "https://issuer.com/register",
+					ScopesSupported:                                    []string{oidc.ScopeOpenID, oidc.ScopeProfile, oidc.ScopeEmail, oidc.ScopePhone, oidc.ScopeAddress, oidc.ScopeOfflineAccess},
+					ResponseTypesSupported:                             []string{string(oidc.ResponseTypeCode), string(oidc.ResponseTypeIDTokenOnly), string(oidc.ResponseTypeIDToken)},
+					ResponseModesSupported:                             []string{string(oidc.ResponseModeQuery), string(oidc.ResponseModeFragment), string(oidc.ResponseModeFormPost)},
+					GrantTypesSupported:                                []oidc.GrantType{oidc.GrantTypeCode, oidc.GrantTypeImplicit, oidc.GrantTypeRefreshToken, oidc.GrantTypeBearer, grantTypeCIBA},
+					ACRValuesSupported:                                 nil,
+					SubjectTypesSupported:                              []string{"public"},
+					IDTokenSigningAlgValuesSupported:                   supportedWebKeyAlgs,
+					IDTokenEncryptionAlgValuesSupported:                nil,
+					IDTokenEncryptionEncValuesSupported:                nil,
+					UserinfoSigningAlgValuesSupported:                  nil,
+					UserinfoEncryptionAlgValuesSupported:               nil,
+					UserinfoEncryptionEncValuesSupported:               nil,
+					RequestObjectSigningAlgValuesSupported:             []string{"RS256"},
+					RequestObjectEncryptionAlgValuesSupported:          nil,
+					RequestObjectEncryptionEncValuesSupported:          nil,
+					TokenEndpointAuthMethodsSupported:                  []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT, "tls_client_auth", "self_signed_tls_client_auth"},
+					TokenEndpointAuthSigningAlgValuesSupported:         []string{"RS256"},
+					RevocationEndpointAuthMethodsSupported:             []oidc.AuthMethod{oidc.AuthMethodNone, oidc.AuthMethodBasic, oidc.AuthMethodPost, oidc.AuthMethodPrivateKeyJWT},
+					RevocationEndpointAuthSigningAlgValuesSupported:    []string{"RS256"},
+					IntrospectionEndpointAuthMethodsSupported:          []oidc.AuthMethod{oidc.AuthMethodBasic, oidc.AuthMethodPrivateKeyJWT, "tls_client_auth", "self_signed_tls_client_auth"},
+					IntrospectionEndpointAuthSigningAlgValuesSupported: []string{"RS256"},
+					DisplayValuesSupported:                             nil,
+					ClaimTypesSupported:                                nil,
+					ClaimsSupported:                                    []string{"sub", "aud", "exp", "iat", "iss", "auth_time", "nonce", "acr", "amr", "c_hash", "at_hash", "act", "scopes", "client_id", "azp", "preferred_username", "name", "family_name", "given_name", "locale", "email", "email_verified", "phone_number", "phone_number_verified"},
+					ClaimsParameterSupported:                           false,
+					CodeChallengeMethodsSupported:                      []oidc.CodeChallengeMethod{"S256"},
+					ServiceDocumentation:                               "",
+					ClaimsLocalesSupported:                             nil,
+					UILocalesSupported:                                 []language.Tag{language.English, language.German},
+					RequestParameterSupported:                          true,
+					RequestURIParameterSupported:                       false,
+					RequireRequestURIRegistration:                      false,
+					OPPolicyURI:                                        "",
+					OPTermsOfServiceURI:                                "",
+				},
+				PushedAuthorizationRequestEndpoint:     "https://issuer.com/par",
+				RequirePushedAuthorizationRequests:     true,
+				DPoPSigningAlgValuesSupported:          []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"},
+				TLSClientCertificateBoundAccessTokens:  true,
+				BackChannelAuthenticationEndpoint:      "https://issuer.com/bc-authorize",
+				BackChannelTokenDeliveryModesSupported: []string{"poll", "ping"},
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			s := &Server{
-				LegacyServer:        tt.fields.LegacyServer,
-				signingKeyAlgorithm: tt.fields.signingKeyAlgorithm,
+				LegacyServer:               tt.fields.LegacyServer,
+				signingKeyAlgorithm:        tt.fields.signingKeyAlgorithm,
+				pushedAuthRequestEndpoint:  tt.fields.pushedAuthRequestEndpoint,
+				requirePushedAuthRequests:  tt.fields.requirePushedAuthRequests,
+				tlsClientCertificateHeader: tt.fields.tlsClientCertificateHeader,
+				backChannelAuthEndpoint:    tt.fields.backChannelAuthEndpoint,
+				clientRegistrationEndpoint: tt.fields.clientRegistrationEndpoint,
 			}
 			assert.Equalf(t, tt.want, s.createDiscoveryConfig(tt.args.ctx, tt.args.supportedUILocales), "createDiscoveryConfig(%v)", tt.args.ctx)
 		})
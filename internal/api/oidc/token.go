@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/go-jose/go-jose/v4"
+	"github.com/muhlemmer/gu"
 	"github.com/zitadel/oidc/v3/pkg/crypto"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
@@ -30,15 +31,21 @@ In some cases step 1 till 3 are completely implemented in the command package,
 for example the v2 code exchange and refresh token.
 */
 
-func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.Client, session *command.OIDCSession, state, projectID string, projectRoleAssertion, accessTokenRoleAssertion, idTokenRoleAssertion, userInfoAssertion bool) (_ *oidc.AccessTokenResponse, err error) {
+func (s *Server) accessTokenResponseFromSession(ctx context.Context, client op.Client, session *command.OIDCSession, state, projectID string, projectRoleAssertion, accessTokenRoleAssertion, idTokenRoleAssertion, userInfoAssertion bool) (_ *accessTokenResponse, err error) {
 	getUserInfo := s.getUserInfo(session.UserID, projectID, projectRoleAssertion, userInfoAssertion, session.Scope)
 	getSigner := s.getSignerOnce()
 
-	resp := &oidc.AccessTokenResponse{
-		TokenType:    oidc.BearerToken,
-		RefreshToken: session.RefreshToken,
-		ExpiresIn:    timeToOIDCExpiresIn(session.Expiration),
-		State:        state,
+	resp := &accessTokenResponse{
+		AccessTokenResponse: &oidc.AccessTokenResponse{
+			TokenType:    oidc.BearerToken,
+			RefreshToken: session.RefreshToken,
+			ExpiresIn:    timeToOIDCExpiresIn(session.Expiration),
+			State:        state,
+		},
+		AuthorizationDetails: session.AuthorizationDetails,
+	}
+	if session.DPoPJKT != "" {
+		resp.TokenType = DPoPTokenType
 	}
 
 	// If the session does not have a token ID, it is an implicit ID-Token only response.
@@ -189,6 +196,20 @@ func (s *Server) createJWT(ctx context.Context, client op.Client, session *comma
 	)
 	claims.Actor = actorDomainToClaims(session.Actor)
 	claims.Claims = userInfo.Claims
+	confirmation := tokenConfirmation(session.DPoPJKT, session.CertificateThumbprint)
+	if confirmation != nil || len(session.AuthorizationDetails) > 0 {
+		// the claims of the userinfo must not be altered, as it might be reused
+		claims.Claims = gu.MapCopy(userInfo.Claims)
+		if claims.Claims == nil {
+			claims.Claims = make(map[string]any, 2)
+		}
+	}
+	if confirmation != nil {
+		claims.Claims[confirmationClaim] = confirmation
+	}
+	if len(session.AuthorizationDetails) > 0 {
+		claims.Claims[authorizationDetailsParam] = session.AuthorizationDetails
+	}
 
 	return crypto.Sign(claims, signer)
 }
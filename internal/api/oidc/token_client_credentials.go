@@ -7,6 +7,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
@@ -22,6 +23,10 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 	if !ok {
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
+	dpopJKT, err := s.tokenRequestDPoPKey(ctx, r.Method, r.Header, false)
+	if err != nil {
+		return nil, err
+	}
 	scope, err := op.ValidateAuthReqScopes(client, r.Data.Scope)
 	if err != nil {
 		return nil, err
@@ -48,6 +53,10 @@ func (s *Server) ClientCredentialsExchange(ctx context.Context, r *op.ClientRequ
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		"", // client credentials are not authenticated by a client certificate
+		nil,
+		command.ClientTokenLifetimes{}, // service users are not applications with configured token lifetimes
 	)
 	if err != nil {
 		return nil, err
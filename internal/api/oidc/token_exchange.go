@@ -52,6 +52,10 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 		// not supposed to happen, but just preventing a panic if it does.
 		return nil, zerrors.ThrowInternal(nil, "OIDC-eShi5", "Error.Internal")
 	}
+	dpopJKT, err := s.tokenRequestDPoPKey(ctx, r.Method, r.Header, client.client.RequireDPoP)
+	if err != nil {
+		return nil, err
+	}
 
 	subjectToken, err := s.verifyExchangeToken(ctx, client, r.Data.SubjectToken, r.Data.SubjectTokenType, oidc.AllTokenTypes...)
 	if err != nil {
@@ -81,8 +85,12 @@ func (s *Server) tokenExchange(ctx context.Context, r *op.ClientRequest[oidc.Tok
 	if err != nil {
 		return nil, err
 	}
+	authorizationDetails, err := s.requestedAuthorizationDetails(r.Form, subjectToken.authorizationDetails)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := s.createExchangeTokens(ctx, r.Data.RequestedTokenType, client, subjectToken, actorToken, audience, scopes)
+	resp, err := s.createExchangeTokens(ctx, r.Data.RequestedTokenType, client, subjectToken, actorToken, audience, scopes, authorizationDetails, dpopJKT)
 	if err != nil {
 		return nil, err
 	}
@@ -217,12 +225,15 @@ func validateTokenExchangeAudience(requestedAudience, subjectAudience, actorAudi
 // The actorToken is used to set the new token's auth time AMR and actor.
 // Both tokens may point to the same object (subjectToken) in case of a regular Token Exchange.
 // When the subject and actor Tokens point to different objects, the new tokens will be for impersonation / delegation.
-func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenType, client *Client, subjectToken, actorToken *exchangeToken, audience, scopes []string) (_ *oidc.TokenExchangeResponse, err error) {
+// The authorizationDetails and the JWK thumbprint of the DPoP proof are only bound to (and returned for) access tokens.
+func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenType, client *Client, subjectToken, actorToken *exchangeToken, audience, scopes []string, authorizationDetails domain.AuthorizationDetails, dpopJKT string) (_ *tokenExchangeResponse, err error) {
 	getUserInfo := s.getUserInfo(subjectToken.userID, client.client.ProjectID, client.client.ProjectRoleAssertion, client.IDTokenUserinfoClaimsAssertion(), scopes)
 	getSigner := s.getSignerOnce()
 
-	resp := &oidc.TokenExchangeResponse{
-		Scopes: scopes,
+	resp := &tokenExchangeResponse{
+		TokenExchangeResponse: &oidc.TokenExchangeResponse{
+			Scopes: scopes,
+		},
 	}
 
 	reason := domain.TokenReasonExchange
@@ -235,14 +246,16 @@ func (s *Server) createExchangeTokens(ctx context.Context, tokenType oidc.TokenT
 	var sessionID string
 	switch tokenType {
 	case oidc.AccessTokenType, "":
-		resp.AccessToken, resp.RefreshToken, sessionID, resp.ExpiresIn, err = s.createExchangeAccessToken(ctx, client, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor)
-		resp.TokenType = oidc.BearerToken
+		resp.AccessToken, resp.RefreshToken, sessionID, resp.ExpiresIn, err = s.createExchangeAccessToken(ctx, client, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor, authorizationDetails, dpopJKT)
+		resp.TokenType = exchangeAccessTokenType(dpopJKT)
 		resp.IssuedTokenType = oidc.AccessTokenType
+		resp.AuthorizationDetails = authorizationDetails
 
 	case oidc.JWTTokenType:
-		resp.AccessToken, resp.RefreshToken, resp.ExpiresIn, err = s.createExchangeJWT(ctx, client, getUserInfo, client.client.AccessTokenRoleAssertion, getSigner, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor)
-		resp.TokenType = oidc.BearerToken
+		resp.AccessToken, resp.RefreshToken, resp.ExpiresIn, err = s.createExchangeJWT(ctx, client, getUserInfo, client.client.AccessTokenRoleAssertion, getSigner, subjectToken.userID, subjectToken.resourceOwner, audience, scopes, actorToken.authMethods, actorToken.authTime, subjectToken.preferredLanguage, reason, actor, authorizationDetails, dpopJKT)
+		resp.TokenType = exchangeAccessTokenType(dpopJKT)
 		resp.IssuedTokenType = oidc.JWTTokenType
+		resp.AuthorizationDetails = authorizationDetails
 
 	case oidc.IDTokenType:
 		resp.AccessToken, resp.ExpiresIn, err = s.createIDToken(ctx, client, getUserInfo, client.client.IDTokenRoleAssertion, getSigner, "", resp.AccessToken, audience, actorToken.authMethods, actorToken.authTime, "", actor)
@@ -280,6 +293,8 @@ func (s *Server) createExchangeAccessToken(
 	preferredLanguage *language.Tag,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	authorizationDetails domain.AuthorizationDetails,
+	dpopJKT string,
 ) (accessToken, refreshToken, sessionID string, exp uint64, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -301,6 +316,10 @@ func (s *Server) createExchangeAccessToken(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		"", // exchanged tokens are not certificate bound
+		authorizationDetails,
+		client.tokenLifetimes(),
 	)
 	if err != nil {
 		return "", "", "", 0, err
@@ -312,6 +331,15 @@ func (s *Server) createExchangeAccessToken(
 	return accessToken, session.RefreshToken, session.SessionID, timeToOIDCExpiresIn(session.Expiration), nil
 }
 
+// exchangeAccessTokenType returns the token_type of an exchanged access token,
+// which is DPoP if the token is bound to the key of a DPoP proof.
+func exchangeAccessTokenType(dpopJKT string) string {
+	if dpopJKT != "" {
+		return DPoPTokenType
+	}
+	return oidc.BearerToken
+}
+
 func (s *Server) createExchangeJWT(
 	ctx context.Context,
 	client *Client,
@@ -327,6 +355,8 @@ func (s *Server) createExchangeJWT(
 	preferredLanguage *language.Tag,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	authorizationDetails domain.AuthorizationDetails,
+	dpopJKT string,
 ) (accessToken string, refreshToken string, exp uint64, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -348,6 +378,10 @@ func (s *Server) createExchangeJWT(
 		slices.Contains(scope, oidc.ScopeOfflineAccess),
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		"", // exchanged tokens are not certificate bound
+		authorizationDetails,
+		client.tokenLifetimes(),
 	)
 	if err != nil {
 		return "", "", 0, err
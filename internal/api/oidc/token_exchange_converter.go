@@ -21,6 +21,8 @@ type exchangeToken struct {
 	audience          []string
 	scopes            []string
 	preferredLanguage *language.Tag
+
+	authorizationDetails domain.AuthorizationDetails
 }
 
 func (et *exchangeToken) nestedActor() *domain.TokenActor {
@@ -42,6 +44,8 @@ func accessToExchangeToken(token *accessToken, issuer string) *exchangeToken {
 		audience:          token.audience,
 		scopes:            token.scope,
 		preferredLanguage: token.preferredLanguage,
+
+		authorizationDetails: token.authorizationDetails,
 	}
 }
 
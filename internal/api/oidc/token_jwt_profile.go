@@ -8,6 +8,7 @@ import (
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"github.com/zitadel/oidc/v3/pkg/op"
 
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/query"
@@ -21,6 +22,10 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		err = oidcError(err)
 	}()
 
+	dpopJKT, err := s.tokenRequestDPoPKey(ctx, r.Method, r.Header, false)
+	if err != nil {
+		return nil, err
+	}
 	user, err := s.verifyJWTProfile(ctx, r.Data)
 	if err != nil {
 		return nil, err
@@ -58,6 +63,10 @@ func (s *Server) JWTProfile(ctx context.Context, r *op.Request[oidc.JWTProfileGr
 		false,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		"", // JWT profile grants are not authenticated by a client certificate
+		nil,
+		command.ClientTokenLifetimes{}, // service users are not applications with configured token lifetimes
 	)
 	if err != nil {
 		return nil, err
@@ -26,12 +26,28 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 		return nil, zerrors.ThrowInternal(nil, "OIDC-ga0EP", "Error.Internal")
 	}
 
-	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, refreshTokenComplianceChecker())
+	dpopJKT, err := s.tokenRequestDPoPKey(ctx, r.Method, r.Header, client.client.RequireDPoP)
+	if err != nil {
+		return nil, err
+	}
+	certificateThumbprint := tokenRequestCertificateThumbprint(ctx, client)
+
+	requestedDetails, err := s.authorizationDetailsFromForm(r.Form)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := s.command.ExchangeOIDCSessionRefreshAndAccessToken(ctx, r.Data.RefreshToken, r.Data.Scopes, requestedDetails, refreshTokenComplianceChecker(requestedDetails), dpopJKT, certificateThumbprint, client.tokenLifetimes())
 	if err == nil {
 		return response(s.accessTokenResponseFromSession(ctx, client, session, "", client.client.ProjectID, client.client.ProjectRoleAssertion, client.client.AccessTokenRoleAssertion, client.client.IDTokenRoleAssertion, client.client.IDTokenUserinfoAssertion))
 	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-JOI23", "Errors.OIDCSession.RefreshTokenInvalid")) {
 		// We try again for v1 tokens when we encountered specific parsing error
-		return s.refreshTokenV1(ctx, client, r)
+		if len(requestedDetails) > 0 {
+			return nil, errInvalidAuthorizationDetails().WithDescription("authorization_details exceed the granted authorization_details")
+		}
+		return s.refreshTokenV1(ctx, client, r, dpopJKT, certificateThumbprint)
+	} else if errors.Is(err, zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ahx5e", "Errors.OIDCSession.DPoPKeyMismatch")) {
+		return nil, errInvalidDPoPProof().WithParent(err).WithDescription("DPoP proof does not match the bound key")
 	}
 	return nil, err
 }
@@ -41,7 +57,7 @@ func (s *Server) RefreshToken(ctx context.Context, r *op.ClientRequest[oidc.Refr
 // This "upgrades" existing v1 sessions to v2 session without requiring users to re-login.
 //
 // This function can be removed when we retire the v1 token repo.
-func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest]) (_ *op.Response, err error) {
+func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.ClientRequest[oidc.RefreshTokenRequest], dpopJKT, certificateThumbprint string) (_ *op.Response, err error) {
 	refreshToken, err := s.repo.RefreshTokenByToken(ctx, r.Data.RefreshToken)
 	if err != nil {
 		return nil, err
@@ -70,6 +86,10 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 		true,
 		"",
 		domain.OIDCResponseTypeUnspecified,
+		dpopJKT,
+		certificateThumbprint,
+		nil, // v1 refresh tokens do not have authorization details
+		client.tokenLifetimes(),
 	)
 	if err != nil {
 		return nil, err
@@ -85,8 +105,13 @@ func (s *Server) refreshTokenV1(ctx context.Context, client *Client, r *op.Clien
 }
 
 // refreshTokenComplianceChecker validates that the requested scope is a subset of the original auth request scope.
-func refreshTokenComplianceChecker() command.RefreshTokenComplianceChecker {
+// Requested authorization details must also be part of the originally granted ones,
+// the new access token is then narrowed to them (RFC 9396, section 7.4).
+func refreshTokenComplianceChecker(requestedDetails domain.AuthorizationDetails) command.RefreshTokenComplianceChecker {
 	return func(_ context.Context, model *command.OIDCSessionWriteModel, requestedScope []string) ([]string, error) {
+		if !model.AuthorizationDetails.Contains(requestedDetails) {
+			return nil, errInvalidAuthorizationDetails().WithDescription("authorization_details exceed the granted authorization_details")
+		}
 		return validateRefreshTokenScopes(model.Scope, requestedScope)
 	}
 }
@@ -37,6 +37,12 @@ func (s *Server) UserInfo(ctx context.Context, r *op.Request[oidc.UserInfoReques
 	if err != nil {
 		return nil, op.NewStatusError(oidc.ErrAccessDenied().WithDescription("access token invalid").WithParent(err).WithReturnParentToClient(authz.GetFeatures(ctx).DebugOIDCParentError), http.StatusUnauthorized)
 	}
+	if err = s.verifyDPoPBoundAccessToken(ctx, token, r.Data.AccessToken, r.Method, r.Header, s.Endpoints().Userinfo); err != nil {
+		return nil, op.NewStatusError(err, http.StatusUnauthorized)
+	}
+	if err = verifyCertificateBoundAccessToken(ctx, token); err != nil {
+		return nil, op.NewStatusError(err, http.StatusUnauthorized)
+	}
 
 	var (
 		projectID string
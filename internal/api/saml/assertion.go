@@ -0,0 +1,343 @@
+package saml
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/beevik/etree"
+	"github.com/crewjam/saml/xmlenc"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	elementType        = "http://www.w3.org/2001/04/xmlenc#Element"
+
+	aes128GCM = "http://www.w3.org/2009/xmlenc11#aes128-gcm"
+	aes256GCM = "http://www.w3.org/2009/xmlenc11#aes256-gcm"
+
+	gcmNonceSize = 12
+)
+
+var (
+	signatureHashes = map[string]crypto.Hash{
+		dsig.RSASHA256SignatureMethod: crypto.SHA256,
+		dsig.RSASHA512SignatureMethod: crypto.SHA512,
+	}
+	digestHashes = map[string]crypto.Hash{
+		domain.SAMLDigestAlgorithmSHA256.URI(): crypto.SHA256,
+		domain.SAMLDigestAlgorithmSHA512.URI(): crypto.SHA512,
+	}
+)
+
+// customizedAssertion returns true if the service provider requires the assertion
+// to be signed with other algorithms than the default, to be encrypted or to contain another NameID.
+func customizedAssertion(sp *query.SAMLServiceProvider) bool {
+	return customizedSignature(sp) || sp.NameIDFormat != nil
+}
+
+// customizedSignature returns true if the service provider requires the assertion
+// to be signed with other algorithms than the default or to be encrypted,
+// which is only supported for the POST binding.
+func customizedSignature(sp *query.SAMLServiceProvider) bool {
+	return sp.SignatureAlgorithm != domain.SAMLSignatureAlgorithmUnspecified ||
+		sp.DigestAlgorithm != domain.SAMLDigestAlgorithmUnspecified ||
+		sp.AssertionEncryption != domain.SAMLAssertionEncryptionNone
+}
+
+// assertionResponse marshals the response with the assertion signed using the algorithms of the service provider.
+// If configured, the signed assertion is encrypted with the encryption certificate of the service provider
+// and returned as EncryptedAssertion.
+func assertionResponse(
+	samlResponse *samlp.ResponseType,
+	key *rsa.PrivateKey,
+	cert []byte,
+	signatureAlgorithm,
+	digestAlgorithm string,
+	encryption domain.SAMLAssertionEncryption,
+	metadata *md.EntityDescriptorType,
+) ([]byte, error) {
+	unsignedAssertion := samlResponse.Assertion
+	unsignedAssertion.Signature = nil
+	assertion, err := marshalElement(unsignedAssertion)
+	if err != nil {
+		return nil, err
+	}
+	if err := signAssertion(assertion, key, cert, signatureAlgorithm, digestAlgorithm); err != nil {
+		return nil, err
+	}
+	if encryption != domain.SAMLAssertionEncryptionNone {
+		encryptionCert, err := encryptionCertificate(metadata)
+		if err != nil {
+			return nil, err
+		}
+		assertion, err = encryptAssertion(assertion, encryptionCert, encryption)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	response := *samlResponse
+	response.Assertion.Signature = nil
+	responseEl, err := marshalElement(response)
+	if err != nil {
+		return nil, err
+	}
+	placeholder := responseEl.SelectElement("Assertion")
+	if placeholder == nil {
+		return nil, errors.New("assertion missing in response")
+	}
+	responseEl.InsertChildAt(placeholder.Index(), assertion)
+	responseEl.RemoveChild(placeholder)
+
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
+	doc.SetRoot(responseEl)
+	return doc.WriteToBytes()
+}
+
+func marshalElement(v interface{}) (*etree.Element, error) {
+	data, err := xml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, err
+	}
+	return doc.Root(), nil
+}
+
+// signAssertion adds an enveloped signature to the assertion, placed directly after its Issuer.
+func signAssertion(assertion *etree.Element, key *rsa.PrivateKey, cert []byte, signatureAlgorithm, digestAlgorithm string) error {
+	signatureHash, ok := signatureHashes[signatureAlgorithm]
+	if !ok {
+		return fmt.Errorf("unsupported signature algorithm %s", signatureAlgorithm)
+	}
+	digestHash, ok := digestHashes[digestAlgorithm]
+	if !ok {
+		return fmt.Errorf("unsupported digest algorithm %s", digestAlgorithm)
+	}
+	canonicalizer := dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+
+	digest, err := canonicalHash(canonicalizer, assertion, digestHash)
+	if err != nil {
+		return err
+	}
+
+	signature := etree.NewElement("ds:" + dsig.SignatureTag)
+	signature.CreateAttr("xmlns:ds", dsig.Namespace)
+	signedInfo := signature.CreateElement("ds:" + dsig.SignedInfoTag)
+	signedInfo.CreateElement("ds:"+dsig.CanonicalizationMethodTag).CreateAttr(dsig.AlgorithmAttr, string(canonicalizer.Algorithm()))
+	signedInfo.CreateElement("ds:"+dsig.SignatureMethodTag).CreateAttr(dsig.AlgorithmAttr, signatureAlgorithm)
+	reference := signedInfo.CreateElement("ds:" + dsig.ReferenceTag)
+	reference.CreateAttr(dsig.URIAttr, "#"+assertion.SelectAttrValue("ID", ""))
+	transforms := reference.CreateElement("ds:" + dsig.TransformsTag)
+	transforms.CreateElement("ds:"+dsig.TransformTag).CreateAttr(dsig.AlgorithmAttr, string(dsig.EnvelopedSignatureAltorithmId))
+	transforms.CreateElement("ds:"+dsig.TransformTag).CreateAttr(dsig.AlgorithmAttr, string(canonicalizer.Algorithm()))
+	reference.CreateElement("ds:"+dsig.DigestMethodTag).CreateAttr(dsig.AlgorithmAttr, digestAlgorithm)
+	reference.CreateElement("ds:" + dsig.DigestValueTag).SetText(base64.StdEncoding.EncodeToString(digest))
+
+	// the SignedInfo is canonicalized with the namespace declarations of the Signature in scope
+	nsContext, err := etreeutils.NewDefaultNSContext().SubContext(signature)
+	if err != nil {
+		return err
+	}
+	detachedSignedInfo, err := etreeutils.NSDetatch(nsContext, signedInfo)
+	if err != nil {
+		return err
+	}
+	signedInfoHash, err := canonicalHash(canonicalizer, detachedSignedInfo, signatureHash)
+	if err != nil {
+		return err
+	}
+	signatureValue, err := rsa.SignPKCS1v15(rand.Reader, key, signatureHash, signedInfoHash)
+	if err != nil {
+		return err
+	}
+	signature.CreateElement("ds:" + dsig.SignatureValueTag).SetText(base64.StdEncoding.EncodeToString(signatureValue))
+	signature.CreateElement("ds:" + dsig.KeyInfoTag).
+		CreateElement("ds:" + dsig.X509DataTag).
+		CreateElement("ds:" + dsig.X509CertificateTag).
+		SetText(base64.StdEncoding.EncodeToString(cert))
+
+	index := 0
+	if issuer := assertion.SelectElement("Issuer"); issuer != nil {
+		index = issuer.Index() + 1
+	}
+	assertion.InsertChildAt(index, signature)
+	return nil
+}
+
+// canonicalHash hashes the canonical form of the element.
+// As the canonicalizer modifies the passed element, a copy is used.
+func canonicalHash(canonicalizer dsig.Canonicalizer, el *etree.Element, hash crypto.Hash) ([]byte, error) {
+	canonical, err := canonicalizer.Canonicalize(el.Copy())
+	if err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write(canonical)
+	return h.Sum(nil), nil
+}
+
+// encryptionCertificate returns the first certificate of the service provider usable for encryption.
+func encryptionCertificate(metadata *md.EntityDescriptorType) (*x509.Certificate, error) {
+	if metadata == nil || metadata.SPSSODescriptor == nil {
+		return nil, errors.New("service provider metadata missing")
+	}
+	for _, keyDescriptor := range metadata.SPSSODescriptor.KeyDescriptor {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != md.KeyTypesEncryption {
+			continue
+		}
+		for _, data := range keyDescriptor.KeyInfo.X509Data {
+			der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(data.X509Certificate), ""))
+			if err != nil {
+				return nil, err
+			}
+			return x509.ParseCertificate(der)
+		}
+	}
+	return nil, errors.New("no encryption certificate in service provider metadata")
+}
+
+// encryptAssertion encrypts the assertion with a random key of the selected block cipher,
+// which is transported using RSA-OAEP and the encryption certificate of the service provider.
+func encryptAssertion(assertion *etree.Element, cert *x509.Certificate, encryption domain.SAMLAssertionEncryption) (*etree.Element, error) {
+	blockCipher, err := assertionBlockCipher(encryption)
+	if err != nil {
+		return nil, err
+	}
+	doc := etree.NewDocument()
+	doc.SetRoot(assertion.Copy())
+	plaintext, err := doc.WriteToBytes()
+	if err != nil {
+		return nil, err
+	}
+	keyTransport := xmlenc.OAEP()
+	keyTransport.BlockCipher = blockCipher
+	encryptedData, err := keyTransport.Encrypt(cert, plaintext, nil)
+	if err != nil {
+		return nil, err
+	}
+	encryptedData.CreateAttr("Type", elementType)
+
+	encryptedAssertion := etree.NewElement("saml:EncryptedAssertion")
+	encryptedAssertion.CreateAttr("xmlns:saml", assertionNamespace)
+	encryptedAssertion.AddChild(encryptedData)
+	return encryptedAssertion, nil
+}
+
+func assertionBlockCipher(encryption domain.SAMLAssertionEncryption) (xmlenc.BlockCipher, error) {
+	switch encryption {
+	case domain.SAMLAssertionEncryptionAES128GCM:
+		return aesGCM{algorithm: aes128GCM, keySize: 16}, nil
+	case domain.SAMLAssertionEncryptionAES256GCM:
+		return aesGCM{algorithm: aes256GCM, keySize: 32}, nil
+	case domain.SAMLAssertionEncryptionNone:
+		fallthrough
+	default:
+		return nil, fmt.Errorf("unsupported assertion encryption %d", encryption)
+	}
+}
+
+var _ xmlenc.BlockCipher = aesGCM{}
+
+// aesGCM implements the AES-GCM block ciphers of XML Encryption 1.1,
+// where the cipher value is the nonce followed by the ciphertext and the authentication tag.
+type aesGCM struct {
+	algorithm string
+	keySize   int
+}
+
+func (e aesGCM) Algorithm() string {
+	return e.algorithm
+}
+
+func (e aesGCM) KeySize() int {
+	return e.keySize
+}
+
+func (e aesGCM) Encrypt(key interface{}, plaintext []byte, nonce []byte) (*etree.Element, error) {
+	aead, err := e.aead(key)
+	if err != nil {
+		return nil, err
+	}
+	if nonce == nil {
+		nonce = make([]byte, gcmNonceSize)
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid nonce size")
+	}
+
+	encryptedData := etree.NewElement("xenc:EncryptedData")
+	encryptedData.CreateAttr("xmlns:xenc", "http://www.w3.org/2001/04/xmlenc#")
+	encryptedData.CreateElement("xenc:EncryptionMethod").CreateAttr("Algorithm", e.algorithm)
+	encryptedData.CreateElement("xenc:CipherData").
+		CreateElement("xenc:CipherValue").
+		SetText(base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, plaintext, nil)))
+	return encryptedData, nil
+}
+
+func (e aesGCM) Decrypt(key interface{}, encryptedData *etree.Element) ([]byte, error) {
+	if keyInfo := encryptedData.FindElement("./KeyInfo"); keyInfo != nil {
+		encryptedKey := keyInfo.FindElement("./EncryptedKey")
+		if encryptedKey == nil {
+			return nil, errors.New("encrypted key missing")
+		}
+		var err error
+		key, err = xmlenc.Decrypt(key, encryptedKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+	aead, err := e.aead(key)
+	if err != nil {
+		return nil, err
+	}
+	cipherValue := encryptedData.FindElement("./CipherData/CipherValue")
+	if cipherValue == nil {
+		return nil, errors.New("cipher value missing")
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cipherValue.Text()))
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	return aead.Open(nil, ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():], nil)
+}
+
+func (e aesGCM) aead(key interface{}) (cipher.AEAD, error) {
+	keyBytes, ok := key.([]byte)
+	if !ok {
+		return nil, errors.New("key must be a byte slice")
+	}
+	if len(keyBytes) != e.keySize {
+		return nil, fmt.Errorf("key must be %d bytes", e.keySize)
+	}
+	block, err := aes.NewCipher(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCMWithNonceSize(block, gcmNonceSize)
+}
@@ -0,0 +1,218 @@
+package saml
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/muhlemmer/gu"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func testCertificate(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+func testResponse() *samlp.ResponseType {
+	return &samlp.ResponseType{
+		Id:      "response",
+		Version: "2.0",
+		Assertion: saml.AssertionType{
+			Id:      "assertion",
+			Version: "2.0",
+			Issuer:  saml.NameIDType{Text: "https://idp.example.com"},
+			Subject: &saml.SubjectType{
+				NameID: &saml.NameIDType{Text: "user@example.com"},
+			},
+		},
+	}
+}
+
+func Test_signAssertion(t *testing.T) {
+	key, cert := testCertificate(t)
+	tests := []struct {
+		name               string
+		signatureAlgorithm string
+		digestAlgorithm    string
+		wantErr            bool
+	}{
+		{
+			name:               "rsa-sha256, sha256",
+			signatureAlgorithm: domain.SAMLSignatureAlgorithmRSASHA256.URI(),
+			digestAlgorithm:    domain.SAMLDigestAlgorithmSHA256.URI(),
+		},
+		{
+			name:               "rsa-sha512, sha512",
+			signatureAlgorithm: domain.SAMLSignatureAlgorithmRSASHA512.URI(),
+			digestAlgorithm:    domain.SAMLDigestAlgorithmSHA512.URI(),
+		},
+		{
+			name:               "unsupported signature algorithm",
+			signatureAlgorithm: "http://www.w3.org/2000/09/xmldsig#rsa-sha1",
+			digestAlgorithm:    domain.SAMLDigestAlgorithmSHA256.URI(),
+			wantErr:            true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertion, err := marshalElement(testResponse().Assertion)
+			require.NoError(t, err)
+			err = signAssertion(assertion, key, cert.Raw, tt.signatureAlgorithm, tt.digestAlgorithm)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, dsig.SignatureTag, assertion.ChildElements()[1].Tag)
+			assert.Equal(t, tt.digestAlgorithm, assertion.FindElement(".//DigestMethod").SelectAttrValue(dsig.AlgorithmAttr, ""))
+
+			validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: []*x509.Certificate{cert}})
+			_, err = validationContext.Validate(assertion)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_customizedSignature(t *testing.T) {
+	tests := []struct {
+		name string
+		sp   *query.SAMLServiceProvider
+		want bool
+	}{
+		{
+			name: "default",
+			sp:   &query.SAMLServiceProvider{},
+		},
+		{
+			name: "name id format only",
+			sp:   &query.SAMLServiceProvider{NameIDFormat: gu.Ptr(domain.SAMLNameIDFormatPersistent)},
+		},
+		{
+			name: "signature algorithm",
+			sp:   &query.SAMLServiceProvider{SignatureAlgorithm: domain.SAMLSignatureAlgorithmRSASHA512},
+			want: true,
+		},
+		{
+			name: "digest algorithm",
+			sp:   &query.SAMLServiceProvider{DigestAlgorithm: domain.SAMLDigestAlgorithmSHA512},
+			want: true,
+		},
+		{
+			name: "encryption",
+			sp:   &query.SAMLServiceProvider{AssertionEncryption: domain.SAMLAssertionEncryptionAES256GCM},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, customizedSignature(tt.sp))
+		})
+	}
+}
+
+func Test_aesGCM(t *testing.T) {
+	for _, encryption := range []domain.SAMLAssertionEncryption{domain.SAMLAssertionEncryptionAES128GCM, domain.SAMLAssertionEncryptionAES256GCM} {
+		blockCipher, err := assertionBlockCipher(encryption)
+		require.NoError(t, err)
+		key := make([]byte, blockCipher.KeySize())
+		_, err = rand.Read(key)
+		require.NoError(t, err)
+
+		encrypted, err := blockCipher.Encrypt(key, []byte("plaintext"), nil)
+		require.NoError(t, err)
+		assert.Equal(t, blockCipher.Algorithm(), encrypted.FindElement("./EncryptionMethod").SelectAttrValue("Algorithm", ""))
+		decrypted, err := blockCipher.Decrypt(key, encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("plaintext"), decrypted)
+
+		_, err = blockCipher.Decrypt(make([]byte, blockCipher.KeySize()), encrypted)
+		assert.Error(t, err)
+	}
+	_, err := assertionBlockCipher(domain.SAMLAssertionEncryptionNone)
+	assert.Error(t, err)
+}
+
+func Test_assertionResponse(t *testing.T) {
+	signingKey, signingCert := testCertificate(t)
+	spKey, spCert := testCertificate(t)
+	metadata := &md.EntityDescriptorType{
+		SPSSODescriptor: &md.SPSSODescriptorType{
+			KeyDescriptor: []md.KeyDescriptorType{{
+				Use: md.KeyTypesEncryption,
+				KeyInfo: xml_dsig.KeyInfoType{
+					X509Data: []xml_dsig.X509DataType{{X509Certificate: base64.StdEncoding.EncodeToString(spCert.Raw)}},
+				},
+			}},
+		},
+	}
+
+	t.Run("signed", func(t *testing.T) {
+		data, err := assertionResponse(testResponse(), signingKey, signingCert.Raw,
+			domain.SAMLSignatureAlgorithmRSASHA512.URI(), domain.SAMLDigestAlgorithmSHA512.URI(),
+			domain.SAMLAssertionEncryptionNone, nil)
+		require.NoError(t, err)
+		doc := etree.NewDocument()
+		require.NoError(t, doc.ReadFromBytes(data))
+		assertion := doc.Root().SelectElement("Assertion")
+		require.NotNil(t, assertion)
+
+		validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: []*x509.Certificate{signingCert}})
+		_, err = validationContext.Validate(assertion)
+		require.NoError(t, err)
+	})
+	t.Run("encrypted", func(t *testing.T) {
+		data, err := assertionResponse(testResponse(), signingKey, signingCert.Raw,
+			domain.SAMLSignatureAlgorithmRSASHA256.URI(), domain.SAMLDigestAlgorithmSHA256.URI(),
+			domain.SAMLAssertionEncryptionAES256GCM, metadata)
+		require.NoError(t, err)
+		doc := etree.NewDocument()
+		require.NoError(t, doc.ReadFromBytes(data))
+		assert.Nil(t, doc.Root().SelectElement("Assertion"))
+		encryptedData := doc.Root().FindElement("./EncryptedAssertion/EncryptedData")
+		require.NotNil(t, encryptedData)
+
+		blockCipher, err := assertionBlockCipher(domain.SAMLAssertionEncryptionAES256GCM)
+		require.NoError(t, err)
+		plaintext, err := blockCipher.Decrypt(spKey, encryptedData)
+		require.NoError(t, err)
+		assertionDoc := etree.NewDocument()
+		require.NoError(t, assertionDoc.ReadFromBytes(plaintext))
+
+		validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{Roots: []*x509.Certificate{signingCert}})
+		_, err = validationContext.Validate(assertionDoc.Root())
+		require.NoError(t, err)
+	})
+	t.Run("encryption certificate missing", func(t *testing.T) {
+		_, err := assertionResponse(testResponse(), signingKey, signingCert.Raw,
+			domain.SAMLSignatureAlgorithmRSASHA256.URI(), domain.SAMLDigestAlgorithmSHA256.URI(),
+			domain.SAMLAssertionEncryptionAES128GCM, &md.EntityDescriptorType{SPSSODescriptor: &md.SPSSODescriptorType{}})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,184 @@
+package saml
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"slices"
+
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+const (
+	attributeNameFormatBasic = "urn:oasis:names:tc:SAML:2.0:attrname-format:basic"
+
+	nameIDFormatEmailAddress = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	nameIDFormatPersistent   = "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent"
+	nameIDFormatTransient    = "urn:oasis:names:tc:SAML:2.0:nameid-format:transient"
+	nameIDFormatUnspecified  = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+)
+
+// getMappedAttributes returns the attributes configured in the attribute mapping of the SAML application.
+func (p *Storage) getMappedAttributes(ctx context.Context, applicationID string, user *query.User, userGrants *query.UserGrants) (map[string]*customAttribute, error) {
+	app, err := p.query.AppByID(ctx, applicationID, true)
+	if err != nil {
+		return nil, err
+	}
+	if app.SAMLConfig == nil || len(app.SAMLConfig.AttributeMapping) == 0 {
+		return nil, nil
+	}
+	var metadata []*query.UserMetadata
+	if slices.ContainsFunc(app.SAMLConfig.AttributeMapping, func(mapping *domain.SAMLAttributeMapping) bool {
+		return mapping.Source == domain.SAMLAttributeSourceMetadata
+	}) {
+		resourceOwnerQuery, err := query.NewUserMetadataResourceOwnerSearchQuery(user.ResourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		list, err := p.query.SearchUserMetadata(ctx, true, user.ID, &query.UserMetadataSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}}, nil)
+		if err != nil {
+			return nil, err
+		}
+		metadata = list.Metadata
+	}
+	return mapAttributes(app.SAMLConfig.AttributeMapping, user, metadata, userGrants), nil
+}
+
+func mapAttributes(mappings []*domain.SAMLAttributeMapping, user *query.User, metadata []*query.UserMetadata, userGrants *query.UserGrants) map[string]*customAttribute {
+	attributes := make(map[string]*customAttribute, len(mappings))
+	for _, mapping := range mappings {
+		var values []string
+		switch mapping.Source {
+		case domain.SAMLAttributeSourceUserField:
+			if value := userFieldValue(user, domain.SAMLUserField(mapping.Key)); value != "" {
+				values = []string{value}
+			}
+		case domain.SAMLAttributeSourceMetadata:
+			for _, md := range metadata {
+				if md.Key == mapping.Key {
+					values = []string{string(md.Value)}
+					break
+				}
+			}
+		case domain.SAMLAttributeSourceRoles:
+			values = grantedRoles(userGrants)
+		case domain.SAMLAttributeSourceUnspecified:
+		}
+		if len(values) == 0 {
+			continue
+		}
+		nameFormat := mapping.NameFormat
+		if nameFormat == "" {
+			nameFormat = attributeNameFormatBasic
+		}
+		attributes = appendCustomAttribute(attributes, mapping.Name, nameFormat, values)
+	}
+	return attributes
+}
+
+func userFieldValue(user *query.User, field domain.SAMLUserField) string {
+	switch field {
+	case domain.SAMLUserFieldID:
+		return user.ID
+	case domain.SAMLUserFieldUsername:
+		return user.Username
+	case domain.SAMLUserFieldPreferredLoginName:
+		return user.PreferredLoginName
+	case domain.SAMLUserFieldResourceOwner:
+		return user.ResourceOwner
+	}
+	if user.Human == nil {
+		return ""
+	}
+	switch field {
+	case domain.SAMLUserFieldEmail:
+		return string(user.Human.Email)
+	case domain.SAMLUserFieldFirstName:
+		return user.Human.FirstName
+	case domain.SAMLUserFieldLastName:
+		return user.Human.LastName
+	case domain.SAMLUserFieldDisplayName:
+		return user.Human.DisplayName
+	case domain.SAMLUserFieldNickName:
+		return user.Human.NickName
+	case domain.SAMLUserFieldPhone:
+		return string(user.Human.Phone)
+	case domain.SAMLUserFieldPreferredLanguage:
+		if user.Human.PreferredLanguage.IsRoot() {
+			return ""
+		}
+		return user.Human.PreferredLanguage.String()
+	default:
+		return ""
+	}
+}
+
+func grantedRoles(userGrants *query.UserGrants) []string {
+	if userGrants == nil {
+		return nil
+	}
+	roles := make([]string, 0)
+	for _, grant := range userGrants.UserGrants {
+		for _, role := range grant.Roles {
+			if !slices.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles
+}
+
+// setNameID replaces the NameID of the assertion with the value of the user for the configured format.
+func (p *Provider) setNameID(ctx context.Context, samlResponse *samlp.ResponseType, format domain.SAMLNameIDFormat, userID, entityID string) error {
+	if samlResponse.Assertion.Subject == nil || samlResponse.Assertion.Subject.NameID == nil {
+		return nil
+	}
+	user, err := p.storage.query.GetUserByID(ctx, true, userID)
+	if err != nil {
+		return err
+	}
+	var persistentNameID string
+	if format == domain.SAMLNameIDFormatPersistent {
+		persistentNameID, err = p.command.SAMLPersistentNameID(ctx, user.ID, user.ResourceOwner, entityID)
+		if err != nil {
+			return err
+		}
+	}
+	nameIDFormat, value, err := nameIDValue(user, format, persistentNameID)
+	if err != nil {
+		return err
+	}
+	samlResponse.Assertion.Subject.NameID.Format = nameIDFormat
+	samlResponse.Assertion.Subject.NameID.Text = value
+	return nil
+}
+
+// nameIDValue returns the SAML identifier of the format and the value of the user in it:
+//   - emailAddress: the email of the user, or the login name if the user has none
+//   - persistent: the persistent NameID of the user for the service provider, which is passed by the caller
+//   - transient: a random value, which changes on every response
+//   - unspecified: the login name of the user
+func nameIDValue(user *query.User, format domain.SAMLNameIDFormat, persistentNameID string) (string, string, error) {
+	switch format {
+	case domain.SAMLNameIDFormatEmailAddress:
+		if user.Human != nil && user.Human.Email != "" {
+			return nameIDFormatEmailAddress, string(user.Human.Email), nil
+		}
+		return nameIDFormatEmailAddress, user.PreferredLoginName, nil
+	case domain.SAMLNameIDFormatPersistent:
+		return nameIDFormatPersistent, persistentNameID, nil
+	case domain.SAMLNameIDFormatTransient:
+		random := make([]byte, 16)
+		if _, err := rand.Read(random); err != nil {
+			return "", "", err
+		}
+		return nameIDFormatTransient, "_" + hex.EncodeToString(random), nil
+	case domain.SAMLNameIDFormatUnspecified:
+		fallthrough
+	default:
+		return nameIDFormatUnspecified, user.PreferredLoginName, nil
+	}
+}
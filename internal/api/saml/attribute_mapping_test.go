@@ -0,0 +1,168 @@
+package saml
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func testUser() *query.User {
+	return &query.User{
+		ID:                 "user1",
+		ResourceOwner:      "org1",
+		Username:           "username",
+		PreferredLoginName: "username@org.localhost",
+		Human: &query.Human{
+			FirstName:         "first",
+			LastName:          "last",
+			DisplayName:       "first last",
+			PreferredLanguage: language.German,
+			Email:             "email@example.com",
+		},
+	}
+}
+
+func Test_mapAttributes(t *testing.T) {
+	type args struct {
+		mappings   []*domain.SAMLAttributeMapping
+		user       *query.User
+		metadata   []*query.UserMetadata
+		userGrants *query.UserGrants
+	}
+	tests := []struct {
+		name string
+		args args
+		want map[string]*customAttribute
+	}{
+		{
+			name: "no mapping",
+			args: args{
+				user: testUser(),
+			},
+			want: map[string]*customAttribute{},
+		},
+		{
+			name: "user fields",
+			args: args{
+				mappings: []*domain.SAMLAttributeMapping{
+					{Name: "mail", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+					{Name: "uid", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldID)},
+					{Name: "lang", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldPreferredLanguage)},
+					{Name: "nick", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldNickName)},
+				},
+				user: testUser(),
+			},
+			want: map[string]*customAttribute{
+				"mail": {nameFormat: attributeNameFormatBasic, attributeValue: []string{"email@example.com"}},
+				"uid":  {nameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:uri", attributeValue: []string{"user1"}},
+				"lang": {nameFormat: attributeNameFormatBasic, attributeValue: []string{"de"}},
+			},
+		},
+		{
+			name: "metadata and roles",
+			args: args{
+				mappings: []*domain.SAMLAttributeMapping{
+					{Name: "department", Source: domain.SAMLAttributeSourceMetadata, Key: "department"},
+					{Name: "missing", Source: domain.SAMLAttributeSourceMetadata, Key: "missing"},
+					{Name: "roles", Source: domain.SAMLAttributeSourceRoles},
+				},
+				user: testUser(),
+				metadata: []*query.UserMetadata{
+					{Key: "department", Value: []byte("engineering")},
+				},
+				userGrants: &query.UserGrants{
+					UserGrants: []*query.UserGrant{
+						{Roles: []string{"admin", "user"}},
+						{Roles: []string{"user", "viewer"}},
+					},
+				},
+			},
+			want: map[string]*customAttribute{
+				"department": {nameFormat: attributeNameFormatBasic, attributeValue: []string{"engineering"}},
+				"roles":      {nameFormat: attributeNameFormatBasic, attributeValue: []string{"admin", "user", "viewer"}},
+			},
+		},
+		{
+			name: "machine user, human fields skipped",
+			args: args{
+				mappings: []*domain.SAMLAttributeMapping{
+					{Name: "mail", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+					{Name: "login", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldPreferredLoginName)},
+				},
+				user: &query.User{
+					ID:                 "machine1",
+					PreferredLoginName: "machine@org.localhost",
+				},
+			},
+			want: map[string]*customAttribute{
+				"login": {nameFormat: attributeNameFormatBasic, attributeValue: []string{"machine@org.localhost"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mapAttributes(tt.args.mappings, tt.args.user, tt.args.metadata, tt.args.userGrants)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_nameIDValue(t *testing.T) {
+	tests := []struct {
+		name       string
+		user       *query.User
+		format     domain.SAMLNameIDFormat
+		wantFormat string
+		wantValue  string
+	}{
+		{
+			name:       "email address",
+			user:       testUser(),
+			format:     domain.SAMLNameIDFormatEmailAddress,
+			wantFormat: nameIDFormatEmailAddress,
+			wantValue:  "email@example.com",
+		},
+		{
+			name:       "email address, machine user",
+			user:       &query.User{ID: "machine1", PreferredLoginName: "machine@org.localhost"},
+			format:     domain.SAMLNameIDFormatEmailAddress,
+			wantFormat: nameIDFormatEmailAddress,
+			wantValue:  "machine@org.localhost",
+		},
+		{
+			name:       "persistent",
+			user:       testUser(),
+			format:     domain.SAMLNameIDFormatPersistent,
+			wantFormat: nameIDFormatPersistent,
+			wantValue:  "nameID1",
+		},
+		{
+			name:       "unspecified",
+			user:       testUser(),
+			format:     domain.SAMLNameIDFormatUnspecified,
+			wantFormat: nameIDFormatUnspecified,
+			wantValue:  "username@org.localhost",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, value, err := nameIDValue(tt.user, tt.format, "nameID1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantFormat, format)
+			assert.Equal(t, tt.wantValue, value)
+		})
+	}
+	t.Run("transient", func(t *testing.T) {
+		format, first, err := nameIDValue(testUser(), domain.SAMLNameIDFormatTransient, "")
+		require.NoError(t, err)
+		assert.Equal(t, nameIDFormatTransient, format)
+		_, second, err := nameIDValue(testUser(), domain.SAMLNameIDFormatTransient, "")
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+}
@@ -7,11 +7,16 @@ import (
 
 	"github.com/zitadel/saml/pkg/provider"
 	"github.com/zitadel/saml/pkg/provider/models"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/signature"
 	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 func (p *Provider) CreateErrorResponse(authReq models.AuthRequestInt, reason domain.SAMLErrorReason, description string) (string, string, error) {
@@ -41,19 +46,103 @@ func (p *Provider) CreateResponse(ctx context.Context, authReq models.AuthReques
 		return "", "", err
 	}
 
+	spQuery, err := p.storage.query.ActiveSAMLServiceProviderByID(ctx, authReq.GetIssuer())
+	if err != nil {
+		return "", "", err
+	}
+	sp, err := ServiceProviderFromBusiness(spQuery, p.storage.defaultLoginURL, p.storage.defaultLoginURLv2)
+	if err != nil {
+		return "", "", err
+	}
+	// the redirect binding signs the whole query and does not support custom algorithms or encrypted assertions
+	if authReq.GetBindingType() != provider.PostBinding && customizedSignature(spQuery) {
+		return "", "", zerrors.ThrowPreconditionFailed(nil, "SAML-Eiw4u", "Errors.Project.App.SAMLRedirectBindingNotSupported")
+	}
+	// the NameID is set before the session is created, so the logout of the session uses the same NameID
+	if spQuery.NameIDFormat != nil {
+		if err := p.setNameID(ctx, samlResponse, *spQuery.NameIDFormat, authReq.GetUserID(), authReq.GetIssuer()); err != nil {
+			return "", "", err
+		}
+		// the redirect binding signs the whole response, which changed with the NameID
+		if authReq.GetBindingType() == provider.RedirectBinding {
+			if err := p.signRedirectResponse(ctx, samlResponse, resp); err != nil {
+				return "", "", err
+			}
+		}
+	}
+	customized := authReq.GetBindingType() == provider.PostBinding && customizedAssertion(spQuery)
+
 	if err := p.command.CreateSAMLSessionFromSAMLRequest(
 		setContextUserSystem(ctx),
 		authReq.GetID(),
 		samlComplianceChecker(),
 		samlResponse.Id,
 		p.Expiration(),
+		samlSessionLogout(sp, samlResponse),
 	); err != nil {
 		return "", "", err
 	}
 
+	// assertions with custom algorithms, encryption or NameID are signed again for the POST binding
+	if customized {
+		respData, err := p.customizedAssertionResponse(ctx, samlResponse, spQuery, sp)
+		if err != nil {
+			return "", "", err
+		}
+		return authReq.GetAccessConsumerServiceURL(), base64.StdEncoding.EncodeToString(respData), nil
+	}
+
 	return createResponse(samlResponse, authReq.GetBindingType(), authReq.GetAccessConsumerServiceURL(), resp.RelayState, resp.SigAlg, resp.Signature)
 }
 
+func (p *Provider) customizedAssertionResponse(ctx context.Context, samlResponse *samlp.ResponseType, spQuery *query.SAMLServiceProvider, sp *serviceprovider.ServiceProvider) ([]byte, error) {
+	signingKey, err := p.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signatureAlgorithm := spQuery.SignatureAlgorithm.URI()
+	if signatureAlgorithm == "" {
+		signatureAlgorithm = p.signatureAlgorithm
+	}
+	digestAlgorithm := spQuery.DigestAlgorithm.URI()
+	if digestAlgorithm == "" {
+		digestAlgorithm = domain.SAMLDigestAlgorithmSHA256.URI()
+	}
+	return assertionResponse(samlResponse, signingKey.Key, signingKey.Certificate, signatureAlgorithm, digestAlgorithm, spQuery.AssertionEncryption, sp.Metadata)
+}
+
+// signRedirectResponse signs the response for the redirect binding the same way as the library,
+// which covers the whole response, the relay state and the signature algorithm.
+func (p *Provider) signRedirectResponse(ctx context.Context, samlResponse *samlp.ResponseType, resp *provider.Response) error {
+	certAndKey, err := p.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	data, err := xml.Marshal(samlResponse)
+	if err != nil {
+		return err
+	}
+	encoded, err := xml.DeflateAndBase64(data)
+	if err != nil {
+		return err
+	}
+	tlsCert, err := signature.ParseTlsKeyPair(certAndKey.Certificate, certAndKey.Key)
+	if err != nil {
+		return err
+	}
+	signingContext, err := signature.GetSigningContext(tlsCert, p.signatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	sig, err := signature.CreateRedirect(signingContext, provider.BuildRedirectQuery(string(encoded), resp.RelayState, p.signatureAlgorithm, ""))
+	if err != nil {
+		return err
+	}
+	resp.Signature = url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+	resp.SigAlg = url.QueryEscape(base64.StdEncoding.EncodeToString([]byte(p.signatureAlgorithm)))
+	return nil
+}
+
 func createResponse(samlResponse interface{}, binding, acs, relayState, sigAlg, sig string) (string, string, error) {
 	respData, err := xml.Marshal(samlResponse)
 	if err != nil {
@@ -0,0 +1,483 @@
+package saml
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zitadel/logging"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/signature"
+	saml_xml "github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+	"github.com/zitadel/saml/pkg/provider/xml/xml_dsig"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+)
+
+const (
+	logoutParamRequest    = "SAMLRequest"
+	logoutParamResponse   = "SAMLResponse"
+	logoutParamRelayState = "RelayState"
+	logoutParamSigAlg     = "SigAlg"
+	logoutParamSignature  = "Signature"
+	logoutParamSessionID  = "session_id"
+	logoutParamReturnURL  = "return_url"
+
+	issuerFormatEntity = "urn:oasis:names:tc:SAML:2.0:nameid-format:entity"
+)
+
+var logoutPostTemplate = template.Must(template.New("logout").Parse(`<!DOCTYPE html>
+<html lang="en">
+<body onload="document.getElementById('samlpost').submit()">
+<noscript>
+<p>
+<strong>Note:</strong> Since your browser does not support JavaScript,
+you must press the Continue button once to proceed.
+</p>
+</noscript>
+<form action="{{ .URL }}" method="post" id="samlpost">
+<input type="hidden" name="{{ .Param }}" value="{{ .Message }}"/>
+{{- if .RelayState }}
+<input type="hidden" name="RelayState" value="{{ .RelayState }}"/>
+{{- end }}
+<noscript>
+<input type="submit" value="Continue"/>
+</noscript>
+</form>
+</body>
+</html>`))
+
+type logoutPostForm struct {
+	URL        string
+	Param      string
+	Message    string
+	RelayState string
+}
+
+// logoutMessage is a LogoutRequest or LogoutResponse received on the Single Logout (SLO) endpoint.
+type logoutMessage struct {
+	binding    string
+	param      string
+	data       []byte
+	relayState string
+	sigAlg     string
+	signature  string
+	// signedQuery is the part of the raw query covered by the signature of the redirect binding.
+	signedQuery string
+}
+
+// LogoutPath returns the path of the Single Logout (SLO) endpoint, which logs the user out
+// of all service providers of the terminated session, once the logout was started by [command.Commands.StartSAMLLogout].
+func LogoutPath(singleLogoutPath, sessionID string) string {
+	v := url.Values{}
+	v.Set(logoutParamSessionID, sessionID)
+	return singleLogoutPath + "?" + v.Encode()
+}
+
+// logoutHandler handles the Single Logout (SLO) endpoint:
+//   - a LogoutRequest of a service provider terminates the session of the user (SP-initiated SLO)
+//   - a LogoutResponse of a service provider continues the logout of the session
+//   - a session_id of a terminated session logs the user out of all its service providers (IdP-initiated SLO),
+//     started by the OIDC end_session endpoint or the deletion of the session through the session API
+//
+// Each service provider of the session is sent a LogoutRequest in turn,
+// before the LogoutResponse is sent to the service provider which initiated the logout.
+func (p *Provider) logoutHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Errorf("failed to parse form: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	ctx := setContextUserSystem(r.Context())
+	message, err := parseLogoutMessage(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	switch {
+	case message == nil && r.Form.Get(logoutParamSessionID) != "":
+		p.continueLogout(ctx, w, r, r.Form.Get(logoutParamSessionID), r.Form.Get(logoutParamReturnURL))
+	case message == nil:
+		http.Error(w, "missing SAMLRequest, SAMLResponse or session_id", http.StatusBadRequest)
+	case message.param == logoutParamRequest:
+		p.handleLogoutRequest(ctx, w, r, message)
+	default:
+		p.handleLogoutResponse(ctx, w, r, message)
+	}
+}
+
+func (p *Provider) handleLogoutRequest(ctx context.Context, w http.ResponseWriter, r *http.Request, message *logoutMessage) {
+	logoutRequest := new(samlp.LogoutRequestType)
+	if err := xml.Unmarshal(message.data, logoutRequest); err != nil {
+		http.Error(w, fmt.Errorf("failed to decode request: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	if logoutRequest.Issuer == nil || logoutRequest.NameID == nil {
+		http.Error(w, "issuer and nameID of the request are required", http.StatusBadRequest)
+		return
+	}
+	sp, err := p.storage.GetEntityByID(ctx, logoutRequest.Issuer.Text)
+	if err != nil {
+		http.Error(w, fmt.Errorf("failed to find registered serviceprovider: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	if err = p.validateLogoutRequest(sp, logoutRequest, message); err != nil {
+		p.sendLogoutResponse(ctx, w, r, singleLogoutService(sp), logoutRequest.Id, message.relayState, provider.StatusCodeRequestDenied, err.Error())
+		return
+	}
+	sessionID, err := p.command.InitiateSAMLLogout(ctx, sp.GetEntityID(), logoutRequest.NameID.Text, logoutRequest.SessionIndex, logoutRequest.Id, message.relayState)
+	if err != nil {
+		p.sendLogoutResponse(ctx, w, r, singleLogoutService(sp), logoutRequest.Id, message.relayState, provider.StatusCodeRequestDenied, err.Error())
+		return
+	}
+	p.continueLogout(ctx, w, r, sessionID, "")
+}
+
+func (p *Provider) handleLogoutResponse(ctx context.Context, w http.ResponseWriter, r *http.Request, message *logoutMessage) {
+	logoutResponse := new(samlp.LogoutResponseType)
+	if err := xml.Unmarshal(message.data, logoutResponse); err != nil {
+		http.Error(w, fmt.Errorf("failed to decode response: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	if logoutResponse.Issuer == nil || logoutResponse.InResponseTo == "" {
+		http.Error(w, "issuer and InResponseTo of the response are required", http.StatusBadRequest)
+		return
+	}
+	sp, err := p.storage.GetEntityByID(ctx, logoutResponse.Issuer.Text)
+	if err != nil {
+		http.Error(w, fmt.Errorf("failed to find registered serviceprovider: %w", err).Error(), http.StatusBadRequest)
+		return
+	}
+	// the response is correlated by the unguessable ID of our request, so the signature is only checked if provided
+	if message.sigAlg != "" || logoutResponse.Signature != nil {
+		if err = validateLogoutSignature(sp, message); err != nil {
+			http.Error(w, fmt.Errorf("failed to verify signature: %w", err).Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	// the logout continues independent of the status, since the session is already terminated
+	logging.WithFields("entity_id", sp.GetEntityID(), "status", logoutResponse.Status.StatusCode.Value).Debug("logout response received")
+	sessionID, err := p.command.CompleteSAMLLogoutRequest(ctx, sp.GetEntityID(), logoutResponse.InResponseTo)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	p.continueLogout(ctx, w, r, sessionID, message.relayState)
+}
+
+// continueLogout sends the LogoutRequest to the next service provider of the session.
+// Once all service providers are logged out, the LogoutResponse is sent to the initiating service provider
+// or the user agent is redirected to the returnURL.
+func (p *Provider) continueLogout(ctx context.Context, w http.ResponseWriter, r *http.Request, sessionID, returnURL string) {
+	step, err := p.command.NextSAMLLogoutStep(ctx, sessionID, provider.NewID())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if step.Participant != nil {
+		if err = p.sendLogoutRequest(ctx, w, r, step.Participant, step.RequestID, returnURL); err != nil {
+			http.Error(w, fmt.Errorf("failed to send logout request: %w", err).Error(), http.StatusInternalServerError)
+		}
+		return
+	}
+	if step.Initiator != nil {
+		logout := &command.SAMLSessionLogout{
+			LogoutURL:   step.Initiator.LogoutURL,
+			ResponseURL: step.Initiator.ResponseURL,
+			Binding:     step.Initiator.Binding,
+		}
+		p.sendLogoutResponse(ctx, w, r, logout, step.InitiatorRequestID, step.InitiatorRelayState, provider.StatusCodeSuccess, "")
+		return
+	}
+	// the return URL of a logout started by ZITADEL was already validated
+	if step.ReturnURL != "" {
+		http.Redirect(w, r, step.ReturnURL, http.StatusFound)
+		return
+	}
+	if returnURL = validReturnURL(ctx, returnURL); returnURL != "" {
+		http.Redirect(w, r, returnURL, http.StatusFound)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (p *Provider) sendLogoutRequest(ctx context.Context, w http.ResponseWriter, r *http.Request, participant *command.SAMLLogoutParticipant, requestID, relayState string) error {
+	now := time.Now().UTC()
+	logoutRequest := &samlp.LogoutRequestType{
+		Id:           requestID,
+		Version:      "2.0",
+		IssueInstant: now.Format(p.Timeformat()),
+		NotOnOrAfter: now.Add(p.Expiration()).Format(p.Timeformat()),
+		Destination:  participant.LogoutURL,
+		Issuer:       &saml.NameIDType{Format: issuerFormatEntity, Text: p.GetEntityID(ctx)},
+		NameID:       &saml.NameIDType{Format: participant.NameIDFormat, Text: participant.NameID},
+	}
+	if participant.SessionIndex != "" {
+		logoutRequest.SessionIndex = []string{participant.SessionIndex}
+	}
+	return p.sendLogoutMessage(ctx, w, r, logoutParamRequest, participant.Binding, participant.LogoutURL, relayState, logoutRequest,
+		func(sig *xml_dsig.SignatureType) { logoutRequest.Signature = sig },
+	)
+}
+
+func (p *Provider) sendLogoutResponse(ctx context.Context, w http.ResponseWriter, r *http.Request, logout *command.SAMLSessionLogout, inResponseTo, relayState, status, message string) {
+	if logout == nil {
+		if status != provider.StatusCodeSuccess {
+			http.Error(w, message, http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	logoutURL := logout.LogoutURL
+	if logout.ResponseURL != "" {
+		logoutURL = logout.ResponseURL
+	}
+	logoutResponse := &samlp.LogoutResponseType{
+		Id:           provider.NewID(),
+		InResponseTo: inResponseTo,
+		Version:      "2.0",
+		IssueInstant: time.Now().UTC().Format(p.Timeformat()),
+		Destination:  logoutURL,
+		Issuer:       &saml.NameIDType{Format: issuerFormatEntity, Text: p.GetEntityID(ctx)},
+		Status: samlp.StatusType{
+			StatusCode:    samlp.StatusCodeType{Value: status},
+			StatusMessage: message,
+		},
+	}
+	err := p.sendLogoutMessage(ctx, w, r, logoutParamResponse, logout.Binding, logoutURL, relayState, logoutResponse,
+		func(sig *xml_dsig.SignatureType) { logoutResponse.Signature = sig },
+	)
+	if err != nil {
+		http.Error(w, fmt.Errorf("failed to send logout response: %w", err).Error(), http.StatusInternalServerError)
+	}
+}
+
+// sendLogoutMessage signs the message and sends it to the service provider using the provided binding.
+func (p *Provider) sendLogoutMessage(ctx context.Context, w http.ResponseWriter, r *http.Request, param, binding, location, relayState string, message any, setSignature func(*xml_dsig.SignatureType)) error {
+	certAndKey, err := p.storage.GetResponseSigningKey(ctx)
+	if err != nil {
+		return err
+	}
+	if binding == provider.PostBinding {
+		signer, err := signature.GetSigner(certAndKey.Certificate, certAndKey.Key, p.signatureAlgorithm)
+		if err != nil {
+			return err
+		}
+		sig, err := signature.Create(signer, message)
+		if err != nil {
+			return err
+		}
+		setSignature(sig)
+		data, err := saml_xml.Marshal(message)
+		if err != nil {
+			return err
+		}
+		return logoutPostTemplate.Execute(w, &logoutPostForm{
+			URL:        location,
+			Param:      param,
+			Message:    base64.StdEncoding.EncodeToString(data),
+			RelayState: relayState,
+		})
+	}
+	data, err := saml_xml.Marshal(message)
+	if err != nil {
+		return err
+	}
+	encoded, err := saml_xml.DeflateAndBase64(data)
+	if err != nil {
+		return err
+	}
+	query := buildLogoutRedirectQuery(param, string(encoded), relayState, p.signatureAlgorithm)
+	tlsCert, err := signature.ParseTlsKeyPair(certAndKey.Certificate, certAndKey.Key)
+	if err != nil {
+		return err
+	}
+	signingContext, err := signature.GetSigningContext(tlsCert, p.signatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	sig, err := signature.CreateRedirect(signingContext, query)
+	if err != nil {
+		return err
+	}
+	query += "&" + logoutParamSignature + "=" + url.QueryEscape(base64.StdEncoding.EncodeToString(sig))
+	separator := "?"
+	if strings.Contains(location, "?") {
+		separator = "&"
+	}
+	http.Redirect(w, r, location+separator+query, http.StatusFound)
+	return nil
+}
+
+func buildLogoutRedirectQuery(param, message, relayState, sigAlg string) string {
+	query := param + "=" + url.QueryEscape(message)
+	if relayState != "" {
+		query += "&" + logoutParamRelayState + "=" + url.QueryEscape(relayState)
+	}
+	return query + "&" + logoutParamSigAlg + "=" + url.QueryEscape(sigAlg)
+}
+
+// parseLogoutMessage returns the LogoutRequest or LogoutResponse of the request, if any.
+func parseLogoutMessage(r *http.Request) (_ *logoutMessage, err error) {
+	message := &logoutMessage{
+		binding:    provider.PostBinding,
+		param:      logoutParamRequest,
+		relayState: r.Form.Get(logoutParamRelayState),
+		sigAlg:     r.Form.Get(logoutParamSigAlg),
+		signature:  r.Form.Get(logoutParamSignature),
+	}
+	encoded := r.Form.Get(logoutParamRequest)
+	if encoded == "" {
+		message.param = logoutParamResponse
+		encoded = r.Form.Get(logoutParamResponse)
+	}
+	if encoded == "" {
+		return nil, nil
+	}
+	encoding := ""
+	if r.Method == http.MethodGet {
+		message.binding = provider.RedirectBinding
+		message.signedQuery = signedRedirectQuery(r.URL.RawQuery, message.param)
+		encoding = saml_xml.EncodingDeflate
+	}
+	message.data, err = saml_xml.InflateAndDecode(encoding, true, encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", message.param, err)
+	}
+	return message, nil
+}
+
+// signedRedirectQuery returns the parameters covered by the signature of the redirect binding
+// as they were received, since the signature is computed over the original URL encoding.
+func signedRedirectQuery(rawQuery, param string) string {
+	values := make(map[string]string, 3)
+	for _, part := range strings.Split(rawQuery, "&") {
+		key, _, _ := strings.Cut(part, "=")
+		switch key {
+		case param, logoutParamRelayState, logoutParamSigAlg:
+			values[key] = part
+		}
+	}
+	signed := make([]string, 0, 3)
+	for _, key := range []string{param, logoutParamRelayState, logoutParamSigAlg} {
+		if part, ok := values[key]; ok {
+			signed = append(signed, part)
+		}
+	}
+	return strings.Join(signed, "&")
+}
+
+func (p *Provider) validateLogoutRequest(sp *serviceprovider.ServiceProvider, logoutRequest *samlp.LogoutRequestType, message *logoutMessage) error {
+	if message.sigAlg == "" && logoutRequest.Signature == nil {
+		return errors.New("logout request must be signed")
+	}
+	if err := validateLogoutSignature(sp, message); err != nil {
+		return fmt.Errorf("failed to verify signature: %w", err)
+	}
+	if logoutRequest.NotOnOrAfter != "" {
+		notOnOrAfter, err := time.Parse(time.RFC3339, logoutRequest.NotOnOrAfter)
+		if err != nil {
+			return fmt.Errorf("failed to parse NotOnOrAfter: %w", err)
+		}
+		if !time.Now().Before(notOnOrAfter) {
+			return errors.New("logout request expired")
+		}
+	}
+	return nil
+}
+
+func validateLogoutSignature(sp *serviceprovider.ServiceProvider, message *logoutMessage) error {
+	if message.binding == provider.PostBinding {
+		return sp.ValidatePostSignature(string(message.data))
+	}
+	if message.sigAlg == "" || message.signature == "" {
+		return errors.New("signature and signature algorithm are required")
+	}
+	sig, err := base64.StdEncoding.DecodeString(message.signature)
+	if err != nil {
+		return err
+	}
+	certs, err := signature.ParseCertificates(saml_xml.GetCertsFromKeyDescriptors(sp.Metadata.SPSSODescriptor.KeyDescriptor))
+	if err != nil {
+		return err
+	}
+	if len(certs) == 0 {
+		return errors.New("no certificate of the service provider found")
+	}
+	for _, cert := range certs {
+		if err = signature.ValidateRedirect(message.sigAlg, []byte(message.signedQuery), sig, cert.PublicKey); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// singleLogoutService returns the Single Logout (SLO) endpoint of the service provider,
+// preferring the redirect binding.
+func singleLogoutService(sp *serviceprovider.ServiceProvider) *command.SAMLSessionLogout {
+	if sp == nil || sp.Metadata == nil {
+		return nil
+	}
+	var logout *command.SAMLSessionLogout
+	for _, endpoint := range sp.Metadata.SPSSODescriptor.SingleLogoutService {
+		if endpoint.Location == "" || (endpoint.Binding != provider.RedirectBinding && endpoint.Binding != provider.PostBinding) {
+			continue
+		}
+		if logout != nil && endpoint.Binding != provider.RedirectBinding {
+			continue
+		}
+		logout = &command.SAMLSessionLogout{
+			LogoutURL:   endpoint.Location,
+			ResponseURL: endpoint.ResponseLocation,
+			Binding:     endpoint.Binding,
+		}
+		if endpoint.Binding == provider.RedirectBinding {
+			break
+		}
+	}
+	return logout
+}
+
+// samlSessionLogout returns the information needed to log the user out of the service provider,
+// if it supports Single Logout (SLO).
+func samlSessionLogout(sp *serviceprovider.ServiceProvider, samlResponse *samlp.ResponseType) *command.SAMLSessionLogout {
+	logout := singleLogoutService(sp)
+	if logout == nil || samlResponse.Assertion.Subject == nil || samlResponse.Assertion.Subject.NameID == nil {
+		return nil
+	}
+	logout.NameID = samlResponse.Assertion.Subject.NameID.Text
+	logout.NameIDFormat = samlResponse.Assertion.Subject.NameID.Format
+	if len(samlResponse.Assertion.AuthnStatement) > 0 {
+		logout.SessionIndex = samlResponse.Assertion.AuthnStatement[0].SessionIndex
+	}
+	return logout
+}
+
+// validReturnURL only allows relative URLs or URLs of the instance to prevent open redirects.
+func validReturnURL(ctx context.Context, returnURL string) string {
+	if returnURL == "" {
+		return ""
+	}
+	parsed, err := url.Parse(returnURL)
+	if err != nil {
+		return ""
+	}
+	if parsed.Scheme == "" && parsed.Host == "" && strings.HasPrefix(parsed.Path, "/") && !strings.HasPrefix(returnURL, "//") && !strings.HasPrefix(returnURL, "/\\") {
+		return returnURL
+	}
+	if parsed.Scheme+"://"+parsed.Host == http_utils.DomainContext(ctx).Origin() {
+		return returnURL
+	}
+	return ""
+}
@@ -0,0 +1,198 @@
+package saml
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/zitadel/saml/pkg/provider"
+	"github.com/zitadel/saml/pkg/provider/serviceprovider"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
+	"github.com/zitadel/saml/pkg/provider/xml/saml"
+	"github.com/zitadel/saml/pkg/provider/xml/samlp"
+
+	http_utils "github.com/zitadel/zitadel/internal/api/http"
+	"github.com/zitadel/zitadel/internal/command"
+)
+
+func Test_signedRedirectQuery(t *testing.T) {
+	tests := []struct {
+		name     string
+		rawQuery string
+		param    string
+		want     string
+	}{
+		{
+			name:     "request with relay state",
+			rawQuery: "Signature=sig&SigAlg=alg%3A1&RelayState=state%2F1&SAMLRequest=req%2B1",
+			param:    logoutParamRequest,
+			want:     "SAMLRequest=req%2B1&RelayState=state%2F1&SigAlg=alg%3A1",
+		},
+		{
+			name:     "response without relay state",
+			rawQuery: "SAMLResponse=resp&SigAlg=alg&Signature=sig&other=value",
+			param:    logoutParamResponse,
+			want:     "SAMLResponse=resp&SigAlg=alg",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, signedRedirectQuery(tt.rawQuery, tt.param))
+		})
+	}
+}
+
+func Test_buildLogoutRedirectQuery(t *testing.T) {
+	assert.Equal(t,
+		"SAMLRequest=a%2Bb%3D&RelayState=%2Fhome&SigAlg=http%3A%2F%2Fwww.w3.org%2F2001%2F04%2Fxmldsig-more%23rsa-sha256",
+		buildLogoutRedirectQuery(logoutParamRequest, "a+b=", "/home", defaultSignatureAlgorithm),
+	)
+	assert.Equal(t,
+		"SAMLResponse=a&SigAlg=alg",
+		buildLogoutRedirectQuery(logoutParamResponse, "a", "", "alg"),
+	)
+}
+
+func Test_singleLogoutService(t *testing.T) {
+	serviceProvider := func(endpoints ...md.EndpointType) *serviceprovider.ServiceProvider {
+		return &serviceprovider.ServiceProvider{
+			Metadata: &md.EntityDescriptorType{
+				SPSSODescriptor: &md.SPSSODescriptorType{
+					SingleLogoutService: endpoints,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name string
+		sp   *serviceprovider.ServiceProvider
+		want *command.SAMLSessionLogout
+	}{
+		{
+			name: "no single logout service",
+			sp:   serviceProvider(),
+		},
+		{
+			name: "unsupported binding",
+			sp: serviceProvider(md.EndpointType{
+				Binding:  "urn:oasis:names:tc:SAML:2.0:bindings:SOAP",
+				Location: "https://sp.example.com/soap",
+			}),
+		},
+		{
+			name: "post binding",
+			sp: serviceProvider(md.EndpointType{
+				Binding:          provider.PostBinding,
+				Location:         "https://sp.example.com/slo",
+				ResponseLocation: "https://sp.example.com/slo/response",
+			}),
+			want: &command.SAMLSessionLogout{
+				LogoutURL:   "https://sp.example.com/slo",
+				ResponseURL: "https://sp.example.com/slo/response",
+				Binding:     provider.PostBinding,
+			},
+		},
+		{
+			name: "redirect binding preferred",
+			sp: serviceProvider(
+				md.EndpointType{
+					Binding:  provider.PostBinding,
+					Location: "https://sp.example.com/slo/post",
+				},
+				md.EndpointType{
+					Binding:  provider.RedirectBinding,
+					Location: "https://sp.example.com/slo/redirect",
+				},
+			),
+			want: &command.SAMLSessionLogout{
+				LogoutURL: "https://sp.example.com/slo/redirect",
+				Binding:   provider.RedirectBinding,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, singleLogoutService(tt.sp))
+		})
+	}
+}
+
+func Test_samlSessionLogout(t *testing.T) {
+	sp := &serviceprovider.ServiceProvider{
+		Metadata: &md.EntityDescriptorType{
+			SPSSODescriptor: &md.SPSSODescriptorType{
+				SingleLogoutService: []md.EndpointType{{
+					Binding:  provider.RedirectBinding,
+					Location: "https://sp.example.com/slo",
+				}},
+			},
+		},
+	}
+	samlResponse := &samlp.ResponseType{
+		Assertion: saml.AssertionType{
+			Subject: &saml.SubjectType{
+				NameID: &saml.NameIDType{
+					Format: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+					Text:   "user@example.com",
+				},
+			},
+			AuthnStatement: []saml.AuthnStatementType{{SessionIndex: "sessionIndex"}},
+		},
+	}
+	assert.Equal(t, &command.SAMLSessionLogout{
+		NameID:       "user@example.com",
+		NameIDFormat: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		SessionIndex: "sessionIndex",
+		LogoutURL:    "https://sp.example.com/slo",
+		Binding:      provider.RedirectBinding,
+	}, samlSessionLogout(sp, samlResponse))
+	assert.Nil(t, samlSessionLogout(sp, &samlp.ResponseType{}))
+}
+
+func Test_validReturnURL(t *testing.T) {
+	ctx := http_utils.WithDomainContext(context.Background(), &http_utils.DomainCtx{
+		InstanceHost: "instance.example.com",
+		Protocol:     "https",
+	})
+	tests := []struct {
+		name      string
+		returnURL string
+		want      string
+	}{
+		{
+			name:      "empty",
+			returnURL: "",
+			want:      "",
+		},
+		{
+			name:      "relative",
+			returnURL: "/ui/v2/login/logout/done",
+			want:      "/ui/v2/login/logout/done",
+		},
+		{
+			name:      "same origin",
+			returnURL: "https://instance.example.com/ui/v2/login",
+			want:      "https://instance.example.com/ui/v2/login",
+		},
+		{
+			name:      "other origin",
+			returnURL: "https://evil.example.com/",
+			want:      "",
+		},
+		{
+			name:      "protocol relative",
+			returnURL: "//evil.example.com/",
+			want:      "",
+		},
+		{
+			name:      "backslash",
+			returnURL: "/\\evil.example.com/",
+			want:      "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validReturnURL(ctx, tt.returnURL))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/gorilla/mux"
 	"github.com/zitadel/saml/pkg/provider"
 
 	http_utils "github.com/zitadel/zitadel/internal/api/http"
@@ -22,6 +23,8 @@ import (
 
 const (
 	HandlerPrefix = "/saml/v2"
+
+	defaultSignatureAlgorithm = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
 )
 
 type Config struct {
@@ -31,7 +34,11 @@ type Config struct {
 
 type Provider struct {
 	*provider.Provider
-	command *command.Commands
+	command     *command.Commands
+	storage     *Storage
+	httpHandler http.Handler
+
+	signatureAlgorithm string
 }
 
 func NewProvider(
@@ -66,17 +73,18 @@ func NewProvider(
 		return nil, err
 	}
 
+	interceptors := []provider.HttpInterceptor{
+		middleware.MetricsHandler(metricTypes),
+		middleware.TelemetryHandler(),
+		middleware.NoCacheInterceptor().Handler,
+		instanceHandler,
+		userAgentCookie,
+		accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
+		http_utils.CopyHeadersToContext,
+		middleware.ActivityHandler,
+	}
 	options := []provider.Option{
-		provider.WithHttpInterceptors(
-			middleware.MetricsHandler(metricTypes),
-			middleware.TelemetryHandler(),
-			middleware.NoCacheInterceptor().Handler,
-			instanceHandler,
-			userAgentCookie,
-			accessHandler.HandleWithPublicAuthPathPrefixes(publicAuthPathPrefixes(conf.ProviderConfig)),
-			http_utils.CopyHeadersToContext,
-			middleware.ActivityHandler,
-		),
+		provider.WithHttpInterceptors(interceptors...),
 		provider.WithCustomTimeFormat("2006-01-02T15:04:05.999Z"),
 	}
 	if !externalSecure {
@@ -92,10 +100,31 @@ func NewProvider(
 	if err != nil {
 		return nil, err
 	}
-	return &Provider{
-		p,
-		command,
-	}, nil
+	samlProvider := &Provider{
+		Provider:           p,
+		command:            command,
+		storage:            provStorage,
+		signatureAlgorithm: signatureAlgorithm(conf.ProviderConfig),
+	}
+	samlProvider.httpHandler = samlProvider.createRouter(singleLogoutEndpoint(conf.ProviderConfig), interceptors...)
+	return samlProvider, nil
+}
+
+// HttpHandler returns the handler of the provider,
+// where the Single Logout (SLO) endpoint is handled by ZITADEL itself.
+func (p *Provider) HttpHandler() http.Handler {
+	return p.httpHandler
+}
+
+func (p *Provider) createRouter(logoutEndpoint string, interceptors ...provider.HttpInterceptor) http.Handler {
+	var logoutHandler http.Handler = http.HandlerFunc(p.logoutHandler)
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		logoutHandler = interceptors[i](logoutHandler)
+	}
+	router := mux.NewRouter()
+	router.Handle(logoutEndpoint, provider.NewIssuerInterceptor(p.IssuerFromRequest).Handler(logoutHandler))
+	router.PathPrefix("/").Handler(p.Provider.HttpHandler())
+	return router
 }
 
 func ContextToIssuer(ctx context.Context) string {
@@ -152,3 +181,22 @@ func publicAuthPathPrefixes(config *provider.Config) []string {
 	}
 	return []string{metadataEndpoint, certificateEndpoint, ssoEndpoint}
 }
+
+// SingleLogoutPath returns the path of the Single Logout (SLO) endpoint of the provider.
+func SingleLogoutPath(conf Config) string {
+	return HandlerPrefix + singleLogoutEndpoint(conf.ProviderConfig)
+}
+
+func singleLogoutEndpoint(config *provider.Config) string {
+	if config.IDPConfig != nil && config.IDPConfig.Endpoints != nil && config.IDPConfig.Endpoints.SingleLogOut != nil && config.IDPConfig.Endpoints.SingleLogOut.Relative() != "/" {
+		return config.IDPConfig.Endpoints.SingleLogOut.Relative()
+	}
+	return provider.NewEndpoint(provider.DefaultSingleLogOutEndpoint).Relative()
+}
+
+func signatureAlgorithm(config *provider.Config) string {
+	if config.IDPConfig != nil && config.IDPConfig.SignatureAlgorithm != "" {
+		return config.IDPConfig.SignatureAlgorithm
+	}
+	return defaultSignatureAlgorithm
+}
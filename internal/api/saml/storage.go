@@ -217,6 +217,16 @@ func (p *Storage) SetUserinfoWithUserID(ctx context.Context, applicationID strin
 	if err != nil {
 		return err
 	}
+	mappedAttributes, err := p.getMappedAttributes(ctx, applicationID, user, userGrants)
+	if err != nil {
+		return err
+	}
+	// attributes set by actions take precedence over the attribute mapping of the application
+	for name, attribute := range mappedAttributes {
+		if _, ok := customAttributes[name]; !ok {
+			customAttributes = appendCustomAttribute(customAttributes, name, attribute.nameFormat, attribute.attributeValue)
+		}
+	}
 
 	setUserinfo(user, userinfo, attributes, customAttributes)
 
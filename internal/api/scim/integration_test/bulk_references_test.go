@@ -0,0 +1,178 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+	"github.com/zitadel/zitadel/internal/test"
+)
+
+func TestBulk_bulkIDReferencesInData(t *testing.T) {
+	iamOwnerCtx := Instance.WithAuthorization(CTX, integration.UserTypeIAMOwner)
+	org := Instance.CreateOrganization(iamOwnerCtx, gofakeit.Name(), gofakeit.Email())
+
+	// the group is defined before the referenced users
+	body := test.Must(json.Marshal(&scim.BulkRequest{
+		Schemas: []schemas.ScimSchemaType{schemas.IdBulkRequest},
+		Operations: []*scim.BulkRequestOperation{
+			{
+				Method: http.MethodPost,
+				BulkID: "group",
+				Path:   "/Groups",
+				Data:   buildGroupWithMembersJson(gofakeit.Name(), "bulkId:user1", "bulkId:User2"),
+			},
+			{
+				Method: http.MethodPost,
+				BulkID: "user1",
+				Path:   "/Users",
+				Data:   withUsername(minimalUserJson, gofakeit.Username()),
+			},
+			{
+				Method: http.MethodPost,
+				BulkID: "user2",
+				Path:   "/Users",
+				Data:   withUsername(minimalUserJson, gofakeit.Username()),
+			},
+		},
+	}))
+
+	resp, err := Instance.Client.SCIM.Bulk(iamOwnerCtx, org.GetOrganizationId(), body)
+	require.NoError(t, err)
+	require.Len(t, resp.Operations, 3)
+	for _, op := range resp.Operations {
+		require.Nil(t, op.Response)
+		require.Equal(t, "201", op.Status)
+	}
+
+	// operations are processed in the order of their dependencies
+	assert.Equal(t, "user1", resp.Operations[0].BulkID)
+	assert.Equal(t, "user2", resp.Operations[1].BulkID)
+	assert.Equal(t, "group", resp.Operations[2].BulkID)
+
+	groupID := path.Base(resp.Operations[2].Location)
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		group, err := Instance.Client.SCIM.Groups.Get(iamOwnerCtx, org.GetOrganizationId(), groupID)
+		require.NoError(ttt, err)
+
+		memberIDs := make([]string, len(group.Members))
+		for i, member := range group.Members {
+			memberIDs[i] = member.Value
+		}
+		assert.ElementsMatch(ttt, buildCreatedIDs(resp)[:2], memberIDs)
+	}, retryDuration, tick)
+}
+
+func TestBulk_circularBulkIDReferences(t *testing.T) {
+	iamOwnerCtx := Instance.WithAuthorization(CTX, integration.UserTypeIAMOwner)
+	org := Instance.CreateOrganization(iamOwnerCtx, gofakeit.Name(), gofakeit.Email())
+
+	body := test.Must(json.Marshal(&scim.BulkRequest{
+		Schemas: []schemas.ScimSchemaType{schemas.IdBulkRequest},
+		Operations: []*scim.BulkRequestOperation{
+			{
+				Method: http.MethodPost,
+				BulkID: "group1",
+				Path:   "/Groups",
+				Data:   buildGroupWithMembersJson(gofakeit.Name(), "bulkId:group2"),
+			},
+			{
+				Method: http.MethodPost,
+				BulkID: "group2",
+				Path:   "/Groups",
+				Data:   buildGroupWithMembersJson(gofakeit.Name(), "bulkId:group1"),
+			},
+		},
+	}))
+
+	resp, err := Instance.Client.SCIM.Bulk(iamOwnerCtx, org.GetOrganizationId(), body)
+	require.NoError(t, err)
+	require.Len(t, resp.Operations, 2)
+	for _, op := range resp.Operations {
+		assert.Equal(t, "409", op.Status)
+		require.NotNil(t, op.Response)
+		assert.Equal(t, "SCIM-BLK30", op.Response.ZitadelDetail.ID)
+	}
+}
+
+func TestBulk_transactional(t *testing.T) {
+	iamOwnerCtx := Instance.WithAuthorization(CTX, integration.UserTypeIAMOwner)
+	org := Instance.CreateOrganization(iamOwnerCtx, gofakeit.Name(), gofakeit.Email())
+	username := gofakeit.Username()
+
+	buildRequest := func(groupMember string) []byte {
+		return test.Must(json.Marshal(&scim.BulkRequest{
+			Schemas:        []schemas.ScimSchemaType{schemas.IdBulkRequest, schemas.IdZitadelBulkRequest},
+			ZitadelOptions: &scim.BulkRequestZitadelOptions{Transactional: true},
+			Operations: []*scim.BulkRequestOperation{
+				{
+					Method: http.MethodPost,
+					BulkID: "user",
+					Path:   "/Users",
+					Data:   withUsername(minimalUserJson, username),
+				},
+				{
+					Method: http.MethodPost,
+					BulkID: "group",
+					Path:   "/Groups",
+					Data:   buildGroupWithMembersJson(gofakeit.Name(), groupMember),
+				},
+			},
+		}))
+	}
+
+	// the second operation fails, the created user is rolled back
+	resp, err := Instance.Client.SCIM.Bulk(iamOwnerCtx, org.GetOrganizationId(), buildRequest("unknown"))
+	require.NoError(t, err)
+	require.Len(t, resp.Operations, 2)
+	assert.Equal(t, "424", resp.Operations[0].Status)
+	assert.Empty(t, resp.Operations[0].Location)
+	assert.Equal(t, "400", resp.Operations[1].Status)
+
+	// the same user can be created again as the first request was rolled back
+	resp, err = Instance.Client.SCIM.Bulk(iamOwnerCtx, org.GetOrganizationId(), buildRequest("bulkId:user"))
+	require.NoError(t, err)
+	require.Len(t, resp.Operations, 2)
+	for _, op := range resp.Operations {
+		require.Nil(t, op.Response)
+		assert.Equal(t, "201", op.Status)
+	}
+}
+
+func TestBulk_transactionalMissingSchema(t *testing.T) {
+	body := test.Must(json.Marshal(&scim.BulkRequest{
+		Schemas:        []schemas.ScimSchemaType{schemas.IdBulkRequest},
+		ZitadelOptions: &scim.BulkRequestZitadelOptions{Transactional: true},
+		Operations:     buildMinimalUpdateRequest(Instance.AdminUserID).Operations,
+	}))
+
+	_, err := Instance.Client.SCIM.Bulk(CTX, Instance.DefaultOrg.Id, body)
+	scimErr := scim.RequireScimError(t, http.StatusBadRequest, err)
+	assert.Equal(t, "invalidSyntax", scimErr.Error.ScimType)
+}
+
+func buildGroupWithMembersJson(displayName string, memberIDs ...string) []byte {
+	members := make([]map[string]string, len(memberIDs))
+	for i, memberID := range memberIDs {
+		members[i] = map[string]string{"value": memberID}
+	}
+
+	return []byte(fmt.Sprintf(
+		`{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": %q, "members": %s}`,
+		displayName,
+		test.Must(json.Marshal(members)),
+	))
+}
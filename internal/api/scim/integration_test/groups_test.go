@@ -0,0 +1,160 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+)
+
+func TestCreateGroup_errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         context.Context
+		orgID       string
+		body        string
+		errorStatus int
+	}{
+		{
+			name:        "not authenticated",
+			ctx:         context.Background(),
+			body:        `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": "group"}`,
+			errorStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "no permissions",
+			ctx:         Instance.WithAuthorization(CTX, integration.UserTypeNoPermission),
+			body:        `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": "group"}`,
+			errorStatus: http.StatusNotFound,
+		},
+		{
+			name: "missing display name",
+			body: `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"]}`,
+		},
+		{
+			name: "unknown member",
+			body: `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": "group", "members": [{"value": "unknown"}]}`,
+		},
+		{
+			name: "unsupported member type",
+			body: `{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": "group", "members": [{"value": "1", "type": "Group"}]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := tt.ctx
+			if ctx == nil {
+				ctx = CTX
+			}
+
+			orgID := tt.orgID
+			if orgID == "" {
+				orgID = Instance.DefaultOrg.Id
+			}
+			_, err := Instance.Client.SCIM.Groups.Create(ctx, orgID, []byte(tt.body))
+
+			statusCode := tt.errorStatus
+			if statusCode == 0 {
+				statusCode = http.StatusBadRequest
+			}
+			scim.RequireScimError(t, statusCode, err)
+		})
+	}
+}
+
+func TestGroup_lifecycle(t *testing.T) {
+	user1 := Instance.CreateHumanUser(CTX)
+	user2 := Instance.CreateHumanUser(CTX)
+	displayName := gofakeit.Name()
+
+	// create
+	createdGroup, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, []byte(fmt.Sprintf(
+		`{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "externalId": "ext-1", "displayName": %q, "members": [{"value": %q}]}`,
+		displayName, user1.GetUserId(),
+	)))
+	require.NoError(t, err)
+	require.NotEmpty(t, createdGroup.ID)
+	assert.Equal(t, schemas.GroupResourceType, createdGroup.Resource.Meta.ResourceType)
+	assert.Equal(t, displayName, createdGroup.DisplayName)
+	require.Len(t, createdGroup.Members, 1)
+	assert.Equal(t, user1.GetUserId(), createdGroup.Members[0].Value)
+
+	// duplicate display name
+	_, err = Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, []byte(fmt.Sprintf(
+		`{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": %q}`,
+		displayName,
+	)))
+	scim.RequireScimError(t, http.StatusConflict, err)
+
+	// patch members
+	err = Instance.Client.SCIM.Groups.Update(CTX, Instance.DefaultOrg.Id, createdGroup.ID, []byte(fmt.Sprintf(
+		`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"], "Operations": [{"op": "add", "path": "members", "value": [{"value": %q}]}, {"op": "remove", "path": "members[value eq %q]"}]}`,
+		user2.GetUserId(), user1.GetUserId(),
+	)))
+	require.NoError(t, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		group, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+		require.NoError(ttt, err)
+		assert.Equal(ttt, displayName, group.DisplayName)
+		assert.Equal(ttt, "ext-1", group.ExternalID)
+		require.Len(ttt, group.Members, 1)
+		assert.Equal(ttt, user2.GetUserId(), group.Members[0].Value)
+	}, retryDuration, tick)
+
+	// list by member
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		groups, err := Instance.Client.SCIM.Groups.List(CTX, Instance.DefaultOrg.Id, &scim.ListRequest{
+			Filter: gu.Ptr(fmt.Sprintf(`members[value eq %q]`, user2.GetUserId())),
+		})
+		require.NoError(ttt, err)
+		require.Equal(ttt, 1, groups.TotalResults)
+		assert.Equal(ttt, createdGroup.ID, groups.Resources[0].ID)
+	}, retryDuration, tick)
+
+	// replace
+	replacedGroup, err := Instance.Client.SCIM.Groups.Replace(CTX, Instance.DefaultOrg.Id, createdGroup.ID, []byte(fmt.Sprintf(
+		`{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": %q}`,
+		displayName+"-replaced",
+	)))
+	require.NoError(t, err)
+	assert.Equal(t, displayName+"-replaced", replacedGroup.DisplayName)
+	assert.Empty(t, replacedGroup.Members)
+
+	// delete
+	err = Instance.Client.SCIM.Groups.Delete(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+	require.NoError(t, err)
+
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		_, err := Instance.Client.SCIM.Groups.Get(CTX, Instance.DefaultOrg.Id, createdGroup.ID)
+		scim.RequireScimError(ttt, http.StatusNotFound, err)
+	}, retryDuration, tick)
+}
+
+func TestGroup_otherOrg(t *testing.T) {
+	createdGroup, err := Instance.Client.SCIM.Groups.Create(CTX, Instance.DefaultOrg.Id, []byte(fmt.Sprintf(
+		`{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:Group"], "displayName": %q}`,
+		gofakeit.Name(),
+	)))
+	require.NoError(t, err)
+
+	iamOwnerCtx := Instance.WithAuthorization(CTX, integration.UserTypeIAMOwner)
+	_, err = Instance.Client.SCIM.Groups.Get(iamOwnerCtx, SecondaryOrganization.OrganizationId, createdGroup.ID)
+	scim.RequireScimError(t, http.StatusNotFound, err)
+
+	err = Instance.Client.SCIM.Groups.Delete(iamOwnerCtx, SecondaryOrganization.OrganizationId, createdGroup.ID)
+	scim.RequireScimError(t, http.StatusNotFound, err)
+}
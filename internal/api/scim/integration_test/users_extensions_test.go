@@ -0,0 +1,138 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+	"github.com/zitadel/zitadel/pkg/grpc/management"
+)
+
+const extensionsUserJsonTemplate = `{
+	"schemas": [
+		"urn:ietf:params:scim:schemas:core:2.0:User",
+		"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"%s
+	],
+	"userName": %q,
+	"name": {
+		"familyName": "Jensen",
+		"givenName": "Barbara"
+	},
+	"emails": [
+		{
+			"value": "bjensen@example.com",
+			"primary": true
+		}
+	],
+	"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": {
+		"employeeNumber": "701984",
+		"department": "Tour Operations",
+		"manager": {
+			"value": "26118915-6090-4610-87e4-49d8ca9f808d",
+			"displayName": "John Smith"
+		}
+	}%s
+}`
+
+func TestUser_enterpriseExtension(t *testing.T) {
+	createdUser, err := Instance.Client.SCIM.Users.Create(CTX, Instance.DefaultOrg.Id, []byte(fmt.Sprintf(extensionsUserJsonTemplate, "", gofakeit.Username(), "")))
+	require.NoError(t, err)
+	assert.Contains(t, createdUser.Resource.Schemas, schemas.IdEnterpriseUser)
+
+	err = Instance.Client.SCIM.Users.Update(CTX, Instance.DefaultOrg.Id, createdUser.ID, []byte(`{
+		"schemas": ["urn:ietf:params:scim:api:messages:2.0:PatchOp"],
+		"Operations": [
+			{"op": "replace", "path": "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department", "value": "Sales"},
+			{"op": "replace", "value": {"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:costCenter": "4130"}}
+		]
+	}`))
+	require.NoError(t, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		user, err := Instance.Client.SCIM.Users.Get(CTX, Instance.DefaultOrg.Id, createdUser.ID)
+		require.NoError(tt, err)
+		assert.Contains(tt, user.Resource.Schemas, schemas.IdEnterpriseUser)
+		require.NotNil(tt, user.EnterpriseUser)
+		assert.Equal(tt, "701984", user.EnterpriseUser.EmployeeNumber)
+		assert.Equal(tt, "Sales", user.EnterpriseUser.Department)
+		assert.Equal(tt, "4130", user.EnterpriseUser.CostCenter)
+		require.NotNil(tt, user.EnterpriseUser.Manager)
+		assert.Equal(tt, "John Smith", user.EnterpriseUser.Manager.DisplayName)
+	}, retryDuration, tick)
+}
+
+func TestUser_customAttributes(t *testing.T) {
+	iamOwnerCtx := Instance.WithAuthorization(CTX, integration.UserTypeIAMOwner)
+	org := Instance.CreateOrganization(iamOwnerCtx, gofakeit.Name(), gofakeit.Email())
+	_, err := Instance.Client.Mgmt.SetOrgMetadata(integration.SetOrgID(iamOwnerCtx, org.GetOrganizationId()), &management.SetOrgMetadataRequest{
+		Key:   "urn:zitadel:scim:customAttributes",
+		Value: []byte(`[{"name": "badgeNumber", "description": "Badge number"}, {"name": "floors", "type": "integer", "multiValued": true}]`),
+	})
+	require.NoError(t, err)
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		resp, err := Instance.Client.SCIM.GetSchema(iamOwnerCtx, org.GetOrganizationId(), string(schemas.IdZitadelUserMetadata))
+		require.NoError(tt, err)
+
+		schema := new(schemas.ResourceSchema)
+		require.NoError(tt, json.Unmarshal(resp, schema))
+		require.Len(tt, schema.Attributes, 2)
+		assert.Equal(tt, "badgeNumber", schema.Attributes[0].Name)
+		assert.Equal(tt, schemas.SchemaAttributeTypeInteger, schema.Attributes[1].Type)
+		assert.True(tt, schema.Attributes[1].MultiValued)
+	}, retryDuration, tick)
+
+	// unknown attribute
+	_, err = Instance.Client.SCIM.Users.Create(iamOwnerCtx, org.GetOrganizationId(), []byte(fmt.Sprintf(
+		extensionsUserJsonTemplate,
+		`, "urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata"`,
+		gofakeit.Username(),
+		`, "urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata": {"unknown": "value"}`,
+	)))
+	scim.RequireScimError(t, http.StatusBadRequest, err)
+
+	// invalid type
+	_, err = Instance.Client.SCIM.Users.Create(iamOwnerCtx, org.GetOrganizationId(), []byte(fmt.Sprintf(
+		extensionsUserJsonTemplate,
+		`, "urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata"`,
+		gofakeit.Username(),
+		`, "urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata": {"floors": "first"}`,
+	)))
+	scim.RequireScimError(t, http.StatusBadRequest, err)
+
+	createdUser, err := Instance.Client.SCIM.Users.Create(iamOwnerCtx, org.GetOrganizationId(), []byte(fmt.Sprintf(
+		extensionsUserJsonTemplate,
+		`, "urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata"`,
+		gofakeit.Username(),
+		`, "urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata": {"badgeNumber": "B-42", "floors": [1, 3]}`,
+	)))
+	require.NoError(t, err)
+
+	require.EventuallyWithT(t, func(tt *assert.CollectT) {
+		md, err := Instance.Client.Mgmt.GetUserMetadata(integration.SetOrgID(iamOwnerCtx, org.GetOrganizationId()), &management.GetUserMetadataRequest{
+			Id:  createdUser.ID,
+			Key: "badgeNumber",
+		})
+		require.NoError(tt, err)
+		assert.Equal(tt, "B-42", string(md.Metadata.Value))
+
+		user, err := Instance.Client.SCIM.Users.Get(iamOwnerCtx, org.GetOrganizationId(), createdUser.ID)
+		require.NoError(tt, err)
+		assert.Contains(tt, user.Resource.Schemas, schemas.IdZitadelUserMetadata)
+		assert.JSONEq(tt, `"B-42"`, string(user.Metadata["badgeNumber"]))
+		assert.JSONEq(tt, `[1,3]`, string(user.Metadata["floors"]))
+	}, retryDuration, tick)
+}
@@ -0,0 +1,84 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/integration"
+	"github.com/zitadel/zitadel/internal/integration/scim"
+)
+
+func TestListUser_cursor(t *testing.T) {
+	iamOwnerCtx := Instance.WithAuthorization(CTX, integration.UserTypeIAMOwner)
+	org := Instance.CreateOrganization(iamOwnerCtx, gofakeit.Name(), gofakeit.Email())
+	createUsers(t, iamOwnerCtx, org.GetOrganizationId())
+
+	retryDuration, tick := integration.WaitForAndTickWithMaxDuration(CTX, time.Minute)
+	require.EventuallyWithT(t, func(ttt *assert.CollectT) {
+		allUsers, err := Instance.Client.SCIM.Users.List(iamOwnerCtx, org.GetOrganizationId(), &scim.ListRequest{
+			Count: gu.Ptr(100),
+		})
+		require.NoError(ttt, err)
+		require.GreaterOrEqual(ttt, allUsers.TotalResults, totalCountOfHumanUsers-1)
+
+		expectedIDs := make([]string, len(allUsers.Resources))
+		for i, user := range allUsers.Resources {
+			expectedIDs[i] = user.ID
+		}
+
+		for _, sendAsPost := range []bool{false, true} {
+			var pagedIDs []string
+			cursor := ""
+			for pages := 0; pages < len(expectedIDs); pages++ {
+				page, err := Instance.Client.SCIM.Users.List(iamOwnerCtx, org.GetOrganizationId(), &scim.ListRequest{
+					Count:      gu.Ptr(5),
+					Cursor:     gu.Ptr(cursor),
+					SendAsPost: sendAsPost,
+				})
+				require.NoError(ttt, err)
+				assert.Zero(ttt, page.TotalResults)
+				assert.LessOrEqual(ttt, len(page.Resources), 5)
+
+				for _, user := range page.Resources {
+					pagedIDs = append(pagedIDs, user.ID)
+				}
+
+				if page.NextCursor == "" {
+					break
+				}
+				cursor = page.NextCursor
+			}
+
+			assert.Equal(ttt, expectedIDs, pagedIDs)
+		}
+	}, retryDuration, tick)
+}
+
+func TestListUser_cursorInvalid(t *testing.T) {
+	_, err := Instance.Client.SCIM.Users.List(CTX, Instance.DefaultOrg.Id, &scim.ListRequest{
+		Cursor: gu.Ptr("invalid!"),
+	})
+	scimErr := scim.RequireScimError(t, http.StatusBadRequest, err)
+	assert.Equal(t, "invalidCursor", scimErr.Error.ScimType)
+
+	_, err = Instance.Client.SCIM.Users.List(CTX, Instance.DefaultOrg.Id, &scim.ListRequest{
+		Cursor: gu.Ptr(""),
+		SortBy: gu.Ptr("userName"),
+	})
+	scimErr = scim.RequireScimError(t, http.StatusBadRequest, err)
+	assert.Equal(t, "invalidValue", scimErr.Error.ScimType)
+
+	_, err = Instance.Client.SCIM.Users.List(CTX, Instance.DefaultOrg.Id, &scim.ListRequest{
+		Cursor:     gu.Ptr(""),
+		StartIndex: gu.Ptr(5),
+	})
+	scim.RequireScimError(t, http.StatusBadRequest, err)
+}
@@ -38,17 +38,27 @@ func GetScimContextData(ctx context.Context) ScimContextData {
 
 func SetScimBulkIDMapping(ctx context.Context, bulkID, zitadelID string) context.Context {
 	data := GetScimContextData(ctx)
-	data.bulkIDMapping[bulkID] = zitadelID
+	data.bulkIDMapping[strings.ToLower(bulkID)] = zitadelID
 	return ctx
 }
 
+// ParseScimBulkIDReference returns the referenced bulkID if the value is a bulkID reference (bulkId:<bulkID>).
+// BulkIDs are case-insensitive and therefore returned in lowercase.
+func ParseScimBulkIDReference(value string) (bulkID string, ok bool) {
+	lowerValue := strings.ToLower(value)
+	if !strings.HasPrefix(lowerValue, bulkIDPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(lowerValue, bulkIDPrefix), true
+}
+
 func ResolveScimBulkIDIfNeeded(ctx context.Context, resourceID string) (string, error) {
-	lowerResourceID := strings.ToLower(resourceID)
-	if !strings.HasPrefix(lowerResourceID, bulkIDPrefix) {
+	bulkID, ok := ParseScimBulkIDReference(resourceID)
+	if !ok {
 		return resourceID, nil
 	}
 
-	bulkID := strings.TrimPrefix(lowerResourceID, bulkIDPrefix)
 	data := GetScimContextData(ctx)
 	zitadelID, ok := data.bulkIDMapping[bulkID]
 	if !ok {
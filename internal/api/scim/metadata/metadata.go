@@ -32,6 +32,11 @@ const (
 	KeyEntitlements             Key = KeyPrefix + "entitlements"
 	KeyRoles                    Key = KeyPrefix + "roles"
 	KeyEmails                   Key = KeyPrefix + "emails"
+	KeyEnterpriseUser           Key = KeyPrefix + "enterpriseUser"
+
+	// KeyCustomAttributes the key of the organization metadata
+	// containing the definitions of the custom attributes of the user metadata schema extension.
+	KeyCustomAttributes Key = KeyPrefix + "customAttributes"
 )
 
 var (
@@ -50,6 +55,7 @@ var (
 		KeyEntitlements,
 		KeyRoles,
 		KeyEmails,
+		KeyEnterpriseUser,
 	}
 
 	AttributePathToMetadataKeys = map[string][]Key{
@@ -68,6 +74,9 @@ var (
 		"entitlements":         {KeyEntitlements},
 		"roles":                {KeyRoles},
 		"emails":               {KeyEmails},
+
+		// the path of extension attributes starts with the lowercase urn of the extension schema
+		"urn:ietf:params:scim:schemas:extension:enterprise:2.0:user": {KeyEnterpriseUser},
 	}
 )
 
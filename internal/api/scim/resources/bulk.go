@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"math"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -16,11 +18,13 @@ import (
 	"github.com/zitadel/zitadel/internal/api/scim/metadata"
 	"github.com/zitadel/zitadel/internal/api/scim/schemas"
 	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type BulkHandler struct {
 	cfg                          *scim_config.BulkConfig
+	command                      *command.Commands
 	handlersByPluralResourceName map[schemas.ScimResourceTypePlural]RawResourceHandlerAdapter
 }
 
@@ -28,6 +32,16 @@ type BulkRequest struct {
 	Schemas      []schemas.ScimSchemaType `json:"schemas"`
 	FailOnErrors *int                     `json:"failOnErrors"`
 	Operations   []*BulkRequestOperation  `json:"Operations"`
+
+	// ZitadelOptions ZITADEL specific options of the bulk request,
+	// the schema IdZitadelBulkRequest is expected to be provided if set.
+	ZitadelOptions *BulkRequestZitadelOptions `json:"urn:ietf:params:scim:api:zitadel:messages:2.0:BulkRequest,omitempty"`
+}
+
+type BulkRequestZitadelOptions struct {
+	// Transactional if true, the changes of all operations are applied atomically.
+	// If any operation fails, the processing stops and no changes are applied.
+	Transactional bool `json:"transactional"`
 }
 
 type BulkRequestOperation struct {
@@ -54,8 +68,12 @@ func (r *BulkRequest) GetSchemas() []schemas.ScimSchemaType {
 	return r.Schemas
 }
 
+// errBulkRollback is used to discard the changes of a transactional bulk request
+var errBulkRollback = errors.New("bulk operation failed")
+
 func NewBulkHandler(
 	cfg scim_config.BulkConfig,
+	command *command.Commands,
 	handlers ...RawResourceHandlerAdapter,
 ) *BulkHandler {
 	handlersByPluralResourceName := make(map[schemas.ScimResourceTypePlural]RawResourceHandlerAdapter, len(handlers))
@@ -65,10 +83,15 @@ func NewBulkHandler(
 
 	return &BulkHandler{
 		&cfg,
+		command,
 		handlersByPluralResourceName,
 	}
 }
 
+func (r *BulkRequest) isTransactional() bool {
+	return r.ZitadelOptions != nil && r.ZitadelOptions.Transactional
+}
+
 func (h *BulkHandler) BulkFromHttp(r *http.Request) (*BulkResponse, error) {
 	req, err := h.readBulkRequest(r)
 	if err != nil {
@@ -87,22 +110,74 @@ func (h *BulkHandler) readBulkRequest(r *http.Request) (*BulkRequest, error) {
 	if len(request.Operations) > h.cfg.MaxOperationsCount {
 		return nil, serrors.ThrowPayloadTooLarge(zerrors.ThrowInvalidArgumentf(nil, "SCIM-BLK19", "Too many bulk operations in one request, max %d allowed.", h.cfg.MaxOperationsCount))
 	}
+
+	if request.FailOnErrors != nil && *request.FailOnErrors < 1 {
+		return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-BLK21", "FailOnErrors has to be greater than 0"))
+	}
+
+	if request.ZitadelOptions != nil && !slices.Contains(request.Schemas, schemas.IdZitadelBulkRequest) {
+		return nil, serrors.ThrowInvalidSyntax(zerrors.ThrowInvalidArgumentf(nil, "SCIM-BLK24", "Expected schema %v is not provided", schemas.IdZitadelBulkRequest))
+	}
+
 	return request, nil
 }
 
 func (h *BulkHandler) processRequest(ctx context.Context, req *BulkRequest) (*BulkResponse, error) {
-	errorBudget := math.MaxInt32
-	if req.FailOnErrors != nil {
-		errorBudget = *req.FailOnErrors
+	if !req.isTransactional() {
+		errorBudget := math.MaxInt32
+		if req.FailOnErrors != nil {
+			errorBudget = *req.FailOnErrors
+		}
+
+		return h.processOperations(ctx, req.Operations, errorBudget), nil
+	}
+
+	// in transactional mode the processing stops at the first error
+	// and the changes of all operations are discarded.
+	// The events of the operations are pushed at once after all operations succeeded,
+	// so no database transaction is held open while the operations are processed.
+	var resp *BulkResponse
+	err := h.command.PushInBatch(ctx, func(ctx context.Context) error {
+		resp = h.processOperations(ctx, req.Operations, 1)
+		for _, opResp := range resp.Operations {
+			if opResp.Error != nil {
+				return errBulkRollback
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, errBulkRollback) {
+		markBulkOperationsRolledBack(ctx, resp)
+		return resp, nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	return resp, nil
+}
 
+// processOperations processes the operations ordered by their bulkID references,
+// an operation referencing the bulkID of another operation is processed after the referenced operation.
+// The processing stops as soon as the errorBudget is used up.
+func (h *BulkHandler) processOperations(ctx context.Context, operations []*BulkRequestOperation, errorBudget int) *BulkResponse {
 	resp := &BulkResponse{
 		Schemas:    []schemas.ScimSchemaType{schemas.IdBulkResponse},
-		Operations: make([]*BulkResponseOperation, 0, len(req.Operations)),
+		Operations: make([]*BulkResponseOperation, 0, len(operations)),
 	}
 
-	for _, operation := range req.Operations {
-		opResp := h.processOperation(ctx, operation)
+	pending := newPendingBulkOperations(operations)
+	for pending.len() > 0 {
+		op, ok := pending.next()
+		if !ok {
+			// the remaining operations reference each other
+			for _, op := range pending.operations {
+				resp.Operations = append(resp.Operations, newCircularReferenceBulkResponseOperation(ctx, op.operation))
+			}
+			return resp
+		}
+
+		opResp := h.processOperation(ctx, op)
 		resp.Operations = append(resp.Operations, opResp)
 
 		if opResp.Error == nil {
@@ -111,11 +186,34 @@ func (h *BulkHandler) processRequest(ctx context.Context, req *BulkRequest) (*Bu
 
 		errorBudget--
 		if errorBudget <= 0 {
-			return resp, nil
+			return resp
 		}
 	}
 
-	return resp, nil
+	return resp
+}
+
+// markBulkOperationsRolledBack updates the responses of the succeeded operations of a rolled back transactional bulk request.
+func markBulkOperationsRolledBack(ctx context.Context, resp *BulkResponse) {
+	for _, opResp := range resp.Operations {
+		if opResp.Error != nil {
+			continue
+		}
+
+		opResp.Location = ""
+		opResp.Error = serrors.MapToScimError(ctx, serrors.ThrowFailedDependency(zerrors.ThrowPreconditionFailed(nil, "SCIM-BLK31", "Operation rolled back, another operation of the transactional bulk request failed")))
+		opResp.Status = opResp.Error.Status
+	}
+}
+
+func newCircularReferenceBulkResponseOperation(ctx context.Context, op *BulkRequestOperation) *BulkResponseOperation {
+	scimErr := serrors.MapToScimError(ctx, serrors.ThrowConflict(zerrors.ThrowPreconditionFailed(nil, "SCIM-BLK30", "Circular bulkId references could not be resolved")))
+	return &BulkResponseOperation{
+		Method: op.Method,
+		BulkID: op.BulkID,
+		Error:  scimErr,
+		Status: scimErr.Status,
+	}
 }
 
 func (h *BulkHandler) processOperation(ctx context.Context, op *BulkRequestOperation) (opResp *BulkResponseOperation) {
@@ -155,6 +253,11 @@ func (h *BulkHandler) processOperation(ctx context.Context, op *BulkRequestOpera
 		return opResp
 	}
 
+	data, err := resolveBulkIDReferences(ctx, op.Data)
+	if err != nil {
+		return opResp
+	}
+
 	resourceHandler, ok := h.handlersByPluralResourceName[resourceNamePlural]
 	if !ok {
 		err = zerrors.ThrowInvalidArgumentf(nil, "SCIM-BLK13", "Unknown resource %s", resourceNamePlural)
@@ -164,13 +267,13 @@ func (h *BulkHandler) processOperation(ctx context.Context, op *BulkRequestOpera
 	switch op.Method {
 	case http.MethodPatch:
 		statusCode = http.StatusNoContent
-		err = h.processPatchOperation(ctx, resourceHandler, resourceID, op.Data)
+		err = h.processPatchOperation(ctx, resourceHandler, resourceID, data)
 	case http.MethodPut:
 		statusCode = http.StatusOK
-		err = h.processPutOperation(ctx, resourceHandler, resourceID, op)
+		err = h.processPutOperation(ctx, resourceHandler, resourceID, data)
 	case http.MethodPost:
 		statusCode = http.StatusCreated
-		resourceID, err = h.processPostOperation(ctx, resourceHandler, resourceID, op)
+		resourceID, err = h.processPostOperation(ctx, resourceHandler, resourceID, op.BulkID, data)
 	case http.MethodDelete:
 		statusCode = http.StatusNoContent
 		err = h.processDeleteOperation(ctx, resourceHandler, resourceID)
@@ -181,26 +284,24 @@ func (h *BulkHandler) processOperation(ctx context.Context, op *BulkRequestOpera
 	return opResp
 }
 
-func (h *BulkHandler) processPutOperation(ctx context.Context, resourceHandler RawResourceHandlerAdapter, resourceID string, op *BulkRequestOperation) error {
-	data := io.NopCloser(bytes.NewReader(op.Data))
-	_, err := resourceHandler.Replace(ctx, resourceID, data)
+func (h *BulkHandler) processPutOperation(ctx context.Context, resourceHandler RawResourceHandlerAdapter, resourceID string, data json.RawMessage) error {
+	_, err := resourceHandler.Replace(ctx, resourceID, io.NopCloser(bytes.NewReader(data)))
 	return err
 }
 
-func (h *BulkHandler) processPostOperation(ctx context.Context, resourceHandler RawResourceHandlerAdapter, resourceID string, op *BulkRequestOperation) (string, error) {
+func (h *BulkHandler) processPostOperation(ctx context.Context, resourceHandler RawResourceHandlerAdapter, resourceID, bulkID string, data json.RawMessage) (string, error) {
 	if resourceID != "" {
 		return "", zerrors.ThrowInvalidArgumentf(nil, "SCIM-BLK56", "Cannot post with a resourceID")
 	}
 
-	data := io.NopCloser(bytes.NewReader(op.Data))
-	createdResource, err := resourceHandler.Create(ctx, data)
+	createdResource, err := resourceHandler.Create(ctx, io.NopCloser(bytes.NewReader(data)))
 	if err != nil {
 		return "", err
 	}
 
 	id := createdResource.GetResource().ID
-	if op.BulkID != "" {
-		metadata.SetScimBulkIDMapping(ctx, op.BulkID, id)
+	if bulkID != "" {
+		metadata.SetScimBulkIDMapping(ctx, bulkID, id)
 	}
 	return id, nil
 }
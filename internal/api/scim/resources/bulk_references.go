@@ -0,0 +1,191 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// bulkIDReferenceMarker is used to detect data which could contain bulkID references
+// without parsing it, the data is compared in lowercase.
+const bulkIDReferenceMarker = "bulkid:"
+
+type pendingBulkOperation struct {
+	operation *BulkRequestOperation
+	// references are the bulkIDs referenced in the path or data of the operation (lowercase)
+	references []string
+}
+
+// pendingBulkOperations keeps track of the operations of a bulk request which are not yet processed.
+type pendingBulkOperations struct {
+	operations []*pendingBulkOperation
+	// pendingBulkIDs counts the not yet processed POST operations by their bulkID (lowercase)
+	pendingBulkIDs map[string]int
+}
+
+func newPendingBulkOperations(operations []*BulkRequestOperation) *pendingBulkOperations {
+	pending := &pendingBulkOperations{
+		operations:     make([]*pendingBulkOperation, len(operations)),
+		pendingBulkIDs: make(map[string]int),
+	}
+
+	for i, op := range operations {
+		pending.operations[i] = &pendingBulkOperation{
+			operation:  op,
+			references: bulkIDReferences(op),
+		}
+
+		if bulkID := op.bulkIDDefinition(); bulkID != "" {
+			pending.pendingBulkIDs[bulkID]++
+		}
+	}
+
+	return pending
+}
+
+func (p *pendingBulkOperations) len() int {
+	return len(p.operations)
+}
+
+// next removes and returns the first operation
+// which does not reference a bulkID defined by a pending operation.
+// Returns false if each pending operation references a pending bulkID.
+func (p *pendingBulkOperations) next() (*BulkRequestOperation, bool) {
+	for i, op := range p.operations {
+		if !p.isReady(op) {
+			continue
+		}
+
+		p.operations = append(p.operations[:i], p.operations[i+1:]...)
+		if bulkID := op.operation.bulkIDDefinition(); bulkID != "" {
+			p.pendingBulkIDs[bulkID]--
+		}
+		return op.operation, true
+	}
+
+	return nil, false
+}
+
+func (p *pendingBulkOperations) isReady(op *pendingBulkOperation) bool {
+	for _, reference := range op.references {
+		if p.pendingBulkIDs[reference] > 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bulkIDDefinition returns the bulkID (lowercase) defined by the operation,
+// only POST operations define bulkIDs.
+func (op *BulkRequestOperation) bulkIDDefinition() string {
+	if op.Method != http.MethodPost {
+		return ""
+	}
+
+	return strings.ToLower(op.BulkID)
+}
+
+// bulkIDReferences returns all bulkIDs referenced in the path and the data of the operation.
+// Invalid data is ignored, it is reported when the operation is processed.
+func bulkIDReferences(op *BulkRequestOperation) []string {
+	references := make([]string, 0)
+	if _, resourceID, ok := strings.Cut(strings.TrimPrefix(op.Path, "/"), "/"); ok {
+		if bulkID, ok := metadata.ParseScimBulkIDReference(resourceID); ok {
+			references = append(references, bulkID)
+		}
+	}
+
+	if !containsBulkIDReference(op.Data) {
+		return references
+	}
+
+	data, err := unmarshalBulkData(op.Data)
+	if err != nil {
+		return references
+	}
+
+	walkBulkDataStrings(data, func(value string) string {
+		if bulkID, ok := metadata.ParseScimBulkIDReference(value); ok {
+			references = append(references, bulkID)
+		}
+		return value
+	})
+	return references
+}
+
+// resolveBulkIDReferences replaces all bulkID references (bulkId:<bulkID>) in string values of the data
+// with the IDs of the resources created by the referenced operations.
+func resolveBulkIDReferences(ctx context.Context, data json.RawMessage) (json.RawMessage, error) {
+	if !containsBulkIDReference(data) {
+		return data, nil
+	}
+
+	parsedData, err := unmarshalBulkData(data)
+	if err != nil {
+		return nil, serrors.ThrowInvalidSyntax(zerrors.ThrowInvalidArgument(err, "SCIM-BLK22", "Could not parse bulk operation data"))
+	}
+
+	var resolveErr error
+	parsedData = walkBulkDataStrings(parsedData, func(value string) string {
+		if resolveErr != nil {
+			return value
+		}
+
+		var resolved string
+		resolved, resolveErr = metadata.ResolveScimBulkIDIfNeeded(ctx, value)
+		return resolved
+	})
+	if resolveErr != nil {
+		return nil, resolveErr
+	}
+
+	resolvedData, err := json.Marshal(parsedData)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "SCIM-BLK23", "Could not serialize bulk operation data")
+	}
+
+	return resolvedData, nil
+}
+
+func containsBulkIDReference(data json.RawMessage) bool {
+	return bytes.Contains(bytes.ToLower(data), []byte(bulkIDReferenceMarker))
+}
+
+func unmarshalBulkData(data json.RawMessage) (any, error) {
+	// use numbers to keep the precision of the values
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var parsedData any
+	if err := decoder.Decode(&parsedData); err != nil {
+		return nil, err
+	}
+
+	return parsedData, nil
+}
+
+// walkBulkDataStrings calls fn for each string value in the data (keys are not included)
+// and replaces the value with the result of fn.
+func walkBulkDataStrings(data any, fn func(string) string) any {
+	switch value := data.(type) {
+	case string:
+		return fn(value)
+	case []any:
+		for i, item := range value {
+			value[i] = walkBulkDataStrings(item, fn)
+		}
+	case map[string]any:
+		for key, item := range value {
+			value[key] = walkBulkDataStrings(item, fn)
+		}
+	}
+
+	return data
+}
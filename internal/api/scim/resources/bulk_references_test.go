@@ -0,0 +1,125 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+)
+
+func Test_pendingBulkOperations_next(t *testing.T) {
+	tests := []struct {
+		name             string
+		operations       []*BulkRequestOperation
+		wantOrder        []string
+		wantRemainingLen int
+	}{
+		{
+			name: "no references",
+			operations: []*BulkRequestOperation{
+				{Method: http.MethodPost, BulkID: "1", Path: "/Users"},
+				{Method: http.MethodPost, BulkID: "2", Path: "/Users"},
+			},
+			wantOrder: []string{"1", "2"},
+		},
+		{
+			name: "forward reference in data",
+			operations: []*BulkRequestOperation{
+				{Method: http.MethodPost, BulkID: "group", Path: "/Groups", Data: json.RawMessage(`{"members":[{"value":"bulkId:User"}]}`)},
+				{Method: http.MethodPost, BulkID: "user", Path: "/Users"},
+			},
+			wantOrder: []string{"user", "group"},
+		},
+		{
+			name: "forward reference in path",
+			operations: []*BulkRequestOperation{
+				{Method: http.MethodPatch, BulkID: "patch", Path: "/Users/bulkId:user"},
+				{Method: http.MethodPost, BulkID: "user", Path: "/Users"},
+			},
+			wantOrder: []string{"user", "patch"},
+		},
+		{
+			name: "reference to unknown bulkID",
+			operations: []*BulkRequestOperation{
+				{Method: http.MethodPatch, BulkID: "patch", Path: "/Users/bulkId:unknown"},
+			},
+			wantOrder: []string{"patch"},
+		},
+		{
+			name: "circular references",
+			operations: []*BulkRequestOperation{
+				{Method: http.MethodPost, BulkID: "user", Path: "/Users"},
+				{Method: http.MethodPost, BulkID: "a", Path: "/Groups", Data: json.RawMessage(`{"members":[{"value":"bulkId:b"}]}`)},
+				{Method: http.MethodPost, BulkID: "b", Path: "/Groups", Data: json.RawMessage(`{"members":[{"value":"bulkId:a"}]}`)},
+			},
+			wantOrder:        []string{"user"},
+			wantRemainingLen: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pending := newPendingBulkOperations(tt.operations)
+			order := make([]string, 0, len(tt.operations))
+			for {
+				op, ok := pending.next()
+				if !ok {
+					break
+				}
+				order = append(order, op.BulkID)
+			}
+
+			assert.Equal(t, tt.wantOrder, order)
+			assert.Equal(t, tt.wantRemainingLen, pending.len())
+		})
+	}
+}
+
+func Test_resolveBulkIDReferences(t *testing.T) {
+	ctx := metadata.SetScimContextData(context.Background(), metadata.NewScimContextData())
+	metadata.SetScimBulkIDMapping(ctx, "User1", "123")
+
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "no references",
+			data: `{"displayName":"foo","number":12345678901234567890}`,
+			want: `{"displayName":"foo","number":12345678901234567890}`,
+		},
+		{
+			name: "nested reference",
+			data: `{"displayName":"foo","members":[{"value":"bulkId:user1"},{"value":"456"}]}`,
+			want: `{"displayName":"foo","members":[{"value":"123"},{"value":"456"}]}`,
+		},
+		{
+			name:    "unknown reference",
+			data:    `{"members":[{"value":"bulkId:user2"}]}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"members":"bulkId:user1"`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBulkIDReferences(ctx, json.RawMessage(tt.data))
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -17,7 +17,8 @@ var jsonFieldCache sync.Map
 type structFieldCache map[string]reflect.StructField
 
 type AttributeResolver struct {
-	schema schemas.ScimSchemaType
+	schema           schemas.ScimSchemaType
+	extensionSchemas []schemas.ScimSchemaType
 }
 
 func (c structFieldCache) get(name string) (reflect.StructField, error) {
@@ -47,18 +48,19 @@ func (c structFieldCache) set(field reflect.StructField) {
 	c[strings.ToLower(fieldName)] = field
 }
 
-func newAttributeResolver(schema schemas.ScimSchemaType) *AttributeResolver {
+func newAttributeResolver(schema schemas.ScimSchemaType, extensionSchemas ...schemas.ScimSchemaType) *AttributeResolver {
 	return &AttributeResolver{
-		schema: schema,
+		schema:           schema,
+		extensionSchemas: extensionSchemas,
 	}
 }
 
 func (r *AttributeResolver) resolveAttrPath(item reflect.Value, attrPath *AttrPath) ([]string, reflect.Value, error) {
-	if err := attrPath.validateSchema(r.schema); err != nil {
+	if err := attrPath.validateSchema(r.schema, r.extensionSchemas...); err != nil {
 		return nil, reflect.Value{}, err
 	}
 
-	segments := attrPath.Segments()
+	segments := attrPath.segmentsWithExtensions(r.extensionSchemas)
 	for _, segment := range segments {
 		var err error
 		item, err = r.resolveField(item, segment)
@@ -72,9 +74,24 @@ func (r *AttributeResolver) resolveAttrPath(item reflect.Value, attrPath *AttrPa
 
 func (r *AttributeResolver) resolveField(item reflect.Value, fieldName string) (reflect.Value, error) {
 	if item.Kind() == reflect.Ptr {
+		// initialize unset optional complex attributes (e.g. extensions), to allow patching their sub attributes
+		if item.IsNil() {
+			if !item.CanSet() {
+				return reflect.Value{}, zerrors.ThrowInvalidArgumentf(nil, "SCIM-attr14", "SCIM Attribute not found %s", fieldName)
+			}
+
+			item.Set(reflect.New(item.Type().Elem()))
+		}
+
 		item = item.Elem()
 	}
 
+	// only attributes of complex attributes can be resolved
+	// (e.g. the attributes of the dynamic user metadata extension are not resolvable)
+	if item.Kind() != reflect.Struct {
+		return reflect.Value{}, zerrors.ThrowInvalidArgumentf(nil, "SCIM-attr15", "SCIM Attribute not found %s", fieldName)
+	}
+
 	fields, err := r.getOrBuildFieldMap(item.Type())
 	if err != nil {
 		return reflect.Value{}, err
@@ -14,17 +14,23 @@ type attributeResolverTestType struct {
 	Nested      struct {
 		IntValue int
 	}
+	Extension *attributeResolverTestExtensionType `json:"urn:foo:ext:Bar,omitempty"`
+}
+
+type attributeResolverTestExtensionType struct {
+	Department string `json:"department"`
 }
 
 func TestAttributeResolver_resolveAttrPath(t *testing.T) {
 	tests := []struct {
-		name         string
-		schema       schemas.ScimSchemaType
-		item         interface{}
-		attrPath     *AttrPath
-		wantSegments []string
-		wantValue    interface{}
-		wantErr      bool
+		name             string
+		schema           schemas.ScimSchemaType
+		extensionSchemas []schemas.ScimSchemaType
+		item             interface{}
+		attrPath         *AttrPath
+		wantSegments     []string
+		wantValue        interface{}
+		wantErr          bool
 	}{
 		{
 			name:   "simple",
@@ -110,10 +116,44 @@ func TestAttributeResolver_resolveAttrPath(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:             "extension attribute",
+			schema:           "fooBar",
+			extensionSchemas: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			item:             &attributeResolverTestType{Extension: &attributeResolverTestExtensionType{Department: "dep"}},
+			attrPath: &AttrPath{
+				UrnAttributePrefix: gu.Ptr("urn:foo:ext:Bar:"),
+				AttrName:           "Department",
+			},
+			wantSegments: []string{"urn:foo:ext:bar", "department"},
+			wantValue:    "dep",
+		},
+		{
+			name:             "extension itself",
+			schema:           "fooBar",
+			extensionSchemas: []schemas.ScimSchemaType{"urn:foo:ext:Bar"},
+			item:             &attributeResolverTestType{Extension: &attributeResolverTestExtensionType{Department: "dep"}},
+			attrPath: &AttrPath{
+				UrnAttributePrefix: gu.Ptr("urn:foo:ext:"),
+				AttrName:           "bar",
+			},
+			wantSegments: []string{"urn:foo:ext:bar"},
+			wantValue:    &attributeResolverTestExtensionType{Department: "dep"},
+		},
+		{
+			name:   "unknown extension",
+			schema: "fooBar",
+			item:   &attributeResolverTestType{Extension: &attributeResolverTestExtensionType{Department: "dep"}},
+			attrPath: &AttrPath{
+				UrnAttributePrefix: gu.Ptr("urn:foo:ext:Bar:"),
+				AttrName:           "Department",
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r := newAttributeResolver(tt.schema)
+			r := newAttributeResolver(tt.schema, tt.extensionSchemas...)
 
 			gotSegments, gotValue, err := r.resolveAttrPath(reflect.ValueOf(tt.item), tt.attrPath)
 			if (err != nil) != tt.wantErr {
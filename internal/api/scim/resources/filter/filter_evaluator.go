@@ -35,10 +35,12 @@ type FilteredValuesEvaluationResultMatch struct {
 
 type EvaluationResult interface{}
 
-func NewEvaluator(schema schemas.ScimSchemaType) *Evaluator {
+// NewEvaluator creates a new evaluator for the provided schema,
+// attributes of the extension schemas are resolved by the json name of the extension urn.
+func NewEvaluator(schema schemas.ScimSchemaType, extensionSchemas ...schemas.ScimSchemaType) *Evaluator {
 	return &Evaluator{
 		schema:            schema,
-		attributeResolver: newAttributeResolver(schema),
+		attributeResolver: newAttributeResolver(schema, extensionSchemas...),
 	}
 }
 
@@ -317,11 +317,15 @@ func (a *AttrPath) String() string {
 	return s
 }
 
-func (a *AttrPath) validateSchema(expectedSchema schemas.ScimSchemaType) error {
+func (a *AttrPath) validateSchema(expectedSchema schemas.ScimSchemaType, extensionSchemas ...schemas.ScimSchemaType) error {
 	if a.UrnAttributePrefix == nil || *a.UrnAttributePrefix == string(expectedSchema)+":" {
 		return nil
 	}
 
+	if _, _, ok := a.extensionSchema(extensionSchemas); ok {
+		return nil
+	}
+
 	logging.WithFields("urnPrefix", *a.UrnAttributePrefix).Info("scim filter: Invalid filter expression: unknown urn attribute prefix")
 	return serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgument(nil, "SCIM-FF431", "Invalid filter expression: unknown urn attribute prefix"))
 }
@@ -335,6 +339,43 @@ func (a *AttrPath) Segments() []string {
 	return []string{strings.ToLower(a.AttrName)}
 }
 
+// extensionSchema returns the extension schema referenced by the urn prefix of the attribute path.
+// isSchemaPath is true if the path references the extension itself
+// (e.g. urn:ietf:params:scim:schemas:extension:enterprise:2.0:User is parsed as prefix and attribute name User).
+func (a *AttrPath) extensionSchema(extensionSchemas []schemas.ScimSchemaType) (schema schemas.ScimSchemaType, isSchemaPath bool, ok bool) {
+	if a.UrnAttributePrefix == nil {
+		return "", false, false
+	}
+
+	for _, extensionSchema := range extensionSchemas {
+		if strings.EqualFold(*a.UrnAttributePrefix, string(extensionSchema)+":") {
+			return extensionSchema, false, true
+		}
+
+		if a.SubAttr == nil && strings.EqualFold(*a.UrnAttributePrefix+a.AttrName, string(extensionSchema)) {
+			return extensionSchema, true, true
+		}
+	}
+
+	return "", false, false
+}
+
+// segmentsWithExtensions returns the segments of the attribute path,
+// attributes of extension schemas are prefixed with the lowercase urn of the extension schema.
+func (a *AttrPath) segmentsWithExtensions(extensionSchemas []schemas.ScimSchemaType) []string {
+	schema, isSchemaPath, ok := a.extensionSchema(extensionSchemas)
+	if !ok {
+		return a.Segments()
+	}
+
+	schemaSegment := strings.ToLower(string(schema))
+	if isSchemaPath {
+		return []string{schemaSegment}
+	}
+
+	return append([]string{schemaSegment}, a.Segments()...)
+}
+
 func (a *AttrPath) FieldPath() string {
 	return strings.Join(a.Segments(), ".")
 }
@@ -57,6 +57,25 @@ func (m FieldPathMapping) Resolve(path string) (*QueryFieldInfo, error) {
 	return info, nil
 }
 
+// ResolveAttrPath resolves an attribute path (e.g. of the sortBy parameter),
+// the path may be prefixed with the urn of the schema.
+func (m FieldPathMapping) ResolveAttrPath(schema schemas.ScimSchemaType, path string) (*QueryFieldInfo, error) {
+	parsedPath, err := ParsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedPath == nil || parsedPath.AttrPath == nil {
+		return nil, serrors.ThrowInvalidFilter(zerrors.ThrowInvalidArgumentf(nil, "SCIM-FF434", "Invalid attribute path %s", path))
+	}
+
+	if err = parsedPath.AttrPath.validateSchema(schema); err != nil {
+		return nil, err
+	}
+
+	return m.Resolve(parsedPath.AttrPath.FieldPath())
+}
+
 func (f *Filter) BuildQuery(ctx context.Context, schema schemas.ScimSchemaType, fieldPathColumnMapping FieldPathMapping) (query.SearchQuery, error) {
 	builder := &queryBuilder{
 		ctx:              ctx,
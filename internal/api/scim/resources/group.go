@@ -0,0 +1,201 @@
+package resources
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
+	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	scim_schemas "github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+type GroupsHandler struct {
+	command         *command.Commands
+	query           *query.Queries
+	filterEvaluator *filter.Evaluator
+	schema          *scim_schemas.ResourceSchema
+}
+
+type ScimGroup struct {
+	*scim_schemas.Resource `scim:"ignoreInSchema"`
+	ID                     string             `json:"id" scim:"ignoreInSchema"`
+	ExternalID             string             `json:"externalId,omitempty"`
+	DisplayName            string             `json:"displayName,omitempty" scim:"required,unique,caseInsensitive"`
+	Members                []*ScimGroupMember `json:"members,omitempty"`
+}
+
+type ScimGroupMember struct {
+	Value   string `json:"value" scim:"required"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+	Type    string `json:"type,omitempty"`
+}
+
+const (
+	scimGroupMemberTypeUser = "User"
+)
+
+func NewGroupsHandler(
+	command *command.Commands,
+	query *query.Queries,
+) ResourceHandler[*ScimGroup] {
+	return &GroupsHandler{
+		command,
+		query,
+		filter.NewEvaluator(scim_schemas.IdGroup),
+		scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
+			ID:           scim_schemas.IdGroup,
+			Name:         scim_schemas.GroupResourceType,
+			EndpointName: scim_schemas.GroupsResourceType,
+			Description:  "Group",
+			Resource:     new(ScimGroup),
+		}),
+	}
+}
+
+func (g *ScimGroup) GetResource() *scim_schemas.Resource {
+	return g.Resource
+}
+
+func (g *ScimGroup) GetSchemas() []scim_schemas.ScimSchemaType {
+	if g.Resource == nil {
+		return nil
+	}
+
+	return g.Resource.Schemas
+}
+
+func (h *GroupsHandler) Schema() *scim_schemas.ResourceSchema {
+	return h.schema
+}
+
+func (h *GroupsHandler) NewResource() *ScimGroup {
+	return new(ScimGroup)
+}
+
+func (h *GroupsHandler) Create(ctx context.Context, group *ScimGroup) (*ScimGroup, error) {
+	addGroup, err := h.mapToAddGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := h.command.AddGroup(ctx, addGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mapDetailsToScimGroup(ctx, group, details)
+	return group, nil
+}
+
+func (h *GroupsHandler) Replace(ctx context.Context, id string, group *ScimGroup) (*ScimGroup, error) {
+	group.ID = id
+	changeGroup, err := h.mapToChangeGroup(ctx, group)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := h.command.ChangeGroup(ctx, changeGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	h.mapDetailsToScimGroup(ctx, group, details)
+	return group, nil
+}
+
+func (h *GroupsHandler) Update(ctx context.Context, id string, operations patch.OperationCollection) error {
+	orgID := authz.GetCtxData(ctx).OrgID
+	groupWM, err := h.command.GroupWriteModelByID(ctx, id, orgID)
+	if err != nil {
+		return err
+	}
+
+	group := h.mapWriteModelToScimGroup(ctx, groupWM)
+	if err := operations.Apply(&groupPatcher{handler: h}, group); err != nil {
+		return err
+	}
+
+	// we rely on the change detection of the write model to only execute commands that really change data
+	changeGroup, err := h.mapToChangeGroup(ctx, group)
+	if err != nil {
+		return err
+	}
+
+	// ensure the identity of the group is not modified
+	changeGroup.AggregateID = id
+	changeGroup.ResourceOwner = orgID
+	_, err = h.command.ChangeGroup(ctx, changeGroup)
+	return err
+}
+
+func (h *GroupsHandler) Delete(ctx context.Context, id string) error {
+	_, err := h.command.RemoveGroup(ctx, id, authz.GetCtxData(ctx).OrgID)
+	return err
+}
+
+func (h *GroupsHandler) Get(ctx context.Context, id string) (*ScimGroup, error) {
+	group, err := h.query.GetGroupByID(ctx, id, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.query.GroupMembersByGroupIDs(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return h.mapToScimGroup(ctx, group, members.Members), nil
+}
+
+func (h *GroupsHandler) List(ctx context.Context, request *ListRequest) (*ListResponse[*ScimGroup], error) {
+	q, err := h.buildListQuery(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := h.query.SearchGroups(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Count == 0 {
+		return NewListResponse(groups.SearchResponse.Count, q.SearchRequest, make([]*ScimGroup, 0)), nil
+	}
+
+	members, err := h.query.GroupMembersByGroupIDs(ctx, groupsToIDs(groups.Groups)...)
+	if err != nil {
+		return nil, err
+	}
+
+	scimGroups := h.mapToScimGroups(ctx, groups.Groups, members.Members)
+	if request.IsCursorPagination() {
+		return NewCursorListResponse(request, scimGroups, func(group *ScimGroup) string { return group.ID }), nil
+	}
+
+	return NewListResponse(groups.SearchResponse.Count, q.SearchRequest, scimGroups), nil
+}
+
+// groupPatcher applies patches directly on the scim group,
+// all attributes of the group are stored on the group aggregate,
+// therefore no additional tracking of the changed attributes is required.
+type groupPatcher struct {
+	handler *GroupsHandler
+}
+
+func (p *groupPatcher) FilterEvaluator() *filter.Evaluator {
+	return p.handler.filterEvaluator
+}
+
+func (p *groupPatcher) Added([]string) error {
+	return nil
+}
+
+func (p *groupPatcher) Replaced([]string) error {
+	return nil
+}
+
+func (p *groupPatcher) Removed([]string) error {
+	return nil
+}
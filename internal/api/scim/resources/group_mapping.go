@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func (h *GroupsHandler) mapToAddGroup(ctx context.Context, scimGroup *ScimGroup) (*command.AddGroup, error) {
+	members, err := h.mapMemberIDs(scimGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &command.AddGroup{
+		ObjectRoot: models.ObjectRoot{
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		Name:       scimGroup.DisplayName,
+		ExternalID: scimGroup.ExternalID,
+		Members:    members,
+	}, nil
+}
+
+func (h *GroupsHandler) mapToChangeGroup(ctx context.Context, scimGroup *ScimGroup) (*command.ChangeGroup, error) {
+	members, err := h.mapMemberIDs(scimGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &command.ChangeGroup{
+		ObjectRoot: models.ObjectRoot{
+			AggregateID:   scimGroup.ID,
+			ResourceOwner: authz.GetCtxData(ctx).OrgID,
+		},
+		Name:       &scimGroup.DisplayName,
+		ExternalID: &scimGroup.ExternalID,
+		Members:    &members,
+	}, nil
+}
+
+func (h *GroupsHandler) mapMemberIDs(scimGroup *ScimGroup) ([]string, error) {
+	members := make([]string, 0, len(scimGroup.Members))
+	for _, member := range scimGroup.Members {
+		if member == nil {
+			continue
+		}
+
+		// only users can be members of a group
+		if member.Type != "" && member.Type != scimGroupMemberTypeUser {
+			return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-GRM1", "Unsupported member type %s", member.Type))
+		}
+
+		if member.Value == "" {
+			return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-GRM2", "Member value is required"))
+		}
+
+		members = append(members, member.Value)
+	}
+	return members, nil
+}
+
+func (h *GroupsHandler) mapDetailsToScimGroup(ctx context.Context, group *ScimGroup, details *domain.ObjectDetails) {
+	group.ID = details.ID
+	group.Resource = buildResource(ctx, h, details)
+	for _, member := range group.Members {
+		if member == nil {
+			continue
+		}
+
+		member.Type = scimGroupMemberTypeUser
+		member.Ref = schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, member.Value)
+	}
+}
+
+func (h *GroupsHandler) mapToScimGroups(ctx context.Context, groups []*query.Group, members []*query.GroupMember) []*ScimGroup {
+	membersByGroupID := make(map[string][]*query.GroupMember, len(groups))
+	for _, member := range members {
+		membersByGroupID[member.GroupID] = append(membersByGroupID[member.GroupID], member)
+	}
+
+	scimGroups := make([]*ScimGroup, len(groups))
+	for i, group := range groups {
+		scimGroups[i] = h.mapToScimGroup(ctx, group, membersByGroupID[group.ID])
+	}
+	return scimGroups
+}
+
+func (h *GroupsHandler) mapToScimGroup(ctx context.Context, group *query.Group, members []*query.GroupMember) *ScimGroup {
+	scimMembers := make([]*ScimGroupMember, len(members))
+	for i, member := range members {
+		scimMembers[i] = &ScimGroupMember{
+			Value:   member.UserID,
+			Display: member.Username,
+			Ref:     schemas.BuildLocationForResource(ctx, schemas.UsersResourceType, member.UserID),
+			Type:    scimGroupMemberTypeUser,
+		}
+	}
+
+	return &ScimGroup{
+		Resource:    h.buildResourceForQuery(ctx, group),
+		ID:          group.ID,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.Name,
+		Members:     scimMembers,
+	}
+}
+
+func (h *GroupsHandler) mapWriteModelToScimGroup(ctx context.Context, group *command.GroupWriteModel) *ScimGroup {
+	members := make([]*ScimGroupMember, len(group.Members))
+	for i, userID := range group.Members {
+		members[i] = &ScimGroupMember{
+			Value: userID,
+			Type:  scimGroupMemberTypeUser,
+		}
+	}
+
+	return &ScimGroup{
+		Resource: &schemas.Resource{
+			Schemas: []schemas.ScimSchemaType{schemas.IdGroup},
+			Meta: &schemas.ResourceMeta{
+				ResourceType: schemas.GroupResourceType,
+				LastModified: gu.Ptr(group.ChangeDate.UTC()),
+				Version:      strconv.FormatUint(group.ProcessedSequence, 10),
+				Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, group.AggregateID),
+			},
+		},
+		ID:          group.AggregateID,
+		ExternalID:  group.ExternalID,
+		DisplayName: group.Name,
+		Members:     members,
+	}
+}
+
+func (h *GroupsHandler) buildResourceForQuery(ctx context.Context, group *query.Group) *schemas.Resource {
+	return &schemas.Resource{
+		ID:      group.ID,
+		Schemas: []schemas.ScimSchemaType{schemas.IdGroup},
+		Meta: &schemas.ResourceMeta{
+			ResourceType: schemas.GroupResourceType,
+			Created:      gu.Ptr(group.CreationDate.UTC()),
+			LastModified: gu.Ptr(group.EventDate.UTC()),
+			Version:      strconv.FormatUint(group.Sequence, 10),
+			Location:     schemas.BuildLocationForResource(ctx, h.schema.PluralName, group.ID),
+		},
+	}
+}
+
+func groupsToIDs(groups []*query.Group) []string {
+	ids := make([]string, len(groups))
+	for i, group := range groups {
+		ids[i] = group.ID
+	}
+	return ids
+}
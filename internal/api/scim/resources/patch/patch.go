@@ -145,13 +145,26 @@ func flattenPatchOperations(op *Operation) ([]*Operation, error) {
 
 	result := make([]*Operation, 0, len(patches))
 	for path, value := range patches {
-		result = append(result, &Operation{
-			Operation: op.Operation,
-			Path: &filter.Path{
-				AttrPath: &filter.AttrPath{
-					AttrName: path,
-				},
+		attrPath := &filter.Path{
+			AttrPath: &filter.AttrPath{
+				AttrName: path,
 			},
+		}
+
+		// keys of extension attributes are prefixed with the urn of the extension schema
+		// (e.g. urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department)
+		if strings.HasPrefix(strings.ToLower(path), "urn:") {
+			parsedPath, err := filter.ParsePath(path)
+			if err != nil {
+				return nil, err
+			}
+
+			attrPath = parsedPath
+		}
+
+		result = append(result, &Operation{
+			Operation:    op.Operation,
+			Path:         attrPath,
 			Value:        value,
 			valueIsArray: strings.HasPrefix(string(value), "["),
 		})
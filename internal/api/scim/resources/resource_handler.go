@@ -23,6 +23,12 @@ type ResourceHandler[T ResourceHolder] interface {
 	List(ctx context.Context, request *ListRequest) (*ListResponse[T], error)
 }
 
+// OrgSchemaExtensionsProvider is implemented by resource handlers
+// supporting schema extensions which are configured per organization.
+type OrgSchemaExtensionsProvider interface {
+	OrgSchemaExtensions(ctx context.Context, orgID string) ([]*schemas.ResourceSchema, error)
+}
+
 type ResourceHolder interface {
 	SchemasHolder
 	GetResource() *schemas.Resource
@@ -20,6 +20,7 @@ import (
 // RawResourceHandlerAdapter adapts the ResourceHandler[T] without any generics
 type RawResourceHandlerAdapter interface {
 	Schema() *schemas.ResourceSchema
+	OrgSchemaExtensions(ctx context.Context, orgID string) ([]*schemas.ResourceSchema, error)
 
 	Create(ctx context.Context, data io.ReadCloser) (ResourceHolder, error)
 	Replace(ctx context.Context, resourceID string, data io.ReadCloser) (ResourceHolder, error)
@@ -41,6 +42,17 @@ func (adapter *ResourceHandlerAdapter[T]) Schema() *schemas.ResourceSchema {
 	return adapter.handler.Schema()
 }
 
+// OrgSchemaExtensions returns the schema extensions configured for the organization,
+// if the handler does not support organization specific extensions, no extensions are returned.
+func (adapter *ResourceHandlerAdapter[T]) OrgSchemaExtensions(ctx context.Context, orgID string) ([]*schemas.ResourceSchema, error) {
+	provider, ok := adapter.handler.(OrgSchemaExtensionsProvider)
+	if !ok {
+		return nil, nil
+	}
+
+	return provider.OrgSchemaExtensions(ctx, orgID)
+}
+
 func (adapter *ResourceHandlerAdapter[T]) CreateFromHttp(r *http.Request) (ResourceHolder, error) {
 	return adapter.Create(r.Context(), r.Body)
 }
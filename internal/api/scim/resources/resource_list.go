@@ -1,8 +1,13 @@
 package resources
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
 
+	"github.com/muhlemmer/gu"
+	"github.com/zitadel/logging"
+
 	zhttp "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
 	"github.com/zitadel/zitadel/internal/api/scim/schemas"
@@ -26,23 +31,36 @@ type ListRequest struct {
 	// SortBy attribute path to the sort attribute
 	SortBy    string               `json:"sortBy" schema:"sortBy"`
 	SortOrder ListRequestSortOrder `json:"sortOrder" schema:"sortOrder"`
+
+	// Cursor opts in to cursor-based pagination (RFC 9865).
+	// An empty cursor requests the first page,
+	// the following pages are requested with the nextCursor of the previous response.
+	Cursor *string `json:"cursor,omitempty" schema:"cursor"`
 }
 
 type ListResponse[T any] struct {
 	Schemas      []schemas.ScimSchemaType `json:"schemas"`
 	ItemsPerPage uint64                   `json:"itemsPerPage"`
-	TotalResults uint64                   `json:"totalResults"`
-	StartIndex   uint64                   `json:"startIndex"`
+	TotalResults *uint64                  `json:"totalResults,omitempty"`
+	StartIndex   *uint64                  `json:"startIndex,omitempty"`
+	NextCursor   string                   `json:"nextCursor,omitempty"`
 	Resources    []T                      `json:"Resources"` // according to the rfc this is the only field in PascalCase...
 }
 
+// listCursor is the content of the opaque cursor used for cursor-based pagination.
+// The pages are ordered by the id of the resources,
+// a page contains the resources following the last id of the previous page.
+type listCursor struct {
+	LastID string `json:"lastId"`
+}
+
 type ListRequestSortOrder string
 
 const (
 	ListRequestSortOrderAsc ListRequestSortOrder = "ascending"
 	ListRequestSortOrderDsc ListRequestSortOrder = "descending"
 
-	defaultListCount = 100
+	DefaultListCount = 100
 	MaxListCount     = 100
 )
 
@@ -65,19 +83,45 @@ func (o ListRequestSortOrder) IsAscending() bool {
 	return o == ListRequestSortOrderAsc
 }
 
+func (r *ListRequest) IsCursorPagination() bool {
+	return r.Cursor != nil
+}
+
 func NewListResponse[T any](totalResultCount uint64, q query.SearchRequest, resources []T) *ListResponse[T] {
 	return &ListResponse[T]{
 		Schemas:      []schemas.ScimSchemaType{schemas.IdListResponse},
 		ItemsPerPage: q.Limit,
-		TotalResults: totalResultCount,
-		StartIndex:   q.Offset + 1, // start index is 1 based
+		TotalResults: &totalResultCount,
+		StartIndex:   gu.Ptr(q.Offset + 1), // start index is 1 based
+		Resources:    resources,
+	}
+}
+
+// NewCursorListResponse creates the response of a cursor-based list request.
+// The resources are expected to be queried with a limit of one more than the requested count,
+// the additional resource is only used to detect whether there is a next page.
+// The total count of the results is not provided, as counting all resources is too expensive for large lists.
+func NewCursorListResponse[T any](r *ListRequest, resources []T, resourceID func(T) string) *ListResponse[T] {
+	response := &ListResponse[T]{
+		Schemas:      []schemas.ScimSchemaType{schemas.IdListResponse},
+		ItemsPerPage: uint64(r.Count),
 		Resources:    resources,
 	}
+
+	if int64(len(resources)) <= r.Count {
+		return response
+	}
+
+	response.Resources = resources[:r.Count]
+	response.NextCursor = encodeListCursor(&listCursor{
+		LastID: resourceID(response.Resources[len(response.Resources)-1]),
+	})
+	return response
 }
 
 func (adapter *ResourceHandlerAdapter[T]) readListRequest(r *http.Request) (*ListRequest, error) {
 	request := &ListRequest{
-		Count:      defaultListCount,
+		Count:      DefaultListCount,
 		StartIndex: 1,
 		SortOrder:  ListRequestSortOrderAsc,
 	}
@@ -93,6 +137,12 @@ func (adapter *ResourceHandlerAdapter[T]) readListRequest(r *http.Request) (*Lis
 
 			return nil, zerrors.ThrowInvalidArgument(nil, "SCIM-ullform", "Could not decode form: "+err.Error())
 		}
+
+		// the form parser ignores empty values,
+		// but an empty cursor is used to request the first page.
+		if request.Cursor == nil && r.Form.Has("cursor") {
+			request.Cursor = gu.Ptr("")
+		}
 	case http.MethodPost:
 		if err := readSchema(r.Body, request, schemas.IdSearchRequest); err != nil {
 			return nil, err
@@ -109,25 +159,83 @@ func (adapter *ResourceHandlerAdapter[T]) readListRequest(r *http.Request) (*Lis
 	return request, request.validate()
 }
 
-func (r *ListRequest) toSearchRequest(defaultSortCol query.Column, fieldPathColumnMapping filter.FieldPathMapping) (query.SearchRequest, error) {
+// toSearchRequest maps the paging and sorting parameters to a search request.
+// The idCol has to be unique per resource,
+// it is used as the default sort column, as tie-breaker to ensure a stable order and for cursor-based pagination.
+func (r *ListRequest) toSearchRequest(schema schemas.ScimSchemaType, idCol query.Column, fieldPathColumnMapping filter.FieldPathMapping) (query.SearchRequest, error) {
 	sr := query.SearchRequest{
-		Offset: uint64(r.StartIndex - 1), // start index is 1 based
-		Limit:  uint64(r.Count),
-		Asc:    r.SortOrder.IsAscending(),
+		Offset:           uint64(r.StartIndex - 1), // start index is 1 based
+		Limit:            uint64(r.Count),
+		Asc:              r.SortOrder.IsAscending(),
+		SortingColumn:    idCol,
+		TieBreakerColumn: idCol,
 	}
 
-	if r.SortBy == "" {
-		// set a default sort to ensure consistent results
-		sr.SortingColumn = defaultSortCol
-	} else if sortCol, err := fieldPathColumnMapping.Resolve(r.SortBy); err != nil || sortCol.FieldType == filter.FieldTypeCustom {
-		return sr, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(err, "SCIM-SRT1", "SortBy field is unknown or not supported"))
-	} else {
+	if r.SortBy != "" {
+		sortCol, err := fieldPathColumnMapping.ResolveAttrPath(schema, r.SortBy)
+		if err != nil || sortCol.FieldType == filter.FieldTypeCustom {
+			return sr, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(err, "SCIM-SRT1", "SortBy field is unknown or not supported"))
+		}
+
 		sr.SortingColumn = sortCol.Column
 	}
 
+	if !r.IsCursorPagination() {
+		return sr, nil
+	}
+
+	// the cursor is based on the id of the last resource of the page,
+	// therefore only sorting by id is supported.
+	if sr.SortingColumn != idCol {
+		return sr, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgument(nil, "SCIM-SRT2", "Cursor-based pagination only supports sorting by id"))
+	}
+
+	// query one additional resource to detect whether there is a next page.
+	sr.Offset = 0
+	sr.Limit = uint64(r.Count) + 1
 	return sr, nil
 }
 
+// cursorQuery returns the query to select the resources following the cursor.
+// Returns nil if the first page is requested.
+func (r *ListRequest) cursorQuery(idCol query.Column) (query.SearchQuery, error) {
+	if r.Cursor == nil || *r.Cursor == "" {
+		return nil, nil
+	}
+
+	cursor, err := decodeListCursor(*r.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := query.TextGreater
+	if !r.SortOrder.IsAscending() {
+		comparison = query.TextLess
+	}
+
+	return query.NewTextQuery(idCol, cursor.LastID, comparison)
+}
+
+func encodeListCursor(cursor *listCursor) string {
+	data, err := json.Marshal(cursor)
+	logging.OnError(err).Error("scim: could not serialize list cursor")
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeListCursor(value string) (*listCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, serrors.ThrowInvalidCursor(zerrors.ThrowInvalidArgument(err, "SCIM-CRS1", "Invalid cursor"))
+	}
+
+	cursor := new(listCursor)
+	if err = json.Unmarshal(data, cursor); err != nil || cursor.LastID == "" {
+		return nil, serrors.ThrowInvalidCursor(zerrors.ThrowInvalidArgument(err, "SCIM-CRS2", "Invalid cursor"))
+	}
+
+	return cursor, nil
+}
+
 func (r *ListRequest) validate() error {
 	// according to the spec values < 1 are treated as 1
 	if r.StartIndex < 1 {
@@ -145,5 +253,10 @@ func (r *ListRequest) validate() error {
 		return zerrors.ThrowInvalidArgument(nil, "SCIM-ucx", "Invalid sort order")
 	}
 
+	// cursor-based and index-based pagination are mutually exclusive
+	if r.IsCursorPagination() && r.StartIndex > 1 {
+		return zerrors.ThrowInvalidArgument(nil, "SCIM-ucc", "StartIndex is not supported with cursor-based pagination")
+	}
+
 	return nil
 }
@@ -4,7 +4,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/test"
 )
 
 func TestListRequest_validate(t *testing.T) {
@@ -48,6 +54,16 @@ func TestListRequest_validate(t *testing.T) {
 				SortOrder:  ListRequestSortOrderAsc,
 			},
 		},
+		{
+			name: "cursor with start index",
+			req: &ListRequest{
+				StartIndex: 10,
+				Count:      10,
+				SortOrder:  ListRequestSortOrderAsc,
+				Cursor:     gu.Ptr(""),
+			},
+			wantErr: true,
+		},
 		{
 			name: "negative count",
 			req: &ListRequest{
@@ -77,3 +93,224 @@ func TestListRequest_validate(t *testing.T) {
 		})
 	}
 }
+
+func TestListRequest_toSearchRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *ListRequest
+		want    query.SearchRequest
+		wantErr bool
+	}{
+		{
+			name: "default sort",
+			req: &ListRequest{
+				StartIndex: 5,
+				Count:      10,
+				SortOrder:  ListRequestSortOrderAsc,
+			},
+			want: query.SearchRequest{
+				Offset:           4,
+				Limit:            10,
+				Asc:              true,
+				SortingColumn:    query.UserIDCol,
+				TieBreakerColumn: query.UserIDCol,
+			},
+		},
+		{
+			name: "sort by",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "name.familyName",
+				SortOrder:  ListRequestSortOrderDsc,
+			},
+			want: query.SearchRequest{
+				Limit:            10,
+				SortingColumn:    query.HumanLastNameCol,
+				TieBreakerColumn: query.UserIDCol,
+			},
+		},
+		{
+			name: "sort by with urn",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "urn:ietf:params:scim:schemas:core:2.0:User:meta.lastModified",
+				SortOrder:  ListRequestSortOrderAsc,
+			},
+			want: query.SearchRequest{
+				Limit:            10,
+				Asc:              true,
+				SortingColumn:    query.UserChangeDateCol,
+				TieBreakerColumn: query.UserIDCol,
+			},
+		},
+		{
+			name: "sort by unknown urn",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "urn:ietf:params:scim:schemas:core:2.0:Group:displayName",
+				SortOrder:  ListRequestSortOrderAsc,
+			},
+			wantErr: true,
+		},
+		{
+			name: "sort by unknown field",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "nickName",
+				SortOrder:  ListRequestSortOrderAsc,
+			},
+			wantErr: true,
+		},
+		{
+			name: "sort by custom field",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "active",
+				SortOrder:  ListRequestSortOrderAsc,
+			},
+			wantErr: true,
+		},
+		{
+			name: "cursor",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortOrder:  ListRequestSortOrderAsc,
+				Cursor:     gu.Ptr(""),
+			},
+			want: query.SearchRequest{
+				Limit:            11,
+				Asc:              true,
+				SortingColumn:    query.UserIDCol,
+				TieBreakerColumn: query.UserIDCol,
+			},
+		},
+		{
+			name: "cursor sort by id",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "id",
+				SortOrder:  ListRequestSortOrderDsc,
+				Cursor:     gu.Ptr(""),
+			},
+			want: query.SearchRequest{
+				Limit:            11,
+				SortingColumn:    query.UserIDCol,
+				TieBreakerColumn: query.UserIDCol,
+			},
+		},
+		{
+			name: "cursor sort by other field",
+			req: &ListRequest{
+				StartIndex: 1,
+				Count:      10,
+				SortBy:     "userName",
+				SortOrder:  ListRequestSortOrderAsc,
+				Cursor:     gu.Ptr(""),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.toSearchRequest(schemas.IdUser, query.UserIDCol, fieldPathColumnMapping)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListRequest_cursorQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *ListRequest
+		want    query.SearchQuery
+		wantErr bool
+	}{
+		{
+			name: "no cursor",
+			req:  &ListRequest{SortOrder: ListRequestSortOrderAsc},
+		},
+		{
+			name: "first page",
+			req:  &ListRequest{SortOrder: ListRequestSortOrderAsc, Cursor: gu.Ptr("")},
+		},
+		{
+			name: "asc",
+			req: &ListRequest{
+				SortOrder: ListRequestSortOrderAsc,
+				Cursor:    gu.Ptr(encodeListCursor(&listCursor{LastID: "123"})),
+			},
+			want: test.Must(query.NewTextQuery(query.UserIDCol, "123", query.TextGreater)),
+		},
+		{
+			name: "desc",
+			req: &ListRequest{
+				SortOrder: ListRequestSortOrderDsc,
+				Cursor:    gu.Ptr(encodeListCursor(&listCursor{LastID: "123"})),
+			},
+			want: test.Must(query.NewTextQuery(query.UserIDCol, "123", query.TextLess)),
+		},
+		{
+			name: "invalid encoding",
+			req: &ListRequest{
+				SortOrder: ListRequestSortOrderAsc,
+				Cursor:    gu.Ptr("not base64!"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid content",
+			req: &ListRequest{
+				SortOrder: ListRequestSortOrderAsc,
+				Cursor:    gu.Ptr("e30"), // {}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.cursorQuery(query.UserIDCol)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewCursorListResponse(t *testing.T) {
+	resourceID := func(id string) string { return id }
+
+	t.Run("last page", func(t *testing.T) {
+		resp := NewCursorListResponse(&ListRequest{Count: 2}, []string{"1", "2"}, resourceID)
+		assert.Equal(t, []string{"1", "2"}, resp.Resources)
+		assert.Empty(t, resp.NextCursor)
+		assert.Nil(t, resp.TotalResults)
+		assert.Nil(t, resp.StartIndex)
+	})
+
+	t.Run("next page", func(t *testing.T) {
+		resp := NewCursorListResponse(&ListRequest{Count: 2}, []string{"1", "2", "3"}, resourceID)
+		assert.Equal(t, []string{"1", "2"}, resp.Resources)
+		assert.Equal(t, uint64(2), resp.ItemsPerPage)
+
+		cursor, err := decodeListCursor(resp.NextCursor)
+		require.NoError(t, err)
+		assert.Equal(t, "2", cursor.LastID)
+	})
+}
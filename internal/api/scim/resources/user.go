@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 
 	"golang.org/x/text/language"
 
@@ -48,6 +49,42 @@ type ScimUser struct {
 	Photos                 []*ScimPhoto                  `json:"photos,omitempty"`
 	Entitlements           []*ScimEntitlement            `json:"entitlements,omitempty"`
 	Roles                  []*ScimRole                   `json:"roles,omitempty"`
+
+	// EnterpriseUser the attributes of the enterprise user schema extension,
+	// see RFC7643 section 4.3.
+	EnterpriseUser *ScimEnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty" scim:"ignoreInSchema"`
+
+	// Metadata the attributes of the ZITADEL user metadata schema extension.
+	// The attributes are configured per organization, see metadata.KeyCustomAttributes.
+	Metadata map[string]json.RawMessage `json:"urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata,omitempty" scim:"ignoreInSchema"`
+}
+
+type ScimEnterpriseUser struct {
+	EmployeeNumber string                     `json:"employeeNumber,omitempty"`
+	CostCenter     string                     `json:"costCenter,omitempty"`
+	Organization   string                     `json:"organization,omitempty"`
+	Division       string                     `json:"division,omitempty"`
+	Department     string                     `json:"department,omitempty"`
+	Manager        *ScimEnterpriseUserManager `json:"manager,omitempty"`
+}
+
+type ScimEnterpriseUserManager struct {
+	Value       string `json:"value,omitempty"`
+	Ref         string `json:"$ref,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+func (u *ScimEnterpriseUser) isEmpty() bool {
+	if u == nil {
+		return true
+	}
+
+	return u.EmployeeNumber == "" &&
+		u.CostCenter == "" &&
+		u.Organization == "" &&
+		u.Division == "" &&
+		u.Department == "" &&
+		(u.Manager == nil || *u.Manager == ScimEnterpriseUserManager{})
 }
 
 type ScimEntitlement struct {
@@ -117,13 +154,21 @@ func NewUsersHandler(
 		query,
 		userCodeAlg,
 		config,
-		filter.NewEvaluator(scim_schemas.IdUser),
+		filter.NewEvaluator(scim_schemas.IdUser, scim_schemas.IdEnterpriseUser, scim_schemas.IdZitadelUserMetadata),
 		scim_schemas.BuildSchema(scim_schemas.SchemaBuilderArgs{
 			ID:           scim_schemas.IdUser,
 			Name:         scim_schemas.UserResourceType,
 			EndpointName: scim_schemas.UsersResourceType,
 			Description:  "User Account",
 			Resource:     new(ScimUser),
+			Extensions: []scim_schemas.SchemaBuilderArgs{
+				{
+					ID:          scim_schemas.IdEnterpriseUser,
+					Name:        scim_schemas.EnterpriseUserSchemaName,
+					Description: "Enterprise User",
+					Resource:    new(ScimEnterpriseUser),
+				},
+			},
 		}),
 	}
 }
@@ -148,9 +193,23 @@ func (h *UsersHandler) NewResource() *ScimUser {
 	return new(ScimUser)
 }
 
+func (h *UsersHandler) OrgSchemaExtensions(ctx context.Context, orgID string) ([]*scim_schemas.ResourceSchema, error) {
+	customAttributes, err := h.queryCustomAttributes(ctx, orgID)
+	if err != nil || len(customAttributes) == 0 {
+		return nil, err
+	}
+
+	return []*scim_schemas.ResourceSchema{scim_schemas.BuildCustomAttributesSchema(customAttributes)}, nil
+}
+
 func (h *UsersHandler) Create(ctx context.Context, user *ScimUser) (*ScimUser, error) {
 	orgID := authz.GetCtxData(ctx).OrgID
-	addHuman, err := h.mapToAddHuman(ctx, user)
+	customAttributes, err := h.queryCustomAttributes(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	addHuman, err := h.mapToAddHuman(ctx, user, customAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -166,7 +225,12 @@ func (h *UsersHandler) Create(ctx context.Context, user *ScimUser) (*ScimUser, e
 
 func (h *UsersHandler) Replace(ctx context.Context, id string, user *ScimUser) (*ScimUser, error) {
 	user.ID = id
-	changeHuman, err := h.mapToChangeHuman(ctx, user)
+	customAttributes, err := h.queryCustomAttributes(ctx, authz.GetCtxData(ctx).OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	changeHuman, err := h.mapToChangeHuman(ctx, user, customAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -187,8 +251,13 @@ func (h *UsersHandler) Update(ctx context.Context, id string, operations patch.O
 		return err
 	}
 
-	user := h.mapWriteModelToScimUser(ctx, userWM)
-	changeHuman, err := h.applyPatchesToChangeHuman(ctx, user, operations)
+	customAttributes, err := h.queryCustomAttributes(ctx, orgID)
+	if err != nil {
+		return err
+	}
+
+	user := h.mapWriteModelToScimUser(ctx, userWM, customAttributes)
+	changeHuman, err := h.applyPatchesToChangeHuman(ctx, user, customAttributes, operations)
 	if err != nil {
 		return err
 	}
@@ -209,7 +278,8 @@ func (h *UsersHandler) Delete(ctx context.Context, id string) error {
 }
 
 func (h *UsersHandler) Get(ctx context.Context, id string) (*ScimUser, error) {
-	user, err := h.query.GetUserByIDWithResourceOwner(ctx, false, id, authz.GetCtxData(ctx).OrgID)
+	orgID := authz.GetCtxData(ctx).OrgID
+	user, err := h.query.GetUserByIDWithResourceOwner(ctx, false, id, orgID)
 	if err != nil {
 		return nil, err
 	}
@@ -218,11 +288,16 @@ func (h *UsersHandler) Get(ctx context.Context, id string) (*ScimUser, error) {
 		return nil, zerrors.ThrowNotFound(nil, "SCIM-USRT1", "Errors.Users.NotFound")
 	}
 
-	metadata, err := h.queryMetadataForUser(ctx, id)
+	customAttributes, err := h.queryCustomAttributes(ctx, orgID)
 	if err != nil {
 		return nil, err
 	}
-	return h.mapToScimUser(ctx, user, metadata), nil
+
+	metadata, err := h.queryMetadataForUser(ctx, id, customAttributes)
+	if err != nil {
+		return nil, err
+	}
+	return h.mapToScimUser(ctx, user, metadata, customAttributes), nil
 }
 
 func (h *UsersHandler) List(ctx context.Context, request *ListRequest) (*ListResponse[*ScimUser], error) {
@@ -245,12 +320,21 @@ func (h *UsersHandler) List(ctx context.Context, request *ListRequest) (*ListRes
 		return nil, err
 	}
 
-	metadata, err := h.queryMetadataForUsers(ctx, usersToIDs(users.Users))
+	customAttributes, err := h.queryCustomAttributes(ctx, authz.GetCtxData(ctx).OrgID)
 	if err != nil {
 		return nil, err
 	}
 
-	scimUsers := h.mapToScimUsers(ctx, users.Users, metadata)
+	metadata, err := h.queryMetadataForUsers(ctx, usersToIDs(users.Users), customAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	scimUsers := h.mapToScimUsers(ctx, users.Users, metadata, customAttributes)
+	if request.IsCursorPagination() {
+		return NewCursorListResponse(request, scimUsers, func(user *ScimUser) string { return user.ID }), nil
+	}
+
 	return NewListResponse(users.SearchResponse.Count, q.SearchRequest, scimUsers), nil
 }
 
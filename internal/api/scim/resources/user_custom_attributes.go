@@ -0,0 +1,166 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/scim/metadata"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
+	"github.com/zitadel/zitadel/internal/api/scim/serrors"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// queryCustomAttributes queries the custom attribute definitions of the user metadata schema extension of the organization.
+// Returns no attributes if the organization has none configured.
+func (h *UsersHandler) queryCustomAttributes(ctx context.Context, orgID string) ([]*schemas.CustomAttribute, error) {
+	md, err := h.query.GetOrgMetadataByKey(ctx, false, orgID, string(metadata.KeyCustomAttributes), false)
+	if err != nil {
+		if zerrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return schemas.ParseCustomAttributes(md.Value)
+}
+
+// mapCustomAttributesToMetadata maps the attributes of the user metadata extension to user metadata.
+// Returns the metadata to set and the keys of the defined attributes without a value.
+func mapCustomAttributesToMetadata(user *ScimUser, customAttributes []*schemas.CustomAttribute) (md []*domain.Metadata, removedKeys []string, err error) {
+	values, err := customAttributeValuesByDefinition(user, customAttributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	md = make([]*domain.Metadata, 0, len(values))
+	for _, attribute := range customAttributes {
+		value, ok := values[attribute]
+		if !ok {
+			if attribute.Required {
+				return nil, nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-UCA2", "Custom attribute %s is required", attribute.Name))
+			}
+
+			removedKeys = append(removedKeys, attribute.Name)
+			continue
+		}
+
+		if err = attribute.ValidateValue(value); err != nil {
+			return nil, nil, serrors.ThrowInvalidValue(err)
+		}
+
+		md = append(md, &domain.Metadata{
+			Key:   attribute.Name,
+			Value: customAttributeMetadataValue(attribute, value),
+		})
+	}
+
+	return md, removedKeys, nil
+}
+
+// customAttributeValuesByDefinition matches the provided values case-insensitive to the attribute definitions,
+// null values are treated as no value.
+func customAttributeValuesByDefinition(user *ScimUser, customAttributes []*schemas.CustomAttribute) (map[*schemas.CustomAttribute]json.RawMessage, error) {
+	values := make(map[*schemas.CustomAttribute]json.RawMessage, len(user.Metadata))
+	for name, value := range user.Metadata {
+		attribute := findCustomAttribute(customAttributes, name)
+		if attribute == nil {
+			return nil, serrors.ThrowInvalidValue(zerrors.ThrowInvalidArgumentf(nil, "SCIM-UCA1", "Unknown custom attribute %s", name))
+		}
+
+		if len(value) == 0 || string(bytes.TrimSpace(value)) == "null" {
+			continue
+		}
+
+		values[attribute] = value
+	}
+
+	return values, nil
+}
+
+func findCustomAttribute(customAttributes []*schemas.CustomAttribute, name string) *schemas.CustomAttribute {
+	for _, attribute := range customAttributes {
+		if strings.EqualFold(attribute.Name, name) {
+			return attribute
+		}
+	}
+
+	return nil
+}
+
+// customAttributeMetadataValue single valued strings are stored as raw value,
+// all other values are stored as json.
+func customAttributeMetadataValue(attribute *schemas.CustomAttribute, value json.RawMessage) []byte {
+	if attribute.Type == schemas.SchemaAttributeTypeString && !attribute.MultiValued {
+		var str string
+		if err := json.Unmarshal(value, &str); err == nil {
+			return []byte(str)
+		}
+	}
+
+	compacted := new(bytes.Buffer)
+	if err := json.Compact(compacted, value); err != nil {
+		return value
+	}
+
+	return compacted.Bytes()
+}
+
+func mapCustomAttributesFromMetadata(md map[metadata.ScopedKey][]byte, customAttributes []*schemas.CustomAttribute) map[string]json.RawMessage {
+	values := make(map[string]json.RawMessage, len(customAttributes))
+	for _, attribute := range customAttributes {
+		value, ok := md[metadata.ScopedKey(attribute.Name)]
+		if !ok {
+			continue
+		}
+
+		if attribute.Type == schemas.SchemaAttributeTypeString && !attribute.MultiValued {
+			jsonValue, err := json.Marshal(string(value))
+			logging.OnError(err).Warn("Could not serialize scim custom attribute metadata")
+			values[attribute.Name] = jsonValue
+			continue
+		}
+
+		if !json.Valid(value) {
+			logging.WithFields("attribute", attribute.Name).Warn("Could not deserialize scim custom attribute metadata")
+			continue
+		}
+
+		values[attribute.Name] = value
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+
+	return values
+}
+
+func customAttributeKeys(customAttributes []*schemas.CustomAttribute) []metadata.Key {
+	keys := make([]metadata.Key, len(customAttributes))
+	for i, attribute := range customAttributes {
+		keys[i] = metadata.Key(attribute.Name)
+	}
+
+	return keys
+}
+
+// appendExtensionSchemas adds the schemas of the extensions set on the user to the schemas of the resource.
+func appendExtensionSchemas(user *ScimUser) {
+	if user.Resource == nil {
+		return
+	}
+
+	if !user.EnterpriseUser.isEmpty() {
+		user.Resource.Schemas = append(user.Resource.Schemas, schemas.IdEnterpriseUser)
+	}
+
+	if len(user.Metadata) > 0 {
+		user.Resource.Schemas = append(user.Resource.Schemas, schemas.IdZitadelUserMetadata)
+	}
+}
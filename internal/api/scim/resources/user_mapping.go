@@ -18,7 +18,7 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (h *UsersHandler) mapToAddHuman(ctx context.Context, scimUser *ScimUser) (*command.AddHuman, error) {
+func (h *UsersHandler) mapToAddHuman(ctx context.Context, scimUser *ScimUser, customAttributes []*schemas.CustomAttribute) (*command.AddHuman, error) {
 	human := &command.AddHuman{
 		Username:    scimUser.UserName,
 		NickName:    scimUser.NickName,
@@ -45,6 +45,17 @@ func (h *UsersHandler) mapToAddHuman(ctx context.Context, scimUser *ScimUser) (*
 	}
 	human.Metadata = md
 
+	customMd, _, err := mapCustomAttributesToMetadata(scimUser, customAttributes)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range customMd {
+		human.Metadata = append(human.Metadata, &command.AddMetadataEntry{
+			Key:   entry.Key,
+			Value: entry.Value,
+		})
+	}
+
 	// Okta sends a random password during SCIM provisioning
 	// irrespective of whether the Sync Password option is enabled or disabled on Okta.
 	// This password does not comply with Zitadel's password complexity, and
@@ -77,7 +88,7 @@ func (h *UsersHandler) mapToAddHuman(ctx context.Context, scimUser *ScimUser) (*
 	return human, nil
 }
 
-func (h *UsersHandler) mapToChangeHuman(ctx context.Context, scimUser *ScimUser) (*command.ChangeHuman, error) {
+func (h *UsersHandler) mapToChangeHuman(ctx context.Context, scimUser *ScimUser, customAttributes []*schemas.CustomAttribute) (*command.ChangeHuman, error) {
 	human := &command.ChangeHuman{
 		ID:            scimUser.ID,
 		ResourceOwner: authz.GetCtxData(ctx).OrgID,
@@ -114,6 +125,13 @@ func (h *UsersHandler) mapToChangeHuman(ctx context.Context, scimUser *ScimUser)
 	human.Metadata = md
 	human.MetadataKeysToRemove = mdRemovedKeys
 
+	customMd, customMdRemovedKeys, err := mapCustomAttributesToMetadata(scimUser, customAttributes)
+	if err != nil {
+		return nil, err
+	}
+	human.Metadata = append(human.Metadata, customMd...)
+	human.MetadataKeysToRemove = append(human.MetadataKeysToRemove, customMdRemovedKeys...)
+
 	if scimUser.Password != nil {
 		human.Password = &command.Password{
 			Password: scimUser.Password.String(),
@@ -201,6 +219,7 @@ func (h *UsersHandler) mapAddCommandToScimUser(ctx context.Context, user *ScimUs
 	user.ID = addHuman.Details.ID
 	user.Resource = buildResource(ctx, h, addHuman.Details)
 	user.Password = nil
+	appendExtensionSchemas(user)
 
 	// ZITADEL supports only one (primary) phone number or email.
 	// Therefore, only the primary one should be returned.
@@ -228,6 +247,7 @@ func (h *UsersHandler) mapChangeCommandToScimUser(ctx context.Context, user *Sci
 	user.ID = changeHuman.Details.ID
 	user.Resource = buildResource(ctx, h, changeHuman.Details)
 	user.Password = nil
+	appendExtensionSchemas(user)
 
 	// ZITADEL supports only one (primary) phone number or email.
 	// Therefore, only the primary one should be returned.
@@ -251,7 +271,7 @@ func (h *UsersHandler) mapChangeCommandToScimUser(ctx context.Context, user *Sci
 	}
 }
 
-func (h *UsersHandler) mapToScimUsers(ctx context.Context, users []*query.User, md map[string]map[metadata.ScopedKey][]byte) []*ScimUser {
+func (h *UsersHandler) mapToScimUsers(ctx context.Context, users []*query.User, md map[string]map[metadata.ScopedKey][]byte, customAttributes []*schemas.CustomAttribute) []*ScimUser {
 	result := make([]*ScimUser, len(users))
 	for i, user := range users {
 		userMetadata, ok := md[user.ID]
@@ -259,13 +279,13 @@ func (h *UsersHandler) mapToScimUsers(ctx context.Context, users []*query.User,
 			userMetadata = make(map[metadata.ScopedKey][]byte)
 		}
 
-		result[i] = h.mapToScimUser(ctx, user, userMetadata)
+		result[i] = h.mapToScimUser(ctx, user, userMetadata, customAttributes)
 	}
 
 	return result
 }
 
-func (h *UsersHandler) mapToScimUser(ctx context.Context, user *query.User, md map[metadata.ScopedKey][]byte) *ScimUser {
+func (h *UsersHandler) mapToScimUser(ctx context.Context, user *query.User, md map[metadata.ScopedKey][]byte, customAttributes []*schemas.CustomAttribute) *ScimUser {
 	scimUser := &ScimUser{
 		Resource:          h.buildResourceForQuery(ctx, user),
 		ID:                user.ID,
@@ -299,11 +319,11 @@ func (h *UsersHandler) mapToScimUser(ctx context.Context, user *query.User, md m
 		}
 	}
 
-	h.mapAndValidateMetadata(ctx, scimUser, md)
+	h.mapAndValidateMetadata(ctx, scimUser, md, customAttributes)
 	return scimUser
 }
 
-func (h *UsersHandler) mapWriteModelToScimUser(ctx context.Context, user *command.UserV2WriteModel) *ScimUser {
+func (h *UsersHandler) mapWriteModelToScimUser(ctx context.Context, user *command.UserV2WriteModel, customAttributes []*schemas.CustomAttribute) *ScimUser {
 	scimUser := &ScimUser{
 		Resource:          h.buildResourceForWriteModel(ctx, user),
 		ID:                user.AggregateID,
@@ -338,11 +358,11 @@ func (h *UsersHandler) mapWriteModelToScimUser(ctx context.Context, user *comman
 	}
 
 	md := metadata.MapToScopedKeyMap(user.Metadata)
-	h.mapAndValidateMetadata(ctx, scimUser, md)
+	h.mapAndValidateMetadata(ctx, scimUser, md, customAttributes)
 	return scimUser
 }
 
-func (h *UsersHandler) mapAndValidateMetadata(ctx context.Context, user *ScimUser, md map[metadata.ScopedKey][]byte) {
+func (h *UsersHandler) mapAndValidateMetadata(ctx context.Context, user *ScimUser, md map[metadata.ScopedKey][]byte, customAttributes []*schemas.CustomAttribute) {
 	user.ExternalID = extractScalarMetadata(ctx, md, metadata.KeyExternalId)
 	user.ProfileUrl = extractHttpURLMetadata(ctx, md, metadata.KeyProfileUrl)
 	user.Title = extractScalarMetadata(ctx, md, metadata.KeyTitle)
@@ -391,6 +411,13 @@ func (h *UsersHandler) mapAndValidateMetadata(ctx context.Context, user *ScimUse
 	if err := extractJsonMetadata(ctx, md, metadata.KeyEmails, &user.Emails); err != nil {
 		logging.OnError(err).Warn("Could not deserialize scim emails metadata")
 	}
+
+	if err := extractJsonMetadata(ctx, md, metadata.KeyEnterpriseUser, &user.EnterpriseUser); err != nil {
+		logging.OnError(err).Warn("Could not deserialize scim enterprise user metadata")
+	}
+
+	user.Metadata = mapCustomAttributesFromMetadata(md, customAttributes)
+	appendExtensionSchemas(user)
 }
 
 func (h *UsersHandler) buildResourceForQuery(ctx context.Context, user *query.User) *schemas.Resource {
@@ -3,6 +3,7 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"time"
 	// import timezone database to ensure it is available at runtime
 	// data is required to validate time zones.
@@ -20,8 +21,8 @@ import (
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
-func (h *UsersHandler) queryMetadataForUsers(ctx context.Context, userIds []string) (map[string]map[metadata.ScopedKey][]byte, error) {
-	queries := BuildMetadataQueries(ctx, metadata.ScimUserRelevantMetadataKeys)
+func (h *UsersHandler) queryMetadataForUsers(ctx context.Context, userIds []string, customAttributes []*schemas.CustomAttribute) (map[string]map[metadata.ScopedKey][]byte, error) {
+	queries := BuildMetadataQueries(ctx, append(slices.Clip(metadata.ScimUserRelevantMetadataKeys), customAttributeKeys(customAttributes)...))
 
 	md, err := h.query.SearchUserMetadataForUsers(ctx, false, userIds, queries)
 	if err != nil {
@@ -42,8 +43,8 @@ func (h *UsersHandler) queryMetadataForUsers(ctx context.Context, userIds []stri
 	return metadataMap, nil
 }
 
-func (h *UsersHandler) queryMetadataForUser(ctx context.Context, id string) (map[metadata.ScopedKey][]byte, error) {
-	queries := BuildMetadataQueries(ctx, metadata.ScimUserRelevantMetadataKeys)
+func (h *UsersHandler) queryMetadataForUser(ctx context.Context, id string, customAttributes []*schemas.CustomAttribute) (map[metadata.ScopedKey][]byte, error) {
+	queries := BuildMetadataQueries(ctx, append(slices.Clip(metadata.ScimUserRelevantMetadataKeys), customAttributeKeys(customAttributes)...))
 
 	md, err := h.query.SearchUserMetadata(ctx, false, id, queries, nil)
 	if err != nil {
@@ -130,7 +131,8 @@ func getValueForMetadataKey(user *ScimUser, key metadata.Key) ([]byte, error) {
 		metadata.KeyEntitlements,
 		metadata.KeyIms,
 		metadata.KeyPhotos,
-		metadata.KeyEmails:
+		metadata.KeyEmails,
+		metadata.KeyEnterpriseUser:
 		val, err := json.Marshal(value)
 		if err != nil {
 			return nil, err
@@ -226,6 +228,11 @@ func getRawValueForMetadataKey(user *ScimUser, key metadata.Key) interface{} {
 		return user.Timezone
 	case metadata.KeyEmails:
 		return user.Emails
+	case metadata.KeyEnterpriseUser:
+		if user.EnterpriseUser.isEmpty() {
+			return nil
+		}
+		return user.EnterpriseUser
 	case metadata.KeyProvisioningDomain:
 		break
 	}
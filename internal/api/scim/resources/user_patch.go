@@ -7,24 +7,29 @@ import (
 	"github.com/zitadel/zitadel/internal/api/scim/metadata"
 	"github.com/zitadel/zitadel/internal/api/scim/resources/filter"
 	"github.com/zitadel/zitadel/internal/api/scim/resources/patch"
+	"github.com/zitadel/zitadel/internal/api/scim/schemas"
 	"github.com/zitadel/zitadel/internal/command"
 	"github.com/zitadel/zitadel/internal/domain"
 )
 
+var userMetadataExtensionPathSegment = strings.ToLower(string(schemas.IdZitadelUserMetadata))
+
 type userPatcher struct {
 	ctx                  context.Context
 	user                 *ScimUser
 	metadataChanges      map[metadata.Key]*domain.Metadata
 	metadataKeysToRemove map[metadata.Key]bool
+	customAttributes     []*schemas.CustomAttribute
 	handler              *UsersHandler
 }
 
-func (h *UsersHandler) applyPatchesToChangeHuman(ctx context.Context, user *ScimUser, operations patch.OperationCollection) (*command.ChangeHuman, error) {
+func (h *UsersHandler) applyPatchesToChangeHuman(ctx context.Context, user *ScimUser, customAttributes []*schemas.CustomAttribute, operations patch.OperationCollection) (*command.ChangeHuman, error) {
 	patcher := &userPatcher{
 		ctx:                  ctx,
 		user:                 user,
 		metadataChanges:      make(map[metadata.Key]*domain.Metadata),
 		metadataKeysToRemove: make(map[metadata.Key]bool),
+		customAttributes:     customAttributes,
 		handler:              h,
 	}
 
@@ -33,7 +38,7 @@ func (h *UsersHandler) applyPatchesToChangeHuman(ctx context.Context, user *Scim
 	}
 
 	// we rely on the change detection of the write model to only execute commands that really change data
-	changeCommand, err := h.mapToChangeHuman(ctx, user)
+	changeCommand, err := h.mapToChangeHuman(ctx, user, customAttributes)
 	if err != nil {
 		return nil, err
 	}
@@ -70,11 +75,36 @@ func (p *userPatcher) applyMetadataChangesToCommand(command *command.ChangeHuman
 	}
 }
 
+func (p *userPatcher) updateCustomAttributesMetadata() error {
+	md, removedKeys, err := mapCustomAttributesToMetadata(p.user, p.customAttributes)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range md {
+		key := metadata.Key(entry.Key)
+		delete(p.metadataKeysToRemove, key)
+		p.metadataChanges[key] = entry
+	}
+
+	for _, removedKey := range removedKeys {
+		key := metadata.Key(removedKey)
+		p.metadataKeysToRemove[key] = true
+		delete(p.metadataChanges, key)
+	}
+	return nil
+}
+
 func (p *userPatcher) updateMetadata(attributePath []string) error {
 	if len(attributePath) == 0 {
 		return nil
 	}
 
+	// the attributes of the user metadata extension are stored with their own name as key
+	if attributePath[0] == userMetadataExtensionPathSegment {
+		return p.updateCustomAttributesMetadata()
+	}
+
 	// try full path first (e.g. name.middleName)
 	// try root only if full path did not match (e.g. for entitlements.value only entitlements is mapped)
 	var ok bool
@@ -651,6 +651,80 @@ func TestOperationCollection_Apply(t *testing.T) {
 			},
 			wantModifications: []string{"rep:entitlements.display"},
 		},
+		{
+			name: "replace extension attribute with path",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:department")),
+				Value:     json.RawMessage(`"Sales"`),
+			},
+			want: &ScimUser{
+				EnterpriseUser: &ScimEnterpriseUser{
+					Department: "Sales",
+				},
+			},
+			wantModifications: []string{"rep:urn:ietf:params:scim:schemas:extension:enterprise:2.0:user.department"},
+		},
+		{
+			name: "replace extension sub attribute without path",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Value:     json.RawMessage(`{ "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User:manager.displayName": "John Smith" }`),
+			},
+			want: &ScimUser{
+				EnterpriseUser: &ScimEnterpriseUser{
+					Manager: &ScimEnterpriseUserManager{
+						DisplayName: "John Smith",
+					},
+				},
+			},
+			wantModifications: []string{"rep:urn:ietf:params:scim:schemas:extension:enterprise:2.0:user.manager.displayname"},
+		},
+		{
+			name: "replace extension without path",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Value:     json.RawMessage(`{ "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User": { "employeeNumber": "701984" } }`),
+			},
+			want: &ScimUser{
+				EnterpriseUser: &ScimEnterpriseUser{
+					EmployeeNumber: "701984",
+				},
+			},
+			wantModifications: []string{"rep:urn:ietf:params:scim:schemas:extension:enterprise:2.0:user"},
+		},
+		{
+			name: "replace user metadata extension",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata")),
+				Value:     json.RawMessage(`{ "badgeNumber": "B-42" }`),
+			},
+			want: &ScimUser{
+				Metadata: map[string]json.RawMessage{
+					"badgeNumber": json.RawMessage(`"B-42"`),
+				},
+			},
+			wantModifications: []string{"rep:urn:ietf:params:scim:schemas:extension:zitadel:2.0:usermetadata"},
+		},
+		{
+			name: "replace user metadata extension attribute is not supported",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:ietf:params:scim:schemas:extension:zitadel:2.0:UserMetadata:badgeNumber")),
+				Value:     json.RawMessage(`"B-42"`),
+			},
+			wantErr: true,
+		},
+		{
+			name: "replace unknown extension",
+			op: &patch.Operation{
+				Operation: patch.OperationTypeReplace,
+				Path:      test.Must(filter.ParsePath("urn:ietf:params:scim:schemas:extension:foo:2.0:User:department")),
+				Value:     json.RawMessage(`"Sales"`),
+			},
+			wantErr: true,
+		},
 		{
 			name: "replace filter complex subattribute primary",
 			op: &patch.Operation{
@@ -931,6 +1005,29 @@ func Test_userPatcher_updateMetadata(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:         "extension attribute",
+			metadataPath: []string{"urn:ietf:params:scim:schemas:extension:enterprise:2.0:user", "department"},
+			wantMetadataChanges: map[metadata.Key]*domain.Metadata{
+				metadata.KeyEnterpriseUser: {
+					Key:   string(metadata.KeyEnterpriseUser),
+					Value: []byte(`{"department":"Tour Operations"}`),
+				},
+			},
+		},
+		{
+			name:         "user metadata extension",
+			metadataPath: []string{"urn:ietf:params:scim:schemas:extension:zitadel:2.0:usermetadata"},
+			wantMetadataChanges: map[metadata.Key]*domain.Metadata{
+				"badgeNumber": {
+					Key:   "badgeNumber",
+					Value: []byte("B-42"),
+				},
+			},
+			wantMetadataKeysToRemove: map[metadata.Key]bool{
+				"floors": true,
+			},
+		},
 		{
 			name:         "delete previous modified attribute",
 			metadataPath: []string{"locale"},
@@ -956,6 +1053,23 @@ func Test_userPatcher_updateMetadata(t *testing.T) {
 						HonorificPrefix: "Adel",
 						HonorificSuffix: "III",
 					},
+					EnterpriseUser: &ScimEnterpriseUser{
+						Department: "Tour Operations",
+					},
+					Metadata: map[string]json.RawMessage{
+						"badgenumber": json.RawMessage(`"B-42"`),
+					},
+				},
+				customAttributes: []*schemas.CustomAttribute{
+					{
+						Name: "badgeNumber",
+						Type: schemas.SchemaAttributeTypeString,
+					},
+					{
+						Name:        "floors",
+						Type:        schemas.SchemaAttributeTypeInteger,
+						MultiValued: true,
+					},
 				},
 				metadataChanges:      tt.metadataChanges,
 				metadataKeysToRemove: tt.metadataKeysToRemove,
@@ -996,7 +1110,7 @@ type simplePatcher struct {
 }
 
 func (s *simplePatcher) FilterEvaluator() *filter.Evaluator {
-	return filter.NewEvaluator(schemas.IdUser)
+	return filter.NewEvaluator(schemas.IdUser, schemas.IdEnterpriseUser, schemas.IdZitadelUserMetadata)
 }
 
 func (s *simplePatcher) Added(attributePath []string) error {
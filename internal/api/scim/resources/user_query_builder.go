@@ -60,13 +60,15 @@ var fieldPathColumnMapping = filter.FieldPathMapping{
 }
 
 func (h *UsersHandler) buildListQuery(ctx context.Context, request *ListRequest) (*query.UserSearchQueries, error) {
-	searchRequest, err := request.toSearchRequest(query.UserIDCol, fieldPathColumnMapping)
+	searchRequest, err := request.toSearchRequest(h.schema.ID, query.UserIDCol, fieldPathColumnMapping)
 	if err != nil {
 		return nil, err
 	}
 
 	q := &query.UserSearchQueries{
 		SearchRequest: searchRequest,
+		// counting all users of large organizations is too expensive for each page
+		WithoutTotalCount: request.IsCursorPagination(),
 	}
 
 	// the zitadel scim implementation only supports humans for now
@@ -84,6 +86,15 @@ func (h *UsersHandler) buildListQuery(ctx context.Context, request *ListRequest)
 
 	q.Queries = append(q.Queries, orgIDQuery, userTypeQuery)
 
+	cursorQuery, err := request.cursorQuery(query.UserIDCol)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursorQuery != nil {
+		q.Queries = append(q.Queries, cursorQuery)
+	}
+
 	if request.Filter == nil {
 		return q, nil
 	}
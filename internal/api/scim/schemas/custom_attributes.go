@@ -0,0 +1,137 @@
+package schemas
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// CustomAttribute the definition of an attribute of the ZITADEL user metadata schema extension.
+// The definitions are configured per organization,
+// the value of each attribute is stored as user metadata with the name of the attribute as key.
+type CustomAttribute struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Type        SchemaAttributeType `json:"type,omitempty"`
+	MultiValued bool                `json:"multiValued,omitempty"`
+	Required    bool                `json:"required,omitempty"`
+}
+
+// ParseCustomAttributes parses and validates the custom attribute definitions of an organization.
+// If no type is set, the attribute is of type string.
+func ParseCustomAttributes(data []byte) ([]*CustomAttribute, error) {
+	var attributes []*CustomAttribute
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "SCIM-CA1", "Could not parse custom attribute definitions")
+	}
+
+	names := make(map[string]bool, len(attributes))
+	for _, attribute := range attributes {
+		if attribute == nil || strings.TrimSpace(attribute.Name) == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "SCIM-CA2", "Custom attribute name is required")
+		}
+
+		lowerName := strings.ToLower(attribute.Name)
+		if names[lowerName] {
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIM-CA3", "Duplicate custom attribute %s", attribute.Name)
+		}
+		names[lowerName] = true
+
+		switch attribute.Type { //nolint:exhaustive
+		case "":
+			attribute.Type = SchemaAttributeTypeString
+		case SchemaAttributeTypeString,
+			SchemaAttributeTypeBoolean,
+			SchemaAttributeTypeDecimal,
+			SchemaAttributeTypeInteger,
+			SchemaAttributeTypeDateTime:
+		default:
+			return nil, zerrors.ThrowInvalidArgumentf(nil, "SCIM-CA4", "Unsupported custom attribute type %s", attribute.Type)
+		}
+	}
+
+	return attributes, nil
+}
+
+// BuildCustomAttributesSchema builds the ZITADEL user metadata schema extension for the provided attribute definitions.
+func BuildCustomAttributesSchema(attributes []*CustomAttribute) *ResourceSchema {
+	schemaAttributes := make([]*SchemaAttribute, len(attributes))
+	for i, attribute := range attributes {
+		description := attribute.Description
+		if description == "" {
+			description = "Custom attribute stored as user metadata " + attribute.Name
+		}
+
+		schemaAttributes[i] = &SchemaAttribute{
+			Name:        attribute.Name,
+			Description: description,
+			Type:        attribute.Type,
+			MultiValued: attribute.MultiValued,
+			Required:    attribute.Required,
+			CaseExact:   true,
+			Mutability:  SchemaAttributeMutabilityReadWrite,
+			Returned:    SchemaAttributeReturnedAlways,
+			Uniqueness:  SchemaAttributeUniquenessNone,
+		}
+	}
+
+	return &ResourceSchema{
+		Resource: &Resource{
+			Schemas: []ScimSchemaType{IdSchema},
+			ID:      string(IdZitadelUserMetadata),
+			Meta: &ResourceMeta{
+				ResourceType: SchemaResourceType,
+			},
+		},
+		ID:          IdZitadelUserMetadata,
+		Name:        ZitadelUserMetadataSchemaName,
+		Description: "ZITADEL user metadata",
+		Attributes:  schemaAttributes,
+	}
+}
+
+// ValidateValue validates the json value against the type of the custom attribute.
+func (a *CustomAttribute) ValidateValue(value json.RawMessage) error {
+	if !a.MultiValued {
+		return a.validateSingleValue(value)
+	}
+
+	var values []json.RawMessage
+	if err := json.Unmarshal(value, &values); err != nil {
+		return zerrors.ThrowInvalidArgumentf(err, "SCIM-CA5", "Custom attribute %s expects an array", a.Name)
+	}
+
+	for _, v := range values {
+		if err := a.validateSingleValue(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *CustomAttribute) validateSingleValue(value json.RawMessage) error {
+	var err error
+	switch a.Type {
+	case SchemaAttributeTypeBoolean:
+		err = json.Unmarshal(value, new(RelaxedBool))
+	case SchemaAttributeTypeInteger:
+		err = json.Unmarshal(value, new(int64))
+	case SchemaAttributeTypeDecimal:
+		err = json.Unmarshal(value, new(float64))
+	case SchemaAttributeTypeDateTime:
+		err = json.Unmarshal(value, new(time.Time))
+	case SchemaAttributeTypeString,
+		SchemaAttributeTypeComplex:
+		if !bytes.HasPrefix(bytes.TrimSpace(value), []byte(`"`)) {
+			return zerrors.ThrowInvalidArgumentf(nil, "SCIM-CA7", "Custom attribute %s expects a value of type %s", a.Name, a.Type)
+		}
+	}
+
+	if err != nil {
+		return zerrors.ThrowInvalidArgumentf(err, "SCIM-CA6", "Custom attribute %s expects a value of type %s", a.Name, a.Type)
+	}
+	return nil
+}
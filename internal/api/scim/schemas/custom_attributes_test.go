@@ -0,0 +1,154 @@
+package schemas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCustomAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		want    []*CustomAttribute
+		wantErr bool
+	}{
+		{
+			name:    "invalid json",
+			data:    []byte(`{`),
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			data:    []byte(`[{"type": "string"}]`),
+			wantErr: true,
+		},
+		{
+			name:    "duplicate name",
+			data:    []byte(`[{"name": "badge"}, {"name": "Badge"}]`),
+			wantErr: true,
+		},
+		{
+			name:    "complex type",
+			data:    []byte(`[{"name": "badge", "type": "complex"}]`),
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			data:    []byte(`[{"name": "badge", "type": "foo"}]`),
+			wantErr: true,
+		},
+		{
+			name: "default type",
+			data: []byte(`[{"name": "badge", "description": "the badge", "required": true}, {"name": "floors", "type": "integer", "multiValued": true}]`),
+			want: []*CustomAttribute{
+				{
+					Name:        "badge",
+					Description: "the badge",
+					Type:        SchemaAttributeTypeString,
+					Required:    true,
+				},
+				{
+					Name:        "floors",
+					Type:        SchemaAttributeTypeInteger,
+					MultiValued: true,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseCustomAttributes(tt.data)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCustomAttribute_ValidateValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute *CustomAttribute
+		value     json.RawMessage
+		wantErr   bool
+	}{
+		{
+			name:      "string",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeString},
+			value:     json.RawMessage(`"value"`),
+		},
+		{
+			name:      "string invalid",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeString},
+			value:     json.RawMessage(`10`),
+			wantErr:   true,
+		},
+		{
+			name:      "boolean relaxed",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeBoolean},
+			value:     json.RawMessage(`"True"`),
+		},
+		{
+			name:      "integer",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeInteger},
+			value:     json.RawMessage(`10`),
+		},
+		{
+			name:      "integer invalid",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeInteger},
+			value:     json.RawMessage(`1.5`),
+			wantErr:   true,
+		},
+		{
+			name:      "decimal",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeDecimal},
+			value:     json.RawMessage(`1.5`),
+		},
+		{
+			name:      "dateTime",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeDateTime},
+			value:     json.RawMessage(`"2024-01-01T00:00:00Z"`),
+		},
+		{
+			name:      "dateTime invalid",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeDateTime},
+			value:     json.RawMessage(`"yesterday"`),
+			wantErr:   true,
+		},
+		{
+			name:      "multi valued",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeInteger, MultiValued: true},
+			value:     json.RawMessage(`[1, 2, 3]`),
+		},
+		{
+			name:      "multi valued single value",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeInteger, MultiValued: true},
+			value:     json.RawMessage(`1`),
+			wantErr:   true,
+		},
+		{
+			name:      "multi valued invalid element",
+			attribute: &CustomAttribute{Name: "attr", Type: SchemaAttributeTypeInteger, MultiValued: true},
+			value:     json.RawMessage(`[1, "2"]`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.attribute.ValidateValue(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
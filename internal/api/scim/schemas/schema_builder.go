@@ -16,6 +16,10 @@ type SchemaBuilderArgs struct {
 	EndpointName ScimResourceTypePlural
 	Description  string
 	Resource     any
+
+	// Extensions the schema extensions supported by the resource.
+	// The EndpointName of an extension is ignored.
+	Extensions []SchemaBuilderArgs
 }
 
 type fieldSchemaInfo struct {
@@ -33,6 +37,15 @@ var (
 )
 
 func BuildSchema(args SchemaBuilderArgs) *ResourceSchema {
+	var extensions []*ResourceSchema
+	if len(args.Extensions) > 0 {
+		extensions = make([]*ResourceSchema, len(args.Extensions))
+		for i, extension := range args.Extensions {
+			extensions[i] = BuildSchema(extension)
+			extensions[i].PluralName = ""
+		}
+	}
+
 	return &ResourceSchema{
 		Resource: &Resource{
 			Schemas: []ScimSchemaType{IdSchema},
@@ -46,6 +59,7 @@ func BuildSchema(args SchemaBuilderArgs) *ResourceSchema {
 		PluralName:  args.EndpointName,
 		Description: args.Description,
 		Attributes:  buildSchemaAttributes(reflect.TypeOf(args.Resource)),
+		Extensions:  extensions,
 	}
 }
 
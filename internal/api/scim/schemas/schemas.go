@@ -3,6 +3,7 @@ package schemas
 import (
 	"context"
 	"path"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -17,8 +18,10 @@ const (
 	idPrefixMessages        = "urn:ietf:params:scim:api:messages:2.0:"
 	idPrefixCore            = "urn:ietf:params:scim:schemas:core:2.0:"
 	idPrefixZitadelMessages = "urn:ietf:params:scim:api:zitadel:messages:2.0:"
+	idPrefixExtension       = "urn:ietf:params:scim:schemas:extension:"
 
 	IdUser                  ScimSchemaType = idPrefixCore + "User"
+	IdGroup                 ScimSchemaType = idPrefixCore + "Group"
 	IdServiceProviderConfig ScimSchemaType = idPrefixCore + "ServiceProviderConfig"
 	IdResourceType          ScimSchemaType = idPrefixCore + "ResourceType"
 	IdSchema                ScimSchemaType = idPrefixCore + "Schema"
@@ -29,10 +32,19 @@ const (
 	IdBulkResponse          ScimSchemaType = idPrefixMessages + "BulkResponse"
 	IdError                 ScimSchemaType = idPrefixMessages + "Error"
 	IdZitadelErrorDetail    ScimSchemaType = idPrefixZitadelMessages + "ErrorDetail"
+	IdZitadelBulkRequest    ScimSchemaType = idPrefixZitadelMessages + "BulkRequest"
+	IdEnterpriseUser        ScimSchemaType = idPrefixExtension + "enterprise:2.0:User"
+	IdZitadelUserMetadata   ScimSchemaType = idPrefixExtension + "zitadel:2.0:UserMetadata"
 
 	UserResourceType  ScimResourceTypeSingular = "User"
 	UsersResourceType ScimResourceTypePlural   = "Users"
 
+	EnterpriseUserSchemaName      ScimResourceTypeSingular = "EnterpriseUser"
+	ZitadelUserMetadataSchemaName ScimResourceTypeSingular = "UserMetadata"
+
+	GroupResourceType  ScimResourceTypeSingular = "Group"
+	GroupsResourceType ScimResourceTypePlural   = "Groups"
+
 	ServiceProviderConfigResourceType  ScimResourceTypeSingular = "ServiceProviderConfig"
 	ServiceProviderConfigsResourceType ScimResourceTypePlural   = "ServiceProviderConfig"
 
@@ -60,11 +72,17 @@ type ResourceMeta struct {
 
 type ResourceType struct {
 	*Resource
-	ID          ScimResourceTypeSingular `json:"id"`
-	Name        ScimResourceTypeSingular `json:"name"`
-	Endpoint    ScimResourceTypePlural   `json:"endpoint"`
-	Schema      ScimSchemaType           `json:"schema"`
-	Description string                   `json:"description"`
+	ID               ScimResourceTypeSingular       `json:"id"`
+	Name             ScimResourceTypeSingular       `json:"name"`
+	Endpoint         ScimResourceTypePlural         `json:"endpoint"`
+	Schema           ScimSchemaType                 `json:"schema"`
+	SchemaExtensions []*ResourceTypeSchemaExtension `json:"schemaExtensions,omitempty"`
+	Description      string                         `json:"description"`
+}
+
+type ResourceTypeSchemaExtension struct {
+	Schema   ScimSchemaType `json:"schema"`
+	Required bool           `json:"required"`
 }
 
 type ResourceSchema struct {
@@ -74,6 +92,10 @@ type ResourceSchema struct {
 	PluralName  ScimResourceTypePlural   `json:"-"`
 	Description string                   `json:"description,omitempty"`
 	Attributes  []*SchemaAttribute       `json:"attributes"`
+
+	// Extensions the schema extensions supported by this resource schema,
+	// the extensions are served as separate schemas.
+	Extensions []*ResourceSchema `json:"-"`
 }
 
 type SchemaAttribute struct {
@@ -137,7 +159,17 @@ func (s *ResourceSchema) GetResource() *Resource {
 	return s.Resource
 }
 
-func (s *ResourceSchema) ToResourceType(ctx context.Context, orgID string) *ResourceType {
+// ToResourceType builds the resource type of the schema.
+// The additional extensions are added to the static extensions of the schema (e.g. organization specific extensions).
+func (s *ResourceSchema) ToResourceType(ctx context.Context, orgID string, additionalExtensions ...*ResourceSchema) *ResourceType {
+	extensions := make([]*ResourceTypeSchemaExtension, 0, len(s.Extensions)+len(additionalExtensions))
+	for _, extension := range append(slices.Clip(s.Extensions), additionalExtensions...) {
+		extensions = append(extensions, &ResourceTypeSchemaExtension{
+			Schema:   extension.ID,
+			Required: false,
+		})
+	}
+
 	return &ResourceType{
 		Resource: &Resource{
 			Schemas: []ScimSchemaType{IdResourceType},
@@ -147,11 +179,12 @@ func (s *ResourceSchema) ToResourceType(ctx context.Context, orgID string) *Reso
 				Location:     BuildLocationWithOrg(ctx, orgID, ResourceTypesResourceType, string(s.Name)),
 			},
 		},
-		ID:          s.Name,
-		Name:        s.Name,
-		Endpoint:    s.PluralName,
-		Schema:      s.ID,
-		Description: s.Description,
+		ID:               s.Name,
+		Name:             s.Name,
+		Endpoint:         s.PluralName,
+		Schema:           s.ID,
+		SchemaExtensions: extensions,
+		Description:      s.Description,
 	}
 }
 
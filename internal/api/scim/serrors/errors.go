@@ -65,6 +65,9 @@ const (
 
 	// ScimTypeUniqueness One or more of the attribute values are already in use or are reserved.
 	ScimTypeUniqueness scimErrorType = "uniqueness"
+
+	// ScimTypeInvalidCursor The cursor value is invalid or malformed (RFC 9865).
+	ScimTypeInvalidCursor scimErrorType = "invalidCursor"
 )
 
 var translator *i18n.Translator
@@ -122,6 +125,27 @@ func ThrowNoTarget(parent error) error {
 	}
 }
 
+func ThrowInvalidCursor(parent error) error {
+	return &wrappedScimError{
+		Parent:   parent,
+		ScimType: ScimTypeInvalidCursor,
+	}
+}
+
+func ThrowConflict(parent error) error {
+	return &wrappedScimError{
+		Parent: parent,
+		Status: http.StatusConflict,
+	}
+}
+
+func ThrowFailedDependency(parent error) error {
+	return &wrappedScimError{
+		Parent: parent,
+		Status: http.StatusFailedDependency,
+	}
+}
+
 func ThrowPayloadTooLarge(parent error) error {
 	return &wrappedScimError{
 		Parent: parent,
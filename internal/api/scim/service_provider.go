@@ -15,10 +15,9 @@ import (
 )
 
 type serviceProviderHandler struct {
-	config                *scim_config.Config
-	schemas               []*sschemas.ResourceSchema
-	schemasByID           map[sschemas.ScimSchemaType]*sschemas.ResourceSchema
-	schemasByResourceName map[sschemas.ScimResourceTypeSingular]*sschemas.ResourceSchema
+	config                 *scim_config.Config
+	handlers               []sresources.RawResourceHandlerAdapter
+	handlersByResourceName map[sschemas.ScimResourceTypeSingular]sresources.RawResourceHandlerAdapter
 }
 
 type serviceProviderConfig struct {
@@ -29,6 +28,7 @@ type serviceProviderConfig struct {
 	Filter                serviceProviderFilterSupported                           `json:"filter"`
 	ChangePassword        serviceProviderConfigSupported                           `json:"changePassword"`
 	Sort                  serviceProviderConfigSupported                           `json:"sort"`
+	Pagination            serviceProviderConfigPagination                          `json:"pagination"`
 	ETag                  serviceProviderConfigSupported                           `json:"etag"`
 	AuthenticationSchemes []*scim_config.ServiceProviderConfigAuthenticationScheme `json:"authenticationSchemes,omitempty"`
 }
@@ -42,6 +42,15 @@ type serviceProviderFilterSupported struct {
 	MaxResults int  `json:"maxResults"`
 }
 
+// serviceProviderConfigPagination the supported pagination methods according to RFC 9865.
+type serviceProviderConfigPagination struct {
+	Cursor                  bool   `json:"cursor"`
+	Index                   bool   `json:"index"`
+	DefaultPaginationMethod string `json:"defaultPaginationMethod"`
+	DefaultPageSize         int    `json:"defaultPageSize"`
+	MaxPageSize             int    `json:"maxPageSize"`
+}
+
 type serviceProviderConfigBulk struct {
 	Supported      bool  `json:"supported"`
 	MaxOperations  int   `json:"maxOperations"`
@@ -56,21 +65,15 @@ var (
 )
 
 func newServiceProviderHandler(cfg *scim_config.Config, handlers ...sresources.RawResourceHandlerAdapter) *serviceProviderHandler {
-	schemas := make([]*sschemas.ResourceSchema, len(handlers))
-	schemasByID := make(map[sschemas.ScimSchemaType]*sschemas.ResourceSchema, len(handlers))
-	schemasByResourceName := make(map[sschemas.ScimResourceTypeSingular]*sschemas.ResourceSchema, len(handlers))
-	for i, handler := range handlers {
-		schema := handler.Schema()
-		schemas[i] = schema
-		schemasByID[schema.ID] = schema
-		schemasByResourceName[schema.Name] = schema
+	handlersByResourceName := make(map[sschemas.ScimResourceTypeSingular]sresources.RawResourceHandlerAdapter, len(handlers))
+	for _, handler := range handlers {
+		handlersByResourceName[handler.Schema().Name] = handler
 	}
 
 	return &serviceProviderHandler{
-		config:                cfg,
-		schemas:               schemas,
-		schemasByID:           schemasByID,
-		schemasByResourceName: schemasByResourceName,
+		config:                 cfg,
+		handlers:               handlers,
+		handlersByResourceName: handlersByResourceName,
 	}
 }
 
@@ -104,6 +107,13 @@ func (h *serviceProviderHandler) GetConfig(r *http.Request) (*serviceProviderCon
 		Sort: serviceProviderConfigSupported{
 			Supported: true,
 		},
+		Pagination: serviceProviderConfigPagination{
+			Cursor:                  true,
+			Index:                   true,
+			DefaultPaginationMethod: "index",
+			DefaultPageSize:         sresources.DefaultListCount,
+			MaxPageSize:             sresources.MaxListCount,
+		},
 		ETag: serviceProviderConfigSupported{
 			Supported: false,
 		},
@@ -116,9 +126,14 @@ func (h *serviceProviderHandler) ListResourceTypes(r *http.Request) (*sresources
 	ctx := r.Context()
 	orgID := mux.Vars(r)[zhttp.OrgIdInPathVariableName]
 
-	resourceTypes := make([]*sschemas.ResourceType, len(h.schemas))
-	for i, schema := range h.schemas {
-		resourceTypes[i] = schema.ToResourceType(ctx, orgID)
+	resourceTypes := make([]*sschemas.ResourceType, len(h.handlers))
+	for i, handler := range h.handlers {
+		orgExtensions, err := handler.OrgSchemaExtensions(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceTypes[i] = handler.Schema().ToResourceType(ctx, orgID, orgExtensions...)
 	}
 
 	return sresources.NewListResponse(uint64(len(resourceTypes)), defaultConfigSearchRequest, resourceTypes), nil
@@ -131,12 +146,17 @@ func (h *serviceProviderHandler) GetResourceType(r *http.Request) (*sschemas.Res
 	orgID := vars[zhttp.OrgIdInPathVariableName]
 	name := sschemas.ScimResourceTypeSingular(vars["name"])
 
-	schema, ok := h.schemasByResourceName[name]
+	handler, ok := h.handlersByResourceName[name]
 	if !ok {
 		return nil, zerrors.ThrowNotFoundf(nil, "SCIMSP-148z", "Scim resource type %s not found", name)
 	}
 
-	return schema.ToResourceType(ctx, orgID), nil
+	orgExtensions, err := handler.OrgSchemaExtensions(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler.Schema().ToResourceType(ctx, orgID, orgExtensions...), nil
 }
 
 func (h *serviceProviderHandler) ListSchemas(r *http.Request) (*sresources.ListResponse[*sschemas.ResourceSchema], error) {
@@ -144,12 +164,17 @@ func (h *serviceProviderHandler) ListSchemas(r *http.Request) (*sresources.ListR
 	ctx := r.Context()
 	orgID := mux.Vars(r)[zhttp.OrgIdInPathVariableName]
 
-	schemas := make([]*sschemas.ResourceSchema, len(h.schemas))
-	for i, schema := range h.schemas {
+	orgSchemas, err := h.orgSchemas(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make([]*sschemas.ResourceSchema, len(orgSchemas))
+	for i, schema := range orgSchemas {
 		schemas[i] = buildSchema(ctx, orgID, schema)
 	}
 
-	return sresources.NewListResponse(uint64(len(h.schemas)), defaultConfigSearchRequest, schemas), nil
+	return sresources.NewListResponse(uint64(len(schemas)), defaultConfigSearchRequest, schemas), nil
 }
 
 func (h *serviceProviderHandler) GetSchema(r *http.Request) (*sschemas.ResourceSchema, error) {
@@ -159,12 +184,37 @@ func (h *serviceProviderHandler) GetSchema(r *http.Request) (*sschemas.ResourceS
 	orgID := vars[zhttp.OrgIdInPathVariableName]
 	id := sschemas.ScimSchemaType(vars["id"])
 
-	schema, ok := h.schemasByID[id]
-	if !ok {
-		return nil, zerrors.ThrowNotFoundf(nil, "SCIMSP-148y", "Scim schema %s not found", id)
+	orgSchemas, err := h.orgSchemas(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schema := range orgSchemas {
+		if schema.ID == id {
+			return buildSchema(ctx, orgID, schema), nil
+		}
+	}
+
+	return nil, zerrors.ThrowNotFoundf(nil, "SCIMSP-148y", "Scim schema %s not found", id)
+}
+
+// orgSchemas returns the schemas of all resources including their extensions
+// and the extensions configured for the organization.
+func (h *serviceProviderHandler) orgSchemas(ctx context.Context, orgID string) ([]*sschemas.ResourceSchema, error) {
+	schemas := make([]*sschemas.ResourceSchema, 0, len(h.handlers))
+	for _, handler := range h.handlers {
+		schema := handler.Schema()
+		schemas = append(schemas, schema)
+		schemas = append(schemas, schema.Extensions...)
+
+		orgExtensions, err := handler.OrgSchemaExtensions(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, orgExtensions...)
 	}
 
-	return buildSchema(ctx, orgID, schema), nil
+	return schemas, nil
 }
 
 // buildSchema shallow copies the provided schema and sets the correct location based on the provided context information.
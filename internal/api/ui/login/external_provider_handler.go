@@ -26,14 +26,18 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 	"github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
+	"github.com/zitadel/zitadel/internal/idp/providers/auth0"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/entraexternalid"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	openid "github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
 	"github.com/zitadel/zitadel/internal/query"
@@ -190,6 +194,14 @@ func (l *Login) handleIDP(w http.ResponseWriter, r *http.Request, authReq *domai
 		provider, err = l.ldapProvider(r.Context(), identityProvider)
 	case domain.IDPTypeSAML:
 		provider, err = l.samlProvider(r.Context(), identityProvider)
+	case domain.IDPTypeOkta:
+		provider, err = l.oktaProvider(r.Context(), identityProvider)
+	case domain.IDPTypeAuth0:
+		provider, err = l.auth0Provider(r.Context(), identityProvider)
+	case domain.IDPTypeKeycloak:
+		provider, err = l.keycloakProvider(r.Context(), identityProvider)
+	case domain.IDPTypeEntraExternalID:
+		provider, err = l.entraExternalIDProvider(r.Context(), identityProvider)
 	case domain.IDPTypeUnspecified:
 		fallthrough
 	default:
@@ -343,6 +355,34 @@ func (l *Login) handleExternalLoginCallback(w http.ResponseWriter, r *http.Reque
 			return
 		}
 		session = openid.NewSession(provider.Provider, data.Code, authReq.SelectedIDPConfigArgs)
+	case domain.IDPTypeOkta:
+		provider, err := l.oktaProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthCallbackFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = openid.NewSession(provider.Provider, data.Code, authReq.SelectedIDPConfigArgs)
+	case domain.IDPTypeAuth0:
+		provider, err := l.auth0Provider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthCallbackFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = openid.NewSession(provider.Provider, data.Code, authReq.SelectedIDPConfigArgs)
+	case domain.IDPTypeKeycloak:
+		provider, err := l.keycloakProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthCallbackFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = openid.NewSession(provider.Provider, data.Code, authReq.SelectedIDPConfigArgs)
+	case domain.IDPTypeEntraExternalID:
+		provider, err := l.entraExternalIDProvider(r.Context(), identityProvider)
+		if err != nil {
+			l.externalAuthCallbackFailed(w, r, authReq, nil, nil, err)
+			return
+		}
+		session = openid.NewSession(provider.Provider, data.Code, authReq.SelectedIDPConfigArgs)
 	case domain.IDPTypeGoogle:
 		provider, err := l.googleProvider(r.Context(), identityProvider)
 		if err != nil {
@@ -459,6 +499,12 @@ func (l *Login) handleExternalUserAuthenticated(
 	callback func(w http.ResponseWriter, r *http.Request, authReq *domain.AuthRequest),
 ) {
 	externalUser := mapIDPUserToExternalUser(user, provider.ID)
+	if !provider.GroupMapping.IsZero() {
+		externalUser.Groups = idp.MapGroups(idp.UserClaims(user), provider.GroupMapping.Claim)
+	}
+	if idpTokens := tokens(session); idpTokens != nil {
+		externalUser.IDToken = idpTokens.IDToken
+	}
 	// ensure the linked IDP is added to the login policy
 	if err := l.authRepo.SelectExternalIDP(r.Context(), authReq.ID, provider.ID, authReq.AgentID, authReq.SelectedIDPConfigArgs); err != nil {
 		l.renderError(w, r, authReq, err)
@@ -516,9 +562,20 @@ func (l *Login) handleExternalUserAuthenticated(
 			return
 		}
 	}
+	l.syncIDPUserGrants(r.Context(), authReq, provider.GroupMapping, externalUser.Groups)
 	callback(w, r, authReq)
 }
 
+// syncIDPUserGrants synchronizes the user grants of the user based on the group mapping of the IDP.
+// Since the grants are not required for the login itself, an error is only logged.
+func (l *Login) syncIDPUserGrants(ctx context.Context, authReq *domain.AuthRequest, groupMapping *domain.IDPGroupMapping, groups []string) {
+	if groupMapping.IsZero() || authReq.UserID == "" {
+		return
+	}
+	err := l.command.SyncIDPUserGrants(setContext(ctx, authReq.UserOrgID), authReq.UserID, authReq.UserOrgID, groupMapping, groups)
+	logging.WithFields("userID", authReq.UserID, "idpID", authReq.SelectedIDPConfigID).OnError(err).Warn("unable to synchronize user grants of idp groups")
+}
+
 // checkAutoLinking checks if a user with the provided information (username or email) already exists within ZITADEL.
 // The decision, which information will be checked is based on the IdP template option.
 // The function returns a boolean whether a user was found or not.
@@ -815,6 +872,14 @@ func (l *Login) registerExternalUser(w http.ResponseWriter, r *http.Request, aut
 		l.renderError(w, r, authReq, err)
 		return
 	}
+	if len(externalUser.Groups) > 0 {
+		idpTemplate, err := l.getIDPByID(r, externalUser.IDPConfigID)
+		if err != nil {
+			l.renderError(w, r, authReq, err)
+			return
+		}
+		l.syncIDPUserGrants(r.Context(), authReq, idpTemplate.GroupMapping, externalUser.Groups)
+	}
 	l.renderNextStep(w, r, authReq)
 }
 
@@ -1064,7 +1129,7 @@ func (l *Login) oidcProvider(ctx context.Context, identityProvider *query.IDPTem
 		secret,
 		l.baseURL(ctx)+EndpointExternalLoginCallback,
 		identityProvider.OIDCIDPTemplate.Scopes,
-		openid.DefaultMapper,
+		openid.ClaimMapper(identityProvider.OIDCIDPTemplate.ClaimMapping),
 		opts...,
 	)
 }
@@ -1106,7 +1171,7 @@ func (l *Login) oauthProvider(ctx context.Context, identityProvider *query.IDPTe
 		identityProvider.Name,
 		identityProvider.OAuthIDPTemplate.UserEndpoint,
 		func() idp.User {
-			return oauth.NewUserMapper(identityProvider.OAuthIDPTemplate.IDAttribute)
+			return oauth.NewClaimUserMapper(identityProvider.OAuthIDPTemplate.IDAttribute, identityProvider.OAuthIDPTemplate.ClaimMapping)
 		},
 		opts...,
 	)
@@ -1241,6 +1306,69 @@ func (l *Login) gitlabSelfHostedProvider(ctx context.Context, identityProvider *
 	)
 }
 
+func (l *Login) oktaProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*okta.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.OktaIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return okta.New(
+		identityProvider.Name,
+		identityProvider.OktaIDPTemplate.Domain,
+		identityProvider.OktaIDPTemplate.AuthorizationServerID,
+		identityProvider.OktaIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.OktaIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) auth0Provider(ctx context.Context, identityProvider *query.IDPTemplate) (*auth0.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.Auth0IDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return auth0.New(
+		identityProvider.Name,
+		identityProvider.Auth0IDPTemplate.Domain,
+		identityProvider.Auth0IDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.Auth0IDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) keycloakProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*keycloak.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.KeycloakIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return keycloak.New(
+		identityProvider.Name,
+		identityProvider.KeycloakIDPTemplate.BaseURL,
+		identityProvider.KeycloakIDPTemplate.Realm,
+		identityProvider.KeycloakIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.KeycloakIDPTemplate.Scopes,
+	)
+}
+
+func (l *Login) entraExternalIDProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*entraexternalid.Provider, error) {
+	secret, err := crypto.DecryptString(identityProvider.EntraExternalIDIDPTemplate.ClientSecret, l.idpConfigAlg)
+	if err != nil {
+		return nil, err
+	}
+	return entraexternalid.New(
+		identityProvider.Name,
+		identityProvider.EntraExternalIDIDPTemplate.TenantID,
+		identityProvider.EntraExternalIDIDPTemplate.Domain,
+		identityProvider.EntraExternalIDIDPTemplate.ClientID,
+		secret,
+		l.baseURL(ctx)+EndpointExternalLoginCallback,
+		identityProvider.EntraExternalIDIDPTemplate.Scopes,
+	)
+}
+
 func (l *Login) appleProvider(ctx context.Context, identityProvider *query.IDPTemplate) (*apple.Provider, error) {
 	privateKey, err := crypto.Decrypt(identityProvider.AppleIDPTemplate.PrivateKey, l.idpConfigAlg)
 	if err != nil {
@@ -1329,7 +1457,7 @@ func tokens(session idp.Session) *oidc.Tokens[*oidc.IDTokenClaims] {
 }
 
 func mapIDPUserToExternalUser(user idp.User, id string) *domain.ExternalUser {
-	return &domain.ExternalUser{
+	externalUser := &domain.ExternalUser{
 		IDPConfigID:       id,
 		ExternalUserID:    user.GetID(),
 		PreferredUsername: user.GetPreferredUsername(),
@@ -1343,6 +1471,11 @@ func mapIDPUserToExternalUser(user idp.User, id string) *domain.ExternalUser {
 		Phone:             user.GetPhone(),
 		IsPhoneVerified:   user.IsPhoneVerified(),
 	}
+	// metadata provided by the claim mapping of the identity provider
+	if metadataUser, ok := user.(idp.MetadataUser); ok {
+		externalUser.Metadatas = metadataUser.GetMetadata()
+	}
+	return externalUser
 }
 
 func mapExternalUserToLoginUser(externalUser *domain.ExternalUser, mustBeDomain bool) (*domain.Human, *domain.UserIDPLink, []*domain.Metadata) {
@@ -1449,6 +1582,10 @@ type federatedLogoutData struct {
 	SessionID string `schema:"sessionID"`
 }
 
+type federatedLogoutCallbackData struct {
+	State string `schema:"state"`
+}
+
 const (
 	federatedLogoutDataSessionID = "sessionID"
 )
@@ -1480,30 +1617,96 @@ func (l *Login) handleExternalLogout(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	nameID, err := l.externalUserID(r.Context(), logoutRequest.UserID, logoutRequest.IDPID)
+	switch provider := provider.(type) {
+	case *saml.Provider:
+		var nameID string
+		nameID, err = l.externalUserID(r.Context(), logoutRequest.UserID, logoutRequest.IDPID)
+		if err != nil {
+			break
+		}
+		err = samlLogoutRequest(w, r, provider, nameID, logoutRequest.SessionID)
+	case idp.LogoutProvider:
+		err = l.oidcLogoutRequest(w, r, provider, logoutRequest.IDTokenHint, logoutRequest.SessionID)
+	default:
+		err = zerrors.ThrowInvalidArgument(nil, "LOGIN-Ohx2a", "Errors.ExternalIDP.IDPTypeNotImplemented")
+	}
 	if err != nil {
 		l.renderError(w, r, nil, err)
 		return
 	}
+	logoutRequest.State = federatedlogout.StateRedirected
+	l.caches.federatedLogouts.Set(r.Context(), logoutRequest)
+}
 
-	err = samlLogoutRequest(w, r, provider, nameID, logoutRequest.SessionID)
+// handleExternalLogoutCallback is called by the external identity provider after the user was signed out
+// using the OpenID Connect RP-Initiated Logout (the state contains the sessionID).
+// SAML single logout responses are handled by the IDP handler.
+func (l *Login) handleExternalLogoutCallback(w http.ResponseWriter, r *http.Request) {
+	data := new(federatedLogoutCallbackData)
+	err := l.parser.Parse(r, data)
 	if err != nil {
 		l.renderError(w, r, nil, err)
 		return
 	}
-	logoutRequest.State = federatedlogout.StateRedirected
-	l.caches.federatedLogouts.Set(r.Context(), logoutRequest)
+
+	instanceID := authz.GetInstance(r.Context()).InstanceID()
+	logoutRequest, ok := l.caches.federatedLogouts.Get(r.Context(), federatedlogout.IndexRequestID, federatedlogout.Key(instanceID, data.State))
+	if !ok || logoutRequest.State != federatedlogout.StateRedirected || logoutRequest.FingerPrintID != authz.GetCtxData(r.Context()).AgentID {
+		l.renderError(w, r, nil, zerrors.ThrowNotFound(nil, "LOGIN-ieG3e", "Errors.ExternalIDP.LogoutRequestNotFound"))
+		return
+	}
+	err = l.caches.federatedLogouts.Delete(r.Context(), federatedlogout.IndexRequestID, federatedlogout.Key(instanceID, logoutRequest.SessionID))
+	logging.WithFields("instanceID", instanceID, "sessionID", logoutRequest.SessionID).OnError(err).Error("could not delete federated logout")
+	http.Redirect(w, r, logoutRequest.PostLogoutRedirectURI, http.StatusFound)
 }
 
-func (l *Login) externalLogoutProvider(r *http.Request, providerID string) (*saml.Provider, error) {
+// externalLogoutProvider returns the provider of the IDP template for a federated logout.
+// Besides SAML, all (OIDC based) providers are returned, which implement the [idp.LogoutProvider] interface.
+func (l *Login) externalLogoutProvider(r *http.Request, providerID string) (idp.Provider, error) {
 	identityProvider, err := l.getIDPByID(r, providerID)
 	if err != nil {
 		return nil, err
 	}
-	if identityProvider.Type != domain.IDPTypeSAML {
+	switch identityProvider.Type {
+	case domain.IDPTypeSAML:
+		return l.samlProvider(r.Context(), identityProvider)
+	case domain.IDPTypeOIDC:
+		return l.oidcProvider(r.Context(), identityProvider)
+	case domain.IDPTypeGitLabSelfHosted:
+		return l.gitlabSelfHostedProvider(r.Context(), identityProvider)
+	case domain.IDPTypeOkta:
+		return l.oktaProvider(r.Context(), identityProvider)
+	case domain.IDPTypeAuth0:
+		return l.auth0Provider(r.Context(), identityProvider)
+	case domain.IDPTypeKeycloak:
+		return l.keycloakProvider(r.Context(), identityProvider)
+	case domain.IDPTypeEntraExternalID:
+		return l.entraExternalIDProvider(r.Context(), identityProvider)
+	case domain.IDPTypeOAuth,
+		domain.IDPTypeJWT,
+		domain.IDPTypeAzureAD,
+		domain.IDPTypeGitHub,
+		domain.IDPTypeGitHubEnterprise,
+		domain.IDPTypeGitLab,
+		domain.IDPTypeGoogle,
+		domain.IDPTypeApple,
+		domain.IDPTypeLDAP,
+		domain.IDPTypeUnspecified:
+		fallthrough
+	default:
 		return nil, zerrors.ThrowInvalidArgument(nil, "LOGIN-ADK21", "Errors.ExternalIDP.IDPTypeNotImplemented")
 	}
-	return l.samlProvider(r.Context(), identityProvider)
+}
+
+// oidcLogoutRequest redirects the user to the end_session_endpoint of the provider.
+// The id_token of the provider is sent as id_token_hint, since some providers (e.g. Okta) require it.
+func (l *Login) oidcLogoutRequest(w http.ResponseWriter, r *http.Request, provider idp.LogoutProvider, idTokenHint, sessionID string) error {
+	logoutURL, err := provider.LogoutURL(idTokenHint, l.baseURL(r.Context())+EndpointExternalLogoutCallback, sessionID)
+	if err != nil {
+		return err
+	}
+	http.Redirect(w, r, logoutURL, http.StatusFound)
+	return nil
 }
 
 func samlLogoutRequest(w http.ResponseWriter, r *http.Request, provider *saml.Provider, nameID, sessionID string) error {
@@ -121,6 +121,7 @@ type orgViewProvider interface {
 type userGrantProvider interface {
 	ProjectByClientID(context.Context, string) (*query.Project, error)
 	UserGrantsByProjectAndUserID(context.Context, string, string) ([]*query.UserGrant, error)
+	UserGroupGrantsByProject(ctx context.Context, userID, projectID string) ([]*query.GroupGrant, error)
 }
 
 type projectProvider interface {
@@ -276,6 +277,7 @@ func (repo *AuthRequestRepo) CheckExternalUserLogin(ctx context.Context, authReq
 	if err != nil {
 		return err
 	}
+	request.SelectedIDPIDToken = externalUser.IDToken
 	err = repo.checkExternalUserLogin(ctx, request, externalUser.IDPConfigID, externalUser.ExternalUserID)
 	if zerrors.IsNotFound(err) {
 		// clear potential user information (e.g. when username was entered but another external user was returned)
@@ -1817,7 +1819,15 @@ func userGrantRequired(ctx context.Context, request *domain.AuthRequest, user *u
 	if err != nil {
 		return false, err
 	}
-	return len(grants) == 0, nil
+	if len(grants) > 0 {
+		return false, nil
+	}
+	// the user might be granted through the (nested) groups they are a member of
+	groupGrants, err := userGrantProvider.UserGroupGrantsByProject(ctx, user.ID, project.ID)
+	if err != nil {
+		return false, err
+	}
+	return len(groupGrants) == 0, nil
 }
 
 func projectRequired(ctx context.Context, request *domain.AuthRequest, projectProvider projectProvider) (missingGrant bool, err error) {
@@ -260,8 +260,9 @@ func (m *mockViewErrOrg) OrgByPrimaryDomain(context.Context, string) (*query.Org
 }
 
 type mockUserGrants struct {
-	roleCheck  bool
-	userGrants int
+	roleCheck   bool
+	userGrants  int
+	groupGrants int
 }
 
 func (m *mockUserGrants) ProjectByClientID(ctx context.Context, s string) (*query.Project, error) {
@@ -276,6 +277,14 @@ func (m *mockUserGrants) UserGrantsByProjectAndUserID(ctx context.Context, s str
 	return grants, nil
 }
 
+func (m *mockUserGrants) UserGroupGrantsByProject(ctx context.Context, userID, projectID string) ([]*query.GroupGrant, error) {
+	var grants []*query.GroupGrant
+	if m.groupGrants > 0 {
+		grants = make([]*query.GroupGrant, m.groupGrants)
+	}
+	return grants, nil
+}
+
 type mockProject struct {
 	hasProject    bool
 	projectCheck  bool
@@ -2110,6 +2119,48 @@ func TestAuthRequestRepo_nextSteps(t *testing.T) {
 			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
 			nil,
 		},
+		{
+			"prompt none, checkLoggedIn true, authenticated and required grants exist through (nested) groups, redirect to callback step",
+			fields{
+				userSessionViewProvider: &mockViewUserSession{
+					PasswordVerification:     testNow.Add(-5 * time.Minute),
+					SecondFactorVerification: testNow.Add(-5 * time.Minute),
+				},
+				userViewProvider: &mockViewUser{
+					PasswordSet:     true,
+					IsEmailVerified: true,
+					MFAMaxSetUp:     int32(domain.MFALevelSecondFactor),
+				},
+				userEventProvider: &mockEventUser{},
+				orgViewProvider:   &mockViewOrg{State: domain.OrgStateActive},
+				userGrantProvider: &mockUserGrants{
+					roleCheck:   true,
+					userGrants:  0,
+					groupGrants: 1,
+				},
+				projectProvider:     &mockProject{},
+				applicationProvider: &mockApp{app: &query.App{OIDCConfig: &query.OIDCApp{AppType: domain.OIDCApplicationTypeWeb}}},
+				lockoutPolicyProvider: &mockLockoutPolicy{
+					policy: &query.LockoutPolicy{
+						ShowFailures: true,
+					},
+				},
+				idpUserLinksProvider: &mockIDPUserLinks{},
+			},
+			args{&domain.AuthRequest{
+				UserID:  "UserID",
+				Prompt:  []domain.Prompt{domain.PromptNone},
+				Request: &domain.AuthRequestOIDC{},
+				LoginPolicy: &domain.LoginPolicy{
+					AllowUsernamePassword:     true,
+					SecondFactors:             []domain.SecondFactorType{domain.SecondFactorTypeTOTP},
+					PasswordCheckLifetime:     10 * 24 * time.Hour,
+					SecondFactorCheckLifetime: 18 * time.Hour,
+				},
+			}, true},
+			[]domain.NextStep{&domain.RedirectToCallbackStep{}},
+			nil,
+		},
 		{
 			"prompt none, checkLoggedIn true, authenticated and required project missing, project required step",
 			fields{
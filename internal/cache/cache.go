@@ -19,6 +19,13 @@ const (
 	PurposeOrganization
 	PurposeIdPFormCallback
 	PurposeFederatedLogout
+	PurposeOIDCClients
+	PurposeSAMLServiceProviders
+	PurposeLoginPolicy
+	PurposeLabelPolicy
+	PurposeUser
+	PurposeTokenReplay
+	PurposeBackChannelAuthPoll
 )
 
 // Cache stores objects with a value of type `V`.
@@ -70,6 +77,32 @@ type Cache[I, K comparable, V Entry[I, K]] interface {
 	Truncate(ctx context.Context) error
 }
 
+// Inspector is implemented by caches which can list the keys of an index.
+// Implementations return [errors.ErrUnsupported] if listing is not possible with the current setup.
+type Inspector[I comparable] interface {
+	// KeysWithPrefix returns all keys of the index which start with prefix.
+	// Keys of objects which are no longer valid may be returned until they are pruned.
+	// An [IndexUnknownError] may be returned if the index is unknown.
+	KeysWithPrefix(ctx context.Context, index I, prefix string) ([]string, error)
+}
+
+// Sizer is implemented by caches which can count their objects.
+// Implementations return [errors.ErrUnsupported] if counting is not possible with the current setup.
+type Sizer interface {
+	// Size returns the amount of stored objects.
+	// Objects which are no longer valid may be counted until they are pruned.
+	Size(ctx context.Context) (int64, error)
+}
+
+// Adder is implemented by caches which can atomically set an object only if its keys are not in use yet.
+// Implementations return [errors.ErrUnsupported] if adding is not possible with the current setup.
+type Adder[V any] interface {
+	// Add sets the object, unless one of its keys already maps to an object.
+	// It reports whether the object was added.
+	// Keys of objects which are no longer valid may count as in use until they are pruned.
+	Add(ctx context.Context, value V) (added bool, err error)
+}
+
 // Entry contains a value of type `V` to be cached.
 //
 // `I` is the type by which indices are identified,
@@ -110,4 +143,24 @@ type Config struct {
 	// Log allows logging of the specific cache.
 	// By default only errors are logged to stdout.
 	Log *logging.Config
+
+	// Local enables an in-memory layer in front of the Postgres or Redis connector.
+	// Invalidate, Delete and Truncate are broadcasted to the in-memory layers
+	// of all ZITADEL instances through the connector.
+	// nil disables the in-memory layer.
+	Local *LocalConfig
+}
+
+// LocalConfig configures the in-memory layer of a cache.
+type LocalConfig struct {
+	// Age since an object was added to the in-memory layer,
+	// after which the object is considered invalid.
+	// Limits the time an object may be stale in case a broadcast was missed.
+	// 0 disables max age checks.
+	MaxAge time.Duration
+
+	// Age since last use (Get) of an object in the in-memory layer,
+	// after which the object is considered invalid.
+	// 0 disables last use age checks.
+	LastUseAge time.Duration
 }
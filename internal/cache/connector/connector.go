@@ -10,6 +10,7 @@ import (
 	"github.com/zitadel/zitadel/internal/cache/connector/noop"
 	"github.com/zitadel/zitadel/internal/cache/connector/pg"
 	"github.com/zitadel/zitadel/internal/cache/connector/redis"
+	"github.com/zitadel/zitadel/internal/cache/connector/tiered"
 	"github.com/zitadel/zitadel/internal/database"
 )
 
@@ -19,11 +20,18 @@ type CachesConfig struct {
 		Postgres pg.Config
 		Redis    redis.Config
 	}
-	Instance         *cache.Config
-	Milestones       *cache.Config
-	Organization     *cache.Config
-	IdPFormCallbacks *cache.Config
-	FederatedLogouts *cache.Config
+	Instance             *cache.Config
+	Milestones           *cache.Config
+	Organization         *cache.Config
+	IdPFormCallbacks     *cache.Config
+	FederatedLogouts     *cache.Config
+	OIDCClients          *cache.Config
+	SAMLServiceProviders *cache.Config
+	LoginPolicies        *cache.Config
+	LabelPolicies        *cache.Config
+	Users                *cache.Config
+	TokenReplays         *cache.Config
+	BackChannelAuthPolls *cache.Config
 }
 
 type Connectors struct {
@@ -31,6 +39,8 @@ type Connectors struct {
 	Memory   *gomap.Connector
 	Postgres *pg.Connector
 	Redis    *redis.Connector
+	// Registry keeps track of the started caches.
+	Registry *Registry
 }
 
 func StartConnectors(conf *CachesConfig, client *database.DB) (Connectors, error) {
@@ -42,13 +52,31 @@ func StartConnectors(conf *CachesConfig, client *database.DB) (Connectors, error
 		Memory:   gomap.NewConnector(conf.Connectors.Memory),
 		Postgres: pg.NewConnector(conf.Connectors.Postgres, client),
 		Redis:    redis.NewConnector(conf.Connectors.Redis),
+		Registry: NewRegistry(),
 	}, nil
 }
 
-func StartCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Context, indices []I, purpose cache.Purpose, conf *cache.Config, connectors Connectors) (cache.Cache[I, K, V], error) {
+// StartCache starts a cache for the purpose using the configured connector.
+// The cache is instrumented with metrics and registered in the [Registry] of the connectors.
+// A noop cache is returned if the cache is not configured.
+func StartCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Context, indices []I, purpose cache.Purpose, conf *cache.Config, connectors Connectors, opts ...CacheOption[I]) (cache.Cache[I, K, V], error) {
 	if conf == nil || conf.Connector == cache.ConnectorUnspecified {
 		return noop.NewCache[I, K, V](), nil
 	}
+	c, err := startCache[I, K, V](background, indices, purpose, conf, connectors)
+	if err != nil {
+		return nil, err
+	}
+	var options cacheOptions[I]
+	for _, opt := range opts {
+		opt(&options)
+	}
+	instrumented := newInstrumentedCache(c, purpose, conf.Connector, options)
+	connectors.Registry.register(instrumented)
+	return instrumented, nil
+}
+
+func startCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Context, indices []I, purpose cache.Purpose, conf *cache.Config, connectors Connectors) (cache.Cache[I, K, V], error) {
 	if conf.Connector == cache.ConnectorMemory && connectors.Memory != nil {
 		c := gomap.NewCache[I, K, V](background, indices, *conf)
 		connectors.Memory.Config.StartAutoPrune(background, c, purpose)
@@ -60,13 +88,35 @@ func StartCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Conte
 			return nil, fmt.Errorf("start cache: %w", err)
 		}
 		connectors.Postgres.Config.AutoPrune.StartAutoPrune(background, c, purpose)
-		return c, nil
+		return startLocalCache(background, indices, purpose, conf, connectors, c, connectors.Postgres)
 	}
 	if conf.Connector == cache.ConnectorRedis && connectors.Redis != nil {
 		db := connectors.Redis.Config.DBOffset + int(purpose)
 		c := redis.NewCache[I, K, V](*conf, connectors.Redis, db, indices)
-		return c, nil
+		return startLocalCache(background, indices, purpose, conf, connectors, c, connectors.Redis)
 	}
 
 	return nil, fmt.Errorf("cache connector %q not enabled", conf.Connector)
 }
+
+// startLocalCache puts an in-memory cache in front of the shared cache, if configured.
+func startLocalCache[I ~int, K ~string, V cache.Entry[I, K]](background context.Context, indices []I, purpose cache.Purpose, conf *cache.Config, connectors Connectors, shared cache.Cache[I, K, V], broadcaster tiered.Broadcaster) (cache.Cache[I, K, V], error) {
+	if conf.Local == nil {
+		return shared, nil
+	}
+	if connectors.Memory == nil {
+		return nil, fmt.Errorf("local cache of %q requires the memory connector", purpose)
+	}
+	localConf := *conf
+	localConf.Connector = cache.ConnectorMemory
+	localConf.MaxAge = conf.Local.MaxAge
+	localConf.LastUseAge = conf.Local.LastUseAge
+	local := gomap.NewCache[I, K, V](background, indices, localConf)
+	connectors.Memory.Config.StartAutoPrune(background, local, purpose)
+
+	c, err := tiered.NewCache[I, K, V](background, purpose, *conf, local, shared, broadcaster)
+	if err != nil {
+		return nil, fmt.Errorf("start local cache: %w", err)
+	}
+	return c, nil
+}
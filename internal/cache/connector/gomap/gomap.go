@@ -3,9 +3,11 @@ package gomap
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"maps"
 	"os"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -17,6 +19,8 @@ type mapCache[I, K comparable, V cache.Entry[I, K]] struct {
 	config   *cache.Config
 	indexMap map[I]*index[K, V]
 	logger   *slog.Logger
+	// addMutex serializes the check and set of [mapCache.Add].
+	addMutex sync.Mutex
 }
 
 // NewCache returns an in-memory Cache implementation based on the builtin go map type.
@@ -78,6 +82,23 @@ func (c *mapCache[I, K, V]) Set(ctx context.Context, value V) {
 	}
 }
 
+// Add sets the object, unless one of its keys maps to a valid object.
+// The indices are locked while checking and setting the keys, so concurrent adds of the same key can't both succeed.
+func (c *mapCache[I, K, V]) Add(ctx context.Context, value V) (bool, error) {
+	c.addMutex.Lock()
+	defer c.addMutex.Unlock()
+	for name, i := range c.indexMap {
+		for _, key := range value.Keys(name) {
+			if _, err := i.Get(key); err == nil {
+				c.logger.DebugContext(ctx, "map cache add", "index", name, "key", key, "added", false)
+				return false, nil
+			}
+		}
+	}
+	c.Set(ctx, value)
+	return true, nil
+}
+
 func (c *mapCache[I, K, V]) Invalidate(ctx context.Context, index I, keys ...K) error {
 	i, ok := c.indexMap[index]
 	if !ok {
@@ -98,6 +119,25 @@ func (c *mapCache[I, K, V]) Delete(ctx context.Context, index I, keys ...K) erro
 	return nil
 }
 
+func (c *mapCache[I, K, V]) KeysWithPrefix(ctx context.Context, index I, prefix string) ([]string, error) {
+	i, ok := c.indexMap[index]
+	if !ok {
+		return nil, cache.NewIndexUnknownErr(index)
+	}
+	keys := i.KeysWithPrefix(prefix)
+	c.logger.DebugContext(ctx, "map cache keys with prefix", "index", index, "prefix", prefix, "count", len(keys))
+	return keys, nil
+}
+
+func (c *mapCache[I, K, V]) Size(ctx context.Context) (int64, error) {
+	// objects are referenced by multiple indices and keys
+	objects := make(map[*entry[V]]struct{})
+	for _, index := range c.indexMap {
+		index.collect(objects)
+	}
+	return int64(len(objects)), nil
+}
+
 func (c *mapCache[I, K, V]) Prune(ctx context.Context) error {
 	for name, index := range c.indexMap {
 		index.Prune()
@@ -156,6 +196,26 @@ func (c *index[K, V]) Delete(keys []K) {
 	c.mutex.Unlock()
 }
 
+func (i *index[K, V]) KeysWithPrefix(prefix string) []string {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+	var keys []string
+	for key := range i.entries {
+		if k := fmt.Sprint(key); strings.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+func (i *index[K, V]) collect(objects map[*entry[V]]struct{}) {
+	i.mutex.RLock()
+	for _, entry := range i.entries {
+		objects[entry] = struct{}{}
+	}
+	i.mutex.RUnlock()
+}
+
 func (c *index[K, V]) Prune() {
 	c.mutex.Lock()
 	maps.DeleteFunc(c.entries, func(_ K, entry *entry[V]) bool {
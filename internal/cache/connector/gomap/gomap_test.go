@@ -102,6 +102,38 @@ func Test_mapCache_Get(t *testing.T) {
 	}
 }
 
+func Test_mapCache_Add(t *testing.T) {
+	c := NewCache[testIndex, string, *testObject](context.Background(), testIndices, cache.Config{
+		Log: &logging.Config{
+			Level:     "debug",
+			AddSource: true,
+		},
+	})
+	adder := c.(cache.Adder[*testObject])
+	obj := &testObject{
+		id:    "id",
+		names: []string{"foo", "bar"},
+	}
+	added, err := adder.Add(context.Background(), obj)
+	require.NoError(t, err)
+	assert.True(t, added)
+	got, ok := c.Get(context.Background(), testIndexName, "bar")
+	assert.True(t, ok)
+	assert.Equal(t, obj, got)
+
+	added, err = adder.Add(context.Background(), &testObject{id: "other", names: []string{"bar"}})
+	require.NoError(t, err)
+	assert.False(t, added)
+	_, ok = c.Get(context.Background(), testIndexID, "other")
+	assert.False(t, ok)
+
+	// keys of invalidated objects may be reused
+	require.NoError(t, c.Invalidate(context.Background(), testIndexID, "id"))
+	added, err = adder.Add(context.Background(), &testObject{id: "other", names: []string{"bar"}})
+	require.NoError(t, err)
+	assert.True(t, added)
+}
+
 func Test_mapCache_Invalidate(t *testing.T) {
 	c := NewCache[testIndex, string, *testObject](context.Background(), testIndices, cache.Config{
 		MaxAge:     time.Second,
@@ -226,6 +258,57 @@ func Test_mapCache_Truncate(t *testing.T) {
 	}
 }
 
+func Test_mapCache_KeysWithPrefix(t *testing.T) {
+	c := NewCache[testIndex, string, *testObject](context.Background(), testIndices, cache.Config{
+		Log: &logging.Config{
+			Level:     "debug",
+			AddSource: true,
+		},
+	})
+	c.Set(context.Background(), &testObject{
+		id:    "instance1-id1",
+		names: []string{"foo"},
+	})
+	c.Set(context.Background(), &testObject{
+		id:    "instance1-id2",
+		names: []string{"bar"},
+	})
+	c.Set(context.Background(), &testObject{
+		id:    "instance2-id1",
+		names: []string{"hello"},
+	})
+
+	mc := c.(*mapCache[testIndex, string, *testObject])
+	keys, err := mc.KeysWithPrefix(context.Background(), testIndexID, "instance1-")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"instance1-id1", "instance1-id2"}, keys)
+
+	_, err = mc.KeysWithPrefix(context.Background(), 99, "instance1-")
+	assert.ErrorIs(t, err, cache.NewIndexUnknownErr(testIndex(99)))
+}
+
+func Test_mapCache_Size(t *testing.T) {
+	c := NewCache[testIndex, string, *testObject](context.Background(), testIndices, cache.Config{
+		Log: &logging.Config{
+			Level:     "debug",
+			AddSource: true,
+		},
+	})
+	c.Set(context.Background(), &testObject{
+		id:    "id1",
+		names: []string{"foo", "bar"},
+	})
+	c.Set(context.Background(), &testObject{
+		id:    "id2",
+		names: []string{"hello"},
+	})
+
+	mc := c.(*mapCache[testIndex, string, *testObject])
+	size, err := mc.Size(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, size)
+}
+
 func Test_entry_isValid(t *testing.T) {
 	type fields struct {
 		created time.Time
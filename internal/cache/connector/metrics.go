@@ -0,0 +1,182 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/zitadel/logging"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+)
+
+const (
+	PurposeLabel   = "purpose"
+	ConnectorLabel = "connector"
+	OperationLabel = "operation"
+
+	CacheHitsMetric      = "cache_hits"
+	CacheMissesMetric    = "cache_misses"
+	CacheEvictionsMetric = "cache_evictions"
+	CacheSizeMetric      = "cache_size"
+
+	operationInvalidate = "invalidate"
+	operationDelete     = "delete"
+	operationTruncate   = "truncate"
+)
+
+// registerMetrics registers the cache metrics.
+// The size of the caches is observed through the registry.
+func registerMetrics(registry *Registry) {
+	err := metrics.RegisterCounter(CacheHitsMetric, "Number of objects found in the cache")
+	logging.OnError(err).Error("failed to register cache hits counter")
+	err = metrics.RegisterCounter(CacheMissesMetric, "Number of objects not found in the cache")
+	logging.OnError(err).Error("failed to register cache misses counter")
+	err = metrics.RegisterCounter(CacheEvictionsMetric, "Number of keys invalidated or deleted and number of truncates of the cache")
+	logging.OnError(err).Error("failed to register cache evictions counter")
+	err = metrics.RegisterValueObserver(CacheSizeMetric, "Number of objects stored in the cache, for connectors which can count them", registry.observeSize)
+	logging.OnError(err).Error("failed to register cache size observer")
+}
+
+// instrumentedCache counts the hits, misses and evictions of a cache
+// and allows inspection and invalidation of a single instance's objects.
+type instrumentedCache[I ~int, K ~string, V cache.Entry[I, K]] struct {
+	cache.Cache[I, K, V]
+	purpose   cache.Purpose
+	connector cache.Connector
+	options   cacheOptions[I]
+}
+
+func newInstrumentedCache[I ~int, K ~string, V cache.Entry[I, K]](c cache.Cache[I, K, V], purpose cache.Purpose, connector cache.Connector, options cacheOptions[I]) *instrumentedCache[I, K, V] {
+	return &instrumentedCache[I, K, V]{
+		Cache:     c,
+		purpose:   purpose,
+		connector: connector,
+		options:   options,
+	}
+}
+
+func (c *instrumentedCache[I, K, V]) Get(ctx context.Context, index I, key K) (value V, ok bool) {
+	value, ok = c.Cache.Get(ctx, index, key)
+	if ok {
+		c.count(ctx, CacheHitsMetric, 1, "")
+	} else {
+		c.count(ctx, CacheMissesMetric, 1, "")
+	}
+	return value, ok
+}
+
+func (c *instrumentedCache[I, K, V]) Invalidate(ctx context.Context, index I, keys ...K) error {
+	c.count(ctx, CacheEvictionsMetric, int64(len(keys)), operationInvalidate)
+	return c.Cache.Invalidate(ctx, index, keys...)
+}
+
+func (c *instrumentedCache[I, K, V]) Delete(ctx context.Context, index I, keys ...K) error {
+	c.count(ctx, CacheEvictionsMetric, int64(len(keys)), operationDelete)
+	return c.Cache.Delete(ctx, index, keys...)
+}
+
+func (c *instrumentedCache[I, K, V]) Truncate(ctx context.Context) error {
+	c.count(ctx, CacheEvictionsMetric, 1, operationTruncate)
+	return c.Cache.Truncate(ctx)
+}
+
+func (c *instrumentedCache[I, K, V]) count(ctx context.Context, name string, value int64, operation string) {
+	if value == 0 {
+		return
+	}
+	labels := map[string]attribute.Value{
+		PurposeLabel:   attribute.StringValue(c.purpose.String()),
+		ConnectorLabel: attribute.StringValue(c.connector.String()),
+	}
+	if operation != "" {
+		labels[OperationLabel] = attribute.StringValue(operation)
+	}
+	err := metrics.AddCount(ctx, name, value, labels)
+	logging.OnError(err).WithField("metric", name).Debug("failed to count cache metric")
+}
+
+// Add implements [cache.Adder], if the underlying cache does.
+func (c *instrumentedCache[I, K, V]) Add(ctx context.Context, value V) (bool, error) {
+	adder, ok := c.Cache.(cache.Adder[V])
+	if !ok {
+		return false, errors.ErrUnsupported
+	}
+	return adder.Add(ctx, value)
+}
+
+// Purpose implements [RegisteredCache].
+func (c *instrumentedCache[I, K, V]) Purpose() cache.Purpose {
+	return c.purpose
+}
+
+// Connector implements [RegisteredCache].
+func (c *instrumentedCache[I, K, V]) Connector() cache.Connector {
+	return c.connector
+}
+
+// Size implements [RegisteredCache].
+func (c *instrumentedCache[I, K, V]) Size(ctx context.Context) (int64, error) {
+	sizer, ok := c.Cache.(cache.Sizer)
+	if !ok {
+		return 0, errors.ErrUnsupported
+	}
+	return sizer.Size(ctx)
+}
+
+// InstanceKeys implements [RegisteredCache].
+func (c *instrumentedCache[I, K, V]) InstanceKeys(ctx context.Context, instanceID string) ([]string, error) {
+	if c.options.instanceIndex == nil {
+		return nil, errors.ErrUnsupported
+	}
+	inspector, ok := c.Cache.(cache.Inspector[I])
+	if !ok {
+		return nil, errors.ErrUnsupported
+	}
+	keys, err := inspector.KeysWithPrefix(ctx, *c.options.instanceIndex, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	// the prefix also matches the keys of instances with a longer ID
+	instanceKeys := keys[:0]
+	for _, key := range keys {
+		if key == instanceID || strings.HasPrefix(key, instanceID+InstanceKeySeparator) {
+			instanceKeys = append(instanceKeys, key)
+		}
+	}
+	return instanceKeys, nil
+}
+
+// InvalidateInstance implements [RegisteredCache].
+func (c *instrumentedCache[I, K, V]) InvalidateInstance(ctx context.Context, instanceID string) (int, error) {
+	keys, err := c.InstanceKeys(ctx, instanceID)
+	if err != nil {
+		return 0, err
+	}
+	cacheKeys := make([]K, len(keys))
+	for i, key := range keys {
+		cacheKeys[i] = K(key)
+	}
+	return len(keys), c.Invalidate(ctx, *c.options.instanceIndex, cacheKeys...)
+}
+
+func (r *Registry) observeSize(ctx context.Context, observer metric.Int64Observer) error {
+	for _, c := range r.Caches() {
+		size, err := c.Size(ctx)
+		if errors.Is(err, errors.ErrUnsupported) {
+			continue
+		}
+		if err != nil {
+			logging.WithError(err).WithField("purpose", c.Purpose()).Warn("failed to observe cache size")
+			continue
+		}
+		observer.Observe(size, metric.WithAttributes(
+			attribute.String(PurposeLabel, c.Purpose().String()),
+			attribute.String(ConnectorLabel, c.Connector().String()),
+		))
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package connector
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/noop"
+	"github.com/zitadel/zitadel/internal/telemetry/metrics"
+)
+
+type testIndex int
+
+const (
+	testIndexID testIndex = iota
+	testIndexName
+)
+
+var testIndices = []testIndex{
+	testIndexID,
+	testIndexName,
+}
+
+type testObject struct {
+	ID   string
+	Name []string
+}
+
+func (o *testObject) Keys(index testIndex) []string {
+	switch index {
+	case testIndexID:
+		return []string{o.ID}
+	case testIndexName:
+		return o.Name
+	default:
+		return nil
+	}
+}
+
+func prepareConnectors(t *testing.T) Connectors {
+	conf := new(CachesConfig)
+	conf.Connectors.Memory.Enabled = true
+	connectors, err := StartConnectors(conf, nil)
+	require.NoError(t, err)
+	return connectors
+}
+
+func Test_instrumentedCache_metrics(t *testing.T) {
+	mockMetrics := metrics.NewMockMetrics()
+	metrics.M = mockMetrics
+	ctx := context.Background()
+	connectors := prepareConnectors(t)
+
+	c, err := StartCache[testIndex, string, *testObject](ctx, testIndices, cache.PurposeOrganization, &cache.Config{Connector: cache.ConnectorMemory}, connectors)
+	require.NoError(t, err)
+
+	c.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	_, ok := c.Get(ctx, testIndexID, "id1")
+	require.True(t, ok)
+	_, ok = c.Get(ctx, testIndexID, "id2")
+	require.False(t, ok)
+	require.NoError(t, c.Invalidate(ctx, testIndexID, "id1", "id2"))
+	require.NoError(t, c.Truncate(ctx))
+
+	assert.EqualValues(t, 1, mockMetrics.GetCounterValue(CacheHitsMetric))
+	assert.EqualValues(t, 1, mockMetrics.GetCounterValue(CacheMissesMetric))
+	assert.EqualValues(t, 3, mockMetrics.GetCounterValue(CacheEvictionsMetric))
+	assert.Equal(t, []map[string]attribute.Value{
+		{
+			PurposeLabel:   attribute.StringValue("organization"),
+			ConnectorLabel: attribute.StringValue("memory"),
+		},
+	}, mockMetrics.GetCounterLabels(CacheHitsMetric))
+	assert.Equal(t, attribute.StringValue(operationTruncate), mockMetrics.GetCounterLabels(CacheEvictionsMetric)[1][OperationLabel])
+}
+
+func TestRegistry(t *testing.T) {
+	ctx := context.Background()
+	connectors := prepareConnectors(t)
+
+	_, err := StartCache[testIndex, string, *testObject](ctx, testIndices, cache.PurposeMilestones, nil, connectors)
+	require.NoError(t, err)
+	_, ok := connectors.Registry.Cache(cache.PurposeMilestones)
+	assert.False(t, ok, "unconfigured caches are not registered")
+
+	withIndex, err := StartCache[testIndex, string, *testObject](ctx, testIndices, cache.PurposeOrganization, &cache.Config{Connector: cache.ConnectorMemory}, connectors, WithInstanceIndex(testIndexID))
+	require.NoError(t, err)
+	_, err = StartCache[testIndex, string, *testObject](ctx, testIndices, cache.PurposeAuthzInstance, &cache.Config{Connector: cache.ConnectorMemory}, connectors)
+	require.NoError(t, err)
+
+	caches := connectors.Registry.Caches()
+	require.Len(t, caches, 2)
+	assert.Equal(t, cache.PurposeAuthzInstance, caches[0].Purpose())
+	assert.Equal(t, cache.PurposeOrganization, caches[1].Purpose())
+
+	_, err = caches[0].InstanceKeys(ctx, "instance1")
+	assert.ErrorIs(t, err, errors.ErrUnsupported)
+
+	withIndex.Set(ctx, &testObject{ID: "instance1", Name: []string{"foo"}})
+	withIndex.Set(ctx, &testObject{ID: "instance1-id1", Name: []string{"bar"}})
+	withIndex.Set(ctx, &testObject{ID: "instance10-id1", Name: []string{"baz"}})
+	registered := caches[1]
+
+	size, err := registered.Size(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+
+	keys, err := registered.InstanceKeys(ctx, "instance1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"instance1", "instance1-id1"}, keys)
+
+	invalidated, err := registered.InvalidateInstance(ctx, "instance1")
+	require.NoError(t, err)
+	assert.Equal(t, 2, invalidated)
+	_, ok = withIndex.Get(ctx, testIndexName, "bar")
+	assert.False(t, ok)
+	_, ok = withIndex.Get(ctx, testIndexName, "baz")
+	assert.True(t, ok)
+}
+
+func TestRegistry_nil(t *testing.T) {
+	var registry *Registry
+	registry.register(newInstrumentedCache(noop.NewCache[testIndex, string, *testObject](), cache.PurposeOrganization, cache.ConnectorMemory, cacheOptions[testIndex]{}))
+	assert.Empty(t, registry.Caches())
+	_, ok := registry.Cache(cache.PurposeOrganization)
+	assert.False(t, ok)
+}
@@ -15,6 +15,7 @@ func NewCache[I, K comparable, V cache.Entry[I, K]]() cache.Cache[I, K, V] {
 
 func (noop[I, K, V]) Set(context.Context, V)                          {}
 func (noop[I, K, V]) Get(context.Context, I, K) (value V, ok bool)    { return }
+func (noop[I, K, V]) Add(context.Context, V) (bool, error)            { return true, nil }
 func (noop[I, K, V]) Invalidate(context.Context, I, ...K) (err error) { return }
 func (noop[I, K, V]) Delete(context.Context, I, ...K) (err error)     { return }
 func (noop[I, K, V]) Prune(context.Context) (err error)               { return }
@@ -0,0 +1,86 @@
+package pg
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/zitadel/logging"
+)
+
+// listenRetryInterval is the wait time between attempts to restore a lost LISTEN connection.
+const listenRetryInterval = time.Second
+
+// ListenPool is implemented by [pgxpool.Pool].
+type ListenPool interface {
+	Acquire(ctx context.Context) (*pgxpool.Conn, error)
+}
+
+// Broadcast sends the message as notification on the channel.
+func (c *Connector) Broadcast(ctx context.Context, channel string, message []byte) error {
+	_, err := c.Exec(ctx, "SELECT pg_notify($1, $2)", channel, string(message))
+	return err
+}
+
+// Listen listens on the channel using a dedicated connection
+// and calls handle for the payload of each notification, until ctx is done.
+// A lost connection is restored, in which case reset is called as notifications might have been missed.
+// LISTEN is not supported by CockroachDB.
+func (c *Connector) Listen(ctx context.Context, channel string, handle func(message []byte), reset func()) error {
+	conn, err := c.listen(ctx, channel)
+	if err != nil {
+		return err
+	}
+	go c.receive(ctx, conn, channel, handle, reset)
+	return nil
+}
+
+func (c *Connector) listen(ctx context.Context, channel string) (*pgx.Conn, error) {
+	poolConn, err := c.listenPool.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the connection stays in LISTEN state and must not be returned to the pool.
+	conn := poolConn.Hijack()
+	if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+		conn.Close(context.Background()) //nolint:errcheck
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (c *Connector) receive(ctx context.Context, conn *pgx.Conn, channel string, handle func(message []byte), reset func()) {
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err == nil {
+			handle([]byte(notification.Payload))
+			continue
+		}
+		conn.Close(context.Background()) //nolint:errcheck
+		if ctx.Err() != nil {
+			return
+		}
+		logging.WithError(err).WithField("channel", channel).Warn("cache listener connection lost")
+		if conn = c.relisten(ctx, channel); conn == nil {
+			return
+		}
+		reset()
+	}
+}
+
+// relisten retries to listen on the channel until it succeeds or ctx is done.
+func (c *Connector) relisten(ctx context.Context, channel string) *pgx.Conn {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(listenRetryInterval):
+		}
+		conn, err := c.listen(ctx, channel)
+		if err == nil {
+			return conn
+		}
+		logging.WithError(err).WithField("channel", channel).Warn("cache listener reconnect")
+	}
+}
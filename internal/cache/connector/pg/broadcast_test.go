@@ -0,0 +1,26 @@
+package pg
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnector_Broadcast(t *testing.T) {
+	pool, err := pgxmock.NewPool()
+	require.NoError(t, err)
+	pool.ExpectExec(regexp.QuoteMeta("SELECT pg_notify($1, $2)")).
+		WithArgs("channel", "message").
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	connector := &Connector{
+		PGXPool: pool,
+	}
+
+	err = connector.Broadcast(context.Background(), "channel", []byte("message"))
+	require.NoError(t, err)
+	assert.NoError(t, pool.ExpectationsWereMet())
+}
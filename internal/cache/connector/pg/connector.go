@@ -13,6 +13,8 @@ type Config struct {
 type Connector struct {
 	PGXPool
 	Config Config
+	// listenPool acquires the dedicated connections used to LISTEN for notifications.
+	listenPool ListenPool
 }
 
 func NewConnector(config Config, client *database.DB) *Connector {
@@ -20,7 +22,8 @@ func NewConnector(config Config, client *database.DB) *Connector {
 		return nil
 	}
 	return &Connector{
-		PGXPool: client.Pool,
-		Config:  config,
+		PGXPool:    client.Pool,
+		Config:     config,
+		listenPool: client.Pool,
 	}
 }
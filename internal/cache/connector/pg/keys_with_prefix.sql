@@ -0,0 +1,6 @@
+select array_agg(index_key)
+from cache.string_keys
+where cache_name = $1
+	and index_id = $2
+	and left(index_key, length($3)) = $3
+;
@@ -22,6 +22,8 @@ var (
 	createPartitionTmpl  = template.Must(template.New("create_partition").Parse(createPartitionQuery))
 	//go:embed set.sql
 	setQuery string
+	//go:embed add.sql
+	addQuery string
 	//go:embed get.sql
 	getQuery string
 	//go:embed invalidate.sql
@@ -32,6 +34,10 @@ var (
 	pruneQuery string
 	//go:embed truncate.sql
 	truncateQuery string
+	//go:embed keys_with_prefix.sql
+	keysWithPrefixQuery string
+	//go:embed size.sql
+	sizeQuery string
 )
 
 type PGXPool interface {
@@ -93,6 +99,24 @@ func (c *pgCache[I, K, V]) set(ctx context.Context, entry V) (err error) {
 	return nil
 }
 
+// Add inserts the keys of the object, keys which exist already keep their object.
+// It reports whether all keys were inserted.
+// If none was inserted, the object is removed on the next prune.
+func (c *pgCache[I, K, V]) Add(ctx context.Context, entry V) (added bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	keys := c.indexKeysFromEntry(entry)
+	tag, err := c.connector.Exec(ctx, addQuery, c.purpose.String(), keys, entry)
+	if err != nil {
+		c.logger.ErrorContext(ctx, "pg cache add", "err", err)
+		return false, err
+	}
+	added = tag.RowsAffected() == int64(len(keys))
+	c.logger.DebugContext(ctx, "pg cache add", "index_key", keys, "added", added)
+	return added, nil
+}
+
 func (c *pgCache[I, K, V]) Get(ctx context.Context, index I, key K) (value V, ok bool) {
 	value, err := c.get(ctx, index, key)
 	if err == nil {
@@ -155,6 +179,26 @@ func (c *pgCache[I, K, V]) Truncate(ctx context.Context) (err error) {
 	return err
 }
 
+func (c *pgCache[I, K, V]) KeysWithPrefix(ctx context.Context, index I, prefix string) (keys []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !slices.Contains(c.indices, index) {
+		return nil, cache.NewIndexUnknownErr(index)
+	}
+	err = c.connector.QueryRow(ctx, keysWithPrefixQuery, c.purpose.String(), index, prefix).Scan(&keys)
+	c.logger.DebugContext(ctx, "pg cache keys with prefix", "index", index, "prefix", prefix, "count", len(keys))
+	return keys, err
+}
+
+func (c *pgCache[I, K, V]) Size(ctx context.Context) (size int64, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	err = c.connector.QueryRow(ctx, sizeQuery, c.purpose.String()).Scan(&size)
+	return size, err
+}
+
 type indexKey[I, K comparable] struct {
 	IndexID  I `json:"index_id"`
 	IndexKey K `json:"index_key"`
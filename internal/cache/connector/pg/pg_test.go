@@ -168,6 +168,75 @@ func Test_pgCache_Set(t *testing.T) {
 	}
 }
 
+func Test_pgCache_Add(t *testing.T) {
+	queryExpect := regexp.QuoteMeta(addQuery)
+	entry := &testObject{
+		ID:   "id1",
+		Name: []string{"foo"},
+	}
+	keys := []indexKey[testIndex, string]{
+		{IndexID: testIndexID, IndexKey: "id1"},
+		{IndexID: testIndexName, IndexKey: "foo"},
+	}
+	tests := []struct {
+		name      string
+		expect    func(pgxmock.PgxCommonIface)
+		wantAdded bool
+		wantErr   error
+	}{
+		{
+			name: "error",
+			expect: func(ppi pgxmock.PgxCommonIface) {
+				ppi.ExpectExec(queryExpect).
+					WithArgs(cachePurpose.String(), keys, entry).
+					WillReturnError(pgx.ErrTxClosed)
+			},
+			wantErr: pgx.ErrTxClosed,
+		},
+		{
+			name: "keys exist",
+			expect: func(ppi pgxmock.PgxCommonIface) {
+				ppi.ExpectExec(queryExpect).
+					WithArgs(cachePurpose.String(), keys, entry).
+					WillReturnResult(pgxmock.NewResult("INSERT", 0))
+			},
+			wantAdded: false,
+		},
+		{
+			name: "some keys exist",
+			expect: func(ppi pgxmock.PgxCommonIface) {
+				ppi.ExpectExec(queryExpect).
+					WithArgs(cachePurpose.String(), keys, entry).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+			},
+			wantAdded: false,
+		},
+		{
+			name: "added",
+			expect: func(ppi pgxmock.PgxCommonIface) {
+				ppi.ExpectExec(queryExpect).
+					WithArgs(cachePurpose.String(), keys, entry).
+					WillReturnResult(pgxmock.NewResult("INSERT", 2))
+			},
+			wantAdded: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, pool := prepareCache(t, cache.Config{})
+			defer pool.Close()
+			tt.expect(pool)
+
+			added, err := c.(cache.Adder[*testObject]).Add(context.Background(), entry)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantAdded, added)
+
+			err = pool.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func Test_pgCache_Get(t *testing.T) {
 	queryExpect := regexp.QuoteMeta(getQuery)
 	type args struct {
@@ -493,6 +562,73 @@ func Test_pgCache_Truncate(t *testing.T) {
 	}
 }
 
+func Test_pgCache_KeysWithPrefix(t *testing.T) {
+	queryExpect := regexp.QuoteMeta(keysWithPrefixQuery)
+	tests := []struct {
+		name    string
+		index   testIndex
+		expect  func(pgxmock.PgxCommonIface)
+		want    []string
+		wantErr error
+	}{
+		{
+			name:    "invalid index",
+			index:   99,
+			expect:  func(pci pgxmock.PgxCommonIface) {},
+			wantErr: cache.NewIndexUnknownErr(testIndex(99)),
+		},
+		{
+			name:  "error",
+			index: testIndexID,
+			expect: func(pci pgxmock.PgxCommonIface) {
+				pci.ExpectQuery(queryExpect).
+					WithArgs(cachePurpose.String(), testIndexID, "instance1-").
+					WillReturnError(pgx.ErrTxClosed)
+			},
+			wantErr: pgx.ErrTxClosed,
+		},
+		{
+			name:  "ok",
+			index: testIndexID,
+			expect: func(pci pgxmock.PgxCommonIface) {
+				pci.ExpectQuery(queryExpect).
+					WithArgs(cachePurpose.String(), testIndexID, "instance1-").
+					WillReturnRows(
+						pgxmock.NewRows([]string{"array_agg"}).AddRow([]string{"instance1-id1", "instance1-id2"}),
+					)
+			},
+			want: []string{"instance1-id1", "instance1-id2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, pool := prepareCache(t, cache.Config{})
+			defer pool.Close()
+			tt.expect(pool)
+
+			got, err := c.(cache.Inspector[testIndex]).KeysWithPrefix(context.Background(), tt.index, "instance1-")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+
+			err = pool.ExpectationsWereMet()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_pgCache_Size(t *testing.T) {
+	c, pool := prepareCache(t, cache.Config{})
+	defer pool.Close()
+	pool.ExpectQuery(regexp.QuoteMeta(sizeQuery)).
+		WithArgs(cachePurpose.String()).
+		WillReturnRows(pgxmock.NewRows([]string{"count"}).AddRow(int64(3)))
+
+	size, err := c.(cache.Sizer).Size(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, size)
+	assert.NoError(t, pool.ExpectationsWereMet())
+}
+
 const (
 	cachePurpose                 = cache.PurposeAuthzInstance
 	expectedCreatePartitionQuery = `create unlogged table if not exists cache.objects_authz_instance
@@ -0,0 +1,4 @@
+select count(*)
+from cache.objects
+where cache_name = $1
+;
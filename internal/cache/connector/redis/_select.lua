@@ -1,3 +1,6 @@
 -- SELECT ensures the DB namespace for each script.
 -- When used, it consumes the first ARGV entry.
-redis.call("SELECT", ARGV[1])
+-- A negative DB skips the SELECT, as Redis Cluster only supports DB 0.
+if tonumber(ARGV[1]) >= 0 then
+    redis.call("SELECT", ARGV[1])
+end
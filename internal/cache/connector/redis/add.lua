@@ -0,0 +1,14 @@
+-- KEYS: [1]: object_id; [>1]: index keys.
+-- Checks that none of the index keys maps to a valid object, before the object is set by the set script.
+for i = 2, #KEYS do
+    local result = redis.call("GET", KEYS[i])
+    if not (result == false) then
+        local object_id = tostring(result)
+        local expiry = getCall("HGET", object_id, "expiry")
+        if redis.call("EXISTS", object_id) == 1 and (expiry == nil or tonumber(expiry) <= 0 or getTime() <= tonumber(expiry)) then
+            return 0
+        end
+        -- object expired, its keys may be reused
+        remove(object_id)
+    end
+end
@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"context"
+	"errors"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Broadcast publishes the message on the channel.
+func (c *Connector) Broadcast(ctx context.Context, channel string, message []byte) error {
+	return c.Publish(ctx, channel, message).Err()
+}
+
+// Listen subscribes to the channel and calls handle for each received message, until ctx is done.
+// The subscription is restored by the client after a connection loss,
+// in which case reset is called as messages might have been missed.
+func (c *Connector) Listen(ctx context.Context, channel string, handle func(message []byte), reset func()) error {
+	pubSub := c.Subscribe(ctx, channel)
+	// wait for the confirmation, so messages published after Listen returns are received.
+	if _, err := pubSub.Receive(ctx); err != nil {
+		return errors.Join(err, pubSub.Close())
+	}
+	go func() {
+		defer pubSub.Close() //nolint:errcheck
+		messages := pubSub.ChannelWithSubscriptions()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				switch m := msg.(type) {
+				case *redis.Message:
+					handle([]byte(m.Payload))
+				case *redis.Subscription:
+					// the client resubscribed after a reconnect
+					reset()
+				}
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnector_Broadcast_Listen(t *testing.T) {
+	server := miniredis.RunT(t)
+	connector := NewConnector(Config{
+		Enabled:          true,
+		Network:          "tcp",
+		Addr:             server.Addr(),
+		DisableIndentity: true,
+	})
+	t.Cleanup(func() {
+		connector.Close()
+		server.Close()
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	received := make(chan []byte, 1)
+	err := connector.Listen(ctx, "channel", func(message []byte) { received <- message }, func() {})
+	require.NoError(t, err)
+
+	require.NoError(t, connector.Broadcast(ctx, "channel", []byte("message")))
+	select {
+	case message := <-received:
+		assert.Equal(t, []byte("message"), message)
+	case <-time.After(time.Second):
+		t.Fatal("message not received")
+	}
+}
+
+func TestConnector_Listen_error(t *testing.T) {
+	server := miniredis.RunT(t)
+	server.RequireAuth("foobar")
+	connector := NewConnector(Config{
+		Enabled:          true,
+		Network:          "tcp",
+		Addr:             server.Addr(),
+		DisableIndentity: true,
+	})
+	t.Cleanup(func() {
+		connector.Close()
+		server.Close()
+	})
+	err := connector.Listen(context.Background(), "channel", func([]byte) {}, func() {})
+	require.Error(t, err)
+}
@@ -89,3 +89,38 @@ func (l *limiter) ReportResult(err error) {
 		errors.Is(err, context.Canceled) ||
 		redis.HasErrorPrefix(err, "NOSCRIPT"))
 }
+
+// limiterHook applies a [redis.Limiter] as [redis.Hook],
+// for clients which don't allow to set the limiter in their options.
+type limiterHook struct {
+	limiter redis.Limiter
+}
+
+// DialHook implements [redis.Hook].
+func (limiterHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook implements [redis.Hook].
+func (h limiterHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.limiter.Allow(); err != nil {
+			return err
+		}
+		err := next(ctx, cmd)
+		h.limiter.ReportResult(err)
+		return err
+	}
+}
+
+// ProcessPipelineHook implements [redis.Hook].
+func (h limiterHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := h.limiter.Allow(); err != nil {
+			return err
+		}
+		err := next(ctx, cmds)
+		h.limiter.ReportResult(err)
+		return err
+	}
+}
@@ -14,7 +14,11 @@ type Config struct {
 	// Default is tcp.
 	Network string
 	// host:port address.
+	// Not used when Cluster or Sentinel is enabled.
 	Addr string
+	// Addrs is the list of host:port addresses of the cluster nodes when Cluster is enabled,
+	// or the addresses of the sentinels when Sentinel is enabled.
+	Addrs []string
 	// ClientName will execute the `CLIENT SETNAME ClientName` command for each conn.
 	ClientName string
 	// Use the specified Username to authenticate the current connection
@@ -107,10 +111,41 @@ type Config struct {
 	IdentitySuffix string
 
 	CircuitBreaker *CBConfig
+
+	// Cluster connects to a Redis Cluster.
+	// Redis Cluster only supports DB 0, therefore the keys of each cache
+	// are namespaced with a hash tag instead of a DB.
+	Cluster ClusterConfig
+	// Sentinel connects to the master managed by Redis Sentinel
+	// and follows the master on failover.
+	Sentinel SentinelConfig
+}
+
+type ClusterConfig struct {
+	Enabled bool
+	// The maximum number of retries before giving up. Command is retried
+	// on network errors and MOVED/ASK redirects.
+	// Default is 3 retries.
+	MaxRedirects int
+	// Allows routing read-only commands to the closest master or replica node.
+	RouteByLatency bool
+	// Allows routing read-only commands to a random master or replica node.
+	RouteRandomly bool
+}
+
+type SentinelConfig struct {
+	Enabled bool
+	// The master name as configured in the sentinels.
+	MasterName string
+	// Username for the ACL authentication on the sentinels.
+	// The Username and Password above are used to authenticate on the master.
+	Username string
+	// Password for the authentication on the sentinels.
+	Password string
 }
 
 type Connector struct {
-	*redis.Client
+	redis.UniversalClient
 	Config Config
 }
 
@@ -118,12 +153,32 @@ func NewConnector(config Config) *Connector {
 	if !config.Enabled {
 		return nil
 	}
+	var client redis.UniversalClient
+	switch {
+	case config.Cluster.Enabled:
+		client = redis.NewClusterClient(clusterOptionsFromConfig(config))
+	case config.Sentinel.Enabled:
+		failover := redis.NewFailoverClient(failoverOptionsFromConfig(config))
+		// the failover options don't allow to pass a limiter, so the circuit breaker is applied as hook.
+		if limiter := newLimiter(config.CircuitBreaker, config.MaxActiveConns); limiter != nil {
+			failover.AddHook(limiterHook{limiter})
+		}
+		client = failover
+	default:
+		client = redis.NewClient(optionsFromConfig(config))
+	}
 	return &Connector{
-		Client: redis.NewClient(optionsFromConfig(config)),
-		Config: config,
+		UniversalClient: client,
+		Config:          config,
 	}
 }
 
+// clusterClient returns the cluster client if the connector is connected to a Redis Cluster.
+func (c *Connector) clusterClient() (*redis.ClusterClient, bool) {
+	client, ok := c.UniversalClient.(*redis.ClusterClient)
+	return client, ok
+}
+
 func optionsFromConfig(c Config) *redis.Options {
 	opts := &redis.Options{
 		Network:               c.Network,
@@ -155,3 +210,76 @@ func optionsFromConfig(c Config) *redis.Options {
 	}
 	return opts
 }
+
+func clusterOptionsFromConfig(c Config) *redis.ClusterOptions {
+	opts := &redis.ClusterOptions{
+		Addrs:                 c.Addrs,
+		ClientName:            c.ClientName,
+		MaxRedirects:          c.Cluster.MaxRedirects,
+		RouteByLatency:        c.Cluster.RouteByLatency,
+		RouteRandomly:         c.Cluster.RouteRandomly,
+		Protocol:              3,
+		Username:              c.Username,
+		Password:              c.Password,
+		MaxRetries:            c.MaxRetries,
+		MinRetryBackoff:       c.MinRetryBackoff,
+		MaxRetryBackoff:       c.MaxRetryBackoff,
+		DialTimeout:           c.DialTimeout,
+		ReadTimeout:           c.ReadTimeout,
+		WriteTimeout:          c.WriteTimeout,
+		ContextTimeoutEnabled: true,
+		PoolFIFO:              c.PoolFIFO,
+		PoolSize:              c.PoolSize,
+		PoolTimeout:           c.PoolTimeout,
+		MinIdleConns:          c.MinIdleConns,
+		MaxIdleConns:          c.MaxIdleConns,
+		MaxActiveConns:        c.MaxActiveConns,
+		ConnMaxIdleTime:       c.ConnMaxIdleTime,
+		ConnMaxLifetime:       c.ConnMaxLifetime,
+		DisableIndentity:      c.DisableIndentity,
+		IdentitySuffix:        c.IdentitySuffix,
+		// each node gets its own circuit breaker, so a failing node doesn't open the circuit for the whole cluster.
+		NewClient: func(opt *redis.Options) *redis.Client {
+			opt.Limiter = newLimiter(c.CircuitBreaker, c.MaxActiveConns)
+			return redis.NewClient(opt)
+		},
+	}
+	if c.EnableTLS {
+		opts.TLSConfig = new(tls.Config)
+	}
+	return opts
+}
+
+func failoverOptionsFromConfig(c Config) *redis.FailoverOptions {
+	opts := &redis.FailoverOptions{
+		MasterName:            c.Sentinel.MasterName,
+		SentinelAddrs:         c.Addrs,
+		SentinelUsername:      c.Sentinel.Username,
+		SentinelPassword:      c.Sentinel.Password,
+		ClientName:            c.ClientName,
+		Protocol:              3,
+		Username:              c.Username,
+		Password:              c.Password,
+		MaxRetries:            c.MaxRetries,
+		MinRetryBackoff:       c.MinRetryBackoff,
+		MaxRetryBackoff:       c.MaxRetryBackoff,
+		DialTimeout:           c.DialTimeout,
+		ReadTimeout:           c.ReadTimeout,
+		WriteTimeout:          c.WriteTimeout,
+		ContextTimeoutEnabled: true,
+		PoolFIFO:              c.PoolFIFO,
+		PoolSize:              c.PoolSize,
+		PoolTimeout:           c.PoolTimeout,
+		MinIdleConns:          c.MinIdleConns,
+		MaxIdleConns:          c.MaxIdleConns,
+		MaxActiveConns:        c.MaxActiveConns,
+		ConnMaxIdleTime:       c.ConnMaxIdleTime,
+		ConnMaxLifetime:       c.ConnMaxLifetime,
+		DisableIndentity:      c.DisableIndentity,
+		IdentitySuffix:        c.IdentitySuffix,
+	}
+	if c.EnableTLS {
+		opts.TLSConfig = new(tls.Config)
+	}
+	return opts
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +27,8 @@ var (
 	removeComponent string
 	//go:embed set.lua
 	setScript string
+	//go:embed add.lua
+	addScript string
 	//go:embed get.lua
 	getScript string
 	//go:embed invalidate.lua
@@ -33,27 +36,41 @@ var (
 
 	// Don't mind the creative "import"
 	setParsed        = redis.NewScript(strings.Join([]string{selectComponent, utilComponent, setScript}, "\n"))
+	addParsed        = redis.NewScript(strings.Join([]string{selectComponent, utilComponent, removeComponent, addScript, setScript}, "\n"))
 	getParsed        = redis.NewScript(strings.Join([]string{selectComponent, utilComponent, removeComponent, getScript}, "\n"))
 	invalidateParsed = redis.NewScript(strings.Join([]string{selectComponent, utilComponent, removeComponent, invalidateScript}, "\n"))
 )
 
 type redisCache[I, K comparable, V cache.Entry[I, K]] struct {
-	db        int
+	db int
+	// keyPrefix namespaces the keys when connected to a Redis Cluster, which only supports DB 0.
+	// The prefix is a hash tag, so all keys of the cache are stored in the same hash slot,
+	// as required by the scripts which operate on multiple keys.
+	keyPrefix string
 	config    *cache.Config
 	indices   []I
 	connector *Connector
 	logger    *slog.Logger
 }
 
-// NewCache returns a cache that stores and retrieves object using single Redis.
+// NewCache returns a cache that stores and retrieves object using Redis.
+// The cache uses the DB namespace `db` or, when connected to a Redis Cluster, keys hash-tagged with `db`.
 func NewCache[I, K comparable, V cache.Entry[I, K]](config cache.Config, client *Connector, db int, indices []I) cache.Cache[I, K, V] {
-	return &redisCache[I, K, V]{
+	c := &redisCache[I, K, V]{
 		config:    &config,
 		db:        db,
 		indices:   indices,
 		connector: client,
 		logger:    config.Log.Slog(),
 	}
+	if _, ok := client.clusterClient(); ok {
+		c.keyPrefix = clusterKeyPrefix(db)
+	}
+	return c
+}
+
+func clusterKeyPrefix(db int) string {
+	return fmt.Sprintf("{zitadel:%d}:", db)
 }
 
 func (c *redisCache[I, K, V]) Set(ctx context.Context, value V) {
@@ -66,29 +83,60 @@ func (c *redisCache[I, K, V]) set(ctx context.Context, value V) (objectID string
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	// Internal ID used for the object
-	objectID = uuid.NewString()
-	keys := []string{objectID}
-	// flatten the secondary keys
-	for _, index := range c.indices {
-		keys = append(keys, c.redisIndexKeys(index, value.Keys(index)...)...)
-	}
-	var buf strings.Builder
-	err = json.NewEncoder(&buf).Encode(value)
+	keys, object, err := c.setKeys(value)
 	if err != nil {
 		return "", err
 	}
-	err = setParsed.Run(ctx, c.connector, keys,
-		c.db,                                   // DB namespace
-		buf.String(),                           // object
-		int64(c.config.LastUseAge/time.Second), // usage_lifetime
-		int64(c.config.MaxAge/time.Second),     // max_age,
-	).Err()
+	err = setParsed.Run(ctx, c.connector, keys, c.setArgs(object)...).Err()
 	// redis.Nil is always returned because the script doesn't have a return value.
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return "", err
 	}
-	return objectID, nil
+	return keys[0], nil
+}
+
+// setKeys returns the keys passed to the set script and the encoded object.
+// The first key is the internal ID used for the object, followed by the flattened secondary keys.
+func (c *redisCache[I, K, V]) setKeys(value V) (keys []string, object string, err error) {
+	keys = []string{c.keyPrefix + uuid.NewString()}
+	for _, index := range c.indices {
+		keys = append(keys, c.redisIndexKeys(index, value.Keys(index)...)...)
+	}
+	var buf strings.Builder
+	if err = json.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, "", err
+	}
+	return keys, buf.String(), nil
+}
+
+func (c *redisCache[I, K, V]) setArgs(object string) []any {
+	return []any{
+		c.selectDB(),                             // DB namespace
+		object,                                   // object
+		int64(c.config.LastUseAge / time.Second), // usage_lifetime
+		int64(c.config.MaxAge / time.Second),     // max_age,
+	}
+}
+
+// Add sets the object, unless one of its keys maps to a valid object.
+// The check and set run in a single script, so concurrent adds of the same key can't both succeed.
+func (c *redisCache[I, K, V]) Add(ctx context.Context, value V) (added bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	keys, object, err := c.setKeys(value)
+	if err != nil {
+		return false, err
+	}
+	err = addParsed.Run(ctx, c.connector, keys, c.setArgs(object)...).Err()
+	// the script returns 0 if a key is in use and no value (redis.Nil) after the object was set.
+	if errors.Is(err, redis.Nil) {
+		return true, nil
+	}
+	if err != nil {
+		c.logger.ErrorContext(ctx, "redis cache add", "err", err)
+	}
+	return false, err
 }
 
 func (c *redisCache[I, K, V]) Get(ctx context.Context, index I, key K) (value V, ok bool) {
@@ -105,7 +153,7 @@ func (c *redisCache[I, K, V]) Get(ctx context.Context, index I, key K) (value V,
 	}()
 
 	logger := c.logger.With("index", index, "key", key)
-	obj, err = getParsed.Run(ctx, c.connector, c.redisIndexKeys(index, key), c.db).Result()
+	obj, err = getParsed.Run(ctx, c.connector, c.redisIndexKeys(index, key), c.selectDB()).Result()
 	if err != nil && !errors.Is(err, redis.Nil) {
 		logger.ErrorContext(ctx, "redis cache get", "err", err)
 		return value, false
@@ -130,7 +178,7 @@ func (c *redisCache[I, K, V]) Invalidate(ctx context.Context, index I, key ...K)
 	if len(key) == 0 {
 		return nil
 	}
-	err = invalidateParsed.Run(ctx, c.connector, c.redisIndexKeys(index, key...), c.db).Err()
+	err = invalidateParsed.Run(ctx, c.connector, c.redisIndexKeys(index, key...), c.selectDB()).Err()
 	// redis.Nil is always returned because the script doesn't have a return value.
 	if err != nil && !errors.Is(err, redis.Nil) {
 		return err
@@ -145,6 +193,9 @@ func (c *redisCache[I, K, V]) Delete(ctx context.Context, index I, key ...K) (er
 	if len(key) == 0 {
 		return nil
 	}
+	if c.keyPrefix != "" {
+		return c.connector.Del(ctx, c.redisIndexKeys(index, key...)...).Err()
+	}
 	pipe := c.connector.Pipeline()
 	pipe.Select(ctx, c.db)
 	pipe.Del(ctx, c.redisIndexKeys(index, key...)...)
@@ -156,6 +207,9 @@ func (c *redisCache[I, K, V]) Truncate(ctx context.Context) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if cluster, ok := c.connector.clusterClient(); ok {
+		return c.truncateCluster(ctx, cluster)
+	}
 	pipe := c.connector.Pipeline()
 	pipe.Select(ctx, c.db)
 	pipe.FlushDB(ctx)
@@ -163,10 +217,92 @@ func (c *redisCache[I, K, V]) Truncate(ctx context.Context) (err error) {
 	return err
 }
 
+// scanBatchSize is the amount of keys scanned at once,
+// and deleted at once on truncate of a cluster cache.
+const scanBatchSize = 1000
+
+// truncateCluster deletes all keys of the cache from the master of the cache's hash slot.
+// FLUSHDB can't be used, as DB 0 of the node is shared with other caches.
+func (c *redisCache[I, K, V]) truncateCluster(ctx context.Context, cluster *redis.ClusterClient) error {
+	master, err := cluster.MasterForKey(ctx, c.keyPrefix)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, scanBatchSize)
+	iter := master.Scan(ctx, 0, c.keyPrefix+"*", scanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) < scanBatchSize {
+			continue
+		}
+		if err = master.Unlink(ctx, keys...).Err(); err != nil {
+			return err
+		}
+		keys = keys[:0]
+	}
+	if err = iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return master.Unlink(ctx, keys...).Err()
+}
+
+// KeysWithPrefix scans the keys of the index starting with prefix.
+// Scanning is done on the master of the cache's hash slot in cluster mode.
+func (c *redisCache[I, K, V]) KeysWithPrefix(ctx context.Context, index I, prefix string) (keys []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if !slices.Contains(c.indices, index) {
+		return nil, cache.NewIndexUnknownErr(index)
+	}
+	indexPrefix := fmt.Sprintf("%s%v:", c.keyPrefix, index)
+	if cluster, ok := c.connector.clusterClient(); ok {
+		master, err := cluster.MasterForKey(ctx, c.keyPrefix)
+		if err != nil {
+			return nil, err
+		}
+		return scanKeys(ctx, master, indexPrefix, prefix)
+	}
+	client, ok := c.connector.UniversalClient.(*redis.Client)
+	if !ok {
+		return nil, errors.ErrUnsupported
+	}
+	// SCAN needs multiple round trips on the selected DB
+	conn := client.Conn()
+	defer conn.Close()
+	if err = conn.Select(ctx, c.db).Err(); err != nil {
+		return nil, err
+	}
+	return scanKeys(ctx, conn, indexPrefix, prefix)
+}
+
+// globEscaper escapes the special characters of Redis glob-style patterns.
+var globEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+func scanKeys(ctx context.Context, client redis.Cmdable, indexPrefix, prefix string) (keys []string, err error) {
+	iter := client.Scan(ctx, 0, indexPrefix+globEscaper.Replace(prefix)+"*", scanBatchSize).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, strings.TrimPrefix(iter.Val(), indexPrefix))
+	}
+	return keys, iter.Err()
+}
+
+// selectDB returns the DB namespace passed to the scripts.
+// A negative DB disables the SELECT in cluster mode.
+func (c *redisCache[I, K, V]) selectDB() int {
+	if c.keyPrefix != "" {
+		return -1
+	}
+	return c.db
+}
+
 func (c *redisCache[I, K, V]) redisIndexKeys(index I, keys ...K) []string {
 	out := make([]string, len(keys))
 	for i, k := range keys {
-		out[i] = fmt.Sprintf("%v:%v", index, k)
+		out[i] = fmt.Sprintf("%s%v:%v", c.keyPrefix, index, k)
 	}
 	return out
 }
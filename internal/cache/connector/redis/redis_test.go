@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -167,6 +168,75 @@ func Test_redisCache_set(t *testing.T) {
 	}
 }
 
+func Test_redisCache_Add(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      cache.Config
+		preparation func(t *testing.T, c cache.Cache[testIndex, string, *testObject], s *miniredis.Miniredis)
+		value       *testObject
+		wantAdded   bool
+		wantErr     bool
+	}{
+		{
+			name:   "connection error",
+			config: cache.Config{},
+			preparation: func(_ *testing.T, _ cache.Cache[testIndex, string, *testObject], s *miniredis.Miniredis) {
+				s.RequireAuth("foobar")
+			},
+			value:   &testObject{ID: "one", Name: []string{"foo"}},
+			wantErr: true,
+		},
+		{
+			name:      "added",
+			config:    cache.Config{},
+			value:     &testObject{ID: "one", Name: []string{"foo"}},
+			wantAdded: true,
+		},
+		{
+			name:   "key in use",
+			config: cache.Config{},
+			preparation: func(t *testing.T, c cache.Cache[testIndex, string, *testObject], s *miniredis.Miniredis) {
+				c.Set(context.Background(), &testObject{ID: "two", Name: []string{"foo"}})
+			},
+			value:     &testObject{ID: "one", Name: []string{"foo"}},
+			wantAdded: false,
+		},
+		{
+			name: "key of expired object",
+			config: cache.Config{
+				MaxAge: time.Minute,
+			},
+			preparation: func(t *testing.T, c cache.Cache[testIndex, string, *testObject], s *miniredis.Miniredis) {
+				c.Set(context.Background(), &testObject{ID: "two", Name: []string{"foo"}})
+				s.FastForward(2 * time.Minute)
+			},
+			value:     &testObject{ID: "one", Name: []string{"foo"}},
+			wantAdded: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, server := prepareCache(t, tt.config)
+			if tt.preparation != nil {
+				tt.preparation(t, c, server)
+			}
+			added, err := c.(cache.Adder[*testObject]).Add(context.Background(), tt.value)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantAdded, added)
+			if !tt.wantAdded {
+				return
+			}
+			got, ok := c.Get(context.Background(), testIndexName, "foo")
+			require.True(t, ok)
+			assert.Equal(t, tt.value, got)
+		})
+	}
+}
+
 func Test_redisCache_Get(t *testing.T) {
 	type args struct {
 		ctx   context.Context
@@ -688,6 +758,95 @@ func Test_redisCache_Truncate(t *testing.T) {
 	}
 }
 
+func Test_redisCache_cluster(t *testing.T) {
+	ctx := context.Background()
+	c, server := prepareCache(t, cache.Config{
+		MaxAge:     time.Hour,
+		LastUseAge: 10 * time.Minute,
+	}, withClusterOption())
+	// cluster mode only uses DB 0
+	server.Select(0)
+
+	c.Set(ctx, &testObject{
+		ID:   "one",
+		Name: []string{"foo", "bar"},
+	})
+	c.Set(ctx, &testObject{
+		ID:   "two",
+		Name: []string{"Hello", "World"},
+	})
+	assert.True(t, server.Exists("{zitadel:99}:0:one"))
+	assert.True(t, server.Exists("{zitadel:99}:1:foo"))
+	assert.True(t, server.Exists("{zitadel:99}:1:World"))
+	objectID, err := server.Get("{zitadel:99}:0:one")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(objectID, "{zitadel:99}:"))
+
+	obj, ok := c.Get(ctx, testIndexName, "bar")
+	require.True(t, ok)
+	assert.Equal(t, "one", obj.ID)
+
+	require.NoError(t, c.Invalidate(ctx, testIndexName, "bar"))
+	_, ok = c.Get(ctx, testIndexID, "one")
+	assert.False(t, ok)
+
+	require.NoError(t, c.Delete(ctx, testIndexName, "Hello"))
+	assert.False(t, server.Exists("{zitadel:99}:1:Hello"))
+	obj, ok = c.Get(ctx, testIndexID, "two")
+	require.True(t, ok)
+	assert.Equal(t, "two", obj.ID)
+
+	// keys of other caches must survive the truncate
+	require.NoError(t, server.Set("other", "value"))
+	require.NoError(t, c.Truncate(ctx))
+	assert.Equal(t, []string{"other"}, server.Keys())
+}
+
+func Test_redisCache_KeysWithPrefix(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []func(*Config)
+	}{
+		{
+			name: "single",
+		},
+		{
+			name:    "cluster",
+			options: []func(*Config){withClusterOption()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, _ := prepareCache(t, cache.Config{}, tt.options...)
+			c.Set(ctx, &testObject{
+				ID:   "instance1-one",
+				Name: []string{"foo"},
+			})
+			c.Set(ctx, &testObject{
+				ID:   "instance1-two",
+				Name: []string{"bar"},
+			})
+			c.Set(ctx, &testObject{
+				ID:   "instance2-one",
+				Name: []string{"instance1-three"},
+			})
+
+			inspector := c.(cache.Inspector[testIndex])
+			keys, err := inspector.KeysWithPrefix(ctx, testIndexID, "instance1-")
+			require.NoError(t, err)
+			assert.ElementsMatch(t, []string{"instance1-one", "instance1-two"}, keys)
+
+			keys, err = inspector.KeysWithPrefix(ctx, testIndexID, "instance*")
+			require.NoError(t, err)
+			assert.Empty(t, keys)
+
+			_, err = inspector.KeysWithPrefix(ctx, 99, "instance1-")
+			assert.ErrorIs(t, err, cache.NewIndexUnknownErr(testIndex(99)))
+		})
+	}
+}
+
 func prepareCache(t *testing.T, conf cache.Config, options ...func(*Config)) (cache.Cache[testIndex, string, *testObject], *miniredis.Miniredis) {
 	conf.Log = &logging.Config{
 		Level:     "debug",
@@ -714,6 +873,13 @@ func prepareCache(t *testing.T, conf cache.Config, options ...func(*Config)) (ca
 	return c, server
 }
 
+func withClusterOption() func(*Config) {
+	return func(c *Config) {
+		c.Addrs = []string{c.Addr}
+		c.Cluster.Enabled = true
+	}
+}
+
 func withCircuitBreakerOption(cb *CBConfig) func(*Config) {
 	return func(c *Config) {
 		c.CircuitBreaker = cb
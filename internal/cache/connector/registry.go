@@ -0,0 +1,98 @@
+package connector
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+// InstanceKeySeparator separates the instance ID from the rest of the key
+// in indices passed to [WithInstanceIndex].
+const InstanceKeySeparator = "-"
+
+// RegisteredCache is a cache started by [StartCache],
+// which can be inspected and invalidated, for example through the system API.
+type RegisteredCache interface {
+	Purpose() cache.Purpose
+	Connector() cache.Connector
+	// Size returns the amount of stored objects.
+	// [errors.ErrUnsupported] is returned if the connector can't count the objects.
+	Size(ctx context.Context) (int64, error)
+	// InstanceKeys returns the keys of the instance's objects.
+	// [errors.ErrUnsupported] is returned if the cache was started without [WithInstanceIndex]
+	// or the connector can't list keys.
+	InstanceKeys(ctx context.Context, instanceID string) ([]string, error)
+	// InvalidateInstance invalidates the objects of the instance and returns the amount of invalidated keys.
+	// [errors.ErrUnsupported] is returned if the keys of the instance can't be listed.
+	InvalidateInstance(ctx context.Context, instanceID string) (int, error)
+	Truncate(ctx context.Context) error
+}
+
+// Registry keeps track of the caches started by [StartCache].
+// A nil Registry discards all caches.
+type Registry struct {
+	mu     sync.RWMutex
+	caches map[cache.Purpose]RegisteredCache
+}
+
+func NewRegistry() *Registry {
+	registry := &Registry{
+		caches: make(map[cache.Purpose]RegisteredCache),
+	}
+	registerMetrics(registry)
+	return registry
+}
+
+func (r *Registry) register(c RegisteredCache) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.caches[c.Purpose()] = c
+	r.mu.Unlock()
+}
+
+// Caches returns the registered caches, ordered by purpose.
+func (r *Registry) Caches() []RegisteredCache {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	caches := make([]RegisteredCache, 0, len(r.caches))
+	for _, c := range r.caches {
+		caches = append(caches, c)
+	}
+	r.mu.RUnlock()
+	slices.SortFunc(caches, func(a, b RegisteredCache) int {
+		return int(a.Purpose()) - int(b.Purpose())
+	})
+	return caches
+}
+
+// Cache returns the registered cache of the purpose.
+func (r *Registry) Cache(purpose cache.Purpose) (RegisteredCache, bool) {
+	if r == nil {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	c, ok := r.caches[purpose]
+	return c, ok
+}
+
+// CacheOption configures a cache started by [StartCache].
+type CacheOption[I ~int] func(*cacheOptions[I])
+
+type cacheOptions[I ~int] struct {
+	instanceIndex *I
+}
+
+// WithInstanceIndex allows to list and invalidate the objects of a single instance through the [Registry].
+// The keys of the index must be the instance ID or start with the instance ID followed by [InstanceKeySeparator].
+func WithInstanceIndex[I ~int](index I) CacheOption[I] {
+	return func(o *cacheOptions[I]) {
+		o.instanceIndex = &index
+	}
+}
@@ -0,0 +1,249 @@
+// Package tiered provides a cache with an in-memory layer in front of a shared cache.
+package tiered
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+// Broadcaster distributes messages to all ZITADEL instances sharing the same connector.
+type Broadcaster interface {
+	// Broadcast sends the message to all listeners of the channel, including the sender.
+	Broadcast(ctx context.Context, channel string, message []byte) error
+	// Listen calls handle for each message received on the channel, until ctx is done.
+	// reset is called when messages might have been missed, for example after a reconnect.
+	// Listen returns after the channel is listened to.
+	Listen(ctx context.Context, channel string, handle func(message []byte), reset func()) error
+}
+
+type operation int
+
+const (
+	operationInvalidate operation = iota + 1
+	operationDelete
+	operationTruncate
+)
+
+// broadcastBatchSize is the maximum amount of keys per message,
+// keeping the payload below the 8000 bytes limit of a Postgres notification for typical keys.
+const broadcastBatchSize = 20
+
+type message[I, K comparable] struct {
+	Origin    string    `json:"origin"`
+	Operation operation `json:"op"`
+	Index     I         `json:"index,omitempty"`
+	Keys      []K       `json:"keys,omitempty"`
+}
+
+type tieredCache[I, K comparable, V cache.Entry[I, K]] struct {
+	// origin identifies the messages sent by this cache,
+	// which are already applied to the local layer.
+	origin      string
+	channel     string
+	local       cache.Cache[I, K, V]
+	shared      cache.Cache[I, K, V]
+	broadcaster Broadcaster
+	logger      *slog.Logger
+
+	// mu guards the promotion of objects from the shared to the local cache against concurrent invalidations.
+	// generation is incremented on every invalidation of the local cache,
+	// objects read from the shared cache are only promoted if the generation did not change in the meantime.
+	mu         sync.RWMutex
+	generation uint64
+}
+
+// NewCache returns a cache which serves objects from the local (in-memory) cache
+// and falls back to the shared cache.
+// Invalidate, Delete and Truncate are applied to both caches and broadcasted to the local caches of all ZITADEL instances.
+// The broadcasts are received until the background context is done.
+func NewCache[I, K comparable, V cache.Entry[I, K]](background context.Context, purpose cache.Purpose, config cache.Config, local, shared cache.Cache[I, K, V], broadcaster Broadcaster) (cache.Cache[I, K, V], error) {
+	c := &tieredCache[I, K, V]{
+		origin:      uuid.NewString(),
+		channel:     Channel(purpose),
+		local:       local,
+		shared:      shared,
+		broadcaster: broadcaster,
+		logger:      config.Log.Slog().With("cache_purpose", purpose),
+	}
+	if err := broadcaster.Listen(background, c.channel, c.handle, c.reset); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Channel returns the broadcast channel of the cache purpose.
+func Channel(purpose cache.Purpose) string {
+	return "zitadel_cache_" + purpose.String()
+}
+
+// Get returns the object from the local cache or from the shared cache.
+// Objects of the shared cache are only promoted to the local cache if they still resolve to the key of the lookup,
+// as the keys are recomputed after decoding and must not be derived from fields lost during encoding.
+// Objects are not promoted either, if the local cache was invalidated while the shared cache was read,
+// as the object might be stale already.
+func (c *tieredCache[I, K, V]) Get(ctx context.Context, index I, key K) (value V, ok bool) {
+	if value, ok = c.local.Get(ctx, index, key); ok {
+		return value, true
+	}
+	generation := c.currentGeneration()
+	if value, ok = c.shared.Get(ctx, index, key); ok && slices.Contains(value.Keys(index), key) {
+		c.promote(ctx, generation, value)
+	}
+	return value, ok
+}
+
+func (c *tieredCache[I, K, V]) currentGeneration() uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generation
+}
+
+// promote sets the object to the local cache, unless the local cache was invalidated since the generation was read.
+func (c *tieredCache[I, K, V]) promote(ctx context.Context, generation uint64, value V) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.generation != generation {
+		return
+	}
+	c.local.Set(ctx, value)
+}
+
+// invalidateLocal applies the invalidation to the local cache and increments the generation,
+// so objects read from the shared cache before are not promoted.
+func (c *tieredCache[I, K, V]) invalidateLocal(invalidate func() error) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	return invalidate()
+}
+
+func (c *tieredCache[I, K, V]) Set(ctx context.Context, value V) {
+	c.shared.Set(ctx, value)
+	c.local.Set(ctx, value)
+}
+
+func (c *tieredCache[I, K, V]) Invalidate(ctx context.Context, index I, keys ...K) error {
+	return errors.Join(
+		c.shared.Invalidate(ctx, index, keys...),
+		c.invalidateLocal(func() error { return c.local.Invalidate(ctx, index, keys...) }),
+		c.broadcastKeys(ctx, operationInvalidate, index, keys),
+	)
+}
+
+func (c *tieredCache[I, K, V]) Delete(ctx context.Context, index I, keys ...K) error {
+	return errors.Join(
+		c.shared.Delete(ctx, index, keys...),
+		c.invalidateLocal(func() error { return c.local.Delete(ctx, index, keys...) }),
+		c.broadcastKeys(ctx, operationDelete, index, keys),
+	)
+}
+
+func (c *tieredCache[I, K, V]) Truncate(ctx context.Context) error {
+	return errors.Join(
+		c.shared.Truncate(ctx),
+		c.invalidateLocal(func() error { return c.local.Truncate(ctx) }),
+		c.broadcast(ctx, &message[I, K]{Operation: operationTruncate}),
+	)
+}
+
+// Add adds the object to the shared cache, which decides whether the keys are in use across all ZITADEL instances.
+// The object is not set to the local cache, it is promoted on the next Get.
+func (c *tieredCache[I, K, V]) Add(ctx context.Context, value V) (bool, error) {
+	adder, ok := c.shared.(cache.Adder[V])
+	if !ok {
+		return false, errors.ErrUnsupported
+	}
+	return adder.Add(ctx, value)
+}
+
+// KeysWithPrefix returns the keys of the shared cache, which contains all objects of the local cache.
+func (c *tieredCache[I, K, V]) KeysWithPrefix(ctx context.Context, index I, prefix string) ([]string, error) {
+	inspector, ok := c.shared.(cache.Inspector[I])
+	if !ok {
+		return nil, errors.ErrUnsupported
+	}
+	return inspector.KeysWithPrefix(ctx, index, prefix)
+}
+
+// Size returns the amount of objects in the shared cache.
+func (c *tieredCache[I, K, V]) Size(ctx context.Context) (int64, error) {
+	sizer, ok := c.shared.(cache.Sizer)
+	if !ok {
+		return 0, errors.ErrUnsupported
+	}
+	return sizer.Size(ctx)
+}
+
+func (c *tieredCache[I, K, V]) broadcastKeys(ctx context.Context, op operation, index I, keys []K) error {
+	for len(keys) > 0 {
+		batch := keys[:min(len(keys), broadcastBatchSize)]
+		keys = keys[len(batch):]
+		if err := c.broadcast(ctx, &message[I, K]{Operation: op, Index: index, Keys: batch}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *tieredCache[I, K, V]) broadcast(ctx context.Context, msg *message[I, K]) error {
+	msg.Origin = c.origin
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if err = c.broadcaster.Broadcast(ctx, c.channel, payload); err != nil {
+		c.logger.ErrorContext(ctx, "tiered cache broadcast", "err", err, "op", msg.Operation)
+		return err
+	}
+	return nil
+}
+
+// handle applies a broadcasted operation to the local cache.
+func (c *tieredCache[I, K, V]) handle(payload []byte) {
+	ctx := context.Background()
+	msg := new(message[I, K])
+	if err := json.Unmarshal(payload, msg); err != nil {
+		c.logger.ErrorContext(ctx, "tiered cache handle", "err", err)
+		// we can't tell which objects are affected
+		c.reset()
+		return
+	}
+	if msg.Origin == c.origin {
+		return
+	}
+	var err error
+	switch msg.Operation {
+	case operationInvalidate:
+		err = c.invalidateLocal(func() error { return c.local.Invalidate(ctx, msg.Index, msg.Keys...) })
+	case operationDelete:
+		err = c.invalidateLocal(func() error { return c.local.Delete(ctx, msg.Index, msg.Keys...) })
+	case operationTruncate:
+		err = c.invalidateLocal(func() error { return c.local.Truncate(ctx) })
+	default:
+		c.logger.WarnContext(ctx, "tiered cache handle unknown operation", "op", msg.Operation)
+		return
+	}
+	if err != nil {
+		c.logger.ErrorContext(ctx, "tiered cache handle", "err", err, "op", msg.Operation)
+		return
+	}
+	c.logger.DebugContext(ctx, "tiered cache handle", "op", msg.Operation, "index", msg.Index, "keys", msg.Keys)
+}
+
+// reset truncates the local cache, as it might contain stale objects.
+func (c *tieredCache[I, K, V]) reset() {
+	ctx := context.Background()
+	if err := c.invalidateLocal(func() error { return c.local.Truncate(ctx) }); err != nil {
+		c.logger.ErrorContext(ctx, "tiered cache reset", "err", err)
+		return
+	}
+	c.logger.InfoContext(ctx, "tiered cache reset")
+}
@@ -0,0 +1,332 @@
+package tiered
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+)
+
+type testIndex int
+
+const (
+	testIndexID testIndex = iota
+	testIndexName
+)
+
+var testIndices = []testIndex{
+	testIndexID,
+	testIndexName,
+}
+
+type testObject struct {
+	ID   string
+	Name []string
+}
+
+func (o *testObject) Keys(index testIndex) []string {
+	switch index {
+	case testIndexID:
+		return []string{o.ID}
+	case testIndexName:
+		return o.Name
+	default:
+		return nil
+	}
+}
+
+// testBroadcaster delivers messages synchronously to all listeners.
+type testBroadcaster struct {
+	mu        sync.Mutex
+	listeners map[string][]func([]byte)
+	resets    []func()
+	err       error
+}
+
+func (b *testBroadcaster) Broadcast(_ context.Context, channel string, message []byte) error {
+	if b.err != nil {
+		return b.err
+	}
+	b.mu.Lock()
+	listeners := b.listeners[channel]
+	b.mu.Unlock()
+	for _, handle := range listeners {
+		handle(message)
+	}
+	return nil
+}
+
+func (b *testBroadcaster) Listen(_ context.Context, channel string, handle func([]byte), reset func()) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.listeners == nil {
+		b.listeners = make(map[string][]func([]byte))
+	}
+	b.listeners[channel] = append(b.listeners[channel], handle)
+	b.resets = append(b.resets, reset)
+	return nil
+}
+
+// prepareCaches returns two tiered caches, sharing the shared cache and the broadcaster,
+// as if they were running in two ZITADEL instances.
+func prepareCaches(t *testing.T, broadcaster Broadcaster) (first, second cache.Cache[testIndex, string, *testObject], shared cache.Cache[testIndex, string, *testObject]) {
+	ctx := context.Background()
+	conf := cache.Config{
+		Log: &logging.Config{
+			Level:     "debug",
+			AddSource: true,
+		},
+	}
+	shared = gomap.NewCache[testIndex, string, *testObject](ctx, testIndices, conf)
+	var err error
+	first, err = NewCache[testIndex, string, *testObject](ctx, cache.PurposeAuthzInstance, conf, gomap.NewCache[testIndex, string, *testObject](ctx, testIndices, conf), shared, broadcaster)
+	require.NoError(t, err)
+	second, err = NewCache[testIndex, string, *testObject](ctx, cache.PurposeAuthzInstance, conf, gomap.NewCache[testIndex, string, *testObject](ctx, testIndices, conf), shared, broadcaster)
+	require.NoError(t, err)
+	return first, second, shared
+}
+
+func Test_tieredCache_Get(t *testing.T) {
+	ctx := context.Background()
+	first, second, shared := prepareCaches(t, new(testBroadcaster))
+
+	shared.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	got, ok := first.Get(ctx, testIndexName, "foo")
+	require.True(t, ok)
+	assert.Equal(t, "id1", got.ID)
+
+	// the object is served from the local cache, even when gone from the shared cache.
+	require.NoError(t, shared.Truncate(ctx))
+	got, ok = first.Get(ctx, testIndexID, "id1")
+	require.True(t, ok)
+	assert.Equal(t, "id1", got.ID)
+
+	_, ok = second.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+func Test_tieredCache_Get_keysChanged(t *testing.T) {
+	ctx := context.Background()
+	first, _, shared := prepareCaches(t, new(testBroadcaster))
+
+	// the keys of the object no longer contain the key of the lookup,
+	// as it happens when they are computed from fields lost during encoding.
+	object := &testObject{ID: "id1", Name: []string{"foo"}}
+	shared.Set(ctx, object)
+	object.Name = nil
+	got, ok := first.Get(ctx, testIndexName, "foo")
+	require.True(t, ok)
+	assert.Equal(t, "id1", got.ID)
+
+	// the object is not promoted to the local cache.
+	require.NoError(t, shared.Truncate(ctx))
+	_, ok = first.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+// hookCache calls afterGet after an object was read from the wrapped cache.
+type hookCache struct {
+	cache.Cache[testIndex, string, *testObject]
+	afterGet func()
+}
+
+func (c *hookCache) Get(ctx context.Context, index testIndex, key string) (*testObject, bool) {
+	value, ok := c.Cache.Get(ctx, index, key)
+	if c.afterGet != nil {
+		c.afterGet()
+	}
+	return value, ok
+}
+
+func Test_tieredCache_Get_invalidatedWhileRead(t *testing.T) {
+	ctx := context.Background()
+	conf := cache.Config{Log: &logging.Config{Level: "debug"}}
+	broadcaster := new(testBroadcaster)
+	shared := &hookCache{Cache: gomap.NewCache[testIndex, string, *testObject](ctx, testIndices, conf)}
+	first, err := NewCache[testIndex, string, *testObject](ctx, cache.PurposeAuthzInstance, conf, gomap.NewCache[testIndex, string, *testObject](ctx, testIndices, conf), shared, broadcaster)
+	require.NoError(t, err)
+	second, err := NewCache[testIndex, string, *testObject](ctx, cache.PurposeAuthzInstance, conf, gomap.NewCache[testIndex, string, *testObject](ctx, testIndices, conf), shared, broadcaster)
+	require.NoError(t, err)
+
+	shared.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	// the object is updated by the second instance, after the first instance read it from the shared cache.
+	shared.afterGet = func() {
+		shared.afterGet = nil
+		require.NoError(t, second.Invalidate(ctx, testIndexID, "id1"))
+	}
+	_, ok := first.Get(ctx, testIndexID, "id1")
+	require.True(t, ok)
+
+	// the stale object was not promoted to the local cache of the first instance.
+	_, ok = first.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+func Test_tieredCache_concurrent(t *testing.T) {
+	ctx := context.Background()
+	first, second, _ := prepareCaches(t, new(testBroadcaster))
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					first.Get(ctx, testIndexID, "id1")
+				}
+			}
+		}()
+	}
+	for range 1000 {
+		second.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+		require.NoError(t, second.Invalidate(ctx, testIndexID, "id1"))
+	}
+	close(done)
+	wg.Wait()
+
+	// no stale object remains in the local cache after the last invalidation.
+	_, ok := first.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+func Test_tieredCache_Add(t *testing.T) {
+	ctx := context.Background()
+	first, second, shared := prepareCaches(t, new(testBroadcaster))
+
+	added, err := first.(cache.Adder[*testObject]).Add(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	require.NoError(t, err)
+	assert.True(t, added)
+	_, ok := shared.Get(ctx, testIndexID, "id1")
+	assert.True(t, ok)
+
+	// the keys are in use across all instances
+	added, err = second.(cache.Adder[*testObject]).Add(ctx, &testObject{ID: "id2", Name: []string{"foo"}})
+	require.NoError(t, err)
+	assert.False(t, added)
+}
+
+func Test_tieredCache_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	first, second, shared := prepareCaches(t, new(testBroadcaster))
+
+	first.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	_, ok := second.Get(ctx, testIndexID, "id1")
+	require.True(t, ok)
+
+	require.NoError(t, first.Invalidate(ctx, testIndexName, "foo"))
+	_, ok = shared.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+	_, ok = first.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+	_, ok = second.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+func Test_tieredCache_Delete(t *testing.T) {
+	ctx := context.Background()
+	first, second, shared := prepareCaches(t, new(testBroadcaster))
+
+	first.Set(ctx, &testObject{ID: "id1", Name: []string{"foo", "bar"}})
+	_, ok := second.Get(ctx, testIndexID, "id1")
+	require.True(t, ok)
+
+	require.NoError(t, second.Delete(ctx, testIndexName, "foo"))
+	for _, c := range []cache.Cache[testIndex, string, *testObject]{first, second, shared} {
+		_, ok = c.Get(ctx, testIndexName, "foo")
+		assert.False(t, ok)
+		_, ok = c.Get(ctx, testIndexName, "bar")
+		assert.True(t, ok)
+	}
+}
+
+func Test_tieredCache_Truncate(t *testing.T) {
+	ctx := context.Background()
+	first, second, shared := prepareCaches(t, new(testBroadcaster))
+
+	first.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	first.Set(ctx, &testObject{ID: "id2", Name: []string{"bar"}})
+	_, ok := second.Get(ctx, testIndexID, "id1")
+	require.True(t, ok)
+
+	require.NoError(t, first.Truncate(ctx))
+	for _, c := range []cache.Cache[testIndex, string, *testObject]{first, second, shared} {
+		_, ok = c.Get(ctx, testIndexID, "id1")
+		assert.False(t, ok)
+		_, ok = c.Get(ctx, testIndexID, "id2")
+		assert.False(t, ok)
+	}
+}
+
+func Test_tieredCache_broadcastError(t *testing.T) {
+	ctx := context.Background()
+	broadcastErr := errors.New("broadcast")
+	broadcaster := new(testBroadcaster)
+	first, _, shared := prepareCaches(t, broadcaster)
+
+	first.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	broadcaster.err = broadcastErr
+
+	err := first.Invalidate(ctx, testIndexID, "id1")
+	require.ErrorIs(t, err, broadcastErr)
+	// the shared and local caches are invalidated regardless
+	_, ok := first.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+	_, ok = shared.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+func Test_tieredCache_reset(t *testing.T) {
+	ctx := context.Background()
+	broadcaster := new(testBroadcaster)
+	first, _, shared := prepareCaches(t, broadcaster)
+
+	first.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	require.NoError(t, shared.Truncate(ctx))
+	for _, reset := range broadcaster.resets {
+		reset()
+	}
+	_, ok := first.Get(ctx, testIndexID, "id1")
+	assert.False(t, ok)
+}
+
+func Test_tieredCache_broadcastKeys(t *testing.T) {
+	ctx := context.Background()
+	broadcaster := new(testBroadcaster)
+	var messages int
+	require.NoError(t, broadcaster.Listen(ctx, Channel(cache.PurposeAuthzInstance), func([]byte) { messages++ }, func() {}))
+	first, _, _ := prepareCaches(t, broadcaster)
+
+	keys := make([]string, broadcastBatchSize*2+1)
+	for i := range keys {
+		keys[i] = string(rune('a' + i))
+	}
+	require.NoError(t, first.Invalidate(ctx, testIndexID, keys...))
+	assert.Equal(t, 3, messages)
+}
+
+func Test_tieredCache_inspect(t *testing.T) {
+	ctx := context.Background()
+	first, _, _ := prepareCaches(t, new(testBroadcaster))
+	first.Set(ctx, &testObject{ID: "id1", Name: []string{"foo"}})
+	first.Set(ctx, &testObject{ID: "id2", Name: []string{"bar"}})
+
+	keys, err := first.(cache.Inspector[testIndex]).KeysWithPrefix(ctx, testIndexID, "id")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"id1", "id2"}, keys)
+
+	size, err := first.(cache.Sizer).Size(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, size)
+}
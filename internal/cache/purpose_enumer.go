@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _PurposeName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callbackfederated_logout"
+const _PurposeName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callbackfederated_logoutoidc_clientssaml_service_providerslogin_policylabel_policyusertoken_replayback_channel_auth_poll"
 
-var _PurposeIndex = [...]uint8{0, 11, 25, 35, 47, 65, 81}
+var _PurposeIndex = [...]uint8{0, 11, 25, 35, 47, 65, 81, 93, 115, 127, 139, 143, 155, 177}
 
-const _PurposeLowerName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callbackfederated_logout"
+const _PurposeLowerName = "unspecifiedauthz_instancemilestonesorganizationid_p_form_callbackfederated_logoutoidc_clientssaml_service_providerslogin_policylabel_policyusertoken_replayback_channel_auth_poll"
 
 func (i Purpose) String() string {
 	if i < 0 || i >= Purpose(len(_PurposeIndex)-1) {
@@ -30,23 +30,44 @@ func _PurposeNoOp() {
 	_ = x[PurposeOrganization-(3)]
 	_ = x[PurposeIdPFormCallback-(4)]
 	_ = x[PurposeFederatedLogout-(5)]
+	_ = x[PurposeOIDCClients-(6)]
+	_ = x[PurposeSAMLServiceProviders-(7)]
+	_ = x[PurposeLoginPolicy-(8)]
+	_ = x[PurposeLabelPolicy-(9)]
+	_ = x[PurposeUser-(10)]
+	_ = x[PurposeTokenReplay-(11)]
+	_ = x[PurposeBackChannelAuthPoll-(12)]
 }
 
-var _PurposeValues = []Purpose{PurposeUnspecified, PurposeAuthzInstance, PurposeMilestones, PurposeOrganization, PurposeIdPFormCallback, PurposeFederatedLogout}
+var _PurposeValues = []Purpose{PurposeUnspecified, PurposeAuthzInstance, PurposeMilestones, PurposeOrganization, PurposeIdPFormCallback, PurposeFederatedLogout, PurposeOIDCClients, PurposeSAMLServiceProviders, PurposeLoginPolicy, PurposeLabelPolicy, PurposeUser, PurposeTokenReplay, PurposeBackChannelAuthPoll}
 
 var _PurposeNameToValueMap = map[string]Purpose{
-	_PurposeName[0:11]:       PurposeUnspecified,
-	_PurposeLowerName[0:11]:  PurposeUnspecified,
-	_PurposeName[11:25]:      PurposeAuthzInstance,
-	_PurposeLowerName[11:25]: PurposeAuthzInstance,
-	_PurposeName[25:35]:      PurposeMilestones,
-	_PurposeLowerName[25:35]: PurposeMilestones,
-	_PurposeName[35:47]:      PurposeOrganization,
-	_PurposeLowerName[35:47]: PurposeOrganization,
-	_PurposeName[47:65]:      PurposeIdPFormCallback,
-	_PurposeLowerName[47:65]: PurposeIdPFormCallback,
-	_PurposeName[65:81]:      PurposeFederatedLogout,
-	_PurposeLowerName[65:81]: PurposeFederatedLogout,
+	_PurposeName[0:11]:         PurposeUnspecified,
+	_PurposeLowerName[0:11]:    PurposeUnspecified,
+	_PurposeName[11:25]:        PurposeAuthzInstance,
+	_PurposeLowerName[11:25]:   PurposeAuthzInstance,
+	_PurposeName[25:35]:        PurposeMilestones,
+	_PurposeLowerName[25:35]:   PurposeMilestones,
+	_PurposeName[35:47]:        PurposeOrganization,
+	_PurposeLowerName[35:47]:   PurposeOrganization,
+	_PurposeName[47:65]:        PurposeIdPFormCallback,
+	_PurposeLowerName[47:65]:   PurposeIdPFormCallback,
+	_PurposeName[65:81]:        PurposeFederatedLogout,
+	_PurposeLowerName[65:81]:   PurposeFederatedLogout,
+	_PurposeName[81:93]:        PurposeOIDCClients,
+	_PurposeLowerName[81:93]:   PurposeOIDCClients,
+	_PurposeName[93:115]:       PurposeSAMLServiceProviders,
+	_PurposeLowerName[93:115]:  PurposeSAMLServiceProviders,
+	_PurposeName[115:127]:      PurposeLoginPolicy,
+	_PurposeLowerName[115:127]: PurposeLoginPolicy,
+	_PurposeName[127:139]:      PurposeLabelPolicy,
+	_PurposeLowerName[127:139]: PurposeLabelPolicy,
+	_PurposeName[139:143]:      PurposeUser,
+	_PurposeLowerName[139:143]: PurposeUser,
+	_PurposeName[143:155]:      PurposeTokenReplay,
+	_PurposeLowerName[143:155]: PurposeTokenReplay,
+	_PurposeName[155:177]:      PurposeBackChannelAuthPoll,
+	_PurposeLowerName[155:177]: PurposeBackChannelAuthPoll,
 }
 
 var _PurposeNames = []string{
@@ -56,6 +77,13 @@ var _PurposeNames = []string{
 	_PurposeName[35:47],
 	_PurposeName[47:65],
 	_PurposeName[65:81],
+	_PurposeName[81:93],
+	_PurposeName[93:115],
+	_PurposeName[115:127],
+	_PurposeName[127:139],
+	_PurposeName[139:143],
+	_PurposeName[143:155],
+	_PurposeName[155:177],
 }
 
 // PurposeString retrieves an enum value from the enum constants string name.
@@ -180,6 +180,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
 							),
 						),
 					),
@@ -235,6 +236,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
 							),
 						),
 					),
@@ -290,6 +292,7 @@ func TestCommands_SetExecutionRequest(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
 							),
 						),
 					),
@@ -862,6 +865,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("12345678"),
 							},
+							nil,
 						),
 					),
 					expectPushFailed(
@@ -962,6 +966,7 @@ func TestCommands_SetExecutionResponse(t *testing.T) {
 									KeyID:      "id",
 									Crypted:    []byte("12345678"),
 								},
+								nil,
 							),
 						),
 					),
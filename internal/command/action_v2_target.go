@@ -21,6 +21,7 @@ type AddTarget struct {
 	Endpoint         string
 	Timeout          time.Duration
 	InterruptOnError bool
+	RetryPolicy      *domain.TargetRetryPolicy
 
 	SigningKey string
 }
@@ -36,6 +37,9 @@ func (a *AddTarget) IsValid() error {
 	if err != nil || a.Endpoint == "" {
 		return zerrors.ThrowInvalidArgument(err, "COMMAND-1r2k6qo6wg", "Errors.Target.InvalidURL")
 	}
+	if !a.RetryPolicy.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-q8fmz1xk3v", "Errors.Target.InvalidRetryPolicy")
+	}
 
 	return nil
 }
@@ -76,6 +80,7 @@ func (c *Commands) AddTarget(ctx context.Context, add *AddTarget, resourceOwner
 		add.Timeout,
 		add.InterruptOnError,
 		code.Crypted,
+		add.RetryPolicy,
 	))
 	if err != nil {
 		return time.Time{}, err
@@ -94,6 +99,8 @@ type ChangeTarget struct {
 	Endpoint         *string
 	Timeout          *time.Duration
 	InterruptOnError *bool
+	// RetryPolicy replaces the retry policy of the target, an empty policy removes it.
+	RetryPolicy *domain.TargetRetryPolicy
 
 	ExpirationSigningKey bool
 	SigningKey           *string
@@ -115,6 +122,9 @@ func (a *ChangeTarget) IsValid() error {
 			return zerrors.ThrowInvalidArgument(err, "COMMAND-jsbaera7b6", "Errors.Target.InvalidURL")
 		}
 	}
+	if a.RetryPolicy != nil && *a.RetryPolicy != (domain.TargetRetryPolicy{}) && !a.RetryPolicy.IsValid() {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-m3w9ox5d2c", "Errors.Target.InvalidRetryPolicy")
+	}
 	return nil
 }
 
@@ -152,6 +162,7 @@ func (c *Commands) ChangeTarget(ctx context.Context, change *ChangeTarget, resou
 		change.Timeout,
 		change.InterruptOnError,
 		changedSigningKey,
+		change.RetryPolicy,
 	)
 	if changedEvent == nil {
 		return existing.WriteModel.ChangeDate, nil
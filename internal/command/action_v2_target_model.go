@@ -20,6 +20,7 @@ type TargetWriteModel struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       *crypto.CryptoValue
+	RetryPolicy      *domain.TargetRetryPolicy
 
 	State domain.TargetState
 }
@@ -44,6 +45,7 @@ func (wm *TargetWriteModel) Reduce() error {
 			wm.Timeout = e.Timeout
 			wm.State = domain.TargetActive
 			wm.SigningKey = e.SigningKey
+			wm.RetryPolicy = e.RetryPolicy
 		case *target.ChangedEvent:
 			if e.Name != nil {
 				wm.Name = *e.Name
@@ -63,6 +65,9 @@ func (wm *TargetWriteModel) Reduce() error {
 			if e.SigningKey != nil {
 				wm.SigningKey = e.SigningKey
 			}
+			if e.RetryPolicy != nil {
+				wm.RetryPolicy = normalizeRetryPolicy(e.RetryPolicy)
+			}
 		case *target.RemovedEvent:
 			wm.State = domain.TargetRemoved
 		}
@@ -91,6 +96,7 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	timeout *time.Duration,
 	interruptOnError *bool,
 	signingKey *crypto.CryptoValue,
+	retryPolicy *domain.TargetRetryPolicy,
 ) *target.ChangedEvent {
 	changes := make([]target.Changes, 0)
 	if name != nil && wm.Name != *name {
@@ -108,6 +114,9 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	if interruptOnError != nil && wm.InterruptOnError != *interruptOnError {
 		changes = append(changes, target.ChangeInterruptOnError(*interruptOnError))
 	}
+	if retryPolicy != nil && !wm.RetryPolicy.Equal(normalizeRetryPolicy(retryPolicy)) {
+		changes = append(changes, target.ChangeRetryPolicy(*retryPolicy))
+	}
 	// if signingkey is set, update it as it is encrypted
 	if signingKey != nil {
 		changes = append(changes, target.ChangeSigningKey(signingKey))
@@ -118,6 +127,14 @@ func (wm *TargetWriteModel) NewChangedEvent(
 	return target.NewChangedEvent(ctx, agg, changes)
 }
 
+// normalizeRetryPolicy returns nil for an empty policy, which removes the retry policy of a target.
+func normalizeRetryPolicy(policy *domain.TargetRetryPolicy) *domain.TargetRetryPolicy {
+	if policy == nil || *policy == (domain.TargetRetryPolicy{}) {
+		return nil
+	}
+	return policy
+}
+
 type TargetsExistsWriteModel struct {
 	eventstore.WriteModel
 	ids         []string
@@ -120,6 +120,27 @@ func TestCommands_AddTarget(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			"invalid retry policy, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:     "name",
+					Timeout:  time.Second,
+					Endpoint: "https://example.com",
+					RetryPolicy: &domain.TargetRetryPolicy{
+						MaxAttempts: 0,
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			"unique constraint failed, error",
 			fields{
@@ -140,6 +161,7 @@ func TestCommands_AddTarget(t *testing.T) {
 								KeyID:      "id",
 								Crypted:    []byte("12345678"),
 							},
+							nil,
 						),
 					),
 				),
@@ -246,6 +268,49 @@ func TestCommands_AddTarget(t *testing.T) {
 				id: "id1",
 			},
 		},
+		{
+			"push with retry policy ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						func() eventstore.Command {
+							event := targetAddEvent("id1", "instance")
+							event.TargetType = domain.TargetTypeAsync
+							event.RetryPolicy = &domain.TargetRetryPolicy{
+								MaxAttempts:    5,
+								InitialBackoff: time.Second,
+								MaxBackoff:     time.Minute,
+								Jitter:         0.2,
+							}
+							return event
+						}(),
+					),
+				),
+				idGenerator:                 mock.ExpectID(t, "id1"),
+				newEncryptedCodeWithDefault: mockEncryptedCodeWithDefault("12345678", time.Hour),
+				defaultSecretGenerators:     &SecretGenerators{},
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddTarget{
+					Name:       "name",
+					TargetType: domain.TargetTypeAsync,
+					Endpoint:   "https://example.com",
+					Timeout:    time.Second,
+					RetryPolicy: &domain.TargetRetryPolicy{
+						MaxAttempts:    5,
+						InitialBackoff: time.Second,
+						MaxBackoff:     time.Minute,
+						Jitter:         0.2,
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				id: "id1",
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -381,6 +446,28 @@ func TestCommands_ChangeTarget(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			"retry policy invalid, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					RetryPolicy: &domain.TargetRetryPolicy{
+						MaxAttempts: 3,
+						Jitter:      2,
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			"not found, error",
 			fields{
@@ -490,6 +577,84 @@ func TestCommands_ChangeTarget(t *testing.T) {
 			},
 			res{},
 		},
+		{
+			"push retry policy ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeRetryPolicy(domain.TargetRetryPolicy{
+									MaxAttempts:    3,
+									InitialBackoff: time.Second,
+								}),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					RetryPolicy: &domain.TargetRetryPolicy{
+						MaxAttempts:    3,
+						InitialBackoff: time.Second,
+					},
+				},
+				resourceOwner: "instance",
+			},
+			res{},
+		},
+		{
+			"remove retry policy ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							targetAddEvent("id1", "instance"),
+						),
+						eventFromEventPusher(
+							target.NewChangedEvent(context.Background(),
+								target.NewAggregate("id1", "instance"),
+								[]target.Changes{
+									target.ChangeRetryPolicy(domain.TargetRetryPolicy{
+										MaxAttempts: 3,
+									}),
+								},
+							),
+						),
+					),
+					expectPush(
+						target.NewChangedEvent(context.Background(),
+							target.NewAggregate("id1", "instance"),
+							[]target.Changes{
+								target.ChangeRetryPolicy(domain.TargetRetryPolicy{}),
+							},
+						),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeTarget{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "id1",
+					},
+					RetryPolicy: &domain.TargetRetryPolicy{},
+				},
+				resourceOwner: "instance",
+			},
+			res{},
+		},
 		{
 			"push full ok",
 			fields{
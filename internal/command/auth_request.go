@@ -30,6 +30,8 @@ type AuthRequest struct {
 	HintUserID       *string
 	NeedRefreshToken bool
 	Issuer           string
+	// AuthorizationDetails are the (validated) authorization_details (RFC 9396) requested by the client.
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 type CurrentAuthRequest struct {
@@ -75,6 +77,7 @@ func (c *Commands) AddAuthRequest(ctx context.Context, authRequest *AuthRequest)
 		authRequest.HintUserID,
 		authRequest.NeedRefreshToken,
 		authRequest.Issuer,
+		authRequest.AuthorizationDetails,
 	))
 	if err != nil {
 		return nil, err
@@ -188,6 +191,8 @@ func authRequestWriteModelToCurrentAuthRequest(writeModel *AuthRequestWriteModel
 			LoginHint:     writeModel.LoginHint,
 			HintUserID:    writeModel.HintUserID,
 			Issuer:        writeModel.Issuer,
+
+			AuthorizationDetails: writeModel.AuthorizationDetails,
 		},
 		SessionID:   writeModel.SessionID,
 		UserID:      writeModel.UserID,
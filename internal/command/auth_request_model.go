@@ -37,6 +37,8 @@ type AuthRequestWriteModel struct {
 	AuthRequestState domain.AuthRequestState
 	NeedRefreshToken bool
 	Issuer           string
+
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 func NewAuthRequestWriteModel(ctx context.Context, id string) *AuthRequestWriteModel {
@@ -70,6 +72,7 @@ func (m *AuthRequestWriteModel) Reduce() error {
 			m.AuthRequestState = domain.AuthRequestStateAdded
 			m.NeedRefreshToken = e.NeedRefreshToken
 			m.Issuer = e.Issuer
+			m.AuthorizationDetails = e.AuthorizationDetails
 		case *authrequest.SessionLinkedEvent:
 			m.SessionID = e.SessionID
 			m.UserID = e.UserID
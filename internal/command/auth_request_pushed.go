@@ -0,0 +1,130 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// PushedAuthRequest holds the (already validated) parameters
+// of an OAuth 2.0 Pushed Authorization Request (RFC 9126).
+type PushedAuthRequest struct {
+	ID                   string
+	ClientID             string
+	RedirectURI          string
+	State                string
+	Nonce                string
+	Scope                []string
+	ResponseType         string
+	ResponseMode         string
+	Display              string
+	Prompt               []string
+	MaxAge               *uint
+	UILocales            []string
+	IDTokenHint          string
+	LoginHint            string
+	ACRValues            []string
+	CodeChallenge        string
+	CodeChallengeMethod  string
+	AuthorizationDetails string
+	Expiration           time.Time
+}
+
+// AddPushedAuthRequest stores the pushed authorization request, which is valid for the passed lifetime.
+func (c *Commands) AddPushedAuthRequest(ctx context.Context, request *PushedAuthRequest, lifetime time.Duration) (_ *PushedAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if request.ClientID == "" || request.RedirectURI == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx2u", "Errors.AuthRequest.Invalid")
+	}
+	request.ID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	request.Expiration = time.Now().Add(lifetime)
+	writeModel := NewPushedAuthRequestWriteModel(ctx, request.ID)
+	err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedEvent(
+		ctx,
+		writeModel.aggregate,
+		request.ClientID,
+		request.RedirectURI,
+		request.State,
+		request.Nonce,
+		request.Scope,
+		request.ResponseType,
+		request.ResponseMode,
+		request.Display,
+		request.Prompt,
+		request.MaxAge,
+		request.UILocales,
+		request.IDTokenHint,
+		request.LoginHint,
+		request.ACRValues,
+		request.CodeChallenge,
+		request.CodeChallengeMethod,
+		request.Expiration,
+		request.AuthorizationDetails,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// ConsumePushedAuthRequest returns the pushed authorization request of the client and marks it as used,
+// so it can only be used once.
+func (c *Commands) ConsumePushedAuthRequest(ctx context.Context, id, clientID string) (_ *PushedAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	writeModel := NewPushedAuthRequestWriteModel(ctx, id)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if writeModel.AuthRequestState == domain.AuthRequestStateUnspecified || writeModel.ClientID != clientID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ahl2o", "Errors.AuthRequest.NotExisting")
+	}
+	if writeModel.AuthRequestState != domain.AuthRequestStatePushed {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fie5s", "Errors.AuthRequest.AlreadyHandled")
+	}
+	if !writeModel.Expiration.After(time.Now()) {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eev1a", "Errors.AuthRequest.Expired")
+	}
+	// the unique constraint of the consumed event fails, if the request was consumed concurrently since it was read
+	if err = c.pushAppendAndReduce(ctx, writeModel, authrequest.NewPushedConsumedEvent(ctx, writeModel.aggregate)); err != nil {
+		if zerrors.IsErrorAlreadyExists(err) {
+			return nil, zerrors.ThrowPreconditionFailed(err, "COMMAND-Oo9ae", "Errors.AuthRequest.AlreadyHandled")
+		}
+		return nil, err
+	}
+	return pushedAuthRequestWriteModelToPushedAuthRequest(writeModel), nil
+}
+
+func pushedAuthRequestWriteModelToPushedAuthRequest(writeModel *PushedAuthRequestWriteModel) *PushedAuthRequest {
+	return &PushedAuthRequest{
+		ID:                   writeModel.AggregateID,
+		ClientID:             writeModel.ClientID,
+		RedirectURI:          writeModel.RedirectURI,
+		State:                writeModel.State,
+		Nonce:                writeModel.Nonce,
+		Scope:                writeModel.Scope,
+		ResponseType:         writeModel.ResponseType,
+		ResponseMode:         writeModel.ResponseMode,
+		Display:              writeModel.Display,
+		Prompt:               writeModel.Prompt,
+		MaxAge:               writeModel.MaxAge,
+		UILocales:            writeModel.UILocales,
+		IDTokenHint:          writeModel.IDTokenHint,
+		LoginHint:            writeModel.LoginHint,
+		ACRValues:            writeModel.ACRValues,
+		CodeChallenge:        writeModel.CodeChallenge,
+		CodeChallengeMethod:  writeModel.CodeChallengeMethod,
+		AuthorizationDetails: writeModel.AuthorizationDetails,
+		Expiration:           writeModel.Expiration,
+	}
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+)
+
+type PushedAuthRequestWriteModel struct {
+	eventstore.WriteModel
+	aggregate *eventstore.Aggregate
+
+	ClientID             string
+	RedirectURI          string
+	State                string
+	Nonce                string
+	Scope                []string
+	ResponseType         string
+	ResponseMode         string
+	Display              string
+	Prompt               []string
+	MaxAge               *uint
+	UILocales            []string
+	IDTokenHint          string
+	LoginHint            string
+	ACRValues            []string
+	CodeChallenge        string
+	CodeChallengeMethod  string
+	AuthorizationDetails string
+	Expiration           time.Time
+	AuthRequestState     domain.AuthRequestState
+}
+
+func NewPushedAuthRequestWriteModel(ctx context.Context, id string) *PushedAuthRequestWriteModel {
+	return &PushedAuthRequestWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: id,
+		},
+		aggregate: &authrequest.NewAggregate(id, authz.GetInstance(ctx).InstanceID()).Aggregate,
+	}
+}
+
+func (m *PushedAuthRequestWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *authrequest.PushedEvent:
+			m.ClientID = e.ClientID
+			m.RedirectURI = e.RedirectURI
+			m.State = e.State
+			m.Nonce = e.Nonce
+			m.Scope = e.Scope
+			m.ResponseType = e.ResponseType
+			m.ResponseMode = e.ResponseMode
+			m.Display = e.Display
+			m.Prompt = e.Prompt
+			m.MaxAge = e.MaxAge
+			m.UILocales = e.UILocales
+			m.IDTokenHint = e.IDTokenHint
+			m.LoginHint = e.LoginHint
+			m.ACRValues = e.ACRValues
+			m.CodeChallenge = e.CodeChallenge
+			m.CodeChallengeMethod = e.CodeChallengeMethod
+			m.AuthorizationDetails = e.AuthorizationDetails
+			m.Expiration = e.Expiration
+			m.AuthRequestState = domain.AuthRequestStatePushed
+		case *authrequest.PushedConsumedEvent:
+			m.AuthRequestState = domain.AuthRequestStatePushedConsumed
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *PushedAuthRequestWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(authrequest.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			authrequest.PushedType,
+			authrequest.PushedConsumedType,
+		).
+		Builder()
+}
@@ -0,0 +1,257 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/authrequest"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddPushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "clientID")
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx     context.Context
+		request *PushedAuthRequest
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			"missing client id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: mockCtx,
+				request: &PushedAuthRequest{
+					RedirectURI: "redirectURI",
+				},
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx2u", "Errors.AuthRequest.Invalid"),
+		},
+		{
+			"missing redirect uri",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: mockCtx,
+				request: &PushedAuthRequest{
+					ClientID: "clientID",
+				},
+			},
+			zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohx2u", "Errors.AuthRequest.Invalid"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.AddPushedAuthRequest(tt.args.ctx, tt.args.request, time.Minute)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Nil(t, got)
+		})
+	}
+}
+
+func TestCommands_ConsumePushedAuthRequest(t *testing.T) {
+	mockCtx := authz.NewMockContext("instanceID", "orgID", "clientID")
+	expiration := time.Now().Add(time.Minute)
+	pushedEvent := func(expiration time.Time) eventstore.Command {
+		return authrequest.NewPushedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate,
+			"clientID",
+			"redirectURI",
+			"state",
+			"nonce",
+			[]string{"openid"},
+			"code",
+			"query",
+			"page",
+			[]string{"login"},
+			nil,
+			[]string{"en"},
+			"",
+			"loginHint",
+			nil,
+			"challenge",
+			"S256",
+			expiration,
+			`[{"type":"payment_initiation"}]`,
+		)
+	}
+	consumedEvent := func() eventstore.Command {
+		aggregate := authrequest.NewAggregate("id", "instanceID").Aggregate
+		return authrequest.NewPushedConsumedEvent(mockCtx, &aggregate)
+	}
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx      context.Context
+		id       string
+		clientID string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *PushedAuthRequest
+		wantErr error
+	}{
+		{
+			"not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowNotFound(nil, "COMMAND-Ahl2o", "Errors.AuthRequest.NotExisting"),
+		},
+		{
+			"other client",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(pushedEvent(expiration)),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "otherClientID",
+			},
+			nil,
+			zerrors.ThrowNotFound(nil, "COMMAND-Ahl2o", "Errors.AuthRequest.NotExisting"),
+		},
+		{
+			"already consumed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(pushedEvent(expiration)),
+						eventFromEventPusher(
+							authrequest.NewPushedConsumedEvent(mockCtx, &authrequest.NewAggregate("id", "instanceID").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Fie5s", "Errors.AuthRequest.AlreadyHandled"),
+		},
+		{
+			"expired",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(pushedEvent(time.Now().Add(-time.Minute))),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Eev1a", "Errors.AuthRequest.Expired"),
+		},
+		{
+			"consumed concurrently",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(pushedEvent(expiration)),
+					),
+					expectPushFailed(zerrors.ThrowAlreadyExists(nil, "V3-DKcYh", "Errors.AuthRequest.AlreadyHandled"),
+						consumedEvent(),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			nil,
+			zerrors.ThrowPreconditionFailed(nil, "COMMAND-Oo9ae", "Errors.AuthRequest.AlreadyHandled"),
+		},
+		{
+			"consumed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(pushedEvent(expiration)),
+					),
+					expectPush(
+						consumedEvent(),
+					),
+				),
+			},
+			args{
+				ctx:      mockCtx,
+				id:       "id",
+				clientID: "clientID",
+			},
+			&PushedAuthRequest{
+				ID:                   "id",
+				ClientID:             "clientID",
+				RedirectURI:          "redirectURI",
+				State:                "state",
+				Nonce:                "nonce",
+				Scope:                []string{"openid"},
+				ResponseType:         "code",
+				ResponseMode:         "query",
+				Display:              "page",
+				Prompt:               []string{"login"},
+				UILocales:            []string{"en"},
+				LoginHint:            "loginHint",
+				CodeChallenge:        "challenge",
+				CodeChallengeMethod:  "S256",
+				AuthorizationDetails: `[{"type":"payment_initiation"}]`,
+				Expiration:           expiration,
+			},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.ConsumePushedAuthRequest(tt.args.ctx, tt.args.id, tt.args.clientID)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.want != nil {
+				require.NotNil(t, got)
+				assert.True(t, tt.want.Expiration.Equal(got.Expiration))
+				got.Expiration = tt.want.Expiration
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
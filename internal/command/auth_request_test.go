@@ -63,6 +63,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 								nil,
 								false,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -103,6 +104,7 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 							gu.Ptr("hintUserID"),
 							false,
 							"issuer",
+							domain.AuthorizationDetails{{"type": "payment_initiation"}},
 						),
 					),
 				),
@@ -130,6 +132,8 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 					LoginHint:  gu.Ptr("loginHint"),
 					HintUserID: gu.Ptr("hintUserID"),
 					Issuer:     "issuer",
+
+					AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
 				},
 			},
 			&CurrentAuthRequest{
@@ -154,6 +158,8 @@ func TestCommands_AddAuthRequest(t *testing.T) {
 					LoginHint:  gu.Ptr("loginHint"),
 					HintUserID: gu.Ptr("hintUserID"),
 					Issuer:     "issuer",
+
+					AuthorizationDetails: domain.AuthorizationDetails{{"type": "payment_initiation"}},
 				},
 			},
 			nil,
@@ -239,6 +245,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 						eventFromEventPusher(
@@ -282,6 +289,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -324,6 +332,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -364,6 +373,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -427,6 +437,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -479,6 +490,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -569,6 +581,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -660,6 +673,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -752,6 +766,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -845,6 +860,7 @@ func TestCommands_LinkSessionToAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -970,6 +986,7 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1009,6 +1026,7 @@ func TestCommands_FailAuthRequest(t *testing.T) {
 								nil,
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1113,6 +1131,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr("hintUserID"),
 								true,
 								"issuer",
+								nil,
 							),
 						),
 					),
@@ -1152,6 +1171,7 @@ func TestCommands_AddAuthRequestCode(t *testing.T) {
 								gu.Ptr("hintUserID"),
 								true,
 								"issuer",
+								nil,
 							),
 						),
 						eventFromEventPusher(
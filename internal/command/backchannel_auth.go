@@ -0,0 +1,259 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// BackChannelAuthRequest is a client initiated backchannel authentication (CIBA) request
+// for a user, which was already resolved from the hint of the request.
+type BackChannelAuthRequest struct {
+	AuthReqID        string
+	ClientID         string
+	UserID           string
+	UserOrgID        string
+	Scopes           []string
+	Audience         []string
+	BindingMessage   string
+	Expires          time.Time
+	NeedRefreshToken bool
+	// ClientNotificationEndpoint is only set for clients using the ping mode.
+	ClientNotificationEndpoint string
+	// ClientNotificationToken is used by ZITADEL to authenticate the ping to the ClientNotificationEndpoint.
+	ClientNotificationToken string
+}
+
+func (c *Commands) AddBackChannelAuth(ctx context.Context, request *BackChannelAuthRequest) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	aggr := backchannelauth.NewAggregate(request.AuthReqID, authz.GetInstance(ctx).InstanceID())
+	model := NewBackChannelAuthWriteModel(request.AuthReqID, aggr.ResourceOwner)
+
+	var notificationToken *crypto.CryptoValue
+	if request.ClientNotificationEndpoint != "" {
+		if request.ClientNotificationToken == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohz3k", "Errors.BackChannelAuth.NotificationTokenMissing")
+		}
+		notificationToken, err = crypto.Encrypt([]byte(request.ClientNotificationToken), c.keyAlgorithm)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, backchannelauth.NewAddedEvent(
+		ctx,
+		aggr,
+		request.ClientID,
+		request.UserID,
+		request.UserOrgID,
+		request.Scopes,
+		request.Audience,
+		request.BindingMessage,
+		request.Expires,
+		request.NeedRefreshToken,
+		request.ClientNotificationEndpoint,
+		notificationToken,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(model, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+// ApproveBackChannelAuthWithSession approves the backchannel authentication request
+// with a session of the user the request was initiated for.
+func (c *Commands) ApproveBackChannelAuthWithSession(
+	ctx context.Context,
+	authReqID,
+	sessionID,
+	sessionToken string,
+) (*domain.ObjectDetails, error) {
+	model, err := c.getBackChannelAuthWriteModelByID(ctx, authReqID)
+	if err != nil {
+		return nil, err
+	}
+	if !model.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ooL4e", "Errors.BackChannelAuth.NotFound")
+	}
+	if model.State != domain.BackChannelAuthStateInitiated {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bae5c", "Errors.BackChannelAuth.AlreadyHandled")
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel)
+	if err != nil {
+		return nil, err
+	}
+	if err = sessionWriteModel.CheckIsActive(); err != nil {
+		return nil, err
+	}
+	if err := c.sessionTokenVerifier(ctx, sessionToken, sessionWriteModel.AggregateID, sessionWriteModel.TokenID); err != nil {
+		return nil, err
+	}
+	// the request was initiated for a specific user, so only a session of this user can approve it
+	if sessionWriteModel.UserID != model.UserID {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tho4i", "Errors.BackChannelAuth.UserMismatch")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, backchannelauth.NewApprovedEvent(
+		ctx,
+		model.aggregate,
+		sessionWriteModel.AuthMethodTypes(),
+		sessionWriteModel.AuthenticationTime(),
+		sessionWriteModel.PreferredLanguage,
+		sessionWriteModel.UserAgent,
+		sessionID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(model, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+func (c *Commands) CancelBackChannelAuth(ctx context.Context, authReqID string, reason domain.BackChannelAuthCanceled) (*domain.ObjectDetails, error) {
+	model, err := c.getBackChannelAuthWriteModelByID(ctx, authReqID)
+	if err != nil {
+		return nil, err
+	}
+	if !model.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-iu4Ee", "Errors.BackChannelAuth.NotFound")
+	}
+	if model.State != domain.BackChannelAuthStateInitiated {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-ahT6u", "Errors.BackChannelAuth.AlreadyHandled")
+	}
+	if err := c.checkPermission(ctx, domain.PermissionSessionLink, model.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, backchannelauth.NewCanceledEvent(ctx, model.aggregate, reason))
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(model, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeModelToObjectDetails(&model.WriteModel), nil
+}
+
+func (c *Commands) getBackChannelAuthWriteModelByID(ctx context.Context, authReqID string) (*BackChannelAuthWriteModel, error) {
+	model := &BackChannelAuthWriteModel{
+		WriteModel: eventstore.WriteModel{AggregateID: authReqID},
+	}
+	err := c.eventstore.FilterToQueryReducer(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	model.aggregate = backchannelauth.NewAggregate(model.AggregateID, model.InstanceID)
+	return model, nil
+}
+
+type BackChannelAuthStateError domain.BackChannelAuthState
+
+func (e BackChannelAuthStateError) Error() string {
+	return fmt.Sprintf("backchannel auth state not approved: %s", domain.BackChannelAuthState(e).String())
+}
+
+// CreateOIDCSessionFromBackChannelAuth creates a new OIDC session if the backchannel authentication
+// was approved by the user.
+// A [BackChannelAuthStateError] is returned if the request was not approved,
+// containing a [domain.BackChannelAuthState] which can be used to inform the client about the state.
+//
+// Same as for the device authorization, an explicit state takes precedence over expiry.
+func (c *Commands) CreateOIDCSessionFromBackChannelAuth(ctx context.Context, authReqID, clientID, backChannelLogoutURI, dpopJKT, certificateThumbprint string, clientLifetimes ClientTokenLifetimes) (_ *OIDCSession, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	model, err := c.getBackChannelAuthWriteModelByID(ctx, authReqID)
+	if err != nil {
+		return nil, err
+	}
+	// the auth_req_id must only be redeemed by the client which initiated the request
+	if model.State.Exists() && model.ClientID != clientID {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Aeb4u", "Errors.BackChannelAuth.NotFound")
+	}
+
+	switch model.State {
+	case domain.BackChannelAuthStateApproved:
+		break
+	case domain.BackChannelAuthStateUndefined:
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Ohs1e", "Errors.BackChannelAuth.NotFound")
+
+	case domain.BackChannelAuthStateInitiated:
+		if model.Expires.Before(time.Now()) {
+			c.asyncPush(ctx, backchannelauth.NewCanceledEvent(ctx, model.aggregate, domain.BackChannelAuthCanceledExpired))
+			return nil, BackChannelAuthStateError(domain.BackChannelAuthStateExpired)
+		}
+		fallthrough
+	case domain.BackChannelAuthStateDenied, domain.BackChannelAuthStateExpired, domain.BackChannelAuthStateDone:
+		fallthrough
+	default:
+		return nil, BackChannelAuthStateError(model.State)
+	}
+
+	cmd, err := c.newOIDCSessionAddEvents(ctx, model.UserID, model.UserOrgID, clientLifetimes)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd.AddSession(ctx,
+		model.UserID,
+		model.UserOrgID,
+		model.SessionID,
+		model.ClientID,
+		model.Audience,
+		model.Scopes,
+		model.UserAuthMethods,
+		model.AuthTime,
+		"",
+		model.PreferredLanguage,
+		model.UserAgent,
+		nil,
+	)
+	cmd.BindDPoPKey(ctx, dpopJKT)
+	cmd.BindCertificate(ctx, certificateThumbprint)
+	cmd.RegisterLogout(ctx, model.SessionID, model.UserID, model.ClientID, backChannelLogoutURI)
+	if err = cmd.AddAccessToken(ctx, model.Scopes, model.UserID, model.UserOrgID, domain.TokenReasonAuthRequest, nil, nil); err != nil {
+		return nil, err
+	}
+
+	if model.NeedRefreshToken {
+		if err = cmd.AddRefreshToken(ctx, model.UserID); err != nil {
+			return nil, err
+		}
+	}
+	// the unique constraint of the done event fails, if the auth_req_id was redeemed concurrently since it was read
+	cmd.BackChannelAuthRequestDone(ctx, model.aggregate)
+	session, err := cmd.PushEvents(ctx)
+	if zerrors.IsErrorAlreadyExists(err) {
+		return nil, BackChannelAuthStateError(domain.BackChannelAuthStateDone)
+	}
+	return session, err
+}
+
+func (cmd *OIDCSessionEvents) BackChannelAuthRequestDone(ctx context.Context, backChannelAuthAggregate *eventstore.Aggregate) {
+	cmd.events = append(cmd.events, backchannelauth.NewDoneEvent(ctx, backChannelAuthAggregate))
+}
@@ -0,0 +1,91 @@
+package command
+
+import (
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+)
+
+type BackChannelAuthWriteModel struct {
+	eventstore.WriteModel
+	aggregate *eventstore.Aggregate
+
+	ClientID                   string
+	UserID                     string
+	UserOrgID                  string
+	Scopes                     []string
+	Audience                   []string
+	BindingMessage             string
+	Expires                    time.Time
+	NeedRefreshToken           bool
+	ClientNotificationEndpoint string
+	ClientNotificationToken    *crypto.CryptoValue
+	State                      domain.BackChannelAuthState
+	UserAuthMethods            []domain.UserAuthMethodType
+	AuthTime                   time.Time
+	PreferredLanguage          *language.Tag
+	UserAgent                  *domain.UserAgent
+	SessionID                  string
+}
+
+func NewBackChannelAuthWriteModel(authReqID, resourceOwner string) *BackChannelAuthWriteModel {
+	return &BackChannelAuthWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   authReqID,
+			ResourceOwner: resourceOwner,
+		},
+		aggregate: backchannelauth.NewAggregate(authReqID, resourceOwner),
+	}
+}
+
+func (m *BackChannelAuthWriteModel) Reduce() error {
+	for _, event := range m.Events {
+		switch e := event.(type) {
+		case *backchannelauth.AddedEvent:
+			m.ClientID = e.ClientID
+			m.UserID = e.UserID
+			m.UserOrgID = e.UserOrgID
+			m.Scopes = e.Scopes
+			m.Audience = e.Audience
+			m.BindingMessage = e.BindingMessage
+			m.Expires = e.Expires
+			m.NeedRefreshToken = e.NeedRefreshToken
+			m.ClientNotificationEndpoint = e.ClientNotificationEndpoint
+			m.ClientNotificationToken = e.ClientNotificationToken
+			m.State = e.State
+		case *backchannelauth.ApprovedEvent:
+			m.State = domain.BackChannelAuthStateApproved
+			m.UserAuthMethods = e.UserAuthMethods
+			m.AuthTime = e.AuthTime
+			m.PreferredLanguage = e.PreferredLanguage
+			m.UserAgent = e.UserAgent
+			m.SessionID = e.SessionID
+		case *backchannelauth.CanceledEvent:
+			m.State = e.Reason.State()
+		case *backchannelauth.DoneEvent:
+			m.State = domain.BackChannelAuthStateDone
+		}
+	}
+
+	return m.WriteModel.Reduce()
+}
+
+func (m *BackChannelAuthWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(m.ResourceOwner).
+		AddQuery().
+		AggregateTypes(backchannelauth.AggregateType).
+		AggregateIDs(m.AggregateID).
+		EventTypes(
+			backchannelauth.AddedEventType,
+			backchannelauth.ApprovedEventType,
+			backchannelauth.CanceledEventType,
+			backchannelauth.DoneEventType,
+		).
+		Builder()
+}
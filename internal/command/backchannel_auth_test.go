@@ -0,0 +1,553 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/repository"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/repository/oidcsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	pushErr := errors.New("pushErr")
+	now := time.Now()
+
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		request     *BackChannelAuthRequest
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "ping without notification token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			request: &BackChannelAuthRequest{
+				AuthReqID:                  "authReqID",
+				ClientID:                   "clientID",
+				UserID:                     "userID",
+				UserOrgID:                  "org1",
+				Scopes:                     []string{"openid"},
+				Expires:                    now,
+				ClientNotificationEndpoint: "https://client.example.com/cb",
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohz3k", "Errors.BackChannelAuth.NotificationTokenMissing"),
+		},
+		{
+			name: "push error",
+			fields: fields{
+				eventstore: expectEventstore(expectPushFailed(pushErr,
+					backchannelauth.NewAddedEvent(
+						ctx,
+						backchannelauth.NewAggregate("authReqID", "instance1"),
+						"clientID", "userID", "org1",
+						[]string{"openid"}, []string{"projectID", "clientID"},
+						"", now, false, "", nil,
+					),
+				)),
+			},
+			request: &BackChannelAuthRequest{
+				AuthReqID: "authReqID",
+				ClientID:  "clientID",
+				UserID:    "userID",
+				UserOrgID: "org1",
+				Scopes:    []string{"openid"},
+				Audience:  []string{"projectID", "clientID"},
+				Expires:   now,
+			},
+			wantErr: pushErr,
+		},
+		{
+			name: "poll, success",
+			fields: fields{
+				eventstore: expectEventstore(expectPush(
+					backchannelauth.NewAddedEvent(
+						ctx,
+						backchannelauth.NewAggregate("authReqID", "instance1"),
+						"clientID", "userID", "org1",
+						[]string{"openid", "offline_access"}, []string{"projectID", "clientID"},
+						"binding", now, true, "", nil,
+					),
+				)),
+			},
+			request: &BackChannelAuthRequest{
+				AuthReqID:        "authReqID",
+				ClientID:         "clientID",
+				UserID:           "userID",
+				UserOrgID:        "org1",
+				Scopes:           []string{"openid", "offline_access"},
+				Audience:         []string{"projectID", "clientID"},
+				BindingMessage:   "binding",
+				Expires:          now,
+				NeedRefreshToken: true,
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+		{
+			name: "ping, success",
+			fields: fields{
+				eventstore: expectEventstore(expectPush(
+					backchannelauth.NewAddedEvent(
+						ctx,
+						backchannelauth.NewAggregate("authReqID", "instance1"),
+						"clientID", "userID", "org1",
+						[]string{"openid"}, []string{"projectID", "clientID"},
+						"", now, false, "https://client.example.com/cb",
+						&crypto.CryptoValue{
+							CryptoType: crypto.TypeEncryption,
+							Algorithm:  "enc",
+							KeyID:      "id",
+							Crypted:    []byte("notificationToken"),
+						},
+					),
+				)),
+			},
+			request: &BackChannelAuthRequest{
+				AuthReqID:                  "authReqID",
+				ClientID:                   "clientID",
+				UserID:                     "userID",
+				UserOrgID:                  "org1",
+				Scopes:                     []string{"openid"},
+				Audience:                   []string{"projectID", "clientID"},
+				Expires:                    now,
+				ClientNotificationEndpoint: "https://client.example.com/cb",
+				ClientNotificationToken:    "notificationToken",
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.fields.eventstore(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			gotDetails, err := c.AddBackChannelAuth(ctx, tt.request)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_ApproveBackChannelAuthWithSession(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	now := time.Now()
+	userAgent := &domain.UserAgent{
+		FingerprintID: gu.Ptr("fp1"),
+		IP:            net.ParseIP("1.2.3.4"),
+		Description:   gu.Ptr("firefox"),
+		Header:        http.Header{"foo": []string{"bar"}},
+	}
+	addedEvent := func() eventstore.Event {
+		return eventFromEventPusherWithInstanceID(
+			"instance1",
+			backchannelauth.NewAddedEvent(
+				ctx,
+				backchannelauth.NewAggregate("authReqID", "instance1"),
+				"clientID", "userID", "org1",
+				[]string{"openid"}, []string{"projectID", "clientID"},
+				"", now, false, "", nil,
+			),
+		)
+	}
+	sessionEvents := func(userID string) []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				session.NewAddedEvent(ctx,
+					&session.NewAggregate("sessionID", "instance1").Aggregate,
+					userAgent,
+				),
+			),
+			eventFromEventPusher(
+				session.NewUserCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					userID, "org1", testNow, &language.Afrikaans),
+			),
+			eventFromEventPusher(
+				session.NewPasswordCheckedEvent(ctx, &session.NewAggregate("sessionID", "instance1").Aggregate,
+					testNow),
+			),
+		}
+	}
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		tokenVerifier   func(ctx context.Context, sessionToken, sessionID, tokenID string) (err error)
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-ooL4e", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "already handled, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						addedEvent(),
+						eventFromEventPusherWithInstanceID(
+							"instance1",
+							backchannelauth.NewCanceledEvent(
+								ctx,
+								backchannelauth.NewAggregate("authReqID", "instance1"),
+								domain.BackChannelAuthCanceledDenied,
+							)),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Bae5c", "Errors.BackChannelAuth.AlreadyHandled"),
+		},
+		{
+			name: "missing permission, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(addedEvent()),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			wantErr: zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"),
+		},
+		{
+			name: "session of other user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(addedEvent()),
+					expectFilter(sessionEvents("otherUserID")...),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Tho4i", "Errors.BackChannelAuth.UserMismatch"),
+		},
+		{
+			name: "approved",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(addedEvent()),
+					expectFilter(sessionEvents("userID")...),
+					expectPush(
+						backchannelauth.NewApprovedEvent(
+							ctx, backchannelauth.NewAggregate("authReqID", "instance1"),
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							testNow, &language.Afrikaans, userAgent,
+							"sessionID",
+						),
+					),
+				),
+				tokenVerifier:   newMockTokenVerifierValid(),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:           tt.fields.eventstore(t),
+				sessionTokenVerifier: tt.fields.tokenVerifier,
+				checkPermission:      tt.fields.checkPermission,
+			}
+			gotDetails, err := c.ApproveBackChannelAuthWithSession(ctx, "authReqID", "sessionID", "sessionToken")
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_CancelBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	now := time.Now()
+
+	type fields struct {
+		eventstore      func(*testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	tests := []struct {
+		name        string
+		fields      fields
+		wantDetails *domain.ObjectDetails
+		wantErr     error
+	}{
+		{
+			name: "not found error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowNotFound(nil, "COMMAND-iu4Ee", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "denied",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(eventFromEventPusherWithInstanceID(
+						"instance1",
+						backchannelauth.NewAddedEvent(
+							ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+							"clientID", "userID", "org1",
+							[]string{"openid"}, []string{"projectID", "clientID"},
+							"", now, false, "", nil,
+						),
+					)),
+					expectPush(
+						backchannelauth.NewCanceledEvent(
+							ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+							domain.BackChannelAuthCanceledDenied,
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			wantDetails: &domain.ObjectDetails{
+				ResourceOwner: "instance1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			gotDetails, err := c.CancelBackChannelAuth(ctx, "authReqID", domain.BackChannelAuthCanceledDenied)
+			require.ErrorIs(t, err, tt.wantErr)
+			assertObjectDetails(t, tt.wantDetails, gotDetails)
+		})
+	}
+}
+
+func TestCommands_CreateOIDCSessionFromBackChannelAuth(t *testing.T) {
+	ctx := authz.WithInstanceID(context.Background(), "instance1")
+	addedEvent := func(expires time.Time) eventstore.Event {
+		return eventFromEventPusherWithInstanceID(
+			"instance1",
+			backchannelauth.NewAddedEvent(
+				ctx,
+				backchannelauth.NewAggregate("authReqID", "instance1"),
+				"clientID", "userID", "org1",
+				[]string{"openid"}, []string{"audience"},
+				"", expires, false, "", nil,
+			),
+		)
+	}
+	approvedEvents := func() []eventstore.Event {
+		added := addedEvent(time.Now().Add(time.Minute))
+		added.(*repository.Event).Seq = 1
+		approved := eventFromEventPusherWithInstanceID(
+			"instance1",
+			backchannelauth.NewApprovedEvent(ctx,
+				backchannelauth.NewAggregate("authReqID", "instance1"),
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				testNow, &language.Afrikaans, nil, "sessionID",
+			),
+		)
+		approved.Seq = 2
+		return []eventstore.Event{added, approved}
+	}
+	userAddedEvent := func() eventstore.Event {
+		return user.NewHumanAddedEvent(
+			ctx,
+			&user.NewAggregate("userID", "org1").Aggregate,
+			"username",
+			"firstname",
+			"lastname",
+			"nickname",
+			"displayname",
+			language.English,
+			domain.GenderUnspecified,
+			"email",
+			false,
+		)
+	}
+	sessionEvents := func() []eventstore.Command {
+		aggregate := backchannelauth.NewAggregate("authReqID", "instance1")
+		return []eventstore.Command{
+			oidcsession.NewAddedEvent(context.Background(), &oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+				"userID", "org1", "sessionID", "clientID", []string{"audience"}, []string{"openid"},
+				[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, "", &language.Afrikaans, nil,
+				nil,
+			),
+			oidcsession.NewAccessTokenAddedEvent(context.Background(),
+				&oidcsession.NewAggregate("V2_oidcSessionID", "org1").Aggregate,
+				"at_accessTokenID", []string{"openid"}, time.Hour, domain.TokenReasonAuthRequest, nil,
+				nil,
+			),
+			user.NewUserTokenV2AddedEvent(context.Background(), &user.NewAggregate("userID", "org1").Aggregate, "at_accessTokenID"),
+			backchannelauth.NewDoneEvent(ctx, aggregate),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+		clientID    string
+		want        *OIDCSession
+		wantErr     error
+	}{
+		{
+			name: "filter error",
+			eventstore: expectEventstore(
+				expectFilterError(io.ErrClosedPipe),
+			),
+			clientID: "clientID",
+			wantErr:  io.ErrClosedPipe,
+		},
+		{
+			name: "not found",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			clientID: "clientID",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Ohs1e", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "other client",
+			eventstore: expectEventstore(
+				expectFilter(addedEvent(time.Now().Add(time.Minute))),
+			),
+			clientID: "otherClientID",
+			wantErr:  zerrors.ThrowNotFound(nil, "COMMAND-Aeb4u", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "not yet approved",
+			eventstore: expectEventstore(
+				expectFilter(addedEvent(time.Now().Add(time.Minute))),
+			),
+			clientID: "clientID",
+			wantErr:  BackChannelAuthStateError(domain.BackChannelAuthStateInitiated),
+		},
+		{
+			name: "expired",
+			eventstore: expectEventstore(
+				expectFilter(addedEvent(time.Now().Add(-time.Minute))),
+				expectPushSlow(time.Second, backchannelauth.NewCanceledEvent(ctx,
+					backchannelauth.NewAggregate("authReqID", "instance1"),
+					domain.BackChannelAuthCanceledExpired,
+				)),
+			),
+			clientID: "clientID",
+			wantErr:  BackChannelAuthStateError(domain.BackChannelAuthStateExpired),
+		},
+		{
+			name: "denied",
+			eventstore: expectEventstore(
+				expectFilter(
+					addedEvent(time.Now().Add(time.Minute)),
+					eventFromEventPusherWithInstanceID(
+						"instance1",
+						backchannelauth.NewCanceledEvent(ctx,
+							backchannelauth.NewAggregate("authReqID", "instance1"),
+							domain.BackChannelAuthCanceledDenied,
+						),
+					),
+				),
+			),
+			clientID: "clientID",
+			wantErr:  BackChannelAuthStateError(domain.BackChannelAuthStateDenied),
+		},
+		{
+			name: "approved, redeemed concurrently",
+			eventstore: expectEventstore(
+				expectFilter(approvedEvents()...),
+				expectFilter(userAddedEvent()),
+				expectFilter(), // token lifetime
+				expectPushFailed(zerrors.ThrowAlreadyExists(nil, "V3-DKcYh", "Errors.BackChannelAuth.AlreadyHandled"),
+					sessionEvents()...,
+				),
+			),
+			idGenerator: mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+			clientID:    "clientID",
+			wantErr:     BackChannelAuthStateError(domain.BackChannelAuthStateDone),
+		},
+		{
+			name: "approved, success",
+			eventstore: expectEventstore(
+				expectFilter(approvedEvents()...),
+				expectFilter(userAddedEvent()),
+				expectFilter(), // token lifetime
+				expectPush(sessionEvents()...),
+			),
+			idGenerator: mock.NewIDGeneratorExpectIDs(t, "oidcSessionID", "accessTokenID"),
+			clientID:    "clientID",
+			want: &OIDCSession{
+				TokenID:           "V2_oidcSessionID-at_accessTokenID",
+				ClientID:          "clientID",
+				UserID:            "userID",
+				Audience:          []string{"audience"},
+				Expiration:        time.Time{}.Add(time.Hour),
+				Scope:             []string{"openid"},
+				AuthMethods:       []domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+				AuthTime:          testNow,
+				PreferredLanguage: &language.Afrikaans,
+				Reason:            domain.TokenReasonAuthRequest,
+				SessionID:         "sessionID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:                 tt.eventstore(t),
+				idGenerator:                tt.idGenerator,
+				defaultAccessTokenLifetime: time.Hour,
+				keyAlgorithm:               crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.CreateOIDCSessionFromBackChannelAuth(ctx, "authReqID", tt.clientID, "", "", "", ClientTokenLifetimes{})
+			c.jobs.Wait()
+			require.ErrorIs(t, err, tt.wantErr)
+			if got != nil {
+				assert.WithinRange(t, got.AuthTime, tt.want.AuthTime.Add(-time.Second), tt.want.AuthTime.Add(time.Second))
+				got.AuthTime = time.Time{}
+				tt.want.AuthTime = time.Time{}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
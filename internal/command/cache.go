@@ -13,7 +13,7 @@ type Caches struct {
 
 func startCaches(background context.Context, connectors connector.Connectors) (_ *Caches, err error) {
 	caches := new(Caches)
-	caches.milestones, err = connector.StartCache[milestoneIndex, string, *MilestonesReached](background, []milestoneIndex{milestoneIndexInstanceID}, cache.PurposeMilestones, connectors.Config.Milestones, connectors)
+	caches.milestones, err = connector.StartCache[milestoneIndex, string, *MilestonesReached](background, []milestoneIndex{milestoneIndexInstanceID}, cache.PurposeMilestones, connectors.Config.Milestones, connectors, connector.WithInstanceIndex(milestoneIndexInstanceID))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,354 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/muhlemmer/gu"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	project_repo "github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// InitialAccessToken allows the holder to register OIDC clients in the organization
+// using OpenID Connect Dynamic Client Registration (RFC 7591).
+// Each client is registered in a project created for it.
+type InitialAccessToken struct {
+	OrgID          string
+	ExpirationDate time.Time
+	Policy         domain.ClientRegistrationPolicy
+
+	// TokenID and Token are set when the token is added.
+	// The Token is not stored and can only be returned once.
+	TokenID string
+	Token   string
+}
+
+func (c *Commands) AddInitialAccessToken(ctx context.Context, token *InitialAccessToken) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if token.OrgID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eiqu3", "Errors.IDMissing")
+	}
+	if !token.ExpirationDate.After(time.Now()) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooY4i", "Errors.ClientRegistration.ExpirationInvalid")
+	}
+	if !token.Policy.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Eeph9", "Errors.ClientRegistration.PolicyInvalid")
+	}
+	if err = c.checkOrgExists(ctx, token.OrgID); err != nil {
+		return nil, err
+	}
+	// the holder of the token creates projects in the organization
+	if err = c.checkPermissionCreateProject(ctx, token.OrgID); err != nil {
+		return nil, err
+	}
+	token.TokenID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	token.Token, err = newClientRegistrationToken(c.keyAlgorithm, token.TokenID, token.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewInitialAccessTokenAddedEvent(
+		ctx,
+		&org.NewAggregate(token.OrgID).Aggregate,
+		token.TokenID,
+		token.ExpirationDate,
+		&token.Policy,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// RemoveInitialAccessToken removes the initial access token, so no further clients can be registered with it.
+// Clients already registered with the token are not affected.
+func (c *Commands) RemoveInitialAccessToken(ctx context.Context, orgID, tokenID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if orgID == "" || tokenID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Jae5u", "Errors.IDMissing")
+	}
+	writeModel := NewInitialAccessTokenWriteModel(orgID, tokenID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	if !writeModel.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ieG7a", "Errors.ClientRegistration.InitialAccessTokenNotFound")
+	}
+	if err = c.checkPermissionCreateProject(ctx, writeModel.AggregateID); err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, org.NewInitialAccessTokenRemovedEvent(
+		ctx,
+		OrgAggregateFromWriteModel(&writeModel.WriteModel),
+		tokenID,
+	))
+	if err != nil {
+		return nil, err
+	}
+	return pushedEventsToObjectDetails(pushedEvents), nil
+}
+
+// OIDCClientRegistration is the result of the dynamic registration of an OIDC client.
+type OIDCClientRegistration struct {
+	App *domain.OIDCApp
+	// RegistrationAccessToken allows the client to read, update and delete its registration (RFC 7592).
+	RegistrationAccessToken string
+	// RegistrationAccessTokenExpiration is zero if the token does not expire.
+	RegistrationAccessTokenExpiration time.Time
+}
+
+// RegisterOIDCClient adds the OIDC application to a new project in the organization of the initial access token (RFC 7591),
+// if the metadata of the client is allowed by the policy of the token.
+// The project is named after the client and keeps the clients of different registrants apart.
+// No permission check is done, as the initial access token authorizes the registration.
+func (c *Commands) RegisterOIDCClient(ctx context.Context, initialAccessToken string, app *domain.OIDCApp) (_ *OIDCClientRegistration, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	ids, err := parseClientRegistrationToken(c.keyAlgorithm, initialAccessToken, 2)
+	if err != nil {
+		return nil, err
+	}
+	tokenWriteModel := NewInitialAccessTokenWriteModel(ids[1], ids[0])
+	if err = c.eventstore.FilterToQueryReducer(ctx, tokenWriteModel); err != nil {
+		return nil, err
+	}
+	if !tokenWriteModel.usable() {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Ohth4", "Errors.ClientRegistration.InvalidToken")
+	}
+	if err = checkClientRegistrationPolicy(&tokenWriteModel.Policy, app); err != nil {
+		return nil, err
+	}
+
+	app.AggregateID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	app.AppID, err = c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	if app.AppName = strings.TrimSpace(app.AppName); app.AppName == "" {
+		app.AppName = app.AppID
+	}
+	if !app.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ahR5e", "Errors.Project.App.Invalid")
+	}
+	if !app.TLSClientAuthValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ooS3e", "Errors.Project.App.TLSClientAuthInvalid")
+	}
+
+	projectAgg := &project_repo.NewAggregate(app.AggregateID, tokenWriteModel.AggregateID).Aggregate
+	events := []eventstore.Command{
+		// the project name must be unique in the organization, unlike the client name
+		project_repo.NewProjectAddedEvent(ctx, projectAgg, app.AppName+" ("+app.AggregateID+")", false, false, false, domain.PrivateLabelingSettingUnspecified),
+	}
+	appEvents, plain, err := c.oidcApplicationAddedEvents(ctx, projectAgg, app)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, appEvents...)
+	registrationTokenID, err := c.idGenerator.Next()
+	if err != nil {
+		return nil, err
+	}
+	var expiration time.Time
+	if lifetime := tokenWriteModel.Policy.RegistrationAccessTokenLifetime; lifetime > 0 {
+		expiration = time.Now().Add(lifetime)
+	}
+	registrationToken, err := newClientRegistrationToken(c.keyAlgorithm, registrationTokenID, app.AggregateID, app.AppID)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, project_repo.NewOIDCConfigRegisteredEvent(ctx, projectAgg, app.AppID, tokenWriteModel.TokenID, registrationTokenID, expiration))
+
+	projectPostCommit, err := c.projectCreatedMilestone(ctx, &events)
+	if err != nil {
+		return nil, err
+	}
+	appPostCommit, err := c.applicationCreatedMilestone(ctx, &events)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	projectPostCommit(ctx)
+	appPostCommit(ctx)
+	appWriteModel := NewOIDCApplicationWriteModelWithAppID(app.AggregateID, app.AppID, tokenWriteModel.AggregateID)
+	if err = AppendAndReduce(appWriteModel, pushedEvents...); err != nil {
+		return nil, err
+	}
+	result := oidcWriteModelToOIDCConfig(appWriteModel)
+	result.ClientSecretString = plain
+	return &OIDCClientRegistration{
+		App:                               result,
+		RegistrationAccessToken:           registrationToken,
+		RegistrationAccessTokenExpiration: expiration,
+	}, nil
+}
+
+// RegisteredOIDCClient returns the dynamically registered OIDC application of the registration access token (RFC 7592).
+func (c *Commands) RegisteredOIDCClient(ctx context.Context, registrationAccessToken, clientID string) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	_, appWriteModel, err := c.oidcClientRegistrationByToken(ctx, registrationAccessToken, clientID)
+	if err != nil {
+		return nil, err
+	}
+	return oidcWriteModelToOIDCConfig(appWriteModel), nil
+}
+
+// UpdateRegisteredOIDCClient updates the dynamically registered OIDC application of the registration access token (RFC 7592).
+// The metadata of the client must still be allowed by the policy of the initial access token used for the registration.
+func (c *Commands) UpdateRegisteredOIDCClient(ctx context.Context, registrationAccessToken string, app *domain.OIDCApp) (_ *domain.OIDCApp, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	registration, appWriteModel, err := c.oidcClientRegistrationByToken(ctx, registrationAccessToken, app.ClientID)
+	if err != nil {
+		return nil, err
+	}
+	tokenWriteModel := NewInitialAccessTokenWriteModel(registration.ResourceOwner, registration.InitialAccessTokenID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, tokenWriteModel); err != nil {
+		return nil, err
+	}
+	if err = checkClientRegistrationPolicy(&tokenWriteModel.Policy, app); err != nil {
+		return nil, err
+	}
+	app.AggregateID = appWriteModel.AggregateID
+	app.AppID = appWriteModel.AppID
+	if !app.IsValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hei4o", "Errors.Project.App.OIDCConfigInvalid")
+	}
+
+	changedEvent, hasChanged, err := oidcApplicationChangedEvent(ctx, appWriteModel, app)
+	if err != nil {
+		return nil, err
+	}
+	if hasChanged {
+		pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+		if err != nil {
+			return nil, err
+		}
+		if err = AppendAndReduce(appWriteModel, pushedEvents...); err != nil {
+			return nil, err
+		}
+	}
+	return oidcWriteModelToOIDCConfig(appWriteModel), nil
+}
+
+// RemoveRegisteredOIDCClient removes the project of the dynamically registered OIDC application of the registration access token (RFC 7592).
+func (c *Commands) RemoveRegisteredOIDCClient(ctx context.Context, registrationAccessToken, clientID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	registration, _, err := c.oidcClientRegistrationByToken(ctx, registrationAccessToken, clientID)
+	if err != nil {
+		return err
+	}
+	projectWriteModel, err := c.getProjectWriteModelByID(ctx, registration.AggregateID, registration.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	// SAML applications might have been added to the project after the registration
+	samlEntityIDs, err := c.getSAMLEntityIdsWriteModelByProjectID(ctx, registration.AggregateID, registration.ResourceOwner)
+	if err != nil {
+		return err
+	}
+	uniqueConstraints := make([]*eventstore.UniqueConstraint, len(samlEntityIDs.EntityIDs))
+	for i, entityID := range samlEntityIDs.EntityIDs {
+		uniqueConstraints[i] = project_repo.NewRemoveSAMLConfigEntityIDUniqueConstraint(entityID.EntityID)
+	}
+	_, err = c.eventstore.Push(ctx, project_repo.NewProjectRemovedEvent(
+		ctx,
+		ProjectAggregateFromWriteModel(&projectWriteModel.WriteModel),
+		projectWriteModel.Name,
+		uniqueConstraints,
+	))
+	return err
+}
+
+// oidcClientRegistrationByToken returns the registration and the application of the registration access token,
+// which must be valid and belong to the client.
+func (c *Commands) oidcClientRegistrationByToken(ctx context.Context, registrationAccessToken, clientID string) (*OIDCClientRegistrationWriteModel, *OIDCApplicationWriteModel, error) {
+	ids, err := parseClientRegistrationToken(c.keyAlgorithm, registrationAccessToken, 3)
+	if err != nil {
+		return nil, nil, err
+	}
+	registration := NewOIDCClientRegistrationWriteModel(ids[1], ids[2], "")
+	if err = c.eventstore.FilterToQueryReducer(ctx, registration); err != nil {
+		return nil, nil, err
+	}
+	if !registration.usableWith(ids[0]) {
+		return nil, nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-Ua4ie", "Errors.ClientRegistration.InvalidToken")
+	}
+	appWriteModel, err := c.getOIDCAppWriteModel(ctx, registration.AggregateID, registration.AppID, registration.ResourceOwner)
+	if err != nil {
+		return nil, nil, err
+	}
+	if appWriteModel.State == domain.AppStateUnspecified || appWriteModel.State == domain.AppStateRemoved || appWriteModel.ClientID != clientID {
+		return nil, nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-aeC4u", "Errors.ClientRegistration.InvalidToken")
+	}
+	return registration, appWriteModel, nil
+}
+
+func checkClientRegistrationPolicy(policy *domain.ClientRegistrationPolicy, app *domain.OIDCApp) error {
+	if !policy.GrantTypesAllowed(app.GrantTypes) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-ieX9u", "Errors.ClientRegistration.GrantTypeNotAllowed")
+	}
+	if !policy.RedirectURIsAllowed(app.RedirectUris) || !policy.RedirectURIsAllowed(app.PostLogoutRedirectUris) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Quo8a", "Errors.ClientRegistration.RedirectURINotAllowed")
+	}
+	if !policy.BackChannelURIsAllowed(gu.Value(app.BackChannelLogoutURI), gu.Value(app.BackChannelClientNotificationEndpoint)) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ohd4u", "Errors.ClientRegistration.BackChannelURINotAllowed")
+	}
+	if !policy.LimitTokenLifetimes(app) {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-aiT0e", "Errors.ClientRegistration.TokenLifetimeNotAllowed")
+	}
+	return nil
+}
+
+// newClientRegistrationToken creates an opaque token of the encrypted IDs,
+// used for initial access tokens (token ID and organization ID) and
+// registration access tokens (token ID, project ID and app ID).
+func newClientRegistrationToken(algorithm crypto.EncryptionAlgorithm, ids ...string) (string, error) {
+	encrypted, err := algorithm.Encrypt([]byte(strings.Join(ids, ":")))
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encrypted), nil
+}
+
+func parseClientRegistrationToken(algorithm crypto.EncryptionAlgorithm, token string, expectedIDs int) ([]string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "COMMAND-oo9Ai", "Errors.ClientRegistration.InvalidToken")
+	}
+	decrypted, err := algorithm.DecryptString(decoded, algorithm.EncryptionKeyID())
+	if err != nil {
+		return nil, zerrors.ThrowUnauthenticated(err, "COMMAND-Eifi4", "Errors.ClientRegistration.InvalidToken")
+	}
+	ids := strings.Split(decrypted, ":")
+	if len(ids) != expectedIDs || slices.Contains(ids, "") {
+		return nil, zerrors.ThrowUnauthenticated(nil, "COMMAND-ahB1o", "Errors.ClientRegistration.InvalidToken")
+	}
+	return ids, nil
+}
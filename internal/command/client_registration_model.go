@@ -0,0 +1,167 @@
+package command
+
+import (
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+)
+
+type InitialAccessTokenWriteModel struct {
+	eventstore.WriteModel
+
+	TokenID        string
+	ExpirationDate time.Time
+	Policy         domain.ClientRegistrationPolicy
+	State          domain.InitialAccessTokenState
+}
+
+func NewInitialAccessTokenWriteModel(orgID, tokenID string) *InitialAccessTokenWriteModel {
+	return &InitialAccessTokenWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   orgID,
+			ResourceOwner: orgID,
+		},
+		TokenID: tokenID,
+	}
+}
+
+func (wm *InitialAccessTokenWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.InitialAccessTokenAddedEvent:
+			if e.TokenID != wm.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.InitialAccessTokenRemovedEvent:
+			if e.TokenID != wm.TokenID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *org.OrgRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InitialAccessTokenWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *org.InitialAccessTokenAddedEvent:
+			wm.ExpirationDate = e.ExpirationDate
+			wm.Policy = domain.ClientRegistrationPolicy{
+				AllowedGrantTypes:               e.AllowedGrantTypes,
+				RedirectURIPatterns:             e.RedirectURIPatterns,
+				RegistrationAccessTokenLifetime: e.RegistrationAccessTokenLifetime,
+				MaxAccessTokenLifetime:          e.MaxAccessTokenLifetime,
+				MaxIDTokenLifetime:              e.MaxIDTokenLifetime,
+				MaxRefreshTokenLifetime:         e.MaxRefreshTokenLifetime,
+			}
+			wm.State = domain.InitialAccessTokenStateActive
+		case *org.InitialAccessTokenRemovedEvent:
+			wm.State = domain.InitialAccessTokenStateRemoved
+		case *org.OrgRemovedEvent:
+			wm.State = domain.InitialAccessTokenStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *InitialAccessTokenWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.InitialAccessTokenAddedType,
+			org.InitialAccessTokenRemovedType,
+			org.OrgRemovedEventType,
+		).Builder()
+}
+
+// usable checks if the token exists and is not expired.
+func (wm *InitialAccessTokenWriteModel) usable() bool {
+	return wm.State.Exists() && wm.ExpirationDate.After(time.Now())
+}
+
+// OIDCClientRegistrationWriteModel holds the registration of a dynamically registered OIDC application.
+type OIDCClientRegistrationWriteModel struct {
+	eventstore.WriteModel
+
+	AppID                             string
+	InitialAccessTokenID              string
+	RegistrationAccessTokenID         string
+	RegistrationAccessTokenExpiration time.Time
+	State                             domain.AppState
+}
+
+func NewOIDCClientRegistrationWriteModel(projectID, appID, resourceOwner string) *OIDCClientRegistrationWriteModel {
+	return &OIDCClientRegistrationWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   projectID,
+			ResourceOwner: resourceOwner,
+		},
+		AppID: appID,
+	}
+}
+
+func (wm *OIDCClientRegistrationWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *project.OIDCConfigRegisteredEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ApplicationRemovedEvent:
+			if e.AppID != wm.AppID {
+				continue
+			}
+			wm.WriteModel.AppendEvents(e)
+		case *project.ProjectRemovedEvent:
+			wm.WriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OIDCClientRegistrationWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *project.OIDCConfigRegisteredEvent:
+			wm.InitialAccessTokenID = e.InitialAccessTokenID
+			wm.RegistrationAccessTokenID = e.RegistrationAccessTokenID
+			wm.RegistrationAccessTokenExpiration = e.RegistrationAccessTokenExpiration
+			wm.State = domain.AppStateActive
+		case *project.ApplicationRemovedEvent:
+			wm.State = domain.AppStateRemoved
+		case *project.ProjectRemovedEvent:
+			wm.State = domain.AppStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OIDCClientRegistrationWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			project.OIDCConfigRegisteredType,
+			project.ApplicationRemovedType,
+			project.ProjectRemovedType,
+		).Builder()
+}
+
+// usableWith checks if the registration exists and the registration access token matches and is not expired.
+func (wm *OIDCClientRegistrationWriteModel) usableWith(registrationAccessTokenID string) bool {
+	if wm.State != domain.AppStateActive || wm.RegistrationAccessTokenID != registrationAccessTokenID {
+		return false
+	}
+	return wm.RegistrationAccessTokenExpiration.IsZero() || wm.RegistrationAccessTokenExpiration.After(time.Now())
+}
@@ -0,0 +1,936 @@
+package command
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommands_AddInitialAccessToken(t *testing.T) {
+	expiration := time.Now().Add(time.Hour)
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type res struct {
+		want  *domain.ObjectDetails
+		token string
+		err   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		token  *InitialAccessToken
+		res    res
+	}{
+		{
+			name: "missing org, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token: &InitialAccessToken{
+				ExpirationDate: expiration,
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "expired, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token: &InitialAccessToken{
+				OrgID:          "org1",
+				ExpirationDate: time.Now().Add(-time.Hour),
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid policy, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token: &InitialAccessToken{
+				OrgID:          "org1",
+				ExpirationDate: expiration,
+				Policy: domain.ClientRegistrationPolicy{
+					RedirectURIPatterns: []string{"https://[.example.com"},
+				},
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "org not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			token: &InitialAccessToken{
+				OrgID:          "org1",
+				ExpirationDate: expiration,
+			},
+			res: res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							org.NewOrgAddedEvent(context.Background(),
+								&org.NewAggregate("org1").Aggregate,
+								"org",
+							),
+						),
+					),
+					expectPush(
+						org.NewInitialAccessTokenAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"token1",
+							expiration,
+							&domain.ClientRegistrationPolicy{
+								AllowedGrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+								RedirectURIPatterns:             []string{"https://*.example.com/callback"},
+								RegistrationAccessTokenLifetime: time.Hour,
+							},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "token1"),
+			},
+			token: &InitialAccessToken{
+				OrgID:          "org1",
+				ExpirationDate: expiration,
+				Policy: domain.ClientRegistrationPolicy{
+					AllowedGrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					RedirectURIPatterns:             []string{"https://*.example.com/callback"},
+					RegistrationAccessTokenLifetime: time.Hour,
+				},
+			},
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+				token: base64.RawURLEncoding.EncodeToString([]byte("token1:org1")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				keyAlgorithm:    crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				checkPermission: newMockPermissionCheckAllowed(),
+			}
+			got, err := c.AddInitialAccessToken(context.Background(), tt.token)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.res.want, got)
+			assert.Equal(t, tt.res.token, tt.token.Token)
+		})
+	}
+}
+
+func TestCommands_RemoveInitialAccessToken(t *testing.T) {
+	type res struct {
+		want *domain.ObjectDetails
+		err  func(error) bool
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		tokenID    string
+		res        res
+	}{
+		{
+			name:       "missing id, error",
+			eventstore: expectEventstore(),
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "not existing, error",
+			eventstore: expectEventstore(
+				expectFilter(),
+			),
+			tokenID: "token1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "already removed, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewInitialAccessTokenAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"token1",
+							time.Now().Add(time.Hour),
+							&domain.ClientRegistrationPolicy{},
+						),
+					),
+					eventFromEventPusher(
+						org.NewInitialAccessTokenRemovedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"token1",
+						),
+					),
+				),
+			),
+			tokenID: "token1",
+			res: res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			name: "ok",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						org.NewInitialAccessTokenAddedEvent(context.Background(),
+							&org.NewAggregate("org1").Aggregate,
+							"token1",
+							time.Now().Add(time.Hour),
+							&domain.ClientRegistrationPolicy{},
+						),
+					),
+				),
+				expectPush(
+					org.NewInitialAccessTokenRemovedEvent(context.Background(),
+						&org.NewAggregate("org1").Aggregate,
+						"token1",
+					),
+				),
+			),
+			tokenID: "token1",
+			res: res{
+				want: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.eventstore(t),
+				checkPermission: newMockPermissionCheckAllowed(),
+			}
+			got, err := c.RemoveInitialAccessToken(context.Background(), "org1", tt.tokenID)
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assertObjectDetails(t, tt.res.want, got)
+		})
+	}
+}
+
+func TestCommands_RegisterOIDCClient(t *testing.T) {
+	initialAccessToken := base64.RawURLEncoding.EncodeToString([]byte("token1:org1"))
+	tokenAddedEvent := func(expiration time.Time) eventstore.Event {
+		return eventFromEventPusher(
+			org.NewInitialAccessTokenAddedEvent(context.Background(),
+				&org.NewAggregate("org1").Aggregate,
+				"token1",
+				expiration,
+				&domain.ClientRegistrationPolicy{
+					AllowedGrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode, domain.OIDCGrantTypeRefreshToken},
+					RedirectURIPatterns:    []string{"https://*.example.com/*"},
+					MaxAccessTokenLifetime: time.Hour,
+				},
+			),
+		)
+	}
+	newApp := func() *domain.OIDCApp {
+		return &domain.OIDCApp{
+			AppName:         "client",
+			OIDCVersion:     gu.Ptr(domain.OIDCVersionV1),
+			RedirectUris:    []string{"https://app.example.com/callback"},
+			ResponseTypes:   []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+			GrantTypes:      []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+			ApplicationType: gu.Ptr(domain.OIDCApplicationTypeWeb),
+			AuthMethodType:  gu.Ptr(domain.OIDCAuthMethodTypeBasic),
+		}
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type res struct {
+		wantClientID          string
+		wantSecret            string
+		wantRegistrationToken string
+		err                   func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		token  string
+		app    func() *domain.OIDCApp
+		res    res
+	}{
+		{
+			name: "invalid token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token: "invalid",
+			app:   newApp,
+			res: res{
+				err: zerrors.IsUnauthenticated,
+			},
+		},
+		{
+			name: "registration access token, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			token: base64.RawURLEncoding.EncodeToString([]byte("registration1:project1:app1")),
+			app:   newApp,
+			res: res{
+				err: zerrors.IsUnauthenticated,
+			},
+		},
+		{
+			name: "token not existing, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			token: initialAccessToken,
+			app:   newApp,
+			res: res{
+				err: zerrors.IsUnauthenticated,
+			},
+		},
+		{
+			name: "token expired, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(-time.Minute)),
+					),
+				),
+			},
+			token: initialAccessToken,
+			app:   newApp,
+			res: res{
+				err: zerrors.IsUnauthenticated,
+			},
+		},
+		{
+			name: "grant type not allowed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+				),
+			},
+			token: initialAccessToken,
+			app: func() *domain.OIDCApp {
+				app := newApp()
+				app.ResponseTypes = []domain.OIDCResponseType{domain.OIDCResponseTypeIDToken}
+				app.GrantTypes = []domain.OIDCGrantType{domain.OIDCGrantTypeImplicit}
+				return app
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "redirect uri not allowed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+				),
+			},
+			token: initialAccessToken,
+			app: func() *domain.OIDCApp {
+				app := newApp()
+				app.RedirectUris = []string{"https://evil.com/callback"}
+				return app
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "back-channel uri not allowed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+				),
+			},
+			token: initialAccessToken,
+			app: func() *domain.OIDCApp {
+				app := newApp()
+				app.BackChannelLogoutURI = gu.Ptr("http://169.254.169.254/latest/meta-data")
+				return app
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "token lifetime not allowed, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+				),
+			},
+			token: initialAccessToken,
+			app: func() *domain.OIDCApp {
+				app := newApp()
+				app.AccessTokenLifetime = gu.Ptr(2 * time.Hour)
+				return app
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "invalid app, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "project1", "app1"),
+			},
+			token: initialAccessToken,
+			app: func() *domain.OIDCApp {
+				app := newApp()
+				app.GrantTypes = []domain.OIDCGrantType{domain.OIDCGrantTypeRefreshToken}
+				return app
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+					expectPush(
+						project.NewProjectAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"client (project1)",
+							false,
+							false,
+							false,
+							domain.PrivateLabelingSettingUnspecified,
+						),
+						project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"client",
+						),
+						project.NewOIDCConfigAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							domain.OIDCVersionV1,
+							"app1",
+							"client1",
+							"secret",
+							[]string{"https://app.example.com/callback"},
+							[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+							[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+							domain.OIDCApplicationTypeWeb,
+							domain.OIDCAuthMethodTypeBasic,
+							nil,
+							false,
+							domain.OIDCTokenTypeBearer,
+							false,
+							false,
+							false,
+							0,
+							nil,
+							false,
+							"",
+							domain.LoginVersionUnspecified,
+							"",
+							false,
+							false,
+							"",
+							"",
+							"",
+							time.Hour,
+							0,
+							0,
+						),
+						project.NewOIDCConfigRegisteredEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"token1",
+							"registration1",
+							time.Time{},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "project1", "app1", "client1", "registration1"),
+			},
+			token: initialAccessToken,
+			app:   newApp,
+			res: res{
+				wantClientID:          "client1",
+				wantSecret:            "secret",
+				wantRegistrationToken: base64.RawURLEncoding.EncodeToString([]byte("registration1:project1:app1")),
+			},
+		},
+		{
+			name: "public client without name, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						tokenAddedEvent(time.Now().Add(time.Hour)),
+					),
+					expectPush(
+						project.NewProjectAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1 (project1)",
+							false,
+							false,
+							false,
+							domain.PrivateLabelingSettingUnspecified,
+						),
+						project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app1",
+						),
+						project.NewOIDCConfigAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							domain.OIDCVersionV1,
+							"app1",
+							"client1",
+							"",
+							[]string{"https://app.example.com/callback"},
+							[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+							[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+							domain.OIDCApplicationTypeNative,
+							domain.OIDCAuthMethodTypeNone,
+							nil,
+							false,
+							domain.OIDCTokenTypeBearer,
+							false,
+							false,
+							false,
+							0,
+							nil,
+							false,
+							"",
+							domain.LoginVersionUnspecified,
+							"",
+							false,
+							false,
+							"",
+							"",
+							"",
+							time.Hour,
+							0,
+							0,
+						),
+						project.NewOIDCConfigRegisteredEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"token1",
+							"registration1",
+							time.Time{},
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "project1", "app1", "client1", "registration1"),
+			},
+			token: initialAccessToken,
+			app: func() *domain.OIDCApp {
+				app := newApp()
+				app.AppName = ""
+				app.ApplicationType = gu.Ptr(domain.OIDCApplicationTypeNative)
+				app.AuthMethodType = gu.Ptr(domain.OIDCAuthMethodTypeNone)
+				return app
+			},
+			res: res{
+				wantClientID:          "client1",
+				wantRegistrationToken: base64.RawURLEncoding.EncodeToString([]byte("registration1:project1:app1")),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				keyAlgorithm:    crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+				newHashedSecret: mockHashedSecret("secret"),
+				defaultSecretGenerators: &SecretGenerators{
+					ClientSecret: emptyConfig,
+				},
+			}
+			c.setMilestonesCompletedForTest("instanceID")
+			got, err := c.RegisterOIDCClient(authz.WithInstanceID(context.Background(), "instanceID"), tt.token, tt.app())
+			if tt.res.err != nil {
+				assert.True(t, tt.res.err(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.res.wantClientID, got.App.ClientID)
+			assert.Equal(t, "project1", got.App.AggregateID)
+			assert.Equal(t, domain.AppStateActive, got.App.State)
+			assert.Equal(t, tt.res.wantSecret, got.App.ClientSecretString)
+			assert.Equal(t, tt.res.wantRegistrationToken, got.RegistrationAccessToken)
+			assert.True(t, got.RegistrationAccessTokenExpiration.IsZero())
+		})
+	}
+}
+
+func TestCommands_RemoveRegisteredOIDCClient(t *testing.T) {
+	registrationAccessToken := base64.RawURLEncoding.EncodeToString([]byte("registration1:project1:app1"))
+	registeredEvents := func(expiration time.Time) []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				project.NewOIDCConfigRegisteredEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"token1",
+					"registration1",
+					expiration,
+				),
+			),
+		}
+	}
+	appEvents := []eventstore.Event{
+		eventFromEventPusher(
+			project.NewApplicationAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				"app1",
+				"client",
+			),
+		),
+		eventFromEventPusher(
+			project.NewOIDCConfigAddedEvent(context.Background(),
+				&project.NewAggregate("project1", "org1").Aggregate,
+				domain.OIDCVersionV1,
+				"app1",
+				"client1",
+				"secret",
+				[]string{"https://app.example.com/callback"},
+				[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+				domain.OIDCApplicationTypeWeb,
+				domain.OIDCAuthMethodTypeBasic,
+				nil,
+				false,
+				domain.OIDCTokenTypeBearer,
+				false,
+				false,
+				false,
+				0,
+				nil,
+				false,
+				"",
+				domain.LoginVersionUnspecified,
+				"",
+				false,
+				false,
+				"",
+				"",
+				"",
+				0,
+				0,
+				0,
+			),
+		),
+	}
+	tests := []struct {
+		name       string
+		eventstore func(t *testing.T) *eventstore.Eventstore
+		token      string
+		clientID   string
+		err        func(error) bool
+	}{
+		{
+			name:       "initial access token, error",
+			eventstore: expectEventstore(),
+			token:      base64.RawURLEncoding.EncodeToString([]byte("token1:org1")),
+			clientID:   "client1",
+			err:        zerrors.IsUnauthenticated,
+		},
+		{
+			name: "rotated token, error",
+			eventstore: expectEventstore(
+				expectFilter(
+					eventFromEventPusher(
+						project.NewOIDCConfigRegisteredEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"token1",
+							"registration2",
+							time.Time{},
+						),
+					),
+				),
+			),
+			token:    registrationAccessToken,
+			clientID: "client1",
+			err:      zerrors.IsUnauthenticated,
+		},
+		{
+			name: "token expired, error",
+			eventstore: expectEventstore(
+				expectFilter(registeredEvents(time.Now().Add(-time.Minute))...),
+			),
+			token:    registrationAccessToken,
+			clientID: "client1",
+			err:      zerrors.IsUnauthenticated,
+		},
+		{
+			name: "other client, error",
+			eventstore: expectEventstore(
+				expectFilter(registeredEvents(time.Time{})...),
+				expectFilter(appEvents...),
+			),
+			token:    registrationAccessToken,
+			clientID: "client2",
+			err:      zerrors.IsUnauthenticated,
+		},
+		{
+			name: "ok",
+			eventstore: expectEventstore(
+				expectFilter(registeredEvents(time.Time{})...),
+				expectFilter(appEvents...),
+				expectFilter(append([]eventstore.Event{
+					eventFromEventPusher(
+						project.NewProjectAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"client (project1)",
+							false,
+							false,
+							false,
+							domain.PrivateLabelingSettingUnspecified,
+						),
+					),
+				}, appEvents...)...),
+				expectFilter(),
+				expectPush(
+					project.NewProjectRemovedEvent(context.Background(),
+						&project.NewAggregate("project1", "org1").Aggregate,
+						"client (project1)",
+						[]*eventstore.UniqueConstraint{},
+					),
+				),
+			),
+			token:    registrationAccessToken,
+			clientID: "client1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.eventstore(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			err := c.RemoveRegisteredOIDCClient(context.Background(), tt.token, tt.clientID)
+			if tt.err != nil {
+				assert.True(t, tt.err(err), "got wrong err: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestCommands_UpdateRegisteredOIDCClient(t *testing.T) {
+	registrationAccessToken := base64.RawURLEncoding.EncodeToString([]byte("registration1:project1:app1"))
+	filterEvents := []expect{
+		expectFilter(
+			eventFromEventPusher(
+				project.NewOIDCConfigRegisteredEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"token1",
+					"registration1",
+					time.Time{},
+				),
+			),
+		),
+		expectFilter(
+			eventFromEventPusher(
+				project.NewApplicationAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"app1",
+					"client",
+				),
+			),
+			eventFromEventPusher(
+				project.NewOIDCConfigAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					domain.OIDCVersionV1,
+					"app1",
+					"client1",
+					"secret",
+					[]string{"https://app.example.com/callback"},
+					[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					domain.OIDCApplicationTypeWeb,
+					domain.OIDCAuthMethodTypeBasic,
+					nil,
+					false,
+					domain.OIDCTokenTypeBearer,
+					false,
+					false,
+					false,
+					0,
+					nil,
+					false,
+					"",
+					domain.LoginVersionUnspecified,
+					"",
+					false,
+					false,
+					"",
+					"",
+					"",
+					0,
+					0,
+					0,
+				),
+			),
+		),
+		// the initial access token is removed, but its policy still applies
+		expectFilter(
+			eventFromEventPusher(
+				org.NewInitialAccessTokenAddedEvent(context.Background(),
+					&org.NewAggregate("org1").Aggregate,
+					"token1",
+					time.Now().Add(time.Hour),
+					&domain.ClientRegistrationPolicy{
+						RedirectURIPatterns: []string{"https://*.example.com/*"},
+					},
+				),
+			),
+			eventFromEventPusher(
+				org.NewInitialAccessTokenRemovedEvent(context.Background(),
+					&org.NewAggregate("org1").Aggregate,
+					"token1",
+				),
+			),
+		),
+	}
+	tests := []struct {
+		name         string
+		eventstore   func(t *testing.T) *eventstore.Eventstore
+		redirectURIs []string
+		want         *domain.OIDCApp
+		err          func(error) bool
+	}{
+		{
+			name:         "redirect uri not allowed, error",
+			eventstore:   expectEventstore(filterEvents...),
+			redirectURIs: []string{"https://evil.com/callback"},
+			err:          zerrors.IsErrorInvalidArgument,
+		},
+		{
+			name:         "no changes, ok",
+			eventstore:   expectEventstore(filterEvents...),
+			redirectURIs: []string{"https://app.example.com/callback"},
+			want: &domain.OIDCApp{
+				AppID:        "app1",
+				ClientID:     "client1",
+				RedirectUris: []string{"https://app.example.com/callback"},
+			},
+		},
+		{
+			name: "changed, ok",
+			eventstore: expectEventstore(append(filterEvents,
+				expectPush(
+					newOIDCAppChangedEventRedirectURIs(context.Background(), "app1", "project1", "org1", []string{"https://other.example.com/callback"}),
+				),
+			)...),
+			redirectURIs: []string{"https://other.example.com/callback"},
+			want: &domain.OIDCApp{
+				AppID:        "app1",
+				ClientID:     "client1",
+				RedirectUris: []string{"https://other.example.com/callback"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:   tt.eventstore(t),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			}
+			got, err := c.UpdateRegisteredOIDCClient(context.Background(), registrationAccessToken, &domain.OIDCApp{
+				ObjectRoot:    models.ObjectRoot{AggregateID: "other"},
+				ClientID:      "client1",
+				RedirectUris:  tt.redirectURIs,
+				ResponseTypes: []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+				GrantTypes:    []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+			})
+			if tt.err != nil {
+				assert.True(t, tt.err(err), "got wrong err: %v", err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.AppID, got.AppID)
+			assert.Equal(t, "project1", got.AggregateID)
+			assert.Equal(t, tt.want.ClientID, got.ClientID)
+			assert.Equal(t, tt.want.RedirectUris, got.RedirectUris)
+		})
+	}
+}
+
+func newOIDCAppChangedEventRedirectURIs(ctx context.Context, appID, projectID, resourceOwner string, redirectURIs []string) *project.OIDCConfigChangedEvent {
+	event, _ := project.NewOIDCConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		[]project.OIDCConfigChanges{
+			project.ChangeRedirectURIs(redirectURIs),
+		},
+	)
+	return event
+}
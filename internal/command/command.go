@@ -227,6 +227,12 @@ func (c *Commands) pushAppendAndReduce(ctx context.Context, object AppendReducer
 	return AppendAndReduce(object, events...)
 }
 
+// PushInBatch executes fn and pushes the events of all commands executed in fn at once,
+// if fn fails, none of the events are pushed.
+func (c *Commands) PushInBatch(ctx context.Context, fn func(ctx context.Context) error) error {
+	return c.eventstore.PushInBatch(ctx, fn)
+}
+
 type AppendReducerDetails interface {
 	AppendEvents(...eventstore.Event)
 	// TODO: Why is it allowed to return an error here?
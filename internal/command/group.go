@@ -0,0 +1,400 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddGroup struct {
+	models.ObjectRoot
+
+	Name        string
+	Description string
+	ExternalID  string
+	Members     []string
+}
+
+func (a *AddGroup) IsValid() error {
+	if a.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-DOxaM", "Errors.ResourceOwnerMissing")
+	}
+	if a.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Nmzim", "Errors.Group.InvalidName")
+	}
+	return nil
+}
+
+// AddGroup creates a new group in the organization of the resource owner.
+// The members are added in the same transaction and must be users of the same organization.
+func (c *Commands) AddGroup(ctx context.Context, add *AddGroup) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := add.IsValid(); err != nil {
+		return nil, err
+	}
+	if add.AggregateID == "" {
+		add.AggregateID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := c.checkPermissionWriteGroup(ctx, add.ResourceOwner, add.AggregateID); err != nil {
+		return nil, err
+	}
+
+	wm, err := c.getGroupWriteModelByID(ctx, add.AggregateID, add.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if wm.State.Exists() {
+		return nil, zerrors.ThrowAlreadyExists(nil, "COMMAND-vBklR", "Errors.Group.AlreadyExists")
+	}
+
+	members := uniqueIDs(add.Members)
+	if err := c.checkGroupMembersExist(ctx, add.ResourceOwner, members); err != nil {
+		return nil, err
+	}
+
+	agg := group.NewAggregate(add.AggregateID, add.ResourceOwner)
+	cmds := make([]eventstore.Command, 0, len(members)+1)
+	cmds = append(cmds, group.NewAddedEvent(ctx, agg, add.Name, add.Description, add.ExternalID))
+	for _, userID := range members {
+		cmds = append(cmds, group.NewMemberAddedEvent(ctx, agg, userID))
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+type ChangeGroup struct {
+	models.ObjectRoot
+
+	Name        *string
+	Description *string
+	ExternalID  *string
+	// Members replaces all members of the group if set.
+	Members *[]string
+}
+
+func (c *ChangeGroup) IsValid() error {
+	if c.AggregateID == "" || c.ResourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-fmWCz", "Errors.IDMissing")
+	}
+	if c.Name != nil && *c.Name == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-E4wDL", "Errors.Group.InvalidName")
+	}
+	return nil
+}
+
+// ChangeGroup updates the attributes of a group.
+// If [ChangeGroup.Members] is set, the members of the group are replaced with the provided users.
+func (c *Commands) ChangeGroup(ctx context.Context, change *ChangeGroup) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := change.IsValid(); err != nil {
+		return nil, err
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, change.AggregateID, change.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-4bSIR", "Errors.Group.NotFound")
+	}
+	if err := c.checkPermissionWriteGroup(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
+		return nil, err
+	}
+
+	agg := group.AggregateFromWriteModel(ctx, &wm.WriteModel)
+	cmds := make([]eventstore.Command, 0)
+	if changedEvent := wm.NewChangedEvent(ctx, agg, change.Name, change.Description, change.ExternalID); changedEvent != nil {
+		cmds = append(cmds, changedEvent)
+	}
+	if change.Members != nil {
+		members := uniqueIDs(*change.Members)
+		added, removed := wm.membersDiff(members)
+		if err := c.checkGroupMembersExist(ctx, wm.ResourceOwner, added); err != nil {
+			return nil, err
+		}
+		for _, userID := range added {
+			cmds = append(cmds, group.NewMemberAddedEvent(ctx, agg, userID))
+		}
+		for _, userID := range removed {
+			cmds = append(cmds, group.NewMemberRemovedEvent(ctx, agg, userID))
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// RemoveGroup removes the group and all its memberships.
+func (c *Commands) RemoveGroup(ctx context.Context, groupID, resourceOwner string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-yIXvu", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-ga5IT", "Errors.Group.NotFound")
+	}
+	if err := c.checkPermissionDeleteGroup(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
+		return nil, err
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		group.NewRemovedEvent(ctx, group.AggregateFromWriteModel(ctx, &wm.WriteModel), wm.Name, wm.removedGrants()),
+	)
+}
+
+// AddGroupMembers adds the users to the group, users which are already members are ignored.
+func (c *Commands) AddGroupMembers(ctx context.Context, groupID, resourceOwner string, userIDs ...string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-bW0Co", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-MJEkc", "Errors.Group.NotFound")
+	}
+	if err := c.checkPermissionWriteGroup(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
+		return nil, err
+	}
+
+	added := make([]string, 0, len(userIDs))
+	for _, userID := range uniqueIDs(userIDs) {
+		if !slices.Contains(wm.Members, userID) {
+			added = append(added, userID)
+		}
+	}
+	if err := c.checkGroupMembersExist(ctx, wm.ResourceOwner, added); err != nil {
+		return nil, err
+	}
+
+	agg := group.AggregateFromWriteModel(ctx, &wm.WriteModel)
+	cmds := make([]eventstore.Command, len(added))
+	for i, userID := range added {
+		cmds[i] = group.NewMemberAddedEvent(ctx, agg, userID)
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// RemoveGroupMembers removes the users from the group, users which are not members are ignored.
+func (c *Commands) RemoveGroupMembers(ctx context.Context, groupID, resourceOwner string, userIDs ...string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-S8OzH", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-D2ulC", "Errors.Group.NotFound")
+	}
+	if err := c.checkPermissionWriteGroup(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
+		return nil, err
+	}
+
+	agg := group.AggregateFromWriteModel(ctx, &wm.WriteModel)
+	cmds := make([]eventstore.Command, 0, len(userIDs))
+	for _, userID := range uniqueIDs(userIDs) {
+		if slices.Contains(wm.Members, userID) {
+			cmds = append(cmds, group.NewMemberRemovedEvent(ctx, agg, userID))
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// SyncGroupMembers adds and removes the members of the group as provisioned by an external directory.
+// The synchronization runs in the background without an authenticated user, therefore no permission is checked.
+// Users which are already members are not added again and users which are not members are not removed.
+func (c *Commands) SyncGroupMembers(ctx context.Context, groupID, resourceOwner string, addUserIDs, removeUserIDs []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-pQ4vy", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Yx3rB", "Errors.Group.NotFound")
+	}
+
+	added := make([]string, 0, len(addUserIDs))
+	for _, userID := range uniqueIDs(addUserIDs) {
+		if !slices.Contains(wm.Members, userID) {
+			added = append(added, userID)
+		}
+	}
+	if err := c.checkGroupMembersExist(ctx, wm.ResourceOwner, added); err != nil {
+		return nil, err
+	}
+
+	agg := group.AggregateFromWriteModel(ctx, &wm.WriteModel)
+	cmds := make([]eventstore.Command, 0, len(added)+len(removeUserIDs))
+	for _, userID := range added {
+		cmds = append(cmds, group.NewMemberAddedEvent(ctx, agg, userID))
+	}
+	for _, userID := range uniqueIDs(removeUserIDs) {
+		if slices.Contains(wm.Members, userID) {
+			cmds = append(cmds, group.NewMemberRemovedEvent(ctx, agg, userID))
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// AddNestedGroups adds the groups as members of the group, groups which are already nested are ignored.
+// The members of the nested groups become effective members of the group.
+// The nested groups must be part of the same organization and must not contain the group itself.
+func (c *Commands) AddNestedGroups(ctx context.Context, groupID, resourceOwner string, nestedGroupIDs ...string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-oWq8v", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-b2Zp0", "Errors.Group.NotFound")
+	}
+	if err := c.checkPermissionWriteGroup(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
+		return nil, err
+	}
+
+	added := make([]string, 0, len(nestedGroupIDs))
+	for _, nestedGroupID := range uniqueIDs(nestedGroupIDs) {
+		if !slices.Contains(wm.NestedGroups, nestedGroupID) {
+			added = append(added, nestedGroupID)
+		}
+	}
+	if err := c.checkNestedGroups(ctx, wm.ResourceOwner, wm.AggregateID, added); err != nil {
+		return nil, err
+	}
+
+	agg := group.AggregateFromWriteModel(ctx, &wm.WriteModel)
+	cmds := make([]eventstore.Command, len(added))
+	for i, nestedGroupID := range added {
+		cmds[i] = group.NewNestedGroupAddedEvent(ctx, agg, nestedGroupID)
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// RemoveNestedGroups removes the nested groups from the group, groups which are not nested are ignored.
+func (c *Commands) RemoveNestedGroups(ctx context.Context, groupID, resourceOwner string, nestedGroupIDs ...string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Hn1cA", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Jx4Wb", "Errors.Group.NotFound")
+	}
+	if err := c.checkPermissionWriteGroup(ctx, wm.ResourceOwner, wm.AggregateID); err != nil {
+		return nil, err
+	}
+
+	agg := group.AggregateFromWriteModel(ctx, &wm.WriteModel)
+	cmds := make([]eventstore.Command, 0, len(nestedGroupIDs))
+	for _, nestedGroupID := range uniqueIDs(nestedGroupIDs) {
+		if slices.Contains(wm.NestedGroups, nestedGroupID) {
+			cmds = append(cmds, group.NewNestedGroupRemovedEvent(ctx, agg, nestedGroupID))
+		}
+	}
+	return c.pushAppendAndReduceDetails(ctx, wm, cmds...)
+}
+
+// checkNestedGroups checks that the groups exist in the organization
+// and that nesting them into the group does not create a cycle.
+func (c *Commands) checkNestedGroups(ctx context.Context, resourceOwner, groupID string, nestedGroupIDs []string) error {
+	if len(nestedGroupIDs) == 0 {
+		return nil
+	}
+	wm := NewGroupNestingWriteModel(resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	for _, nestedGroupID := range nestedGroupIDs {
+		if !wm.Exists(nestedGroupID) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-tV7kq", "Errors.Group.NotFound")
+		}
+		if wm.IsNestedIn(groupID, nestedGroupID) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Vb3sE", "Errors.Group.NestingCycle")
+		}
+	}
+	return nil
+}
+
+func (c *Commands) checkGroupMembersExist(ctx context.Context, resourceOwner string, userIDs []string) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	wm := NewUsersExistWriteModel(userIDs, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return err
+	}
+	if !wm.AllExist() {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-mh9Kj", "Errors.User.NotFound")
+	}
+	return nil
+}
+
+// GroupWriteModelByID returns the current state of the group including its members.
+func (c *Commands) GroupWriteModelByID(ctx context.Context, groupID, resourceOwner string) (_ *GroupWriteModel, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-Zn7uY", "Errors.Group.NotFound")
+	}
+	return wm, nil
+}
+
+func (c *Commands) getGroupWriteModelByID(ctx context.Context, id, resourceOwner string) (*GroupWriteModel, error) {
+	wm := NewGroupWriteModel(id, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, wm); err != nil {
+		return nil, err
+	}
+	return wm, nil
+}
+
+func uniqueIDs(ids []string) []string {
+	unique := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id != "" && !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
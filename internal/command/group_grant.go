@@ -0,0 +1,170 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type AddGroupGrant struct {
+	GroupID       string
+	ResourceOwner string
+	// GrantID is generated if empty
+	GrantID   string
+	ProjectID string
+	// ProjectGrantID is resolved from the organization of the group if the project is granted to it
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func (a *AddGroupGrant) IsValid() error {
+	if a.GroupID == "" || a.ResourceOwner == "" || a.ProjectID == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Tz6pb", "Errors.IDMissing")
+	}
+	return nil
+}
+
+// AddGroupGrant grants the roles of a project to the group.
+// All effective members of the group, including the members of nested groups, receive the roles.
+func (c *Commands) AddGroupGrant(ctx context.Context, add *AddGroupGrant) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if err := add.IsValid(); err != nil {
+		return nil, err
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, add.GroupID, add.ResourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !wm.State.Exists() {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-hM2oD", "Errors.Group.NotFound")
+	}
+	roleKeys := uniqueIDs(add.RoleKeys)
+	if err := c.checkGroupGrantPreCondition(ctx, wm.ResourceOwner, add.ProjectID, &add.ProjectGrantID, roleKeys); err != nil {
+		return nil, err
+	}
+	if err := c.NewPermissionCheckUserGrantWrite(ctx)(add.ProjectID, add.ProjectGrantID)(wm.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+	if add.GrantID == "" {
+		add.GrantID, err = c.idGenerator.Next()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		group.NewGrantAddedEvent(ctx, group.AggregateFromWriteModel(ctx, &wm.WriteModel), add.GrantID, add.ProjectID, add.ProjectGrantID, roleKeys),
+	)
+}
+
+// ChangeGroupGrant replaces the roles of the group grant.
+func (c *Commands) ChangeGroupGrant(ctx context.Context, groupID, resourceOwner, grantID string, roleKeys []string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" || grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-ZbI3q", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	grant := wm.grantByID(grantID)
+	if !wm.State.Exists() || grant == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-pY5tA", "Errors.Group.Grant.NotFound")
+	}
+	roleKeys = uniqueIDs(roleKeys)
+	if err := c.checkGroupGrantPreCondition(ctx, wm.ResourceOwner, grant.ProjectID, &grant.ProjectGrantID, roleKeys); err != nil {
+		return nil, err
+	}
+	if err := c.NewPermissionCheckUserGrantWrite(ctx)(grant.ProjectID, grant.ProjectGrantID)(wm.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+	if slices.Equal(grant.RoleKeys, roleKeys) {
+		return writeModelToObjectDetails(&wm.WriteModel), nil
+	}
+
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		group.NewGrantChangedEvent(ctx, group.AggregateFromWriteModel(ctx, &wm.WriteModel), grantID, roleKeys),
+	)
+}
+
+// RemoveGroupGrant removes the grant from the group.
+func (c *Commands) RemoveGroupGrant(ctx context.Context, groupID, resourceOwner, grantID string) (_ *domain.ObjectDetails, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if groupID == "" || resourceOwner == "" || grantID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-C0gNw", "Errors.IDMissing")
+	}
+	wm, err := c.getGroupWriteModelByID(ctx, groupID, resourceOwner)
+	if err != nil {
+		return nil, err
+	}
+	grant := wm.grantByID(grantID)
+	if !wm.State.Exists() || grant == nil {
+		return nil, zerrors.ThrowNotFound(nil, "COMMAND-kS1xe", "Errors.Group.Grant.NotFound")
+	}
+	if err := c.NewPermissionCheckUserGrantDelete(ctx)(grant.ProjectID, grant.ProjectGrantID)(wm.ResourceOwner, ""); err != nil {
+		return nil, err
+	}
+
+	return c.pushAppendAndReduceDetails(ctx, wm,
+		group.NewGrantRemovedEvent(ctx, group.AggregateFromWriteModel(ctx, &wm.WriteModel), grantID, grant.ProjectID, grant.ProjectGrantID),
+	)
+}
+
+// checkGroupGrantPreCondition checks that the project is owned by or granted to the organization of the group
+// and that all roles exist on the project (grant).
+// The projectGrantID is set if the project is granted to the organization.
+func (c *Commands) checkGroupGrantPreCondition(ctx context.Context, resourceOwner, projectID string, projectGrantID *string, roleKeys []string) error {
+	preConditions := NewGroupGrantPreConditionReadModel(projectID, *projectGrantID, resourceOwner)
+	if err := c.eventstore.FilterToQueryReducer(ctx, preConditions); err != nil {
+		return err
+	}
+	*projectGrantID = preConditions.ProjectGrantID
+	if !preConditions.ProjectExists && !preConditions.ProjectGrantExists {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-gF6rm", "Errors.Project.NotFound")
+	}
+	for _, roleKey := range roleKeys {
+		if !slices.Contains(preConditions.ExistingRoleKeys, roleKey) {
+			return zerrors.ThrowPreconditionFailed(nil, "COMMAND-cW1wG", "Errors.Project.Role.NotFound")
+		}
+	}
+	return nil
+}
+
+// GroupGrantPreConditionReadModel reuses the project related checks of the [UserGrantPreConditionReadModel].
+type GroupGrantPreConditionReadModel struct {
+	*UserGrantPreConditionReadModel
+}
+
+func NewGroupGrantPreConditionReadModel(projectID, projectGrantID, resourceOwner string) *GroupGrantPreConditionReadModel {
+	return &GroupGrantPreConditionReadModel{
+		UserGrantPreConditionReadModel: NewUserGrantPreConditionReadModel("", projectID, projectGrantID, resourceOwner),
+	}
+}
+
+func (wm *GroupGrantPreConditionReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(project.AggregateType).
+		AggregateIDs(wm.ProjectID).
+		EventTypes(
+			project.ProjectAddedType,
+			project.ProjectRemovedType,
+			project.GrantAddedType,
+			project.GrantChangedType,
+			project.GrantRemovedType,
+			project.RoleAddedType,
+			project.RoleRemovedType).
+		Builder()
+}
@@ -0,0 +1,429 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func groupGrantProjectAddedEvent(projectID, resourceOwner string) *project.ProjectAddedEvent {
+	return project.NewProjectAddedEvent(context.Background(),
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		"projectname", true, true, true,
+		domain.PrivateLabelingSettingUnspecified,
+	)
+}
+
+func groupGrantRoleAddedEvent(projectID, resourceOwner, key string) *project.RoleAddedEvent {
+	return project.NewRoleAddedEvent(context.Background(),
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		key, key, "",
+	)
+}
+
+func TestCommands_AddGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		add *AddGroupGrant
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing project, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1"},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"group not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1", ProjectID: "project1"},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"project of other organization, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupGrantProjectAddedEvent("project1", "org2")),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1", ProjectID: "project1"},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"role not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupGrantProjectAddedEvent("project1", "org1")),
+						eventFromEventPusher(groupGrantRoleAddedEvent("project1", "org1", "role1")),
+					),
+				),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1", ProjectID: "project1", RoleKeys: []string{"role2"}},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"permission denied, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupGrantProjectAddedEvent("project1", "org1")),
+						eventFromEventPusher(groupGrantRoleAddedEvent("project1", "org1", "role1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1", ProjectID: "project1", RoleKeys: []string{"role1"}},
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"owned project, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupGrantProjectAddedEvent("project1", "org1")),
+						eventFromEventPusher(groupGrantRoleAddedEvent("project1", "org1", "role1")),
+					),
+					expectPush(
+						group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1",
+							"project1",
+							"",
+							[]string{"role1"},
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "grant1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1", ProjectID: "project1", RoleKeys: []string{"role1", "role1"}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+		{
+			"granted project, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupGrantProjectAddedEvent("project1", "org2")),
+						eventFromEventPusher(groupGrantRoleAddedEvent("project1", "org2", "role1")),
+						eventFromEventPusher(project.NewGrantAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org2").Aggregate,
+							"projectgrant1",
+							"org1",
+							[]string{"role1"},
+						)),
+					),
+					expectPush(
+						group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1",
+							"project1",
+							"projectgrant1",
+							[]string{"role1"},
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "grant1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroupGrant{GroupID: "group1", ResourceOwner: "org1", ProjectID: "project1", RoleKeys: []string{"role1"}},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddGroupGrant(tt.args.ctx, tt.args.add)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx      context.Context
+		grantID  string
+		roleKeys []string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"grant not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+			},
+			args{
+				ctx:     context.Background(),
+				grantID: "grant1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"change roles, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1", "project1", "", []string{"role1"},
+						)),
+					),
+					expectFilter(
+						eventFromEventPusher(groupGrantProjectAddedEvent("project1", "org1")),
+						eventFromEventPusher(groupGrantRoleAddedEvent("project1", "org1", "role1")),
+						eventFromEventPusher(groupGrantRoleAddedEvent("project1", "org1", "role2")),
+					),
+					expectPush(
+						group.NewGrantChangedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1",
+							[]string{"role2"},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:      context.Background(),
+				grantID:  "grant1",
+				roleKeys: []string{"role2"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.ChangeGroupGrant(tt.args.ctx, "group1", "org1", tt.args.grantID, tt.args.roleKeys)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveGroupGrant(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			"grant not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"permission denied, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1", "project1", "", []string{"role1"},
+						)),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1", "project1", "", []string{"role1"},
+						)),
+					),
+					expectPush(
+						group.NewGrantRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1", "project1", "",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveGroupGrant(context.Background(), "group1", "org1", "grant1")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -0,0 +1,323 @@
+package command
+
+import (
+	"context"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+type GroupWriteModel struct {
+	eventstore.WriteModel
+
+	Name        string
+	Description string
+	ExternalID  string
+	Members     []string
+	// NestedGroups are the groups which are members of the group
+	NestedGroups []string
+	Grants       []*GroupGrant
+
+	State domain.GroupState
+}
+
+// GroupGrant grants the roles of a project to all effective members of a group.
+type GroupGrant struct {
+	GrantID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       []string
+}
+
+func NewGroupWriteModel(id, resourceOwner string) *GroupWriteModel {
+	return &GroupWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   id,
+			ResourceOwner: resourceOwner,
+		},
+	}
+}
+
+func (wm *GroupWriteModel) GetWriteModel() *eventstore.WriteModel {
+	return &wm.WriteModel
+}
+
+func (wm *GroupWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.AddedEvent:
+			wm.Name = e.Name
+			wm.Description = e.Description
+			wm.ExternalID = e.ExternalID
+			wm.State = domain.GroupStateActive
+		case *group.ChangedEvent:
+			if e.Name != nil {
+				wm.Name = *e.Name
+			}
+			if e.Description != nil {
+				wm.Description = *e.Description
+			}
+			if e.ExternalID != nil {
+				wm.ExternalID = *e.ExternalID
+			}
+		case *group.RemovedEvent:
+			wm.State = domain.GroupStateRemoved
+			wm.Members = nil
+			wm.NestedGroups = nil
+			wm.Grants = nil
+		case *group.MemberAddedEvent:
+			if !slices.Contains(wm.Members, e.UserID) {
+				wm.Members = append(wm.Members, e.UserID)
+			}
+		case *group.MemberRemovedEvent:
+			wm.Members = slices.DeleteFunc(wm.Members, func(userID string) bool {
+				return userID == e.UserID
+			})
+		case *group.NestedGroupAddedEvent:
+			if !slices.Contains(wm.NestedGroups, e.GroupID) {
+				wm.NestedGroups = append(wm.NestedGroups, e.GroupID)
+			}
+		case *group.NestedGroupRemovedEvent:
+			wm.NestedGroups = slices.DeleteFunc(wm.NestedGroups, func(groupID string) bool {
+				return groupID == e.GroupID
+			})
+		case *group.GrantAddedEvent:
+			wm.Grants = append(wm.Grants, &GroupGrant{
+				GrantID:        e.GrantID,
+				ProjectID:      e.ProjectID,
+				ProjectGrantID: e.ProjectGrantID,
+				RoleKeys:       e.RoleKeys,
+			})
+		case *group.GrantChangedEvent:
+			if grant := wm.grantByID(e.GrantID); grant != nil {
+				grant.RoleKeys = e.RoleKeys
+			}
+		case *group.GrantRemovedEvent:
+			wm.Grants = slices.DeleteFunc(wm.Grants, func(grant *GroupGrant) bool {
+				return grant.GrantID == e.GrantID
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			group.AddedEventType,
+			group.ChangedEventType,
+			group.RemovedEventType,
+			group.MemberAddedEventType,
+			group.MemberRemovedEventType,
+			group.NestedGroupAddedEventType,
+			group.NestedGroupRemovedEventType,
+			group.GrantAddedEventType,
+			group.GrantChangedEventType,
+			group.GrantRemovedEventType,
+		).
+		Builder()
+}
+
+func (wm *GroupWriteModel) grantByID(grantID string) *GroupGrant {
+	for _, grant := range wm.Grants {
+		if grant.GrantID == grantID {
+			return grant
+		}
+	}
+	return nil
+}
+
+// removedGrants returns the grants to release with the removal of the group.
+func (wm *GroupWriteModel) removedGrants() []*group.RemovedGrant {
+	grants := make([]*group.RemovedGrant, len(wm.Grants))
+	for i, grant := range wm.Grants {
+		grants[i] = &group.RemovedGrant{
+			ProjectID:      grant.ProjectID,
+			ProjectGrantID: grant.ProjectGrantID,
+		}
+	}
+	return grants
+}
+
+func (wm *GroupWriteModel) NewChangedEvent(
+	ctx context.Context,
+	agg *eventstore.Aggregate,
+	name,
+	description,
+	externalID *string,
+) *group.ChangedEvent {
+	changes := make([]group.Changes, 0, 3)
+	if name != nil && wm.Name != *name {
+		changes = append(changes, group.ChangeName(wm.Name, *name))
+	}
+	if description != nil && wm.Description != *description {
+		changes = append(changes, group.ChangeDescription(*description))
+	}
+	if externalID != nil && wm.ExternalID != *externalID {
+		changes = append(changes, group.ChangeExternalID(*externalID))
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+	return group.NewChangedEvent(ctx, agg, changes)
+}
+
+// membersDiff returns the users which have to be added and removed
+// so the members of the group match the provided users.
+func (wm *GroupWriteModel) membersDiff(userIDs []string) (added, removed []string) {
+	for _, userID := range userIDs {
+		if !slices.Contains(wm.Members, userID) {
+			added = append(added, userID)
+		}
+	}
+	for _, userID := range wm.Members {
+		if !slices.Contains(userIDs, userID) {
+			removed = append(removed, userID)
+		}
+	}
+	return added, removed
+}
+
+// GroupNestingWriteModel contains the existing groups of an organization and their nested groups.
+type GroupNestingWriteModel struct {
+	eventstore.WriteModel
+
+	// nestedGroups contains the nested groups by the ID of all existing groups
+	nestedGroups map[string][]string
+}
+
+func NewGroupNestingWriteModel(resourceOwner string) *GroupNestingWriteModel {
+	return &GroupNestingWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		nestedGroups: make(map[string][]string),
+	}
+}
+
+func (wm *GroupNestingWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *group.AddedEvent:
+			wm.nestedGroups[e.Aggregate().ID] = []string{}
+		case *group.RemovedEvent:
+			delete(wm.nestedGroups, e.Aggregate().ID)
+			for groupID, nestedGroups := range wm.nestedGroups {
+				wm.nestedGroups[groupID] = slices.DeleteFunc(nestedGroups, func(nestedGroupID string) bool {
+					return nestedGroupID == e.Aggregate().ID
+				})
+			}
+		case *group.NestedGroupAddedEvent:
+			if nestedGroups, ok := wm.nestedGroups[e.Aggregate().ID]; ok && !slices.Contains(nestedGroups, e.GroupID) {
+				wm.nestedGroups[e.Aggregate().ID] = append(nestedGroups, e.GroupID)
+			}
+		case *group.NestedGroupRemovedEvent:
+			wm.nestedGroups[e.Aggregate().ID] = slices.DeleteFunc(wm.nestedGroups[e.Aggregate().ID], func(nestedGroupID string) bool {
+				return nestedGroupID == e.GroupID
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *GroupNestingWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		OrderAsc().
+		AddQuery().
+		AggregateTypes(group.AggregateType).
+		EventTypes(
+			group.AddedEventType,
+			group.RemovedEventType,
+			group.NestedGroupAddedEventType,
+			group.NestedGroupRemovedEventType,
+		).
+		Builder()
+}
+
+// Exists returns true if the group exists in the organization.
+func (wm *GroupNestingWriteModel) Exists(groupID string) bool {
+	_, ok := wm.nestedGroups[groupID]
+	return ok
+}
+
+// IsNestedIn returns true if the group is the parent group or is nested in it (directly or transitively).
+func (wm *GroupNestingWriteModel) IsNestedIn(groupID, parentGroupID string) bool {
+	visited := make(map[string]bool)
+	pending := []string{parentGroupID}
+	for len(pending) > 0 {
+		current := pending[0]
+		pending = pending[1:]
+		if current == groupID {
+			return true
+		}
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+		pending = append(pending, wm.nestedGroups[current]...)
+	}
+	return false
+}
+
+// UsersExistWriteModel checks if all provided users exist in the resource owner.
+type UsersExistWriteModel struct {
+	eventstore.WriteModel
+
+	ids         []string
+	existingIDs []string
+}
+
+func NewUsersExistWriteModel(ids []string, resourceOwner string) *UsersExistWriteModel {
+	return &UsersExistWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		ids: ids,
+	}
+}
+
+func (wm *UsersExistWriteModel) AllExist() bool {
+	return len(wm.ids) == len(wm.existingIDs)
+}
+
+func (wm *UsersExistWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch event.(type) {
+		case *user.HumanAddedEvent, *user.HumanRegisteredEvent, *user.MachineAddedEvent:
+			if !slices.Contains(wm.existingIDs, event.Aggregate().ID) {
+				wm.existingIDs = append(wm.existingIDs, event.Aggregate().ID)
+			}
+		case *user.UserRemovedEvent:
+			wm.existingIDs = slices.DeleteFunc(wm.existingIDs, func(id string) bool {
+				return id == event.Aggregate().ID
+			})
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UsersExistWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		OrderAsc().
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.ids...).
+		EventTypes(
+			user.HumanAddedType,
+			user.HumanRegisteredType,
+			user.UserV1AddedType,
+			user.UserV1RegisteredType,
+			user.MachineAddedEventType,
+			user.UserRemovedType,
+		).
+		Builder()
+}
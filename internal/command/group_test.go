@@ -0,0 +1,1085 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/id"
+	"github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func groupAddedEvent(groupID, resourceOwner string) *group.AddedEvent {
+	return group.NewAddedEvent(context.Background(),
+		group.NewAggregate(groupID, resourceOwner),
+		"name",
+		"description",
+		"",
+	)
+}
+
+func groupUserAddedEvent(userID, resourceOwner string) *user.HumanAddedEvent {
+	return user.NewHumanAddedEvent(context.Background(),
+		&user.NewAggregate(userID, resourceOwner).Aggregate,
+		"username",
+		"firstname",
+		"lastname",
+		"nickname",
+		"displayname",
+		language.German,
+		domain.GenderUnspecified,
+		"email@test.ch",
+		true,
+	)
+}
+
+func TestCommands_AddGroup(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		idGenerator     id.Generator
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx context.Context
+		add *AddGroup
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no resourceowner, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroup{Name: "name"},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"no name, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroup{ObjectRoot: models.ObjectRoot{ResourceOwner: "org1"}},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"permission denied, error",
+			fields{
+				eventstore:      expectEventstore(),
+				idGenerator:     mock.ExpectID(t, "group1"),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroup{
+					ObjectRoot: models.ObjectRoot{ResourceOwner: "org1"},
+					Name:       "name",
+				},
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"already existing, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       "name",
+				},
+			},
+			res{
+				err: zerrors.IsErrorAlreadyExists,
+			},
+		},
+		{
+			"member not existing, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(),
+				),
+				idGenerator:     mock.ExpectID(t, "group1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroup{
+					ObjectRoot: models.ObjectRoot{ResourceOwner: "org1"},
+					Name:       "name",
+					Members:    []string{"user1"},
+				},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"push ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(groupUserAddedEvent("user1", "org1")),
+					),
+					expectPush(
+						groupAddedEvent("group1", "org1"),
+						group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						),
+					),
+				),
+				idGenerator:     mock.ExpectID(t, "group1"),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				add: &AddGroup{
+					ObjectRoot:  models.ObjectRoot{ResourceOwner: "org1"},
+					Name:        "name",
+					Description: "description",
+					Members:     []string{"user1", "user1"},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				idGenerator:     tt.fields.idGenerator,
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddGroup(tt.args.ctx, tt.args.add)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_ChangeGroup(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx    context.Context
+		change *ChangeGroup
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{ResourceOwner: "org1"},
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"empty name, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       gu.Ptr(""),
+				},
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name2"),
+				},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"no changes, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name"),
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+		{
+			"change name and replace members, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						)),
+					),
+					expectFilter(
+						eventFromEventPusher(groupUserAddedEvent("user2", "org1")),
+					),
+					expectPush(
+						group.NewChangedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							[]group.Changes{group.ChangeName("name", "name2")},
+						),
+						group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user2",
+						),
+						group.NewMemberRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx: context.Background(),
+				change: &ChangeGroup{
+					ObjectRoot: models.ObjectRoot{AggregateID: "group1", ResourceOwner: "org1"},
+					Name:       gu.Ptr("name2"),
+					Members:    &[]string{"user2"},
+				},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.ChangeGroup(tt.args.ctx, tt.args.change)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveGroup(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		resourceOwner string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"no id, error",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:           context.Background(),
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			"not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"permission denied, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectPush(
+						group.NewRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"name",
+							[]*group.RemovedGrant{},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+		{
+			"remove with grants, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewGrantAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"grant1", "project1", "", []string{"role1"},
+						)),
+					),
+					expectPush(
+						group.NewRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"name",
+							[]*group.RemovedGrant{{ProjectID: "project1"}},
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveGroup(tt.args.ctx, tt.args.groupID, tt.args.resourceOwner)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_AddGroupMembers(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		resourceOwner string
+		userIDs       []string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"group not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"already member, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						)),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+		{
+			"add member, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupUserAddedEvent("user1", "org1")),
+					),
+					expectPush(
+						group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddGroupMembers(tt.args.ctx, tt.args.groupID, tt.args.resourceOwner, tt.args.userIDs...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveGroupMembers(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		resourceOwner string
+		userIDs       []string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"not a member, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+		{
+			"remove member, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						)),
+					),
+					expectPush(
+						group.NewMemberRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				userIDs:       []string{"user1"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveGroupMembers(tt.args.ctx, tt.args.groupID, tt.args.resourceOwner, tt.args.userIDs...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_SyncGroupMembers(t *testing.T) {
+	type fields struct {
+		eventstore func(t *testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx           context.Context
+		groupID       string
+		resourceOwner string
+		addUserIDs    []string
+		removeUserIDs []string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"group not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				addUserIDs:    []string{"user1"},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"user not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				addUserIDs:    []string{"user1"},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"add and remove members, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user2",
+						)),
+						eventFromEventPusher(group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user3",
+						)),
+					),
+					expectFilter(
+						eventFromEventPusher(groupUserAddedEvent("user1", "org1")),
+					),
+					expectPush(
+						group.NewMemberAddedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user1",
+						),
+						group.NewMemberRemovedEvent(context.Background(),
+							group.NewAggregate("group1", "org1"),
+							"user2",
+						),
+					),
+				),
+			},
+			args{
+				ctx:           context.Background(),
+				groupID:       "group1",
+				resourceOwner: "org1",
+				addUserIDs:    []string{"user1", "user3"},
+				removeUserIDs: []string{"user2", "user4"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			details, err := c.SyncGroupMembers(tt.args.ctx, tt.args.groupID, tt.args.resourceOwner, tt.args.addUserIDs, tt.args.removeUserIDs)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_AddNestedGroups(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type args struct {
+		ctx            context.Context
+		groupID        string
+		nestedGroupIDs []string
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"group not found, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:            context.Background(),
+				groupID:        "group1",
+				nestedGroupIDs: []string{"group2"},
+			},
+			res{
+				err: zerrors.IsNotFound,
+			},
+		},
+		{
+			"nested group not existing, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:            context.Background(),
+				groupID:        "group1",
+				nestedGroupIDs: []string{"group2"},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"nested group is the group itself, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:            context.Background(),
+				groupID:        "group1",
+				nestedGroupIDs: []string{"group1"},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"cycle, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(groupAddedEvent("group2", "org1")),
+						eventFromEventPusher(groupAddedEvent("group3", "org1")),
+						eventFromEventPusher(group.NewNestedGroupAddedEvent(context.Background(), group.NewAggregate("group3", "org1"), "group1")),
+						eventFromEventPusher(group.NewNestedGroupAddedEvent(context.Background(), group.NewAggregate("group2", "org1"), "group3")),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:            context.Background(),
+				groupID:        "group1",
+				nestedGroupIDs: []string{"group2"},
+			},
+			res{
+				err: zerrors.IsPreconditionFailed,
+			},
+		},
+		{
+			"add, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewNestedGroupAddedEvent(context.Background(), group.NewAggregate("group1", "org1"), "group2")),
+					),
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(groupAddedEvent("group2", "org1")),
+						eventFromEventPusher(groupAddedEvent("group3", "org1")),
+						eventFromEventPusher(group.NewNestedGroupAddedEvent(context.Background(), group.NewAggregate("group1", "org1"), "group2")),
+					),
+					expectPush(
+						group.NewNestedGroupAddedEvent(context.Background(), group.NewAggregate("group1", "org1"), "group3"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			args{
+				ctx:            context.Background(),
+				groupID:        "group1",
+				nestedGroupIDs: []string{"group2", "group3"},
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.AddNestedGroups(tt.args.ctx, tt.args.groupID, "org1", tt.args.nestedGroupIDs...)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
+
+func TestCommands_RemoveNestedGroups(t *testing.T) {
+	type fields struct {
+		eventstore      func(t *testing.T) *eventstore.Eventstore
+		checkPermission domain.PermissionCheck
+	}
+	type res struct {
+		details *domain.ObjectDetails
+		err     func(error) bool
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		res    res
+	}{
+		{
+			"permission denied, error",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+					),
+				),
+				checkPermission: newMockPermissionCheckNotAllowed(),
+			},
+			res{
+				err: zerrors.IsPermissionDenied,
+			},
+		},
+		{
+			"remove, ok",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(groupAddedEvent("group1", "org1")),
+						eventFromEventPusher(group.NewNestedGroupAddedEvent(context.Background(), group.NewAggregate("group1", "org1"), "group2")),
+					),
+					expectPush(
+						group.NewNestedGroupRemovedEvent(context.Background(), group.NewAggregate("group1", "org1"), "group2"),
+					),
+				),
+				checkPermission: newMockPermissionCheckAllowed(),
+			},
+			res{
+				details: &domain.ObjectDetails{
+					ResourceOwner: "org1",
+					ID:            "group1",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:      tt.fields.eventstore(t),
+				checkPermission: tt.fields.checkPermission,
+			}
+			details, err := c.RemoveNestedGroups(context.Background(), "group1", "org1", "group2", "group3")
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+			if tt.res.err == nil {
+				assertObjectDetails(t, tt.res.details, details)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ type GenericOAuthProvider struct {
 	Scopes                []string
 	IDAttribute           string
 	UsePKCE               bool
+	ClaimMapping          *domain.IDPClaimMapping
 	IDPOptions            idp.Options
 }
 
@@ -32,6 +33,7 @@ type GenericOIDCProvider struct {
 	Scopes           []string
 	IsIDTokenMapping bool
 	UsePKCE          bool
+	ClaimMapping     *domain.IDPClaimMapping
 	IDPOptions       idp.Options
 }
 
@@ -136,6 +138,45 @@ type AppleProvider struct {
 	IDPOptions idp.Options
 }
 
+type OktaProvider struct {
+	Name                  string
+	Domain                string
+	AuthorizationServerID string
+	ClientID              string
+	ClientSecret          string
+	Scopes                []string
+	IDPOptions            idp.Options
+}
+
+type Auth0Provider struct {
+	Name         string
+	Domain       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type KeycloakProvider struct {
+	Name         string
+	BaseURL      string
+	Realm        string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
+type EntraExternalIDProvider struct {
+	Name         string
+	TenantID     string
+	Domain       string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	IDPOptions   idp.Options
+}
+
 // ExistsIDPOnOrgOrInstance query first org level IDPs and then instance level IDPs, no check if the IDP is active
 func ExistsIDPOnOrgOrInstance(ctx context.Context, filter preparation.FilterToQueryReducer, instanceID, orgID, id string) (exists bool, err error) {
 	ctx, span := tracing.NewSpan(ctx)
@@ -312,3 +312,16 @@ func tokensForSucceededIDPIntent(session idp.Session, encryptionAlg crypto.Encry
 	accessToken, err := crypto.Encrypt([]byte(tokens.AccessToken), encryptionAlg)
 	return accessToken, tokens.IDToken, err
 }
+
+// idpSessionID returns the session ID (sid) of the identity provider contained in its id_token.
+// The id_token was already verified when the user was authenticated, so it's only parsed.
+func idpSessionID(idToken string) string {
+	if idToken == "" {
+		return ""
+	}
+	claims := new(oidc.IDTokenClaims)
+	if _, err := oidc.ParseToken(idToken, claims); err != nil {
+		return ""
+	}
+	return claims.SessionID
+}
@@ -188,6 +188,7 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -243,6 +244,7 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -303,6 +305,7 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -363,6 +366,7 @@ func TestCommands_CreateIntent(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -499,6 +503,7 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
@@ -544,6 +549,7 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -566,6 +572,7 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								"idAttribute",
 								nil,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 					),
@@ -603,6 +610,7 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								[]string{"openid", "profile", "User.Read"},
 								false,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
@@ -640,6 +648,7 @@ func TestCommands_AuthFromProvider(t *testing.T) {
 								[]string{"openid", "profile", "User.Read"},
 								false,
 								true,
+								nil,
 								rep_idp.Options{},
 							)),
 						eventFromEventPusherWithInstanceID(
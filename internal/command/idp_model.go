@@ -16,14 +16,18 @@ import (
 	"github.com/zitadel/zitadel/internal/eventstore"
 	providers "github.com/zitadel/zitadel/internal/idp"
 	"github.com/zitadel/zitadel/internal/idp/providers/apple"
+	"github.com/zitadel/zitadel/internal/idp/providers/auth0"
 	"github.com/zitadel/zitadel/internal/idp/providers/azuread"
+	"github.com/zitadel/zitadel/internal/idp/providers/entraexternalid"
 	"github.com/zitadel/zitadel/internal/idp/providers/github"
 	"github.com/zitadel/zitadel/internal/idp/providers/gitlab"
 	"github.com/zitadel/zitadel/internal/idp/providers/google"
 	"github.com/zitadel/zitadel/internal/idp/providers/jwt"
+	"github.com/zitadel/zitadel/internal/idp/providers/keycloak"
 	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
 	"github.com/zitadel/zitadel/internal/idp/providers/oauth"
 	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+	"github.com/zitadel/zitadel/internal/idp/providers/okta"
 	saml2 "github.com/zitadel/zitadel/internal/idp/providers/saml"
 	"github.com/zitadel/zitadel/internal/idp/providers/saml/requesttracker"
 	"github.com/zitadel/zitadel/internal/repository/idp"
@@ -46,6 +50,7 @@ type OAuthIDPWriteModel struct {
 	Scopes                []string
 	IDAttribute           string
 	UsePKCE               bool
+	ClaimMapping          *domain.IDPClaimMapping
 	idp.Options
 
 	State domain.IDPState
@@ -75,6 +80,7 @@ func (wm *OAuthIDPWriteModel) reduceAddedEvent(e *idp.OAuthIDPAddedEvent) {
 	wm.Scopes = e.Scopes
 	wm.IDAttribute = e.IDAttribute
 	wm.UsePKCE = e.UsePKCE
+	wm.ClaimMapping = e.ClaimMapping
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -107,6 +113,9 @@ func (wm *OAuthIDPWriteModel) reduceChangedEvent(e *idp.OAuthIDPChangedEvent) {
 	if e.UsePKCE != nil {
 		wm.UsePKCE = *e.UsePKCE
 	}
+	if e.ClaimMapping != nil {
+		wm.ClaimMapping = e.ClaimMapping
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -121,6 +130,7 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	idAttribute string,
 	scopes []string,
 	usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) ([]idp.OAuthIDPChanges, error) {
 	changes := make([]idp.OAuthIDPChanges, 0)
@@ -157,6 +167,9 @@ func (wm *OAuthIDPWriteModel) NewChanges(
 	if wm.UsePKCE != usePKCE {
 		changes = append(changes, idp.ChangeOAuthUsePKCE(usePKCE))
 	}
+	if !wm.ClaimMapping.Equal(claimMapping) {
+		changes = append(changes, idp.ChangeOAuthClaimMapping(claimMapping))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeOAuthOptions(opts))
@@ -197,7 +210,7 @@ func (wm *OAuthIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encry
 		wm.Name,
 		wm.UserEndpoint,
 		func() providers.User {
-			return oauth.NewUserMapper(wm.IDAttribute)
+			return oauth.NewClaimUserMapper(wm.IDAttribute, wm.ClaimMapping)
 		},
 		opts...,
 	)
@@ -218,6 +231,7 @@ type OIDCIDPWriteModel struct {
 	Scopes           []string
 	IsIDTokenMapping bool
 	UsePKCE          bool
+	ClaimMapping     *domain.IDPClaimMapping
 	idp.Options
 
 	State domain.IDPState
@@ -259,6 +273,7 @@ func (wm *OIDCIDPWriteModel) reduceAddedEvent(e *idp.OIDCIDPAddedEvent) {
 	wm.Scopes = e.Scopes
 	wm.IsIDTokenMapping = e.IsIDTokenMapping
 	wm.UsePKCE = e.UsePKCE
+	wm.ClaimMapping = e.ClaimMapping
 	wm.Options = e.Options
 	wm.State = domain.IDPStateActive
 }
@@ -285,6 +300,9 @@ func (wm *OIDCIDPWriteModel) reduceChangedEvent(e *idp.OIDCIDPChangedEvent) {
 	if e.UsePKCE != nil {
 		wm.UsePKCE = *e.UsePKCE
 	}
+	if e.ClaimMapping != nil {
+		wm.ClaimMapping = e.ClaimMapping
+	}
 	wm.Options.ReduceChanges(e.OptionChanges)
 }
 
@@ -296,6 +314,7 @@ func (wm *OIDCIDPWriteModel) NewChanges(
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	idTokenMapping, usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) ([]idp.OIDCIDPChanges, error) {
 	changes := make([]idp.OIDCIDPChanges, 0)
@@ -326,6 +345,9 @@ func (wm *OIDCIDPWriteModel) NewChanges(
 	if wm.UsePKCE != usePKCE {
 		changes = append(changes, idp.ChangeOIDCUsePKCE(usePKCE))
 	}
+	if !wm.ClaimMapping.Equal(claimMapping) {
+		changes = append(changes, idp.ChangeOIDCClaimMapping(claimMapping))
+	}
 	opts := wm.Options.Changes(options)
 	if !opts.IsZero() {
 		changes = append(changes, idp.ChangeOIDCOptions(opts))
@@ -406,7 +428,7 @@ func (wm *OIDCIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.Encryp
 		secret,
 		callbackURL,
 		wm.Scopes,
-		oidc.DefaultMapper,
+		oidc.ClaimMapper(wm.ClaimMapping),
 		opts...,
 	)
 }
@@ -1929,6 +1951,548 @@ func (wm *SAMLIDPWriteModel) GetProviderOptions() idp.Options {
 	return wm.Options
 }
 
+type OktaIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID                    string
+	Name                  string
+	Domain                string
+	AuthorizationServerID string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	Scopes                []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *OktaIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.OktaIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.OktaIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *OktaIDPWriteModel) reduceAddedEvent(e *idp.OktaIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.Domain = e.Domain
+	wm.AuthorizationServerID = e.AuthorizationServerID
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *OktaIDPWriteModel) reduceChangedEvent(e *idp.OktaIDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.Domain != nil {
+		wm.Domain = *e.Domain
+	}
+	if e.AuthorizationServerID != nil {
+		wm.AuthorizationServerID = *e.AuthorizationServerID
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *OktaIDPWriteModel) NewChanges(
+	name string,
+	domain string,
+	authorizationServerID string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.OktaIDPChanges, error) {
+	changes := make([]idp.OktaIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeOktaClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeOktaClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeOktaName(name))
+	}
+	if wm.Domain != domain {
+		changes = append(changes, idp.ChangeOktaDomain(domain))
+	}
+	if wm.AuthorizationServerID != authorizationServerID {
+		changes = append(changes, idp.ChangeOktaAuthorizationServerID(authorizationServerID))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeOktaScopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeOktaOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *OktaIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return okta.New(
+		wm.Name,
+		wm.Domain,
+		wm.AuthorizationServerID,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *OktaIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type Auth0IDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	Domain       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *Auth0IDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.Auth0IDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.Auth0IDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *Auth0IDPWriteModel) reduceAddedEvent(e *idp.Auth0IDPAddedEvent) {
+	wm.Name = e.Name
+	wm.Domain = e.Domain
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *Auth0IDPWriteModel) reduceChangedEvent(e *idp.Auth0IDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.Domain != nil {
+		wm.Domain = *e.Domain
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *Auth0IDPWriteModel) NewChanges(
+	name string,
+	domain string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.Auth0IDPChanges, error) {
+	changes := make([]idp.Auth0IDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeAuth0ClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeAuth0ClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeAuth0Name(name))
+	}
+	if wm.Domain != domain {
+		changes = append(changes, idp.ChangeAuth0Domain(domain))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeAuth0Scopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeAuth0Options(opts))
+	}
+	return changes, nil
+}
+
+func (wm *Auth0IDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return auth0.New(
+		wm.Name,
+		wm.Domain,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *Auth0IDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type KeycloakIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	BaseURL      string
+	Realm        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *KeycloakIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.KeycloakIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.KeycloakIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *KeycloakIDPWriteModel) reduceAddedEvent(e *idp.KeycloakIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.BaseURL = e.BaseURL
+	wm.Realm = e.Realm
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *KeycloakIDPWriteModel) reduceChangedEvent(e *idp.KeycloakIDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.BaseURL != nil {
+		wm.BaseURL = *e.BaseURL
+	}
+	if e.Realm != nil {
+		wm.Realm = *e.Realm
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *KeycloakIDPWriteModel) NewChanges(
+	name string,
+	baseURL string,
+	realm string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.KeycloakIDPChanges, error) {
+	changes := make([]idp.KeycloakIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeKeycloakClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeKeycloakClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeKeycloakName(name))
+	}
+	if wm.BaseURL != baseURL {
+		changes = append(changes, idp.ChangeKeycloakBaseURL(baseURL))
+	}
+	if wm.Realm != realm {
+		changes = append(changes, idp.ChangeKeycloakRealm(realm))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeKeycloakScopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeKeycloakOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *KeycloakIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return keycloak.New(
+		wm.Name,
+		wm.BaseURL,
+		wm.Realm,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *KeycloakIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
+type EntraExternalIDIDPWriteModel struct {
+	eventstore.WriteModel
+
+	ID           string
+	Name         string
+	TenantID     string
+	Domain       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       []string
+	idp.Options
+
+	State domain.IDPState
+}
+
+func (wm *EntraExternalIDIDPWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *idp.EntraExternalIDIDPAddedEvent:
+			wm.reduceAddedEvent(e)
+		case *idp.EntraExternalIDIDPChangedEvent:
+			wm.reduceChangedEvent(e)
+		case *idp.RemovedEvent:
+			wm.State = domain.IDPStateRemoved
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *EntraExternalIDIDPWriteModel) reduceAddedEvent(e *idp.EntraExternalIDIDPAddedEvent) {
+	wm.Name = e.Name
+	wm.TenantID = e.TenantID
+	wm.Domain = e.Domain
+	wm.ClientID = e.ClientID
+	wm.ClientSecret = e.ClientSecret
+	wm.Scopes = e.Scopes
+	wm.Options = e.Options
+	wm.State = domain.IDPStateActive
+}
+
+func (wm *EntraExternalIDIDPWriteModel) reduceChangedEvent(e *idp.EntraExternalIDIDPChangedEvent) {
+	if e.ClientID != nil {
+		wm.ClientID = *e.ClientID
+	}
+	if e.ClientSecret != nil {
+		wm.ClientSecret = e.ClientSecret
+	}
+	if e.Name != nil {
+		wm.Name = *e.Name
+	}
+	if e.TenantID != nil {
+		wm.TenantID = *e.TenantID
+	}
+	if e.Domain != nil {
+		wm.Domain = *e.Domain
+	}
+	if e.Scopes != nil {
+		wm.Scopes = e.Scopes
+	}
+	wm.Options.ReduceChanges(e.OptionChanges)
+}
+
+func (wm *EntraExternalIDIDPWriteModel) NewChanges(
+	name string,
+	tenantID string,
+	domain string,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) ([]idp.EntraExternalIDIDPChanges, error) {
+	changes := make([]idp.EntraExternalIDIDPChanges, 0)
+	var clientSecret *crypto.CryptoValue
+	var err error
+	if clientSecretString != "" {
+		clientSecret, err = crypto.Crypt([]byte(clientSecretString), secretCrypto)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, idp.ChangeEntraExternalIDClientSecret(clientSecret))
+	}
+	if wm.ClientID != clientID {
+		changes = append(changes, idp.ChangeEntraExternalIDClientID(clientID))
+	}
+	if wm.Name != name {
+		changes = append(changes, idp.ChangeEntraExternalIDName(name))
+	}
+	if wm.TenantID != tenantID {
+		changes = append(changes, idp.ChangeEntraExternalIDTenantID(tenantID))
+	}
+	if wm.Domain != domain {
+		changes = append(changes, idp.ChangeEntraExternalIDDomain(domain))
+	}
+	if !reflect.DeepEqual(wm.Scopes, scopes) {
+		changes = append(changes, idp.ChangeEntraExternalIDScopes(scopes))
+	}
+	opts := wm.Options.Changes(options)
+	if !opts.IsZero() {
+		changes = append(changes, idp.ChangeEntraExternalIDOptions(opts))
+	}
+	return changes, nil
+}
+
+func (wm *EntraExternalIDIDPWriteModel) ToProvider(callbackURL string, idpAlg crypto.EncryptionAlgorithm) (providers.Provider, error) {
+	secret, err := crypto.DecryptString(wm.ClientSecret, idpAlg)
+	if err != nil {
+		return nil, err
+	}
+	opts := make([]oidc.ProviderOpts, 0, 4)
+	if wm.IsCreationAllowed {
+		opts = append(opts, oidc.WithCreationAllowed())
+	}
+	if wm.IsLinkingAllowed {
+		opts = append(opts, oidc.WithLinkingAllowed())
+	}
+	if wm.IsAutoCreation {
+		opts = append(opts, oidc.WithAutoCreation())
+	}
+	if wm.IsAutoUpdate {
+		opts = append(opts, oidc.WithAutoUpdate())
+	}
+	return entraexternalid.New(
+		wm.Name,
+		wm.TenantID,
+		wm.Domain,
+		wm.ClientID,
+		secret,
+		callbackURL,
+		wm.Scopes,
+		opts...,
+	)
+}
+
+func (wm *EntraExternalIDIDPWriteModel) GetProviderOptions() idp.Options {
+	return wm.Options
+}
+
 type IDPRemoveWriteModel struct {
 	eventstore.WriteModel
 
@@ -1963,6 +2527,14 @@ func (wm *IDPRemoveWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID)
 		case *idp.SAMLIDPAddedEvent:
 			wm.reduceAdded(e.ID)
+		case *idp.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.Auth0IDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID)
+		case *idp.EntraExternalIDIDPAddedEvent:
+			wm.reduceAdded(e.ID)
 		case *idp.RemovedEvent:
 			wm.reduceRemoved(e.ID)
 		case *idpconfig.IDPConfigAddedEvent:
@@ -2053,6 +2625,22 @@ func (wm *IDPTypeWriteModel) Reduce() error {
 			wm.reduceAdded(e.ID, domain.IDPTypeSAML, e.Aggregate())
 		case *org.SAMLIDPAddedEvent:
 			wm.reduceAdded(e.ID, domain.IDPTypeSAML, e.Aggregate())
+		case *instance.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeOkta, e.Aggregate())
+		case *org.OktaIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeOkta, e.Aggregate())
+		case *instance.Auth0IDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeAuth0, e.Aggregate())
+		case *org.Auth0IDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeAuth0, e.Aggregate())
+		case *instance.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeKeycloak, e.Aggregate())
+		case *org.KeycloakIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeKeycloak, e.Aggregate())
+		case *instance.EntraExternalIDIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeEntraExternalID, e.Aggregate())
+		case *org.EntraExternalIDIDPAddedEvent:
+			wm.reduceAdded(e.ID, domain.IDPTypeEntraExternalID, e.Aggregate())
 		case *instance.OIDCIDPMigratedAzureADEvent:
 			wm.reduceChanged(e.ID, domain.IDPTypeAzureAD)
 		case *org.OIDCIDPMigratedAzureADEvent:
@@ -2130,6 +2718,10 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
 			instance.SAMLIDPAddedEventType,
+			instance.OktaIDPAddedEventType,
+			instance.Auth0IDPAddedEventType,
+			instance.KeycloakIDPAddedEventType,
+			instance.EntraExternalIDIDPAddedEventType,
 			instance.OIDCIDPMigratedAzureADEventType,
 			instance.OIDCIDPMigratedGoogleEventType,
 			instance.IDPRemovedEventType,
@@ -2150,6 +2742,10 @@ func (wm *IDPTypeWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.LDAPIDPAddedEventType,
 			org.AppleIDPAddedEventType,
 			org.SAMLIDPAddedEventType,
+			org.OktaIDPAddedEventType,
+			org.Auth0IDPAddedEventType,
+			org.KeycloakIDPAddedEventType,
+			org.EntraExternalIDIDPAddedEventType,
 			org.OIDCIDPMigratedAzureADEventType,
 			org.OIDCIDPMigratedGoogleEventType,
 			org.IDPRemovedEventType,
@@ -2228,6 +2824,14 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewAppleInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeSAML:
 			writeModel.samlModel = NewSAMLInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeOkta:
+			writeModel.model = NewOktaInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeAuth0:
+			writeModel.model = NewAuth0InstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeKeycloak:
+			writeModel.model = NewKeycloakInstanceIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeEntraExternalID:
+			writeModel.model = NewEntraExternalIDInstanceIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeUnspecified:
 			fallthrough
 		default:
@@ -2259,6 +2863,14 @@ func NewAllIDPWriteModel(resourceOwner string, instanceBool bool, id string, idp
 			writeModel.model = NewAppleOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeSAML:
 			writeModel.samlModel = NewSAMLOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeOkta:
+			writeModel.model = NewOktaOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeAuth0:
+			writeModel.model = NewAuth0OrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeKeycloak:
+			writeModel.model = NewKeycloakOrgIDPWriteModel(resourceOwner, id)
+		case domain.IDPTypeEntraExternalID:
+			writeModel.model = NewEntraExternalIDOrgIDPWriteModel(resourceOwner, id)
 		case domain.IDPTypeUnspecified:
 			fallthrough
 		default:
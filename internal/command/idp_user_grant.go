@@ -0,0 +1,184 @@
+package command
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/feature"
+	providers "github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// idpUserGrantPermissionCheck allows the synchronization of the user grants
+// without permission of the caller (usually the login), since the group mapping was configured by an administrator
+// of the identity provider and the user grants are always managed in the organization of the user.
+func idpUserGrantPermissionCheck(string, string) PermissionCheck {
+	return func(string, string) error {
+		return nil
+	}
+}
+
+// SyncIDPUserGrants synchronizes the user grants of the user with the groups provided by the identity provider.
+// Only roles of the group mapping are granted or revoked, all other roles of the user grants stay untouched.
+// User grants without any remaining role are removed.
+// The user grants are managed in the organization of the user, so projects must either be owned by or granted to it.
+// If the groups are nil, the identity provider didn't provide them and the user grants are left untouched,
+// instead of revoking all roles of the group mapping.
+func (c *Commands) SyncIDPUserGrants(ctx context.Context, userID, resourceOwner string, mapping *domain.IDPGroupMapping, groups []string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if mapping.IsZero() {
+		return nil
+	}
+	if userID == "" || resourceOwner == "" {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Gw2nq", "Errors.IDMissing")
+	}
+	if groups == nil {
+		return nil
+	}
+	managedRoles := mapping.ManagedProjectRoles()
+	grantedRoles := mapping.ProjectRoles(groups)
+	projectIDs := slices.Sorted(maps.Keys(managedRoles))
+
+	grantIDs := newIDPUserGrantIDsWriteModel(userID, resourceOwner, projectIDs)
+	if err = c.eventstore.FilterToQueryReducer(ctx, grantIDs); err != nil {
+		return err
+	}
+	cmds := make([]eventstore.Command, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		cmd, err := c.syncIDPUserGrant(ctx, userID, resourceOwner, projectID, grantIDs.GrantIDs[projectID], managedRoles[projectID], grantedRoles[projectID])
+		if err != nil {
+			return err
+		}
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	if len(cmds) == 0 {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, cmds...)
+	return err
+}
+
+// SyncIDPIntentUserGrants synchronizes the user grants of the user of the session
+// with the groups provided in the (checked) intent, see [Commands.SyncIDPUserGrants].
+func (c *Commands) SyncIDPIntentUserGrants(ctx context.Context, sessionID, intentID string) (err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	intent, err := c.GetIntentWriteModel(ctx, intentID, "")
+	if err != nil {
+		return err
+	}
+	// the intent is consumed by the session check
+	if intent.State != domain.IDPIntentStateSucceeded && intent.State != domain.IDPIntentStateConsumed {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ht5pe", "Errors.Intent.NotSucceeded")
+	}
+	idpWriteModel, err := IDPProviderWriteModel(ctx, c.eventstore.Filter, intent.IDPID)
+	if err != nil {
+		return err
+	}
+	mapping := idpWriteModel.GetProviderOptions().GroupMapping
+	if mapping.IsZero() {
+		return nil
+	}
+	session := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, session); err != nil {
+		return err
+	}
+	if session.UserID == "" {
+		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uv9ds", "Errors.User.UserIDMissing")
+	}
+	groups := providers.MapGroups(providers.RawClaims(intent.IDPUser), mapping.Claim)
+	return c.SyncIDPUserGrants(ctx, session.UserID, session.UserResourceOwner, mapping, groups)
+}
+
+func (c *Commands) syncIDPUserGrant(ctx context.Context, userID, resourceOwner, projectID string, grantIDs, managedRoles, grantedRoles []string) (eventstore.Command, error) {
+	existing, err := c.existingIDPUserGrant(ctx, resourceOwner, grantIDs)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		if len(grantedRoles) == 0 {
+			return nil, nil
+		}
+		return c.addIDPUserGrant(ctx, userID, resourceOwner, projectID, grantedRoles)
+	}
+	roleKeys := make([]string, 0, len(existing.RoleKeys)+len(grantedRoles))
+	for _, role := range existing.RoleKeys {
+		if !slices.Contains(managedRoles, role) {
+			roleKeys = append(roleKeys, role)
+		}
+	}
+	for _, role := range grantedRoles {
+		if !slices.Contains(roleKeys, role) {
+			roleKeys = append(roleKeys, role)
+		}
+	}
+	userGrantAgg := UserGrantAggregateFromWriteModel(&existing.WriteModel)
+	if len(roleKeys) == 0 {
+		return usergrant.NewUserGrantRemovedEvent(ctx, userGrantAgg, existing.UserID, existing.ProjectID, existing.ProjectGrantID), nil
+	}
+	if slices.Equal(slices.Sorted(slices.Values(roleKeys)), slices.Sorted(slices.Values(existing.RoleKeys))) {
+		return nil, nil
+	}
+	userGrant := &domain.UserGrant{
+		ObjectRoot:     writeModelToObjectRoot(existing.WriteModel),
+		UserID:         existing.UserID,
+		ProjectID:      existing.ProjectID,
+		ProjectGrantID: existing.ProjectGrantID,
+		RoleKeys:       roleKeys,
+	}
+	if err = c.checkUserGrantPreCondition(ctx, userGrant, idpUserGrantPermissionCheck); err != nil {
+		return nil, err
+	}
+	return usergrant.NewUserGrantChangedEvent(ctx, userGrantAgg, existing.UserID, roleKeys), nil
+}
+
+func (c *Commands) addIDPUserGrant(ctx context.Context, userID, resourceOwner, projectID string, roleKeys []string) (eventstore.Command, error) {
+	userGrant := &domain.UserGrant{
+		ObjectRoot: models.ObjectRoot{
+			ResourceOwner: resourceOwner,
+		},
+		UserID:    userID,
+		ProjectID: projectID,
+		RoleKeys:  roleKeys,
+	}
+	// the improved precondition check does not resolve the project grant of the organization
+	if authz.GetFeatures(ctx).ShouldUseImprovedPerformance(feature.ImprovedPerformanceTypeUserGrant) {
+		projectOwner, grantID, err := c.searchProjectOwnerAndGrantID(ctx, projectID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if projectOwner != resourceOwner {
+			if grantID == "" {
+				return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Kd8sw", "Errors.Project.Grant.NotFound")
+			}
+			userGrant.ProjectGrantID = grantID
+		}
+	}
+	cmd, _, err := c.addUserGrant(ctx, userGrant, idpUserGrantPermissionCheck)
+	return cmd, err
+}
+
+func (c *Commands) existingIDPUserGrant(ctx context.Context, resourceOwner string, grantIDs []string) (*UserGrantWriteModel, error) {
+	for _, grantID := range grantIDs {
+		writeModel, err := c.userGrantWriteModelByID(ctx, grantID, resourceOwner)
+		if err != nil {
+			return nil, err
+		}
+		if writeModel.State == domain.UserGrantStateActive || writeModel.State == domain.UserGrantStateInactive {
+			return writeModel, nil
+		}
+	}
+	return nil, nil
+}
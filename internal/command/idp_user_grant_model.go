@@ -0,0 +1,50 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+)
+
+// idpUserGrantIDsWriteModel collects the ids of the user grants of the user for the projects,
+// incl. the ones already removed.
+type idpUserGrantIDsWriteModel struct {
+	eventstore.WriteModel
+
+	userID     string
+	projectIDs []string
+	GrantIDs   map[string][]string
+}
+
+func newIDPUserGrantIDsWriteModel(userID, resourceOwner string, projectIDs []string) *idpUserGrantIDsWriteModel {
+	return &idpUserGrantIDsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			ResourceOwner: resourceOwner,
+		},
+		userID:     userID,
+		projectIDs: projectIDs,
+		GrantIDs:   make(map[string][]string, len(projectIDs)),
+	}
+}
+
+func (wm *idpUserGrantIDsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		e, ok := event.(*usergrant.UserGrantAddedEvent)
+		if !ok || e.UserID != wm.userID || !slices.Contains(wm.projectIDs, e.ProjectID) {
+			continue
+		}
+		wm.GrantIDs[e.ProjectID] = append(wm.GrantIDs[e.ProjectID], e.Aggregate().ID)
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *idpUserGrantIDsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(usergrant.AggregateType).
+		EventTypes(usergrant.UserGrantAddedType).
+		EventData(map[string]interface{}{"userId": wm.userID}).
+		Builder()
+}
@@ -0,0 +1,491 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	rep_idp "github.com/zitadel/zitadel/internal/repository/idp"
+	"github.com/zitadel/zitadel/internal/repository/idpintent"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/repository/usergrant"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestCommandSide_SyncIDPUserGrants(t *testing.T) {
+	mapping := &domain.IDPGroupMapping{
+		Claim: "$.groups",
+		Mappings: []*domain.IDPGroupRoleMapping{
+			{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+			{Group: "users", ProjectID: "project1", RoleKeys: []string{"user"}},
+		},
+	}
+	preConditionEvents := func() []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				user.NewHumanAddedEvent(context.Background(),
+					&user.NewAggregate("user1", "org1").Aggregate,
+					"username1",
+					"firstname1",
+					"lastname1",
+					"nickname1",
+					"displayname1",
+					language.German,
+					domain.GenderMale,
+					"email1",
+					true,
+				),
+			),
+			eventFromEventPusher(
+				project.NewProjectAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"projectname1", true, true, true,
+					domain.PrivateLabelingSettingUnspecified,
+				),
+			),
+			eventFromEventPusher(
+				project.NewRoleAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"admin",
+					"admin",
+					"",
+				),
+			),
+			eventFromEventPusher(
+				project.NewRoleAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"user",
+					"user",
+					"",
+				),
+			),
+			eventFromEventPusher(
+				project.NewRoleAddedEvent(context.Background(),
+					&project.NewAggregate("project1", "org1").Aggregate,
+					"manual",
+					"manual",
+					"",
+				),
+			),
+		}
+	}
+	userGrantAdded := func(roleKeys ...string) eventstore.Event {
+		return eventFromEventPusher(
+			usergrant.NewUserGrantAddedEvent(context.Background(),
+				&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+				"user1",
+				"project1",
+				"",
+				roleKeys,
+			),
+		)
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator func(t *testing.T) id.Generator
+	}
+	type args struct {
+		userID        string
+		resourceOwner string
+		mapping       *domain.IDPGroupMapping
+		groups        []string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr error
+	}{
+		{
+			name: "no mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				groups:        []string{"admins"},
+			},
+		},
+		{
+			name: "missing user, error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				resourceOwner: "org1",
+				mapping:       mapping,
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "COMMAND-Gw2nq", "Errors.IDMissing"),
+		},
+		{
+			name: "no grant and no group, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        []string{"other"},
+			},
+		},
+		{
+			name: "add user grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectFilter(preConditionEvents()...),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"admin", "user"},
+						),
+					),
+				),
+				idGenerator: func(t *testing.T) id.Generator {
+					return id_mock.NewIDGeneratorExpectIDs(t, "usergrant1")
+				},
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        []string{"admins", "other", "users"},
+			},
+		},
+		{
+			name: "change user grant, keep unmanaged roles, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAdded("admin", "manual")),
+					expectFilter(userGrantAdded("admin", "manual")),
+					expectFilter(preConditionEvents()...),
+					expectPush(
+						usergrant.NewUserGrantChangedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							[]string{"manual", "user"},
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        []string{"users"},
+			},
+		},
+		{
+			name: "user grant unchanged, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAdded("user", "admin")),
+					expectFilter(userGrantAdded("user", "admin")),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        []string{"admins", "users"},
+			},
+		},
+		{
+			name: "remove stale user grant, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAdded("admin")),
+					expectFilter(userGrantAdded("admin")),
+					expectPush(
+						usergrant.NewUserGrantRemovedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        []string{},
+			},
+		},
+		{
+			name: "unknown groups, ok",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        nil,
+			},
+		},
+		{
+			name: "removed user grant, add new, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(userGrantAdded("admin")),
+					expectFilter(
+						userGrantAdded("admin"),
+						eventFromEventPusher(
+							usergrant.NewUserGrantRemovedEvent(context.Background(),
+								&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+								"user1",
+								"project1",
+								"",
+							),
+						),
+					),
+					expectFilter(preConditionEvents()...),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant2", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"user"},
+						),
+					),
+				),
+				idGenerator: func(t *testing.T) id.Generator {
+					return id_mock.NewIDGeneratorExpectIDs(t, "usergrant2")
+				},
+			},
+			args: args{
+				userID:        "user1",
+				resourceOwner: "org1",
+				mapping:       mapping,
+				groups:        []string{"users"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			if tt.fields.idGenerator != nil {
+				c.idGenerator = tt.fields.idGenerator(t)
+			}
+			err := c.SyncIDPUserGrants(context.Background(), tt.args.userID, tt.args.resourceOwner, tt.args.mapping, tt.args.groups)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCommandSide_SyncIDPIntentUserGrants(t *testing.T) {
+	intentEvents := func() []eventstore.Event {
+		return []eventstore.Event{
+			eventFromEventPusher(
+				idpintent.NewStartedEvent(context.Background(),
+					&idpintent.NewAggregate("intent", "instance").Aggregate,
+					nil,
+					nil,
+					"idp",
+					nil,
+				),
+			),
+			eventFromEventPusher(
+				idpintent.NewSucceededEvent(context.Background(),
+					&idpintent.NewAggregate("intent", "instance").Aggregate,
+					[]byte(`{"groups":["admins"]}`),
+					"idpUserID",
+					"idpUsername",
+					"",
+					nil,
+					"",
+					time.Now().Add(time.Hour),
+				),
+			),
+		}
+	}
+	idpAdded := func(options rep_idp.Options) eventstore.Event {
+		return eventFromEventPusherWithInstanceID("instance",
+			instance.NewOAuthIDPAddedEvent(context.Background(), &instance.NewAggregate("instance").Aggregate,
+				"idp",
+				"name",
+				"clientID",
+				&crypto.CryptoValue{
+					CryptoType: crypto.TypeEncryption,
+					Algorithm:  "enc",
+					KeyID:      "id",
+					Crypted:    []byte("clientSecret"),
+				},
+				"auth",
+				"token",
+				"user",
+				"idAttribute",
+				nil,
+				true,
+				nil,
+				options,
+			),
+		)
+	}
+	type fields struct {
+		eventstore  func(t *testing.T) *eventstore.Eventstore
+		idGenerator func(t *testing.T) id.Generator
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		wantErr error
+	}{
+		{
+			name: "intent not succeeded, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ht5pe", "Errors.Intent.NotSucceeded"),
+		},
+		{
+			name: "no group mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(intentEvents()...),
+					expectFilter(idpAdded(rep_idp.Options{})),
+					expectFilter(idpAdded(rep_idp.Options{})),
+				),
+			},
+		},
+		{
+			name: "session without user, error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(intentEvents()...),
+					expectFilter(idpAdded(rep_idp.Options{GroupMapping: &domain.IDPGroupMapping{
+						Claim: "$.groups",
+						Mappings: []*domain.IDPGroupRoleMapping{
+							{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+						},
+					}})),
+					expectFilter(idpAdded(rep_idp.Options{GroupMapping: &domain.IDPGroupMapping{
+						Claim: "$.groups",
+						Mappings: []*domain.IDPGroupRoleMapping{
+							{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+						},
+					}})),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session", "instance").Aggregate,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			wantErr: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Uv9ds", "Errors.User.UserIDMissing"),
+		},
+		{
+			name: "sync user grants, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(intentEvents()...),
+					expectFilter(idpAdded(rep_idp.Options{GroupMapping: &domain.IDPGroupMapping{
+						Claim: "$.groups",
+						Mappings: []*domain.IDPGroupRoleMapping{
+							{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+						},
+					}})),
+					expectFilter(idpAdded(rep_idp.Options{GroupMapping: &domain.IDPGroupMapping{
+						Claim: "$.groups",
+						Mappings: []*domain.IDPGroupRoleMapping{
+							{Group: "admins", ProjectID: "project1", RoleKeys: []string{"admin"}},
+						},
+					}})),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("session", "instance").Aggregate,
+								nil,
+							),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(),
+								&session.NewAggregate("session", "instance").Aggregate,
+								"user1",
+								"org1",
+								time.Now(),
+								nil,
+							),
+						),
+					),
+					expectFilter(),
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username1", "", "", "", "", language.German, domain.GenderUnspecified, "", true,
+							),
+						),
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"projectname1", true, true, true,
+								domain.PrivateLabelingSettingUnspecified,
+							),
+						),
+						eventFromEventPusher(
+							project.NewRoleAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"admin",
+								"admin",
+								"",
+							),
+						),
+					),
+					expectPush(
+						usergrant.NewUserGrantAddedEvent(context.Background(),
+							&usergrant.NewAggregate("usergrant1", "org1").Aggregate,
+							"user1",
+							"project1",
+							"",
+							[]string{"admin"},
+						),
+					),
+				),
+				idGenerator: func(t *testing.T) id.Generator {
+					return id_mock.NewIDGeneratorExpectIDs(t, "usergrant1")
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			if tt.fields.idGenerator != nil {
+				c.idGenerator = tt.fields.idGenerator(t)
+			}
+			err := c.SyncIDPIntentUserGrants(context.Background(), "session", "intent")
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
@@ -158,6 +158,14 @@ func TestCommandSide_AddInstanceDomain(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								false,
+								"",
+								"",
+								"",
+								0,
+								0,
+								0,
 							),
 						),
 					),
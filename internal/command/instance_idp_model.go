@@ -69,6 +69,7 @@ func (wm *InstanceOAuthIDPWriteModel) NewChangedEvent(
 	idAttribute string,
 	scopes []string,
 	usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) (*instance.OAuthIDPChangedEvent, error) {
 
@@ -83,6 +84,7 @@ func (wm *InstanceOAuthIDPWriteModel) NewChangedEvent(
 		idAttribute,
 		scopes,
 		usePKCE,
+		claimMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -177,6 +179,7 @@ func (wm *InstanceOIDCIDPWriteModel) NewChangedEvent(
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	idTokenMapping, usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) (*instance.OIDCIDPChangedEvent, error) {
 
@@ -189,6 +192,7 @@ func (wm *InstanceOIDCIDPWriteModel) NewChangedEvent(
 		scopes,
 		idTokenMapping,
 		usePKCE,
+		claimMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -945,6 +949,273 @@ func (wm *InstanceSAMLIDPWriteModel) NewChangedEvent(
 	return instance.NewSAMLIDPChangedEvent(ctx, aggregate, id, changes)
 }
 
+type InstanceOktaIDPWriteModel struct {
+	OktaIDPWriteModel
+}
+
+func NewOktaInstanceIDPWriteModel(instanceID, id string) *InstanceOktaIDPWriteModel {
+	return &InstanceOktaIDPWriteModel{
+		OktaIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceOktaIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.OktaIDPAddedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.OktaIDPAddedEvent)
+		case *instance.OktaIDPChangedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.OktaIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.OktaIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceOktaIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.OktaIDPAddedEventType,
+			instance.OktaIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceOktaIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	domain,
+	authorizationServerID,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.OktaIDPChangedEvent, error) {
+
+	changes, err := wm.OktaIDPWriteModel.NewChanges(name, domain, authorizationServerID, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewOktaIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type InstanceAuth0IDPWriteModel struct {
+	Auth0IDPWriteModel
+}
+
+func NewAuth0InstanceIDPWriteModel(instanceID, id string) *InstanceAuth0IDPWriteModel {
+	return &InstanceAuth0IDPWriteModel{
+		Auth0IDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceAuth0IDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.Auth0IDPAddedEvent:
+			wm.Auth0IDPWriteModel.AppendEvents(&e.Auth0IDPAddedEvent)
+		case *instance.Auth0IDPChangedEvent:
+			wm.Auth0IDPWriteModel.AppendEvents(&e.Auth0IDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.Auth0IDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.Auth0IDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceAuth0IDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.Auth0IDPAddedEventType,
+			instance.Auth0IDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceAuth0IDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	domain,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.Auth0IDPChangedEvent, error) {
+
+	changes, err := wm.Auth0IDPWriteModel.NewChanges(name, domain, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewAuth0IDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type InstanceKeycloakIDPWriteModel struct {
+	KeycloakIDPWriteModel
+}
+
+func NewKeycloakInstanceIDPWriteModel(instanceID, id string) *InstanceKeycloakIDPWriteModel {
+	return &InstanceKeycloakIDPWriteModel{
+		KeycloakIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceKeycloakIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.KeycloakIDPAddedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.KeycloakIDPAddedEvent)
+		case *instance.KeycloakIDPChangedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.KeycloakIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.KeycloakIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceKeycloakIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.KeycloakIDPAddedEventType,
+			instance.KeycloakIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceKeycloakIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	baseURL,
+	realm,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.KeycloakIDPChangedEvent, error) {
+
+	changes, err := wm.KeycloakIDPWriteModel.NewChanges(name, baseURL, realm, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewKeycloakIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type InstanceEntraExternalIDIDPWriteModel struct {
+	EntraExternalIDIDPWriteModel
+}
+
+func NewEntraExternalIDInstanceIDPWriteModel(instanceID, id string) *InstanceEntraExternalIDIDPWriteModel {
+	return &InstanceEntraExternalIDIDPWriteModel{
+		EntraExternalIDIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   instanceID,
+				ResourceOwner: instanceID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *InstanceEntraExternalIDIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *instance.EntraExternalIDIDPAddedEvent:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(&e.EntraExternalIDIDPAddedEvent)
+		case *instance.EntraExternalIDIDPChangedEvent:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(&e.EntraExternalIDIDPChangedEvent)
+		case *instance.IDPRemovedEvent:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *InstanceEntraExternalIDIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(instance.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			instance.EntraExternalIDIDPAddedEventType,
+			instance.EntraExternalIDIDPChangedEventType,
+			instance.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *InstanceEntraExternalIDIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	tenantID,
+	domain,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*instance.EntraExternalIDIDPChangedEvent, error) {
+
+	changes, err := wm.EntraExternalIDIDPWriteModel.NewChanges(name, tenantID, domain, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return instance.NewEntraExternalIDIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
 type InstanceIDPRemoveWriteModel struct {
 	IDPRemoveWriteModel
 }
@@ -988,6 +1259,14 @@ func (wm *InstanceIDPRemoveWriteModel) AppendEvents(events ...eventstore.Event)
 			wm.IDPRemoveWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *instance.AppleIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.AppleIDPAddedEvent)
+		case *instance.OktaIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.OktaIDPAddedEvent)
+		case *instance.Auth0IDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.Auth0IDPAddedEvent)
+		case *instance.KeycloakIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.KeycloakIDPAddedEvent)
+		case *instance.EntraExternalIDIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.EntraExternalIDIDPAddedEvent)
 		case *instance.IDPRemovedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.RemovedEvent)
 		case *instance.IDPConfigAddedEvent:
@@ -1019,6 +1298,10 @@ func (wm *InstanceIDPRemoveWriteModel) Query() *eventstore.SearchQueryBuilder {
 			instance.LDAPIDPAddedEventType,
 			instance.AppleIDPAddedEventType,
 			instance.SAMLIDPAddedEventType,
+			instance.OktaIDPAddedEventType,
+			instance.Auth0IDPAddedEventType,
+			instance.KeycloakIDPAddedEventType,
+			instance.EntraExternalIDIDPAddedEventType,
 			instance.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
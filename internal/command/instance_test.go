@@ -90,6 +90,8 @@ func apiAppEvents(ctx context.Context, orgID, projectID, id, name string) []even
 			"clientID",
 			"",
 			domain.APIAuthMethodTypePrivateKeyJWT,
+			"",
+			"",
 		),
 	}
 }
@@ -125,6 +127,14 @@ func oidcAppEvents(ctx context.Context, orgID, projectID, id, name, clientID str
 			"",
 			domain.LoginVersionUnspecified,
 			"",
+			false,
+			false,
+			"",
+			"",
+			"",
+			0,
+			0,
+			0,
 		),
 	}
 }
@@ -450,6 +460,14 @@ func generatedDomainFilters(instanceID, orgID, projectID, appID, generatedDomain
 				"",
 				domain.LoginVersionUnspecified,
 				"",
+				false,
+				false,
+				"",
+				"",
+				"",
+				0,
+				0,
+				0,
 			),
 		),
 		expectFilter(
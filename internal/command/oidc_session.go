@@ -33,21 +33,24 @@ const (
 )
 
 type OIDCSession struct {
-	SessionID         string
-	TokenID           string
-	ClientID          string
-	UserID            string
-	Audience          []string
-	Expiration        time.Time
-	Scope             []string
-	AuthMethods       []domain.UserAuthMethodType
-	AuthTime          time.Time
-	Nonce             string
-	PreferredLanguage *language.Tag
-	UserAgent         *domain.UserAgent
-	Reason            domain.TokenReason
-	Actor             *domain.TokenActor
-	RefreshToken      string
+	SessionID             string
+	TokenID               string
+	ClientID              string
+	UserID                string
+	Audience              []string
+	Expiration            time.Time
+	Scope                 []string
+	AuthMethods           []domain.UserAuthMethodType
+	AuthTime              time.Time
+	Nonce                 string
+	PreferredLanguage     *language.Tag
+	UserAgent             *domain.UserAgent
+	Reason                domain.TokenReason
+	Actor                 *domain.TokenActor
+	RefreshToken          string
+	DPoPJKT               string
+	CertificateThumbprint string
+	AuthorizationDetails  domain.AuthorizationDetails
 }
 
 type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel) error
@@ -55,12 +58,18 @@ type AuthRequestComplianceChecker func(context.Context, *AuthRequestWriteModel)
 // CreateOIDCSessionFromAuthRequest creates a new OIDC Session, creates an access token and refresh token.
 // It returns the access token id, expiration and the refresh token.
 // If the underlying [AuthRequest] is a OIDC Auth Code Flow, it will set the code as exchanged.
+// If a dpopJKT is provided, the tokens of the session are bound to the corresponding DPoP key.
+// If a certificateThumbprint is provided, the tokens of the session are bound to the corresponding client certificate.
+// The clientLifetimes of the tokens take precedence over the ones of the OIDC settings.
 func (c *Commands) CreateOIDCSessionFromAuthRequest(
 	ctx context.Context,
 	authReqId string,
 	complianceCheck AuthRequestComplianceChecker,
 	needRefreshToken bool,
 	backChannelLogoutURI string,
+	dpopJKT string,
+	certificateThumbprint string,
+	clientLifetimes ClientTokenLifetimes,
 ) (session *OIDCSession, state string, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
@@ -88,7 +97,7 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 		return nil, "", err
 	}
 
-	cmd, err := c.newOIDCSessionAddEvents(ctx, sessionModel.UserID, sessionModel.UserResourceOwner)
+	cmd, err := c.newOIDCSessionAddEvents(ctx, sessionModel.UserID, sessionModel.UserResourceOwner, clientLifetimes)
 	if err != nil {
 		return nil, "", err
 	}
@@ -113,11 +122,14 @@ func (c *Commands) CreateOIDCSessionFromAuthRequest(
 		authReqModel.Nonce,
 		sessionModel.PreferredLanguage,
 		sessionModel.UserAgent,
+		authReqModel.AuthorizationDetails,
 	)
+	cmd.BindDPoPKey(ctx, dpopJKT)
+	cmd.BindCertificate(ctx, certificateThumbprint)
 	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, authReqModel.ClientID, backChannelLogoutURI)
 
 	if authReqModel.ResponseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil); err != nil {
+		if err = cmd.AddAccessToken(ctx, authReqModel.Scope, sessionModel.UserID, sessionModel.UserResourceOwner, domain.TokenReasonAuthRequest, nil, nil); err != nil {
 			return nil, "", err
 		}
 	}
@@ -155,11 +167,15 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 	needRefreshToken bool,
 	sessionID string,
 	responseType domain.OIDCResponseType,
+	dpopJKT string,
+	certificateThumbprint string,
+	authorizationDetails domain.AuthorizationDetails,
+	clientLifetimes ClientTokenLifetimes,
 ) (session *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	cmd, err := c.newOIDCSessionAddEvents(ctx, userID, resourceOwner)
+	cmd, err := c.newOIDCSessionAddEvents(ctx, userID, resourceOwner, clientLifetimes)
 	if err != nil {
 		return nil, err
 	}
@@ -170,10 +186,12 @@ func (c *Commands) CreateOIDCSession(ctx context.Context,
 		cmd.UserImpersonated(ctx, userID, resourceOwner, clientID, actor)
 	}
 
-	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent)
+	cmd.AddSession(ctx, userID, resourceOwner, sessionID, clientID, audience, scope, authMethods, authTime, nonce, preferredLanguage, userAgent, authorizationDetails)
+	cmd.BindDPoPKey(ctx, dpopJKT)
+	cmd.BindCertificate(ctx, certificateThumbprint)
 	cmd.RegisterLogout(ctx, sessionID, userID, clientID, backChannelLogoutURI)
 	if responseType != domain.OIDCResponseTypeIDToken {
-		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor); err != nil {
+		if err = cmd.AddAccessToken(ctx, scope, userID, resourceOwner, reason, actor, nil); err != nil {
 			return nil, err
 		}
 	}
@@ -197,11 +215,16 @@ type RefreshTokenComplianceChecker func(ctx context.Context, wm *OIDCSessionWrit
 
 // ExchangeOIDCSessionRefreshAndAccessToken updates an existing OIDC Session, creates a new access and refresh token.
 // It returns the access token id and expiration and the new refresh token.
-func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, complianceCheck RefreshTokenComplianceChecker) (_ *OIDCSession, err error) {
+// If the session is bound to a DPoP key, the provided dpopJKT must match it.
+// If a certificateThumbprint is provided, the new tokens are bound to the corresponding client certificate,
+// which allows clients to rotate their certificate.
+// The authorizationDetails narrow the new access token to a subset of the granted ones (checked by the complianceCheck).
+// The clientLifetimes of the tokens take precedence over the ones of the OIDC settings.
+func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context, refreshToken string, scope []string, authorizationDetails domain.AuthorizationDetails, complianceCheck RefreshTokenComplianceChecker, dpopJKT, certificateThumbprint string, clientLifetimes ClientTokenLifetimes) (_ *OIDCSession, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	cmd, err := c.newOIDCSessionUpdateEvents(ctx, refreshToken)
+	cmd, err := c.newOIDCSessionUpdateEvents(ctx, refreshToken, dpopJKT, clientLifetimes)
 	if err != nil {
 		return nil, err
 	}
@@ -209,11 +232,13 @@ func (c *Commands) ExchangeOIDCSessionRefreshAndAccessToken(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	cmd.BindCertificate(ctx, certificateThumbprint)
 	err = cmd.AddAccessToken(ctx, scope,
 		cmd.oidcSessionWriteModel.UserID,
 		cmd.oidcSessionWriteModel.UserResourceOwner,
 		domain.TokenReasonRefresh,
 		cmd.oidcSessionWriteModel.AccessTokenActor,
+		authorizationDetails,
 	)
 	if err != nil {
 		return nil, err
@@ -294,7 +319,7 @@ func (c *Commands) RevokeOIDCSessionToken(ctx context.Context, token, clientID s
 	return c.pushAppendAndReduce(ctx, writeModel, oidcsession.NewAccessTokenRevokedEvent(ctx, writeModel.aggregate))
 }
 
-func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, userID, resourceOwner string, pending ...eventstore.Command) (*OIDCSessionEvents, error) {
+func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, userID, resourceOwner string, clientLifetimes ClientTokenLifetimes, pending ...eventstore.Command) (*OIDCSessionEvents, error) {
 	userStateModel, err := c.userStateWriteModel(ctx, userID)
 	if err != nil {
 		return nil, err
@@ -302,7 +327,7 @@ func (c *Commands) newOIDCSessionAddEvents(ctx context.Context, userID, resource
 	if !userStateModel.UserState.IsEnabled() {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDCS-kj3g2", "Errors.User.NotActive")
 	}
-	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx)
+	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx, clientLifetimes)
 	if err != nil {
 		return nil, err
 	}
@@ -346,7 +371,7 @@ func parseRefreshToken(refreshToken string) (oidcSessionID, refreshTokenID strin
 	return split[0], strings.Split(split[1], oidcTokenSubjectDelimiter)[0], nil
 }
 
-func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken string) (*OIDCSessionEvents, error) {
+func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken, dpopJKT string, clientLifetimes ClientTokenLifetimes) (*OIDCSessionEvents, error) {
 	oidcSessionID, refreshTokenID, err := c.decryptRefreshToken(refreshToken)
 	if err != nil {
 		return nil, err
@@ -358,6 +383,9 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 	if err = sessionWriteModel.CheckRefreshToken(refreshTokenID); err != nil {
 		return nil, err
 	}
+	if err = sessionWriteModel.CheckDPoPKey(dpopJKT); err != nil {
+		return nil, err
+	}
 	userStateWriteModel, err := c.userStateWriteModel(ctx, sessionWriteModel.UserID)
 	if err != nil {
 		return nil, err
@@ -365,7 +393,7 @@ func (c *Commands) newOIDCSessionUpdateEvents(ctx context.Context, refreshToken
 	if !userStateWriteModel.UserState.IsEnabled() {
 		return nil, zerrors.ThrowPreconditionFailed(nil, "OIDCS-J39h2", "Errors.User.NotActive")
 	}
-	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx)
+	accessTokenLifetime, refreshTokenLifeTime, refreshTokenIdleLifetime, err := c.tokenTokenLifetimes(ctx, clientLifetimes)
 	if err != nil {
 		return nil, err
 	}
@@ -413,6 +441,7 @@ func (c *OIDCSessionEvents) AddSession(
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	authorizationDetails domain.AuthorizationDetails,
 ) {
 	c.events = append(c.events, oidcsession.NewAddedEvent(
 		ctx,
@@ -428,6 +457,7 @@ func (c *OIDCSessionEvents) AddSession(
 		nonce,
 		preferredLanguage,
 		userAgent,
+		authorizationDetails,
 	))
 }
 
@@ -466,13 +496,16 @@ func (c *OIDCSessionEvents) RegisterLogout(ctx context.Context, sessionID, userI
 	))
 }
 
-func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor) error {
+// AddAccessToken adds a new access token to the session.
+// The authorizationDetails narrow the token to a subset of the granted authorization details of the session;
+// if none are provided, the token carries all of them.
+func (c *OIDCSessionEvents) AddAccessToken(ctx context.Context, scope []string, userID, resourceOwner string, reason domain.TokenReason, actor *domain.TokenActor, authorizationDetails domain.AuthorizationDetails) error {
 	accessTokenID, err := c.idGenerator.Next()
 	if err != nil {
 		return err
 	}
 	c.accessTokenID = AccessTokenPrefix + accessTokenID
-	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor))
+	c.events = append(c.events, oidcsession.NewAccessTokenAddedEvent(ctx, c.oidcSessionWriteModel.aggregate, c.accessTokenID, scope, c.accessTokenLifetime, reason, actor, authorizationDetails))
 	if !authz.GetFeatures(ctx).DisableUserTokenEvent {
 		c.events = append(c.events, user.NewUserTokenV2AddedEvent(ctx, &user.NewAggregate(userID, resourceOwner).Aggregate, c.accessTokenID))
 	}
@@ -498,6 +531,24 @@ func (c *OIDCSessionEvents) RenewRefreshToken(ctx context.Context) (err error) {
 	return nil
 }
 
+// BindCertificate binds the tokens of the session to the client certificate with the provided x5t#S256 thumbprint.
+// Nothing is bound if the thumbprint is empty or the session is already bound to the certificate.
+func (c *OIDCSessionEvents) BindCertificate(ctx context.Context, thumbprint string) {
+	if thumbprint == "" || thumbprint == c.oidcSessionWriteModel.CertificateThumbprint {
+		return
+	}
+	c.events = append(c.events, oidcsession.NewCertificateBoundEvent(ctx, c.oidcSessionWriteModel.aggregate, thumbprint))
+}
+
+// BindDPoPKey binds the tokens of the session to the DPoP key with the provided JWK thumbprint.
+// Nothing is bound if the thumbprint is empty.
+func (c *OIDCSessionEvents) BindDPoPKey(ctx context.Context, jkt string) {
+	if jkt == "" {
+		return
+	}
+	c.events = append(c.events, oidcsession.NewDPoPBoundEvent(ctx, c.oidcSessionWriteModel.aggregate, jkt))
+}
+
 func (c *OIDCSessionEvents) UserImpersonated(ctx context.Context, userID, resourceOwner, clientID string, actor *domain.TokenActor) {
 	c.events = append(c.events, user.NewUserImpersonatedEvent(ctx, &user.NewAggregate(userID, resourceOwner).Aggregate, clientID, actor))
 }
@@ -525,20 +576,23 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 		return nil, err
 	}
 	session := &OIDCSession{
-		SessionID:         c.oidcSessionWriteModel.SessionID,
-		ClientID:          c.oidcSessionWriteModel.ClientID,
-		UserID:            c.oidcSessionWriteModel.UserID,
-		Audience:          c.oidcSessionWriteModel.Audience,
-		Expiration:        c.oidcSessionWriteModel.AccessTokenExpiration,
-		Scope:             c.oidcSessionWriteModel.Scope,
-		AuthMethods:       c.oidcSessionWriteModel.AuthMethods,
-		AuthTime:          c.oidcSessionWriteModel.AuthTime,
-		Nonce:             c.oidcSessionWriteModel.Nonce,
-		PreferredLanguage: c.oidcSessionWriteModel.PreferredLanguage,
-		UserAgent:         c.oidcSessionWriteModel.UserAgent,
-		Reason:            c.oidcSessionWriteModel.AccessTokenReason,
-		Actor:             c.oidcSessionWriteModel.AccessTokenActor,
-		RefreshToken:      c.refreshToken,
+		SessionID:             c.oidcSessionWriteModel.SessionID,
+		ClientID:              c.oidcSessionWriteModel.ClientID,
+		UserID:                c.oidcSessionWriteModel.UserID,
+		Audience:              c.oidcSessionWriteModel.Audience,
+		Expiration:            c.oidcSessionWriteModel.AccessTokenExpiration,
+		Scope:                 c.oidcSessionWriteModel.Scope,
+		AuthMethods:           c.oidcSessionWriteModel.AuthMethods,
+		AuthTime:              c.oidcSessionWriteModel.AuthTime,
+		Nonce:                 c.oidcSessionWriteModel.Nonce,
+		PreferredLanguage:     c.oidcSessionWriteModel.PreferredLanguage,
+		UserAgent:             c.oidcSessionWriteModel.UserAgent,
+		Reason:                c.oidcSessionWriteModel.AccessTokenReason,
+		Actor:                 c.oidcSessionWriteModel.AccessTokenActor,
+		RefreshToken:          c.refreshToken,
+		DPoPJKT:               c.oidcSessionWriteModel.DPoPJKT,
+		CertificateThumbprint: c.oidcSessionWriteModel.CertificateThumbprint,
+		AuthorizationDetails:  c.oidcSessionWriteModel.AccessTokenAuthorizationDetails,
 	}
 	if c.accessTokenID != "" {
 		// prefix the returned id with the oidcSessionID so that we can retrieve it later on
@@ -549,7 +603,14 @@ func (c *OIDCSessionEvents) PushEvents(ctx context.Context) (*OIDCSession, error
 	return session, nil
 }
 
-func (c *Commands) tokenTokenLifetimes(ctx context.Context) (accessTokenLifetime time.Duration, refreshTokenLifetime time.Duration, refreshTokenIdleLifetime time.Duration, err error) {
+// ClientTokenLifetimes are the access and refresh token lifetimes configured on the client.
+// If set, they take precedence over the lifetimes of the OIDC settings.
+type ClientTokenLifetimes struct {
+	AccessToken  time.Duration
+	RefreshToken time.Duration
+}
+
+func (c *Commands) tokenTokenLifetimes(ctx context.Context, clientLifetimes ClientTokenLifetimes) (accessTokenLifetime time.Duration, refreshTokenLifetime time.Duration, refreshTokenIdleLifetime time.Duration, err error) {
 	oidcSettings := NewInstanceOIDCSettingsWriteModel(ctx)
 	err = c.eventstore.FilterToQueryReducer(ctx, oidcSettings)
 	if err != nil {
@@ -567,6 +628,12 @@ func (c *Commands) tokenTokenLifetimes(ctx context.Context) (accessTokenLifetime
 	if oidcSettings.RefreshTokenIdleExpiration > 0 {
 		refreshTokenIdleLifetime = oidcSettings.RefreshTokenIdleExpiration
 	}
+	if clientLifetimes.AccessToken > 0 {
+		accessTokenLifetime = clientLifetimes.AccessToken
+	}
+	if clientLifetimes.RefreshToken > 0 {
+		refreshTokenLifetime = clientLifetimes.RefreshToken
+	}
 	return accessTokenLifetime, refreshTokenLifetime, refreshTokenIdleLifetime, nil
 }
 
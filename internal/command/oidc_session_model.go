@@ -35,6 +35,12 @@ type OIDCSessionWriteModel struct {
 	RefreshToken               string
 	RefreshTokenExpiration     time.Time
 	RefreshTokenIdleExpiration time.Time
+	DPoPJKT                    string
+	CertificateThumbprint      string
+	AuthorizationDetails       domain.AuthorizationDetails
+	// AccessTokenAuthorizationDetails are the authorization details of the current access token,
+	// which might be a subset of the granted AuthorizationDetails.
+	AccessTokenAuthorizationDetails domain.AuthorizationDetails
 
 	aggregate *eventstore.Aggregate
 }
@@ -64,6 +70,10 @@ func (wm *OIDCSessionWriteModel) Reduce() error {
 			wm.reduceRefreshTokenRenewed(e)
 		case *oidcsession.RefreshTokenRevokedEvent:
 			wm.reduceRefreshTokenRevoked(e)
+		case *oidcsession.DPoPBoundEvent:
+			wm.DPoPJKT = e.JKT
+		case *oidcsession.CertificateBoundEvent:
+			wm.CertificateThumbprint = e.Thumbprint
 		}
 	}
 	return wm.WriteModel.Reduce()
@@ -80,6 +90,8 @@ func (wm *OIDCSessionWriteModel) Query() *eventstore.SearchQueryBuilder {
 			oidcsession.RefreshTokenAddedType,
 			oidcsession.RefreshTokenRenewedType,
 			oidcsession.RefreshTokenRevokedType,
+			oidcsession.DPoPBoundType,
+			oidcsession.CertificateBoundType,
 		).
 		Builder()
 
@@ -101,6 +113,7 @@ func (wm *OIDCSessionWriteModel) reduceAdded(e *oidcsession.AddedEvent) {
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.AuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 	// the write model might be initialized without resource owner,
 	// so update the aggregate
@@ -114,6 +127,10 @@ func (wm *OIDCSessionWriteModel) reduceAccessTokenAdded(e *oidcsession.AccessTok
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.AccessTokenReason = e.Reason
 	wm.AccessTokenActor = e.Actor
+	wm.AccessTokenAuthorizationDetails = wm.AuthorizationDetails
+	if len(e.AuthorizationDetails) > 0 {
+		wm.AccessTokenAuthorizationDetails = e.AuthorizationDetails
+	}
 }
 
 func (wm *OIDCSessionWriteModel) reduceAccessTokenRevoked(e *oidcsession.AccessTokenRevokedEvent) {
@@ -167,6 +184,15 @@ func (wm *OIDCSessionWriteModel) CheckAccessToken(accessTokenID string) error {
 	return nil
 }
 
+// CheckDPoPKey ensures that tokens of a DPoP bound session are only issued
+// to the holder of the same key, identified by the JWK thumbprint of the presented proof.
+func (wm *OIDCSessionWriteModel) CheckDPoPKey(jkt string) error {
+	if wm.DPoPJKT != "" && wm.DPoPJKT != jkt {
+		return zerrors.ThrowPreconditionFailed(nil, "OIDCS-Ahx5e", "Errors.OIDCSession.DPoPKeyMismatch")
+	}
+	return nil
+}
+
 func (wm *OIDCSessionWriteModel) CheckClient(clientID string) error {
 	for _, aud := range wm.Audience {
 		if aud == clientID {
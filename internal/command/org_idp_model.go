@@ -71,6 +71,7 @@ func (wm *OrgOAuthIDPWriteModel) NewChangedEvent(
 	idAttribute string,
 	scopes []string,
 	usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) (*org.OAuthIDPChangedEvent, error) {
 
@@ -85,6 +86,7 @@ func (wm *OrgOAuthIDPWriteModel) NewChangedEvent(
 		idAttribute,
 		scopes,
 		usePKCE,
+		claimMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -179,6 +181,7 @@ func (wm *OrgOIDCIDPWriteModel) NewChangedEvent(
 	secretCrypto crypto.EncryptionAlgorithm,
 	scopes []string,
 	idTokenMapping, usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) (*org.OIDCIDPChangedEvent, error) {
 
@@ -191,6 +194,7 @@ func (wm *OrgOIDCIDPWriteModel) NewChangedEvent(
 		scopes,
 		idTokenMapping,
 		usePKCE,
+		claimMapping,
 		options,
 	)
 	if err != nil || len(changes) == 0 {
@@ -957,6 +961,273 @@ func (wm *OrgSAMLIDPWriteModel) NewChangedEvent(
 	return org.NewSAMLIDPChangedEvent(ctx, aggregate, id, changes)
 }
 
+type OrgOktaIDPWriteModel struct {
+	OktaIDPWriteModel
+}
+
+func NewOktaOrgIDPWriteModel(orgID, id string) *OrgOktaIDPWriteModel {
+	return &OrgOktaIDPWriteModel{
+		OktaIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgOktaIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.OktaIDPAddedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.OktaIDPAddedEvent)
+		case *org.OktaIDPChangedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.OktaIDPChangedEvent)
+		case *org.IDPRemovedEvent:
+			wm.OktaIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.OktaIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgOktaIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.OktaIDPAddedEventType,
+			org.OktaIDPChangedEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *OrgOktaIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	domain,
+	authorizationServerID,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*org.OktaIDPChangedEvent, error) {
+
+	changes, err := wm.OktaIDPWriteModel.NewChanges(name, domain, authorizationServerID, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return org.NewOktaIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type OrgAuth0IDPWriteModel struct {
+	Auth0IDPWriteModel
+}
+
+func NewAuth0OrgIDPWriteModel(orgID, id string) *OrgAuth0IDPWriteModel {
+	return &OrgAuth0IDPWriteModel{
+		Auth0IDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgAuth0IDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.Auth0IDPAddedEvent:
+			wm.Auth0IDPWriteModel.AppendEvents(&e.Auth0IDPAddedEvent)
+		case *org.Auth0IDPChangedEvent:
+			wm.Auth0IDPWriteModel.AppendEvents(&e.Auth0IDPChangedEvent)
+		case *org.IDPRemovedEvent:
+			wm.Auth0IDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.Auth0IDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgAuth0IDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.Auth0IDPAddedEventType,
+			org.Auth0IDPChangedEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *OrgAuth0IDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	domain,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*org.Auth0IDPChangedEvent, error) {
+
+	changes, err := wm.Auth0IDPWriteModel.NewChanges(name, domain, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return org.NewAuth0IDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type OrgKeycloakIDPWriteModel struct {
+	KeycloakIDPWriteModel
+}
+
+func NewKeycloakOrgIDPWriteModel(orgID, id string) *OrgKeycloakIDPWriteModel {
+	return &OrgKeycloakIDPWriteModel{
+		KeycloakIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgKeycloakIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.KeycloakIDPAddedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.KeycloakIDPAddedEvent)
+		case *org.KeycloakIDPChangedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.KeycloakIDPChangedEvent)
+		case *org.IDPRemovedEvent:
+			wm.KeycloakIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.KeycloakIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgKeycloakIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.KeycloakIDPAddedEventType,
+			org.KeycloakIDPChangedEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *OrgKeycloakIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	baseURL,
+	realm,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*org.KeycloakIDPChangedEvent, error) {
+
+	changes, err := wm.KeycloakIDPWriteModel.NewChanges(name, baseURL, realm, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return org.NewKeycloakIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
+type OrgEntraExternalIDIDPWriteModel struct {
+	EntraExternalIDIDPWriteModel
+}
+
+func NewEntraExternalIDOrgIDPWriteModel(orgID, id string) *OrgEntraExternalIDIDPWriteModel {
+	return &OrgEntraExternalIDIDPWriteModel{
+		EntraExternalIDIDPWriteModel{
+			WriteModel: eventstore.WriteModel{
+				AggregateID:   orgID,
+				ResourceOwner: orgID,
+			},
+			ID: id,
+		},
+	}
+}
+
+func (wm *OrgEntraExternalIDIDPWriteModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		switch e := event.(type) {
+		case *org.EntraExternalIDIDPAddedEvent:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(&e.EntraExternalIDIDPAddedEvent)
+		case *org.EntraExternalIDIDPChangedEvent:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(&e.EntraExternalIDIDPChangedEvent)
+		case *org.IDPRemovedEvent:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(&e.RemovedEvent)
+		default:
+			wm.EntraExternalIDIDPWriteModel.AppendEvents(e)
+		}
+	}
+}
+
+func (wm *OrgEntraExternalIDIDPWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(org.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(
+			org.EntraExternalIDIDPAddedEventType,
+			org.EntraExternalIDIDPChangedEventType,
+			org.IDPRemovedEventType,
+		).
+		EventData(map[string]interface{}{"id": wm.ID}).
+		Builder()
+}
+
+func (wm *OrgEntraExternalIDIDPWriteModel) NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	tenantID,
+	domain,
+	clientID string,
+	clientSecretString string,
+	secretCrypto crypto.EncryptionAlgorithm,
+	scopes []string,
+	options idp.Options,
+) (*org.EntraExternalIDIDPChangedEvent, error) {
+
+	changes, err := wm.EntraExternalIDIDPWriteModel.NewChanges(name, tenantID, domain, clientID, clientSecretString, secretCrypto, scopes, options)
+	if err != nil || len(changes) == 0 {
+		return nil, err
+	}
+	return org.NewEntraExternalIDIDPChangedEvent(ctx, aggregate, id, changes)
+}
+
 type OrgIDPRemoveWriteModel struct {
 	IDPRemoveWriteModel
 }
@@ -998,6 +1269,14 @@ func (wm *OrgIDPRemoveWriteModel) AppendEvents(events ...eventstore.Event) {
 			wm.IDPRemoveWriteModel.AppendEvents(&e.LDAPIDPAddedEvent)
 		case *org.AppleIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.AppleIDPAddedEvent)
+		case *org.OktaIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.OktaIDPAddedEvent)
+		case *org.Auth0IDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.Auth0IDPAddedEvent)
+		case *org.KeycloakIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.KeycloakIDPAddedEvent)
+		case *org.EntraExternalIDIDPAddedEvent:
+			wm.IDPRemoveWriteModel.AppendEvents(&e.EntraExternalIDIDPAddedEvent)
 		case *org.SAMLIDPAddedEvent:
 			wm.IDPRemoveWriteModel.AppendEvents(&e.SAMLIDPAddedEvent)
 		case *org.IDPRemovedEvent:
@@ -1031,6 +1310,10 @@ func (wm *OrgIDPRemoveWriteModel) Query() *eventstore.SearchQueryBuilder {
 			org.LDAPIDPAddedEventType,
 			org.AppleIDPAddedEventType,
 			org.SAMLIDPAddedEventType,
+			org.OktaIDPAddedEventType,
+			org.Auth0IDPAddedEventType,
+			org.KeycloakIDPAddedEventType,
+			org.EntraExternalIDIDPAddedEventType,
 			org.IDPRemovedEventType,
 		).
 		EventData(map[string]interface{}{"id": wm.ID}).
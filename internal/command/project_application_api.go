@@ -61,6 +61,8 @@ func (c *Commands) AddAPIAppCommand(app *addAPIApp) preparation.Validation {
 					app.ClientID,
 					app.EncodedHash,
 					app.AuthMethodType,
+					"",
+					"",
 				),
 			}, nil
 		}, nil
@@ -117,6 +119,10 @@ func (c *Commands) addAPIApplicationWithID(ctx context.Context, apiApp *domain.A
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if !apiApp.TLSClientAuthValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Ka3ub", "Errors.Project.App.TLSClientAuthInvalid")
+	}
+
 	apiApp.AppID = appID
 
 	addedApplication := NewAPIApplicationWriteModel(apiApp.AggregateID, resourceOwner)
@@ -149,7 +155,10 @@ func (c *Commands) addAPIApplicationWithID(ctx context.Context, apiApp *domain.A
 		apiApp.AppID,
 		apiApp.ClientID,
 		apiApp.EncodedHash,
-		apiApp.AuthMethodType))
+		apiApp.AuthMethodType,
+		strings.TrimSpace(apiApp.TLSClientAuthSubjectDN),
+		apiApp.TLSClientAuthPublicKeyPin,
+	))
 
 	addedApplication.AppID = apiApp.AppID
 	pushedEvents, err := c.eventstore.Push(ctx, events...)
@@ -169,6 +178,9 @@ func (c *Commands) UpdateAPIApplication(ctx context.Context, apiApp *domain.APIA
 	if apiApp.AppID == "" || apiApp.AggregateID == "" {
 		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-1m900", "Errors.Project.App.APIConfigInvalid")
 	}
+	if !apiApp.TLSClientAuthValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Thoo3", "Errors.Project.App.TLSClientAuthInvalid")
+	}
 
 	existingAPI, err := c.getAPIAppWriteModel(ctx, apiApp.AggregateID, apiApp.AppID, resourceOwner)
 	if err != nil {
@@ -192,7 +204,10 @@ func (c *Commands) UpdateAPIApplication(ctx context.Context, apiApp *domain.APIA
 		ctx,
 		projectAgg,
 		apiApp.AppID,
-		apiApp.AuthMethodType)
+		apiApp.AuthMethodType,
+		strings.TrimSpace(apiApp.TLSClientAuthSubjectDN),
+		apiApp.TLSClientAuthPublicKeyPin,
+	)
 	if err != nil {
 		return nil, err
 	}
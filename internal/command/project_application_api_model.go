@@ -20,6 +20,9 @@ type APIApplicationWriteModel struct {
 	AuthMethodType     domain.APIAuthMethodType
 	State              domain.AppState
 	api                bool
+
+	TLSClientAuthSubjectDN    string
+	TLSClientAuthPublicKeyPin string
 }
 
 func NewAPIApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *APIApplicationWriteModel {
@@ -134,12 +137,20 @@ func (wm *APIApplicationWriteModel) appendAddAPIEvent(e *project.APIConfigAddedE
 	wm.ClientID = e.ClientID
 	wm.HashedSecret = crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)
 	wm.AuthMethodType = e.AuthMethodType
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.TLSClientAuthPublicKeyPin = e.TLSClientAuthPublicKeyPin
 }
 
 func (wm *APIApplicationWriteModel) appendChangeAPIEvent(e *project.APIConfigChangedEvent) {
 	if e.AuthMethodType != nil {
 		wm.AuthMethodType = *e.AuthMethodType
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.TLSClientAuthPublicKeyPin != nil {
+		wm.TLSClientAuthPublicKeyPin = *e.TLSClientAuthPublicKeyPin
+	}
 }
 
 func (wm *APIApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -167,6 +178,8 @@ func (wm *APIApplicationWriteModel) NewChangedEvent(
 	aggregate *eventstore.Aggregate,
 	appID string,
 	authMethodType domain.APIAuthMethodType,
+	tlsClientAuthSubjectDN,
+	tlsClientAuthPublicKeyPin string,
 ) (*project.APIConfigChangedEvent, bool, error) {
 	changes := make([]project.APIConfigChanges, 0)
 	var err error
@@ -174,6 +187,12 @@ func (wm *APIApplicationWriteModel) NewChangedEvent(
 	if wm.AuthMethodType != authMethodType {
 		changes = append(changes, project.ChangeAPIAuthMethodType(authMethodType))
 	}
+	if wm.TLSClientAuthSubjectDN != tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeAPITLSClientAuthSubjectDN(tlsClientAuthSubjectDN))
+	}
+	if wm.TLSClientAuthPublicKeyPin != tlsClientAuthPublicKeyPin {
+		changes = append(changes, project.ChangeAPITLSClientAuthPublicKeyPin(tlsClientAuthPublicKeyPin))
+	}
 	if len(changes) == 0 {
 		return nil, false, nil
 	}
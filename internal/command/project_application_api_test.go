@@ -115,6 +115,8 @@ func TestAddAPIConfig(t *testing.T) {
 						"clientID",
 						"",
 						domain.APIAuthMethodTypePrivateKeyJWT,
+						"",
+						"",
 					),
 				},
 			},
@@ -251,7 +253,9 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1",
 							"secret",
-							domain.APIAuthMethodTypeBasic),
+							domain.APIAuthMethodTypeBasic,
+							"",
+							""),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -306,7 +310,9 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1@project1",
 							"secret",
-							domain.APIAuthMethodTypeBasic),
+							domain.APIAuthMethodTypeBasic,
+							"",
+							""),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1@project1"),
@@ -361,7 +367,9 @@ func TestCommandSide_AddAPIApplication(t *testing.T) {
 							"app1",
 							"client1",
 							"",
-							domain.APIAuthMethodTypePrivateKeyJWT),
+							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
+							""),
 					),
 				),
 				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1", "client1"),
@@ -482,6 +490,27 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 				err: zerrors.IsErrorInvalidArgument,
 			},
 		},
+		{
+			name: "tls client auth without subject dn, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx: context.Background(),
+				apiApp: &domain.APIApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppID:          "app1",
+					AppName:        "app",
+					AuthMethodType: domain.APIAuthMethodTypeTLSClientAuth,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "app not existing, not found error",
 			fields: fields{
@@ -522,7 +551,9 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 								"app1",
 								"client1@project",
 								"",
-								domain.APIAuthMethodTypePrivateKeyJWT),
+								domain.APIAuthMethodTypePrivateKeyJWT,
+								"",
+								""),
 						),
 					),
 					expectFilter(),
@@ -562,7 +593,9 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+								""),
 						),
 					),
 					expectFilter(),
@@ -601,6 +634,73 @@ func TestCommandSide_ChangeAPIApplication(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "change api app to tls client auth, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewAPIConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"client1@project",
+								"secret",
+								domain.APIAuthMethodTypeBasic,
+								"",
+								""),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						func() *project.APIConfigChangedEvent {
+							event, _ := project.NewAPIConfigChangedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								[]project.APIConfigChanges{
+									project.ChangeAPIAuthMethodType(domain.APIAuthMethodTypeTLSClientAuth),
+									project.ChangeAPITLSClientAuthSubjectDN("CN=client,O=ZITADEL"),
+								},
+							)
+							return event
+						}(),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				apiApp: &domain.APIApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppID:                  "app1",
+					AppName:                "app",
+					AuthMethodType:         domain.APIAuthMethodTypeTLSClientAuth,
+					TLSClientAuthSubjectDN: " CN=client,O=ZITADEL ",
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.APIApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:                  "app1",
+					AppName:                "app",
+					ClientID:               "client1@project",
+					AuthMethodType:         domain.APIAuthMethodTypeTLSClientAuth,
+					TLSClientAuthSubjectDN: "CN=client,O=ZITADEL",
+					State:                  domain.AppStateActive,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -714,7 +814,9 @@ func TestCommandSide_ChangeAPIApplicationSecret(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+								""),
 						),
 					),
 					expectPush(
@@ -114,7 +114,9 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+								""),
 						),
 					),
 				),
@@ -155,7 +157,9 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+								""),
 						),
 					),
 				),
@@ -197,7 +201,9 @@ func TestCommandSide_AddAPIApplicationKey(t *testing.T) {
 								"app1",
 								"client1@project",
 								"secret",
-								domain.APIAuthMethodTypeBasic),
+								domain.APIAuthMethodTypeBasic,
+								"",
+								""),
 						),
 					),
 				),
@@ -18,28 +18,29 @@ import (
 
 type addOIDCApp struct {
 	AddApp
-	Version                     domain.OIDCVersion
-	RedirectUris                []string
-	ResponseTypes               []domain.OIDCResponseType
-	GrantTypes                  []domain.OIDCGrantType
-	ApplicationType             domain.OIDCApplicationType
-	AuthMethodType              domain.OIDCAuthMethodType
-	PostLogoutRedirectUris      []string
-	DevMode                     bool
-	AccessTokenType             domain.OIDCTokenType
-	AccessTokenRoleAssertion    bool
-	IDTokenRoleAssertion        bool
-	IDTokenUserinfoAssertion    bool
-	ClockSkew                   time.Duration
-	AdditionalOrigins           []string
-	SkipSuccessPageForNativeApp bool
-	BackChannelLogoutURI        string
-	LoginVersion                domain.LoginVersion
-	LoginBaseURI                string
-
-	ClientID          string
-	ClientSecret      string
-	ClientSecretPlain string
+	Version                            domain.OIDCVersion
+	RedirectUris                       []string
+	ResponseTypes                      []domain.OIDCResponseType
+	GrantTypes                         []domain.OIDCGrantType
+	ApplicationType                    domain.OIDCApplicationType
+	AuthMethodType                     domain.OIDCAuthMethodType
+	PostLogoutRedirectUris             []string
+	DevMode                            bool
+	AccessTokenType                    domain.OIDCTokenType
+	AccessTokenRoleAssertion           bool
+	IDTokenRoleAssertion               bool
+	IDTokenUserinfoAssertion           bool
+	ClockSkew                          time.Duration
+	AdditionalOrigins                  []string
+	SkipSuccessPageForNativeApp        bool
+	BackChannelLogoutURI               string
+	LoginVersion                       domain.LoginVersion
+	LoginBaseURI                       string
+	RequirePushedAuthorizationRequests bool
+	RequireDPoP                        bool
+	ClientID                           string
+	ClientSecret                       string
+	ClientSecretPlain                  string
 }
 
 // AddOIDCAppCommand prepares the commands to add an oidc app. The ClientID will be set during the CreateCommands
@@ -116,6 +117,14 @@ func (c *Commands) AddOIDCAppCommand(app *addOIDCApp) preparation.Validation {
 					app.BackChannelLogoutURI,
 					app.LoginVersion,
 					app.LoginBaseURI,
+					app.RequirePushedAuthorizationRequests,
+					app.RequireDPoP,
+					"",
+					"",
+					"",
+					0,
+					0,
+					0,
 				),
 			}, nil
 		}, nil
@@ -170,6 +179,10 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if !oidcApp.TLSClientAuthValid() {
+		return nil, zerrors.ThrowInvalidArgument(nil, "PROJECT-Ohz4a", "Errors.Project.App.TLSClientAuthInvalid")
+	}
+
 	addedApplication := NewOIDCApplicationWriteModel(oidcApp.AggregateID, resourceOwner)
 	if err := c.eventstore.FilterToQueryReducer(ctx, addedApplication); err != nil {
 		return nil, err
@@ -181,21 +194,47 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 	projectAgg := ProjectAggregateFromWriteModel(&addedApplication.WriteModel)
 
 	oidcApp.AppID = appID
+	events, plain, err := c.oidcApplicationAddedEvents(ctx, projectAgg, oidcApp)
+	if err != nil {
+		return nil, err
+	}
 
+	addedApplication.AppID = oidcApp.AppID
+	postCommit, err := c.applicationCreatedMilestone(ctx, &events)
+	if err != nil {
+		return nil, err
+	}
+	pushedEvents, err := c.eventstore.Push(ctx, events...)
+	if err != nil {
+		return nil, err
+	}
+	postCommit(ctx)
+	err = AppendAndReduce(addedApplication, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+	result := oidcWriteModelToOIDCConfig(addedApplication)
+	result.ClientSecretString = plain
+	result.FillCompliance()
+	return result, nil
+}
+
+// oidcApplicationAddedEvents returns the events to add the OIDC application to the project,
+// generating the client ID and, if required by the auth method, the client secret.
+func (c *Commands) oidcApplicationAddedEvents(ctx context.Context, projectAgg *eventstore.Aggregate, oidcApp *domain.OIDCApp) (_ []eventstore.Command, plain string, err error) {
 	events := []eventstore.Command{
 		project_repo.NewApplicationAddedEvent(ctx, projectAgg, oidcApp.AppID, oidcApp.AppName),
 	}
 
-	var plain string
 	err = domain.SetNewClientID(oidcApp, c.idGenerator)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	plain, err = domain.SetNewClientSecretIfNeeded(oidcApp, func() (string, string, error) {
 		return c.newHashedSecret(ctx, c.eventstore.Filter) //nolint:staticcheck
 	})
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	events = append(events, project_repo.NewOIDCConfigAddedEvent(ctx,
 		projectAgg,
@@ -220,26 +259,16 @@ func (c *Commands) addOIDCApplicationWithID(ctx context.Context, oidcApp *domain
 		strings.TrimSpace(gu.Value(oidcApp.BackChannelLogoutURI)),
 		gu.Value(oidcApp.LoginVersion),
 		strings.TrimSpace(gu.Value(oidcApp.LoginBaseURI)),
+		gu.Value(oidcApp.RequirePushedAuthorizationRequests),
+		gu.Value(oidcApp.RequireDPoP),
+		strings.TrimSpace(gu.Value(oidcApp.TLSClientAuthSubjectDN)),
+		gu.Value(oidcApp.TLSClientAuthPublicKeyPin),
+		strings.TrimSpace(gu.Value(oidcApp.BackChannelClientNotificationEndpoint)),
+		gu.Value(oidcApp.AccessTokenLifetime),
+		gu.Value(oidcApp.IDTokenLifetime),
+		gu.Value(oidcApp.RefreshTokenLifetime),
 	))
-
-	addedApplication.AppID = oidcApp.AppID
-	postCommit, err := c.applicationCreatedMilestone(ctx, &events)
-	if err != nil {
-		return nil, err
-	}
-	pushedEvents, err := c.eventstore.Push(ctx, events...)
-	if err != nil {
-		return nil, err
-	}
-	postCommit(ctx)
-	err = AppendAndReduce(addedApplication, pushedEvents...)
-	if err != nil {
-		return nil, err
-	}
-	result := oidcWriteModelToOIDCConfig(addedApplication)
-	result.ClientSecretString = plain
-	result.FillCompliance()
-	return result, nil
+	return events, plain, nil
 }
 
 func (c *Commands) UpdateOIDCApplication(ctx context.Context, oidc *domain.OIDCApp, resourceOwner string) (*domain.OIDCApp, error) {
@@ -264,8 +293,33 @@ func (c *Commands) UpdateOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		return nil, err
 	}
 
+	changedEvent, hasChanged, err := oidcApplicationChangedEvent(ctx, existingOIDC, oidc)
+	if err != nil {
+		return nil, err
+	}
+	if !hasChanged {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
+	}
+
+	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
+	if err != nil {
+		return nil, err
+	}
+	err = AppendAndReduce(existingOIDC, pushedEvents...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := oidcWriteModelToOIDCConfig(existingOIDC)
+	result.FillCompliance()
+	return result, nil
+}
+
+// oidcApplicationChangedEvent returns the event to change the existing OIDC application
+// with the (trimmed) non-nil values of the app.
+func oidcApplicationChangedEvent(ctx context.Context, existingOIDC *OIDCApplicationWriteModel, oidc *domain.OIDCApp) (*project_repo.OIDCConfigChangedEvent, bool, error) {
 	projectAgg := ProjectAggregateFromWriteModel(&existingOIDC.WriteModel)
-	var backChannelLogout, loginBaseURI *string
+	var backChannelLogout, loginBaseURI, tlsClientAuthSubjectDN, backChannelClientNotificationEndpoint *string
 	if oidc.BackChannelLogoutURI != nil {
 		backChannelLogout = gu.Ptr(strings.TrimSpace(*oidc.BackChannelLogoutURI))
 	}
@@ -274,7 +328,15 @@ func (c *Commands) UpdateOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		loginBaseURI = gu.Ptr(strings.TrimSpace(*oidc.LoginBaseURI))
 	}
 
-	changedEvent, hasChanged, err := existingOIDC.NewChangedEvent(
+	if oidc.TLSClientAuthSubjectDN != nil {
+		tlsClientAuthSubjectDN = gu.Ptr(strings.TrimSpace(*oidc.TLSClientAuthSubjectDN))
+	}
+
+	if oidc.BackChannelClientNotificationEndpoint != nil {
+		backChannelClientNotificationEndpoint = gu.Ptr(strings.TrimSpace(*oidc.BackChannelClientNotificationEndpoint))
+	}
+
+	return existingOIDC.NewChangedEvent(
 		ctx,
 		projectAgg,
 		oidc.AppID,
@@ -296,26 +358,15 @@ func (c *Commands) UpdateOIDCApplication(ctx context.Context, oidc *domain.OIDCA
 		backChannelLogout,
 		oidc.LoginVersion,
 		loginBaseURI,
+		oidc.RequirePushedAuthorizationRequests,
+		oidc.RequireDPoP,
+		tlsClientAuthSubjectDN,
+		oidc.TLSClientAuthPublicKeyPin,
+		backChannelClientNotificationEndpoint,
+		oidc.AccessTokenLifetime,
+		oidc.IDTokenLifetime,
+		oidc.RefreshTokenLifetime,
 	)
-	if err != nil {
-		return nil, err
-	}
-	if !hasChanged {
-		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-1m88i", "Errors.NoChangesFound")
-	}
-
-	pushedEvents, err := c.eventstore.Push(ctx, changedEvent)
-	if err != nil {
-		return nil, err
-	}
-	err = AppendAndReduce(existingOIDC, pushedEvents...)
-	if err != nil {
-		return nil, err
-	}
-
-	result := oidcWriteModelToOIDCConfig(existingOIDC)
-	result.FillCompliance()
-	return result, nil
 }
 
 func (c *Commands) ChangeOIDCApplicationSecret(ctx context.Context, projectID, appID, resourceOwner string) (*domain.OIDCApp, error) {
@@ -5,41 +5,52 @@ import (
 	"slices"
 	"time"
 
+	"github.com/muhlemmer/gu"
+
 	"github.com/zitadel/zitadel/internal/crypto"
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type OIDCApplicationWriteModel struct {
 	eventstore.WriteModel
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	HashedSecret             string
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []domain.OIDCResponseType
-	GrantTypes               []domain.OIDCGrantType
-	ApplicationType          domain.OIDCApplicationType
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              domain.OIDCVersion
-	Compliance               *domain.Compliance
-	DevMode                  bool
-	AccessTokenType          domain.OIDCTokenType
-	AccessTokenRoleAssertion bool
-	IDTokenRoleAssertion     bool
-	IDTokenUserinfoAssertion bool
-	ClockSkew                time.Duration
-	State                    domain.AppState
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             domain.LoginVersion
-	LoginBaseURI             string
-	oidc                     bool
+	AppID                                 string
+	AppName                               string
+	ClientID                              string
+	HashedSecret                          string
+	ClientSecretString                    string
+	RedirectUris                          []string
+	ResponseTypes                         []domain.OIDCResponseType
+	GrantTypes                            []domain.OIDCGrantType
+	ApplicationType                       domain.OIDCApplicationType
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectUris                []string
+	OIDCVersion                           domain.OIDCVersion
+	Compliance                            *domain.Compliance
+	DevMode                               bool
+	AccessTokenType                       domain.OIDCTokenType
+	AccessTokenRoleAssertion              bool
+	IDTokenRoleAssertion                  bool
+	IDTokenUserinfoAssertion              bool
+	ClockSkew                             time.Duration
+	State                                 domain.AppState
+	AdditionalOrigins                     []string
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	LoginVersion                          domain.LoginVersion
+	LoginBaseURI                          string
+	RequirePushedAuthorizationRequests    bool
+	RequireDPoP                           bool
+	TLSClientAuthSubjectDN                string
+	TLSClientAuthPublicKeyPin             string
+	BackChannelClientNotificationEndpoint string
+	AccessTokenLifetime                   time.Duration
+	IDTokenLifetime                       time.Duration
+	RefreshTokenLifetime                  time.Duration
+	oidc                                  bool
 }
 
 func NewOIDCApplicationWriteModelWithAppID(projectID, appID, resourceOwner string) *OIDCApplicationWriteModel {
@@ -171,6 +182,14 @@ func (wm *OIDCApplicationWriteModel) appendAddOIDCEvent(e *project.OIDCConfigAdd
 	wm.BackChannelLogoutURI = e.BackChannelLogoutURI
 	wm.LoginVersion = e.LoginVersion
 	wm.LoginBaseURI = e.LoginBaseURI
+	wm.RequirePushedAuthorizationRequests = e.RequirePushedAuthorizationRequests
+	wm.RequireDPoP = e.RequireDPoP
+	wm.TLSClientAuthSubjectDN = e.TLSClientAuthSubjectDN
+	wm.TLSClientAuthPublicKeyPin = e.TLSClientAuthPublicKeyPin
+	wm.BackChannelClientNotificationEndpoint = e.BackChannelClientNotificationEndpoint
+	wm.AccessTokenLifetime = e.AccessTokenLifetime
+	wm.IDTokenLifetime = e.IDTokenLifetime
+	wm.RefreshTokenLifetime = e.RefreshTokenLifetime
 }
 
 func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfigChangedEvent) {
@@ -228,6 +247,30 @@ func (wm *OIDCApplicationWriteModel) appendChangeOIDCEvent(e *project.OIDCConfig
 	if e.LoginBaseURI != nil {
 		wm.LoginBaseURI = *e.LoginBaseURI
 	}
+	if e.RequirePushedAuthorizationRequests != nil {
+		wm.RequirePushedAuthorizationRequests = *e.RequirePushedAuthorizationRequests
+	}
+	if e.RequireDPoP != nil {
+		wm.RequireDPoP = *e.RequireDPoP
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		wm.TLSClientAuthSubjectDN = *e.TLSClientAuthSubjectDN
+	}
+	if e.TLSClientAuthPublicKeyPin != nil {
+		wm.TLSClientAuthPublicKeyPin = *e.TLSClientAuthPublicKeyPin
+	}
+	if e.BackChannelClientNotificationEndpoint != nil {
+		wm.BackChannelClientNotificationEndpoint = *e.BackChannelClientNotificationEndpoint
+	}
+	if e.AccessTokenLifetime != nil {
+		wm.AccessTokenLifetime = *e.AccessTokenLifetime
+	}
+	if e.IDTokenLifetime != nil {
+		wm.IDTokenLifetime = *e.IDTokenLifetime
+	}
+	if e.RefreshTokenLifetime != nil {
+		wm.RefreshTokenLifetime = *e.RefreshTokenLifetime
+	}
 }
 
 func (wm *OIDCApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -272,7 +315,18 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	backChannelLogoutURI *string,
 	loginVersion *domain.LoginVersion,
 	loginBaseURI *string,
+	requirePushedAuthorizationRequests *bool,
+	requireDPoP *bool,
+	tlsClientAuthSubjectDN,
+	tlsClientAuthPublicKeyPin *string,
+	backChannelClientNotificationEndpoint *string,
+	accessTokenLifetime,
+	idTokenLifetime,
+	refreshTokenLifetime *time.Duration,
 ) (*project.OIDCConfigChangedEvent, bool, error) {
+	if !wm.tlsClientAuthValid(authMethodType, tlsClientAuthSubjectDN, tlsClientAuthPublicKeyPin) {
+		return nil, false, zerrors.ThrowInvalidArgument(nil, "COMMAND-Fae3u", "Errors.Project.App.TLSClientAuthInvalid")
+	}
 	changes := make([]project.OIDCConfigChanges, 0)
 	var err error
 
@@ -330,6 +384,30 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 	if loginBaseURI != nil && wm.LoginBaseURI != *loginBaseURI {
 		changes = append(changes, project.ChangeOIDCLoginBaseURI(*loginBaseURI))
 	}
+	if requirePushedAuthorizationRequests != nil && wm.RequirePushedAuthorizationRequests != *requirePushedAuthorizationRequests {
+		changes = append(changes, project.ChangeRequirePushedAuthorizationRequests(*requirePushedAuthorizationRequests))
+	}
+	if requireDPoP != nil && wm.RequireDPoP != *requireDPoP {
+		changes = append(changes, project.ChangeRequireDPoP(*requireDPoP))
+	}
+	if tlsClientAuthSubjectDN != nil && wm.TLSClientAuthSubjectDN != *tlsClientAuthSubjectDN {
+		changes = append(changes, project.ChangeTLSClientAuthSubjectDN(*tlsClientAuthSubjectDN))
+	}
+	if tlsClientAuthPublicKeyPin != nil && wm.TLSClientAuthPublicKeyPin != *tlsClientAuthPublicKeyPin {
+		changes = append(changes, project.ChangeTLSClientAuthPublicKeyPin(*tlsClientAuthPublicKeyPin))
+	}
+	if backChannelClientNotificationEndpoint != nil && wm.BackChannelClientNotificationEndpoint != *backChannelClientNotificationEndpoint {
+		changes = append(changes, project.ChangeBackChannelClientNotificationEndpoint(*backChannelClientNotificationEndpoint))
+	}
+	if accessTokenLifetime != nil && wm.AccessTokenLifetime != *accessTokenLifetime {
+		changes = append(changes, project.ChangeAccessTokenLifetime(*accessTokenLifetime))
+	}
+	if idTokenLifetime != nil && wm.IDTokenLifetime != *idTokenLifetime {
+		changes = append(changes, project.ChangeIDTokenLifetime(*idTokenLifetime))
+	}
+	if refreshTokenLifetime != nil && wm.RefreshTokenLifetime != *refreshTokenLifetime {
+		changes = append(changes, project.ChangeRefreshTokenLifetime(*refreshTokenLifetime))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -344,3 +422,22 @@ func (wm *OIDCApplicationWriteModel) NewChangedEvent(
 func (wm *OIDCApplicationWriteModel) IsOIDC() bool {
 	return wm.oidc
 }
+
+// tlsClientAuthValid checks the mutual TLS client authentication of the app resulting from the change.
+func (wm *OIDCApplicationWriteModel) tlsClientAuthValid(authMethodType *domain.OIDCAuthMethodType, subjectDN, publicKeyPin *string) bool {
+	app := &domain.OIDCApp{
+		AuthMethodType:            gu.Ptr(wm.AuthMethodType),
+		TLSClientAuthSubjectDN:    gu.Ptr(wm.TLSClientAuthSubjectDN),
+		TLSClientAuthPublicKeyPin: gu.Ptr(wm.TLSClientAuthPublicKeyPin),
+	}
+	if authMethodType != nil {
+		app.AuthMethodType = authMethodType
+	}
+	if subjectDN != nil {
+		app.TLSClientAuthSubjectDN = subjectDN
+	}
+	if publicKeyPin != nil {
+		app.TLSClientAuthPublicKeyPin = publicKeyPin
+	}
+	return app.TLSClientAuthValid()
+}
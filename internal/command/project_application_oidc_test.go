@@ -174,6 +174,14 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						"",
+						"",
+						0,
+						0,
+						0,
 					),
 				},
 			},
@@ -242,6 +250,14 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						"",
+						"",
+						0,
+						0,
+						0,
 					),
 				},
 			},
@@ -310,6 +326,14 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						"",
+						"",
+						0,
+						0,
+						0,
 					),
 				},
 			},
@@ -378,6 +402,14 @@ func TestAddOIDCApp(t *testing.T) {
 						"",
 						domain.LoginVersionUnspecified,
 						"",
+						false,
+						false,
+						"",
+						"",
+						"",
+						0,
+						0,
+						0,
 					),
 				},
 			},
@@ -530,6 +562,14 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"https://test.ch/backchannel",
 							domain.LoginVersion2,
 							"https://login.test.ch",
+							false,
+							false,
+							"",
+							"",
+							"",
+							0,
+							0,
+							0,
 						),
 					),
 				),
@@ -569,30 +609,38 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:                    "app1",
-					AppName:                  "app",
-					ClientID:                 "client1",
-					ClientSecretString:       "secret",
-					AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypePost),
-					OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  gu.Ptr(true),
-					AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-					AccessTokenRoleAssertion: gu.Ptr(true),
-					IDTokenRoleAssertion:     gu.Ptr(true),
-					IDTokenUserinfoAssertion: gu.Ptr(true),
-					ClockSkew:                gu.Ptr(time.Second * 1),
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					SkipNativeAppSuccessPage: gu.Ptr(true),
-					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
-					LoginVersion:             gu.Ptr(domain.LoginVersion2),
-					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
-					State:                    domain.AppStateActive,
-					Compliance:               &domain.Compliance{},
+					AppID:                                 "app1",
+					AppName:                               "app",
+					ClientID:                              "client1",
+					ClientSecretString:                    "secret",
+					AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypePost),
+					OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+					RedirectUris:                          []string{"https://test.ch"},
+					ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+					PostLogoutRedirectUris:                []string{"https://test.ch/logout"},
+					DevMode:                               gu.Ptr(true),
+					AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+					AccessTokenRoleAssertion:              gu.Ptr(true),
+					IDTokenRoleAssertion:                  gu.Ptr(true),
+					IDTokenUserinfoAssertion:              gu.Ptr(true),
+					ClockSkew:                             gu.Ptr(time.Second * 1),
+					AdditionalOrigins:                     []string{"https://sub.test.ch"},
+					SkipNativeAppSuccessPage:              gu.Ptr(true),
+					BackChannelLogoutURI:                  gu.Ptr("https://test.ch/backchannel"),
+					LoginVersion:                          gu.Ptr(domain.LoginVersion2),
+					LoginBaseURI:                          gu.Ptr("https://login.test.ch"),
+					RequirePushedAuthorizationRequests:    gu.Ptr(false),
+					RequireDPoP:                           gu.Ptr(false),
+					TLSClientAuthSubjectDN:                gu.Ptr(""),
+					TLSClientAuthPublicKeyPin:             gu.Ptr(""),
+					BackChannelClientNotificationEndpoint: gu.Ptr(""),
+					AccessTokenLifetime:                   gu.Ptr(time.Duration(0)),
+					IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+					RefreshTokenLifetime:                  gu.Ptr(time.Duration(0)),
+					State:                                 domain.AppStateActive,
+					Compliance:                            &domain.Compliance{},
 				},
 			},
 		},
@@ -638,6 +686,14 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 							"https://test.ch/backchannel",
 							domain.LoginVersion2,
 							"https://login.test.ch",
+							true,
+							true,
+							"",
+							"",
+							"",
+							0,
+							0,
+							0,
 						),
 					),
 				),
@@ -649,25 +705,27 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 					ObjectRoot: models.ObjectRoot{
 						AggregateID: "project1",
 					},
-					AppName:                  "app",
-					AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypePost),
-					OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  gu.Ptr(true),
-					AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-					AccessTokenRoleAssertion: gu.Ptr(true),
-					IDTokenRoleAssertion:     gu.Ptr(true),
-					IDTokenUserinfoAssertion: gu.Ptr(true),
-					ClockSkew:                gu.Ptr(time.Second * 1),
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					SkipNativeAppSuccessPage: gu.Ptr(true),
-					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
-					LoginVersion:             gu.Ptr(domain.LoginVersion2),
-					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
+					AppName:                            "app",
+					AuthMethodType:                     gu.Ptr(domain.OIDCAuthMethodTypePost),
+					OIDCVersion:                        gu.Ptr(domain.OIDCVersionV1),
+					RedirectUris:                       []string{"https://test.ch"},
+					ResponseTypes:                      []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                         []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:                    gu.Ptr(domain.OIDCApplicationTypeWeb),
+					PostLogoutRedirectUris:             []string{"https://test.ch/logout"},
+					DevMode:                            gu.Ptr(true),
+					AccessTokenType:                    gu.Ptr(domain.OIDCTokenTypeBearer),
+					AccessTokenRoleAssertion:           gu.Ptr(true),
+					IDTokenRoleAssertion:               gu.Ptr(true),
+					IDTokenUserinfoAssertion:           gu.Ptr(true),
+					ClockSkew:                          gu.Ptr(time.Second * 1),
+					AdditionalOrigins:                  []string{"https://sub.test.ch"},
+					SkipNativeAppSuccessPage:           gu.Ptr(true),
+					BackChannelLogoutURI:               gu.Ptr("https://test.ch/backchannel"),
+					LoginVersion:                       gu.Ptr(domain.LoginVersion2),
+					LoginBaseURI:                       gu.Ptr("https://login.test.ch"),
+					RequirePushedAuthorizationRequests: gu.Ptr(true),
+					RequireDPoP:                        gu.Ptr(true),
 				},
 				resourceOwner: "org1",
 			},
@@ -677,30 +735,38 @@ func TestCommandSide_AddOIDCApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:                    "app1",
-					AppName:                  "app",
-					ClientID:                 "client1",
-					ClientSecretString:       "secret",
-					AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypePost),
-					OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  gu.Ptr(true),
-					AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-					AccessTokenRoleAssertion: gu.Ptr(true),
-					IDTokenRoleAssertion:     gu.Ptr(true),
-					IDTokenUserinfoAssertion: gu.Ptr(true),
-					ClockSkew:                gu.Ptr(time.Second * 1),
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					SkipNativeAppSuccessPage: gu.Ptr(true),
-					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
-					LoginVersion:             gu.Ptr(domain.LoginVersion2),
-					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
-					State:                    domain.AppStateActive,
-					Compliance:               &domain.Compliance{},
+					AppID:                                 "app1",
+					AppName:                               "app",
+					ClientID:                              "client1",
+					ClientSecretString:                    "secret",
+					AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypePost),
+					OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+					RedirectUris:                          []string{"https://test.ch"},
+					ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+					PostLogoutRedirectUris:                []string{"https://test.ch/logout"},
+					DevMode:                               gu.Ptr(true),
+					AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+					AccessTokenRoleAssertion:              gu.Ptr(true),
+					IDTokenRoleAssertion:                  gu.Ptr(true),
+					IDTokenUserinfoAssertion:              gu.Ptr(true),
+					ClockSkew:                             gu.Ptr(time.Second * 1),
+					AdditionalOrigins:                     []string{"https://sub.test.ch"},
+					SkipNativeAppSuccessPage:              gu.Ptr(true),
+					BackChannelLogoutURI:                  gu.Ptr("https://test.ch/backchannel"),
+					LoginVersion:                          gu.Ptr(domain.LoginVersion2),
+					LoginBaseURI:                          gu.Ptr("https://login.test.ch"),
+					RequirePushedAuthorizationRequests:    gu.Ptr(true),
+					RequireDPoP:                           gu.Ptr(true),
+					TLSClientAuthSubjectDN:                gu.Ptr(""),
+					TLSClientAuthPublicKeyPin:             gu.Ptr(""),
+					BackChannelClientNotificationEndpoint: gu.Ptr(""),
+					AccessTokenLifetime:                   gu.Ptr(time.Duration(0)),
+					IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+					RefreshTokenLifetime:                  gu.Ptr(time.Duration(0)),
+					State:                                 domain.AppStateActive,
+					Compliance:                            &domain.Compliance{},
 				},
 			},
 		},
@@ -875,6 +941,14 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion2,
 								"https://login.test.ch",
+								false,
+								false,
+								"",
+								"",
+								"",
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -914,6 +988,89 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 				err: zerrors.IsPreconditionFailed,
 			},
 		},
+		{
+			name: "self signed tls client auth without public key pin, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewOIDCConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								domain.OIDCVersionV1,
+								"app1",
+								"client1@project",
+								"secret",
+								[]string{"https://test.ch"},
+								[]domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+								[]domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+								domain.OIDCApplicationTypeWeb,
+								domain.OIDCAuthMethodTypePost,
+								[]string{"https://test.ch/logout"},
+								true,
+								domain.OIDCTokenTypeBearer,
+								true,
+								true,
+								true,
+								time.Second*1,
+								[]string{"https://sub.test.ch"},
+								true,
+								"https://test.ch/backchannel",
+								domain.LoginVersion2,
+								"https://login.test.ch",
+								false,
+								false,
+								"",
+								"",
+								"",
+								0,
+								0,
+								0,
+							),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				oidcApp: &domain.OIDCApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppID:                    "app1",
+					AppName:                  "app",
+					AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth),
+					OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
+					RedirectUris:             []string{"https://test.ch"},
+					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
+					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
+					DevMode:                  gu.Ptr(true),
+					AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
+					AccessTokenRoleAssertion: gu.Ptr(true),
+					IDTokenRoleAssertion:     gu.Ptr(true),
+					IDTokenUserinfoAssertion: gu.Ptr(true),
+					ClockSkew:                gu.Ptr(time.Second * 1),
+					AdditionalOrigins:        []string{"https://sub.test.ch"},
+					SkipNativeAppSuccessPage: gu.Ptr(true),
+					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
+					LoginVersion:             gu.Ptr(domain.LoginVersion2),
+					LoginBaseURI:             gu.Ptr("https://login.test.ch"),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
 		{
 			name: "no changes whitespaces are ignored, precondition error",
 			fields: fields{
@@ -950,6 +1107,14 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion2,
 								"https://login.test.ch",
+								false,
+								false,
+								"",
+								"",
+								"",
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -1025,6 +1190,14 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 								"https://test.ch/backchannel",
 								domain.LoginVersion1,
 								"",
+								false,
+								false,
+								"",
+								"",
+								"",
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -1057,29 +1230,37 @@ func TestCommandSide_ChangeOIDCApplication(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:                    "app1",
-					ClientID:                 "client1@project",
-					AppName:                  "app",
-					AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypeBasic),
-					OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  gu.Ptr(false),
-					AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-					AccessTokenRoleAssertion: gu.Ptr(true),
-					IDTokenRoleAssertion:     gu.Ptr(true),
-					IDTokenUserinfoAssertion: gu.Ptr(true),
-					ClockSkew:                gu.Ptr(time.Second * 1),
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					SkipNativeAppSuccessPage: gu.Ptr(true),
-					BackChannelLogoutURI:     gu.Ptr("https://test.ch/backchannel"),
-					LoginVersion:             gu.Ptr(domain.LoginVersion1),
-					LoginBaseURI:             gu.Ptr(""),
-					Compliance:               &domain.Compliance{},
-					State:                    domain.AppStateActive,
+					AppID:                                 "app1",
+					ClientID:                              "client1@project",
+					AppName:                               "app",
+					AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypeBasic),
+					OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+					RedirectUris:                          []string{"https://test.ch"},
+					ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+					PostLogoutRedirectUris:                []string{"https://test.ch/logout"},
+					DevMode:                               gu.Ptr(false),
+					AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+					AccessTokenRoleAssertion:              gu.Ptr(true),
+					IDTokenRoleAssertion:                  gu.Ptr(true),
+					IDTokenUserinfoAssertion:              gu.Ptr(true),
+					ClockSkew:                             gu.Ptr(time.Second * 1),
+					AdditionalOrigins:                     []string{"https://sub.test.ch"},
+					SkipNativeAppSuccessPage:              gu.Ptr(true),
+					BackChannelLogoutURI:                  gu.Ptr("https://test.ch/backchannel"),
+					LoginVersion:                          gu.Ptr(domain.LoginVersion1),
+					LoginBaseURI:                          gu.Ptr(""),
+					RequirePushedAuthorizationRequests:    gu.Ptr(false),
+					RequireDPoP:                           gu.Ptr(false),
+					TLSClientAuthSubjectDN:                gu.Ptr(""),
+					TLSClientAuthPublicKeyPin:             gu.Ptr(""),
+					BackChannelClientNotificationEndpoint: gu.Ptr(""),
+					AccessTokenLifetime:                   gu.Ptr(time.Duration(0)),
+					IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+					RefreshTokenLifetime:                  gu.Ptr(time.Duration(0)),
+					Compliance:                            &domain.Compliance{},
+					State:                                 domain.AppStateActive,
 				},
 			},
 		},
@@ -1209,6 +1390,14 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								false,
+								false,
+								"",
+								"",
+								"",
+								0,
+								0,
+								0,
 							),
 						),
 					),
@@ -1233,29 +1422,37 @@ func TestCommandSide_ChangeOIDCApplicationSecret(t *testing.T) {
 						AggregateID:   "project1",
 						ResourceOwner: "org1",
 					},
-					AppID:                    "app1",
-					AppName:                  "app",
-					ClientID:                 "client1@project",
-					ClientSecretString:       "secret",
-					AuthMethodType:           gu.Ptr(domain.OIDCAuthMethodTypePost),
-					OIDCVersion:              gu.Ptr(domain.OIDCVersionV1),
-					RedirectUris:             []string{"https://test.ch"},
-					ResponseTypes:            []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
-					GrantTypes:               []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
-					ApplicationType:          gu.Ptr(domain.OIDCApplicationTypeWeb),
-					PostLogoutRedirectUris:   []string{"https://test.ch/logout"},
-					DevMode:                  gu.Ptr(true),
-					AccessTokenType:          gu.Ptr(domain.OIDCTokenTypeBearer),
-					AccessTokenRoleAssertion: gu.Ptr(true),
-					IDTokenRoleAssertion:     gu.Ptr(true),
-					IDTokenUserinfoAssertion: gu.Ptr(true),
-					ClockSkew:                gu.Ptr(time.Second * 1),
-					AdditionalOrigins:        []string{"https://sub.test.ch"},
-					SkipNativeAppSuccessPage: gu.Ptr(false),
-					BackChannelLogoutURI:     gu.Ptr(""),
-					LoginVersion:             gu.Ptr(domain.LoginVersionUnspecified),
-					LoginBaseURI:             gu.Ptr(""),
-					State:                    domain.AppStateActive,
+					AppID:                                 "app1",
+					AppName:                               "app",
+					ClientID:                              "client1@project",
+					ClientSecretString:                    "secret",
+					AuthMethodType:                        gu.Ptr(domain.OIDCAuthMethodTypePost),
+					OIDCVersion:                           gu.Ptr(domain.OIDCVersionV1),
+					RedirectUris:                          []string{"https://test.ch"},
+					ResponseTypes:                         []domain.OIDCResponseType{domain.OIDCResponseTypeCode},
+					GrantTypes:                            []domain.OIDCGrantType{domain.OIDCGrantTypeAuthorizationCode},
+					ApplicationType:                       gu.Ptr(domain.OIDCApplicationTypeWeb),
+					PostLogoutRedirectUris:                []string{"https://test.ch/logout"},
+					DevMode:                               gu.Ptr(true),
+					AccessTokenType:                       gu.Ptr(domain.OIDCTokenTypeBearer),
+					AccessTokenRoleAssertion:              gu.Ptr(true),
+					IDTokenRoleAssertion:                  gu.Ptr(true),
+					IDTokenUserinfoAssertion:              gu.Ptr(true),
+					ClockSkew:                             gu.Ptr(time.Second * 1),
+					AdditionalOrigins:                     []string{"https://sub.test.ch"},
+					SkipNativeAppSuccessPage:              gu.Ptr(false),
+					BackChannelLogoutURI:                  gu.Ptr(""),
+					LoginVersion:                          gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI:                          gu.Ptr(""),
+					RequirePushedAuthorizationRequests:    gu.Ptr(false),
+					RequireDPoP:                           gu.Ptr(false),
+					TLSClientAuthSubjectDN:                gu.Ptr(""),
+					TLSClientAuthPublicKeyPin:             gu.Ptr(""),
+					BackChannelClientNotificationEndpoint: gu.Ptr(""),
+					AccessTokenLifetime:                   gu.Ptr(time.Duration(0)),
+					IDTokenLifetime:                       gu.Ptr(time.Duration(0)),
+					RefreshTokenLifetime:                  gu.Ptr(time.Duration(0)),
+					State:                                 domain.AppStateActive,
 				},
 			},
 		},
@@ -5,6 +5,7 @@ import (
 
 	"github.com/muhlemmer/gu"
 	"github.com/zitadel/saml/pkg/provider/xml"
+	"github.com/zitadel/saml/pkg/provider/xml/md"
 
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
@@ -74,6 +75,9 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "SAML-bquso", "Errors.Project.App.SAMLMetadataFormat")
 	}
+	if gu.Value(samlApp.AssertionEncryption) != domain.SAMLAssertionEncryptionNone && !hasSAMLEncryptionCertificate(entity) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SAML-Eef2a", "Errors.Project.App.SAMLEncryptionCertificateMissing")
+	}
 
 	samlApp.AppID, err = c.idGenerator.Next()
 	if err != nil {
@@ -90,6 +94,11 @@ func (c *Commands) addSAMLApplication(ctx context.Context, projectAgg *eventstor
 			gu.Value(samlApp.MetadataURL),
 			gu.Value(samlApp.LoginVersion),
 			gu.Value(samlApp.LoginBaseURI),
+			gu.Value(samlApp.SignatureAlgorithm),
+			gu.Value(samlApp.DigestAlgorithm),
+			gu.Value(samlApp.AssertionEncryption),
+			samlApp.NameIDFormat,
+			samlApp.AttributeMapping,
 		),
 	}, nil
 }
@@ -128,6 +137,13 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 	if err != nil {
 		return nil, zerrors.ThrowInvalidArgument(err, "SAML-3fk2b", "Errors.Project.App.SAMLMetadataFormat")
 	}
+	assertionEncryption := existingSAML.AssertionEncryption
+	if samlApp.AssertionEncryption != nil {
+		assertionEncryption = *samlApp.AssertionEncryption
+	}
+	if assertionEncryption != domain.SAMLAssertionEncryptionNone && !hasSAMLEncryptionCertificate(entity) {
+		return nil, zerrors.ThrowInvalidArgument(nil, "SAML-aeT3o", "Errors.Project.App.SAMLEncryptionCertificateMissing")
+	}
 
 	changedEvent, hasChanged, err := existingSAML.NewChangedEvent(
 		ctx,
@@ -138,6 +154,11 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 		samlApp.MetadataURL,
 		samlApp.LoginVersion,
 		samlApp.LoginBaseURI,
+		samlApp.SignatureAlgorithm,
+		samlApp.DigestAlgorithm,
+		samlApp.AssertionEncryption,
+		samlApp.NameIDFormat,
+		samlApp.AttributeMapping,
 	)
 	if err != nil {
 		return nil, err
@@ -158,6 +179,25 @@ func (c *Commands) UpdateSAMLApplication(ctx context.Context, samlApp *domain.SA
 	return samlWriteModelToSAMLConfig(existingSAML), nil
 }
 
+// hasSAMLEncryptionCertificate checks if the metadata of the service provider contains a certificate,
+// which can be used to encrypt the assertions.
+func hasSAMLEncryptionCertificate(entity *md.EntityDescriptorType) bool {
+	if entity.SPSSODescriptor == nil {
+		return false
+	}
+	for _, keyDescriptor := range entity.SPSSODescriptor.KeyDescriptor {
+		if keyDescriptor.Use != "" && keyDescriptor.Use != md.KeyTypesEncryption {
+			continue
+		}
+		for _, data := range keyDescriptor.KeyInfo.X509Data {
+			if data.X509Certificate != "" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *Commands) getSAMLAppWriteModel(ctx context.Context, projectID, appID, resourceOwner string) (*SAMLApplicationWriteModel, error) {
 	appWriteModel := NewSAMLApplicationWriteModelWithAppID(projectID, appID, resourceOwner)
 	err := c.eventstore.FilterToQueryReducer(ctx, appWriteModel)
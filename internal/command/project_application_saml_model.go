@@ -20,6 +20,13 @@ type SAMLApplicationWriteModel struct {
 	LoginVersion domain.LoginVersion
 	LoginBaseURI string
 
+	SignatureAlgorithm  domain.SAMLSignatureAlgorithm
+	DigestAlgorithm     domain.SAMLDigestAlgorithm
+	AssertionEncryption domain.SAMLAssertionEncryption
+
+	NameIDFormat     *domain.SAMLNameIDFormat
+	AttributeMapping []*domain.SAMLAttributeMapping
+
 	State domain.AppState
 	saml  bool
 }
@@ -125,6 +132,11 @@ func (wm *SAMLApplicationWriteModel) appendAddSAMLEvent(e *project.SAMLConfigAdd
 	wm.EntityID = e.EntityID
 	wm.LoginVersion = e.LoginVersion
 	wm.LoginBaseURI = e.LoginBaseURI
+	wm.SignatureAlgorithm = e.SignatureAlgorithm
+	wm.DigestAlgorithm = e.DigestAlgorithm
+	wm.AssertionEncryption = e.AssertionEncryption
+	wm.NameIDFormat = e.NameIDFormat
+	wm.AttributeMapping = e.AttributeMapping
 }
 
 func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfigChangedEvent) {
@@ -144,6 +156,21 @@ func (wm *SAMLApplicationWriteModel) appendChangeSAMLEvent(e *project.SAMLConfig
 	if e.LoginBaseURI != nil {
 		wm.LoginBaseURI = *e.LoginBaseURI
 	}
+	if e.SignatureAlgorithm != nil {
+		wm.SignatureAlgorithm = *e.SignatureAlgorithm
+	}
+	if e.DigestAlgorithm != nil {
+		wm.DigestAlgorithm = *e.DigestAlgorithm
+	}
+	if e.AssertionEncryption != nil {
+		wm.AssertionEncryption = *e.AssertionEncryption
+	}
+	if e.NameIDFormat != nil {
+		wm.NameIDFormat = e.NameIDFormat
+	}
+	if e.AttributeMapping != nil {
+		wm.AttributeMapping = *e.AttributeMapping
+	}
 }
 
 func (wm *SAMLApplicationWriteModel) Query() *eventstore.SearchQueryBuilder {
@@ -173,6 +200,11 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	metadataURL *string,
 	loginVersion *domain.LoginVersion,
 	loginBaseURI *string,
+	signatureAlgorithm *domain.SAMLSignatureAlgorithm,
+	digestAlgorithm *domain.SAMLDigestAlgorithm,
+	assertionEncryption *domain.SAMLAssertionEncryption,
+	nameIDFormat *domain.SAMLNameIDFormat,
+	attributeMapping []*domain.SAMLAttributeMapping,
 ) (*project.SAMLConfigChangedEvent, bool, error) {
 	changes := make([]project.SAMLConfigChanges, 0)
 	var err error
@@ -191,6 +223,21 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	if loginBaseURI != nil && wm.LoginBaseURI != *loginBaseURI {
 		changes = append(changes, project.ChangeSAMLLoginBaseURI(*loginBaseURI))
 	}
+	if signatureAlgorithm != nil && wm.SignatureAlgorithm != *signatureAlgorithm {
+		changes = append(changes, project.ChangeSAMLSignatureAlgorithm(*signatureAlgorithm))
+	}
+	if digestAlgorithm != nil && wm.DigestAlgorithm != *digestAlgorithm {
+		changes = append(changes, project.ChangeSAMLDigestAlgorithm(*digestAlgorithm))
+	}
+	if assertionEncryption != nil && wm.AssertionEncryption != *assertionEncryption {
+		changes = append(changes, project.ChangeSAMLAssertionEncryption(*assertionEncryption))
+	}
+	if nameIDFormat != nil && (wm.NameIDFormat == nil || *wm.NameIDFormat != *nameIDFormat) {
+		changes = append(changes, project.ChangeSAMLNameIDFormat(*nameIDFormat))
+	}
+	if attributeMapping != nil && !slices.EqualFunc(wm.AttributeMapping, attributeMapping, equalSAMLAttributeMapping) {
+		changes = append(changes, project.ChangeSAMLAttributeMapping(attributeMapping))
+	}
 
 	if len(changes) == 0 {
 		return nil, false, nil
@@ -202,6 +249,10 @@ func (wm *SAMLApplicationWriteModel) NewChangedEvent(
 	return changeEvent, true, nil
 }
 
+func equalSAMLAttributeMapping(a, b *domain.SAMLAttributeMapping) bool {
+	return *a == *b
+}
+
 func (wm *SAMLApplicationWriteModel) IsSAML() bool {
 	return wm.saml
 }
@@ -49,6 +49,32 @@ var testMetadataChangedEntityID = []byte(`<?xml version="1.0"?>
 </md:EntityDescriptor>
 `)
 
+var testMetadataEncryption = []byte(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata"
+                     xmlns:ds="http://www.w3.org/2000/09/xmldsig#"
+                     entityID="https://test.com/saml/metadata">
+    <md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+        <md:KeyDescriptor use="encryption">
+            <ds:KeyInfo>
+                <ds:X509Data>
+                    <ds:X509Certificate>MIIBcertificate</ds:X509Certificate>
+                </ds:X509Data>
+            </ds:KeyInfo>
+        </md:KeyDescriptor>
+        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>
+        <md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+                                     Location="https://test.com/saml/acs"
+                                     index="1" />
+    </md:SPSSODescriptor>
+</md:EntityDescriptor>
+`)
+
+var testSAMLAttributeMapping = []*domain.SAMLAttributeMapping{
+	{Name: "mail", NameFormat: "urn:oasis:names:tc:SAML:2.0:attrname-format:basic", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+	{Name: "department", Source: domain.SAMLAttributeSourceMetadata, Key: "department"},
+	{Name: "roles", Source: domain.SAMLAttributeSourceRoles},
+}
+
 func TestCommandSide_AddSAMLApplication(t *testing.T) {
 	t.Parallel()
 
@@ -229,6 +255,11 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							domain.SAMLSignatureAlgorithmUnspecified,
+							domain.SAMLDigestAlgorithmUnspecified,
+							domain.SAMLAssertionEncryptionNone,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -261,6 +292,10 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 					State:        domain.AppStateActive,
 					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
 				},
 			},
 		},
@@ -291,6 +326,11 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"",
 							domain.LoginVersion2,
 							"https://test.com/login",
+							domain.SAMLSignatureAlgorithmUnspecified,
+							domain.SAMLDigestAlgorithmUnspecified,
+							domain.SAMLAssertionEncryptionNone,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -325,6 +365,228 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 					State:        domain.AppStateActive,
 					LoginVersion: gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI: gu.Ptr("https://test.com/login"),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
+				},
+			},
+		},
+		{
+			name: "create saml app, encryption without certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:             "app",
+					EntityID:            "https://test.com/saml/metadata",
+					Metadata:            testMetadata,
+					MetadataURL:         gu.Ptr(""),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES256GCM),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "create saml app, signing and encryption, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						),
+						project.NewSAMLConfigAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"https://test.com/saml/metadata",
+							testMetadataEncryption,
+							"",
+							domain.LoginVersionUnspecified,
+							"",
+							domain.SAMLSignatureAlgorithmRSASHA512,
+							domain.SAMLDigestAlgorithmSHA512,
+							domain.SAMLAssertionEncryptionAES256GCM,
+							nil,
+							nil,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1"),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:             "app",
+					EntityID:            "https://test.com/saml/metadata",
+					Metadata:            testMetadataEncryption,
+					MetadataURL:         gu.Ptr(""),
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA512),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmSHA512),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES256GCM),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:        "app1",
+					AppName:      "app",
+					EntityID:     "https://test.com/saml/metadata",
+					Metadata:     testMetadataEncryption,
+					MetadataURL:  gu.Ptr(""),
+					State:        domain.AppStateActive,
+					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA512),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmSHA512),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES256GCM),
+				},
+			},
+		},
+		{
+			name: "create saml app, invalid attribute mapping, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectFilter(),
+				),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:     "app",
+					EntityID:    "https://test.com/saml/metadata",
+					Metadata:    testMetadata,
+					MetadataURL: gu.Ptr(""),
+					AttributeMapping: []*domain.SAMLAttributeMapping{
+						{Name: "email", Source: domain.SAMLAttributeSourceUserField, Key: "unknown"},
+					},
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "create saml app, name id format and attribute mapping, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewProjectAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"project", true, true, true,
+								domain.PrivateLabelingSettingUnspecified),
+						),
+					),
+					expectFilter(),
+					expectPush(
+						project.NewApplicationAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"app",
+						),
+						project.NewSAMLConfigAddedEvent(context.Background(),
+							&project.NewAggregate("project1", "org1").Aggregate,
+							"app1",
+							"https://test.com/saml/metadata",
+							testMetadata,
+							"",
+							domain.LoginVersionUnspecified,
+							"",
+							domain.SAMLSignatureAlgorithmUnspecified,
+							domain.SAMLDigestAlgorithmUnspecified,
+							domain.SAMLAssertionEncryptionNone,
+							gu.Ptr(domain.SAMLNameIDFormatPersistent),
+							testSAMLAttributeMapping,
+						),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "app1"),
+			},
+			args: args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID: "project1",
+					},
+					AppName:          "app",
+					EntityID:         "https://test.com/saml/metadata",
+					Metadata:         testMetadata,
+					MetadataURL:      gu.Ptr(""),
+					NameIDFormat:     gu.Ptr(domain.SAMLNameIDFormatPersistent),
+					AttributeMapping: testSAMLAttributeMapping,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:        "app1",
+					AppName:      "app",
+					EntityID:     "https://test.com/saml/metadata",
+					Metadata:     testMetadata,
+					MetadataURL:  gu.Ptr(""),
+					State:        domain.AppStateActive,
+					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
+
+					NameIDFormat:     gu.Ptr(domain.SAMLNameIDFormatPersistent),
+					AttributeMapping: testSAMLAttributeMapping,
 				},
 			},
 		},
@@ -355,6 +617,11 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 							"http://localhost:8080/saml/metadata",
 							domain.LoginVersionUnspecified,
 							"",
+							domain.SAMLSignatureAlgorithmUnspecified,
+							domain.SAMLDigestAlgorithmUnspecified,
+							domain.SAMLAssertionEncryptionNone,
+							nil,
+							nil,
 						),
 					),
 				),
@@ -388,6 +655,10 @@ func TestCommandSide_AddSAMLApplication(t *testing.T) {
 					State:        domain.AppStateActive,
 					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
 				},
 			},
 		},
@@ -576,6 +847,11 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -622,6 +898,11 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -668,6 +949,11 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -713,6 +999,10 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 					State:        domain.AppStateActive,
 					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
 				},
 			},
 		},
@@ -737,6 +1027,11 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -782,6 +1077,10 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 					State:        domain.AppStateActive,
 					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
 					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
 				},
 			},
 		},
@@ -806,6 +1105,11 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -855,6 +1159,225 @@ func TestCommandSide_ChangeSAMLApplication(t *testing.T) {
 					State:        domain.AppStateActive,
 					LoginVersion: gu.Ptr(domain.LoginVersion2),
 					LoginBaseURI: gu.Ptr("https://test.com/login"),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
+				},
+			},
+		},
+		{
+			name: "change saml app, encryption without certificate, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadataEncryption,
+								"",
+								domain.LoginVersionUnspecified,
+								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionAES128GCM,
+								nil,
+								nil,
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:       "app1",
+					AppName:     "app",
+					EntityID:    "https://test.com/saml/metadata",
+					Metadata:    testMetadata,
+					MetadataURL: gu.Ptr(""),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "change saml app, ok, signing and encryption",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadataEncryption,
+								"",
+								domain.LoginVersionUnspecified,
+								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
+							),
+						),
+					),
+					expectPush(
+						newSAMLAppChangedEventSigningAndEncryption(context.Background(),
+							"app1",
+							"project1",
+							"org1",
+							"https://test.com/saml/metadata",
+							domain.SAMLSignatureAlgorithmRSASHA256,
+							domain.SAMLDigestAlgorithmSHA512,
+							domain.SAMLAssertionEncryptionAES128GCM,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:               "app1",
+					AppName:             "app",
+					EntityID:            "https://test.com/saml/metadata",
+					Metadata:            testMetadataEncryption,
+					MetadataURL:         gu.Ptr(""),
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA256),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmSHA512),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES128GCM),
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:        "app1",
+					AppName:      "app",
+					EntityID:     "https://test.com/saml/metadata",
+					Metadata:     testMetadataEncryption,
+					MetadataURL:  gu.Ptr(""),
+					State:        domain.AppStateActive,
+					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmRSASHA256),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmSHA512),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionAES128GCM),
+				},
+			},
+		},
+		{
+			name: "change saml app, ok, name id format and attribute mapping",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							project.NewApplicationAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"app",
+							),
+						),
+						eventFromEventPusher(
+							project.NewSAMLConfigAddedEvent(context.Background(),
+								&project.NewAggregate("project1", "org1").Aggregate,
+								"app1",
+								"https://test.com/saml/metadata",
+								testMetadata,
+								"",
+								domain.LoginVersionUnspecified,
+								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								gu.Ptr(domain.SAMLNameIDFormatEmailAddress),
+								[]*domain.SAMLAttributeMapping{
+									{Name: "email", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+								},
+							),
+						),
+					),
+					expectPush(
+						newSAMLAppChangedEventNameIDAndAttributes(context.Background(),
+							"app1",
+							"project1",
+							"org1",
+							"https://test.com/saml/metadata",
+							domain.SAMLNameIDFormatTransient,
+							testSAMLAttributeMapping,
+						),
+					),
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				samlApp: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:            "app1",
+					AppName:          "app",
+					EntityID:         "https://test.com/saml/metadata",
+					Metadata:         testMetadata,
+					MetadataURL:      gu.Ptr(""),
+					NameIDFormat:     gu.Ptr(domain.SAMLNameIDFormatTransient),
+					AttributeMapping: testSAMLAttributeMapping,
+				},
+				resourceOwner: "org1",
+			},
+			res: res{
+				want: &domain.SAMLApp{
+					ObjectRoot: models.ObjectRoot{
+						AggregateID:   "project1",
+						ResourceOwner: "org1",
+					},
+					AppID:        "app1",
+					AppName:      "app",
+					EntityID:     "https://test.com/saml/metadata",
+					Metadata:     testMetadata,
+					MetadataURL:  gu.Ptr(""),
+					State:        domain.AppStateActive,
+					LoginVersion: gu.Ptr(domain.LoginVersionUnspecified),
+					LoginBaseURI: gu.Ptr(""),
+
+					SignatureAlgorithm:  gu.Ptr(domain.SAMLSignatureAlgorithmUnspecified),
+					DigestAlgorithm:     gu.Ptr(domain.SAMLDigestAlgorithmUnspecified),
+					AssertionEncryption: gu.Ptr(domain.SAMLAssertionEncryptionNone),
+
+					NameIDFormat:     gu.Ptr(domain.SAMLNameIDFormatTransient),
+					AttributeMapping: testSAMLAttributeMapping,
 				},
 			},
 		},
@@ -927,6 +1450,35 @@ func newSAMLAppChangedEventLoginVersion(ctx context.Context, appID, projectID, r
 	return event
 }
 
+func newSAMLAppChangedEventSigningAndEncryption(ctx context.Context, appID, projectID, resourceOwner, entityID string, signatureAlgorithm domain.SAMLSignatureAlgorithm, digestAlgorithm domain.SAMLDigestAlgorithm, assertionEncryption domain.SAMLAssertionEncryption) *project.SAMLConfigChangedEvent {
+	changes := []project.SAMLConfigChanges{
+		project.ChangeSAMLSignatureAlgorithm(signatureAlgorithm),
+		project.ChangeSAMLDigestAlgorithm(digestAlgorithm),
+		project.ChangeSAMLAssertionEncryption(assertionEncryption),
+	}
+	event, _ := project.NewSAMLConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		entityID,
+		changes,
+	)
+	return event
+}
+
+func newSAMLAppChangedEventNameIDAndAttributes(ctx context.Context, appID, projectID, resourceOwner, entityID string, nameIDFormat domain.SAMLNameIDFormat, attributeMapping []*domain.SAMLAttributeMapping) *project.SAMLConfigChangedEvent {
+	changes := []project.SAMLConfigChanges{
+		project.ChangeSAMLNameIDFormat(nameIDFormat),
+		project.ChangeSAMLAttributeMapping(attributeMapping),
+	}
+	event, _ := project.NewSAMLConfigChangedEvent(ctx,
+		&project.NewAggregate(projectID, resourceOwner).Aggregate,
+		appID,
+		entityID,
+		changes,
+	)
+	return event
+}
+
 type roundTripperFunc func(*http.Request) *http.Response
 
 // RoundTrip implements the http.RoundTripper interface.
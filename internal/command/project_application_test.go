@@ -596,6 +596,11 @@ func TestCommandSide_RemoveApplication(t *testing.T) {
 							"",
 							domain.LoginVersionUnspecified,
 							"",
+							domain.SAMLSignatureAlgorithmUnspecified,
+							domain.SAMLDigestAlgorithmUnspecified,
+							domain.SAMLAssertionEncryptionNone,
+							nil,
+							nil,
 						)),
 					),
 					expectPush(
@@ -29,29 +29,37 @@ func projectGrantWriteModelToProjectGrant(writeModel *ProjectGrantWriteModel) *d
 
 func oidcWriteModelToOIDCConfig(writeModel *OIDCApplicationWriteModel) *domain.OIDCApp {
 	return &domain.OIDCApp{
-		ObjectRoot:               writeModelToObjectRoot(writeModel.WriteModel),
-		AppID:                    writeModel.AppID,
-		AppName:                  writeModel.AppName,
-		State:                    writeModel.State,
-		ClientID:                 writeModel.ClientID,
-		RedirectUris:             writeModel.RedirectUris,
-		ResponseTypes:            writeModel.ResponseTypes,
-		GrantTypes:               writeModel.GrantTypes,
-		ApplicationType:          gu.Ptr(writeModel.ApplicationType),
-		AuthMethodType:           gu.Ptr(writeModel.AuthMethodType),
-		PostLogoutRedirectUris:   writeModel.PostLogoutRedirectUris,
-		OIDCVersion:              gu.Ptr(writeModel.OIDCVersion),
-		DevMode:                  gu.Ptr(writeModel.DevMode),
-		AccessTokenType:          gu.Ptr(writeModel.AccessTokenType),
-		AccessTokenRoleAssertion: gu.Ptr(writeModel.AccessTokenRoleAssertion),
-		IDTokenRoleAssertion:     gu.Ptr(writeModel.IDTokenRoleAssertion),
-		IDTokenUserinfoAssertion: gu.Ptr(writeModel.IDTokenUserinfoAssertion),
-		ClockSkew:                gu.Ptr(writeModel.ClockSkew),
-		AdditionalOrigins:        writeModel.AdditionalOrigins,
-		SkipNativeAppSuccessPage: gu.Ptr(writeModel.SkipNativeAppSuccessPage),
-		BackChannelLogoutURI:     gu.Ptr(writeModel.BackChannelLogoutURI),
-		LoginVersion:             gu.Ptr(writeModel.LoginVersion),
-		LoginBaseURI:             gu.Ptr(writeModel.LoginBaseURI),
+		ObjectRoot:                            writeModelToObjectRoot(writeModel.WriteModel),
+		AppID:                                 writeModel.AppID,
+		AppName:                               writeModel.AppName,
+		State:                                 writeModel.State,
+		ClientID:                              writeModel.ClientID,
+		RedirectUris:                          writeModel.RedirectUris,
+		ResponseTypes:                         writeModel.ResponseTypes,
+		GrantTypes:                            writeModel.GrantTypes,
+		ApplicationType:                       gu.Ptr(writeModel.ApplicationType),
+		AuthMethodType:                        gu.Ptr(writeModel.AuthMethodType),
+		PostLogoutRedirectUris:                writeModel.PostLogoutRedirectUris,
+		OIDCVersion:                           gu.Ptr(writeModel.OIDCVersion),
+		DevMode:                               gu.Ptr(writeModel.DevMode),
+		AccessTokenType:                       gu.Ptr(writeModel.AccessTokenType),
+		AccessTokenRoleAssertion:              gu.Ptr(writeModel.AccessTokenRoleAssertion),
+		IDTokenRoleAssertion:                  gu.Ptr(writeModel.IDTokenRoleAssertion),
+		IDTokenUserinfoAssertion:              gu.Ptr(writeModel.IDTokenUserinfoAssertion),
+		ClockSkew:                             gu.Ptr(writeModel.ClockSkew),
+		AdditionalOrigins:                     writeModel.AdditionalOrigins,
+		SkipNativeAppSuccessPage:              gu.Ptr(writeModel.SkipNativeAppSuccessPage),
+		BackChannelLogoutURI:                  gu.Ptr(writeModel.BackChannelLogoutURI),
+		LoginVersion:                          gu.Ptr(writeModel.LoginVersion),
+		LoginBaseURI:                          gu.Ptr(writeModel.LoginBaseURI),
+		RequirePushedAuthorizationRequests:    gu.Ptr(writeModel.RequirePushedAuthorizationRequests),
+		RequireDPoP:                           gu.Ptr(writeModel.RequireDPoP),
+		TLSClientAuthSubjectDN:                gu.Ptr(writeModel.TLSClientAuthSubjectDN),
+		TLSClientAuthPublicKeyPin:             gu.Ptr(writeModel.TLSClientAuthPublicKeyPin),
+		BackChannelClientNotificationEndpoint: gu.Ptr(writeModel.BackChannelClientNotificationEndpoint),
+		AccessTokenLifetime:                   gu.Ptr(writeModel.AccessTokenLifetime),
+		IDTokenLifetime:                       gu.Ptr(writeModel.IDTokenLifetime),
+		RefreshTokenLifetime:                  gu.Ptr(writeModel.RefreshTokenLifetime),
 	}
 }
 
@@ -66,6 +74,13 @@ func samlWriteModelToSAMLConfig(writeModel *SAMLApplicationWriteModel) *domain.S
 		EntityID:     writeModel.EntityID,
 		LoginVersion: gu.Ptr(writeModel.LoginVersion),
 		LoginBaseURI: gu.Ptr(writeModel.LoginBaseURI),
+
+		SignatureAlgorithm:  gu.Ptr(writeModel.SignatureAlgorithm),
+		DigestAlgorithm:     gu.Ptr(writeModel.DigestAlgorithm),
+		AssertionEncryption: gu.Ptr(writeModel.AssertionEncryption),
+
+		NameIDFormat:     writeModel.NameIDFormat,
+		AttributeMapping: writeModel.AttributeMapping,
 	}
 }
 
@@ -77,6 +92,9 @@ func apiWriteModelToAPIConfig(writeModel *APIApplicationWriteModel) *domain.APIA
 		State:          writeModel.State,
 		ClientID:       writeModel.ClientID,
 		AuthMethodType: writeModel.AuthMethodType,
+
+		TLSClientAuthSubjectDN:    writeModel.TLSClientAuthSubjectDN,
+		TLSClientAuthPublicKeyPin: writeModel.TLSClientAuthPublicKeyPin,
 	}
 }
 
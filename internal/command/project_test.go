@@ -1112,6 +1112,11 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1165,6 +1170,11 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1181,6 +1191,11 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1197,6 +1212,11 @@ func TestCommandSide_RemoveProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1441,6 +1461,11 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"http://localhost:8080/saml/metadata",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
@@ -1492,6 +1517,11 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1508,6 +1538,11 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 						eventFromEventPusher(project.NewApplicationAddedEvent(context.Background(),
@@ -1524,6 +1559,11 @@ func TestCommandSide_DeleteProject(t *testing.T) {
 								"",
 								domain.LoginVersionUnspecified,
 								"",
+								domain.SAMLSignatureAlgorithmUnspecified,
+								domain.SAMLDigestAlgorithmUnspecified,
+								domain.SAMLAssertionEncryptionNone,
+								nil,
+								nil,
 							),
 						),
 					),
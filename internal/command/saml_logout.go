@@ -0,0 +1,204 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SAMLSessionLogout contains the information needed to log the user out of a service provider
+// using Single Logout (SLO).
+type SAMLSessionLogout struct {
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+	LogoutURL    string
+	ResponseURL  string
+	Binding      string
+}
+
+// SAMLLogoutStep is the next step of the Single Logout of a session.
+// If a Participant is set, the LogoutRequest with the RequestID must be sent to it.
+// Otherwise, all service providers were logged out and if the logout was initiated by a service provider,
+// the LogoutResponse must be sent to the Initiator or the user agent redirected to the ReturnURL.
+type SAMLLogoutStep struct {
+	Participant *SAMLLogoutParticipant
+	RequestID   string
+
+	Initiator           *SAMLLogoutParticipant
+	InitiatorRequestID  string
+	InitiatorRelayState string
+	ReturnURL           string
+}
+
+// InitiateSAMLLogout handles the LogoutRequest of a service provider (SP-initiated SLO).
+// It terminates the session the user was logged in through the service provider
+// and stores the request, so it can be answered once all other service providers of the session were logged out.
+// The ID of the terminated session is returned.
+func (c *Commands) InitiateSAMLLogout(ctx context.Context, entityID, nameID string, sessionIndexes []string, requestID, relayState string) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if entityID == "" || nameID == "" || requestID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-eiZ4o", "Errors.SAMLLogout.Invalid")
+	}
+	registration := &samlLogoutRegistrationSearchModel{
+		entityID:       entityID,
+		nameID:         nameID,
+		sessionIndexes: sessionIndexes,
+	}
+	if err = c.eventstore.FilterToQueryReducer(ctx, registration); err != nil {
+		return "", err
+	}
+	if registration.SessionID == "" {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Ahng4", "Errors.SAMLLogout.SessionNotFound")
+	}
+	if _, err = c.TerminateSessionWithoutTokenCheck(ctx, registration.SessionID); err != nil {
+		return "", err
+	}
+	writeModel, err := c.samlLogoutWriteModel(ctx, registration.SessionID)
+	if err != nil {
+		return "", err
+	}
+	initiator := writeModel.participant(registration.SAMLSessionID)
+	if initiator == nil {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Ahng4", "Errors.SAMLLogout.SessionNotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		samlsession.NewLogoutInitiatedEvent(ctx, initiator.aggregate(), requestID, relayState, ""),
+		samlsession.NewLogoutCompletedEvent(ctx, initiator.aggregate()),
+	)
+	if err != nil {
+		return "", err
+	}
+	return registration.SessionID, nil
+}
+
+// StartSAMLLogout starts the Single Logout of the service providers of a terminated session (IdP-initiated SLO).
+// It returns false, if there are no service providers to log out or the logout was already started.
+// Once all service providers were logged out, the user agent is redirected to the returnURL.
+func (c *Commands) StartSAMLLogout(ctx context.Context, sessionID, returnURL string) (_ bool, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if sessionID == "" {
+		return false, zerrors.ThrowInvalidArgument(nil, "COMMAND-Xei4a", "Errors.SAMLLogout.Invalid")
+	}
+	writeModel, err := c.samlLogoutWriteModel(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+	participant := writeModel.pendingParticipant()
+	if participant == nil || writeModel.Initiated {
+		return false, nil
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		samlsession.NewLogoutInitiatedEvent(ctx, participant.aggregate(), "", "", returnURL),
+	)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// NextSAMLLogoutStep returns the next step of the Single Logout of a session, which must already be terminated.
+// If a service provider still needs to be logged out, the LogoutRequest with the provided requestID is recorded,
+// so the LogoutResponse of the service provider can be correlated by [Commands.CompleteSAMLLogoutRequest].
+func (c *Commands) NextSAMLLogoutStep(ctx context.Context, sessionID, requestID string) (_ *SAMLLogoutStep, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if sessionID == "" || requestID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooph6", "Errors.SAMLLogout.Invalid")
+	}
+	sessionWriteModel := NewSessionWriteModel(sessionID, authz.GetInstance(ctx).InstanceID())
+	if err = c.eventstore.FilterToQueryReducer(ctx, sessionWriteModel); err != nil {
+		return nil, err
+	}
+	if sessionWriteModel.CheckIsActive() == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieph3", "Errors.SAMLLogout.SessionActive")
+	}
+	writeModel, err := c.samlLogoutWriteModel(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if participant := writeModel.pendingParticipant(); participant != nil {
+		err = c.pushAppendAndReduce(ctx, writeModel,
+			samlsession.NewLogoutRequestedEvent(ctx, participant.aggregate(), requestID),
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &SAMLLogoutStep{
+			Participant: participant,
+			RequestID:   requestID,
+		}, nil
+	}
+	step := &SAMLLogoutStep{
+		ReturnURL: writeModel.ReturnURL,
+	}
+	if writeModel.InitiatorSAMLSessionID != "" {
+		step.Initiator = writeModel.participant(writeModel.InitiatorSAMLSessionID)
+		step.InitiatorRequestID = writeModel.InitiatorRequestID
+		step.InitiatorRelayState = writeModel.InitiatorRelayState
+	}
+	return step, nil
+}
+
+// CompleteSAMLLogoutRequest handles the LogoutResponse of a service provider to a LogoutRequest
+// sent during the Single Logout of a session and marks the service provider as logged out.
+// The ID of the session is returned, so the logout can be continued with [Commands.NextSAMLLogoutStep].
+func (c *Commands) CompleteSAMLLogoutRequest(ctx context.Context, entityID, requestID string) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if entityID == "" || requestID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-ug4Ee", "Errors.SAMLLogout.Invalid")
+	}
+	request := &samlLogoutRequestSearchModel{requestID: requestID}
+	if err = c.eventstore.FilterToQueryReducer(ctx, request); err != nil {
+		return "", err
+	}
+	if request.SAMLSessionID == "" {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-Thae3", "Errors.SAMLLogout.RequestNotFound")
+	}
+	samlSession := NewSAMLSessionWriteModel(request.SAMLSessionID, "")
+	if err = c.eventstore.FilterToQueryReducer(ctx, samlSession); err != nil {
+		return "", err
+	}
+	writeModel, err := c.samlLogoutWriteModel(ctx, samlSession.SessionID)
+	if err != nil {
+		return "", err
+	}
+	participant := writeModel.participantByRequestID(requestID)
+	if participant == nil || participant.EntityID != entityID || participant.Completed {
+		return "", zerrors.ThrowNotFound(nil, "COMMAND-aiQu2", "Errors.SAMLLogout.RequestNotFound")
+	}
+	err = c.pushAppendAndReduce(ctx, writeModel,
+		samlsession.NewLogoutCompletedEvent(ctx, participant.aggregate()),
+	)
+	if err != nil {
+		return "", err
+	}
+	return writeModel.SessionID, nil
+}
+
+// samlLogoutWriteModel returns the state of the Single Logout of the service providers of the session,
+// which is kept on the SAML sessions registered for the logout.
+func (c *Commands) samlLogoutWriteModel(ctx context.Context, sessionID string) (*SAMLLogoutWriteModel, error) {
+	sessions := &samlLogoutSessionsSearchModel{sessionID: sessionID}
+	if err := c.eventstore.FilterToQueryReducer(ctx, sessions); err != nil {
+		return nil, err
+	}
+	writeModel := NewSAMLLogoutWriteModel(sessionID, sessions.SAMLSessionIDs)
+	if len(sessions.SAMLSessionIDs) == 0 {
+		return writeModel, nil
+	}
+	if err := c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return nil, err
+	}
+	return writeModel, nil
+}
@@ -0,0 +1,242 @@
+package command
+
+import (
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+)
+
+// SAMLLogoutParticipant is a service provider the user was logged in through a session.
+type SAMLLogoutParticipant struct {
+	SAMLSessionID string
+	UserID        string
+	EntityID      string
+	NameID        string
+	NameIDFormat  string
+	SessionIndex  string
+	LogoutURL     string
+	ResponseURL   string
+	Binding       string
+
+	// RequestID is the ID of the last LogoutRequest sent to the service provider.
+	RequestID string
+	Completed bool
+
+	resourceOwner string
+}
+
+func (p *SAMLLogoutParticipant) aggregate() *eventstore.Aggregate {
+	return &samlsession.NewAggregate(p.SAMLSessionID, p.resourceOwner).Aggregate
+}
+
+// SAMLLogoutWriteModel holds the state of the Single Logout (SLO) of all service providers of a session.
+// The state is kept on the SAML sessions of the session, which are found by [samlLogoutSessionsSearchModel].
+type SAMLLogoutWriteModel struct {
+	eventstore.WriteModel
+
+	SessionID    string
+	Participants []*SAMLLogoutParticipant
+
+	Initiated              bool
+	InitiatorSAMLSessionID string
+	InitiatorRequestID     string
+	InitiatorRelayState    string
+	ReturnURL              string
+
+	samlSessionIDs []string
+}
+
+func NewSAMLLogoutWriteModel(sessionID string, samlSessionIDs []string) *SAMLLogoutWriteModel {
+	return &SAMLLogoutWriteModel{
+		SessionID:      sessionID,
+		samlSessionIDs: samlSessionIDs,
+	}
+}
+
+func (wm *SAMLLogoutWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *samlsession.LogoutRegisteredEvent:
+			wm.Participants = append(wm.Participants, &SAMLLogoutParticipant{
+				SAMLSessionID: e.Aggregate().ID,
+				UserID:        e.UserID,
+				EntityID:      e.EntityID,
+				NameID:        e.NameID,
+				NameIDFormat:  e.NameIDFormat,
+				SessionIndex:  e.SessionIndex,
+				LogoutURL:     e.LogoutURL,
+				ResponseURL:   e.ResponseURL,
+				Binding:       e.Binding,
+				resourceOwner: e.Aggregate().ResourceOwner,
+			})
+		case *samlsession.LogoutInitiatedEvent:
+			wm.Initiated = true
+			wm.ReturnURL = e.ReturnURL
+			if e.RequestID != "" {
+				wm.InitiatorSAMLSessionID = e.Aggregate().ID
+				wm.InitiatorRequestID = e.RequestID
+				wm.InitiatorRelayState = e.RelayState
+			}
+		case *samlsession.LogoutRequestedEvent:
+			if participant := wm.participant(e.Aggregate().ID); participant != nil {
+				participant.RequestID = e.RequestID
+			}
+		case *samlsession.LogoutCompletedEvent:
+			if participant := wm.participant(e.Aggregate().ID); participant != nil {
+				participant.Completed = true
+			}
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLLogoutWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		AggregateIDs(wm.samlSessionIDs...).
+		EventTypes(
+			samlsession.LogoutRegisteredType,
+			samlsession.LogoutInitiatedType,
+			samlsession.LogoutRequestedType,
+			samlsession.LogoutCompletedType,
+		).
+		Builder()
+}
+
+func (wm *SAMLLogoutWriteModel) participant(samlSessionID string) *SAMLLogoutParticipant {
+	index := slices.IndexFunc(wm.Participants, func(participant *SAMLLogoutParticipant) bool {
+		return participant.SAMLSessionID == samlSessionID
+	})
+	if index < 0 {
+		return nil
+	}
+	return wm.Participants[index]
+}
+
+func (wm *SAMLLogoutWriteModel) participantByRequestID(requestID string) *SAMLLogoutParticipant {
+	index := slices.IndexFunc(wm.Participants, func(participant *SAMLLogoutParticipant) bool {
+		return participant.RequestID == requestID
+	})
+	if index < 0 {
+		return nil
+	}
+	return wm.Participants[index]
+}
+
+// pendingParticipant returns the first service provider, which was not logged out yet.
+func (wm *SAMLLogoutWriteModel) pendingParticipant() *SAMLLogoutParticipant {
+	index := slices.IndexFunc(wm.Participants, func(participant *SAMLLogoutParticipant) bool {
+		return !participant.Completed
+	})
+	if index < 0 {
+		return nil
+	}
+	return wm.Participants[index]
+}
+
+// samlLogoutSessionsSearchModel searches the SAML sessions of a session, which registered a service provider for the logout.
+type samlLogoutSessionsSearchModel struct {
+	sessionID string
+
+	SAMLSessionIDs []string
+}
+
+func (m *samlLogoutSessionsSearchModel) Reduce() error {
+	return nil
+}
+
+func (m *samlLogoutSessionsSearchModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		e, ok := event.(*samlsession.LogoutRegisteredEvent)
+		if !ok || e.SessionID != m.sessionID {
+			continue
+		}
+		m.SAMLSessionIDs = append(m.SAMLSessionIDs, e.Aggregate().ID)
+	}
+}
+
+func (m *samlLogoutSessionsSearchModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		EventTypes(samlsession.LogoutRegisteredType).
+		EventData(map[string]interface{}{
+			"sessionID": m.sessionID,
+		}).
+		Builder()
+}
+
+// samlLogoutRegistrationSearchModel searches the latest registration of a service provider for the user,
+// identified by its NameID and optionally the SessionIndex of the assertion.
+type samlLogoutRegistrationSearchModel struct {
+	entityID       string
+	nameID         string
+	sessionIndexes []string
+
+	SessionID     string
+	SAMLSessionID string
+}
+
+func (m *samlLogoutRegistrationSearchModel) Reduce() error {
+	return nil
+}
+
+func (m *samlLogoutRegistrationSearchModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		e, ok := event.(*samlsession.LogoutRegisteredEvent)
+		if !ok || e.EntityID != m.entityID || e.NameID != m.nameID {
+			continue
+		}
+		if len(m.sessionIndexes) > 0 && !slices.Contains(m.sessionIndexes, e.SessionIndex) {
+			continue
+		}
+		m.SessionID = e.SessionID
+		m.SAMLSessionID = e.Aggregate().ID
+	}
+}
+
+func (m *samlLogoutRegistrationSearchModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		EventTypes(samlsession.LogoutRegisteredType).
+		EventData(map[string]interface{}{
+			"entityID": m.entityID,
+			"nameID":   m.nameID,
+		}).
+		Builder()
+}
+
+// samlLogoutRequestSearchModel searches the SAML session of a LogoutRequest sent to a service provider.
+type samlLogoutRequestSearchModel struct {
+	requestID string
+
+	SAMLSessionID string
+}
+
+func (m *samlLogoutRequestSearchModel) Reduce() error {
+	return nil
+}
+
+func (m *samlLogoutRequestSearchModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		e, ok := event.(*samlsession.LogoutRequestedEvent)
+		if !ok || e.RequestID != m.requestID {
+			continue
+		}
+		m.SAMLSessionID = e.Aggregate().ID
+	}
+}
+
+func (m *samlLogoutRequestSearchModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(samlsession.AggregateType).
+		EventTypes(samlsession.LogoutRequestedType).
+		EventData(map[string]interface{}{
+			"requestID": m.requestID,
+		}).
+		Builder()
+}
@@ -0,0 +1,644 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/samlsession"
+	"github.com/zitadel/zitadel/internal/repository/session"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func samlLogoutRegisteredEvent(samlSessionID, entityID string) eventstore.Event {
+	return eventFromEventPusher(
+		samlsession.NewLogoutRegisteredEvent(context.Background(),
+			&samlsession.NewAggregate(samlSessionID, "org1").Aggregate,
+			"sessionID", "userID", entityID, "nameID", "nameIDFormat", "sessionIndex",
+			"https://"+entityID+"/slo", "https://"+entityID+"/slo/response", "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+		),
+	)
+}
+
+func samlSessionAddedEvent(samlSessionID, entityID string) eventstore.Event {
+	return eventFromEventPusher(
+		samlsession.NewAddedEvent(context.Background(),
+			&samlsession.NewAggregate(samlSessionID, "org1").Aggregate,
+			"userID", "org1", "sessionID", entityID, []string{entityID}, nil, time.Time{}, nil, nil,
+		),
+	)
+}
+
+func TestCommands_InitiateSAMLLogout(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx            context.Context
+		entityID       string
+		nameID         string
+		sessionIndexes []string
+		requestID      string
+		relayState     string
+	}
+	type res struct {
+		sessionID string
+		err       error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing request id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "sp1",
+				nameID:   "nameID",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-eiZ4o", "Errors.SAMLLogout.Invalid"),
+			},
+		},
+		{
+			"session not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:  "sp1",
+				nameID:    "nameID",
+				requestID: "requestID",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ahng4", "Errors.SAMLLogout.SessionNotFound"),
+			},
+		},
+		{
+			"session index mismatch",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+					),
+				),
+			},
+			args{
+				ctx:            authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:       "sp1",
+				nameID:         "nameID",
+				sessionIndexes: []string{"otherSessionIndex"},
+				requestID:      "requestID",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Ahng4", "Errors.SAMLLogout.SessionNotFound"),
+			},
+		},
+		{
+			"initiated, session terminated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instanceID").Aggregate,
+								&domain.UserAgent{},
+							),
+						),
+					),
+					expectPush(
+						session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+					),
+					expectPush(
+						samlsession.NewLogoutInitiatedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate,
+							"requestID", "relayState", "",
+						),
+						samlsession.NewLogoutCompletedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:            authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:       "sp1",
+				nameID:         "nameID",
+				sessionIndexes: []string{"sessionIndex"},
+				requestID:      "requestID",
+				relayState:     "relayState",
+			},
+			res{
+				sessionID: "sessionID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.InitiateSAMLLogout(tt.args.ctx, tt.args.entityID, tt.args.nameID, tt.args.sessionIndexes, tt.args.requestID, tt.args.relayState)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.sessionID, got)
+		})
+	}
+}
+
+func TestCommands_StartSAMLLogout(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		sessionID string
+		returnURL string
+	}
+	type res struct {
+		started bool
+		err     error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing session id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx: authz.WithInstanceID(context.Background(), "instanceID"),
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Xei4a", "Errors.SAMLLogout.Invalid"),
+			},
+		},
+		{
+			"no service providers",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				returnURL: "https://example.com/logged-out",
+			},
+			res{
+				started: false,
+			},
+		},
+		{
+			"already initiated",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+						eventFromEventPusher(
+							samlsession.NewLogoutInitiatedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate,
+								"requestID", "relayState", "",
+							),
+						),
+						eventFromEventPusher(
+							samlsession.NewLogoutCompletedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				returnURL: "https://example.com/logged-out",
+			},
+			res{
+				started: false,
+			},
+		},
+		{
+			"started",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+					),
+					expectPush(
+						samlsession.NewLogoutInitiatedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate,
+							"", "", "https://example.com/logged-out",
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				returnURL: "https://example.com/logged-out",
+			},
+			res{
+				started: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.StartSAMLLogout(tt.args.ctx, tt.args.sessionID, tt.args.returnURL)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.started, got)
+		})
+	}
+}
+
+func TestCommands_NextSAMLLogoutStep(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		sessionID string
+		requestID string
+	}
+	type res struct {
+		step *SAMLLogoutStep
+		err  error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing session id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				requestID: "requestID",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Ooph6", "Errors.SAMLLogout.Invalid"),
+			},
+		},
+		{
+			"session still active",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instanceID").Aggregate,
+								&domain.UserAgent{},
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				requestID: "requestID",
+			},
+			res{
+				err: zerrors.ThrowPreconditionFailed(nil, "COMMAND-Ieph3", "Errors.SAMLLogout.SessionActive"),
+			},
+		},
+		{
+			"pending service provider",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instanceID").Aggregate,
+								&domain.UserAgent{},
+							),
+						),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+						),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+						eventFromEventPusher(
+							samlsession.NewLogoutCompletedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate),
+						),
+					),
+					expectPush(
+						samlsession.NewLogoutRequestedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID2", "org1").Aggregate,
+							"requestID",
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				requestID: "requestID",
+			},
+			res{
+				step: &SAMLLogoutStep{
+					Participant: &SAMLLogoutParticipant{
+						SAMLSessionID: "samlSessionID2",
+						UserID:        "userID",
+						EntityID:      "sp2",
+						NameID:        "nameID",
+						NameIDFormat:  "nameIDFormat",
+						SessionIndex:  "sessionIndex",
+						LogoutURL:     "https://sp2/slo",
+						ResponseURL:   "https://sp2/slo/response",
+						Binding:       "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+						RequestID:     "requestID",
+						resourceOwner: "org1",
+					},
+					RequestID: "requestID",
+				},
+			},
+		},
+		{
+			"all logged out, respond to initiator",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instanceID").Aggregate,
+								&domain.UserAgent{},
+							),
+						),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+						),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						eventFromEventPusher(
+							samlsession.NewLogoutInitiatedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate,
+								"initiatorRequestID", "relayState", "",
+							),
+						),
+						eventFromEventPusher(
+							samlsession.NewLogoutCompletedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				requestID: "requestID",
+			},
+			res{
+				step: &SAMLLogoutStep{
+					Initiator: &SAMLLogoutParticipant{
+						SAMLSessionID: "samlSessionID1",
+						UserID:        "userID",
+						EntityID:      "sp1",
+						NameID:        "nameID",
+						NameIDFormat:  "nameIDFormat",
+						SessionIndex:  "sessionIndex",
+						LogoutURL:     "https://sp1/slo",
+						ResponseURL:   "https://sp1/slo/response",
+						Binding:       "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect",
+						Completed:     true,
+						resourceOwner: "org1",
+					},
+					InitiatorRequestID:  "initiatorRequestID",
+					InitiatorRelayState: "relayState",
+				},
+			},
+		},
+		{
+			"all logged out, redirect to return url",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instanceID").Aggregate,
+								&domain.UserAgent{},
+							),
+						),
+						eventFromEventPusher(
+							session.NewTerminateEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate),
+						),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID1", "sp1"),
+						eventFromEventPusher(
+							samlsession.NewLogoutInitiatedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate,
+								"", "", "https://example.com/logged-out",
+							),
+						),
+						eventFromEventPusher(
+							samlsession.NewLogoutCompletedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID1", "org1").Aggregate),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				sessionID: "sessionID",
+				requestID: "requestID",
+			},
+			res{
+				step: &SAMLLogoutStep{
+					ReturnURL: "https://example.com/logged-out",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.NextSAMLLogoutStep(tt.args.ctx, tt.args.sessionID, tt.args.requestID)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.step, got)
+		})
+	}
+}
+
+func TestCommands_CompleteSAMLLogoutRequest(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx       context.Context
+		entityID  string
+		requestID string
+	}
+	type res struct {
+		sessionID string
+		err       error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing request id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				ctx:      authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID: "sp1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-ug4Ee", "Errors.SAMLLogout.Invalid"),
+			},
+		},
+		{
+			"request not found",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:  "sp1",
+				requestID: "requestID",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-Thae3", "Errors.SAMLLogout.RequestNotFound"),
+			},
+		},
+		{
+			"other service provider",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							samlsession.NewLogoutRequestedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID2", "org1").Aggregate,
+								"requestID",
+							),
+						),
+					),
+					expectFilter(
+						samlSessionAddedEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+						eventFromEventPusher(
+							samlsession.NewLogoutRequestedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID2", "org1").Aggregate,
+								"requestID",
+							),
+						),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:  "sp1",
+				requestID: "requestID",
+			},
+			res{
+				err: zerrors.ThrowNotFound(nil, "COMMAND-aiQu2", "Errors.SAMLLogout.RequestNotFound"),
+			},
+		},
+		{
+			"completed",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							samlsession.NewLogoutRequestedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID2", "org1").Aggregate,
+								"requestID",
+							),
+						),
+					),
+					expectFilter(
+						samlSessionAddedEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+					),
+					expectFilter(
+						samlLogoutRegisteredEvent("samlSessionID2", "sp2"),
+						eventFromEventPusher(
+							samlsession.NewLogoutRequestedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID2", "org1").Aggregate,
+								"requestID",
+							),
+						),
+					),
+					expectPush(
+						samlsession.NewLogoutCompletedEvent(context.Background(), &samlsession.NewAggregate("samlSessionID2", "org1").Aggregate),
+					),
+				),
+			},
+			args{
+				ctx:       authz.WithInstanceID(context.Background(), "instanceID"),
+				entityID:  "sp2",
+				requestID: "requestID",
+			},
+			res{
+				sessionID: "sessionID",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			got, err := c.CompleteSAMLLogoutRequest(tt.args.ctx, tt.args.entityID, tt.args.requestID)
+			require.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.sessionID, got)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package command
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SAMLPersistentNameID returns the persistent NameID of the user for the SAML service provider.
+// The NameID is generated on the first login of the user at the service provider and stored on the user,
+// so it cannot be correlated with the NameIDs of other service providers or the ID of the user.
+func (c *Commands) SAMLPersistentNameID(ctx context.Context, userID, resourceOwner, entityID string) (_ string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || entityID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "COMMAND-Quoh5", "Errors.IDMissing")
+	}
+	writeModel := NewSAMLNameIDWriteModel(userID, resourceOwner, entityID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return "", err
+	}
+	if writeModel.NameID != "" {
+		return writeModel.NameID, nil
+	}
+	nameID, err := c.idGenerator.Next()
+	if err != nil {
+		return "", err
+	}
+	if _, err = c.eventstore.Push(ctx, user.NewSAMLNameIDAddedEvent(ctx, UserAggregateFromWriteModelCtx(ctx, &writeModel.WriteModel), entityID, nameID)); err != nil {
+		return "", err
+	}
+	// a concurrent login might have added another NameID first, which is then used by both
+	writeModel = NewSAMLNameIDWriteModel(userID, resourceOwner, entityID)
+	if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+		return "", err
+	}
+	return writeModel.NameID, nil
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+)
+
+// SAMLNameIDWriteModel holds the persistent NameID of the user for a SAML service provider.
+// If multiple NameIDs were added concurrently, the first one is used.
+type SAMLNameIDWriteModel struct {
+	eventstore.WriteModel
+
+	EntityID string
+	NameID   string
+}
+
+func NewSAMLNameIDWriteModel(userID, resourceOwner, entityID string) *SAMLNameIDWriteModel {
+	return &SAMLNameIDWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID:   userID,
+			ResourceOwner: resourceOwner,
+		},
+		EntityID: entityID,
+	}
+}
+
+func (wm *SAMLNameIDWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		e, ok := event.(*user.SAMLNameIDAddedEvent)
+		if !ok || e.EntityID != wm.EntityID || wm.NameID != "" {
+			continue
+		}
+		wm.NameID = e.NameID
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *SAMLNameIDWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		ResourceOwner(wm.ResourceOwner).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.SAMLNameIDAddedType).
+		EventData(map[string]interface{}{"entityId": wm.EntityID}).
+		Builder()
+}
@@ -0,0 +1,137 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/id"
+	id_mock "github.com/zitadel/zitadel/internal/id/mock"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func samlNameIDAddedEvent(entityID, nameID string) eventstore.Event {
+	return eventFromEventPusher(
+		user.NewSAMLNameIDAddedEvent(context.Background(),
+			&user.NewAggregate("user1", "org1").Aggregate,
+			entityID, nameID,
+		),
+	)
+}
+
+func TestCommands_SAMLPersistentNameID(t *testing.T) {
+	type fields struct {
+		eventstore  func(*testing.T) *eventstore.Eventstore
+		idGenerator id.Generator
+	}
+	type args struct {
+		userID   string
+		entityID string
+	}
+	type res struct {
+		nameID string
+		err    error
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			"missing entity id",
+			fields{
+				eventstore: expectEventstore(),
+			},
+			args{
+				userID: "user1",
+			},
+			res{
+				err: zerrors.ThrowInvalidArgument(nil, "COMMAND-Quoh5", "Errors.IDMissing"),
+			},
+		},
+		{
+			"existing",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						samlNameIDAddedEvent("https://sp.example.com", "nameID1"),
+					),
+				),
+			},
+			args{
+				userID:   "user1",
+				entityID: "https://sp.example.com",
+			},
+			res{
+				nameID: "nameID1",
+			},
+		},
+		{
+			"new",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						user.NewSAMLNameIDAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"https://sp.example.com", "nameID1",
+						),
+					),
+					expectFilter(
+						samlNameIDAddedEvent("https://sp.example.com", "nameID1"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "nameID1"),
+			},
+			args{
+				userID:   "user1",
+				entityID: "https://sp.example.com",
+			},
+			res{
+				nameID: "nameID1",
+			},
+		},
+		{
+			"added concurrently",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(),
+					expectPush(
+						user.NewSAMLNameIDAddedEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"https://sp.example.com", "nameID2",
+						),
+					),
+					expectFilter(
+						samlNameIDAddedEvent("https://sp.example.com", "nameID1"),
+						samlNameIDAddedEvent("https://sp.example.com", "nameID2"),
+					),
+				),
+				idGenerator: id_mock.NewIDGeneratorExpectIDs(t, "nameID2"),
+			},
+			args{
+				userID:   "user1",
+				entityID: "https://sp.example.com",
+			},
+			res{
+				nameID: "nameID1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Commands{
+				eventstore:  tt.fields.eventstore(t),
+				idGenerator: tt.fields.idGenerator,
+			}
+			got, err := c.SAMLPersistentNameID(authz.WithInstanceID(context.Background(), "instanceID"), tt.args.userID, "org1", tt.args.entityID)
+			assert.ErrorIs(t, err, tt.res.err)
+			assert.Equal(t, tt.res.nameID, got)
+		})
+	}
+}
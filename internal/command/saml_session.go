@@ -33,7 +33,7 @@ type SAMLSession struct {
 
 type SAMLRequestComplianceChecker func(context.Context, *SAMLRequestWriteModel) error
 
-func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReqId string, complianceCheck SAMLRequestComplianceChecker, samlResponseID string, samlResponseLifetime time.Duration) (err error) {
+func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReqId string, complianceCheck SAMLRequestComplianceChecker, samlResponseID string, samlResponseLifetime time.Duration, logout *SAMLSessionLogout) (err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
@@ -79,6 +79,7 @@ func (c *Commands) CreateSAMLSessionFromSAMLRequest(ctx context.Context, samlReq
 	if err = cmd.AddSAMLResponse(ctx, samlResponseID, samlResponseLifetime); err != nil {
 		return err
 	}
+	cmd.RegisterLogout(ctx, sessionModel.AggregateID, sessionModel.UserID, samlReqModel.Issuer, logout)
 	cmd.SetSAMLRequestSuccessful(ctx, samlReqModel.aggregate)
 	postCommit, err := cmd.SetMilestones(ctx)
 	if err != nil {
@@ -154,6 +155,27 @@ func (c *SAMLSessionEvents) AddSession(
 	))
 }
 
+// RegisterLogout stores the information needed to log the user out of the service provider
+// once the session is terminated.
+func (c *SAMLSessionEvents) RegisterLogout(ctx context.Context, sessionID, userID, entityID string, logout *SAMLSessionLogout) {
+	if logout == nil || sessionID == "" {
+		return
+	}
+	c.events = append(c.events, samlsession.NewLogoutRegisteredEvent(
+		ctx,
+		c.samlSessionWriteModel.aggregate,
+		sessionID,
+		userID,
+		entityID,
+		logout.NameID,
+		logout.NameIDFormat,
+		logout.SessionIndex,
+		logout.LogoutURL,
+		logout.ResponseURL,
+		logout.Binding,
+	))
+}
+
 func (c *SAMLSessionEvents) SetSAMLRequestSuccessful(ctx context.Context, samlRequestAggregate *eventstore.Aggregate) {
 	c.events = append(c.events, samlrequest.NewSucceededEvent(ctx, samlRequestAggregate))
 }
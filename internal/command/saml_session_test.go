@@ -44,6 +44,7 @@ func TestCommands_CreateSAMLSessionFromSAMLRequest(t *testing.T) {
 		samlResponseID       string
 		complianceCheck      SAMLRequestComplianceChecker
 		samlResponseLifetime time.Duration
+		logout               *SAMLSessionLogout
 	}
 	type res struct {
 		err error
@@ -326,6 +327,107 @@ func TestCommands_CreateSAMLSessionFromSAMLRequest(t *testing.T) {
 			},
 			res{},
 		},
+		{
+			"add successful, logout registered",
+			fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							samlrequest.NewAddedEvent(context.Background(), &samlrequest.NewAggregate("V2_samlRequestID", "instanceID").Aggregate,
+								"loginClient",
+								"applicationId",
+								"acs",
+								"relaystate",
+								"request",
+								"binding",
+								"issuer",
+								"destination",
+								"responseissuer",
+							),
+						),
+						eventFromEventPusher(
+							samlrequest.NewSessionLinkedEvent(context.Background(), &samlrequest.NewAggregate("V2_samlRequestID", "instanceID").Aggregate,
+								"sessionID",
+								"userID",
+								testNow,
+								[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword},
+							),
+						),
+					),
+					expectFilter(
+						eventFromEventPusher(
+							session.NewAddedEvent(context.Background(),
+								&session.NewAggregate("sessionID", "instance1").Aggregate,
+								&domain.UserAgent{
+									FingerprintID: gu.Ptr("fp1"),
+									IP:            net.ParseIP("1.2.3.4"),
+									Description:   gu.Ptr("firefox"),
+									Header:        http.Header{"foo": []string{"bar"}},
+								},
+							),
+						),
+						eventFromEventPusher(
+							session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+								"userID", "org1", testNow, &language.Afrikaans),
+						),
+						eventFromEventPusher(
+							session.NewPasswordCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instanceID").Aggregate,
+								testNow),
+						),
+					),
+					expectFilter(
+						user.NewHumanAddedEvent(
+							context.Background(),
+							&user.NewAggregate("userID", "org1").Aggregate,
+							"username",
+							"firstname",
+							"lastname",
+							"nickname",
+							"displayname",
+							language.Afrikaans,
+							domain.GenderUnspecified,
+							"email",
+							false,
+						),
+					),
+					expectPush(
+						samlsession.NewAddedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+							"userID", "org1", "sessionID", "issuer", []string{"issuer"},
+							[]domain.UserAuthMethodType{domain.UserAuthMethodTypePassword}, testNow, &language.Afrikaans,
+							&domain.UserAgent{
+								FingerprintID: gu.Ptr("fp1"),
+								IP:            net.ParseIP("1.2.3.4"),
+								Description:   gu.Ptr("firefox"),
+								Header:        http.Header{"foo": []string{"bar"}},
+							},
+						),
+						samlsession.NewSAMLResponseAddedEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate, "samlResponseID", time.Minute*5),
+						samlsession.NewLogoutRegisteredEvent(context.Background(), &samlsession.NewAggregate("V2_samlSessionID", "org1").Aggregate,
+							"sessionID", "userID", "issuer", "nameID", "nameIDFormat", "sessionIndex", "https://sp.example.com/slo", "https://sp.example.com/slo/response", "binding",
+						),
+						samlrequest.NewSucceededEvent(context.Background(), &samlrequest.NewAggregate("V2_samlRequestID", "instanceID").Aggregate),
+					),
+				),
+				idGenerator:  mock.NewIDGeneratorExpectIDs(t, "samlSessionID"),
+				keyAlgorithm: crypto.CreateMockEncryptionAlg(gomock.NewController(t)),
+			},
+			args{
+				ctx:                  authz.WithInstanceID(context.Background(), "instanceID"),
+				samlRequestID:        "V2_samlRequestID",
+				samlResponseID:       "samlResponseID",
+				samlResponseLifetime: time.Minute * 5,
+				complianceCheck:      mockSAMLRequestComplianceChecker(nil),
+				logout: &SAMLSessionLogout{
+					NameID:       "nameID",
+					NameIDFormat: "nameIDFormat",
+					SessionIndex: "sessionIndex",
+					LogoutURL:    "https://sp.example.com/slo",
+					ResponseURL:  "https://sp.example.com/slo/response",
+					Binding:      "binding",
+				},
+			},
+			res{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -335,7 +437,7 @@ func TestCommands_CreateSAMLSessionFromSAMLRequest(t *testing.T) {
 				keyAlgorithm: tt.fields.keyAlgorithm,
 			}
 			c.setMilestonesCompletedForTest("instanceID")
-			err := c.CreateSAMLSessionFromSAMLRequest(tt.args.ctx, tt.args.samlRequestID, tt.args.complianceCheck, tt.args.samlResponseID, tt.args.samlResponseLifetime)
+			err := c.CreateSAMLSessionFromSAMLRequest(tt.args.ctx, tt.args.samlRequestID, tt.args.complianceCheck, tt.args.samlResponseID, tt.args.samlResponseLifetime, tt.args.logout)
 			require.ErrorIs(t, err, tt.res.err)
 		})
 	}
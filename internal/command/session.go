@@ -173,7 +173,8 @@ func (s *SessionCommands) PasswordChecked(ctx context.Context, checkedAt time.Ti
 }
 
 func (s *SessionCommands) IntentChecked(ctx context.Context, checkedAt time.Time) {
-	s.eventCommands = append(s.eventCommands, session.NewIntentCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt))
+	s.eventCommands = append(s.eventCommands, session.NewIntentCheckedEvent(ctx, s.sessionWriteModel.aggregate, checkedAt,
+		s.intentWriteModel.IDPID, idpSessionID(s.intentWriteModel.IDPIDToken)))
 	s.eventCommands = append(s.eventCommands, idpintent.NewConsumedEvent(ctx, IDPIntentAggregateFromWriteModel(&s.intentWriteModel.WriteModel)))
 }
 
@@ -965,7 +965,7 @@ func TestCommands_updateSession(t *testing.T) {
 								"idpUsername",
 								"userID",
 								nil,
-								"",
+								"eyJhbGciOiJub25lIn0.eyJzdWIiOiJpZHBVc2VySUQiLCJzaWQiOiJpZHBTZXNzaW9uSUQifQ.c2ln",
 								time.Now().Add(time.Hour),
 							),
 						),
@@ -974,7 +974,7 @@ func TestCommands_updateSession(t *testing.T) {
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
 						session.NewIntentCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
-							testNow),
+							testNow, "", "idpSessionID"),
 						idpintent.NewConsumedEvent(context.Background(), &idpintent.NewAggregate("intent", "org1").Aggregate),
 						session.NewMetadataSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							map[string][]byte{"key": []byte("value")}),
@@ -1059,7 +1059,7 @@ func TestCommands_updateSession(t *testing.T) {
 						session.NewUserCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"userID", "org1", testNow, &language.Afrikaans),
 						session.NewIntentCheckedEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
-							testNow),
+							testNow, "idpID", ""),
 						idpintent.NewConsumedEvent(context.Background(), &idpintent.NewAggregate("intent", "org1").Aggregate),
 						session.NewTokenSetEvent(context.Background(), &session.NewAggregate("sessionID", "instance1").Aggregate,
 							"tokenID"),
@@ -152,8 +152,46 @@ func (c *Commands) UserIDPLoginChecked(ctx context.Context, orgID, userID string
 		return zerrors.ThrowPreconditionFailed(nil, "COMMAND-dn88J", "Errors.User.NotFound")
 	}
 
+	var idpIDToken string
+	if authRequest != nil {
+		idpIDToken = authRequest.SelectedIDPIDToken
+	}
 	userAgg := UserAggregateFromWriteModel(&existingHuman.WriteModel)
-	_, err = c.eventstore.Push(ctx, user.NewUserIDPCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest)))
+	_, err = c.eventstore.Push(ctx, user.NewUserIDPCheckSucceededEvent(ctx, userAgg, authRequestDomainToAuthRequestInfo(authRequest), idpIDToken, idpSessionID(idpIDToken)))
+	return err
+}
+
+// UserIDPSignOut signs the user out of all user agents, on which the user was authenticated using the identity provider,
+// e.g. because the identity provider sent a back-channel logout for the user.
+// If the idpSessionID (sid) is provided, only the user agents authenticated with the session of the identity provider are signed out.
+// The userID might be omitted in that case, so the users are searched by the session of the identity provider.
+func (c *Commands) UserIDPSignOut(ctx context.Context, userID, idpConfigID, idpSessionID string) (err error) {
+	if idpConfigID == "" || (userID == "" && idpSessionID == "") {
+		return zerrors.ThrowInvalidArgument(nil, "COMMAND-Ruu1a", "Errors.IDMissing")
+	}
+	userIDs := []string{userID}
+	if userID == "" {
+		searchModel := &userIDPSessionSearchModel{idpConfigID: idpConfigID, idpSessionID: idpSessionID}
+		if err = c.eventstore.FilterToQueryReducer(ctx, searchModel); err != nil {
+			return err
+		}
+		userIDs = searchModel.UserIDs
+	}
+	events := make([]eventstore.Command, 0, len(userIDs))
+	for _, userID := range userIDs {
+		writeModel := NewUserIDPSessionsWriteModel(userID, idpConfigID, idpSessionID)
+		if err = c.eventstore.FilterToQueryReducer(ctx, writeModel); err != nil {
+			return err
+		}
+		userAgg := UserAggregateFromWriteModel(&writeModel.WriteModel)
+		for _, userAgentID := range writeModel.UserAgentIDs {
+			events = append(events, user.NewHumanSignedOutEvent(ctx, userAgg, userAgentID, ""))
+		}
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	_, err = c.eventstore.Push(ctx, events...)
 	return err
 }
 
@@ -1,6 +1,8 @@
 package command
 
 import (
+	"slices"
+
 	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/repository/user"
@@ -90,3 +92,98 @@ func (wm *UserIDPLinkWriteModel) Query() *eventstore.SearchQueryBuilder {
 			user.UserRemovedType).
 		Builder()
 }
+
+// UserIDPSessionsWriteModel collects the user agents, on which the user is (V1) signed in using the identity provider.
+// If an IDPSessionID is set, only the user agents signed in with the session of the identity provider are collected.
+type UserIDPSessionsWriteModel struct {
+	eventstore.WriteModel
+
+	IDPConfigID  string
+	IDPSessionID string
+	UserAgentIDs []string
+}
+
+func NewUserIDPSessionsWriteModel(userID, idpConfigID, idpSessionID string) *UserIDPSessionsWriteModel {
+	return &UserIDPSessionsWriteModel{
+		WriteModel: eventstore.WriteModel{
+			AggregateID: userID,
+		},
+		IDPConfigID:  idpConfigID,
+		IDPSessionID: idpSessionID,
+	}
+}
+
+func (wm *UserIDPSessionsWriteModel) Reduce() error {
+	for _, event := range wm.Events {
+		switch e := event.(type) {
+		case *user.UserIDPCheckSucceededEvent:
+			if e.AuthRequestInfo == nil || e.UserAgentID == "" {
+				continue
+			}
+			wm.removeUserAgent(e.UserAgentID)
+			// a login with another identity provider on the same user agent replaces the previous one
+			if e.SelectedIDPConfigID == wm.IDPConfigID && (wm.IDPSessionID == "" || e.IDPSessionID == wm.IDPSessionID) {
+				wm.UserAgentIDs = append(wm.UserAgentIDs, e.UserAgentID)
+			}
+		case *user.HumanSignedOutEvent:
+			wm.removeUserAgent(e.UserAgentID)
+		case *user.UserRemovedEvent:
+			wm.UserAgentIDs = nil
+		}
+	}
+	return wm.WriteModel.Reduce()
+}
+
+func (wm *UserIDPSessionsWriteModel) removeUserAgent(userAgentID string) {
+	wm.UserAgentIDs = slices.DeleteFunc(wm.UserAgentIDs, func(id string) bool {
+		return id == userAgentID
+	})
+}
+
+func (wm *UserIDPSessionsWriteModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(wm.AggregateID).
+		EventTypes(user.UserIDPLoginCheckSucceededType,
+			user.HumanSignedOutType,
+			user.UserV1SignedOutType,
+			user.UserRemovedType).
+		Builder()
+}
+
+// userIDPSessionSearchModel searches the users, which were (V1) signed in with the session of the identity provider.
+type userIDPSessionSearchModel struct {
+	idpConfigID  string
+	idpSessionID string
+
+	UserIDs []string
+}
+
+func (m *userIDPSessionSearchModel) Reduce() error {
+	return nil
+}
+
+func (m *userIDPSessionSearchModel) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		e, ok := event.(*user.UserIDPCheckSucceededEvent)
+		if !ok || e.AuthRequestInfo == nil || e.SelectedIDPConfigID != m.idpConfigID || e.IDPSessionID != m.idpSessionID {
+			continue
+		}
+		if !slices.Contains(m.UserIDs, e.Aggregate().ID) {
+			m.UserIDs = append(m.UserIDs, e.Aggregate().ID)
+		}
+	}
+}
+
+func (m *userIDPSessionSearchModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		EventTypes(user.UserIDPLoginCheckSucceededType).
+		EventData(map[string]interface{}{
+			"selectedIDPConfigID": m.idpConfigID,
+			"idpSessionId":        m.idpSessionID,
+		}).
+		Builder()
+}
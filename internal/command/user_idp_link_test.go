@@ -1036,6 +1036,8 @@ func TestCommandSide_ExternalLoginCheck(t *testing.T) {
 								UserAgentID:         "useragent1",
 								SelectedIDPConfigID: "config1",
 							},
+							"",
+							"",
 						),
 					),
 				),
@@ -1052,6 +1054,53 @@ func TestCommandSide_ExternalLoginCheck(t *testing.T) {
 			},
 			res: res{},
 		},
+		{
+			name: "external login check with idp id_token, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						eventFromEventPusher(
+							user.NewHumanAddedEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"username",
+								"firstname",
+								"lastname",
+								"nickname",
+								"displayname",
+								language.German,
+								domain.GenderUnspecified,
+								"email@test.ch",
+								true,
+							),
+						),
+					),
+					expectPush(
+						user.NewUserIDPCheckSucceededEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							&user.AuthRequestInfo{
+								ID:                  "request1",
+								UserAgentID:         "useragent1",
+								SelectedIDPConfigID: "config1",
+							},
+							"eyJhbGciOiJub25lIn0.eyJzdWIiOiJpZHBVc2VySUQiLCJzaWQiOiJpZHBTZXNzaW9uSUQifQ.c2ln",
+							"idpSessionID",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				orgID:  "org1",
+				userID: "user1",
+				authRequest: &domain.AuthRequest{
+					ID:                  "request1",
+					AgentID:             "useragent1",
+					SelectedIDPConfigID: "config1",
+					SelectedIDPIDToken:  "eyJhbGciOiJub25lIn0.eyJzdWIiOiJpZHBVc2VySUQiLCJzaWQiOiJpZHBTZXNzaW9uSUQifQ.c2ln",
+				},
+			},
+			res: res{},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1069,6 +1118,216 @@ func TestCommandSide_ExternalLoginCheck(t *testing.T) {
 	}
 }
 
+func TestCommandSide_UserIDPSignOut(t *testing.T) {
+	type fields struct {
+		eventstore func(*testing.T) *eventstore.Eventstore
+	}
+	type args struct {
+		ctx          context.Context
+		userID       string
+		idpConfigID  string
+		idpSessionID string
+	}
+	type res struct {
+		err func(error) bool
+	}
+	idpSessionChecked := func(userID, userAgentID, idpConfigID, idpSessionID string) eventstore.Event {
+		return eventFromEventPusher(
+			user.NewUserIDPCheckSucceededEvent(context.Background(),
+				&user.NewAggregate(userID, "org1").Aggregate,
+				&user.AuthRequestInfo{
+					ID:                  "request1",
+					UserAgentID:         userAgentID,
+					SelectedIDPConfigID: idpConfigID,
+				},
+				"",
+				idpSessionID,
+			),
+		)
+	}
+	idpChecked := func(userAgentID, idpConfigID string) eventstore.Event {
+		return idpSessionChecked("user1", userAgentID, idpConfigID, "")
+	}
+	tests := []struct {
+		name   string
+		fields fields
+		args   args
+		res    res
+	}{
+		{
+			name: "idp config id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:    context.Background(),
+				userID: "user1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "userid and idp session id missing, invalid argument error",
+			fields: fields{
+				eventstore: expectEventstore(),
+			},
+			args: args{
+				ctx:         context.Background(),
+				idpConfigID: "config1",
+			},
+			res: res{
+				err: zerrors.IsErrorInvalidArgument,
+			},
+		},
+		{
+			name: "no session with idp, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						idpChecked("useragent1", "config2"),
+					),
+				),
+			},
+			args: args{
+				ctx:         context.Background(),
+				userID:      "user1",
+				idpConfigID: "config1",
+			},
+			res: res{},
+		},
+		{
+			name: "session already signed out, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						idpChecked("useragent1", "config1"),
+						eventFromEventPusher(
+							user.NewHumanSignedOutEvent(context.Background(),
+								&user.NewAggregate("user1", "org1").Aggregate,
+								"useragent1",
+								"",
+							),
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:         context.Background(),
+				userID:      "user1",
+				idpConfigID: "config1",
+			},
+			res: res{},
+		},
+		{
+			name: "sign out user agents of idp, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						idpChecked("useragent1", "config1"),
+						idpChecked("useragent2", "config1"),
+						idpChecked("useragent2", "config2"),
+						idpChecked("useragent3", "config1"),
+					),
+					expectPush(
+						user.NewHumanSignedOutEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"useragent1",
+							"",
+						),
+						user.NewHumanSignedOutEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"useragent3",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:         context.Background(),
+				userID:      "user1",
+				idpConfigID: "config1",
+			},
+			res: res{},
+		},
+		{
+			name: "sign out user agents of idp session, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						idpSessionChecked("user1", "useragent1", "config1", "sid1"),
+						idpSessionChecked("user1", "useragent2", "config1", "sid2"),
+					),
+					expectPush(
+						user.NewHumanSignedOutEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"useragent1",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:          context.Background(),
+				userID:       "user1",
+				idpConfigID:  "config1",
+				idpSessionID: "sid1",
+			},
+			res: res{},
+		},
+		{
+			name: "sign out user agents of idp session without user, ok",
+			fields: fields{
+				eventstore: expectEventstore(
+					expectFilter(
+						idpSessionChecked("user1", "useragent1", "config1", "sid1"),
+						idpSessionChecked("user2", "useragent2", "config1", "sid1"),
+					),
+					expectFilter(
+						idpSessionChecked("user1", "useragent1", "config1", "sid1"),
+						idpSessionChecked("user1", "useragent3", "config1", "sid3"),
+					),
+					expectFilter(
+						idpSessionChecked("user2", "useragent2", "config1", "sid1"),
+					),
+					expectPush(
+						user.NewHumanSignedOutEvent(context.Background(),
+							&user.NewAggregate("user1", "org1").Aggregate,
+							"useragent1",
+							"",
+						),
+						user.NewHumanSignedOutEvent(context.Background(),
+							&user.NewAggregate("user2", "org1").Aggregate,
+							"useragent2",
+							"",
+						),
+					),
+				),
+			},
+			args: args{
+				ctx:          context.Background(),
+				idpConfigID:  "config1",
+				idpSessionID: "sid1",
+			},
+			res: res{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Commands{
+				eventstore: tt.fields.eventstore(t),
+			}
+			err := r.UserIDPSignOut(tt.args.ctx, tt.args.userID, tt.args.idpConfigID, tt.args.idpSessionID)
+			if tt.res.err == nil {
+				assert.NoError(t, err)
+			}
+			if tt.res.err != nil && !tt.res.err(err) {
+				t.Errorf("got wrong err: %v ", err)
+			}
+		})
+	}
+}
+
 func TestCommandSide_MigrateUserIDP(t *testing.T) {
 	type fields struct {
 		eventstore func(t *testing.T) *eventstore.Eventstore
@@ -0,0 +1,16 @@
+package directorysync
+
+type Config struct {
+	// Enabled starts the scheduled synchronization of all LDAP identity providers.
+	Enabled bool
+	// Interval is the cron expression at which the synchronizations are scheduled.
+	Interval string
+	// MaxAttempts of each synchronization of an identity provider.
+	MaxAttempts uint8
+	// GroupsAttribute is the LDAP attribute of the user containing its groups, e.g. memberOf.
+	// If empty, group memberships are not synchronized.
+	GroupsAttribute string
+	// Deprovision deactivates linked users which are no longer found in the directory
+	// and reactivates them once they are found again.
+	Deprovision bool
+}
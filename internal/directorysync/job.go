@@ -0,0 +1,20 @@
+package directorysync
+
+const QueueName = "directory_sync"
+
+// ScheduleSyncs is the periodic job, which queues a [Sync] for the LDAP identity providers of all instances.
+type ScheduleSyncs struct{}
+
+func (*ScheduleSyncs) Kind() string {
+	return "directory_sync_schedule"
+}
+
+// Sync synchronizes the users of an LDAP identity provider.
+type Sync struct {
+	InstanceID string `json:"instanceID"`
+	IDPID      string `json:"idpID"`
+}
+
+func (*Sync) Kind() string {
+	return "directory_sync"
+}
@@ -0,0 +1,441 @@
+package directorysync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/riverqueue/river"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// maxSummaryErrors limits the errors stored in the summary of a synchronization.
+const maxSummaryErrors = 10
+
+// ErrEmptyDirectory is returned if the directory doesn't return any user.
+// This is most likely caused by a misconfiguration, therefore no user is deactivated.
+var ErrEmptyDirectory = errors.New("no users found in the directory")
+
+// Summary reports the changes of a synchronization of an identity provider.
+type Summary struct {
+	// DirectoryUsers is the amount of users found in the directory.
+	DirectoryUsers int `json:"directoryUsers"`
+	Created        int `json:"created"`
+	Updated        int `json:"updated"`
+	Deactivated    int `json:"deactivated"`
+	// Reactivated users were deactivated, but are found in the directory again.
+	Reactivated int `json:"reactivated"`
+	Unchanged   int `json:"unchanged"`
+	// Skipped users are found in the directory, but not created because auto creation is disabled on the identity provider.
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+	// Groups is the amount of groups of the organization, whose members were synchronized.
+	Groups int `json:"groups"`
+	// Errors of the first failed users.
+	Errors []string `json:"errors,omitempty"`
+}
+
+func (s *Summary) fail(externalUserID string, err error) {
+	s.Failed++
+	if len(s.Errors) < maxSummaryErrors {
+		s.Errors = append(s.Errors, fmt.Sprintf("%s: %v", externalUserID, err))
+	}
+}
+
+// syncPlan is the difference between the users of the directory and the users linked to the identity provider.
+type syncPlan struct {
+	create     []*ldap.DirectoryUser
+	update     []*linkedUser
+	deactivate []*query.IDPUserLink
+}
+
+type linkedUser struct {
+	link *query.IDPUserLink
+	user *ldap.DirectoryUser
+}
+
+func planSync(users []*ldap.DirectoryUser, links []*query.IDPUserLink) *syncPlan {
+	linksByExternalID := make(map[string]*query.IDPUserLink, len(links))
+	for _, link := range links {
+		linksByExternalID[link.ProvidedUserID] = link
+	}
+	plan := new(syncPlan)
+	found := make(map[string]bool, len(users))
+	for _, user := range users {
+		// users without id can't be linked and duplicates are only synchronized once
+		if user.GetID() == "" || found[user.GetID()] {
+			continue
+		}
+		found[user.GetID()] = true
+		if link, ok := linksByExternalID[user.GetID()]; ok {
+			plan.update = append(plan.update, &linkedUser{link: link, user: user})
+			continue
+		}
+		plan.create = append(plan.create, user)
+	}
+	for _, link := range links {
+		if !found[link.ProvidedUserID] {
+			plan.deactivate = append(plan.deactivate, link)
+		}
+	}
+	return plan
+}
+
+// sync creates, updates and deactivates the users linked to the identity provider according to the directory.
+// Users are only created if auto creation and only updated if auto update is enabled on the identity provider.
+// Users of the organization, which are members of a group with the same name as a group of the directory,
+// are added to or removed from it.
+func (w *Worker) sync(ctx context.Context, idpID string) (*Summary, error) {
+	template, err := w.queries.IDPTemplateByID(ctx, false, idpID, false, nil)
+	if zerrors.IsNotFound(err) {
+		// the identity provider was removed since the synchronization was queued
+		return nil, river.JobCancel(err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if template.Type != domain.IDPTypeLDAP || template.State != domain.IDPStateActive {
+		return nil, river.JobCancel(zerrors.ThrowPreconditionFailed(nil, "DSYNC-Wq3xv", "Errors.IDPConfig.DirectorySync.NotActiveLDAP"))
+	}
+	provider, err := w.commands.GetProvider(ctx, idpID, "", "")
+	if err != nil {
+		return nil, err
+	}
+	ldapProvider, ok := provider.(*ldap.Provider)
+	if !ok {
+		return nil, river.JobCancel(zerrors.ThrowPreconditionFailed(nil, "DSYNC-a8Tde", "Errors.IDPConfig.DirectorySync.NotActiveLDAP"))
+	}
+	resourceOwner := template.ResourceOwner
+	if template.OwnerType == domain.IdentityProviderTypeSystem {
+		resourceOwner = authz.GetInstance(ctx).DefaultOrganisationID()
+	}
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: SyncUserID, OrgID: resourceOwner})
+
+	directoryUsers, err := ldapProvider.SearchUsers(ctx, w.config.GroupsAttribute)
+	if err != nil {
+		return nil, err
+	}
+	if len(directoryUsers) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	idpQuery, err := query.NewIDPUserLinkIDPIDSearchQuery(idpID)
+	if err != nil {
+		return nil, err
+	}
+	links, err := w.queries.IDPUserLinks(ctx, &query.IDPUserLinksSearchQuery{Queries: []query.SearchQuery{idpQuery}}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := planSync(directoryUsers, links.Links)
+	summary := &Summary{DirectoryUsers: len(directoryUsers)}
+	// orgUserIDs maps the external user ids to the ids of the users of the organization
+	orgUserIDs := make(map[string]string, len(directoryUsers))
+	for _, user := range plan.create {
+		if !template.IsAutoCreation {
+			summary.Skipped++
+			continue
+		}
+		userID, err := w.createUser(ctx, resourceOwner, idpID, user)
+		if err != nil {
+			summary.fail(user.GetID(), err)
+			continue
+		}
+		orgUserIDs[user.GetID()] = userID
+		summary.Created++
+	}
+	for _, linked := range plan.update {
+		if linked.link.ResourceOwner == resourceOwner {
+			orgUserIDs[linked.user.GetID()] = linked.link.UserID
+		}
+		var reactivated bool
+		// only the deprovisioning manages the state of the users
+		if w.config.Deprovision {
+			reactivated, err = w.reactivateUser(ctx, linked.link)
+			if err != nil {
+				summary.fail(linked.user.GetID(), err)
+				continue
+			}
+			if reactivated {
+				summary.Reactivated++
+			}
+		}
+		var changed bool
+		if template.IsAutoUpdate {
+			changed, err = w.updateUser(ctx, linked)
+			if err != nil {
+				summary.fail(linked.user.GetID(), err)
+				continue
+			}
+		}
+		if changed {
+			summary.Updated++
+			continue
+		}
+		if !reactivated {
+			summary.Unchanged++
+		}
+	}
+	for _, link := range plan.deactivate {
+		if !w.config.Deprovision {
+			break
+		}
+		deactivated, err := w.deactivateUser(ctx, link)
+		if err != nil {
+			summary.fail(link.ProvidedUserID, err)
+			continue
+		}
+		if deactivated {
+			summary.Deactivated++
+		}
+	}
+
+	if w.config.GroupsAttribute == "" {
+		return summary, nil
+	}
+	managedUserIDs := make([]string, 0, len(links.Links)+summary.Created)
+	for _, link := range links.Links {
+		if link.ResourceOwner == resourceOwner {
+			managedUserIDs = append(managedUserIDs, link.UserID)
+		}
+	}
+	for _, user := range plan.create {
+		if userID, ok := orgUserIDs[user.GetID()]; ok {
+			managedUserIDs = append(managedUserIDs, userID)
+		}
+	}
+	return summary, w.syncGroups(ctx, resourceOwner, groupMembers(directoryUsers, orgUserIDs), managedUserIDs, summary)
+}
+
+func (w *Worker) createUser(ctx context.Context, resourceOwner, idpID string, user *ldap.DirectoryUser) (string, error) {
+	human := &command.AddHuman{
+		Username:          username(user.User),
+		FirstName:         user.FirstName,
+		LastName:          user.LastName,
+		NickName:          user.NickName,
+		DisplayName:       user.DisplayName,
+		Email:             command.Email{Address: user.Email, Verified: user.EmailVerified},
+		Phone:             command.Phone{Number: user.Phone, Verified: user.PhoneVerified},
+		PreferredLanguage: user.PreferredLanguage,
+		ExternalIDP:       true,
+		Links: []*command.AddLink{
+			{
+				IDPID:         idpID,
+				DisplayName:   user.PreferredUsername,
+				IDPExternalID: user.GetID(),
+			},
+		},
+	}
+	// synchronized users sign in through the directory and therefore don't need an initialization mail
+	if err := w.commands.AddHuman(ctx, resourceOwner, human, false); err != nil {
+		return "", err
+	}
+	return human.ID, nil
+}
+
+func username(user *ldap.User) string {
+	if user.PreferredUsername != "" {
+		return user.PreferredUsername
+	}
+	if user.Email != "" {
+		return string(user.Email)
+	}
+	return user.ID
+}
+
+func (w *Worker) updateUser(ctx context.Context, linked *linkedUser) (changed bool, err error) {
+	user, err := w.queries.GetUserByID(ctx, false, linked.link.UserID)
+	if err != nil {
+		return false, err
+	}
+	if user.Human == nil {
+		return false, zerrors.ThrowPreconditionFailed(nil, "DSYNC-t0Nmq", "Errors.User.NotHuman")
+	}
+	objectRoot := models.ObjectRoot{AggregateID: user.ID, ResourceOwner: user.ResourceOwner}
+	if profile, ok := changedProfile(user.Human, linked.user.User); ok {
+		profile.ObjectRoot = objectRoot
+		if _, err := w.commands.ChangeHumanProfile(ctx, profile); err != nil {
+			return false, err
+		}
+		changed = true
+	}
+	if emailChanged(user.Human, linked.user.User) {
+		emailCodeGenerator, err := w.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyEmailCode, w.userAlg)
+		if err != nil {
+			return changed, err
+		}
+		_, err = w.commands.ChangeHumanEmail(ctx,
+			&domain.Email{
+				ObjectRoot:      objectRoot,
+				EmailAddress:    linked.user.Email.Normalize(),
+				IsEmailVerified: linked.user.EmailVerified,
+			},
+			emailCodeGenerator,
+		)
+		if err != nil {
+			return changed, err
+		}
+		changed = true
+	}
+	phoneChanged, err := phoneChanged(user.Human, linked.user.User)
+	if err != nil || !phoneChanged {
+		return changed, err
+	}
+	phoneCodeGenerator, err := w.queries.InitEncryptionGenerator(ctx, domain.SecretGeneratorTypeVerifyPhoneCode, w.userAlg)
+	if err != nil {
+		return changed, err
+	}
+	_, err = w.commands.ChangeHumanPhone(ctx,
+		&domain.Phone{
+			ObjectRoot:      objectRoot,
+			PhoneNumber:     linked.user.Phone,
+			IsPhoneVerified: linked.user.PhoneVerified,
+		},
+		user.ResourceOwner,
+		phoneCodeGenerator,
+	)
+	if err != nil {
+		return changed, err
+	}
+	return true, nil
+}
+
+// changedProfile returns the profile with the values of the directory, if any of them changed.
+// Attributes which are empty in the directory keep their current value.
+func changedProfile(human *query.Human, user *ldap.User) (*domain.Profile, bool) {
+	profile := &domain.Profile{
+		FirstName:         valueOrCurrent(user.FirstName, human.FirstName),
+		LastName:          valueOrCurrent(user.LastName, human.LastName),
+		NickName:          valueOrCurrent(user.NickName, human.NickName),
+		DisplayName:       valueOrCurrent(user.DisplayName, human.DisplayName),
+		PreferredLanguage: human.PreferredLanguage,
+		Gender:            human.Gender,
+	}
+	if !user.PreferredLanguage.IsRoot() {
+		profile.PreferredLanguage = user.PreferredLanguage
+	}
+	changed := profile.FirstName != human.FirstName ||
+		profile.LastName != human.LastName ||
+		profile.NickName != human.NickName ||
+		profile.DisplayName != human.DisplayName ||
+		profile.PreferredLanguage != human.PreferredLanguage
+	return profile, changed
+}
+
+func valueOrCurrent(value, current string) string {
+	if value == "" {
+		return current
+	}
+	return value
+}
+
+func emailChanged(human *query.Human, user *ldap.User) bool {
+	email := user.Email.Normalize()
+	if email == "" {
+		return false
+	}
+	return email != human.Email || user.EmailVerified && !human.IsEmailVerified
+}
+
+func phoneChanged(human *query.Human, user *ldap.User) (bool, error) {
+	if user.Phone == "" {
+		return false, nil
+	}
+	phone, err := user.Phone.Normalize()
+	if err != nil {
+		return false, err
+	}
+	current, err := human.Phone.Normalize()
+	if err != nil {
+		// the user has no valid phone yet
+		return true, nil
+	}
+	return phone != current || user.PhoneVerified && !human.IsPhoneVerified, nil
+}
+
+// deactivateUser deactivates the user removed from the directory,
+// users which are not active or locked are left untouched.
+func (w *Worker) deactivateUser(ctx context.Context, link *query.IDPUserLink) (bool, error) {
+	user, err := w.queries.GetUserByID(ctx, false, link.UserID)
+	if zerrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if user.State != domain.UserStateActive && user.State != domain.UserStateLocked {
+		return false, nil
+	}
+	if _, err := w.commands.DeactivateUser(ctx, link.UserID, link.ResourceOwner); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// reactivateUser reactivates the deactivated user found in the directory again,
+// users in any other state are left untouched.
+func (w *Worker) reactivateUser(ctx context.Context, link *query.IDPUserLink) (bool, error) {
+	user, err := w.queries.GetUserByID(ctx, false, link.UserID)
+	if err != nil {
+		return false, err
+	}
+	if user.State != domain.UserStateInactive {
+		return false, nil
+	}
+	if _, err := w.commands.ReactivateUser(ctx, link.UserID, link.ResourceOwner); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// groupMembers returns the ids of the users of the organization by the names of their directory groups.
+func groupMembers(users []*ldap.DirectoryUser, orgUserIDs map[string]string) map[string][]string {
+	members := make(map[string][]string)
+	for _, user := range users {
+		userID, ok := orgUserIDs[user.GetID()]
+		if !ok {
+			continue
+		}
+		for _, group := range user.Groups {
+			if !slices.Contains(members[group], userID) {
+				members[group] = append(members[group], userID)
+			}
+		}
+	}
+	return members
+}
+
+// syncGroups sets the members of the groups of the organization, which have the same name as a group of the directory.
+// Only managed users, which are the users linked to the identity provider, are added or removed.
+func (w *Worker) syncGroups(ctx context.Context, resourceOwner string, members map[string][]string, managedUserIDs []string, summary *Summary) error {
+	resourceOwnerQuery, err := query.NewGroupResourceOwnerSearchQuery(resourceOwner)
+	if err != nil {
+		return err
+	}
+	groups, err := w.queries.SearchGroups(ctx, &query.GroupSearchQueries{Queries: []query.SearchQuery{resourceOwnerQuery}})
+	if err != nil {
+		return err
+	}
+	for _, group := range groups.Groups {
+		add, ok := members[group.Name]
+		if !ok {
+			continue
+		}
+		remove := slices.DeleteFunc(slices.Clone(managedUserIDs), func(userID string) bool {
+			return slices.Contains(add, userID)
+		})
+		if _, err := w.commands.SyncGroupMembers(ctx, group.ID, resourceOwner, add, remove); err != nil {
+			return err
+		}
+		summary.Groups++
+	}
+	return nil
+}
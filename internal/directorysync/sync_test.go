@@ -0,0 +1,246 @@
+package directorysync
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp/providers/ldap"
+	"github.com/zitadel/zitadel/internal/query"
+)
+
+func directoryUser(id string, groups ...string) *ldap.DirectoryUser {
+	return &ldap.DirectoryUser{
+		User:   &ldap.User{ID: id},
+		Groups: groups,
+	}
+}
+
+func Test_planSync(t *testing.T) {
+	linked := &query.IDPUserLink{UserID: "user1", ProvidedUserID: "ext1"}
+	removed := &query.IDPUserLink{UserID: "user2", ProvidedUserID: "ext2"}
+	existing := directoryUser("ext1")
+	added := directoryUser("ext3")
+
+	got := planSync(
+		[]*ldap.DirectoryUser{existing, added, directoryUser(""), directoryUser("ext3")},
+		[]*query.IDPUserLink{linked, removed},
+	)
+	assert.Equal(t, &syncPlan{
+		create:     []*ldap.DirectoryUser{added},
+		update:     []*linkedUser{{link: linked, user: existing}},
+		deactivate: []*query.IDPUserLink{removed},
+	}, got)
+}
+
+func Test_groupMembers(t *testing.T) {
+	got := groupMembers(
+		[]*ldap.DirectoryUser{
+			directoryUser("ext1", "admins", "developers"),
+			directoryUser("ext2", "developers", "developers"),
+			directoryUser("ext3", "admins"),
+		},
+		map[string]string{
+			"ext1": "user1",
+			"ext2": "user2",
+		},
+	)
+	assert.Equal(t, map[string][]string{
+		"admins":     {"user1"},
+		"developers": {"user1", "user2"},
+	}, got)
+}
+
+func Test_changedProfile(t *testing.T) {
+	human := &query.Human{
+		FirstName:         "first",
+		LastName:          "last",
+		NickName:          "nick",
+		DisplayName:       "first last",
+		PreferredLanguage: language.German,
+		Gender:            domain.GenderFemale,
+	}
+	tests := []struct {
+		name        string
+		user        *ldap.User
+		want        *domain.Profile
+		wantChanged bool
+	}{
+		{
+			name: "empty attributes keep current values",
+			user: &ldap.User{PreferredLanguage: language.Make("")},
+			want: &domain.Profile{
+				FirstName:         "first",
+				LastName:          "last",
+				NickName:          "nick",
+				DisplayName:       "first last",
+				PreferredLanguage: language.German,
+				Gender:            domain.GenderFemale,
+			},
+		},
+		{
+			name: "changed",
+			user: &ldap.User{FirstName: "first", LastName: "changed", PreferredLanguage: language.English},
+			want: &domain.Profile{
+				FirstName:         "first",
+				LastName:          "changed",
+				NickName:          "nick",
+				DisplayName:       "first last",
+				PreferredLanguage: language.English,
+				Gender:            domain.GenderFemale,
+			},
+			wantChanged: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := changedProfile(human, tt.user)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
+
+func Test_emailChanged(t *testing.T) {
+	human := &query.Human{Email: "email@example.com"}
+	tests := []struct {
+		name string
+		user *ldap.User
+		want bool
+	}{
+		{
+			name: "no email",
+			user: &ldap.User{},
+			want: false,
+		},
+		{
+			name: "same email",
+			user: &ldap.User{Email: " email@example.com "},
+			want: false,
+		},
+		{
+			name: "verified",
+			user: &ldap.User{Email: "email@example.com", EmailVerified: true},
+			want: true,
+		},
+		{
+			name: "changed email",
+			user: &ldap.User{Email: "other@example.com"},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, emailChanged(human, tt.user))
+		})
+	}
+}
+
+func Test_phoneChanged(t *testing.T) {
+	tests := []struct {
+		name    string
+		human   *query.Human
+		user    *ldap.User
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:  "no phone",
+			human: &query.Human{Phone: "+41791234567"},
+			user:  &ldap.User{},
+			want:  false,
+		},
+		{
+			name:  "same phone",
+			human: &query.Human{Phone: "+41791234567"},
+			user:  &ldap.User{Phone: "+41 79 123 45 67"},
+			want:  false,
+		},
+		{
+			name:  "no current phone",
+			human: &query.Human{},
+			user:  &ldap.User{Phone: "+41791234567"},
+			want:  true,
+		},
+		{
+			name:    "invalid phone",
+			human:   &query.Human{},
+			user:    &ldap.User{Phone: "invalid"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := phoneChanged(tt.human, tt.user)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+type userQueries struct {
+	Queries
+	user *query.User
+}
+
+func (q *userQueries) GetUserByID(context.Context, bool, string) (*query.User, error) {
+	return q.user, nil
+}
+
+type reactivateCommands struct {
+	Commands
+	reactivated []string
+}
+
+func (c *reactivateCommands) ReactivateUser(_ context.Context, userID, _ string) (*domain.ObjectDetails, error) {
+	c.reactivated = append(c.reactivated, userID)
+	return &domain.ObjectDetails{}, nil
+}
+
+func TestWorker_reactivateUser(t *testing.T) {
+	tests := []struct {
+		name  string
+		state domain.UserState
+		want  bool
+	}{
+		{
+			name:  "inactive",
+			state: domain.UserStateInactive,
+			want:  true,
+		},
+		{
+			name:  "active",
+			state: domain.UserStateActive,
+			want:  false,
+		},
+		{
+			name:  "locked",
+			state: domain.UserStateLocked,
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			commands := new(reactivateCommands)
+			w := &Worker{
+				queries:  &userQueries{user: &query.User{ID: "user1", State: tt.state}},
+				commands: commands,
+			}
+			got, err := w.reactivateUser(context.Background(), &query.IDPUserLink{UserID: "user1", ResourceOwner: "org1"})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			if tt.want {
+				assert.Equal(t, []string{"user1"}, commands.reactivated)
+				return
+			}
+			assert.Empty(t, commands.reactivated)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package directorysync
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	defaultSyncsLimit = 10
+	maxSyncsLimit     = 100
+)
+
+type SyncState int
+
+const (
+	SyncStateUnspecified SyncState = iota
+	SyncStatePending
+	SyncStateRunning
+	SyncStateSucceeded
+	SyncStateFailed
+)
+
+// SyncResult is a queued, running or finished synchronization of an identity provider.
+type SyncResult struct {
+	ID         int64
+	IDPID      string
+	State      SyncState
+	CreatedAt  time.Time
+	FinishedAt time.Time
+	// Summary is set as soon as the users were synchronized.
+	Summary *Summary
+	// Error of the last attempt of a failed synchronization.
+	Error string
+}
+
+// Trigger queues the synchronization of the identity provider of the instance in the context.
+func Trigger(ctx context.Context, idpID string) error {
+	if syncs == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "DSYNC-Qk2vu", "Errors.IDPConfig.DirectorySync.Disabled")
+	}
+	if err := insertSync(ctx, syncs, syncConfig, idpID); err != nil {
+		return zerrors.ThrowInternal(err, "DSYNC-b7Rfe", "Errors.Internal")
+	}
+	return nil
+}
+
+// ListSyncs returns the latest synchronizations of the identity provider of the instance in the context.
+func ListSyncs(ctx context.Context, idpID string, limit int) ([]*SyncResult, error) {
+	if syncs == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "DSYNC-Z1hnS", "Errors.IDPConfig.DirectorySync.Disabled")
+	}
+	if limit <= 0 {
+		limit = defaultSyncsLimit
+	}
+	limit = min(limit, maxSyncsLimit)
+
+	// marshalling a map of strings can't fail
+	metadataFilter, _ := json.Marshal(map[string]string{
+		syncInstanceIDMetadata: authz.GetInstance(ctx).InstanceID(),
+		syncIDPIDMetadata:      idpID,
+	})
+	params := river.NewJobListParams().
+		Kinds((*Sync)(nil).Kind()).
+		Metadata(string(metadataFilter)).
+		OrderBy(river.JobListOrderByID, river.SortOrderDesc).
+		First(limit)
+	result, err := syncs.ListJobs(ctx, params)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "DSYNC-iG4qa", "Errors.Internal")
+	}
+	results := make([]*SyncResult, 0, len(result.Jobs))
+	for _, job := range result.Jobs {
+		syncResult, err := syncFromJob(job)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "DSYNC-6Wnxe", "Errors.Internal")
+		}
+		results = append(results, syncResult)
+	}
+	return results, nil
+}
+
+func syncStateFromJob(state rivertype.JobState) SyncState {
+	switch state {
+	case rivertype.JobStateAvailable,
+		rivertype.JobStatePending,
+		rivertype.JobStateScheduled,
+		rivertype.JobStateRetryable:
+		return SyncStatePending
+	case rivertype.JobStateRunning:
+		return SyncStateRunning
+	case rivertype.JobStateCompleted:
+		return SyncStateSucceeded
+	case rivertype.JobStateCancelled,
+		rivertype.JobStateDiscarded:
+		return SyncStateFailed
+	default:
+		return SyncStateUnspecified
+	}
+}
+
+// syncFromJob returns the result of the synchronization job.
+func syncFromJob(job *rivertype.JobRow) (*SyncResult, error) {
+	args := new(Sync)
+	if err := json.Unmarshal(job.EncodedArgs, args); err != nil {
+		return nil, err
+	}
+	result := &SyncResult{
+		ID:        job.ID,
+		IDPID:     args.IDPID,
+		State:     syncStateFromJob(job.State),
+		CreatedAt: job.CreatedAt,
+	}
+	if job.FinalizedAt != nil {
+		result.FinishedAt = *job.FinalizedAt
+	}
+	if output := job.Output(); len(output) > 0 {
+		result.Summary = new(Summary)
+		if err := json.Unmarshal(output, result.Summary); err != nil {
+			return nil, err
+		}
+	}
+	if result.State == SyncStateFailed && len(job.Errors) > 0 {
+		result.Error = job.Errors[len(job.Errors)-1].Error
+	}
+	return result, nil
+}
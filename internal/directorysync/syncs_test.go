@@ -0,0 +1,90 @@
+package directorysync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_syncFromJob(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	finalizedAt := createdAt.Add(time.Minute)
+	tests := []struct {
+		name string
+		job  *rivertype.JobRow
+		want *SyncResult
+	}{
+		{
+			name: "pending",
+			job: &rivertype.JobRow{
+				ID:          1,
+				EncodedArgs: []byte(`{"instanceID":"instance1","idpID":"idp1"}`),
+				State:       rivertype.JobStateAvailable,
+				CreatedAt:   createdAt,
+			},
+			want: &SyncResult{
+				ID:        1,
+				IDPID:     "idp1",
+				State:     SyncStatePending,
+				CreatedAt: createdAt,
+			},
+		},
+		{
+			name: "succeeded",
+			job: &rivertype.JobRow{
+				ID:          2,
+				EncodedArgs: []byte(`{"instanceID":"instance1","idpID":"idp1"}`),
+				State:       rivertype.JobStateCompleted,
+				CreatedAt:   createdAt,
+				FinalizedAt: &finalizedAt,
+				Metadata:    []byte(`{"output":{"directoryUsers":3,"created":1,"unchanged":1,"failed":1,"errors":["ext3: invalid"]}}`),
+			},
+			want: &SyncResult{
+				ID:         2,
+				IDPID:      "idp1",
+				State:      SyncStateSucceeded,
+				CreatedAt:  createdAt,
+				FinishedAt: finalizedAt,
+				Summary: &Summary{
+					DirectoryUsers: 3,
+					Created:        1,
+					Unchanged:      1,
+					Failed:         1,
+					Errors:         []string{"ext3: invalid"},
+				},
+			},
+		},
+		{
+			name: "failed",
+			job: &rivertype.JobRow{
+				ID:          3,
+				EncodedArgs: []byte(`{"instanceID":"instance1","idpID":"idp1"}`),
+				State:       rivertype.JobStateDiscarded,
+				CreatedAt:   createdAt,
+				FinalizedAt: &finalizedAt,
+				Errors: []rivertype.AttemptError{
+					{Attempt: 1, Error: "first"},
+					{Attempt: 2, Error: "no users found in the directory"},
+				},
+			},
+			want: &SyncResult{
+				ID:         3,
+				IDPID:      "idp1",
+				State:      SyncStateFailed,
+				CreatedAt:  createdAt,
+				FinishedAt: finalizedAt,
+				Error:      "no users found in the directory",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := syncFromJob(tt.job)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
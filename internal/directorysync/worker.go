@@ -0,0 +1,205 @@
+package directorysync
+
+import (
+	"context"
+
+	"github.com/riverqueue/river"
+	"github.com/robfig/cron/v3"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/command"
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// SyncUserID is the editor of the changes made by the synchronization.
+const SyncUserID = "DIRECTORY_SYNC"
+
+const (
+	syncInstanceIDMetadata = "instance_id"
+	syncIDPIDMetadata      = "idp_id"
+)
+
+var (
+	_ river.Worker[*Sync]          = (*Worker)(nil)
+	_ river.Worker[*ScheduleSyncs] = (*scheduleWorker)(nil)
+)
+
+type Queries interface {
+	SearchInstances(ctx context.Context, queries *query.InstanceSearchQueries) (*query.Instances, error)
+	InstanceByID(ctx context.Context, id string) (authz.Instance, error)
+	IDPTemplates(ctx context.Context, queries *query.IDPTemplateSearchQueries, withOwnerRemoved bool) (*query.IDPTemplates, error)
+	IDPTemplateByID(ctx context.Context, shouldTriggerBulk bool, id string, withOwnerRemoved bool, permissionCheck domain.PermissionCheck, queries ...query.SearchQuery) (*query.IDPTemplate, error)
+	IDPUserLinks(ctx context.Context, queries *query.IDPUserLinksSearchQuery, permissionCheck domain.PermissionCheck) (*query.IDPUserLinks, error)
+	GetUserByID(ctx context.Context, shouldTriggerBulk bool, userID string) (*query.User, error)
+	SearchGroups(ctx context.Context, queries *query.GroupSearchQueries) (*query.Groups, error)
+	InitEncryptionGenerator(ctx context.Context, generatorType domain.SecretGeneratorType, algorithm crypto.EncryptionAlgorithm) (crypto.Generator, error)
+}
+
+type Commands interface {
+	GetProvider(ctx context.Context, idpID string, idpCallback string, samlRootURL string) (idp.Provider, error)
+	AddHuman(ctx context.Context, resourceOwner string, human *command.AddHuman, allowInitMail bool) error
+	ChangeHumanProfile(ctx context.Context, profile *domain.Profile) (*domain.Profile, error)
+	ChangeHumanEmail(ctx context.Context, email *domain.Email, emailCodeGenerator crypto.Generator) (*domain.Email, error)
+	ChangeHumanPhone(ctx context.Context, phone *domain.Phone, resourceOwner string, phoneCodeGenerator crypto.Generator) (*domain.Phone, error)
+	DeactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	ReactivateUser(ctx context.Context, userID, resourceOwner string) (*domain.ObjectDetails, error)
+	SyncGroupMembers(ctx context.Context, groupID, resourceOwner string, addUserIDs, removeUserIDs []string) (*domain.ObjectDetails, error)
+}
+
+// Queue stores the synchronizations and allows to inspect them.
+type Queue interface {
+	Insert(ctx context.Context, args river.JobArgs, opts ...queue.InsertOpt) error
+	ListJobs(ctx context.Context, params *river.JobListParams) (*river.JobListResult, error)
+}
+
+// syncs and syncConfig are set by [Register].
+// Without them, synchronizations can't be triggered nor listed.
+var (
+	syncs      Queue
+	syncConfig *Config
+)
+
+// Worker synchronizes the users of an LDAP identity provider.
+type Worker struct {
+	river.WorkerDefaults[*Sync]
+
+	config   *Config
+	queries  Queries
+	commands Commands
+	userAlg  crypto.EncryptionAlgorithm
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *Worker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker[*Sync](workers, w)
+	queues[QueueName] = river.QueueConfig{
+		MaxWorkers: 1, // the synchronizations run one after the other to limit the load on the directories
+	}
+}
+
+// Work implements the [river.Worker] interface.
+func (w *Worker) Work(ctx context.Context, job *river.Job[*Sync]) error {
+	instance, err := w.queries.InstanceByID(ctx, job.Args.InstanceID)
+	if err != nil {
+		return err
+	}
+	ctx = authz.WithInstance(ctx, instance)
+
+	summary, err := w.sync(ctx, job.Args.IDPID)
+	if summary != nil {
+		recordErr := river.RecordOutput(ctx, summary)
+		logging.WithFields("instance", job.Args.InstanceID, "idp", job.Args.IDPID).OnError(recordErr).Error("unable to record summary of directory sync")
+	}
+	return err
+}
+
+// scheduleWorker queues the synchronization of all LDAP identity providers.
+type scheduleWorker struct {
+	river.WorkerDefaults[*ScheduleSyncs]
+
+	config  *Config
+	queries Queries
+	queue   Queue
+}
+
+// Register implements the [queue.Worker] interface.
+func (w *scheduleWorker) Register(workers *river.Workers, _ map[string]river.QueueConfig) {
+	river.AddWorker[*ScheduleSyncs](workers, w)
+}
+
+// Work implements the [river.Worker] interface.
+func (w *scheduleWorker) Work(ctx context.Context, _ *river.Job[*ScheduleSyncs]) error {
+	instances, err := w.queries.SearchInstances(ctx, &query.InstanceSearchQueries{})
+	if err != nil {
+		return err
+	}
+	typeQuery, err := query.NewIDPTemplateTypeSearchQuery(domain.IDPTypeLDAP)
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances.Instances {
+		instanceCtx := authz.WithInstanceID(ctx, instance.ID)
+		idps, err := w.queries.IDPTemplates(instanceCtx, &query.IDPTemplateSearchQueries{Queries: []query.SearchQuery{typeQuery}}, false)
+		if err != nil {
+			return err
+		}
+		for _, template := range idps.Templates {
+			if template.State != domain.IDPStateActive {
+				continue
+			}
+			if err := insertSync(instanceCtx, w.queue, w.config, template.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func insertSync(ctx context.Context, q Queue, config *Config, idpID string) error {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	return q.Insert(ctx,
+		&Sync{
+			InstanceID: instanceID,
+			IDPID:      idpID,
+		},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+		queue.WithMetadata(map[string]string{
+			syncInstanceIDMetadata: instanceID,
+			syncIDPIDMetadata:      idpID,
+		}),
+	)
+}
+
+// Register adds the workers of the directory synchronization to the queue.
+func Register(
+	q *queue.Queue,
+	queries *query.Queries,
+	commands *command.Commands,
+	userAlg crypto.EncryptionAlgorithm,
+	config *Config,
+) {
+	if !config.Enabled {
+		return
+	}
+	q.ShouldStart()
+	q.AddWorkers(
+		&Worker{
+			config:   config,
+			queries:  queries,
+			commands: commands,
+			userAlg:  userAlg,
+		},
+		&scheduleWorker{
+			config:  config,
+			queries: queries,
+			queue:   q,
+		},
+	)
+	syncs = q
+	syncConfig = config
+}
+
+// Start schedules the synchronizations, the queue must already be started.
+func Start(config *Config, q *queue.Queue) error {
+	if !config.Enabled {
+		return nil
+	}
+	schedule, err := cron.ParseStandard(config.Interval)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "DSYNC-m3Yq1", "invalid interval")
+	}
+	q.AddPeriodicJob(
+		schedule,
+		&ScheduleSyncs{},
+		queue.WithQueueName(QueueName),
+		queue.WithMaxAttempts(config.MaxAttempts),
+	)
+	return nil
+}
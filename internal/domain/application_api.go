@@ -15,6 +15,9 @@ type APIApp struct {
 	ClientSecretString string
 	AuthMethodType     APIAuthMethodType
 
+	TLSClientAuthSubjectDN    string
+	TLSClientAuthPublicKeyPin string
+
 	State AppState
 }
 
@@ -31,12 +34,29 @@ type APIAuthMethodType int32
 const (
 	APIAuthMethodTypeBasic APIAuthMethodType = iota
 	APIAuthMethodTypePrivateKeyJWT
+	APIAuthMethodTypeTLSClientAuth
+	APIAuthMethodTypeSelfSignedTLSClientAuth
 )
 
+// IsTLSClientAuth returns true for the mutual TLS client authentication methods (RFC 8705).
+func (m APIAuthMethodType) IsTLSClientAuth() bool {
+	return m == APIAuthMethodTypeTLSClientAuth || m == APIAuthMethodTypeSelfSignedTLSClientAuth
+}
+
 func (a *APIApp) IsValid() bool {
 	return a.AppName != ""
 }
 
+// TLSClientAuthValid checks that the certificate required by the mutual TLS authentication methods is registered.
+func (a *APIApp) TLSClientAuthValid() bool {
+	return TLSClientAuthValid(
+		a.AuthMethodType == APIAuthMethodTypeTLSClientAuth,
+		a.AuthMethodType == APIAuthMethodTypeSelfSignedTLSClientAuth,
+		a.TLSClientAuthSubjectDN,
+		a.TLSClientAuthPublicKeyPin,
+	)
+}
+
 func (a *APIApp) setClientID(clientID string) {
 	a.ClientID = clientID
 }
@@ -50,7 +70,7 @@ func (a *APIApp) requiresClientSecret() bool {
 }
 
 func (a *APIApp) GenerateClientSecretIfNeeded(generator *crypto.HashGenerator) (plain string, err error) {
-	if a.AuthMethodType == APIAuthMethodTypePrivateKeyJWT {
+	if !a.requiresClientSecret() {
 		return "", nil
 	}
 	a.EncodedHash, plain, err = generator.NewCode()
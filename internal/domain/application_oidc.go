@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/muhlemmer/gu"
+
 	http_util "github.com/zitadel/zitadel/internal/api/http"
 	"github.com/zitadel/zitadel/internal/eventstore/v1/models"
 )
@@ -25,32 +27,39 @@ const (
 type OIDCApp struct {
 	models.ObjectRoot
 
-	AppID                    string
-	AppName                  string
-	ClientID                 string
-	EncodedHash              string
-	ClientSecretString       string
-	RedirectUris             []string
-	ResponseTypes            []OIDCResponseType
-	GrantTypes               []OIDCGrantType
-	ApplicationType          *OIDCApplicationType
-	AuthMethodType           *OIDCAuthMethodType
-	PostLogoutRedirectUris   []string
-	OIDCVersion              *OIDCVersion
-	Compliance               *Compliance
-	DevMode                  *bool
-	AccessTokenType          *OIDCTokenType
-	AccessTokenRoleAssertion *bool
-	IDTokenRoleAssertion     *bool
-	IDTokenUserinfoAssertion *bool
-	ClockSkew                *time.Duration
-	AdditionalOrigins        []string
-	SkipNativeAppSuccessPage *bool
-	BackChannelLogoutURI     *string
-	LoginVersion             *LoginVersion
-	LoginBaseURI             *string
-
-	State AppState
+	AppID                                 string
+	AppName                               string
+	ClientID                              string
+	EncodedHash                           string
+	ClientSecretString                    string
+	RedirectUris                          []string
+	ResponseTypes                         []OIDCResponseType
+	GrantTypes                            []OIDCGrantType
+	ApplicationType                       *OIDCApplicationType
+	AuthMethodType                        *OIDCAuthMethodType
+	PostLogoutRedirectUris                []string
+	OIDCVersion                           *OIDCVersion
+	Compliance                            *Compliance
+	DevMode                               *bool
+	AccessTokenType                       *OIDCTokenType
+	AccessTokenRoleAssertion              *bool
+	IDTokenRoleAssertion                  *bool
+	IDTokenUserinfoAssertion              *bool
+	ClockSkew                             *time.Duration
+	AdditionalOrigins                     []string
+	SkipNativeAppSuccessPage              *bool
+	BackChannelLogoutURI                  *string
+	LoginVersion                          *LoginVersion
+	LoginBaseURI                          *string
+	RequirePushedAuthorizationRequests    *bool
+	RequireDPoP                           *bool
+	TLSClientAuthSubjectDN                *string
+	TLSClientAuthPublicKeyPin             *string
+	BackChannelClientNotificationEndpoint *string
+	AccessTokenLifetime                   *time.Duration
+	IDTokenLifetime                       *time.Duration
+	RefreshTokenLifetime                  *time.Duration
+	State                                 AppState
 }
 
 func (a *OIDCApp) GetApplicationName() string {
@@ -106,6 +115,7 @@ const (
 	OIDCGrantTypeRefreshToken
 	OIDCGrantTypeDeviceCode
 	OIDCGrantTypeTokenExchange
+	OIDCGrantTypeCIBA
 )
 
 type OIDCApplicationType int32
@@ -123,8 +133,15 @@ const (
 	OIDCAuthMethodTypePost
 	OIDCAuthMethodTypeNone
 	OIDCAuthMethodTypePrivateKeyJWT
+	OIDCAuthMethodTypeTLSClientAuth
+	OIDCAuthMethodTypeSelfSignedTLSClientAuth
 )
 
+// IsTLSClientAuth returns true for the mutual TLS client authentication methods (RFC 8705).
+func (m OIDCAuthMethodType) IsTLSClientAuth() bool {
+	return m == OIDCAuthMethodTypeTLSClientAuth || m == OIDCAuthMethodTypeSelfSignedTLSClientAuth
+}
+
 type Compliance struct {
 	NoneCompliant bool
 	Problems      []string
@@ -141,6 +158,9 @@ func (a *OIDCApp) IsValid() bool {
 	if (a.ClockSkew != nil && (*a.ClockSkew > time.Second*5 || *a.ClockSkew < time.Second*0)) || !a.OriginsValid() {
 		return false
 	}
+	if gu.Value(a.AccessTokenLifetime) < 0 || gu.Value(a.IDTokenLifetime) < 0 || gu.Value(a.RefreshTokenLifetime) < 0 {
+		return false
+	}
 	grantTypes := a.getRequiredGrantTypes()
 	if len(grantTypes) == 0 {
 		return false
@@ -163,6 +183,19 @@ func (a *OIDCApp) OriginsValid() bool {
 	return true
 }
 
+// TLSClientAuthValid checks that the certificate required by the mutual TLS authentication methods is registered.
+func (a *OIDCApp) TLSClientAuthValid() bool {
+	if a.AuthMethodType == nil {
+		return true
+	}
+	return TLSClientAuthValid(
+		*a.AuthMethodType == OIDCAuthMethodTypeTLSClientAuth,
+		*a.AuthMethodType == OIDCAuthMethodTypeSelfSignedTLSClientAuth,
+		gu.Value(a.TLSClientAuthSubjectDN),
+		gu.Value(a.TLSClientAuthPublicKeyPin),
+	)
+}
+
 func ContainsRequiredGrantTypes(responseTypes []OIDCResponseType, grantTypes []OIDCGrantType) bool {
 	required := RequiredOIDCGrantTypes(responseTypes, grantTypes)
 	return ContainsOIDCGrantTypes(required, grantTypes)
@@ -208,6 +241,12 @@ func containsOIDCGrantType(grantTypes []OIDCGrantType, grantType OIDCGrantType)
 	return slices.Contains(grantTypes, grantType)
 }
 
+// containsRedirectlessGrantType returns true if the grant types contain the device code or CIBA grant,
+// where the user authenticates on a different device and no redirect to the client happens.
+func containsRedirectlessGrantType(grantTypes []OIDCGrantType) bool {
+	return containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || containsOIDCGrantType(grantTypes, OIDCGrantTypeCIBA)
+}
+
 func (a *OIDCApp) FillCompliance() {
 	a.Compliance = GetOIDCCompliance(a.OIDCVersion, a.ApplicationType, a.GrantTypes, a.ResponseTypes, a.AuthMethodType, a.RedirectUris)
 }
@@ -237,7 +276,7 @@ func GetOIDCV1Compliance(appType *OIDCApplicationType, grantTypes []OIDCGrantTyp
 }
 
 func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantType) {
-	if !containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
+	if !containsRedirectlessGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeRefreshToken) && !containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append(compliance.Problems, "Application.OIDC.V1.GrantType.Refresh.NoAuthCode")
 	}
@@ -245,7 +284,7 @@ func checkGrantTypesCombination(compliance *Compliance, grantTypes []OIDCGrantTy
 
 func checkRedirectURIs(compliance *Compliance, grantTypes []OIDCGrantType, appType *OIDCApplicationType, redirectUris []string) {
 	// See #5684 for OIDCGrantTypeDeviceCode and redirectUris further explanation
-	if len(redirectUris) == 0 && (!containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) || (containsOIDCGrantType(grantTypes, OIDCGrantTypeDeviceCode) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
+	if len(redirectUris) == 0 && (!containsRedirectlessGrantType(grantTypes) || (containsRedirectlessGrantType(grantTypes) && containsOIDCGrantType(grantTypes, OIDCGrantTypeAuthorizationCode))) {
 		compliance.NoneCompliant = true
 		compliance.Problems = append([]string{"Application.OIDC.V1.NoRedirectUris"}, compliance.Problems...)
 	}
@@ -15,9 +15,164 @@ type SAMLApp struct {
 	LoginVersion *LoginVersion
 	LoginBaseURI *string
 
+	SignatureAlgorithm  *SAMLSignatureAlgorithm
+	DigestAlgorithm     *SAMLDigestAlgorithm
+	AssertionEncryption *SAMLAssertionEncryption
+
+	// NameIDFormat is the format of the NameID in the assertions.
+	// If nil, the login name of the user is sent with the emailAddress format.
+	NameIDFormat     *SAMLNameIDFormat
+	AttributeMapping []*SAMLAttributeMapping
+
 	State AppState
 }
 
+// SAMLSignatureAlgorithm is the algorithm used to sign the assertions of a SAML application.
+// If unspecified, the algorithm configured for the instance is used.
+type SAMLSignatureAlgorithm int32
+
+const (
+	SAMLSignatureAlgorithmUnspecified SAMLSignatureAlgorithm = iota
+	SAMLSignatureAlgorithmRSASHA256
+	SAMLSignatureAlgorithmRSASHA512
+)
+
+func (a SAMLSignatureAlgorithm) Valid() bool {
+	return a >= SAMLSignatureAlgorithmUnspecified && a <= SAMLSignatureAlgorithmRSASHA512
+}
+
+// URI returns the XML signature identifier of the algorithm or an empty string if unspecified.
+func (a SAMLSignatureAlgorithm) URI() string {
+	switch a {
+	case SAMLSignatureAlgorithmRSASHA256:
+		return "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	case SAMLSignatureAlgorithmRSASHA512:
+		return "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	case SAMLSignatureAlgorithmUnspecified:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+// SAMLDigestAlgorithm is the algorithm used for the digest of the signed assertions of a SAML application.
+// If unspecified, SHA-256 is used.
+type SAMLDigestAlgorithm int32
+
+const (
+	SAMLDigestAlgorithmUnspecified SAMLDigestAlgorithm = iota
+	SAMLDigestAlgorithmSHA256
+	SAMLDigestAlgorithmSHA512
+)
+
+func (a SAMLDigestAlgorithm) Valid() bool {
+	return a >= SAMLDigestAlgorithmUnspecified && a <= SAMLDigestAlgorithmSHA512
+}
+
+// URI returns the XML encryption identifier of the algorithm or an empty string if unspecified.
+func (a SAMLDigestAlgorithm) URI() string {
+	switch a {
+	case SAMLDigestAlgorithmSHA256:
+		return "http://www.w3.org/2001/04/xmlenc#sha256"
+	case SAMLDigestAlgorithmSHA512:
+		return "http://www.w3.org/2001/04/xmlenc#sha512"
+	case SAMLDigestAlgorithmUnspecified:
+		fallthrough
+	default:
+		return ""
+	}
+}
+
+// SAMLAssertionEncryption defines if and with which block cipher the assertions of a SAML application are encrypted.
+// The key is transported using RSA-OAEP with the encryption certificate of the service provider.
+type SAMLAssertionEncryption int32
+
+const (
+	SAMLAssertionEncryptionNone SAMLAssertionEncryption = iota
+	SAMLAssertionEncryptionAES128GCM
+	SAMLAssertionEncryptionAES256GCM
+)
+
+func (e SAMLAssertionEncryption) Valid() bool {
+	return e >= SAMLAssertionEncryptionNone && e <= SAMLAssertionEncryptionAES256GCM
+}
+
+// SAMLAttributeMapping maps a value of the user to an attribute in the assertions of a SAML application.
+type SAMLAttributeMapping struct {
+	Name       string              `json:"name,omitempty"`
+	NameFormat string              `json:"nameFormat,omitempty"`
+	Source     SAMLAttributeSource `json:"source,omitempty"`
+	// Key is the field of the user for [SAMLAttributeSourceUserField]
+	// and the metadata key for [SAMLAttributeSourceMetadata].
+	Key string `json:"key,omitempty"`
+}
+
+func (m *SAMLAttributeMapping) IsValid() bool {
+	if m == nil || m.Name == "" {
+		return false
+	}
+	switch m.Source {
+	case SAMLAttributeSourceUserField:
+		return SAMLUserField(m.Key).Valid()
+	case SAMLAttributeSourceMetadata:
+		return m.Key != ""
+	case SAMLAttributeSourceRoles:
+		return m.Key == ""
+	case SAMLAttributeSourceUnspecified:
+		fallthrough
+	default:
+		return false
+	}
+}
+
+type SAMLAttributeSource int32
+
+const (
+	SAMLAttributeSourceUnspecified SAMLAttributeSource = iota
+	// SAMLAttributeSourceUserField maps a field of the user, see [SAMLUserField]
+	SAMLAttributeSourceUserField
+	// SAMLAttributeSourceMetadata maps the value of a metadata of the user
+	SAMLAttributeSourceMetadata
+	// SAMLAttributeSourceRoles maps the roles the user is granted on the project of the application
+	SAMLAttributeSourceRoles
+)
+
+// SAMLUserField is a field of the user which can be mapped to a SAML attribute.
+type SAMLUserField string
+
+const (
+	SAMLUserFieldID                 SAMLUserField = "id"
+	SAMLUserFieldUsername           SAMLUserField = "username"
+	SAMLUserFieldPreferredLoginName SAMLUserField = "preferred_login_name"
+	SAMLUserFieldEmail              SAMLUserField = "email"
+	SAMLUserFieldFirstName          SAMLUserField = "first_name"
+	SAMLUserFieldLastName           SAMLUserField = "last_name"
+	SAMLUserFieldDisplayName        SAMLUserField = "display_name"
+	SAMLUserFieldNickName           SAMLUserField = "nick_name"
+	SAMLUserFieldPhone              SAMLUserField = "phone"
+	SAMLUserFieldPreferredLanguage  SAMLUserField = "preferred_language"
+	SAMLUserFieldResourceOwner      SAMLUserField = "resource_owner"
+)
+
+func (f SAMLUserField) Valid() bool {
+	switch f {
+	case SAMLUserFieldID,
+		SAMLUserFieldUsername,
+		SAMLUserFieldPreferredLoginName,
+		SAMLUserFieldEmail,
+		SAMLUserFieldFirstName,
+		SAMLUserFieldLastName,
+		SAMLUserFieldDisplayName,
+		SAMLUserFieldNickName,
+		SAMLUserFieldPhone,
+		SAMLUserFieldPreferredLanguage,
+		SAMLUserFieldResourceOwner:
+		return true
+	default:
+		return false
+	}
+}
+
 func (a *SAMLApp) GetApplicationName() string {
 	return a.AppName
 }
@@ -41,5 +196,21 @@ func (a *SAMLApp) IsValid() bool {
 	if (a.MetadataURL == nil || *a.MetadataURL == "") && a.Metadata == nil {
 		return false
 	}
+	if (a.SignatureAlgorithm != nil && !a.SignatureAlgorithm.Valid()) ||
+		(a.DigestAlgorithm != nil && !a.DigestAlgorithm.Valid()) ||
+		(a.AssertionEncryption != nil && !a.AssertionEncryption.Valid()) ||
+		(a.NameIDFormat != nil && *a.NameIDFormat > SAMLNameIDFormatTransient) {
+		return false
+	}
+	names := make(map[string]struct{}, len(a.AttributeMapping))
+	for _, mapping := range a.AttributeMapping {
+		if !mapping.IsValid() {
+			return false
+		}
+		if _, ok := names[mapping.Name]; ok {
+			return false
+		}
+		names[mapping.Name] = struct{}{}
+	}
 	return true
 }
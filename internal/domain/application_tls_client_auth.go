@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"strings"
+)
+
+// TLSClientAuthValid checks the registration of a client using mutual TLS client authentication (RFC 8705, section 2).
+// The PKI method (tls_client_auth) requires the subject distinguished name of the certificate,
+// the self-signed method (self_signed_tls_client_auth) requires the pin of the certificate's public key.
+func TLSClientAuthValid(tlsClientAuth, selfSignedTLSClientAuth bool, subjectDN, publicKeyPin string) bool {
+	switch {
+	case tlsClientAuth:
+		return strings.TrimSpace(subjectDN) != ""
+	case selfSignedTLSClientAuth:
+		return IsTLSClientAuthPublicKeyPin(publicKeyPin)
+	default:
+		return true
+	}
+}
+
+// TLSClientAuthPublicKeyPin returns the pin of the certificate's public key,
+// which is the standard base64 encoded SHA-256 hash of the DER encoded SubjectPublicKeyInfo.
+func TLSClientAuthPublicKeyPin(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// IsTLSClientAuthPublicKeyPin checks that the pin is a base64 encoded SHA-256 hash.
+func IsTLSClientAuthPublicKeyPin(pin string) bool {
+	hash, err := base64.StdEncoding.DecodeString(pin)
+	return err == nil && len(hash) == sha256.Size
+}
+
+// TLSClientAuthCertificateMatches checks that the client certificate matches the registered subject distinguished name
+// (compared in its RFC 4514 string representation) or public key pin, depending on the method.
+// The certificate chain must already have been verified by the TLS terminating ingress for the PKI method.
+func TLSClientAuthCertificateMatches(cert *x509.Certificate, selfSigned bool, subjectDN, publicKeyPin string) bool {
+	if cert == nil {
+		return false
+	}
+	if selfSigned {
+		return publicKeyPin != "" && TLSClientAuthPublicKeyPin(cert) == publicKeyPin
+	}
+	return subjectDN != "" && strings.EqualFold(cert.Subject.String(), strings.TrimSpace(subjectDN))
+}
+
+// TLSClientCertificateThumbprint returns the base64url encoded SHA-256 thumbprint of the DER encoded certificate,
+// which certificate-bound tokens are bound to in the x5t#S256 confirmation method (RFC 8705, section 3.1).
+func TLSClientCertificateThumbprint(cert *x509.Certificate) string {
+	hash := sha256.Sum256(cert.Raw)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTLSClientCertificate(t *testing.T, commonName string) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"ZITADEL"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestTLSClientAuthValid(t *testing.T) {
+	cert := createTLSClientCertificate(t, "client")
+	tests := []struct {
+		name                    string
+		tlsClientAuth           bool
+		selfSignedTLSClientAuth bool
+		subjectDN               string
+		publicKeyPin            string
+		want                    bool
+	}{
+		{
+			name: "other method",
+			want: true,
+		},
+		{
+			name:          "tls client auth without subject dn",
+			tlsClientAuth: true,
+			subjectDN:     " ",
+			want:          false,
+		},
+		{
+			name:          "tls client auth",
+			tlsClientAuth: true,
+			subjectDN:     "CN=client,O=ZITADEL",
+			want:          true,
+		},
+		{
+			name:                    "self signed tls client auth invalid pin",
+			selfSignedTLSClientAuth: true,
+			publicKeyPin:            "pin",
+			want:                    false,
+		},
+		{
+			name:                    "self signed tls client auth",
+			selfSignedTLSClientAuth: true,
+			publicKeyPin:            TLSClientAuthPublicKeyPin(cert),
+			want:                    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TLSClientAuthValid(tt.tlsClientAuth, tt.selfSignedTLSClientAuth, tt.subjectDN, tt.publicKeyPin)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestTLSClientAuthCertificateMatches(t *testing.T) {
+	cert := createTLSClientCertificate(t, "client")
+	otherCert := createTLSClientCertificate(t, "other")
+	tests := []struct {
+		name         string
+		cert         *x509.Certificate
+		selfSigned   bool
+		subjectDN    string
+		publicKeyPin string
+		want         bool
+	}{
+		{
+			name:      "no certificate",
+			subjectDN: "CN=client,O=ZITADEL",
+			want:      false,
+		},
+		{
+			name:      "subject dn mismatch",
+			cert:      otherCert,
+			subjectDN: "CN=client,O=ZITADEL",
+			want:      false,
+		},
+		{
+			name:      "subject dn match",
+			cert:      cert,
+			subjectDN: "cn=client,o=ZITADEL",
+			want:      true,
+		},
+		{
+			name:         "public key pin mismatch",
+			cert:         otherCert,
+			selfSigned:   true,
+			publicKeyPin: TLSClientAuthPublicKeyPin(cert),
+			want:         false,
+		},
+		{
+			name:         "public key pin match",
+			cert:         cert,
+			selfSigned:   true,
+			publicKeyPin: TLSClientAuthPublicKeyPin(cert),
+			want:         true,
+		},
+		{
+			name:       "self signed ignores subject dn",
+			cert:       cert,
+			selfSigned: true,
+			subjectDN:  "CN=client,O=ZITADEL",
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := TLSClientAuthCertificateMatches(tt.cert, tt.selfSigned, tt.subjectDN, tt.publicKeyPin)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
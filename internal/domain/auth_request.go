@@ -44,6 +44,7 @@ type AuthRequest struct {
 	PrivateLabelingSetting   PrivateLabelingSetting
 	SelectedIDPConfigID      string
 	SelectedIDPConfigArgs    map[string]any
+	SelectedIDPIDToken       string
 	LinkingUsers             []*ExternalUser
 	PossibleSteps            []NextStep `json:"-"`
 	PasswordVerified         bool
@@ -104,6 +105,10 @@ type ExternalUser struct {
 	Phone             PhoneNumber
 	IsPhoneVerified   bool
 	Metadatas         []*Metadata
+	// Groups provided by the identity provider, used to synchronize the user grants based on its group mapping
+	Groups []string
+	// IDToken issued by the identity provider, used for the federated logout
+	IDToken string
 }
 
 type Prompt int32
@@ -177,6 +182,8 @@ const (
 	AuthRequestStateCodeExchanged
 	AuthRequestStateFailed
 	AuthRequestStateSucceeded
+	AuthRequestStatePushed
+	AuthRequestStatePushedConsumed
 )
 
 func NewAuthRequestFromType(requestType AuthRequestType) (*AuthRequest, error) {
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"encoding/json"
+	"reflect"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// AuthorizationDetails are the fine-grained authorization requirements of a client
+// as defined in RFC 9396 (OAuth 2.0 Rich Authorization Requests).
+type AuthorizationDetails []AuthorizationDetail
+
+// AuthorizationDetail is a single object of the authorization_details parameter.
+// Besides the required type, it can contain any (type specific) fields,
+// which are persisted and returned as provided by the client.
+type AuthorizationDetail map[string]any
+
+const authorizationDetailTypeField = "type"
+
+// Type returns the type of the authorization detail or an empty string if it is not set.
+func (d AuthorizationDetail) Type() string {
+	detailType, _ := d[authorizationDetailTypeField].(string)
+	return detailType
+}
+
+// ParseAuthorizationDetails parses the JSON encoded authorization_details parameter.
+// An empty parameter results in no authorization details.
+// Each authorization detail must contain a type (RFC 9396, section 2).
+func ParseAuthorizationDetails(data string) (AuthorizationDetails, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var details AuthorizationDetails
+	if err := json.Unmarshal([]byte(data), &details); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "DOMAIN-Iex4e", "Errors.AuthorizationDetails.Invalid")
+	}
+	if len(details) == 0 {
+		return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-quo1E", "Errors.AuthorizationDetails.Invalid")
+	}
+	for _, detail := range details {
+		if detail.Type() == "" {
+			return nil, zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahm0a", "Errors.AuthorizationDetails.TypeMissing")
+		}
+	}
+	return details, nil
+}
+
+// Types returns the distinct types of the authorization details.
+func (d AuthorizationDetails) Types() []string {
+	types := make([]string, 0, len(d))
+	for _, detail := range d {
+		if !slices.Contains(types, detail.Type()) {
+			types = append(types, detail.Type())
+		}
+	}
+	return types
+}
+
+// Contains checks if every of the provided authorization details
+// is also part of (equal to one of) the authorization details.
+func (d AuthorizationDetails) Contains(details AuthorizationDetails) bool {
+	for _, detail := range details {
+		if !slices.ContainsFunc(d, func(granted AuthorizationDetail) bool {
+			return reflect.DeepEqual(granted, detail)
+		}) {
+			return false
+		}
+	}
+	return true
+}
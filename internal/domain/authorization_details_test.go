@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestParseAuthorizationDetails(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    AuthorizationDetails
+		wantErr error
+	}{
+		{
+			name: "empty",
+			data: "",
+			want: nil,
+		},
+		{
+			name:    "invalid json",
+			data:    `{"type":"payment_initiation"}`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Iex4e", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "empty array",
+			data:    `[]`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-quo1E", "Errors.AuthorizationDetails.Invalid"),
+		},
+		{
+			name:    "missing type",
+			data:    `[{"actions":["initiate"]}]`,
+			wantErr: zerrors.ThrowInvalidArgument(nil, "DOMAIN-Ahm0a", "Errors.AuthorizationDetails.TypeMissing"),
+		},
+		{
+			name: "ok",
+			data: `[{"type":"payment_initiation","actions":["initiate"],"instructedAmount":{"currency":"EUR","amount":"123.50"}},{"type":"account_information"}]`,
+			want: AuthorizationDetails{
+				{
+					"type":    "payment_initiation",
+					"actions": []any{"initiate"},
+					"instructedAmount": map[string]any{
+						"currency": "EUR",
+						"amount":   "123.50",
+					},
+				},
+				{
+					"type": "account_information",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAuthorizationDetails(tt.data)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthorizationDetails_Types(t *testing.T) {
+	details := AuthorizationDetails{
+		{"type": "payment_initiation", "actions": []any{"initiate"}},
+		{"type": "account_information"},
+		{"type": "payment_initiation", "actions": []any{"status"}},
+	}
+	assert.Equal(t, []string{"payment_initiation", "account_information"}, details.Types())
+}
+
+func TestAuthorizationDetails_Contains(t *testing.T) {
+	granted := AuthorizationDetails{
+		{"type": "payment_initiation", "actions": []any{"initiate"}},
+		{"type": "account_information"},
+	}
+	tests := []struct {
+		name    string
+		details AuthorizationDetails
+		want    bool
+	}{
+		{
+			name:    "empty",
+			details: nil,
+			want:    true,
+		},
+		{
+			name: "subset",
+			details: AuthorizationDetails{
+				{"type": "account_information"},
+			},
+			want: true,
+		},
+		{
+			name: "equal",
+			details: AuthorizationDetails{
+				{"type": "account_information"},
+				{"type": "payment_initiation", "actions": []any{"initiate"}},
+			},
+			want: true,
+		},
+		{
+			name: "different fields",
+			details: AuthorizationDetails{
+				{"type": "payment_initiation", "actions": []any{"initiate", "cancel"}},
+			},
+			want: false,
+		},
+		{
+			name: "different type",
+			details: AuthorizationDetails{
+				{"type": "customer_information"},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, granted.Contains(tt.details))
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// BackChannelAuthState describes the step the
+// client initiated backchannel authentication (CIBA) process is in.
+// We generate the Stringer implementation for prettier
+// log output.
+//
+//go:generate stringer -type=BackChannelAuthState -linecomment
+type BackChannelAuthState uint
+
+const (
+	BackChannelAuthStateUndefined BackChannelAuthState = iota // undefined
+	BackChannelAuthStateInitiated                             // initiated
+	BackChannelAuthStateApproved                              // approved
+	BackChannelAuthStateDenied                                // denied
+	BackChannelAuthStateExpired                               // expired
+	BackChannelAuthStateDone                                  // done
+
+	backChannelAuthStateCount // invalid
+)
+
+// Exists returns true when not Undefined and
+// any status lower than backChannelAuthStateCount.
+func (s BackChannelAuthState) Exists() bool {
+	return s > BackChannelAuthStateUndefined && s < backChannelAuthStateCount
+}
+
+func (s BackChannelAuthState) GoString() string {
+	return strconv.Itoa(int(s))
+}
+
+// BackChannelAuthCanceled is a subset of BackChannelAuthState, allowed to
+// be used in the backchannelauth.CanceledEvent.
+type BackChannelAuthCanceled string
+
+const (
+	BackChannelAuthCanceledDenied  BackChannelAuthCanceled = "denied"
+	BackChannelAuthCanceledExpired BackChannelAuthCanceled = "expired"
+)
+
+func (c BackChannelAuthCanceled) State() BackChannelAuthState {
+	switch c {
+	case BackChannelAuthCanceledDenied:
+		return BackChannelAuthStateDenied
+	case BackChannelAuthCanceledExpired:
+		return BackChannelAuthStateExpired
+	default:
+		return BackChannelAuthStateUndefined
+	}
+}
+
+// BackChannelTokenDeliveryMode is the mode in which the client
+// is informed about the result of the backchannel authentication.
+type BackChannelTokenDeliveryMode string
+
+const (
+	// BackChannelTokenDeliveryModePoll lets the client poll the token endpoint.
+	BackChannelTokenDeliveryModePoll BackChannelTokenDeliveryMode = "poll"
+	// BackChannelTokenDeliveryModePing calls the client notification endpoint,
+	// after which the client fetches the tokens from the token endpoint.
+	BackChannelTokenDeliveryModePing BackChannelTokenDeliveryMode = "ping"
+)
+
+// BackChannelTokenDeliveryModeFromEndpoint returns the ping mode
+// if the client registered a notification endpoint and poll otherwise.
+func BackChannelTokenDeliveryModeFromEndpoint(clientNotificationEndpoint string) BackChannelTokenDeliveryMode {
+	if clientNotificationEndpoint != "" {
+		return BackChannelTokenDeliveryModePing
+	}
+	return BackChannelTokenDeliveryModePoll
+}
+
+// BackChannelAuthRequest is a pending backchannel authentication request,
+// which needs to be approved or denied by the user.
+type BackChannelAuthRequest struct {
+	AuthReqID      string
+	ClientID       string
+	UserID         string
+	Scopes         []string
+	BindingMessage string
+	Expires        time.Time
+	CreationDate   time.Time
+	AppName        string
+	ProjectName    string
+}
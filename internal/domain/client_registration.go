@@ -0,0 +1,180 @@
+package domain
+
+import (
+	"net"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+type InitialAccessTokenState int32
+
+const (
+	InitialAccessTokenStateUnspecified InitialAccessTokenState = iota
+	InitialAccessTokenStateActive
+	InitialAccessTokenStateRemoved
+)
+
+func (s InitialAccessTokenState) Exists() bool {
+	return s != InitialAccessTokenStateUnspecified && s != InitialAccessTokenStateRemoved
+}
+
+// ClientRegistrationPolicy restricts the metadata of the OIDC clients
+// registered dynamically (RFC 7591) with an initial access token.
+type ClientRegistrationPolicy struct {
+	// AllowedGrantTypes restricts the grant types of the client.
+	// All grant types are allowed if empty.
+	AllowedGrantTypes []OIDCGrantType
+	// RedirectURIPatterns restricts the redirect and post logout redirect URIs of the client
+	// to URIs matching at least one of the patterns, e.g. https://*.example.com/callback.
+	// The scheme, host and port must be equal, except for host labels of the pattern consisting of a single wildcard,
+	// which match any label. The path is matched using the syntax of [path.Match].
+	// URIs with user info, query or fragment are never allowed.
+	// All redirect URIs are allowed if empty.
+	// The back-channel logout URI and client notification endpoint must match the patterns as well,
+	// they are not allowed if empty, as ZITADEL sends requests to them.
+	RedirectURIPatterns []string
+	// RegistrationAccessTokenLifetime is the lifetime of the registration access token (RFC 7592)
+	// the client uses to manage its registration.
+	// The token does not expire if 0.
+	RegistrationAccessTokenLifetime time.Duration
+	// MaxAccessTokenLifetime, MaxIDTokenLifetime and MaxRefreshTokenLifetime limit the token lifetimes the client may request.
+	// A client not requesting a lifetime is limited to the maximum.
+	// The lifetimes of the instance's OIDC settings apply if 0.
+	MaxAccessTokenLifetime  time.Duration
+	MaxIDTokenLifetime      time.Duration
+	MaxRefreshTokenLifetime time.Duration
+}
+
+// IsValid checks the syntax of the redirect URI patterns and the lifetimes.
+func (p *ClientRegistrationPolicy) IsValid() bool {
+	if p.RegistrationAccessTokenLifetime < 0 || p.MaxAccessTokenLifetime < 0 || p.MaxIDTokenLifetime < 0 || p.MaxRefreshTokenLifetime < 0 {
+		return false
+	}
+	for _, pattern := range p.RedirectURIPatterns {
+		parsed, ok := parseRedirectURI(pattern)
+		if !ok {
+			return false
+		}
+		if _, err := path.Match(parsed.Path, ""); err != nil {
+			return false
+		}
+		for _, label := range strings.Split(parsed.Hostname(), ".") {
+			if label != "*" && strings.Contains(label, "*") {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// GrantTypesAllowed checks that all grant types are allowed by the policy.
+func (p *ClientRegistrationPolicy) GrantTypesAllowed(grantTypes []OIDCGrantType) bool {
+	if len(p.AllowedGrantTypes) == 0 {
+		return true
+	}
+	for _, grantType := range grantTypes {
+		if !slices.Contains(p.AllowedGrantTypes, grantType) {
+			return false
+		}
+	}
+	return true
+}
+
+// RedirectURIsAllowed checks that all URIs match at least one of the patterns of the policy.
+func (p *ClientRegistrationPolicy) RedirectURIsAllowed(uris []string) bool {
+	if len(p.RedirectURIPatterns) == 0 {
+		return true
+	}
+	for _, uri := range uris {
+		parsed, ok := parseRedirectURI(uri)
+		if !ok {
+			return false
+		}
+		if !slices.ContainsFunc(p.RedirectURIPatterns, func(pattern string) bool {
+			return redirectURIMatches(pattern, parsed)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// BackChannelURIsAllowed checks that all URIs ZITADEL sends requests to match at least one of the patterns of the policy.
+// Unlike redirect URIs, they are never allowed without patterns, so the registered clients can't make ZITADEL call arbitrary hosts.
+func (p *ClientRegistrationPolicy) BackChannelURIsAllowed(uris ...string) bool {
+	uris = slices.DeleteFunc(slices.Clone(uris), func(uri string) bool { return uri == "" })
+	if len(uris) == 0 {
+		return true
+	}
+	return len(p.RedirectURIPatterns) > 0 && p.RedirectURIsAllowed(uris)
+}
+
+// LimitTokenLifetimes checks the token lifetimes requested by the client against the maximum lifetimes of the policy
+// and sets the lifetimes not requested to the maximum.
+func (p *ClientRegistrationPolicy) LimitTokenLifetimes(app *OIDCApp) bool {
+	return limitTokenLifetime(&app.AccessTokenLifetime, p.MaxAccessTokenLifetime) &&
+		limitTokenLifetime(&app.IDTokenLifetime, p.MaxIDTokenLifetime) &&
+		limitTokenLifetime(&app.RefreshTokenLifetime, p.MaxRefreshTokenLifetime)
+}
+
+func limitTokenLifetime(lifetime **time.Duration, maxLifetime time.Duration) bool {
+	if maxLifetime == 0 {
+		return true
+	}
+	if *lifetime == nil || **lifetime == 0 {
+		*lifetime = &maxLifetime
+		return true
+	}
+	return **lifetime <= maxLifetime
+}
+
+// parseRedirectURI parses an absolute URI without user info, query and fragment,
+// so they cannot be used to bypass the matching of the host and path.
+func parseRedirectURI(uri string) (*url.URL, bool) {
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.Opaque != "" ||
+		parsed.User != nil || parsed.RawQuery != "" || parsed.ForceQuery || parsed.Fragment != "" || strings.Contains(uri, "#") {
+		return nil, false
+	}
+	for _, segment := range strings.Split(parsed.Path, "/") {
+		if segment == "." || segment == ".." {
+			return nil, false
+		}
+	}
+	return parsed, true
+}
+
+// redirectURIMatches checks the scheme, host, port and path of the URI separately against the pattern.
+func redirectURIMatches(pattern string, uri *url.URL) bool {
+	parsedPattern, ok := parseRedirectURI(pattern)
+	if !ok || parsedPattern.Scheme != uri.Scheme || parsedPattern.Port() != uri.Port() {
+		return false
+	}
+	if !hostMatches(parsedPattern.Hostname(), uri.Hostname()) {
+		return false
+	}
+	matched, err := path.Match(parsedPattern.EscapedPath(), uri.EscapedPath())
+	return err == nil && matched
+}
+
+// hostMatches compares the host labels, where a wildcard label of the pattern matches any label.
+// IP addresses must be equal.
+func hostMatches(pattern, host string) bool {
+	if net.ParseIP(host) != nil {
+		return pattern == host
+	}
+	patternLabels := strings.Split(pattern, ".")
+	hostLabels := strings.Split(host, ".")
+	if len(patternLabels) != len(hostLabels) {
+		return false
+	}
+	for i, label := range patternLabels {
+		if hostLabels[i] == "" || (label != "*" && !strings.EqualFold(label, hostLabels[i])) {
+			return false
+		}
+	}
+	return true
+}
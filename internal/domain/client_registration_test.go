@@ -0,0 +1,276 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/muhlemmer/gu"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientRegistrationPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *ClientRegistrationPolicy
+		want   bool
+	}{
+		{
+			name:   "empty",
+			policy: &ClientRegistrationPolicy{},
+			want:   true,
+		},
+		{
+			name: "valid",
+			policy: &ClientRegistrationPolicy{
+				RedirectURIPatterns:             []string{"https://*.example.com/callback"},
+				RegistrationAccessTokenLifetime: time.Hour,
+			},
+			want: true,
+		},
+		{
+			name: "invalid pattern",
+			policy: &ClientRegistrationPolicy{
+				RedirectURIPatterns: []string{"https://[.example.com"},
+			},
+			want: false,
+		},
+		{
+			name: "wildcard in host label",
+			policy: &ClientRegistrationPolicy{
+				RedirectURIPatterns: []string{"https://app*.example.com/callback"},
+			},
+			want: false,
+		},
+		{
+			name: "pattern with query",
+			policy: &ClientRegistrationPolicy{
+				RedirectURIPatterns: []string{"https://*.example.com/callback?*"},
+			},
+			want: false,
+		},
+		{
+			name: "negative lifetime",
+			policy: &ClientRegistrationPolicy{
+				RegistrationAccessTokenLifetime: -time.Hour,
+			},
+			want: false,
+		},
+		{
+			name: "negative max token lifetime",
+			policy: &ClientRegistrationPolicy{
+				MaxRefreshTokenLifetime: -time.Hour,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsValid())
+		})
+	}
+}
+
+func TestClientRegistrationPolicy_GrantTypesAllowed(t *testing.T) {
+	tests := []struct {
+		name       string
+		allowed    []OIDCGrantType
+		grantTypes []OIDCGrantType
+		want       bool
+	}{
+		{
+			name:       "no restriction",
+			grantTypes: []OIDCGrantType{OIDCGrantTypeImplicit},
+			want:       true,
+		},
+		{
+			name:       "allowed",
+			allowed:    []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeRefreshToken},
+			grantTypes: []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeRefreshToken},
+			want:       true,
+		},
+		{
+			name:       "not allowed",
+			allowed:    []OIDCGrantType{OIDCGrantTypeAuthorizationCode},
+			grantTypes: []OIDCGrantType{OIDCGrantTypeAuthorizationCode, OIDCGrantTypeImplicit},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ClientRegistrationPolicy{AllowedGrantTypes: tt.allowed}
+			assert.Equal(t, tt.want, p.GrantTypesAllowed(tt.grantTypes))
+		})
+	}
+}
+
+func TestClientRegistrationPolicy_RedirectURIsAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		uris     []string
+		want     bool
+	}{
+		{
+			name: "no restriction",
+			uris: []string{"https://evil.com/callback"},
+			want: true,
+		},
+		{
+			name:     "matching",
+			patterns: []string{"https://*.example.com/callback", "http://localhost:8080/*"},
+			uris:     []string{"https://APP.example.com/callback", "http://localhost:8080/callback"},
+			want:     true,
+		},
+		{
+			name:     "other port",
+			patterns: []string{"http://localhost:8080/*"},
+			uris:     []string{"http://localhost:9090/callback"},
+			want:     false,
+		},
+		{
+			name:     "wildcard matches single host label only",
+			patterns: []string{"https://*.example.com/callback"},
+			uris:     []string{"https://evil.com.example.com/callback"},
+			want:     false,
+		},
+		{
+			name:     "query bypass",
+			patterns: []string{"https://*.example.com/*"},
+			uris:     []string{"https://evil.com/?.example.com/callback"},
+			want:     false,
+		},
+		{
+			name:     "userinfo bypass",
+			patterns: []string{"https://*/callback"},
+			uris:     []string{"https://app.example.com@evil.com/callback"},
+			want:     false,
+		},
+		{
+			name:     "fragment",
+			patterns: []string{"https://*.example.com/*"},
+			uris:     []string{"https://app.example.com/callback#fragment"},
+			want:     false,
+		},
+		{
+			name:     "dot segments",
+			patterns: []string{"https://app.example.com/callback/*"},
+			uris:     []string{"https://app.example.com/callback/../admin"},
+			want:     false,
+		},
+		{
+			name:     "not matching",
+			patterns: []string{"https://*.example.com/callback"},
+			uris:     []string{"https://app.example.com/callback", "https://evil.com/callback"},
+			want:     false,
+		},
+		{
+			name:     "no wildcard over path separator",
+			patterns: []string{"https://*/callback"},
+			uris:     []string{"https://evil.com/example.com/callback"},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ClientRegistrationPolicy{RedirectURIPatterns: tt.patterns}
+			assert.Equal(t, tt.want, p.RedirectURIsAllowed(tt.uris))
+		})
+	}
+}
+
+func TestClientRegistrationPolicy_BackChannelURIsAllowed(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		uris     []string
+		want     bool
+	}{
+		{
+			name: "no uris",
+			uris: []string{"", ""},
+			want: true,
+		},
+		{
+			name: "no patterns",
+			uris: []string{"https://internal.local/logout", ""},
+			want: false,
+		},
+		{
+			name:     "matching",
+			patterns: []string{"https://*.example.com/*"},
+			uris:     []string{"https://app.example.com/logout", "https://app.example.com/notify"},
+			want:     true,
+		},
+		{
+			name:     "not matching",
+			patterns: []string{"https://*.example.com/*"},
+			uris:     []string{"https://app.example.com/logout", "http://169.254.169.254/latest"},
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &ClientRegistrationPolicy{RedirectURIPatterns: tt.patterns}
+			assert.Equal(t, tt.want, p.BackChannelURIsAllowed(tt.uris...))
+		})
+	}
+}
+
+func TestClientRegistrationPolicy_LimitTokenLifetimes(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  *ClientRegistrationPolicy
+		app     *OIDCApp
+		want    bool
+		wantApp *OIDCApp
+	}{
+		{
+			name:    "no limits",
+			policy:  &ClientRegistrationPolicy{},
+			app:     &OIDCApp{AccessTokenLifetime: gu.Ptr(24 * time.Hour)},
+			want:    true,
+			wantApp: &OIDCApp{AccessTokenLifetime: gu.Ptr(24 * time.Hour)},
+		},
+		{
+			name: "not requested, maximum",
+			policy: &ClientRegistrationPolicy{
+				MaxAccessTokenLifetime:  time.Hour,
+				MaxIDTokenLifetime:      time.Hour,
+				MaxRefreshTokenLifetime: 24 * time.Hour,
+			},
+			app:  &OIDCApp{},
+			want: true,
+			wantApp: &OIDCApp{
+				AccessTokenLifetime:  gu.Ptr(time.Hour),
+				IDTokenLifetime:      gu.Ptr(time.Hour),
+				RefreshTokenLifetime: gu.Ptr(24 * time.Hour),
+			},
+		},
+		{
+			name: "within limits",
+			policy: &ClientRegistrationPolicy{
+				MaxAccessTokenLifetime: time.Hour,
+			},
+			app:     &OIDCApp{AccessTokenLifetime: gu.Ptr(time.Minute)},
+			want:    true,
+			wantApp: &OIDCApp{AccessTokenLifetime: gu.Ptr(time.Minute)},
+		},
+		{
+			name: "exceeding limit",
+			policy: &ClientRegistrationPolicy{
+				MaxRefreshTokenLifetime: 24 * time.Hour,
+			},
+			app:  &OIDCApp{RefreshTokenLifetime: gu.Ptr(48 * time.Hour)},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.policy.LimitTokenLifetimes(tt.app)
+			assert.Equal(t, tt.want, got)
+			if tt.want {
+				assert.Equal(t, tt.wantApp, tt.app)
+			}
+		})
+	}
+}
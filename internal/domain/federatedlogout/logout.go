@@ -14,7 +14,9 @@ type FederatedLogout struct {
 	IDPID                 string
 	UserID                string
 	PostLogoutRedirectURI string
-	State                 State
+	// IDTokenHint is the id_token issued by the identity provider, sent on the OIDC RP-Initiated Logout.
+	IDTokenHint string
+	State       State
 }
 
 // Keys implements cache.Entry
@@ -0,0 +1,19 @@
+package domain
+
+type GroupState int32
+
+const (
+	GroupStateUnspecified GroupState = iota
+	GroupStateActive
+	GroupStateRemoved
+
+	groupStateCount
+)
+
+func (s GroupState) Valid() bool {
+	return s >= 0 && s < groupStateCount
+}
+
+func (s GroupState) Exists() bool {
+	return s != GroupStateUnspecified && s != GroupStateRemoved
+}
@@ -38,6 +38,10 @@ const (
 	IDPTypeGoogle
 	IDPTypeApple
 	IDPTypeSAML
+	IDPTypeOkta
+	IDPTypeAuth0
+	IDPTypeKeycloak
+	IDPTypeEntraExternalID
 )
 
 func (t IDPType) GetCSSClass() string {
@@ -59,7 +63,11 @@ func (t IDPType) GetCSSClass() string {
 		IDPTypeJWT,
 		IDPTypeOAuth,
 		IDPTypeLDAP,
-		IDPTypeSAML:
+		IDPTypeSAML,
+		IDPTypeOkta,
+		IDPTypeAuth0,
+		IDPTypeKeycloak,
+		IDPTypeEntraExternalID:
 		fallthrough
 	default:
 		return ""
@@ -93,7 +101,11 @@ func (t IDPType) DisplayName() string {
 		IDPTypeAzureAD,
 		IDPTypeGitHubEnterprise,
 		IDPTypeGitLabSelfHosted,
-		IDPTypeSAML:
+		IDPTypeSAML,
+		IDPTypeOkta,
+		IDPTypeAuth0,
+		IDPTypeKeycloak,
+		IDPTypeEntraExternalID:
 		fallthrough
 	default:
 		// we should never get here, so log it
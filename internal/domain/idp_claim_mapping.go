@@ -0,0 +1,81 @@
+package domain
+
+import "maps"
+
+// IDPClaimMapping maps the claims of a generic OAuth or OIDC identity provider
+// onto the user information using JSONPath expressions, e.g. `$.realm_access.roles[0]`.
+// Empty expressions keep the default mapping of the provider.
+type IDPClaimMapping struct {
+	ID                string `json:"id,omitempty"`
+	FirstName         string `json:"firstName,omitempty"`
+	LastName          string `json:"lastName,omitempty"`
+	DisplayName       string `json:"displayName,omitempty"`
+	NickName          string `json:"nickName,omitempty"`
+	PreferredUsername string `json:"preferredUsername,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     string `json:"emailVerified,omitempty"`
+	Phone             string `json:"phone,omitempty"`
+	PhoneVerified     string `json:"phoneVerified,omitempty"`
+	PreferredLanguage string `json:"preferredLanguage,omitempty"`
+	AvatarURL         string `json:"avatarURL,omitempty"`
+	Profile           string `json:"profile,omitempty"`
+	// Metadata maps the metadata keys of the user to their expressions.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Expressions returns all expressions of the mapping, which are set.
+func (m *IDPClaimMapping) Expressions() []string {
+	if m == nil {
+		return nil
+	}
+	expressions := make([]string, 0, 13+len(m.Metadata))
+	for _, expression := range []string{
+		m.ID,
+		m.FirstName,
+		m.LastName,
+		m.DisplayName,
+		m.NickName,
+		m.PreferredUsername,
+		m.Email,
+		m.EmailVerified,
+		m.Phone,
+		m.PhoneVerified,
+		m.PreferredLanguage,
+		m.AvatarURL,
+		m.Profile,
+	} {
+		if expression != "" {
+			expressions = append(expressions, expression)
+		}
+	}
+	for _, expression := range m.Metadata {
+		expressions = append(expressions, expression)
+	}
+	return expressions
+}
+
+// IsZero returns true if no expression is set.
+func (m *IDPClaimMapping) IsZero() bool {
+	return len(m.Expressions()) == 0
+}
+
+// Equal returns true if both mappings contain the same expressions.
+func (m *IDPClaimMapping) Equal(other *IDPClaimMapping) bool {
+	if m.IsZero() || other.IsZero() {
+		return m.IsZero() == other.IsZero()
+	}
+	return m.ID == other.ID &&
+		m.FirstName == other.FirstName &&
+		m.LastName == other.LastName &&
+		m.DisplayName == other.DisplayName &&
+		m.NickName == other.NickName &&
+		m.PreferredUsername == other.PreferredUsername &&
+		m.Email == other.Email &&
+		m.EmailVerified == other.EmailVerified &&
+		m.Phone == other.Phone &&
+		m.PhoneVerified == other.PhoneVerified &&
+		m.PreferredLanguage == other.PreferredLanguage &&
+		m.AvatarURL == other.AvatarURL &&
+		m.Profile == other.Profile &&
+		maps.Equal(m.Metadata, other.Metadata)
+}
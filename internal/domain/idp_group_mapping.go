@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"slices"
+)
+
+// IDPGroupMapping maps the groups (or roles) provided by an identity provider onto project roles.
+// The resulting user grants are synchronized on every login of the user.
+type IDPGroupMapping struct {
+	// Claim is the JSONPath expression to the groups in the raw information of the user,
+	// e.g. `$.groups` or `$.attributes['http://schemas.microsoft.com/ws/2008/06/identity/claims/groups']`.
+	Claim    string                 `json:"claim,omitempty"`
+	Mappings []*IDPGroupRoleMapping `json:"mappings,omitempty"`
+}
+
+// IDPGroupRoleMapping grants the roles of the project to users, which are a member of the group.
+type IDPGroupRoleMapping struct {
+	Group     string   `json:"group,omitempty"`
+	ProjectID string   `json:"projectId,omitempty"`
+	RoleKeys  []string `json:"roleKeys,omitempty"`
+}
+
+// IsZero returns true if no group is mapped.
+func (m *IDPGroupMapping) IsZero() bool {
+	return m == nil || len(m.Mappings) == 0
+}
+
+// Equal returns true if both mappings use the same claim and map the same groups.
+func (m *IDPGroupMapping) Equal(other *IDPGroupMapping) bool {
+	if m.IsZero() || other.IsZero() {
+		return m.IsZero() == other.IsZero()
+	}
+	return m.Claim == other.Claim &&
+		slices.EqualFunc(m.Mappings, other.Mappings, func(a, b *IDPGroupRoleMapping) bool {
+			return a.Group == b.Group && a.ProjectID == b.ProjectID && slices.Equal(a.RoleKeys, b.RoleKeys)
+		})
+}
+
+// ManagedProjectRoles returns all roles per project, which are managed by the mapping.
+func (m *IDPGroupMapping) ManagedProjectRoles() map[string][]string {
+	return m.projectRoles(func(*IDPGroupRoleMapping) bool { return true })
+}
+
+// ProjectRoles returns the roles per project, which are granted by the provided groups.
+func (m *IDPGroupMapping) ProjectRoles(groups []string) map[string][]string {
+	return m.projectRoles(func(mapping *IDPGroupRoleMapping) bool {
+		return slices.Contains(groups, mapping.Group)
+	})
+}
+
+func (m *IDPGroupMapping) projectRoles(include func(*IDPGroupRoleMapping) bool) map[string][]string {
+	if m.IsZero() {
+		return nil
+	}
+	projectRoles := make(map[string][]string)
+	for _, mapping := range m.Mappings {
+		if !include(mapping) {
+			continue
+		}
+		roles := projectRoles[mapping.ProjectID]
+		for _, role := range mapping.RoleKeys {
+			if !slices.Contains(roles, role) {
+				roles = append(roles, role)
+			}
+		}
+		projectRoles[mapping.ProjectID] = roles
+	}
+	return projectRoles
+}
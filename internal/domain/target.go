@@ -1,5 +1,10 @@
 package domain
 
+import (
+	"math"
+	"time"
+)
+
 type TargetType uint
 
 const (
@@ -24,3 +29,67 @@ func (s TargetState) Valid() bool {
 func (s TargetState) Exists() bool {
 	return s != TargetUnspecified && s != TargetRemoved
 }
+
+// TargetRetryPolicy defines how often a failed call to a target is retried
+// and how long to wait between the attempts.
+type TargetRetryPolicy struct {
+	// MaxAttempts is the maximum amount of calls, including the first one.
+	MaxAttempts uint8 `json:"maxAttempts,omitempty"`
+	// InitialBackoff is the delay before the first retry, which is doubled for every further retry.
+	InitialBackoff time.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff caps the delay between two attempts.
+	MaxBackoff time.Duration `json:"maxBackoff,omitempty"`
+	// Jitter is the fraction (0 to 1) of the delay which is randomized to spread retries of concurrent failures.
+	Jitter float64 `json:"jitter,omitempty"`
+}
+
+const maxTargetRetryAttempts = 25
+
+func (p *TargetRetryPolicy) IsValid() bool {
+	if p == nil {
+		return true
+	}
+	return p.MaxAttempts > 0 && p.MaxAttempts <= maxTargetRetryAttempts &&
+		p.InitialBackoff >= 0 &&
+		(p.MaxBackoff == 0 || p.MaxBackoff >= p.InitialBackoff) &&
+		p.Jitter >= 0 && p.Jitter <= 1
+}
+
+// Attempts returns the maximum amount of calls, a target without retry policy is called once.
+func (p *TargetRetryPolicy) Attempts() int {
+	if p == nil || p.MaxAttempts == 0 {
+		return 1
+	}
+	return int(p.MaxAttempts)
+}
+
+// Backoff returns the delay before the next call after the attempt failed.
+// random returns a value in [0, 1) and is used to apply the jitter.
+func (p *TargetRetryPolicy) Backoff(attempt int, random func() float64) time.Duration {
+	if p == nil || p.InitialBackoff <= 0 {
+		return 0
+	}
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt; i++ {
+		if p.MaxBackoff > 0 && backoff >= p.MaxBackoff || backoff > math.MaxInt64/2 {
+			break
+		}
+		backoff *= 2
+	}
+	if p.MaxBackoff > 0 && backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		// randomize the delay within [backoff - jitter, backoff + jitter]
+		jitter := float64(backoff) * p.Jitter
+		backoff += time.Duration(jitter * (2*random() - 1))
+	}
+	return backoff
+}
+
+func (p *TargetRetryPolicy) Equal(other *TargetRetryPolicy) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	return *p == *other
+}
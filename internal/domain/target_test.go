@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTargetRetryPolicy_IsValid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *TargetRetryPolicy
+		want   bool
+	}{
+		{
+			name:   "nil",
+			policy: nil,
+			want:   true,
+		},
+		{
+			name:   "no attempts",
+			policy: &TargetRetryPolicy{},
+			want:   false,
+		},
+		{
+			name:   "too many attempts",
+			policy: &TargetRetryPolicy{MaxAttempts: 26},
+			want:   false,
+		},
+		{
+			name:   "max backoff lower than initial backoff",
+			policy: &TargetRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Minute, MaxBackoff: time.Second},
+			want:   false,
+		},
+		{
+			name:   "jitter too big",
+			policy: &TargetRetryPolicy{MaxAttempts: 3, Jitter: 1.5},
+			want:   false,
+		},
+		{
+			name:   "valid",
+			policy: &TargetRetryPolicy{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: time.Minute, Jitter: 0.5},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.IsValid())
+		})
+	}
+}
+
+func TestTargetRetryPolicy_Attempts(t *testing.T) {
+	var policy *TargetRetryPolicy
+	assert.Equal(t, 1, policy.Attempts())
+	assert.Equal(t, 1, (&TargetRetryPolicy{}).Attempts())
+	assert.Equal(t, 5, (&TargetRetryPolicy{MaxAttempts: 5}).Attempts())
+}
+
+func TestTargetRetryPolicy_Backoff(t *testing.T) {
+	half := func() float64 { return 0.5 }
+	tests := []struct {
+		name    string
+		policy  *TargetRetryPolicy
+		attempt int
+		random  func() float64
+		want    time.Duration
+	}{
+		{
+			name:    "nil",
+			policy:  nil,
+			attempt: 1,
+			want:    0,
+		},
+		{
+			name:    "first attempt",
+			policy:  &TargetRetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second},
+			attempt: 1,
+			random:  half,
+			want:    time.Second,
+		},
+		{
+			name:    "exponential",
+			policy:  &TargetRetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second},
+			attempt: 4,
+			random:  half,
+			want:    8 * time.Second,
+		},
+		{
+			name:    "capped",
+			policy:  &TargetRetryPolicy{MaxAttempts: 5, InitialBackoff: time.Second, MaxBackoff: 5 * time.Second},
+			attempt: 4,
+			random:  half,
+			want:    5 * time.Second,
+		},
+		{
+			name:    "jitter lower bound",
+			policy:  &TargetRetryPolicy{MaxAttempts: 5, InitialBackoff: 10 * time.Second, Jitter: 0.2},
+			attempt: 1,
+			random:  func() float64 { return 0 },
+			want:    8 * time.Second,
+		},
+		{
+			name:    "jitter upper bound",
+			policy:  &TargetRetryPolicy{MaxAttempts: 5, InitialBackoff: 10 * time.Second, Jitter: 0.2},
+			attempt: 1,
+			random:  func() float64 { return 1 },
+			want:    12 * time.Second,
+		},
+		{
+			name:    "no overflow",
+			policy:  &TargetRetryPolicy{MaxAttempts: 255, InitialBackoff: time.Hour},
+			attempt: 200,
+			random:  half,
+			want:    time.Hour << 21,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.policy.Backoff(tt.attempt, tt.random))
+		})
+	}
+}
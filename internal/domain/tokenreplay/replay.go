@@ -0,0 +1,64 @@
+// Package tokenreplay detects the replay of tokens which may only be used once, such as DPoP proofs and logout tokens,
+// by caching their JWT ID (jti) until they expire.
+package tokenreplay
+
+import (
+	"context"
+	"errors"
+
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/cache"
+)
+
+type Index int
+
+const (
+	IndexUnspecified Index = iota
+	IndexKey
+)
+
+// Token is a used token, identified by its key.
+type Token struct {
+	Key string
+}
+
+// Keys implements cache.Entry
+func (t *Token) Keys(i Index) []string {
+	if i == IndexKey {
+		return []string{t.Key}
+	}
+	return nil
+}
+
+// Key returns the key of a token of the instance,
+// the issuer distinguishes the JWT IDs of different senders, e.g. the key of a DPoP proof or an identity provider.
+func Key(instanceID, issuer, jwtID string) string {
+	return instanceID + "-" + issuer + "-" + jwtID
+}
+
+// Use marks the token as used and returns false if it was already used before.
+// The token is added atomically if the cache supports it, so concurrent uses of the same token can't both succeed.
+// A token which can't be marked as used is rejected.
+// The token is kept until the cache prunes it, so the max age of the cache must exceed the lifetime of the tokens.
+func Use(ctx context.Context, c cache.Cache[Index, string, *Token], key string) bool {
+	adder, ok := c.(cache.Adder[*Token])
+	if !ok {
+		return use(ctx, c, key)
+	}
+	added, err := adder.Add(ctx, &Token{Key: key})
+	if errors.Is(err, errors.ErrUnsupported) {
+		return use(ctx, c, key)
+	}
+	logging.OnError(err).WithField("key", key).Error("unable to mark token as used")
+	return err == nil && added
+}
+
+// use is the fallback for caches which can't add atomically.
+func use(ctx context.Context, c cache.Cache[Index, string, *Token], key string) bool {
+	if _, ok := c.Get(ctx, IndexKey, key); ok {
+		return false
+	}
+	c.Set(ctx, &Token{Key: key})
+	return true
+}
@@ -0,0 +1,54 @@
+package tokenreplay
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/cache"
+	"github.com/zitadel/zitadel/internal/cache/connector/gomap"
+	"github.com/zitadel/zitadel/internal/cache/connector/noop"
+)
+
+func TestUse(t *testing.T) {
+	ctx := context.Background()
+	c := gomap.NewCache[Index, string, *Token](ctx, []Index{IndexKey}, cache.Config{})
+
+	assert.True(t, Use(ctx, c, Key("instance", "issuer", "jti")))
+	assert.False(t, Use(ctx, c, Key("instance", "issuer", "jti")))
+	assert.True(t, Use(ctx, c, Key("instance", "other", "jti")))
+	assert.True(t, Use(ctx, c, Key("other", "issuer", "jti")))
+}
+
+func TestUse_concurrent(t *testing.T) {
+	ctx := context.Background()
+	c := gomap.NewCache[Index, string, *Token](ctx, []Index{IndexKey}, cache.Config{})
+
+	var (
+		wg   sync.WaitGroup
+		used atomic.Int32
+	)
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if Use(ctx, c, Key("instance", "issuer", "jti")) {
+				used.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), used.Load())
+}
+
+func TestUse_disabled(t *testing.T) {
+	ctx := context.Background()
+	c := noop.NewCache[Index, string, *Token]()
+
+	// replays can't be detected without a cache
+	assert.True(t, Use(ctx, c, Key("instance", "issuer", "jti")))
+	assert.True(t, Use(ctx, c, Key("instance", "issuer", "jti")))
+}
@@ -0,0 +1,65 @@
+package eventstore
+
+import (
+	"context"
+	"slices"
+)
+
+type batchContextKey struct{}
+
+// batch is stored in the context to collect the commands pushed while it is executed
+type batch struct {
+	commands []Command
+}
+
+func batchFromContext(ctx context.Context) *batch {
+	b, _ := ctx.Value(batchContextKey{}).(*batch)
+	return b
+}
+
+// PushInBatch executes fn and pushes the commands of all pushes inside fn at once after fn succeeded.
+// If fn fails, none of the commands are pushed.
+// The pushes inside fn return the commands as events without storing them,
+// filters inside fn include the matching commands pushed before in the same batch (see [SearchQueryBuilder.Matches]).
+// Other than a database transaction spanning fn, the batch does not hold back the projections while fn is executed
+// and the final push is retried on collisions as any other push.
+// If the context already contains a batch, fn is executed as part of it.
+func (es *Eventstore) PushInBatch(ctx context.Context, fn func(ctx context.Context) error) error {
+	if batchFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	b := new(batch)
+	if err := fn(context.WithValue(ctx, batchContextKey{}, b)); err != nil {
+		return err
+	}
+	if len(b.commands) == 0 {
+		return nil
+	}
+	_, err := es.Push(ctx, b.commands...)
+	return err
+}
+
+// add collects the commands and returns them as events
+func (b *batch) add(cmds []Command) []Event {
+	b.commands = append(b.commands, cmds...)
+	return commandsToEvents(cmds)
+}
+
+// matches returns the collected commands matching the search query as events,
+// ordered the same as the events of the query.
+func (b *batch) matches(searchQuery *SearchQueryBuilder) []Event {
+	events := commandsToEvents(searchQuery.Matches(b.commands...))
+	if searchQuery.GetDesc() {
+		slices.Reverse(events)
+	}
+	return events
+}
+
+func commandsToEvents(cmds []Command) []Event {
+	events := make([]Event, len(cmds))
+	for i, cmd := range cmds {
+		events[i] = cmd.(Event)
+	}
+	return events
+}
@@ -0,0 +1,146 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+)
+
+type batchTestStorage struct {
+	stored []Command
+	pushed [][]Command
+}
+
+func (s *batchTestStorage) Health(context.Context) error {
+	return nil
+}
+
+func (s *batchTestStorage) Push(_ context.Context, _ database.ContextQueryExecuter, cmds ...Command) ([]Event, error) {
+	s.pushed = append(s.pushed, cmds)
+	return nil, nil
+}
+
+func (s *batchTestStorage) FilterToReducer(_ context.Context, searchQuery *SearchQueryBuilder, reduce Reducer) error {
+	for _, event := range commandsToEvents(searchQuery.Matches(s.stored...)) {
+		if err := reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *batchTestStorage) LatestPosition(context.Context, *SearchQueryBuilder) (decimal.Decimal, error) {
+	return decimal.Decimal{}, nil
+}
+
+func (s *batchTestStorage) InstanceIDs(context.Context, *SearchQueryBuilder) ([]string, error) {
+	return nil, nil
+}
+
+func (s *batchTestStorage) Client() *database.DB {
+	return nil
+}
+
+func newBatchTestEventstore(stored ...Command) (*Eventstore, *batchTestStorage) {
+	storage := &batchTestStorage{stored: stored}
+	return NewEventstore(&Config{Pusher: storage, Querier: storage}), storage
+}
+
+func testAggregateQuery(id string) *SearchQueryBuilder {
+	return NewSearchQueryBuilder(ColumnsEvent).
+		AddQuery().
+		AggregateTypes("test.aggregate").
+		AggregateIDs(id).
+		Builder()
+}
+
+func TestEventstore_PushInBatch(t *testing.T) {
+	t.Run("push at once", func(t *testing.T) {
+		es, storage := newBatchTestEventstore()
+		first := newTestEvent("1", "first", nil, false)
+		second := newTestEvent("2", "second", nil, false)
+
+		err := es.PushInBatch(context.Background(), func(ctx context.Context) error {
+			events, err := es.Push(ctx, first)
+			require.NoError(t, err)
+			assert.Equal(t, []Event{first}, events)
+			_, err = es.Push(ctx, second)
+			require.NoError(t, err)
+			assert.Empty(t, storage.pushed)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]Command{{first, second}}, storage.pushed)
+	})
+
+	t.Run("failed, nothing pushed", func(t *testing.T) {
+		es, storage := newBatchTestEventstore()
+		expectedErr := errors.New("failed")
+
+		err := es.PushInBatch(context.Background(), func(ctx context.Context) error {
+			_, err := es.Push(ctx, newTestEvent("1", "first", nil, false))
+			require.NoError(t, err)
+			return expectedErr
+		})
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Empty(t, storage.pushed)
+	})
+
+	t.Run("filter includes pushed commands", func(t *testing.T) {
+		stored := newTestEvent("1", "stored", nil, false)
+		es, _ := newBatchTestEventstore(stored)
+		pushed := newTestEvent("1", "pushed", nil, false)
+		other := newTestEvent("2", "other", nil, false)
+
+		err := es.PushInBatch(context.Background(), func(ctx context.Context) error {
+			_, err := es.Push(ctx, pushed, other)
+			require.NoError(t, err)
+
+			events, err := es.Filter(ctx, testAggregateQuery("1"))
+			require.NoError(t, err)
+			require.Len(t, events, 2)
+			assert.Equal(t, pushed, events[1])
+
+			events, err = es.Filter(ctx, testAggregateQuery("1").OrderDesc())
+			require.NoError(t, err)
+			require.Len(t, events, 2)
+			assert.Equal(t, pushed, events[0])
+
+			reducer := &testReducer{t: t, expectedLength: 1}
+			require.NoError(t, es.FilterToReducer(ctx, testAggregateQuery("2"), reducer))
+			assert.Equal(t, []Event{other}, reducer.events)
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		es, storage := newBatchTestEventstore()
+		event := newTestEvent("1", "first", nil, false)
+
+		err := es.PushInBatch(context.Background(), func(ctx context.Context) error {
+			return es.PushInBatch(ctx, func(ctx context.Context) error {
+				_, err := es.Push(ctx, event)
+				return err
+			})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, [][]Command{{event}}, storage.pushed)
+	})
+
+	t.Run("nothing pushed", func(t *testing.T) {
+		es, storage := newBatchTestEventstore()
+
+		err := es.PushInBatch(context.Background(), func(context.Context) error {
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Empty(t, storage.pushed)
+	})
+}
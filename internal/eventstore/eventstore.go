@@ -91,6 +91,10 @@ func (es *Eventstore) Push(ctx context.Context, cmds ...Command) ([]Event, error
 // PushWithClient pushes the events in a single transaction using the provided database client
 // an event needs at least an aggregate
 func (es *Eventstore) PushWithClient(ctx context.Context, client database.ContextQueryExecuter, cmds ...Command) ([]Event, error) {
+	// the commands are pushed at the end of the batch
+	if b := batchFromContext(ctx); client == nil && b != nil {
+		return b.add(cmds), nil
+	}
 	if es.PushTimeout > 0 {
 		var cancel func()
 		ctx, cancel = context.WithTimeout(ctx, es.PushTimeout)
@@ -170,11 +174,7 @@ func (es *Eventstore) Search(ctx context.Context, conditions ...map[FieldType]an
 func (es *Eventstore) Filter(ctx context.Context, searchQuery *SearchQueryBuilder) ([]Event, error) {
 	events := make([]Event, 0, searchQuery.GetLimit())
 	searchQuery.ensureInstanceID(ctx)
-	err := es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
-		event, err := es.mapEvent(event)
-		if err != nil {
-			return err
-		}
+	err := es.filterWithBatch(ctx, searchQuery, func(event Event) error {
 		events = append(events, event)
 		return nil
 	})
@@ -226,14 +226,43 @@ type reducer interface {
 // FilterToReducer filters the events based on the search query, appends all events to the reducer and calls it's reduce function
 func (es *Eventstore) FilterToReducer(ctx context.Context, searchQuery *SearchQueryBuilder, r reducer) error {
 	searchQuery.ensureInstanceID(ctx)
-	return es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
+	return es.filterWithBatch(ctx, searchQuery, func(event Event) error {
+		r.AppendEvents(event)
+		return r.Reduce()
+	})
+}
+
+// filterWithBatch filters the stored events and maps them before they are passed to reduce.
+// If the context contains a batch, the matching commands of the batch are passed to reduce as well,
+// the commands are not stored yet, so they are the latest events.
+func (es *Eventstore) filterWithBatch(ctx context.Context, searchQuery *SearchQueryBuilder, reduce func(Event) error) error {
+	var pending []Event
+	if b := batchFromContext(ctx); b != nil {
+		pending = b.matches(searchQuery)
+	}
+	if searchQuery.GetDesc() {
+		for _, event := range pending {
+			if err := reduce(event); err != nil {
+				return err
+			}
+		}
+	}
+	err := es.querier.FilterToReducer(ctx, searchQuery, func(event Event) error {
 		event, err := es.mapEvent(event)
 		if err != nil {
 			return err
 		}
-		r.AppendEvents(event)
-		return r.Reduce()
+		return reduce(event)
 	})
+	if err != nil || searchQuery.GetDesc() {
+		return err
+	}
+	for _, event := range pending {
+		if err = reduce(event); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // LatestPosition filters the latest position for the given search query
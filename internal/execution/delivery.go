@@ -0,0 +1,303 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/zitadel/logging"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// DeliveryQueue stores the deliveries to targets
+// and allows to inspect and replay the failed ones.
+type DeliveryQueue interface {
+	Queue
+	ListJobs(ctx context.Context, params *river.JobListParams) (*river.JobListResult, error)
+	GetJob(ctx context.Context, id int64) (*rivertype.JobRow, error)
+	RetryJob(ctx context.Context, id int64) (*rivertype.JobRow, error)
+}
+
+// deliveries is set by [Register].
+// Without queue, asynchronous targets are called once in a goroutine and failed calls are not retried.
+var deliveries DeliveryQueue
+
+const (
+	deliveryInstanceIDMetadata = "instance_id"
+	deliveryTargetIDMetadata   = "target_id"
+)
+
+// queueDelivery hands the call of the target over to the delivery queue,
+// which retries it according to the retry policy of the target.
+// previousAttempts is the amount of calls to the target which already failed.
+func queueDelivery(ctx context.Context, target Target, body []byte, previousAttempts int) error {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	data, err := json.Marshal(deliveryTarget(instanceID, target))
+	if err != nil {
+		return err
+	}
+	retryPolicy := target.GetRetryPolicy()
+	opts := []queue.InsertOpt{
+		queue.WithQueueName(exec_repo.DeliveryQueueName),
+		queue.WithMaxAttempts(uint8(retryPolicy.Attempts() - previousAttempts)),
+		queue.WithMetadata(map[string]string{
+			deliveryInstanceIDMetadata: instanceID,
+			deliveryTargetIDMetadata:   target.GetTargetID(),
+		}),
+	}
+	if previousAttempts > 0 {
+		opts = append(opts, queue.WithScheduledAt(time.Now().Add(retryPolicy.Backoff(previousAttempts, rand.Float64))))
+	}
+	return deliveries.Insert(ctx,
+		&exec_repo.Delivery{
+			InstanceID:       instanceID,
+			ResourceOwner:    authz.GetCtxData(ctx).OrgID,
+			TargetData:       data,
+			Body:             body,
+			PreviousAttempts: previousAttempts,
+		},
+		opts...,
+	)
+}
+
+// retryLater queues another delivery of a failed call, if the retry policy of the target allows further attempts.
+func retryLater(ctx context.Context, target Target, body []byte) {
+	if deliveries == nil || target.GetRetryPolicy().Attempts() <= 1 {
+		return
+	}
+	err := queueDelivery(ctx, target, body, 1)
+	logging.WithFields("target", target.GetTargetID()).OnError(err).Error("unable to queue retry of target")
+}
+
+func deliveryTarget(instanceID string, target Target) *query.ExecutionTarget {
+	if executionTarget, ok := target.(*query.ExecutionTarget); ok {
+		return executionTarget
+	}
+	return &query.ExecutionTarget{
+		InstanceID:       instanceID,
+		TargetID:         target.GetTargetID(),
+		TargetType:       target.GetTargetType(),
+		Endpoint:         target.GetEndpoint(),
+		Timeout:          target.GetTimeout(),
+		InterruptOnError: target.IsInterruptOnError(),
+		SigningKey:       target.GetSigningKey(),
+		RetryPolicy:      target.GetRetryPolicy(),
+	}
+}
+
+func targetFromDelivery(d *exec_repo.Delivery) (*query.ExecutionTarget, error) {
+	target := new(query.ExecutionTarget)
+	if err := json.Unmarshal(d.TargetData, target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// DeliveryWorker calls the target of a [exec_repo.Delivery].
+// Failed calls are retried according to the retry policy of the target,
+// after the last attempt the delivery is discarded and listed as failed delivery.
+type DeliveryWorker struct {
+	river.WorkerDefaults[*exec_repo.Delivery]
+
+	config WorkerConfig
+	now    nowFunc
+	random func() float64
+}
+
+func NewDeliveryWorker(
+	config WorkerConfig,
+) *DeliveryWorker {
+	return &DeliveryWorker{
+		config: config,
+		now:    time.Now,
+		random: rand.Float64,
+	}
+}
+
+var _ river.Worker[*exec_repo.Delivery] = (*DeliveryWorker)(nil)
+
+// Timeout implements the Timeout-function of [river.Worker].
+func (w *DeliveryWorker) Timeout(*river.Job[*exec_repo.Delivery]) time.Duration {
+	return w.config.TransactionDuration
+}
+
+// NextRetry implements the NextRetry-function of [river.Worker].
+// The delay grows exponentially with the attempts, including the calls done before the delivery was queued.
+func (w *DeliveryWorker) NextRetry(job *river.Job[*exec_repo.Delivery]) time.Time {
+	target, err := targetFromDelivery(job.Args)
+	if err != nil {
+		// use the default retry policy of the queue
+		return time.Time{}
+	}
+	return w.now().Add(target.GetRetryPolicy().Backoff(job.Args.PreviousAttempts+job.Attempt, w.random))
+}
+
+// Work implements [river.Worker].
+func (w *DeliveryWorker) Work(ctx context.Context, job *river.Job[*exec_repo.Delivery]) error {
+	ctx = authz.WithInstanceID(ctx, job.Args.InstanceID)
+	ctx = authz.SetCtxData(ctx, authz.CtxData{UserID: ExecutionUserID, OrgID: job.Args.ResourceOwner})
+
+	target, err := targetFromDelivery(job.Args)
+	if err != nil {
+		// without target there is nothing to call and retry
+		return river.JobCancel(fmt.Errorf("unable to unmarshal target because %w", err))
+	}
+	if _, err = Call(ctx, target.GetEndpoint(), target.GetTimeout(), job.Args.GetHTTPRequestBody(), target.GetSigningKey()); err != nil {
+		return fmt.Errorf("call of target %s failed because %w", target.GetTargetID(), err)
+	}
+	return nil
+}
+
+func (w *DeliveryWorker) Register(workers *river.Workers, queues map[string]river.QueueConfig) {
+	river.AddWorker(workers, w)
+	queues[exec_repo.DeliveryQueueName] = river.QueueConfig{
+		MaxWorkers: int(w.config.Workers),
+	}
+}
+
+const (
+	defaultFailedDeliveriesLimit = 100
+	maxFailedDeliveriesLimit     = 1000
+)
+
+// FailedDelivery is a delivery to a target which failed on all attempts.
+type FailedDelivery struct {
+	ID          int64
+	TargetID    string
+	ExecutionID string
+	Attempts    int
+	CreatedAt   time.Time
+	FailedAt    time.Time
+	// Error of the last attempt.
+	Error string
+}
+
+type FailedDeliveries struct {
+	Deliveries []*FailedDelivery
+	// Cursor points to the next page and is empty on the last page.
+	Cursor string
+}
+
+// ListFailedDeliveries lists the failed deliveries of the instance, the most recent first.
+// If targetID is set, only the deliveries to the target are returned.
+func ListFailedDeliveries(ctx context.Context, targetID string, limit int, cursor string) (*FailedDeliveries, error) {
+	if deliveries == nil {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "EXEC-v5qk0ehz2n", "Errors.Execution.FailedDelivery.Unavailable")
+	}
+	if limit <= 0 {
+		limit = defaultFailedDeliveriesLimit
+	}
+	limit = min(limit, maxFailedDeliveriesLimit)
+
+	metadata := map[string]string{
+		deliveryInstanceIDMetadata: authz.GetInstance(ctx).InstanceID(),
+	}
+	if targetID != "" {
+		metadata[deliveryTargetIDMetadata] = targetID
+	}
+	// marshalling a map of strings can't fail
+	metadataFilter, _ := json.Marshal(metadata)
+	params := river.NewJobListParams().
+		Kinds((*exec_repo.Delivery)(nil).Kind()).
+		States(rivertype.JobStateDiscarded).
+		Metadata(string(metadataFilter)).
+		OrderBy(river.JobListOrderByFinalizedAt, river.SortOrderDesc).
+		First(limit)
+	if cursor != "" {
+		after := new(river.JobListCursor)
+		if err := after.UnmarshalText([]byte(cursor)); err != nil {
+			return nil, zerrors.ThrowInvalidArgument(err, "EXEC-g1xo3rmw8d", "Errors.Query.InvalidRequest")
+		}
+		params = params.After(after)
+	}
+
+	result, err := deliveries.ListJobs(ctx, params)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-7yq2c6nlfa", "Errors.Internal")
+	}
+	failed := &FailedDeliveries{
+		Deliveries: make([]*FailedDelivery, 0, len(result.Jobs)),
+	}
+	for _, job := range result.Jobs {
+		delivery, err := failedDeliveryFromJob(job)
+		if err != nil {
+			return nil, err
+		}
+		failed.Deliveries = append(failed.Deliveries, delivery)
+	}
+	if len(result.Jobs) == limit && result.LastCursor != nil {
+		next, err := result.LastCursor.MarshalText()
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "EXEC-b0d8sz4kqe", "Errors.Internal")
+		}
+		failed.Cursor = string(next)
+	}
+	return failed, nil
+}
+
+// RetryFailedDelivery queues the failed delivery of the instance for one more attempt.
+func RetryFailedDelivery(ctx context.Context, id int64) error {
+	if deliveries == nil {
+		return zerrors.ThrowPreconditionFailed(nil, "EXEC-r4nz7jwm1u", "Errors.Execution.FailedDelivery.Unavailable")
+	}
+	job, err := deliveries.GetJob(ctx, id)
+	if errors.Is(err, river.ErrNotFound) {
+		return zerrors.ThrowNotFound(err, "EXEC-k2p9dvx6ho", "Errors.Execution.FailedDelivery.NotFound")
+	}
+	if err != nil {
+		return zerrors.ThrowInternal(err, "EXEC-u8fj3ly0qc", "Errors.Internal")
+	}
+	// deliveries of other instances and deliveries which didn't fail are not exposed
+	if job.Kind != (*exec_repo.Delivery)(nil).Kind() ||
+		job.State != rivertype.JobStateDiscarded ||
+		jobInstanceID(job) != authz.GetInstance(ctx).InstanceID() {
+		return zerrors.ThrowNotFound(nil, "EXEC-s6ew1nbt7z", "Errors.Execution.FailedDelivery.NotFound")
+	}
+	if _, err = deliveries.RetryJob(ctx, id); err != nil {
+		return zerrors.ThrowInternal(err, "EXEC-c3hv5ma9xi", "Errors.Internal")
+	}
+	return nil
+}
+
+func jobInstanceID(job *rivertype.JobRow) string {
+	var metadata map[string]string
+	if err := json.Unmarshal(job.Metadata, &metadata); err != nil {
+		return ""
+	}
+	return metadata[deliveryInstanceIDMetadata]
+}
+
+func failedDeliveryFromJob(job *rivertype.JobRow) (*FailedDelivery, error) {
+	args := new(exec_repo.Delivery)
+	if err := json.Unmarshal(job.EncodedArgs, args); err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-z5tg8ocr4w", "Errors.Internal")
+	}
+	target, err := targetFromDelivery(args)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "EXEC-n9lw2eku7p", "Errors.Internal")
+	}
+	delivery := &FailedDelivery{
+		ID:          job.ID,
+		TargetID:    target.GetTargetID(),
+		ExecutionID: target.GetExecutionID(),
+		Attempts:    args.PreviousAttempts + job.Attempt,
+		CreatedAt:   job.CreatedAt,
+	}
+	if job.FinalizedAt != nil {
+		delivery.FailedAt = *job.FinalizedAt
+	}
+	if len(job.Errors) > 0 {
+		delivery.Error = job.Errors[len(job.Errors)-1].Error
+	}
+	return delivery, nil
+}
@@ -0,0 +1,383 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/execution/mock"
+	"github.com/zitadel/zitadel/internal/query"
+	"github.com/zitadel/zitadel/internal/queue"
+	"github.com/zitadel/zitadel/internal/repository/action"
+	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func withDeliveries(t *testing.T, q DeliveryQueue) {
+	previous := deliveries
+	deliveries = q
+	t.Cleanup(func() {
+		deliveries = previous
+	})
+}
+
+func applyInsertOpts(opts []queue.InsertOpt) *river.InsertOpts {
+	options := new(river.InsertOpts)
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+func mockRetryTarget() *query.ExecutionTarget {
+	target := mockTarget()
+	target.TargetType = domain.TargetTypeAsync
+	target.RetryPolicy = &domain.TargetRetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Minute,
+	}
+	return target
+}
+
+func deliveryCtx() context.Context {
+	ctx := authz.WithInstanceID(context.Background(), instanceID)
+	return authz.SetCtxData(ctx, authz.CtxData{UserID: userID, OrgID: orgID})
+}
+
+func Test_queueDelivery(t *testing.T) {
+	target := mockRetryTarget()
+	targetData, err := json.Marshal(target)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		previousAttempts int
+		wantMaxAttempts  int
+		wantScheduled    bool
+	}{
+		{
+			name:             "first attempt",
+			previousAttempts: 0,
+			wantMaxAttempts:  3,
+			wantScheduled:    false,
+		},
+		{
+			name:             "retry",
+			previousAttempts: 1,
+			wantMaxAttempts:  2,
+			wantScheduled:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := mock.NewMockDeliveryQueue(gomock.NewController(t))
+			withDeliveries(t, q)
+			q.EXPECT().Insert(gomock.Any(), &exec_repo.Delivery{
+				InstanceID:       instanceID,
+				ResourceOwner:    orgID,
+				TargetData:       targetData,
+				Body:             []byte("body"),
+				PreviousAttempts: tt.previousAttempts,
+			}, gomock.Any()).DoAndReturn(func(_ context.Context, _ river.JobArgs, opts ...queue.InsertOpt) error {
+				options := applyInsertOpts(opts)
+				assert.Equal(t, exec_repo.DeliveryQueueName, options.Queue)
+				assert.Equal(t, tt.wantMaxAttempts, options.MaxAttempts)
+				assert.JSONEq(t, `{"instance_id":"instanceID","target_id":"targetID"}`, string(options.Metadata))
+				if tt.wantScheduled {
+					assert.WithinDuration(t, time.Now().Add(time.Minute), options.ScheduledAt, time.Second)
+				} else {
+					assert.True(t, options.ScheduledAt.IsZero())
+				}
+				return nil
+			})
+
+			err := queueDelivery(deliveryCtx(), target, []byte("body"), tt.previousAttempts)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_CallTarget_asyncQueued(t *testing.T) {
+	q := mock.NewMockDeliveryQueue(gomock.NewController(t))
+	withDeliveries(t, q)
+	q.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+	resp, err := CallTarget(deliveryCtx(), mockRetryTarget(), &exec_repo.ContextInfoEvent{})
+	assert.NoError(t, err)
+	assert.Nil(t, resp)
+}
+
+func Test_Worker_retryFailedTarget(t *testing.T) {
+	q := mock.NewMockDeliveryQueue(gomock.NewController(t))
+	withDeliveries(t, q)
+
+	job := &river.Job[*exec_repo.Request]{
+		JobRow: &rivertype.JobRow{
+			CreatedAt: time.Now(),
+		},
+		Args: &exec_repo.Request{
+			Aggregate: &eventstore.Aggregate{
+				InstanceID:    instanceID,
+				Type:          action.AggregateType,
+				Version:       action.AggregateVersion,
+				ID:            eventID,
+				ResourceOwner: orgID,
+			},
+			Sequence:  1,
+			CreatedAt: time.Now().UTC(),
+			EventType: action.AddedEventType,
+			UserID:    userID,
+			EventData: []byte(eventData),
+		},
+	}
+	url, closeF, calledF := testServerCall(nil, 0, http.StatusServiceUnavailable, nil)
+	defer closeF()
+
+	target := mockTarget()
+	target.Endpoint = url
+	target.InterruptOnError = false
+	target.RetryPolicy = &domain.TargetRetryPolicy{MaxAttempts: 2}
+	data, err := json.Marshal([]*query.ExecutionTarget{target})
+	require.NoError(t, err)
+	job.Args.TargetsData = data
+
+	q.EXPECT().Insert(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, args river.JobArgs, opts ...queue.InsertOpt) error {
+		delivery, ok := args.(*exec_repo.Delivery)
+		require.True(t, ok)
+		assert.Equal(t, instanceID, delivery.InstanceID)
+		assert.Equal(t, orgID, delivery.ResourceOwner)
+		assert.Equal(t, 1, delivery.PreviousAttempts)
+		assert.Equal(t, exec_repo.ContextInfoFromRequest(job.Args).GetHTTPRequestBody(), delivery.Body)
+		assert.Equal(t, 1, applyInsertOpts(opts).MaxAttempts)
+		return nil
+	})
+
+	err = newExecutionWorker(fieldsWorker{now: time.Now}).Work(context.Background(), job)
+	assert.NoError(t, err)
+	assert.True(t, calledF())
+}
+
+func newTestDeliveryWorker(now time.Time) *DeliveryWorker {
+	return &DeliveryWorker{
+		config: WorkerConfig{
+			Workers:             1,
+			TransactionDuration: 5 * time.Second,
+		},
+		now:    func() time.Time { return now },
+		random: func() float64 { return 0.5 },
+	}
+}
+
+func testDeliveryJob(t *testing.T, target *query.ExecutionTarget, attempt, previousAttempts int) *river.Job[*exec_repo.Delivery] {
+	data, err := json.Marshal(target)
+	require.NoError(t, err)
+	return &river.Job[*exec_repo.Delivery]{
+		JobRow: &rivertype.JobRow{
+			Attempt: attempt,
+		},
+		Args: &exec_repo.Delivery{
+			InstanceID:       instanceID,
+			ResourceOwner:    orgID,
+			TargetData:       data,
+			Body:             []byte(`{"content":"content"}`),
+			PreviousAttempts: previousAttempts,
+		},
+	}
+}
+
+func TestDeliveryWorker_NextRetry(t *testing.T) {
+	now := time.Now()
+	worker := newTestDeliveryWorker(now)
+
+	assert.Equal(t, now.Add(time.Minute), worker.NextRetry(testDeliveryJob(t, mockRetryTarget(), 1, 0)))
+	assert.Equal(t, now.Add(4*time.Minute), worker.NextRetry(testDeliveryJob(t, mockRetryTarget(), 2, 1)))
+	assert.Equal(t, now, worker.NextRetry(testDeliveryJob(t, mockTarget(), 1, 0)), "no retry policy")
+
+	invalid := testDeliveryJob(t, mockTarget(), 1, 0)
+	invalid.Args.TargetData = []byte("invalid")
+	assert.True(t, worker.NextRetry(invalid).IsZero(), "default retry policy of the queue")
+}
+
+func TestDeliveryWorker_Work(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		invalid    bool
+		err        func(error) bool
+	}{
+		{
+			name:       "ok",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "failed, retry",
+			statusCode: http.StatusServiceUnavailable,
+			err: func(err error) bool {
+				return err != nil && !errors.As(err, new(*river.JobCancelError))
+			},
+		},
+		{
+			name:    "invalid target, cancel",
+			invalid: true,
+			err: func(err error) bool {
+				return errors.As(err, new(*river.JobCancelError))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, closeF, calledF := testServerCall(map[string]string{"content": "content"}, 0, tt.statusCode, nil)
+			defer closeF()
+			target := mockRetryTarget()
+			target.Endpoint = url
+			job := testDeliveryJob(t, target, 1, 0)
+			if tt.invalid {
+				job.Args.TargetData = []byte("invalid")
+			}
+
+			err := newTestDeliveryWorker(time.Now()).Work(context.Background(), job)
+			if tt.err != nil {
+				assert.True(t, tt.err(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, calledF())
+		})
+	}
+}
+
+func failedDeliveryJob(t *testing.T, id int64, jobInstanceID string) *rivertype.JobRow {
+	job := testDeliveryJob(t, mockRetryTarget(), 2, 1)
+	args, err := json.Marshal(job.Args)
+	require.NoError(t, err)
+	metadata, err := json.Marshal(map[string]string{
+		deliveryInstanceIDMetadata: jobInstanceID,
+		deliveryTargetIDMetadata:   "targetID",
+	})
+	require.NoError(t, err)
+	finalizedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &rivertype.JobRow{
+		ID:          id,
+		Attempt:     2,
+		CreatedAt:   finalizedAt.Add(-time.Hour),
+		EncodedArgs: args,
+		Errors: []rivertype.AttemptError{
+			{Attempt: 1, Error: "first"},
+			{Attempt: 2, Error: "last"},
+		},
+		FinalizedAt: &finalizedAt,
+		Kind:        (*exec_repo.Delivery)(nil).Kind(),
+		Metadata:    metadata,
+		State:       rivertype.JobStateDiscarded,
+	}
+}
+
+func TestListFailedDeliveries(t *testing.T) {
+	t.Run("unavailable", func(t *testing.T) {
+		withDeliveries(t, nil)
+		_, err := ListFailedDeliveries(deliveryCtx(), "", 0, "")
+		assert.True(t, zerrors.IsPreconditionFailed(err))
+	})
+	t.Run("invalid cursor", func(t *testing.T) {
+		withDeliveries(t, mock.NewMockDeliveryQueue(gomock.NewController(t)))
+		_, err := ListFailedDeliveries(deliveryCtx(), "", 0, "invalid")
+		assert.True(t, zerrors.IsErrorInvalidArgument(err))
+	})
+	t.Run("ok", func(t *testing.T) {
+		q := mock.NewMockDeliveryQueue(gomock.NewController(t))
+		withDeliveries(t, q)
+		job := failedDeliveryJob(t, 1, instanceID)
+		q.EXPECT().ListJobs(gomock.Any(), gomock.Any()).Return(&river.JobListResult{
+			Jobs:       []*rivertype.JobRow{job},
+			LastCursor: river.JobListCursorFromJob(job),
+		}, nil)
+
+		got, err := ListFailedDeliveries(deliveryCtx(), "targetID", 10, "")
+		require.NoError(t, err)
+		assert.Equal(t, &FailedDeliveries{
+			Deliveries: []*FailedDelivery{
+				{
+					ID:          1,
+					TargetID:    "targetID",
+					ExecutionID: "executionID",
+					Attempts:    3,
+					CreatedAt:   job.CreatedAt,
+					FailedAt:    *job.FinalizedAt,
+					Error:       "last",
+				},
+			},
+		}, got)
+	})
+}
+
+func TestRetryFailedDelivery(t *testing.T) {
+	tests := []struct {
+		name  string
+		job   func(t *testing.T) *rivertype.JobRow
+		err   error
+		retry bool
+		want  func(error) bool
+	}{
+		{
+			name: "not found",
+			err:  river.ErrNotFound,
+			want: zerrors.IsNotFound,
+		},
+		{
+			name: "other instance",
+			job: func(t *testing.T) *rivertype.JobRow {
+				return failedDeliveryJob(t, 1, "other")
+			},
+			want: zerrors.IsNotFound,
+		},
+		{
+			name: "not failed",
+			job: func(t *testing.T) *rivertype.JobRow {
+				job := failedDeliveryJob(t, 1, instanceID)
+				job.State = rivertype.JobStateRetryable
+				return job
+			},
+			want: zerrors.IsNotFound,
+		},
+		{
+			name: "ok",
+			job: func(t *testing.T) *rivertype.JobRow {
+				return failedDeliveryJob(t, 1, instanceID)
+			},
+			retry: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := mock.NewMockDeliveryQueue(gomock.NewController(t))
+			withDeliveries(t, q)
+			var job *rivertype.JobRow
+			if tt.job != nil {
+				job = tt.job(t)
+			}
+			q.EXPECT().GetJob(gomock.Any(), int64(1)).Return(job, tt.err)
+			if tt.retry {
+				q.EXPECT().RetryJob(gomock.Any(), int64(1)).Return(job, nil)
+			}
+
+			err := RetryFailedDelivery(deliveryCtx(), 1)
+			if tt.want != nil {
+				assert.True(t, tt.want(err), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
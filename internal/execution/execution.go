@@ -33,6 +33,7 @@ type Target interface {
 	GetTargetType() domain.TargetType
 	GetTimeout() time.Duration
 	GetSigningKey() string
+	GetRetryPolicy() *domain.TargetRetryPolicy
 }
 
 // CallTargets call a list of targets in order with handling of error and responses
@@ -82,6 +83,13 @@ func CallTarget(
 	case domain.TargetTypeCall:
 		return Call(ctx, target.GetEndpoint(), target.GetTimeout(), info.GetHTTPRequestBody(), target.GetSigningKey())
 	case domain.TargetTypeAsync:
+		if deliveries != nil {
+			err := queueDelivery(ctx, target, info.GetHTTPRequestBody(), 0)
+			if err == nil {
+				return nil, nil
+			}
+			logging.WithFields("target", target.GetTargetID()).WithError(err).Warn("unable to queue delivery, call target directly")
+		}
 		go func(ctx context.Context, target Target, info []byte) {
 			if _, err := Call(ctx, target.GetEndpoint(), target.GetTimeout(), info, target.GetSigningKey()); err != nil {
 				logging.WithFields("target", target.GetTargetID()).OnError(err).Info(err)
@@ -458,6 +458,7 @@ type mockTarget struct {
 	Timeout          time.Duration
 	InterruptOnError bool
 	SigningKey       string
+	RetryPolicy      *domain.TargetRetryPolicy
 }
 
 func (e *mockTarget) GetTargetID() string {
@@ -478,6 +479,9 @@ func (e *mockTarget) GetTimeout() time.Duration {
 func (e *mockTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *mockTarget) GetRetryPolicy() *domain.TargetRetryPolicy {
+	return e.RetryPolicy
+}
 
 type callTestServer struct {
 	method      string
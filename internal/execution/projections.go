@@ -26,7 +26,10 @@ func Register(
 	projections = []*handler.Handler{
 		NewEventHandler(ctx, projection.ApplyCustomConfig(executionsCustomConfig), eventTypes, eventstore.AggregateTypeFromEventType, queries, queue),
 	}
-	queue.AddWorkers(NewWorker(workerConfig))
+	queue.AddWorkers(NewWorker(workerConfig), NewDeliveryWorker(workerConfig))
+	if queue != nil {
+		deliveries = queue
+	}
 }
 
 func Start(ctx context.Context) {
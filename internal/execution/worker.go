@@ -9,6 +9,7 @@ import (
 
 	"github.com/riverqueue/river"
 
+	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/query"
 	exec_repo "github.com/zitadel/zitadel/internal/repository/execution"
 )
@@ -29,7 +30,7 @@ func (w *Worker) Timeout(*river.Job[*exec_repo.Request]) time.Duration {
 
 // Work implements [river.Worker].
 func (w *Worker) Work(ctx context.Context, job *river.Job[*exec_repo.Request]) error {
-	ctx = ContextWithExecuter(ctx, job.Args.Aggregate)
+	ctx = ContextWithExecuter(authz.WithInstanceID(ctx, job.Args.Aggregate.InstanceID), job.Args.Aggregate)
 
 	// if the event is too old, we can directly return as it will be removed anyway
 	if job.CreatedAt.Add(w.config.MaxTtl).Before(w.now()) {
@@ -42,10 +43,15 @@ func (w *Worker) Work(ctx context.Context, job *river.Job[*exec_repo.Request]) e
 		return river.JobCancel(fmt.Errorf("unable to unmarshal targets because %w", err))
 	}
 
-	_, err = CallTargets(ctx, targets, exec_repo.ContextInfoFromRequest(job.Args))
-	if err != nil {
-		// If there is an error returned from the targets, it means that the execution was interrupted
-		return river.JobCancel(fmt.Errorf("interruption during call of targets because %w", err))
+	info := exec_repo.ContextInfoFromRequest(job.Args)
+	for _, target := range targets {
+		if _, err := CallTarget(ctx, target, info); err != nil {
+			// failed calls are retried according to the retry policy of the target
+			retryLater(ctx, target, info.GetHTTPRequestBody())
+			if target.IsInterruptOnError() {
+				return river.JobCancel(fmt.Errorf("interruption during call of targets because %w", err))
+			}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,278 @@
+package idp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	ErrInvalidClaimPath = errors.New("invalid claim path")
+)
+
+// MetadataUser is a [User], which provides metadata for the user,
+// e.g. through the claim mapping of the identity provider.
+type MetadataUser interface {
+	User
+	GetMetadata() []*domain.Metadata
+}
+
+// ClaimPath is a parsed JSONPath expression.
+// The supported subset contains the root (`$`), child names (`.name` and `['name']`),
+// array indexes (`[0]`, `[-1]` for the last element) and wildcards (`.*` and `[*]`).
+type ClaimPath struct {
+	segments []claimPathSegment
+}
+
+type claimPathSegment struct {
+	name     string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// ParseClaimPath parses the JSONPath expression.
+func ParseClaimPath(expression string) (*ClaimPath, error) {
+	rest, ok := strings.CutPrefix(strings.TrimSpace(expression), "$")
+	if !ok {
+		return nil, fmt.Errorf("%w: %q must start with $", ErrInvalidClaimPath, expression)
+	}
+	path := new(ClaimPath)
+	for rest != "" {
+		var (
+			segment claimPathSegment
+			err     error
+		)
+		switch rest[0] {
+		case '.':
+			segment, rest, err = parseDotSegment(rest[1:])
+		case '[':
+			segment, rest, err = parseBracketSegment(rest[1:])
+		default:
+			err = fmt.Errorf("unexpected %q", rest[0])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidClaimPath, expression, err)
+		}
+		path.segments = append(path.segments, segment)
+	}
+	return path, nil
+}
+
+func parseDotSegment(rest string) (claimPathSegment, string, error) {
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+	name := rest[:end]
+	if name == "" {
+		return claimPathSegment{}, "", errors.New("missing name")
+	}
+	if name == "*" {
+		return claimPathSegment{wildcard: true}, rest[end:], nil
+	}
+	return claimPathSegment{name: name}, rest[end:], nil
+}
+
+func parseBracketSegment(rest string) (claimPathSegment, string, error) {
+	if rest != "" && (rest[0] == '\'' || rest[0] == '"') {
+		quote := rest[0]
+		end := strings.IndexByte(rest[1:], quote)
+		if end < 0 || !strings.HasPrefix(rest[end+2:], "]") {
+			return claimPathSegment{}, "", errors.New("unterminated name")
+		}
+		return claimPathSegment{name: rest[1 : end+1]}, rest[end+3:], nil
+	}
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return claimPathSegment{}, "", errors.New("unterminated index")
+	}
+	if rest[:end] == "*" {
+		return claimPathSegment{wildcard: true}, rest[end+1:], nil
+	}
+	index, err := strconv.Atoi(rest[:end])
+	if err != nil {
+		return claimPathSegment{}, "", fmt.Errorf("invalid index %q", rest[:end])
+	}
+	return claimPathSegment{index: index, isIndex: true}, rest[end+1:], nil
+}
+
+// Evaluate returns the value of the path in the claims.
+// If the path contains a wildcard, all found values are returned as a list.
+// The second return value is false if no value was found.
+func (p *ClaimPath) Evaluate(claims map[string]any) (any, bool) {
+	values := []any{claims}
+	wildcard := false
+	for _, segment := range p.segments {
+		wildcard = wildcard || segment.wildcard
+		next := make([]any, 0, len(values))
+		for _, value := range values {
+			next = append(next, segment.evaluate(value)...)
+		}
+		values = next
+	}
+	if wildcard {
+		return values, len(values) > 0
+	}
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+func (s claimPathSegment) evaluate(value any) []any {
+	switch v := value.(type) {
+	case map[string]any:
+		if s.wildcard {
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			slices.Sort(keys)
+			values := make([]any, 0, len(keys))
+			for _, key := range keys {
+				values = append(values, v[key])
+			}
+			return values
+		}
+		if s.isIndex {
+			return nil
+		}
+		child, ok := v[s.name]
+		if !ok {
+			return nil
+		}
+		return []any{child}
+	case []any:
+		if s.wildcard {
+			return v
+		}
+		if !s.isIndex {
+			return nil
+		}
+		index := s.index
+		if index < 0 {
+			index += len(v)
+		}
+		if index < 0 || index >= len(v) {
+			return nil
+		}
+		return []any{v[index]}
+	default:
+		return nil
+	}
+}
+
+// ValidateClaimMapping checks that all expressions of the mapping are valid JSONPath expressions.
+func ValidateClaimMapping(mapping *domain.IDPClaimMapping) error {
+	if mapping == nil {
+		return nil
+	}
+	for key := range mapping.Metadata {
+		if key == "" {
+			return zerrors.ThrowInvalidArgument(nil, "IDP-Qm2bx", "Errors.IDPConfig.ClaimMappingInvalid")
+		}
+	}
+	for _, expression := range mapping.Expressions() {
+		if _, err := ParseClaimPath(expression); err != nil {
+			return zerrors.ThrowInvalidArgument(err, "IDP-Vb8sk", "Errors.IDPConfig.ClaimMappingInvalid")
+		}
+	}
+	return nil
+}
+
+// MapClaim returns the value of the expression in the claims as string.
+// If the expression is empty, invalid or doesn't match any claim, the fallback is returned.
+func MapClaim(claims map[string]any, expression, fallback string) string {
+	value, ok := evaluateClaim(claims, expression)
+	if !ok {
+		return fallback
+	}
+	return claimToString(value)
+}
+
+// MapBoolClaim returns the value of the expression in the claims as bool.
+// Strings are parsed with [strconv.ParseBool].
+// If the expression is empty, invalid or doesn't match any claim, the fallback is returned.
+func MapBoolClaim(claims map[string]any, expression string, fallback bool) bool {
+	value, ok := evaluateClaim(claims, expression)
+	if !ok {
+		return fallback
+	}
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return fallback
+		}
+		return b
+	default:
+		return fallback
+	}
+}
+
+// MapMetadata returns the metadata of the mapping ordered by key.
+// Keys whose expression doesn't match any claim are omitted.
+func MapMetadata(claims map[string]any, metadata map[string]string) []*domain.Metadata {
+	if len(metadata) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	mapped := make([]*domain.Metadata, 0, len(keys))
+	for _, key := range keys {
+		value, ok := evaluateClaim(claims, metadata[key])
+		if !ok {
+			continue
+		}
+		mapped = append(mapped, &domain.Metadata{
+			Key:   key,
+			Value: []byte(claimToString(value)),
+		})
+	}
+	return mapped
+}
+
+func evaluateClaim(claims map[string]any, expression string) (any, bool) {
+	if expression == "" || claims == nil {
+		return nil, false
+	}
+	path, err := ParseClaimPath(expression)
+	if err != nil {
+		return nil, false
+	}
+	value, ok := path.Evaluate(claims)
+	if !ok || value == nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// claimToString returns strings as they are and all other values JSON encoded.
+func claimToString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(encoded)
+	}
+}
@@ -0,0 +1,287 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func testClaims() map[string]any {
+	return map[string]any{
+		"sub":   "id",
+		"email": "email@example.com",
+		"https://example.com/claims": map[string]any{
+			"department": "sales",
+		},
+		"realm_access": map[string]any{
+			"roles": []any{"admin", "user"},
+		},
+		"emails": []any{
+			map[string]any{"value": "first@example.com", "verified": "true"},
+			map[string]any{"value": "second@example.com", "verified": false},
+		},
+		"age":  float64(42),
+		"null": nil,
+	}
+}
+
+func TestClaimPath_Evaluate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		want       any
+		wantFound  bool
+	}{
+		{
+			name:       "root",
+			expression: "$",
+			want:       testClaims(),
+			wantFound:  true,
+		},
+		{
+			name:       "child",
+			expression: "$.email",
+			want:       "email@example.com",
+			wantFound:  true,
+		},
+		{
+			name:       "quoted child",
+			expression: "$['https://example.com/claims'].department",
+			want:       "sales",
+			wantFound:  true,
+		},
+		{
+			name:       "double quoted child",
+			expression: `$["https://example.com/claims"]["department"]`,
+			want:       "sales",
+			wantFound:  true,
+		},
+		{
+			name:       "nested array",
+			expression: "$.realm_access.roles",
+			want:       []any{"admin", "user"},
+			wantFound:  true,
+		},
+		{
+			name:       "index",
+			expression: "$.emails[0].value",
+			want:       "first@example.com",
+			wantFound:  true,
+		},
+		{
+			name:       "negative index",
+			expression: "$.emails[-1].value",
+			want:       "second@example.com",
+			wantFound:  true,
+		},
+		{
+			name:       "wildcard",
+			expression: "$.emails[*].value",
+			want:       []any{"first@example.com", "second@example.com"},
+			wantFound:  true,
+		},
+		{
+			name:       "missing child",
+			expression: "$.missing.child",
+			wantFound:  false,
+		},
+		{
+			name:       "index out of range",
+			expression: "$.emails[2]",
+			wantFound:  false,
+		},
+		{
+			name:       "index on object",
+			expression: "$.realm_access[0]",
+			wantFound:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := ParseClaimPath(tt.expression)
+			require.NoError(t, err)
+			got, found := path.Evaluate(testClaims())
+			assert.Equal(t, tt.wantFound, found)
+			if tt.wantFound {
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseClaimPath_invalid(t *testing.T) {
+	for _, expression := range []string{
+		"",
+		"email",
+		"$email",
+		"$.",
+		"$..email",
+		"$[0",
+		"$['email'",
+		"$[email]",
+	} {
+		t.Run(expression, func(t *testing.T) {
+			_, err := ParseClaimPath(expression)
+			assert.ErrorIs(t, err, ErrInvalidClaimPath)
+		})
+	}
+}
+
+func TestMapClaim(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		fallback   string
+		want       string
+	}{
+		{
+			name:       "no expression",
+			expression: "",
+			fallback:   "fallback",
+			want:       "fallback",
+		},
+		{
+			name:       "invalid expression",
+			expression: "email",
+			fallback:   "fallback",
+			want:       "fallback",
+		},
+		{
+			name:       "not found",
+			expression: "$.missing",
+			fallback:   "fallback",
+			want:       "fallback",
+		},
+		{
+			name:       "null",
+			expression: "$.null",
+			fallback:   "fallback",
+			want:       "fallback",
+		},
+		{
+			name:       "string",
+			expression: "$.email",
+			want:       "email@example.com",
+		},
+		{
+			name:       "number",
+			expression: "$.age",
+			want:       "42",
+		},
+		{
+			name:       "list",
+			expression: "$.realm_access.roles",
+			want:       `["admin","user"]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapClaim(testClaims(), tt.expression, tt.fallback))
+		})
+	}
+}
+
+func TestMapBoolClaim(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		fallback   bool
+		want       bool
+	}{
+		{
+			name:       "no expression",
+			expression: "",
+			fallback:   true,
+			want:       true,
+		},
+		{
+			name:       "bool",
+			expression: "$.emails[1].verified",
+			fallback:   true,
+			want:       false,
+		},
+		{
+			name:       "string",
+			expression: "$.emails[0].verified",
+			want:       true,
+		},
+		{
+			name:       "no bool",
+			expression: "$.email",
+			fallback:   true,
+			want:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapBoolClaim(testClaims(), tt.expression, tt.fallback))
+		})
+	}
+}
+
+func TestMapMetadata(t *testing.T) {
+	got := MapMetadata(testClaims(), map[string]string{
+		"roles":      "$.realm_access.roles",
+		"department": "$['https://example.com/claims'].department",
+		"missing":    "$.missing",
+	})
+	assert.Equal(t, []*domain.Metadata{
+		{Key: "department", Value: []byte("sales")},
+		{Key: "roles", Value: []byte(`["admin","user"]`)},
+	}, got)
+}
+
+func TestValidateClaimMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *domain.IDPClaimMapping
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			mapping: nil,
+		},
+		{
+			name: "valid",
+			mapping: &domain.IDPClaimMapping{
+				Email:    "$.emails[0].value",
+				Metadata: map[string]string{"roles": "$.realm_access.roles"},
+			},
+		},
+		{
+			name: "invalid field",
+			mapping: &domain.IDPClaimMapping{
+				Email: "emails",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid metadata expression",
+			mapping: &domain.IDPClaimMapping{
+				Metadata: map[string]string{"roles": ""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty metadata key",
+			mapping: &domain.IDPClaimMapping{
+				Metadata: map[string]string{"": "$.roles"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateClaimMapping(tt.mapping)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package idp
+
+import (
+	"encoding/json"
+	"slices"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// ValidateGroupMapping checks that the claim is a valid JSONPath expression
+// and that every mapping contains a group, a project and at least one role.
+func ValidateGroupMapping(mapping *domain.IDPGroupMapping) error {
+	if mapping.IsZero() {
+		return nil
+	}
+	if _, err := ParseClaimPath(mapping.Claim); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "IDP-Jw3ks", "Errors.IDPConfig.GroupMappingInvalid")
+	}
+	for _, roleMapping := range mapping.Mappings {
+		if roleMapping == nil || roleMapping.Group == "" || roleMapping.ProjectID == "" || len(roleMapping.RoleKeys) == 0 {
+			return zerrors.ThrowInvalidArgument(nil, "IDP-Pq8xe", "Errors.IDPConfig.GroupMappingInvalid")
+		}
+	}
+	return nil
+}
+
+// UserClaims returns the raw information of the user as claims,
+// which is the JSON representation of the user as it's also stored in the intent.
+func UserClaims(user User) map[string]any {
+	data, err := json.Marshal(user)
+	if err != nil {
+		return nil
+	}
+	return RawClaims(data)
+}
+
+// RawClaims returns the JSON encoded raw information of the user as claims.
+func RawClaims(data []byte) map[string]any {
+	claims := make(map[string]any)
+	if err := json.Unmarshal(data, &claims); err != nil {
+		return nil
+	}
+	return claims
+}
+
+// MapGroups returns the groups, which the expression points to in the claims.
+// The value can either be a single group or a list of groups.
+// If the claim is missing or null, nil is returned, since the groups are unknown rather than empty.
+func MapGroups(claims map[string]any, expression string) []string {
+	value, ok := evaluateClaim(claims, expression)
+	if !ok {
+		return nil
+	}
+	values, ok := value.([]any)
+	if !ok {
+		values = []any{value}
+	}
+	groups := make([]string, 0, len(values))
+	for _, value := range values {
+		if value == nil {
+			continue
+		}
+		// wildcards on lists of lists (e.g. SAML attributes) return nested lists
+		if nested, ok := value.([]any); ok {
+			for _, group := range nested {
+				if group != nil {
+					groups = append(groups, claimToString(group))
+				}
+			}
+			continue
+		}
+		groups = append(groups, claimToString(value))
+	}
+	slices.Sort(groups)
+	return slices.Compact(groups)
+}
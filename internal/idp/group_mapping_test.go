@@ -0,0 +1,126 @@
+package idp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestMapGroups(t *testing.T) {
+	claims := RawClaims([]byte(`{
+		"groups": ["b", "a", "b"],
+		"group": "single",
+		"empty": [],
+		"unknown": null,
+		"attributes": {
+			"memberOf": ["c", "d"],
+			"role": ["e"]
+		}
+	}`))
+	tests := []struct {
+		name       string
+		expression string
+		want       []string
+	}{
+		{
+			name:       "no expression",
+			expression: "",
+			want:       nil,
+		},
+		{
+			name:       "not found",
+			expression: "$.missing",
+			want:       nil,
+		},
+		{
+			name:       "null",
+			expression: "$.unknown",
+			want:       nil,
+		},
+		{
+			name:       "empty list",
+			expression: "$.empty",
+			want:       []string{},
+		},
+		{
+			name:       "list",
+			expression: "$.groups",
+			want:       []string{"a", "b"},
+		},
+		{
+			name:       "single value",
+			expression: "$.group",
+			want:       []string{"single"},
+		},
+		{
+			name:       "attribute",
+			expression: "$.attributes['memberOf']",
+			want:       []string{"c", "d"},
+		},
+		{
+			name:       "wildcard",
+			expression: "$.attributes.*",
+			want:       []string{"c", "d", "e"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MapGroups(claims, tt.expression))
+		})
+	}
+}
+
+func TestValidateGroupMapping(t *testing.T) {
+	tests := []struct {
+		name    string
+		mapping *domain.IDPGroupMapping
+		wantErr bool
+	}{
+		{
+			name:    "nil",
+			mapping: nil,
+		},
+		{
+			name: "valid",
+			mapping: &domain.IDPGroupMapping{
+				Claim: "$.groups",
+				Mappings: []*domain.IDPGroupRoleMapping{
+					{Group: "admins", ProjectID: "project", RoleKeys: []string{"admin"}},
+				},
+			},
+		},
+		{
+			name: "invalid claim",
+			mapping: &domain.IDPGroupMapping{
+				Claim: "groups",
+				Mappings: []*domain.IDPGroupRoleMapping{
+					{Group: "admins", ProjectID: "project", RoleKeys: []string{"admin"}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing roles",
+			mapping: &domain.IDPGroupMapping{
+				Claim: "$.groups",
+				Mappings: []*domain.IDPGroupRoleMapping{
+					{Group: "admins", ProjectID: "project"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGroupMapping(tt.mapping)
+			if tt.wantErr {
+				assert.True(t, zerrors.IsErrorInvalidArgument(err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -18,6 +18,27 @@ type Provider interface {
 	IsAutoUpdate() bool
 }
 
+// LogoutProvider is implemented by providers, which allow to terminate the session of the user
+// at the provider, e.g. by redirecting the user to the OpenID Connect end_session_endpoint.
+type LogoutProvider interface {
+	LogoutURL(idTokenHint, postLogoutRedirectURI, state string) (string, error)
+}
+
+// BackChannelLogoutProvider is implemented by providers, which are able to verify a logout token
+// sent by the provider to terminate the sessions of a user (OpenID Connect Back-Channel Logout).
+type BackChannelLogoutProvider interface {
+	VerifyLogoutToken(ctx context.Context, logoutToken string) (*LogoutToken, error)
+}
+
+// LogoutToken contains the verified information of a logout token.
+// At least one of Subject and SessionID is set.
+type LogoutToken struct {
+	Subject   string
+	SessionID string
+	// JWTID (jti) identifies the token to detect its replay.
+	JWTID string
+}
+
 // User contains the information of a federated user.
 type User interface {
 	GetID() string
@@ -0,0 +1,55 @@
+package auth0
+
+import (
+	"net/url"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+const (
+	logoutEndpoint = "/v2/logout"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+var _ idp.LogoutProvider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Auth0
+type Provider struct {
+	*oidc.Provider
+	domain string
+}
+
+// New creates an Auth0 provider using the [oidc.Provider] (OIDC generic provider).
+// The domain is either the domain of the tenant (e.g. `example.eu.auth0.com`) or a custom domain.
+func New(name, domain, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	rp, err := oidc.New(name, Issuer(domain), clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+		domain:   oidc.DomainURL(domain),
+	}, nil
+}
+
+// Issuer returns the issuer of the Auth0 tenant, which (unlike most other providers) always ends with a slash.
+func Issuer(domain string) string {
+	return oidc.DomainURL(domain) + "/"
+}
+
+// LogoutURL implements the [idp.LogoutProvider] interface.
+// Auth0 only provides the end_session_endpoint if the RP-Initiated Logout is enabled on the tenant.
+// Otherwise, the Auth0 logout endpoint is used, which does not support the id_token_hint and state.
+func (p *Provider) LogoutURL(idTokenHint, postLogoutRedirectURI, state string) (string, error) {
+	if p.Provider.GetEndSessionEndpoint() != "" {
+		return p.Provider.LogoutURL(idTokenHint, postLogoutRedirectURI, state)
+	}
+	params := url.Values{
+		"client_id": {p.Provider.OAuthConfig().ClientID},
+	}
+	if postLogoutRedirectURI != "" {
+		params.Set("returnTo", postLogoutRedirectURI)
+	}
+	return oidc.AppendQuery(p.domain+logoutEndpoint, params), nil
+}
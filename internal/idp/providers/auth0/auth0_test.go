@@ -0,0 +1,50 @@
+package auth0
+
+import (
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+)
+
+func TestProvider_LogoutURL(t *testing.T) {
+	tests := []struct {
+		name               string
+		endSessionEndpoint string
+		want               string
+	}{
+		{
+			name:               "rp-initiated logout",
+			endSessionEndpoint: "https://example.eu.auth0.com/oidc/logout",
+			want:               "https://example.eu.auth0.com/oidc/logout?client_id=clientID&id_token_hint=idToken&post_logout_redirect_uri=https%3A%2F%2Fzitadel.cloud%2Flogout&state=state",
+		},
+		{
+			name: "auth0 logout",
+			want: "https://example.eu.auth0.com/v2/logout?client_id=clientID&returnTo=https%3A%2F%2Fzitadel.cloud%2Flogout",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			issuer := "https://example.eu.auth0.com/"
+			gock.New("https://example.eu.auth0.com").
+				Get(openid.DiscoveryEndpoint).
+				Reply(200).
+				JSON(&openid.DiscoveryConfiguration{
+					Issuer:                issuer,
+					AuthorizationEndpoint: issuer + "authorize",
+					TokenEndpoint:         issuer + "oauth/token",
+					UserinfoEndpoint:      issuer + "userinfo",
+					EndSessionEndpoint:    tt.endSessionEndpoint,
+				})
+
+			provider, err := New("auth0", "example.eu.auth0.com", "clientID", "clientSecret", "redirectURI", nil)
+			require.NoError(t, err)
+			got, err := provider.LogoutURL("idToken", "https://zitadel.cloud/logout", "state")
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -172,7 +172,10 @@ type User struct {
 	PreferredLanguage string               `json:"preferredLanguage"`
 	LastName          string               `json:"surname"`
 	UserPrincipalName string               `json:"userPrincipalName"`
-	isEmailVerified   bool
+	// Groups are taken from the `groups` claim of the id_token (if configured in the app registration).
+	// They are nil (and therefore `null`), if they couldn't be read, which is different from an empty list.
+	Groups          []string `json:"groups"`
+	isEmailVerified bool
 }
 
 // GetID is an implementation of the [idp.User] interface.
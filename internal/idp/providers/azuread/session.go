@@ -2,6 +2,7 @@ package azuread
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -54,34 +55,74 @@ func (s *Session) PersistentParameters() map[string]any {
 // FetchUser implements the [idp.Session] interface.
 // It will execute an OAuth 2.0 code exchange if needed to retrieve the access token,
 // call the specified userEndpoint and map the received information into an [idp.User].
-// In case of a specific TenantID as [TenantType] it will additionally extract the id_token and validate it.
+// It will additionally extract and validate the id_token to retrieve the groups of the user.
 func (s *Session) FetchUser(ctx context.Context) (user idp.User, err error) {
 	user, err = s.oauth().FetchUser(ctx)
 	if err != nil {
 		return nil, err
 	}
-	// since azure will sign the id_token always with the issuer of the application it might differ from
+	idToken, ok := s.oauth().Tokens.Extra("id_token").(string)
+	if !ok {
+		return user, nil
+	}
+	issuer := s.Provider.issuer()
+	// since azure will sign the id_token always with the issuer of the tenant of the user it might differ from
 	// the issuer the auth and token were based on, e.g. when allowing all account types to login,
 	// then the auth endpoint must be `https://login.microsoftonline.com/common/oauth2/v2.0/authorize`
 	// even though the issuer would be like `https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0`
 	if s.Provider.tenant == CommonTenant ||
 		s.Provider.tenant == OrganizationsTenant ||
 		s.Provider.tenant == ConsumersTenant {
-		return user, nil
+		issuer, err = tenantIssuer(idToken)
+		if err != nil {
+			return nil, err
+		}
 	}
-	idToken, ok := s.oauth().Tokens.Extra("id_token").(string)
-	if !ok {
-		return user, nil
-	}
-	idTokenVerifier := rp.NewIDTokenVerifier(s.Provider.issuer(), s.Provider.OAuthConfig().ClientID, rp.NewRemoteKeySet(s.Provider.HttpClient(), s.Provider.keysEndpoint()))
+	idTokenVerifier := rp.NewIDTokenVerifier(issuer, s.Provider.OAuthConfig().ClientID, rp.NewRemoteKeySet(s.Provider.HttpClient(), s.Provider.keysEndpoint()))
 	s.oauth().Tokens.IDTokenClaims, err = rp.VerifyTokens[*oidc.IDTokenClaims](ctx, s.oauth().Tokens.AccessToken, idToken, idTokenVerifier)
 	if err != nil {
 		return nil, err
 	}
 	s.oauth().Tokens.IDToken = idToken
+	if azureUser, ok := user.(*User); ok {
+		azureUser.Groups = groupsFromClaims(s.oauth().Tokens.IDTokenClaims.Claims)
+	}
 	return user, nil
 }
 
+// tenantIssuer returns the issuer of the tenant the user of the (not yet verified) id_token belongs to.
+// The signature and the issuer of the id_token must still be verified.
+func tenantIssuer(idToken string) (string, error) {
+	claims := new(struct {
+		TenantID string `json:"tid"`
+	})
+	if _, err := oidc.ParseToken(idToken, claims); err != nil {
+		return "", err
+	}
+	if claims.TenantID == "" {
+		return "", oidc.ErrIssuerInvalid
+	}
+	return fmt.Sprintf(issuerTemplate, claims.TenantID), nil
+}
+
+// groupsFromClaims returns the groups of the `groups` claim.
+// If the claim is missing, e.g. because it's not configured in the app registration
+// or the user is a member of too many groups (overage), nil is returned,
+// so the groups are treated as unknown rather than as empty.
+func groupsFromClaims(claims map[string]any) []string {
+	values, ok := claims["groups"].([]any)
+	if !ok {
+		return nil
+	}
+	groups := make([]string, 0, len(values))
+	for _, value := range values {
+		if group, ok := value.(string); ok {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
 func (s *Session) ExpiresAt() time.Time {
 	if s.OAuthSession == nil {
 		return time.Time{}
@@ -2,6 +2,7 @@ package azuread
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"net/http"
 	"testing"
@@ -414,3 +415,68 @@ func TestSession_RetrievePreviousID(t *testing.T) {
 		})
 	}
 }
+
+func Test_tenantIssuer(t *testing.T) {
+	tests := []struct {
+		name    string
+		idToken string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "invalid token",
+			idToken: "invalid",
+			wantErr: true,
+		},
+		{
+			name:    "missing tenant",
+			idToken: "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"sub"}`)) + ".signature",
+			wantErr: true,
+		},
+		{
+			name:    "tenant",
+			idToken: "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"sub","tid":"d8cdd43f-fd94-4576-8deb-f3bfea72dc2e"}`)) + ".signature",
+			want:    "https://login.microsoftonline.com/d8cdd43f-fd94-4576-8deb-f3bfea72dc2e/v2.0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tenantIssuer(tt.idToken)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_groupsFromClaims(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims map[string]any
+		want   []string
+	}{
+		{
+			name:   "missing claim",
+			claims: map[string]any{"_claim_names": map[string]any{"groups": "src1"}},
+			want:   nil,
+		},
+		{
+			name:   "empty",
+			claims: map[string]any{"groups": []any{}},
+			want:   []string{},
+		},
+		{
+			name:   "groups",
+			claims: map[string]any{"groups": []any{"group1", "group2"}},
+			want:   []string{"group1", "group2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, groupsFromClaims(tt.claims))
+		})
+	}
+}
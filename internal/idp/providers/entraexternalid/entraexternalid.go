@@ -0,0 +1,65 @@
+package entraexternalid
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+const (
+	issuerTemplate    = "https://%[1]s.ciamlogin.com/%[1]s/v2.0"
+	discoveryTemplate = "%s/%s/v2.0" + openid.DiscoveryEndpoint
+	domainSuffix      = ".ciamlogin.com"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+var _ idp.LogoutProvider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Microsoft Entra External ID (customer identity and access management)
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates a Microsoft Entra External ID provider using the [oidc.Provider] (OIDC generic provider).
+// The tenantID is the ID of the external tenant.
+// The (optional) domain is either the subdomain of the tenant (e.g. `example` for `example.ciamlogin.com`)
+// or a custom URL domain. If none is provided, the tenantID is used as subdomain.
+func New(name, tenantID, domain, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	if len(scopes) == 0 {
+		// Entra does not support the `phone` scope, which would be requested by default by the OIDC provider
+		scopes = []string{openid.ScopeOpenID, openid.ScopeProfile, openid.ScopeEmail}
+	}
+	options = append(options, oidc.WithRelyingPartyOption(rp.WithCustomDiscoveryUrl(DiscoveryEndpoint(tenantID, domain))))
+	provider, err := oidc.New(name, Issuer(tenantID), clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: provider,
+	}, nil
+}
+
+// Issuer returns the issuer of the tenant, which is always based on the tenant ID,
+// even if the endpoints are served on the subdomain or a custom URL domain.
+func Issuer(tenantID string) string {
+	return fmt.Sprintf(issuerTemplate, tenantID)
+}
+
+// DiscoveryEndpoint returns the OpenID Connect discovery endpoint of the tenant
+// served on the subdomain or the custom URL domain.
+func DiscoveryEndpoint(tenantID, domain string) string {
+	domain = strings.TrimSpace(domain)
+	if domain == "" {
+		domain = tenantID
+	}
+	// subdomain of ciamlogin.com
+	if !strings.Contains(domain, ".") {
+		domain += domainSuffix
+	}
+	return fmt.Sprintf(discoveryTemplate, oidc.DomainURL(domain), tenantID)
+}
@@ -0,0 +1,39 @@
+package entraexternalid
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscoveryEndpoint(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID string
+		domain   string
+		want     string
+	}{
+		{
+			name:     "tenant id",
+			tenantID: "tenant",
+			want:     "https://tenant.ciamlogin.com/tenant/v2.0/.well-known/openid-configuration",
+		},
+		{
+			name:     "subdomain",
+			tenantID: "tenant",
+			domain:   "example",
+			want:     "https://example.ciamlogin.com/tenant/v2.0/.well-known/openid-configuration",
+		},
+		{
+			name:     "custom url domain",
+			tenantID: "tenant",
+			domain:   "https://login.example.com/",
+			want:     "https://login.example.com/tenant/v2.0/.well-known/openid-configuration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DiscoveryEndpoint(tt.tenantID, tt.domain))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package keycloak
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+var _ idp.LogoutProvider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Keycloak
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates a Keycloak provider using the [oidc.Provider] (OIDC generic provider).
+// The issuer is based on the base URL of the Keycloak server and the realm, see [Issuer].
+func New(name, baseURL, realm, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	rp, err := oidc.New(name, Issuer(baseURL, realm), clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+// Issuer returns the issuer of the realm, e.g. `https://keycloak.example.com/realms/example`.
+// Older Keycloak versions (before 17) need the base URL to include the `/auth` context path.
+func Issuer(baseURL, realm string) string {
+	return oidc.DomainURL(baseURL) + "/realms/" + url.PathEscape(strings.TrimSpace(realm))
+}
@@ -0,0 +1,57 @@
+package keycloak
+
+import (
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestProvider_LogoutURL(t *testing.T) {
+	tests := []struct {
+		name               string
+		endSessionEndpoint string
+		want               string
+		wantErr            bool
+	}{
+		{
+			name:               "rp-initiated logout",
+			endSessionEndpoint: "https://keycloak.example.com/realms/example/protocol/openid-connect/logout",
+			want:               "https://keycloak.example.com/realms/example/protocol/openid-connect/logout?client_id=clientID&id_token_hint=idToken&post_logout_redirect_uri=https%3A%2F%2Fzitadel.cloud%2Flogout&state=state",
+		},
+		{
+			name:    "not supported",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			issuer := "https://keycloak.example.com/realms/example"
+			gock.New(issuer).
+				Get(openid.DiscoveryEndpoint).
+				Reply(200).
+				JSON(&openid.DiscoveryConfiguration{
+					Issuer:                issuer,
+					AuthorizationEndpoint: issuer + "/protocol/openid-connect/auth",
+					TokenEndpoint:         issuer + "/protocol/openid-connect/token",
+					UserinfoEndpoint:      issuer + "/protocol/openid-connect/userinfo",
+					EndSessionEndpoint:    tt.endSessionEndpoint,
+				})
+
+			provider, err := New("keycloak", "https://keycloak.example.com/", "example", "clientID", "clientSecret", "redirectURI", nil)
+			require.NoError(t, err)
+			got, err := provider.LogoutURL("idToken", "https://zitadel.cloud/logout", "state")
+			if tt.wantErr {
+				assert.True(t, zerrors.IsPreconditionFailed(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package ldap
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-ldap/ldap/v3"
+	"github.com/zitadel/logging"
+)
+
+// directorySearchPageSize is the amount of entries requested per page when searching the whole directory.
+const directorySearchPageSize = 500
+
+var ErrNoServer = errors.New("no ldap server configured")
+
+// DirectoryUser is a user found in the directory with the names of the groups it's a member of.
+type DirectoryUser struct {
+	*User
+	Groups []string
+}
+
+// SearchUsers returns all users of the directory, which match the configured user object classes.
+// The group names are read from the groupsAttribute (e.g. memberOf), if it's set.
+// The servers are tried in order until one of them returns a result.
+func (p *Provider) SearchUsers(_ context.Context, groupsAttribute string) (users []*DirectoryUser, err error) {
+	err = ErrNoServer
+	for _, server := range p.servers {
+		users, err = p.searchUsers(server, groupsAttribute)
+		if err == nil {
+			return users, nil
+		}
+		logging.WithFields("server", server).WithError(err).Info("ldap: directory search failed")
+	}
+	return nil, err
+}
+
+func (p *Provider) searchUsers(server, groupsAttribute string) ([]*DirectoryUser, error) {
+	conn, err := getConnection(server, p.startTLS, p.timeout, p.rootCA)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Bind(p.bindDN, p.bindPassword); err != nil {
+		return nil, err
+	}
+
+	attributes := p.getNecessaryAttributes()
+	if groupsAttribute != "" {
+		attributes = append(attributes, groupsAttribute)
+	}
+	searchRequest := ldap.NewSearchRequest(
+		p.baseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(p.timeout.Seconds()), false,
+		directorySearchQuery(p.userObjectClasses),
+		attributes,
+		nil,
+	)
+	sr, err := conn.SearchWithPaging(searchRequest, directorySearchPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*DirectoryUser, 0, len(sr.Entries))
+	for _, entry := range sr.Entries {
+		// a single invalid entry fails the whole search,
+		// otherwise the user would be treated as removed from the directory
+		user, err := mapLDAPEntryToUser(
+			entry,
+			p.idAttribute,
+			p.firstNameAttribute,
+			p.lastNameAttribute,
+			p.displayNameAttribute,
+			p.nickNameAttribute,
+			p.preferredUsernameAttribute,
+			p.emailAttribute,
+			p.emailVerifiedAttribute,
+			p.phoneAttribute,
+			p.phoneVerifiedAttribute,
+			p.preferredLanguageAttribute,
+			p.avatarURLAttribute,
+			p.profileAttribute,
+		)
+		if err != nil {
+			return nil, err
+		}
+		var groups []string
+		if groupsAttribute != "" {
+			groups = groupNames(entry.GetAttributeValues(groupsAttribute))
+		}
+		users = append(users, &DirectoryUser{User: user, Groups: groups})
+	}
+	return users, nil
+}
+
+// directorySearchQuery returns the filter for all entries of the object classes.
+func directorySearchQuery(classes []string) string {
+	switch len(classes) {
+	case 0:
+		return "(objectClass=*)"
+	case 1:
+		return objectClassesToSearchQuery(classes)
+	default:
+		return "(&" + objectClassesToSearchQuery(classes) + ")"
+	}
+}
+
+// groupNames returns the value of the first relative distinguished name of each group,
+// e.g. `admins` for `cn=admins,ou=groups,dc=example,dc=com`.
+// Values which aren't a distinguished name are used as they are.
+func groupNames(groups []string) []string {
+	names := make([]string, 0, len(groups))
+	for _, group := range groups {
+		dn, err := ldap.ParseDN(group)
+		if err != nil || len(dn.RDNs) == 0 || len(dn.RDNs[0].Attributes) == 0 {
+			names = append(names, group)
+			continue
+		}
+		names = append(names, dn.RDNs[0].Attributes[0].Value)
+	}
+	return names
+}
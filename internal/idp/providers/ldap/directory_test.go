@@ -0,0 +1,65 @@
+package ldap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvider_directorySearchQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes []string
+		want    string
+	}{
+		{
+			name:    "zero",
+			classes: []string{},
+			want:    "(objectClass=*)",
+		},
+		{
+			name:    "one",
+			classes: []string{"user"},
+			want:    "(objectClass=user)",
+		},
+		{
+			name:    "two",
+			classes: []string{"user", "person"},
+			want:    "(&(objectClass=user)(objectClass=person))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, directorySearchQuery(tt.classes))
+		})
+	}
+}
+
+func TestProvider_groupNames(t *testing.T) {
+	tests := []struct {
+		name   string
+		groups []string
+		want   []string
+	}{
+		{
+			name:   "zero",
+			groups: nil,
+			want:   []string{},
+		},
+		{
+			name:   "distinguished names",
+			groups: []string{"cn=admins,ou=groups,dc=example,dc=com", "CN=Sales Team,OU=Groups,DC=example,DC=com"},
+			want:   []string{"admins", "Sales Team"},
+		},
+		{
+			name:   "plain names",
+			groups: []string{"admins", "developers"},
+			want:   []string{"admins", "developers"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, groupNames(tt.groups))
+		})
+	}
+}
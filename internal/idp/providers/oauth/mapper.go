@@ -11,13 +11,15 @@ import (
 	"github.com/zitadel/zitadel/internal/idp"
 )
 
-var _ idp.User = (*UserMapper)(nil)
+var _ idp.MetadataUser = (*UserMapper)(nil)
 
 // UserMapper is an implementation of [idp.User].
 // It can be used in ZITADEL actions to map the `RawInfo`
+// or configured with a claim mapping of the identity provider.
 type UserMapper struct {
-	idAttribute string
-	RawInfo     map[string]interface{}
+	idAttribute  string
+	claimMapping domain.IDPClaimMapping
+	RawInfo      map[string]interface{}
 }
 
 func NewUserMapper(idAttribute string) *UserMapper {
@@ -27,12 +29,24 @@ func NewUserMapper(idAttribute string) *UserMapper {
 	}
 }
 
+// NewClaimUserMapper creates a [UserMapper], which maps the `RawInfo` using the claim mapping.
+func NewClaimUserMapper(idAttribute string, claimMapping *domain.IDPClaimMapping) *UserMapper {
+	mapper := NewUserMapper(idAttribute)
+	if claimMapping != nil {
+		mapper.claimMapping = *claimMapping
+	}
+	return mapper
+}
+
 func (u *UserMapper) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &u.RawInfo)
 }
 
 // GetID is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetID() string {
+	if u.claimMapping.ID != "" {
+		return idp.MapClaim(u.RawInfo, u.claimMapping.ID, "")
+	}
 	id, ok := u.RawInfo[u.idAttribute]
 	if !ok {
 		return ""
@@ -51,60 +65,65 @@ func (u *UserMapper) GetID() string {
 
 // GetFirstName is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetFirstName() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.FirstName, "")
 }
 
 // GetLastName is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetLastName() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.LastName, "")
 }
 
 // GetDisplayName is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetDisplayName() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.DisplayName, "")
 }
 
 // GetNickname is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetNickname() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.NickName, "")
 }
 
 // GetPreferredUsername is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetPreferredUsername() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.PreferredUsername, "")
 }
 
 // GetEmail is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetEmail() domain.EmailAddress {
-	return ""
+	return domain.EmailAddress(idp.MapClaim(u.RawInfo, u.claimMapping.Email, ""))
 }
 
 // IsEmailVerified is an implementation of the [idp.User] interface.
 func (u *UserMapper) IsEmailVerified() bool {
-	return false
+	return idp.MapBoolClaim(u.RawInfo, u.claimMapping.EmailVerified, false)
 }
 
 // GetPhone is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetPhone() domain.PhoneNumber {
-	return ""
+	return domain.PhoneNumber(idp.MapClaim(u.RawInfo, u.claimMapping.Phone, ""))
 }
 
 // IsPhoneVerified is an implementation of the [idp.User] interface.
 func (u *UserMapper) IsPhoneVerified() bool {
-	return false
+	return idp.MapBoolClaim(u.RawInfo, u.claimMapping.PhoneVerified, false)
 }
 
 // GetPreferredLanguage is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetPreferredLanguage() language.Tag {
-	return language.Und
+	return language.Make(idp.MapClaim(u.RawInfo, u.claimMapping.PreferredLanguage, ""))
 }
 
 // GetAvatarURL is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetAvatarURL() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.AvatarURL, "")
 }
 
 // GetProfile is an implementation of the [idp.User] interface.
 func (u *UserMapper) GetProfile() string {
-	return ""
+	return idp.MapClaim(u.RawInfo, u.claimMapping.Profile, "")
+}
+
+// GetMetadata is an implementation of the [idp.MetadataUser] interface.
+func (u *UserMapper) GetMetadata() []*domain.Metadata {
+	return idp.MapMetadata(u.RawInfo, u.claimMapping.Metadata)
 }
@@ -44,6 +44,7 @@ func TestProvider_FetchUser(t *testing.T) {
 		preferredLanguage language.Tag
 		avatarURL         string
 		profile           string
+		metadata          []*domain.Metadata
 	}
 	tests := []struct {
 		name   string
@@ -231,6 +232,101 @@ func TestProvider_FetchUser(t *testing.T) {
 				profile:           "",
 			},
 		},
+		{
+			name: "successful fetch with claim mapping",
+			fields: fields{
+				config: &oauth2.Config{
+					ClientID:     "clientID",
+					ClientSecret: "clientSecret",
+					Endpoint: oauth2.Endpoint{
+						AuthURL:  "https://oauth2.com/authorize",
+						TokenURL: "https://oauth2.com/token",
+					},
+					RedirectURL: "redirectURI",
+					Scopes:      []string{"user"},
+				},
+				userEndpoint: "https://oauth2.com/user",
+				httpMock: func(issuer string) {
+					gock.New(issuer).
+						Get("/user").
+						Reply(200).
+						JSON(map[string]interface{}{
+							"userID": "id",
+							"profile": map[string]interface{}{
+								"givenName":  "first",
+								"familyName": "last",
+								"locale":     "de",
+							},
+							"mails": []interface{}{
+								map[string]interface{}{"address": "email@example.com", "verified": true},
+							},
+							"roles": []interface{}{"admin", "user"},
+						})
+				},
+				userMapper: func() idp.User {
+					return NewClaimUserMapper("userID", &domain.IDPClaimMapping{
+						FirstName:         "$.profile.givenName",
+						LastName:          "$.profile.familyName",
+						Email:             "$.mails[0].address",
+						EmailVerified:     "$.mails[0].verified",
+						PreferredLanguage: "$.profile.locale",
+						Metadata: map[string]string{
+							"roles": "$.roles",
+						},
+					})
+				},
+				authURL: "https://issuer.com/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=user&state=testState",
+				tokens: &oidc.Tokens[*oidc.IDTokenClaims]{
+					Token: &oauth2.Token{
+						AccessToken: "accessToken",
+						TokenType:   oidc.BearerToken,
+					},
+				},
+			},
+			want: want{
+				user: &UserMapper{
+					idAttribute: "userID",
+					claimMapping: domain.IDPClaimMapping{
+						FirstName:         "$.profile.givenName",
+						LastName:          "$.profile.familyName",
+						Email:             "$.mails[0].address",
+						EmailVerified:     "$.mails[0].verified",
+						PreferredLanguage: "$.profile.locale",
+						Metadata: map[string]string{
+							"roles": "$.roles",
+						},
+					},
+					RawInfo: map[string]interface{}{
+						"userID": "id",
+						"profile": map[string]interface{}{
+							"givenName":  "first",
+							"familyName": "last",
+							"locale":     "de",
+						},
+						"mails": []interface{}{
+							map[string]interface{}{"address": "email@example.com", "verified": true},
+						},
+						"roles": []interface{}{"admin", "user"},
+					},
+				},
+				id:                "id",
+				firstName:         "first",
+				lastName:          "last",
+				displayName:       "",
+				nickName:          "",
+				preferredUsername: "",
+				email:             "email@example.com",
+				isEmailVerified:   true,
+				phone:             "",
+				isPhoneVerified:   false,
+				preferredLanguage: language.German,
+				avatarURL:         "",
+				profile:           "",
+				metadata: []*domain.Metadata{
+					{Key: "roles", Value: []byte(`["admin","user"]`)},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -268,6 +364,7 @@ func TestProvider_FetchUser(t *testing.T) {
 				a.Equal(tt.want.preferredLanguage, user.GetPreferredLanguage())
 				a.Equal(tt.want.avatarURL, user.GetAvatarURL())
 				a.Equal(tt.want.profile, user.GetProfile())
+				a.Equal(tt.want.metadata, user.(idp.MetadataUser).GetMetadata())
 			}
 		})
 	}
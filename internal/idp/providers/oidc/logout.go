@@ -0,0 +1,115 @@
+package oidc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	backChannelLogoutEvent = "http://schemas.openid.net/event/backchannel-logout"
+	// logoutTokenType is the explicit type of logout tokens (OpenID Connect Back-Channel Logout, section 2.4)
+	logoutTokenType = "logout+jwt"
+)
+
+var _ idp.BackChannelLogoutProvider = (*Provider)(nil)
+
+// VerifyLogoutToken implements the [idp.BackChannelLogoutProvider] interface.
+// It verifies the logout token sent by the provider according to the OpenID Connect Back-Channel Logout specification
+// using the keys of the provider.
+func (p *Provider) VerifyLogoutToken(ctx context.Context, logoutToken string) (*idp.LogoutToken, error) {
+	return VerifyLogoutToken(ctx, logoutToken, p.RelyingParty.IDTokenVerifier())
+}
+
+type logoutTokenClaims struct {
+	oidc.LogoutTokenClaims
+}
+
+// SetSignatureAlgorithm implements the [oidc.ClaimsSignature] interface.
+// The algorithm is already restricted by the verifier and does not need to be kept.
+func (c *logoutTokenClaims) SetSignatureAlgorithm(jose.SignatureAlgorithm) {}
+
+// VerifyLogoutToken verifies the type, signature, issuer, audience, issued at, expiration and events of the logout token
+// and makes sure it contains a JWT ID and a subject and / or session id, but no nonce.
+// The replay of the token (JWT ID) has to be checked by the caller.
+func VerifyLogoutToken(ctx context.Context, logoutToken string, verifier *rp.IDTokenVerifier) (*idp.LogoutToken, error) {
+	decrypted, err := oidc.DecryptToken(logoutToken)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "OIDC-Ahb4o", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	claims := new(logoutTokenClaims)
+	payload, err := oidc.ParseToken(decrypted, claims)
+	if err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "OIDC-Eeng2", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if err = checkLogoutTokenType(decrypted); err != nil {
+		return nil, err
+	}
+	if err = oidc.CheckSignature(ctx, decrypted, payload, claims, verifier.SupportedSignAlgs, verifier.KeySet); err != nil {
+		return nil, zerrors.ThrowInvalidArgument(err, "OIDC-Tha6u", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if claims.Issuer != verifier.Issuer {
+		return nil, zerrors.ThrowInvalidArgument(oidc.ErrIssuerInvalid, "OIDC-ooT4a", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if !slices.Contains(claims.Audience, verifier.ClientID) {
+		return nil, zerrors.ThrowInvalidArgument(oidc.ErrAudience, "OIDC-Iet9e", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	now := time.Now()
+	if claims.IssuedAt == 0 || claims.IssuedAt.AsTime().After(now.Add(verifier.Offset)) {
+		return nil, zerrors.ThrowInvalidArgument(oidc.ErrIatInFuture, "OIDC-Xoo3i", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if claims.Expiration != 0 && !now.Add(-verifier.Offset).Before(claims.Expiration.AsTime()) {
+		return nil, zerrors.ThrowInvalidArgument(oidc.ErrExpired, "OIDC-ieQu7", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if _, ok := claims.Events[backChannelLogoutEvent]; !ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "OIDC-Ohd3a", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if claims.JWTID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "OIDC-ooC4i", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if claims.Subject == "" && claims.SessionID == "" {
+		return nil, zerrors.ThrowInvalidArgument(nil, "OIDC-Ri0qu", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if _, ok := claims.Claims["nonce"]; ok {
+		return nil, zerrors.ThrowInvalidArgument(nil, "OIDC-ahQu5", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	return &idp.LogoutToken{
+		Subject:   claims.Subject,
+		SessionID: claims.SessionID,
+		JWTID:     claims.JWTID,
+	}, nil
+}
+
+// checkLogoutTokenType makes sure that no other JWT of the provider, e.g. an id_token, is used as logout token.
+// Explicit typing is only recommended by the specification, so tokens without a typ header are still accepted.
+func checkLogoutTokenType(token string) error {
+	encodedHeader, _, _ := strings.Cut(token, ".")
+	decodedHeader, err := base64.RawURLEncoding.DecodeString(encodedHeader)
+	if err != nil {
+		return zerrors.ThrowInvalidArgument(err, "OIDC-Ou4ie", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	header := new(struct {
+		Type string `json:"typ"`
+	})
+	if err = json.Unmarshal(decodedHeader, header); err != nil {
+		return zerrors.ThrowInvalidArgument(err, "OIDC-Ou4ie", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	if header.Type == "" {
+		return nil
+	}
+	// the media type might also be specified with the application/ prefix (RFC 7515, section 4.1.9)
+	if !strings.EqualFold(strings.TrimPrefix(strings.ToLower(header.Type), "application/"), logoutTokenType) {
+		return zerrors.ThrowInvalidArgument(nil, "OIDC-Aeth6", "Errors.IDPConfig.LogoutTokenInvalid")
+	}
+	return nil
+}
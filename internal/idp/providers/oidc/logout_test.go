@@ -0,0 +1,166 @@
+package oidc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zitadel/oidc/v3/pkg/client/rp"
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type staticKeySet struct {
+	keys *jose.JSONWebKeySet
+}
+
+func (s *staticKeySet) VerifySignature(_ context.Context, jws *jose.JSONWebSignature) ([]byte, error) {
+	return jws.Verify(s.keys.Keys[0])
+}
+
+func TestVerifyLogoutToken(t *testing.T) {
+	issuer := "https://issuer.com"
+	logoutClaims := func(modify func(claims *oidc.LogoutTokenClaims)) *oidc.LogoutTokenClaims {
+		claims := oidc.NewLogoutTokenClaims(issuer, "sub", []string{"clientID"}, time.Now().Add(time.Minute), "jti", "sid", time.Second)
+		if modify != nil {
+			modify(claims)
+		}
+		return claims
+	}
+	tests := []struct {
+		name    string
+		token   func(t *testing.T) string
+		want    *idp.LogoutToken
+		wantErr error
+	}{
+		{
+			name: "invalid token",
+			token: func(*testing.T) string {
+				return "invalid"
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-Eeng2", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "invalid type",
+			token: func(t *testing.T) string {
+				return signTypedToken(t, logoutClaims(nil), "JWT")
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-Aeth6", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "invalid issuer",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Issuer = "https://other.com"
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-ooT4a", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "invalid audience",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Audience = []string{"other"}
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-Iet9e", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "expired",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Expiration = oidc.FromTime(time.Now().Add(-time.Minute))
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-ieQu7", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "missing event",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Events = nil
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-Ohd3a", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "missing jwt id",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.JWTID = ""
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-ooC4i", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "missing subject and session id",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Subject = ""
+					claims.SessionID = ""
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-Ri0qu", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "nonce not allowed",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Claims = map[string]any{"nonce": "nonce"}
+				}))
+			},
+			wantErr: zerrors.ThrowInvalidArgument(nil, "OIDC-ahQu5", "Errors.IDPConfig.LogoutTokenInvalid"),
+		},
+		{
+			name: "successful verification",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(nil))
+			},
+			want: &idp.LogoutToken{
+				Subject:   "sub",
+				SessionID: "sid",
+				JWTID:     "jti",
+			},
+		},
+		{
+			name: "successful verification, explicitly typed",
+			token: func(t *testing.T) string {
+				return signTypedToken(t, logoutClaims(nil), "logout+jwt")
+			},
+			want: &idp.LogoutToken{
+				Subject:   "sub",
+				SessionID: "sid",
+				JWTID:     "jti",
+			},
+		},
+		{
+			name: "successful verification, session id only",
+			token: func(t *testing.T) string {
+				return signToken(t, logoutClaims(func(claims *oidc.LogoutTokenClaims) {
+					claims.Subject = ""
+				}))
+			},
+			want: &idp.LogoutToken{
+				SessionID: "sid",
+				JWTID:     "jti",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := rp.NewIDTokenVerifier(issuer, "clientID", &staticKeySet{keys: keys(t)})
+			got, err := VerifyLogoutToken(context.Background(), tt.token(t), verifier)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
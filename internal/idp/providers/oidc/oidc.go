@@ -2,12 +2,16 @@ package oidc
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
 	"github.com/zitadel/oidc/v3/pkg/client/rp"
 	"github.com/zitadel/oidc/v3/pkg/oidc"
 	"golang.org/x/oauth2"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 var _ idp.Provider = (*Provider)(nil)
@@ -100,6 +104,13 @@ var DefaultMapper UserInfoMapper = func(info *oidc.UserInfo) idp.User {
 	return NewUser(info)
 }
 
+// ClaimMapper returns a [UserInfoMapper], which maps the claims using the claim mapping.
+func ClaimMapper(claimMapping *domain.IDPClaimMapping) UserInfoMapper {
+	return func(info *oidc.UserInfo) idp.User {
+		return NewClaimUser(info, claimMapping)
+	}
+}
+
 // New creates a generic OIDC provider
 func New(name, issuer, clientID, clientSecret, redirectURI string, scopes []string, userInfoMapper UserInfoMapper, options ...ProviderOpts) (provider *Provider, err error) {
 	provider = &Provider{
@@ -188,3 +199,54 @@ func (p *Provider) IsAutoCreation() bool {
 func (p *Provider) IsAutoUpdate() bool {
 	return p.isAutoUpdate
 }
+
+// User returns an empty [idp.User] of the provider, e.g. to unmarshal a stored user into.
+// If the provider could not be initialized (e.g. discovery failed), the default user is returned.
+func (p *Provider) User() idp.User {
+	if p == nil || p.userInfoMapper == nil {
+		return InitUser()
+	}
+	return p.userInfoMapper(&oidc.UserInfo{})
+}
+
+// LogoutURL implements the [idp.LogoutProvider] interface.
+// It returns the end_session_endpoint of the provider (OpenID Connect RP-Initiated Logout)
+// including the id_token_hint, client_id, post_logout_redirect_uri and state (if provided).
+func (p *Provider) LogoutURL(idTokenHint, postLogoutRedirectURI, state string) (string, error) {
+	endSessionEndpoint := p.RelyingParty.GetEndSessionEndpoint()
+	if endSessionEndpoint == "" {
+		return "", zerrors.ThrowPreconditionFailed(nil, "OIDC-Lw3ns", "Errors.IDPConfig.LogoutNotSupported")
+	}
+	params := url.Values{
+		"client_id": {p.RelyingParty.OAuthConfig().ClientID},
+	}
+	if idTokenHint != "" {
+		params.Set("id_token_hint", idTokenHint)
+	}
+	if postLogoutRedirectURI != "" {
+		params.Set("post_logout_redirect_uri", postLogoutRedirectURI)
+	}
+	if state != "" {
+		params.Set("state", state)
+	}
+	return AppendQuery(endSessionEndpoint, params), nil
+}
+
+// AppendQuery appends the params to the query of the endpoint.
+func AppendQuery(endpoint string, params url.Values) string {
+	separator := "?"
+	if strings.Contains(endpoint, "?") {
+		separator = "&"
+	}
+	return endpoint + separator + params.Encode()
+}
+
+// DomainURL returns the https URL of the domain (without trailing slash).
+// The domain can be provided with or without the scheme, e.g. `example.com` or `https://example.com/`.
+func DomainURL(domain string) string {
+	domain = strings.TrimSuffix(strings.TrimSpace(domain), "/")
+	if strings.HasPrefix(domain, "https://") || strings.HasPrefix(domain, "http://") {
+		return domain
+	}
+	return "https://" + domain
+}
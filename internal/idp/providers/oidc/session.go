@@ -2,6 +2,7 @@ package oidc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -100,58 +101,110 @@ func InitUser() *User {
 	return &User{UserInfo: &oidc.UserInfo{}}
 }
 
+// NewClaimUser creates a [User], which maps the claims using the claim mapping.
+func NewClaimUser(info *oidc.UserInfo, claimMapping *domain.IDPClaimMapping) *User {
+	user := NewUser(info)
+	if claimMapping != nil {
+		user.claimMapping = *claimMapping
+	}
+	return user
+}
+
 type User struct {
 	*oidc.UserInfo
+	claimMapping domain.IDPClaimMapping
+	claims       map[string]any
+}
+
+// mapClaim returns the mapped claim or the fallback, if the field isn't mapped.
+func (u *User) mapClaim(expression, fallback string) string {
+	if expression == "" {
+		return fallback
+	}
+	return idp.MapClaim(u.allClaims(), expression, fallback)
+}
+
+// allClaims returns the standard and additional claims of the user info.
+func (u *User) allClaims() map[string]any {
+	if u.claims != nil {
+		return u.claims
+	}
+	u.claims = make(map[string]any)
+	data, err := json.Marshal(u.UserInfo)
+	if err != nil {
+		return u.claims
+	}
+	if err = json.Unmarshal(data, &u.claims); err != nil {
+		u.claims = make(map[string]any)
+	}
+	return u.claims
 }
 
 func (u *User) GetID() string {
-	return u.Subject
+	return u.mapClaim(u.claimMapping.ID, u.Subject)
 }
 
 func (u *User) GetFirstName() string {
-	return u.GivenName
+	return u.mapClaim(u.claimMapping.FirstName, u.GivenName)
 }
 
 func (u *User) GetLastName() string {
-	return u.FamilyName
+	return u.mapClaim(u.claimMapping.LastName, u.FamilyName)
 }
 
 func (u *User) GetDisplayName() string {
-	return u.Name
+	return u.mapClaim(u.claimMapping.DisplayName, u.Name)
 }
 
 func (u *User) GetNickname() string {
-	return u.Nickname
+	return u.mapClaim(u.claimMapping.NickName, u.Nickname)
 }
 
 func (u *User) GetPreferredUsername() string {
-	return u.PreferredUsername
+	return u.mapClaim(u.claimMapping.PreferredUsername, u.PreferredUsername)
 }
 
 func (u *User) GetEmail() domain.EmailAddress {
-	return domain.EmailAddress(u.UserInfo.Email)
+	return domain.EmailAddress(u.mapClaim(u.claimMapping.Email, string(u.UserInfo.Email)))
 }
 
 func (u *User) IsEmailVerified() bool {
-	return bool(u.EmailVerified)
+	if u.claimMapping.EmailVerified != "" {
+		return idp.MapBoolClaim(u.allClaims(), u.claimMapping.EmailVerified, bool(u.UserInfo.EmailVerified))
+	}
+	return bool(u.UserInfo.EmailVerified)
 }
 
 func (u *User) GetPhone() domain.PhoneNumber {
-	return domain.PhoneNumber(u.PhoneNumber)
+	return domain.PhoneNumber(u.mapClaim(u.claimMapping.Phone, u.PhoneNumber))
 }
 
 func (u *User) IsPhoneVerified() bool {
+	if u.claimMapping.PhoneVerified != "" {
+		return idp.MapBoolClaim(u.allClaims(), u.claimMapping.PhoneVerified, u.PhoneNumberVerified)
+	}
 	return u.PhoneNumberVerified
 }
 
 func (u *User) GetPreferredLanguage() language.Tag {
+	if u.claimMapping.PreferredLanguage != "" {
+		return language.Make(u.mapClaim(u.claimMapping.PreferredLanguage, u.Locale.Tag().String()))
+	}
 	return u.Locale.Tag()
 }
 
 func (u *User) GetAvatarURL() string {
-	return u.Picture
+	return u.mapClaim(u.claimMapping.AvatarURL, u.Picture)
 }
 
 func (u *User) GetProfile() string {
-	return u.Profile
+	return u.mapClaim(u.claimMapping.Profile, u.Profile)
+}
+
+// GetMetadata is an implementation of the [idp.MetadataUser] interface.
+func (u *User) GetMetadata() []*domain.Metadata {
+	if len(u.claimMapping.Metadata) == 0 {
+		return nil
+	}
+	return idp.MapMetadata(u.allClaims(), u.claimMapping.Metadata)
 }
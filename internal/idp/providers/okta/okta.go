@@ -0,0 +1,36 @@
+package okta
+
+import (
+	"github.com/zitadel/zitadel/internal/idp"
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+var _ idp.Provider = (*Provider)(nil)
+var _ idp.LogoutProvider = (*Provider)(nil)
+
+// Provider is the [idp.Provider] implementation for Okta
+type Provider struct {
+	*oidc.Provider
+}
+
+// New creates an Okta provider using the [oidc.Provider] (OIDC generic provider).
+// The issuer is based on the domain of the Okta organization and the (optional) ID of a custom authorization server,
+// see [Issuer].
+func New(name, domain, authorizationServerID, clientID, clientSecret, redirectURI string, scopes []string, options ...oidc.ProviderOpts) (*Provider, error) {
+	rp, err := oidc.New(name, Issuer(domain, authorizationServerID), clientID, clientSecret, redirectURI, scopes, oidc.DefaultMapper, options...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		Provider: rp,
+	}, nil
+}
+
+// Issuer returns the issuer of the Okta org authorization server (e.g. `https://example.okta.com`)
+// or of the custom authorization server, if an ID is provided (e.g. `https://example.okta.com/oauth2/default`).
+func Issuer(domain, authorizationServerID string) string {
+	if authorizationServerID == "" {
+		return oidc.DomainURL(domain)
+	}
+	return oidc.DomainURL(domain) + "/oauth2/" + authorizationServerID
+}
@@ -0,0 +1,71 @@
+package okta
+
+import (
+	"context"
+	"testing"
+
+	"github.com/h2non/gock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	openid "github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/idp/providers/oidc"
+)
+
+func TestProvider_BeginAuth(t *testing.T) {
+	type fields struct {
+		domain                string
+		authorizationServerID string
+		scopes                []string
+	}
+	tests := []struct {
+		name     string
+		fields   fields
+		issuer   string
+		wantAuth string
+	}{
+		{
+			name: "org authorization server",
+			fields: fields{
+				domain: "example.okta.com",
+				scopes: []string{"openid"},
+			},
+			issuer:   "https://example.okta.com",
+			wantAuth: "https://example.okta.com/oauth2/v1/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid&state=testState",
+		},
+		{
+			name: "custom authorization server",
+			fields: fields{
+				domain:                "https://example.okta.com/",
+				authorizationServerID: "default",
+				scopes:                []string{"openid"},
+			},
+			issuer:   "https://example.okta.com/oauth2/default",
+			wantAuth: "https://example.okta.com/oauth2/default/v1/authorize?client_id=clientID&redirect_uri=redirectURI&response_type=code&scope=openid&state=testState",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer gock.Off()
+			authorizationEndpoint := tt.issuer + "/v1/authorize"
+			if tt.fields.authorizationServerID == "" {
+				authorizationEndpoint = tt.issuer + "/oauth2/v1/authorize"
+			}
+			gock.New(tt.issuer).
+				Get(openid.DiscoveryEndpoint).
+				Reply(200).
+				JSON(&openid.DiscoveryConfiguration{
+					Issuer:                tt.issuer,
+					AuthorizationEndpoint: authorizationEndpoint,
+					TokenEndpoint:         tt.issuer + "/v1/token",
+					UserinfoEndpoint:      tt.issuer + "/v1/userinfo",
+				})
+
+			provider, err := New("okta", tt.fields.domain, tt.fields.authorizationServerID, "clientID", "clientSecret", "redirectURI", tt.fields.scopes)
+			require.NoError(t, err)
+			session, err := provider.BeginAuth(context.Background(), "testState")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAuth, session.(*oidc.Session).AuthURL)
+		})
+	}
+}
@@ -24,6 +24,7 @@ type Client struct {
 	client  *http.Client
 	baseURL string
 	Users   *ResourceClient[resources.ScimUser]
+	Groups  *ResourceClient[resources.ScimGroup]
 }
 
 type ResourceClient[T any] struct {
@@ -59,6 +60,9 @@ type ListRequest struct {
 	SortBy    *string               `json:"sortBy,omitempty"`
 	SortOrder *ListRequestSortOrder `json:"sortOrder,omitempty"`
 
+	// Cursor enables cursor-based pagination, an empty cursor requests the first page.
+	Cursor *string `json:"cursor,omitempty"`
+
 	SendAsPost bool
 }
 
@@ -74,6 +78,7 @@ type ListResponse[T any] struct {
 	ItemsPerPage int                      `json:"itemsPerPage"`
 	TotalResults int                      `json:"totalResults"`
 	StartIndex   int                      `json:"startIndex"`
+	NextCursor   string                   `json:"nextCursor"`
 	Resources    []T                      `json:"Resources"`
 }
 
@@ -81,6 +86,12 @@ type BulkRequest struct {
 	Schemas      []schemas.ScimSchemaType `json:"schemas"`
 	FailOnErrors *int                     `json:"failOnErrors"`
 	Operations   []*BulkRequestOperation  `json:"Operations"`
+
+	ZitadelOptions *BulkRequestZitadelOptions `json:"urn:ietf:params:scim:api:zitadel:messages:2.0:BulkRequest,omitempty"`
+}
+
+type BulkRequestZitadelOptions struct {
+	Transactional bool `json:"transactional"`
 }
 
 type BulkRequestOperation struct {
@@ -109,6 +120,7 @@ const (
 	listQueryParamCount      = "count"
 	listQueryParamStartIndex = "startIndex"
 	listQueryParamFilter     = "filter"
+	listQueryParamCursor     = "cursor"
 )
 
 func NewScimClient(target string) *Client {
@@ -122,6 +134,11 @@ func NewScimClient(target string) *Client {
 			baseURL:      target,
 			resourceName: "Users",
 		},
+		Groups: &ResourceClient[resources.ScimGroup]{
+			client:       client,
+			baseURL:      target,
+			resourceName: "Groups",
+		},
 	}
 }
 
@@ -211,6 +228,10 @@ func (c *ResourceClient[T]) List(ctx context.Context, orgID string, req *ListReq
 		query.Set(listQueryParamFilter, *req.Filter)
 	}
 
+	if req.Cursor != nil {
+		query.Set(listQueryParamCursor, *req.Cursor)
+	}
+
 	err = c.doWithResponse(ctx, http.MethodGet, orgID, "?"+query.Encode(), nil, listResponse)
 	return listResponse, err
 }
@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/zitadel/oidc/v3/pkg/oidc"
+
+	"github.com/zitadel/zitadel/internal/command"
+	zcrypto "github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/notification/channels/webhook"
+	"github.com/zitadel/zitadel/internal/notification/types"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthNotificationsProjectionTable = "projections.notifications_backchannel_auth"
+)
+
+// backChannelAuthNotifier informs clients using the CIBA ping mode
+// about the completion (approval or denial) of a backchannel authentication request.
+type backChannelAuthNotifier struct {
+	commands         *command.Commands
+	queries          *NotificationQueries
+	eventstore       *eventstore.Eventstore
+	keyEncryptionAlg zcrypto.EncryptionAlgorithm
+	channels         types.ChannelChains
+}
+
+func NewBackChannelAuthNotifier(
+	ctx context.Context,
+	config handler.Config,
+	commands *command.Commands,
+	queries *NotificationQueries,
+	es *eventstore.Eventstore,
+	keyEncryptionAlg zcrypto.EncryptionAlgorithm,
+	channels types.ChannelChains,
+) *handler.Handler {
+	return handler.NewHandler(ctx, &config, &backChannelAuthNotifier{
+		commands:         commands,
+		queries:          queries,
+		eventstore:       es,
+		keyEncryptionAlg: keyEncryptionAlg,
+		channels:         channels,
+	})
+}
+
+func (*backChannelAuthNotifier) Name() string {
+	return BackChannelAuthNotificationsProjectionTable
+}
+
+func (u *backChannelAuthNotifier) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: backchannelauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  backchannelauth.ApprovedEventType,
+					Reduce: u.reduceApproved,
+				},
+				{
+					Event:  backchannelauth.CanceledEventType,
+					Reduce: u.reduceCanceled,
+				},
+			},
+		},
+	}
+}
+
+func (u *backChannelAuthNotifier) reduceApproved(event eventstore.Event) (*handler.Statement, error) {
+	if _, ok := event.(*backchannelauth.ApprovedEvent); !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Ohng4", "reduce.wrong.event.type %s", backchannelauth.ApprovedEventType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		return u.notify(event)
+	}), nil
+}
+
+func (u *backChannelAuthNotifier) reduceCanceled(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*backchannelauth.CanceledEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ieR4a", "reduce.wrong.event.type %s", backchannelauth.CanceledEventType)
+	}
+	return handler.NewStatement(event, func(ex handler.Executer, projectionName string) error {
+		// expired requests are reported to the client on its next token request
+		if e.Reason != domain.BackChannelAuthCanceledDenied {
+			return nil
+		}
+		return u.notify(event)
+	}), nil
+}
+
+func (u *backChannelAuthNotifier) notify(event eventstore.Event) error {
+	ctx, err := u.queries.HandlerContext(event.Aggregate())
+	if err != nil {
+		return err
+	}
+	request := &backChannelAuthNotification{authReqID: event.Aggregate().ID}
+	if err = u.eventstore.FilterToQueryReducer(ctx, request); err != nil {
+		return err
+	}
+	// only clients using the ping mode provide a notification endpoint
+	if request.endpoint == "" || request.token == nil {
+		return nil
+	}
+	token, err := zcrypto.DecryptString(request.token, u.keyEncryptionAlg)
+	if err != nil {
+		return err
+	}
+	headers := make(http.Header)
+	headers.Set("Authorization", oidc.PrefixBearer+token)
+	return types.SendJSON(
+		ctx,
+		webhook.Config{
+			CallURL: request.endpoint,
+			Method:  http.MethodPost,
+			Headers: headers,
+		},
+		u.channels,
+		&BackChannelAuthPingMessage{AuthReqID: request.authReqID},
+		event.Type(),
+	).WithoutTemplate()
+}
+
+// BackChannelAuthPingMessage is the payload sent to the client notification endpoint
+// as defined in https://openid.net/specs/openid-client-initiated-backchannel-authentication-core-1_0.html#rfc.section.10.2
+type BackChannelAuthPingMessage struct {
+	AuthReqID string `json:"auth_req_id"`
+}
+
+type backChannelAuthNotification struct {
+	authReqID string
+	endpoint  string
+	token     *zcrypto.CryptoValue
+}
+
+func (b *backChannelAuthNotification) Reduce() error {
+	return nil
+}
+
+func (b *backChannelAuthNotification) AppendEvents(events ...eventstore.Event) {
+	for _, event := range events {
+		if e, ok := event.(*backchannelauth.AddedEvent); ok {
+			b.endpoint = e.ClientNotificationEndpoint
+			b.token = e.ClientNotificationToken
+		}
+	}
+}
+
+func (b *backChannelAuthNotification) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(backchannelauth.AggregateType).
+		AggregateIDs(b.authReqID).
+		EventTypes(backchannelauth.AddedEventType).
+		Builder()
+}
@@ -60,6 +60,15 @@ func Register(
 		c,
 		tokenLifetime,
 	))
+	projections = append(projections, handlers.NewBackChannelAuthNotifier(
+		ctx,
+		projection.ApplyCustomConfig(backChannelLogoutHandlerCustomConfig),
+		commands,
+		q,
+		es,
+		keysEncryptionAlg,
+		c,
+	))
 	if telemetryCfg.Enabled {
 		projections = append(projections, handlers.NewTelemetryPusher(ctx, telemetryCfg, projection.ApplyCustomConfig(telemetryHandlerCustomConfig), commands, q, c))
 	}
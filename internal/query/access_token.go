@@ -36,6 +36,11 @@ type OIDCSessionAccessTokenReadModel struct {
 	UserAgent             *domain.UserAgent
 	Reason                domain.TokenReason
 	Actor                 *domain.TokenActor
+	DPoPJKT               string
+	CertificateThumbprint string
+	AuthorizationDetails  domain.AuthorizationDetails
+
+	grantedAuthorizationDetails domain.AuthorizationDetails
 }
 
 func newOIDCSessionAccessTokenReadModel(id string) *OIDCSessionAccessTokenReadModel {
@@ -56,6 +61,10 @@ func (wm *OIDCSessionAccessTokenReadModel) Reduce() error {
 		case *oidcsession.AccessTokenRevokedEvent,
 			*oidcsession.RefreshTokenRevokedEvent:
 			wm.reduceTokenRevoked(event)
+		case *oidcsession.DPoPBoundEvent:
+			wm.DPoPJKT = e.JKT
+		case *oidcsession.CertificateBoundEvent:
+			wm.CertificateThumbprint = e.Thumbprint
 		}
 	}
 	return wm.ReadModel.Reduce()
@@ -71,6 +80,8 @@ func (wm *OIDCSessionAccessTokenReadModel) Query() *eventstore.SearchQueryBuilde
 			oidcsession.AccessTokenAddedType,
 			oidcsession.AccessTokenRevokedType,
 			oidcsession.RefreshTokenRevokedType,
+			oidcsession.DPoPBoundType,
+			oidcsession.CertificateBoundType,
 		).
 		Builder()
 }
@@ -86,6 +97,7 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAdded(e *oidcsession.AddedEvent
 	wm.Nonce = e.Nonce
 	wm.PreferredLanguage = e.PreferredLanguage
 	wm.UserAgent = e.UserAgent
+	wm.grantedAuthorizationDetails = e.AuthorizationDetails
 	wm.State = domain.OIDCSessionStateActive
 }
 
@@ -95,6 +107,11 @@ func (wm *OIDCSessionAccessTokenReadModel) reduceAccessTokenAdded(e *oidcsession
 	wm.AccessTokenExpiration = e.CreationDate().Add(e.Lifetime)
 	wm.Reason = e.Reason
 	wm.Actor = e.Actor
+	// the access token might be narrowed to a subset of the granted authorization details
+	wm.AuthorizationDetails = wm.grantedAuthorizationDetails
+	if len(e.AuthorizationDetails) > 0 {
+		wm.AuthorizationDetails = e.AuthorizationDetails
+	}
 }
 
 func (wm *OIDCSessionAccessTokenReadModel) reduceTokenRevoked(e eventstore.Event) {
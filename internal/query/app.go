@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"slices"
 	"time"
@@ -43,27 +44,32 @@ type App struct {
 }
 
 type OIDCApp struct {
-	RedirectURIs             database.TextArray[string]
-	ResponseTypes            database.NumberArray[domain.OIDCResponseType]
-	GrantTypes               database.NumberArray[domain.OIDCGrantType]
-	AppType                  domain.OIDCApplicationType
-	ClientID                 string
-	AuthMethodType           domain.OIDCAuthMethodType
-	PostLogoutRedirectURIs   database.TextArray[string]
-	Version                  domain.OIDCVersion
-	ComplianceProblems       database.TextArray[string]
-	IsDevMode                bool
-	AccessTokenType          domain.OIDCTokenType
-	AssertAccessTokenRole    bool
-	AssertIDTokenRole        bool
-	AssertIDTokenUserinfo    bool
-	ClockSkew                time.Duration
-	AdditionalOrigins        database.TextArray[string]
-	AllowedOrigins           database.TextArray[string]
-	SkipNativeAppSuccessPage bool
-	BackChannelLogoutURI     string
-	LoginVersion             domain.LoginVersion
-	LoginBaseURI             *string
+	RedirectURIs                          database.TextArray[string]
+	ResponseTypes                         database.NumberArray[domain.OIDCResponseType]
+	GrantTypes                            database.NumberArray[domain.OIDCGrantType]
+	AppType                               domain.OIDCApplicationType
+	ClientID                              string
+	AuthMethodType                        domain.OIDCAuthMethodType
+	PostLogoutRedirectURIs                database.TextArray[string]
+	Version                               domain.OIDCVersion
+	ComplianceProblems                    database.TextArray[string]
+	IsDevMode                             bool
+	AccessTokenType                       domain.OIDCTokenType
+	AssertAccessTokenRole                 bool
+	AssertIDTokenRole                     bool
+	AssertIDTokenUserinfo                 bool
+	ClockSkew                             time.Duration
+	AdditionalOrigins                     database.TextArray[string]
+	AllowedOrigins                        database.TextArray[string]
+	SkipNativeAppSuccessPage              bool
+	BackChannelLogoutURI                  string
+	LoginVersion                          domain.LoginVersion
+	LoginBaseURI                          *string
+	RequirePushedAuthorizationRequests    bool
+	RequireDPoP                           bool
+	TLSClientAuthSubjectDN                string
+	TLSClientAuthPublicKeyPin             string
+	BackChannelClientNotificationEndpoint string
 }
 
 type SAMLApp struct {
@@ -72,11 +78,20 @@ type SAMLApp struct {
 	EntityID     string
 	LoginVersion domain.LoginVersion
 	LoginBaseURI *string
+
+	SignatureAlgorithm  domain.SAMLSignatureAlgorithm
+	DigestAlgorithm     domain.SAMLDigestAlgorithm
+	AssertionEncryption domain.SAMLAssertionEncryption
+
+	NameIDFormat     *domain.SAMLNameIDFormat
+	AttributeMapping []*domain.SAMLAttributeMapping
 }
 
 type APIApp struct {
-	ClientID       string
-	AuthMethodType domain.APIAuthMethodType
+	ClientID                  string
+	AuthMethodType            domain.APIAuthMethodType
+	TLSClientAuthSubjectDN    string
+	TLSClientAuthPublicKeyPin string
 }
 
 type AppSearchQueries struct {
@@ -168,6 +183,26 @@ var (
 		name:  projection.AppSAMLConfigColumnLoginBaseURI,
 		table: appSAMLConfigsTable,
 	}
+	AppSAMLConfigColumnSignatureAlgorithm = Column{
+		name:  projection.AppSAMLConfigColumnSignatureAlgorithm,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnDigestAlgorithm = Column{
+		name:  projection.AppSAMLConfigColumnDigestAlgorithm,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnAssertionEncryption = Column{
+		name:  projection.AppSAMLConfigColumnAssertionEncryption,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnNameIDFormat = Column{
+		name:  projection.AppSAMLConfigColumnNameIDFormat,
+		table: appSAMLConfigsTable,
+	}
+	AppSAMLConfigColumnAttributeMapping = Column{
+		name:  projection.AppSAMLConfigColumnAttributeMapping,
+		table: appSAMLConfigsTable,
+	}
 )
 
 var (
@@ -187,6 +222,14 @@ var (
 		name:  projection.AppAPIConfigColumnAuthMethod,
 		table: appAPIConfigsTable,
 	}
+	AppAPIConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppAPIConfigColumnTLSClientAuthSubjectDN,
+		table: appAPIConfigsTable,
+	}
+	AppAPIConfigColumnTLSClientAuthPublicKeyPin = Column{
+		name:  projection.AppAPIConfigColumnTLSClientAuthPublicKeyPin,
+		table: appAPIConfigsTable,
+	}
 )
 
 var (
@@ -278,6 +321,26 @@ var (
 		name:  projection.AppOIDCConfigColumnLoginBaseURI,
 		table: appOIDCConfigsTable,
 	}
+	AppOIDCConfigColumnRequirePushedAuthorizationRequests = Column{
+		name:  projection.AppOIDCConfigColumnRequirePushedAuthorizationRequests,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnRequireDPoP = Column{
+		name:  projection.AppOIDCConfigColumnRequireDPoP,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthSubjectDN = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthSubjectDN,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnTLSClientAuthPublicKeyPin = Column{
+		name:  projection.AppOIDCConfigColumnTLSClientAuthPublicKeyPin,
+		table: appOIDCConfigsTable,
+	}
+	AppOIDCConfigColumnBackChannelClientNotificationEndpoint = Column{
+		name:  projection.AppOIDCConfigColumnBackChannelClientNotificationEndpoint,
+		table: appOIDCConfigsTable,
+	}
 )
 
 func (q *Queries) AppByProjectAndAppID(ctx context.Context, shouldTriggerBulk bool, projectID, appID string) (app *App, err error) {
@@ -684,6 +747,8 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppAPIConfigColumnAppID.identifier(),
 		AppAPIConfigColumnClientID.identifier(),
 		AppAPIConfigColumnAuthMethod.identifier(),
+		AppAPIConfigColumnTLSClientAuthSubjectDN.identifier(),
+		AppAPIConfigColumnTLSClientAuthPublicKeyPin.identifier(),
 
 		AppOIDCConfigColumnAppID.identifier(),
 		AppOIDCConfigColumnVersion.identifier(),
@@ -705,6 +770,11 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 		AppOIDCConfigColumnLoginVersion.identifier(),
 		AppOIDCConfigColumnLoginBaseURI.identifier(),
+		AppOIDCConfigColumnRequirePushedAuthorizationRequests.identifier(),
+		AppOIDCConfigColumnRequireDPoP.identifier(),
+		AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+		AppOIDCConfigColumnTLSClientAuthPublicKeyPin.identifier(),
+		AppOIDCConfigColumnBackChannelClientNotificationEndpoint.identifier(),
 
 		AppSAMLConfigColumnAppID.identifier(),
 		AppSAMLConfigColumnEntityID.identifier(),
@@ -712,6 +782,11 @@ func prepareAppQuery(activeOnly bool) (sq.SelectBuilder, func(*sql.Row) (*App, e
 		AppSAMLConfigColumnMetadataURL.identifier(),
 		AppSAMLConfigColumnLoginVersion.identifier(),
 		AppSAMLConfigColumnLoginBaseURI.identifier(),
+		AppSAMLConfigColumnSignatureAlgorithm.identifier(),
+		AppSAMLConfigColumnDigestAlgorithm.identifier(),
+		AppSAMLConfigColumnAssertionEncryption.identifier(),
+		AppSAMLConfigColumnNameIDFormat.identifier(),
+		AppSAMLConfigColumnAttributeMapping.identifier(),
 	).From(appsTable.identifier()).
 		PlaceholderFormat(sq.Dollar)
 
@@ -753,6 +828,8 @@ func scanApp(row *sql.Row) (*App, error) {
 		&apiConfig.appID,
 		&apiConfig.clientID,
 		&apiConfig.authMethod,
+		&apiConfig.tlsClientAuthSubjectDN,
+		&apiConfig.tlsClientAuthPublicKeyPin,
 
 		&oidcConfig.appID,
 		&oidcConfig.version,
@@ -774,6 +851,11 @@ func scanApp(row *sql.Row) (*App, error) {
 		&oidcConfig.backChannelLogoutURI,
 		&oidcConfig.loginVersion,
 		&oidcConfig.loginBaseURI,
+		&oidcConfig.requirePushedAuthorizationRequests,
+		&oidcConfig.requireDPoP,
+		&oidcConfig.tlsClientAuthSubjectDN,
+		&oidcConfig.tlsClientAuthPublicKeyPin,
+		&oidcConfig.backChannelClientNotificationEndpoint,
 
 		&samlConfig.appID,
 		&samlConfig.entityID,
@@ -781,6 +863,11 @@ func scanApp(row *sql.Row) (*App, error) {
 		&samlConfig.metadataURL,
 		&samlConfig.loginVersion,
 		&samlConfig.loginBaseURI,
+		&samlConfig.signatureAlgorithm,
+		&samlConfig.digestAlgorithm,
+		&samlConfig.assertionEncryption,
+		&samlConfig.nameIDFormat,
+		&samlConfig.attributeMapping,
 	)
 
 	if err != nil {
@@ -828,6 +915,11 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 			AppOIDCConfigColumnLoginVersion.identifier(),
 			AppOIDCConfigColumnLoginBaseURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthorizationRequests.identifier(),
+			AppOIDCConfigColumnRequireDPoP.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthPublicKeyPin.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationEndpoint.identifier(),
 		).From(appsTable.identifier()).
 			Join(join(AppOIDCConfigColumnAppID, AppColumnID)).
 			PlaceholderFormat(sq.Dollar), func(row *sql.Row) (*App, error) {
@@ -867,6 +959,11 @@ func prepareOIDCAppQuery() (sq.SelectBuilder, func(*sql.Row) (*App, error)) {
 				&oidcConfig.backChannelLogoutURI,
 				&oidcConfig.loginVersion,
 				&oidcConfig.loginBaseURI,
+				&oidcConfig.requirePushedAuthorizationRequests,
+				&oidcConfig.requireDPoP,
+				&oidcConfig.tlsClientAuthSubjectDN,
+				&oidcConfig.tlsClientAuthPublicKeyPin,
+				&oidcConfig.backChannelClientNotificationEndpoint,
 			)
 
 			if err != nil {
@@ -963,6 +1060,8 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppAPIConfigColumnAppID.identifier(),
 			AppAPIConfigColumnClientID.identifier(),
 			AppAPIConfigColumnAuthMethod.identifier(),
+			AppAPIConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppAPIConfigColumnTLSClientAuthPublicKeyPin.identifier(),
 
 			AppOIDCConfigColumnAppID.identifier(),
 			AppOIDCConfigColumnVersion.identifier(),
@@ -984,6 +1083,11 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppOIDCConfigColumnBackChannelLogoutURI.identifier(),
 			AppOIDCConfigColumnLoginVersion.identifier(),
 			AppOIDCConfigColumnLoginBaseURI.identifier(),
+			AppOIDCConfigColumnRequirePushedAuthorizationRequests.identifier(),
+			AppOIDCConfigColumnRequireDPoP.identifier(),
+			AppOIDCConfigColumnTLSClientAuthSubjectDN.identifier(),
+			AppOIDCConfigColumnTLSClientAuthPublicKeyPin.identifier(),
+			AppOIDCConfigColumnBackChannelClientNotificationEndpoint.identifier(),
 
 			AppSAMLConfigColumnAppID.identifier(),
 			AppSAMLConfigColumnEntityID.identifier(),
@@ -991,6 +1095,11 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 			AppSAMLConfigColumnMetadataURL.identifier(),
 			AppSAMLConfigColumnLoginVersion.identifier(),
 			AppSAMLConfigColumnLoginBaseURI.identifier(),
+			AppSAMLConfigColumnSignatureAlgorithm.identifier(),
+			AppSAMLConfigColumnDigestAlgorithm.identifier(),
+			AppSAMLConfigColumnAssertionEncryption.identifier(),
+			AppSAMLConfigColumnNameIDFormat.identifier(),
+			AppSAMLConfigColumnAttributeMapping.identifier(),
 			countColumn.identifier(),
 		).From(appsTable.identifier()).
 			LeftJoin(join(AppAPIConfigColumnAppID, AppColumnID)).
@@ -1020,6 +1129,8 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&apiConfig.appID,
 					&apiConfig.clientID,
 					&apiConfig.authMethod,
+					&apiConfig.tlsClientAuthSubjectDN,
+					&apiConfig.tlsClientAuthPublicKeyPin,
 
 					&oidcConfig.appID,
 					&oidcConfig.version,
@@ -1041,6 +1152,11 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&oidcConfig.backChannelLogoutURI,
 					&oidcConfig.loginVersion,
 					&oidcConfig.loginBaseURI,
+					&oidcConfig.requirePushedAuthorizationRequests,
+					&oidcConfig.requireDPoP,
+					&oidcConfig.tlsClientAuthSubjectDN,
+					&oidcConfig.tlsClientAuthPublicKeyPin,
+					&oidcConfig.backChannelClientNotificationEndpoint,
 
 					&samlConfig.appID,
 					&samlConfig.entityID,
@@ -1048,6 +1164,11 @@ func prepareAppsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Apps, error)) {
 					&samlConfig.metadataURL,
 					&samlConfig.loginVersion,
 					&samlConfig.loginBaseURI,
+					&samlConfig.signatureAlgorithm,
+					&samlConfig.digestAlgorithm,
+					&samlConfig.assertionEncryption,
+					&samlConfig.nameIDFormat,
+					&samlConfig.attributeMapping,
 
 					&apps.Count,
 				)
@@ -1128,26 +1249,31 @@ func prepareLoginVersionBySAMLAppID() (sq.SelectBuilder, func(*sql.Row) (domain.
 }
 
 type sqlOIDCConfig struct {
-	appID                    sql.NullString
-	version                  sql.NullInt32
-	clientID                 sql.NullString
-	redirectUris             database.TextArray[string]
-	applicationType          sql.NullInt16
-	authMethodType           sql.NullInt16
-	postLogoutRedirectUris   database.TextArray[string]
-	devMode                  sql.NullBool
-	accessTokenType          sql.NullInt16
-	accessTokenRoleAssertion sql.NullBool
-	iDTokenRoleAssertion     sql.NullBool
-	iDTokenUserinfoAssertion sql.NullBool
-	clockSkew                sql.NullInt64
-	additionalOrigins        database.TextArray[string]
-	responseTypes            database.NumberArray[domain.OIDCResponseType]
-	grantTypes               database.NumberArray[domain.OIDCGrantType]
-	skipNativeAppSuccessPage sql.NullBool
-	backChannelLogoutURI     sql.NullString
-	loginVersion             sql.NullInt16
-	loginBaseURI             sql.NullString
+	appID                                 sql.NullString
+	version                               sql.NullInt32
+	clientID                              sql.NullString
+	redirectUris                          database.TextArray[string]
+	applicationType                       sql.NullInt16
+	authMethodType                        sql.NullInt16
+	postLogoutRedirectUris                database.TextArray[string]
+	devMode                               sql.NullBool
+	accessTokenType                       sql.NullInt16
+	accessTokenRoleAssertion              sql.NullBool
+	iDTokenRoleAssertion                  sql.NullBool
+	iDTokenUserinfoAssertion              sql.NullBool
+	clockSkew                             sql.NullInt64
+	additionalOrigins                     database.TextArray[string]
+	responseTypes                         database.NumberArray[domain.OIDCResponseType]
+	grantTypes                            database.NumberArray[domain.OIDCGrantType]
+	skipNativeAppSuccessPage              sql.NullBool
+	backChannelLogoutURI                  sql.NullString
+	loginVersion                          sql.NullInt16
+	loginBaseURI                          sql.NullString
+	requirePushedAuthorizationRequests    sql.NullBool
+	requireDPoP                           sql.NullBool
+	tlsClientAuthSubjectDN                sql.NullString
+	tlsClientAuthPublicKeyPin             sql.NullString
+	backChannelClientNotificationEndpoint sql.NullString
 }
 
 func (c sqlOIDCConfig) set(app *App) {
@@ -1155,24 +1281,29 @@ func (c sqlOIDCConfig) set(app *App) {
 		return
 	}
 	app.OIDCConfig = &OIDCApp{
-		Version:                  domain.OIDCVersion(c.version.Int32),
-		ClientID:                 c.clientID.String,
-		RedirectURIs:             c.redirectUris,
-		AppType:                  domain.OIDCApplicationType(c.applicationType.Int16),
-		AuthMethodType:           domain.OIDCAuthMethodType(c.authMethodType.Int16),
-		PostLogoutRedirectURIs:   c.postLogoutRedirectUris,
-		IsDevMode:                c.devMode.Bool,
-		AccessTokenType:          domain.OIDCTokenType(c.accessTokenType.Int16),
-		AssertAccessTokenRole:    c.accessTokenRoleAssertion.Bool,
-		AssertIDTokenRole:        c.iDTokenRoleAssertion.Bool,
-		AssertIDTokenUserinfo:    c.iDTokenUserinfoAssertion.Bool,
-		ClockSkew:                time.Duration(c.clockSkew.Int64),
-		AdditionalOrigins:        c.additionalOrigins,
-		ResponseTypes:            c.responseTypes,
-		GrantTypes:               c.grantTypes,
-		SkipNativeAppSuccessPage: c.skipNativeAppSuccessPage.Bool,
-		BackChannelLogoutURI:     c.backChannelLogoutURI.String,
-		LoginVersion:             domain.LoginVersion(c.loginVersion.Int16),
+		Version:                               domain.OIDCVersion(c.version.Int32),
+		ClientID:                              c.clientID.String,
+		RedirectURIs:                          c.redirectUris,
+		AppType:                               domain.OIDCApplicationType(c.applicationType.Int16),
+		AuthMethodType:                        domain.OIDCAuthMethodType(c.authMethodType.Int16),
+		PostLogoutRedirectURIs:                c.postLogoutRedirectUris,
+		IsDevMode:                             c.devMode.Bool,
+		AccessTokenType:                       domain.OIDCTokenType(c.accessTokenType.Int16),
+		AssertAccessTokenRole:                 c.accessTokenRoleAssertion.Bool,
+		AssertIDTokenRole:                     c.iDTokenRoleAssertion.Bool,
+		AssertIDTokenUserinfo:                 c.iDTokenUserinfoAssertion.Bool,
+		ClockSkew:                             time.Duration(c.clockSkew.Int64),
+		AdditionalOrigins:                     c.additionalOrigins,
+		ResponseTypes:                         c.responseTypes,
+		GrantTypes:                            c.grantTypes,
+		SkipNativeAppSuccessPage:              c.skipNativeAppSuccessPage.Bool,
+		BackChannelLogoutURI:                  c.backChannelLogoutURI.String,
+		LoginVersion:                          domain.LoginVersion(c.loginVersion.Int16),
+		RequirePushedAuthorizationRequests:    c.requirePushedAuthorizationRequests.Bool,
+		RequireDPoP:                           c.requireDPoP.Bool,
+		TLSClientAuthSubjectDN:                c.tlsClientAuthSubjectDN.String,
+		TLSClientAuthPublicKeyPin:             c.tlsClientAuthPublicKeyPin.String,
+		BackChannelClientNotificationEndpoint: c.backChannelClientNotificationEndpoint.String,
 	}
 	if c.loginBaseURI.Valid {
 		app.OIDCConfig.LoginBaseURI = &c.loginBaseURI.String
@@ -1192,6 +1323,13 @@ type sqlSAMLConfig struct {
 	metadata     []byte
 	loginVersion sql.NullInt16
 	loginBaseURI sql.NullString
+
+	signatureAlgorithm  sql.NullInt16
+	digestAlgorithm     sql.NullInt16
+	assertionEncryption sql.NullInt16
+
+	nameIDFormat     sql.NullInt16
+	attributeMapping []byte
 }
 
 func (c sqlSAMLConfig) set(app *App) {
@@ -1203,16 +1341,29 @@ func (c sqlSAMLConfig) set(app *App) {
 		MetadataURL:  c.metadataURL.String,
 		Metadata:     c.metadata,
 		LoginVersion: domain.LoginVersion(c.loginVersion.Int16),
+
+		SignatureAlgorithm:  domain.SAMLSignatureAlgorithm(c.signatureAlgorithm.Int16),
+		DigestAlgorithm:     domain.SAMLDigestAlgorithm(c.digestAlgorithm.Int16),
+		AssertionEncryption: domain.SAMLAssertionEncryption(c.assertionEncryption.Int16),
 	}
 	if c.loginBaseURI.Valid {
 		app.SAMLConfig.LoginBaseURI = &c.loginBaseURI.String
 	}
+	if c.nameIDFormat.Valid {
+		app.SAMLConfig.NameIDFormat = gu.Ptr(domain.SAMLNameIDFormat(c.nameIDFormat.Int16))
+	}
+	if len(c.attributeMapping) > 0 {
+		err := json.Unmarshal(c.attributeMapping, &app.SAMLConfig.AttributeMapping)
+		logging.LogWithFields("app", app.ID).OnError(err).Warn("unable to unmarshal saml attribute mapping")
+	}
 }
 
 type sqlAPIConfig struct {
-	appID      sql.NullString
-	clientID   sql.NullString
-	authMethod sql.NullInt16
+	appID                     sql.NullString
+	clientID                  sql.NullString
+	authMethod                sql.NullInt16
+	tlsClientAuthSubjectDN    sql.NullString
+	tlsClientAuthPublicKeyPin sql.NullString
 }
 
 func (c sqlAPIConfig) set(app *App) {
@@ -1220,7 +1371,9 @@ func (c sqlAPIConfig) set(app *App) {
 		return
 	}
 	app.APIConfig = &APIApp{
-		ClientID:       c.clientID.String,
-		AuthMethodType: domain.APIAuthMethodType(c.authMethod.Int16),
+		ClientID:                  c.clientID.String,
+		AuthMethodType:            domain.APIAuthMethodType(c.authMethod.Int16),
+		TLSClientAuthSubjectDN:    c.tlsClientAuthSubjectDN.String,
+		TLSClientAuthPublicKeyPin: c.tlsClientAuthPublicKeyPin.String,
 	}
 }
@@ -30,6 +30,8 @@ var (
 		` projections.apps7_api_configs.app_id,` +
 		` projections.apps7_api_configs.client_id,` +
 		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps7_api_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_api_configs.tls_client_auth_public_key_pin,` +
 		// oidc config
 		` projections.apps7_oidc_configs.app_id,` +
 		` projections.apps7_oidc_configs.version,` +
@@ -51,13 +53,23 @@ var (
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
 		` projections.apps7_oidc_configs.login_version,` +
 		` projections.apps7_oidc_configs.login_base_uri,` +
+		` projections.apps7_oidc_configs.require_pushed_authorization_requests,` +
+		` projections.apps7_oidc_configs.require_dpop,` +
+		` projections.apps7_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_oidc_configs.tls_client_auth_public_key_pin,` +
+		` projections.apps7_oidc_configs.back_channel_client_notification_endpoint,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
 		` projections.apps7_saml_configs.metadata,` +
 		` projections.apps7_saml_configs.metadata_url,` +
 		` projections.apps7_saml_configs.login_version,` +
-		` projections.apps7_saml_configs.login_base_uri` +
+		` projections.apps7_saml_configs.login_base_uri,` +
+		` projections.apps7_saml_configs.signature_algorithm,` +
+		` projections.apps7_saml_configs.digest_algorithm,` +
+		` projections.apps7_saml_configs.assertion_encryption,` +
+		` projections.apps7_saml_configs.name_id_format,` +
+		` projections.apps7_saml_configs.attribute_mapping` +
 		` FROM projections.apps7` +
 		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
 		` LEFT JOIN projections.apps7_oidc_configs ON projections.apps7.id = projections.apps7_oidc_configs.app_id AND projections.apps7.instance_id = projections.apps7_oidc_configs.instance_id` +
@@ -78,6 +90,8 @@ var (
 		` projections.apps7_api_configs.app_id,` +
 		` projections.apps7_api_configs.client_id,` +
 		` projections.apps7_api_configs.auth_method,` +
+		` projections.apps7_api_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_api_configs.tls_client_auth_public_key_pin,` +
 		// oidc config
 		` projections.apps7_oidc_configs.app_id,` +
 		` projections.apps7_oidc_configs.version,` +
@@ -99,6 +113,11 @@ var (
 		` projections.apps7_oidc_configs.back_channel_logout_uri,` +
 		` projections.apps7_oidc_configs.login_version,` +
 		` projections.apps7_oidc_configs.login_base_uri,` +
+		` projections.apps7_oidc_configs.require_pushed_authorization_requests,` +
+		` projections.apps7_oidc_configs.require_dpop,` +
+		` projections.apps7_oidc_configs.tls_client_auth_subject_dn,` +
+		` projections.apps7_oidc_configs.tls_client_auth_public_key_pin,` +
+		` projections.apps7_oidc_configs.back_channel_client_notification_endpoint,` +
 		//saml config
 		` projections.apps7_saml_configs.app_id,` +
 		` projections.apps7_saml_configs.entity_id,` +
@@ -106,6 +125,11 @@ var (
 		` projections.apps7_saml_configs.metadata_url,` +
 		` projections.apps7_saml_configs.login_version,` +
 		` projections.apps7_saml_configs.login_base_uri,` +
+		` projections.apps7_saml_configs.signature_algorithm,` +
+		` projections.apps7_saml_configs.digest_algorithm,` +
+		` projections.apps7_saml_configs.assertion_encryption,` +
+		` projections.apps7_saml_configs.name_id_format,` +
+		` projections.apps7_saml_configs.attribute_mapping,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.apps7` +
 		` LEFT JOIN projections.apps7_api_configs ON projections.apps7.id = projections.apps7_api_configs.app_id AND projections.apps7.instance_id = projections.apps7_api_configs.instance_id` +
@@ -151,6 +175,8 @@ var (
 		"app_id",
 		"client_id",
 		"auth_method",
+		"tls_client_auth_subject_dn",
+		"tls_client_auth_public_key_pin",
 		// oidc config
 		"app_id",
 		"version",
@@ -172,6 +198,11 @@ var (
 		"back_channel_logout_uri",
 		"login_version",
 		"login_base_uri",
+		"require_pushed_authorization_requests",
+		"require_dpop",
+		"tls_client_auth_subject_dn",
+		"tls_client_auth_public_key_pin",
+		"back_channel_client_notification_endpoint",
 		//saml config
 		"app_id",
 		"entity_id",
@@ -179,6 +210,11 @@ var (
 		"metadata_url",
 		"login_version",
 		"login_base_uri",
+		"signature_algorithm",
+		"digest_algorithm",
+		"assertion_encryption",
+		"name_id_format",
+		"attribute_mapping",
 	}
 	appsCols = append(appCols, "count")
 )
@@ -227,6 +263,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -248,6 +286,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -255,6 +298,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -298,6 +346,8 @@ func Test_AppsPrepare(t *testing.T) {
 							"app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
+							"",
 							// oidc config
 							nil,
 							nil,
@@ -319,6 +369,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -326,6 +381,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -372,6 +432,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -393,6 +455,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -400,6 +467,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"https://test.com/saml/metadata",
 							domain.LoginVersionUnspecified,
 							nil,
+							domain.SAMLSignatureAlgorithmRSASHA512,
+							domain.SAMLDigestAlgorithmSHA512,
+							domain.SAMLAssertionEncryptionAES256GCM,
+							domain.SAMLNameIDFormatPersistent,
+							[]byte(`[{"name":"mail","source":1,"key":"email"},{"name":"roles","source":3}]`),
 						},
 					},
 				),
@@ -422,6 +494,16 @@ func Test_AppsPrepare(t *testing.T) {
 							Metadata:    []byte("<?xml version=\"1.0\"?>\n<md:EntityDescriptor xmlns:md=\"urn:oasis:names:tc:SAML:2.0:metadata\"\n                     validUntil=\"2022-08-26T14:08:16Z\"\n                     cacheDuration=\"PT604800S\"\n                     entityID=\"https://test.com/saml/metadata\">\n    <md:SPSSODescriptor AuthnRequestsSigned=\"false\" WantAssertionsSigned=\"false\" protocolSupportEnumeration=\"urn:oasis:names:tc:SAML:2.0:protocol\">\n        <md:NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</md:NameIDFormat>\n        <md:AssertionConsumerService Binding=\"urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST\"\n                                     Location=\"https://test.com/saml/acs\"\n                                     index=\"1\" />\n        \n    </md:SPSSODescriptor>\n</md:EntityDescriptor>"),
 							MetadataURL: "https://test.com/saml/metadata",
 							EntityID:    "https://test.com/saml/metadata",
+
+							SignatureAlgorithm:  domain.SAMLSignatureAlgorithmRSASHA512,
+							DigestAlgorithm:     domain.SAMLDigestAlgorithmSHA512,
+							AssertionEncryption: domain.SAMLAssertionEncryptionAES256GCM,
+
+							NameIDFormat: gu.Ptr(domain.SAMLNameIDFormatPersistent),
+							AttributeMapping: []*domain.SAMLAttributeMapping{
+								{Name: "mail", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+								{Name: "roles", Source: domain.SAMLAttributeSourceRoles},
+							},
 						},
 					},
 				},
@@ -448,6 +530,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -469,6 +553,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -476,6 +565,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -542,6 +636,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -563,6 +659,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -570,6 +671,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -636,6 +742,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -657,6 +765,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -664,6 +777,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -730,6 +848,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -751,6 +871,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -758,6 +883,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -824,6 +954,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -845,6 +977,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -852,6 +989,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -918,6 +1060,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -939,6 +1083,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -946,6 +1095,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1012,6 +1166,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"oidc-app-id",
 							domain.OIDCVersionV1,
@@ -1033,6 +1189,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersion2,
 							"https://login.ch/",
+							true,
+							true,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1040,6 +1201,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"api-app-id",
@@ -1054,6 +1220,8 @@ func Test_AppsPrepare(t *testing.T) {
 							"api-app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
+							"",
 							// oidc config
 							nil,
 							nil,
@@ -1075,6 +1243,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1082,6 +1255,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"saml-app-id",
@@ -1096,6 +1274,8 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1117,6 +1297,11 @@ func Test_AppsPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"saml-app-id",
 							"https://test.com/saml/metadata",
@@ -1124,6 +1309,11 @@ func Test_AppsPrepare(t *testing.T) {
 							"https://test.com/saml/metadata",
 							domain.LoginVersion2,
 							"https://login.ch/",
+							domain.SAMLSignatureAlgorithmRSASHA512,
+							domain.SAMLDigestAlgorithmSHA512,
+							domain.SAMLAssertionEncryptionAES256GCM,
+							domain.SAMLNameIDFormatPersistent,
+							[]byte(`[{"name":"mail","source":1,"key":"email"},{"name":"roles","source":3}]`),
 						},
 					},
 				),
@@ -1143,27 +1333,29 @@ func Test_AppsPrepare(t *testing.T) {
 						Name:          "app-name",
 						ProjectID:     "project-id",
 						OIDCConfig: &OIDCApp{
-							Version:                  domain.OIDCVersionV1,
-							ClientID:                 "oidc-client-id",
-							RedirectURIs:             database.TextArray[string]{"https://redirect.to/me"},
-							ResponseTypes:            database.NumberArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
-							GrantTypes:               database.NumberArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
-							AppType:                  domain.OIDCApplicationTypeUserAgent,
-							AuthMethodType:           domain.OIDCAuthMethodTypeNone,
-							PostLogoutRedirectURIs:   database.TextArray[string]{"post.logout.ch"},
-							IsDevMode:                true,
-							AccessTokenType:          domain.OIDCTokenTypeJWT,
-							AssertAccessTokenRole:    true,
-							AssertIDTokenRole:        true,
-							AssertIDTokenUserinfo:    true,
-							ClockSkew:                1 * time.Second,
-							AdditionalOrigins:        database.TextArray[string]{"additional.origin"},
-							ComplianceProblems:       nil,
-							AllowedOrigins:           database.TextArray[string]{"https://redirect.to", "additional.origin"},
-							SkipNativeAppSuccessPage: false,
-							BackChannelLogoutURI:     "back.channel.logout.ch",
-							LoginVersion:             domain.LoginVersion2,
-							LoginBaseURI:             gu.Ptr("https://login.ch/"),
+							Version:                            domain.OIDCVersionV1,
+							ClientID:                           "oidc-client-id",
+							RedirectURIs:                       database.TextArray[string]{"https://redirect.to/me"},
+							ResponseTypes:                      database.NumberArray[domain.OIDCResponseType]{domain.OIDCResponseTypeIDTokenToken},
+							GrantTypes:                         database.NumberArray[domain.OIDCGrantType]{domain.OIDCGrantTypeImplicit},
+							AppType:                            domain.OIDCApplicationTypeUserAgent,
+							AuthMethodType:                     domain.OIDCAuthMethodTypeNone,
+							PostLogoutRedirectURIs:             database.TextArray[string]{"post.logout.ch"},
+							IsDevMode:                          true,
+							AccessTokenType:                    domain.OIDCTokenTypeJWT,
+							AssertAccessTokenRole:              true,
+							AssertIDTokenRole:                  true,
+							AssertIDTokenUserinfo:              true,
+							ClockSkew:                          1 * time.Second,
+							AdditionalOrigins:                  database.TextArray[string]{"additional.origin"},
+							ComplianceProblems:                 nil,
+							AllowedOrigins:                     database.TextArray[string]{"https://redirect.to", "additional.origin"},
+							SkipNativeAppSuccessPage:           false,
+							BackChannelLogoutURI:               "back.channel.logout.ch",
+							LoginVersion:                       domain.LoginVersion2,
+							LoginBaseURI:                       gu.Ptr("https://login.ch/"),
+							RequirePushedAuthorizationRequests: true,
+							RequireDPoP:                        true,
 						},
 					},
 					{
@@ -1195,6 +1387,16 @@ func Test_AppsPrepare(t *testing.T) {
 							EntityID:     "https://test.com/saml/metadata",
 							LoginVersion: domain.LoginVersion2,
 							LoginBaseURI: gu.Ptr("https://login.ch/"),
+
+							SignatureAlgorithm:  domain.SAMLSignatureAlgorithmRSASHA512,
+							DigestAlgorithm:     domain.SAMLDigestAlgorithmSHA512,
+							AssertionEncryption: domain.SAMLAssertionEncryptionAES256GCM,
+
+							NameIDFormat: gu.Ptr(domain.SAMLNameIDFormatPersistent),
+							AttributeMapping: []*domain.SAMLAttributeMapping{
+								{Name: "mail", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+								{Name: "roles", Source: domain.SAMLAttributeSourceRoles},
+							},
 						},
 					},
 				},
@@ -1281,6 +1483,8 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
 						// oidc config
 						nil,
 						nil,
@@ -1302,6 +1506,11 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 						// saml config
 						nil,
 						nil,
@@ -1309,6 +1518,11 @@ func Test_AppPrepare(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1346,6 +1560,8 @@ func Test_AppPrepare(t *testing.T) {
 							"app-id",
 							"api-client-id",
 							domain.APIAuthMethodTypePrivateKeyJWT,
+							"",
+							"",
 							// oidc config
 							nil,
 							nil,
@@ -1367,6 +1583,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							nil,
 							nil,
@@ -1374,6 +1595,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1416,6 +1642,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1437,6 +1665,11 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1444,6 +1677,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1505,6 +1743,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1526,6 +1766,11 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1533,6 +1778,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1594,6 +1844,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							nil,
 							nil,
@@ -1615,6 +1867,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 							// saml config
 							"app-id",
 							"https://test.com/saml/metadata",
@@ -1622,6 +1879,11 @@ func Test_AppPrepare(t *testing.T) {
 							"https://test.com/saml/metadata",
 							domain.LoginVersionUnspecified,
 							nil,
+							domain.SAMLSignatureAlgorithmRSASHA512,
+							domain.SAMLDigestAlgorithmSHA512,
+							domain.SAMLAssertionEncryptionAES256GCM,
+							domain.SAMLNameIDFormatPersistent,
+							[]byte(`[{"name":"mail","source":1,"key":"email"},{"name":"roles","source":3}]`),
 						},
 					},
 				),
@@ -1641,6 +1903,16 @@ func Test_AppPrepare(t *testing.T) {
 					EntityID:     "https://test.com/saml/metadata",
 					LoginVersion: domain.LoginVersionUnspecified,
 					LoginBaseURI: nil,
+
+					SignatureAlgorithm:  domain.SAMLSignatureAlgorithmRSASHA512,
+					DigestAlgorithm:     domain.SAMLDigestAlgorithmSHA512,
+					AssertionEncryption: domain.SAMLAssertionEncryptionAES256GCM,
+
+					NameIDFormat: gu.Ptr(domain.SAMLNameIDFormatPersistent),
+					AttributeMapping: []*domain.SAMLAttributeMapping{
+						{Name: "mail", Source: domain.SAMLAttributeSourceUserField, Key: string(domain.SAMLUserFieldEmail)},
+						{Name: "roles", Source: domain.SAMLAttributeSourceRoles},
+					},
 				},
 			},
 		},
@@ -1667,6 +1939,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1688,6 +1962,11 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1695,6 +1974,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1756,6 +2040,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1777,6 +2063,11 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1784,6 +2075,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1845,6 +2141,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1866,6 +2164,11 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1873,6 +2176,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -1934,6 +2242,8 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
 							// oidc config
 							"app-id",
 							domain.OIDCVersionV1,
@@ -1955,6 +2265,11 @@ func Test_AppPrepare(t *testing.T) {
 							"back.channel.logout.ch",
 							domain.LoginVersionUnspecified,
 							nil,
+							false,
+							false,
+							"",
+							"",
+							"",
 							// saml config
 							nil,
 							nil,
@@ -1962,6 +2277,11 @@ func Test_AppPrepare(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -30,6 +31,8 @@ type AuthRequest struct {
 	LoginHint    *string
 	MaxAge       *time.Duration
 	HintUserID   *string
+
+	AuthorizationDetails domain.AuthorizationDetails
 }
 
 func (a *AuthRequest) checkLoginClient(ctx context.Context, permissionCheck domain.PermissionCheck) error {
@@ -57,6 +60,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		scope   database.TextArray[string]
 		prompt  database.NumberArray[domain.Prompt]
 		locales database.TextArray[string]
+		details []byte
 	)
 
 	dst := new(AuthRequest)
@@ -65,7 +69,7 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 		func(row *sql.Row) error {
 			return row.Scan(
 				&dst.ID, &dst.CreationDate, &dst.LoginClient, &dst.ClientID, &scope, &dst.RedirectURI,
-				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID,
+				&prompt, &locales, &dst.LoginHint, &dst.MaxAge, &dst.HintUserID, &details,
 			)
 		},
 		authRequestByIDQuery,
@@ -81,6 +85,11 @@ func (q *Queries) AuthRequestByID(ctx context.Context, shouldTriggerBulk bool, i
 	dst.Scope = scope
 	dst.Prompt = prompt
 	dst.UiLocales = locales
+	if len(details) > 0 {
+		if err = json.Unmarshal(details, &dst.AuthorizationDetails); err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-ooK9a", "Errors.Internal")
+		}
+	}
 
 	if checkLoginClient {
 		if err = dst.checkLoginClient(ctx, q.checkPermission); err != nil {
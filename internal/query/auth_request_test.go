@@ -35,6 +35,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 		projection.AuthRequestColumnLoginHint,
 		projection.AuthRequestColumnMaxAge,
 		projection.AuthRequestColumnHintUserID,
+		projection.AuthRequestColumnAuthorizationDetails,
 	}
 	type args struct {
 		shouldTriggerBulk bool
@@ -68,6 +69,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				"me@example.com",
 				int64(time.Minute),
 				"userID",
+				[]byte(`[{"type":"payment_initiation","actions":["initiate"]}]`),
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -81,6 +83,9 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				LoginHint:    gu.Ptr("me@example.com"),
 				MaxAge:       gu.Ptr(time.Minute),
 				HintUserID:   gu.Ptr("userID"),
+				AuthorizationDetails: domain.AuthorizationDetails{
+					{"type": "payment_initiation", "actions": []any{"initiate"}},
+				},
 			},
 		},
 		{
@@ -102,6 +107,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			want: &AuthRequest{
 				ID:           "id",
@@ -154,6 +160,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return zerrors.ThrowPermissionDenied(nil, "id", "not permitted")
@@ -179,6 +186,7 @@ func TestQueries_AuthRequestByID(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 			}, "123", "instanceID"),
 			permissionCheck: func(ctx context.Context, permission, orgID, resourceID string) (err error) {
 				return nil
@@ -0,0 +1,168 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	backChannelAuthRequestTable = table{
+		name:          projection.BackChannelAuthRequestProjectionTable,
+		instanceIDCol: projection.BackChannelAuthRequestColumnInstanceID,
+	}
+	BackChannelAuthRequestColumnAuthReqID = Column{
+		name:  projection.BackChannelAuthRequestColumnAuthReqID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnClientID = Column{
+		name:  projection.BackChannelAuthRequestColumnClientID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnUserID = Column{
+		name:  projection.BackChannelAuthRequestColumnUserID,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnScopes = Column{
+		name:  projection.BackChannelAuthRequestColumnScopes,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnBindingMessage = Column{
+		name:  projection.BackChannelAuthRequestColumnBindingMessage,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnExpires = Column{
+		name:  projection.BackChannelAuthRequestColumnExpires,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnCreationDate = Column{
+		name:  projection.BackChannelAuthRequestColumnCreationDate,
+		table: backChannelAuthRequestTable,
+	}
+	BackChannelAuthRequestColumnInstanceID = Column{
+		name:  projection.BackChannelAuthRequestColumnInstanceID,
+		table: backChannelAuthRequestTable,
+	}
+)
+
+// BackChannelAuthRequestByID finds a pending backchannel authentication request by its auth_req_id
+// from the `backchannel_auth_requests` projection.
+func (q *Queries) BackChannelAuthRequestByID(ctx context.Context, authReqID string) (authReq *domain.BackChannelAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	stmt, scan := prepareBackChannelAuthQuery()
+	eq := sq.Eq{
+		BackChannelAuthRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		BackChannelAuthRequestColumnAuthReqID.identifier():  authReqID,
+	}
+	query, args, err := stmt.Where(eq).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Uu5ah", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		authReqs, err := scan(rows)
+		if err != nil {
+			return err
+		}
+		if len(authReqs) == 0 {
+			return zerrors.ThrowNotFound(nil, "QUERY-Ahx3i", "Errors.BackChannelAuth.NotFound")
+		}
+		authReq = authReqs[0]
+		return nil
+	}, query, args...)
+	return authReq, err
+}
+
+// BackChannelAuthRequestsByUserID returns all pending backchannel authentication requests of the user
+// from the `backchannel_auth_requests` projection, so they can be approved or denied by the user.
+// Requests of other users can only be listed with the permission to link sessions (e.g. by a login client).
+func (q *Queries) BackChannelAuthRequestsByUserID(ctx context.Context, userID string) (authReqs []*domain.BackChannelAuthRequest, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if authz.GetCtxData(ctx).UserID != userID {
+		if err := q.checkPermission(ctx, domain.PermissionSessionLink, authz.GetInstance(ctx).InstanceID(), ""); err != nil {
+			return nil, err
+		}
+	}
+
+	stmt, scan := prepareBackChannelAuthQuery()
+	eq := sq.Eq{
+		BackChannelAuthRequestColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+		BackChannelAuthRequestColumnUserID.identifier():     userID,
+	}
+	query, args, err := stmt.Where(eq).OrderBy(BackChannelAuthRequestColumnCreationDate.identifier()).ToSql()
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-Phee0", "Errors.Query.SQLStatement")
+	}
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		authReqs, err = scan(rows)
+		return err
+	}, query, args...)
+	return authReqs, err
+}
+
+var backChannelAuthSelectColumns = []string{
+	BackChannelAuthRequestColumnAuthReqID.identifier(),
+	BackChannelAuthRequestColumnClientID.identifier(),
+	BackChannelAuthRequestColumnUserID.identifier(),
+	BackChannelAuthRequestColumnScopes.identifier(),
+	BackChannelAuthRequestColumnBindingMessage.identifier(),
+	BackChannelAuthRequestColumnExpires.identifier(),
+	BackChannelAuthRequestColumnCreationDate.identifier(),
+	AppColumnName.identifier(),
+	ProjectColumnName.identifier(),
+}
+
+func prepareBackChannelAuthQuery() (sq.SelectBuilder, func(*sql.Rows) ([]*domain.BackChannelAuthRequest, error)) {
+	return sq.Select(backChannelAuthSelectColumns...).
+			From(backChannelAuthRequestTable.identifier()).
+			LeftJoin(join(AppOIDCConfigColumnClientID, BackChannelAuthRequestColumnClientID)).
+			LeftJoin(join(AppColumnID, AppOIDCConfigColumnAppID)).
+			LeftJoin(join(ProjectColumnID, AppColumnProjectID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) ([]*domain.BackChannelAuthRequest, error) {
+			authReqs := make([]*domain.BackChannelAuthRequest, 0)
+			for rows.Next() {
+				dst := new(domain.BackChannelAuthRequest)
+				var (
+					scopes      database.TextArray[string]
+					appName     sql.NullString
+					projectName sql.NullString
+				)
+				err := rows.Scan(
+					&dst.AuthReqID,
+					&dst.ClientID,
+					&dst.UserID,
+					&scopes,
+					&dst.BindingMessage,
+					&dst.Expires,
+					&dst.CreationDate,
+					&appName,
+					&projectName,
+				)
+				if err != nil {
+					return nil, zerrors.ThrowInternal(err, "QUERY-aiX1o", "Errors.Internal")
+				}
+				dst.Scopes = scopes
+				dst.AppName = appName.String
+				dst.ProjectName = projectName.String
+				authReqs = append(authReqs, dst)
+			}
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-eeJ3h", "Errors.Query.CloseRows")
+			}
+			return authReqs, nil
+		}
+}
@@ -0,0 +1,143 @@
+package query
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/database"
+	db_mock "github.com/zitadel/zitadel/internal/database/mock"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	expectedBackChannelAuthQueryC = `SELECT` +
+		` projections.backchannel_auth_requests.auth_req_id,` +
+		` projections.backchannel_auth_requests.client_id,` +
+		` projections.backchannel_auth_requests.user_id,` +
+		` projections.backchannel_auth_requests.scopes,` +
+		` projections.backchannel_auth_requests.binding_message,` +
+		` projections.backchannel_auth_requests.expires,` +
+		` projections.backchannel_auth_requests.creation_date,` +
+		` projections.apps7.name,` +
+		` projections.projects4.name` +
+		` FROM projections.backchannel_auth_requests` +
+		` LEFT JOIN projections.apps7_oidc_configs` +
+		` ON projections.backchannel_auth_requests.client_id = projections.apps7_oidc_configs.client_id` +
+		` AND projections.backchannel_auth_requests.instance_id = projections.apps7_oidc_configs.instance_id` +
+		` LEFT JOIN projections.apps7 ON projections.apps7_oidc_configs.app_id = projections.apps7.id` +
+		` AND projections.apps7_oidc_configs.instance_id = projections.apps7.instance_id` +
+		` LEFT JOIN projections.projects4 ON projections.apps7.project_id = projections.projects4.id` +
+		` AND projections.apps7.instance_id = projections.projects4.instance_id`
+	expectedBackChannelAuthWhereIDQueryC = expectedBackChannelAuthQueryC +
+		` WHERE projections.backchannel_auth_requests.auth_req_id = $1` +
+		` AND projections.backchannel_auth_requests.instance_id = $2`
+	expectedBackChannelAuthWhereUserIDQueryC = expectedBackChannelAuthQueryC +
+		` WHERE projections.backchannel_auth_requests.instance_id = $1` +
+		` AND projections.backchannel_auth_requests.user_id = $2` +
+		` ORDER BY projections.backchannel_auth_requests.creation_date`
+)
+
+var (
+	testBackChannelAuthNow           = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	expectedBackChannelAuthWhereID   = regexp.QuoteMeta(expectedBackChannelAuthWhereIDQueryC)
+	expectedBackChannelAuthWhereUser = regexp.QuoteMeta(expectedBackChannelAuthWhereUserIDQueryC)
+	expectedBackChannelAuthValues    = []driver.Value{
+		"authReqID",
+		"clientID",
+		"userID",
+		database.TextArray[string]{"openid", "profile"},
+		"binding",
+		testBackChannelAuthNow.Add(time.Minute),
+		testBackChannelAuthNow,
+		"appName",
+		"projectName",
+	}
+	expectedBackChannelAuth = &domain.BackChannelAuthRequest{
+		AuthReqID:      "authReqID",
+		ClientID:       "clientID",
+		UserID:         "userID",
+		Scopes:         []string{"openid", "profile"},
+		BindingMessage: "binding",
+		Expires:        testBackChannelAuthNow.Add(time.Minute),
+		CreationDate:   testBackChannelAuthNow,
+		AppName:        "appName",
+		ProjectName:    "projectName",
+	}
+)
+
+func TestQueries_BackChannelAuthRequestByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		rows    [][]driver.Value
+		want    *domain.BackChannelAuthRequest
+		wantErr error
+	}{
+		{
+			name:    "not found",
+			wantErr: zerrors.ThrowNotFound(nil, "QUERY-Ahx3i", "Errors.BackChannelAuth.NotFound"),
+		},
+		{
+			name: "found",
+			rows: [][]driver.Value{expectedBackChannelAuthValues},
+			want: expectedBackChannelAuth,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+			require.NoError(t, err)
+			defer client.Close()
+
+			rows := mock.NewRows(backChannelAuthSelectColumns)
+			for _, row := range tt.rows {
+				rows.AddRow(row...)
+			}
+			mock.ExpectQuery(expectedBackChannelAuthWhereID).WillReturnRows(rows)
+			q := Queries{
+				client: &database.DB{DB: client},
+			}
+			got, err := q.BackChannelAuthRequestByID(context.TODO(), "authReqID")
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestQueries_BackChannelAuthRequestsByUserID(t *testing.T) {
+	client, mock, err := sqlmock.New(sqlmock.ValueConverterOption(new(db_mock.TypeConverter)))
+	require.NoError(t, err)
+	defer client.Close()
+
+	mock.ExpectQuery(expectedBackChannelAuthWhereUser).WillReturnRows(
+		mock.NewRows(backChannelAuthSelectColumns).AddRow(expectedBackChannelAuthValues...),
+	)
+	q := Queries{
+		client: &database.DB{DB: client},
+		checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+			return nil
+		},
+	}
+	got, err := q.BackChannelAuthRequestsByUserID(context.TODO(), "userID")
+	require.NoError(t, err)
+	assert.Equal(t, []*domain.BackChannelAuthRequest{expectedBackChannelAuth}, got)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueries_BackChannelAuthRequestsByUserID_permissionDenied(t *testing.T) {
+	q := Queries{
+		checkPermission: func(ctx context.Context, permission, orgID, resourceID string) error {
+			return zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied")
+		},
+	}
+	_, err := q.BackChannelAuthRequestsByUserID(context.TODO(), "userID")
+	assert.ErrorIs(t, err, zerrors.ThrowPermissionDenied(nil, "AUTHZ-HKJD33", "Errors.PermissionDenied"))
+}
@@ -2,6 +2,8 @@ package query
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"time"
 
 	"github.com/hashicorp/golang-lru/v2/expirable"
@@ -10,13 +12,26 @@ import (
 	"github.com/zitadel/zitadel/internal/cache"
 	"github.com/zitadel/zitadel/internal/cache/connector"
 	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type Caches struct {
-	instance cache.Cache[instanceIndex, string, *authzInstance]
-	org      cache.Cache[orgIndex, string, *Org]
+	instance    cache.Cache[instanceIndex, string, *authzInstance]
+	org         cache.Cache[orgIndex, string, *Org]
+	loginPolicy cache.Cache[orgPolicyIndex, string, *orgPolicy[*LoginPolicy]]
+	labelPolicy cache.Cache[orgPolicyIndex, string, *orgPolicy[*LabelPolicy]]
+	user        cache.Cache[userIndex, string, *User]
+	// oidcClients and samlServiceProviders are nil when not configured,
+	// as the cached objects contain all apps of a project, which is more expensive to query.
+	oidcClients          cache.Cache[oidcClientIndex, string, *oidcProjectClients]
+	samlServiceProviders cache.Cache[samlSPIndex, string, *samlProjectServiceProviders]
 
 	activeInstances *expirable.LRU[string, bool]
+	// registry contains all caches started with the same connectors,
+	// including caches outside of the query package.
+	registry *connector.Registry
 }
 
 type ActiveInstanceConfig struct {
@@ -26,26 +41,65 @@ type ActiveInstanceConfig struct {
 
 func startCaches(background context.Context, connectors connector.Connectors, instanceConfig ActiveInstanceConfig) (_ *Caches, err error) {
 	caches := new(Caches)
-	caches.instance, err = connector.StartCache[instanceIndex, string, *authzInstance](background, instanceIndexValues(), cache.PurposeAuthzInstance, connectors.Config.Instance, connectors)
+	caches.instance, err = connector.StartCache[instanceIndex, string, *authzInstance](background, instanceIndexValues(), cache.PurposeAuthzInstance, connectors.Config.Instance, connectors, connector.WithInstanceIndex(instanceIndexByID))
 	if err != nil {
 		return nil, err
 	}
-	caches.org, err = connector.StartCache[orgIndex, string, *Org](background, orgIndexValues(), cache.PurposeOrganization, connectors.Config.Organization, connectors)
+	caches.org, err = connector.StartCache[orgIndex, string, *Org](background, orgIndexValues(), cache.PurposeOrganization, connectors.Config.Organization, connectors, connector.WithInstanceIndex(orgIndexByID))
 	if err != nil {
 		return nil, err
 	}
 
+	caches.loginPolicy, err = connector.StartCache[orgPolicyIndex, string, *orgPolicy[*LoginPolicy]](background, orgPolicyIndexValues(), cache.PurposeLoginPolicy, connectors.Config.LoginPolicies, connectors, connector.WithInstanceIndex(orgPolicyIndexByOrgID))
+	if err != nil {
+		return nil, err
+	}
+	caches.labelPolicy, err = connector.StartCache[orgPolicyIndex, string, *orgPolicy[*LabelPolicy]](background, orgPolicyIndexValues(), cache.PurposeLabelPolicy, connectors.Config.LabelPolicies, connectors, connector.WithInstanceIndex(orgPolicyIndexByOrgID))
+	if err != nil {
+		return nil, err
+	}
+	caches.user, err = connector.StartCache[userIndex, string, *User](background, userIndexValues(), cache.PurposeUser, connectors.Config.Users, connectors, connector.WithInstanceIndex(userIndexByID))
+	if err != nil {
+		return nil, err
+	}
+	if cacheConfigured(connectors.Config.OIDCClients) {
+		caches.oidcClients, err = connector.StartCache[oidcClientIndex, string, *oidcProjectClients](background, oidcClientIndexValues(), cache.PurposeOIDCClients, connectors.Config.OIDCClients, connectors, connector.WithInstanceIndex(oidcClientIndexByProjectID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cacheConfigured(connectors.Config.SAMLServiceProviders) {
+		caches.samlServiceProviders, err = connector.StartCache[samlSPIndex, string, *samlProjectServiceProviders](background, samlSPIndexValues(), cache.PurposeSAMLServiceProviders, connectors.Config.SAMLServiceProviders, connectors, connector.WithInstanceIndex(samlSPIndexByProjectID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	caches.registry = connectors.Registry
 	caches.activeInstances = expirable.NewLRU[string, bool](instanceConfig.MaxEntries, nil, instanceConfig.TTL)
 
 	caches.registerInstanceInvalidation()
 	caches.registerOrgInvalidation()
+	caches.registerLoginPolicyInvalidation()
+	caches.registerLabelPolicyInvalidation()
+	caches.registerUserInvalidation()
+	caches.registerOIDCClientInvalidation()
+	caches.registerSAMLServiceProviderInvalidation()
 	return caches, nil
 }
 
+func cacheConfigured(conf *cache.Config) bool {
+	return conf != nil && conf.Connector != cache.ConnectorUnspecified
+}
+
 type invalidator[I comparable] interface {
 	Invalidate(ctx context.Context, index I, key ...string) error
 }
 
+type truncater interface {
+	Truncate(ctx context.Context) error
+}
+
 func cacheInvalidationFunc[I comparable](cache invalidator[I], index I, getID func(*eventstore.Aggregate) string) func(context.Context, []*eventstore.Aggregate) {
 	return func(ctx context.Context, aggregates []*eventstore.Aggregate) {
 		ids := make([]string, len(aggregates))
@@ -57,6 +111,45 @@ func cacheInvalidationFunc[I comparable](cache invalidator[I], index I, getID fu
 	}
 }
 
+// cacheInvalidationOrTruncateFunc invalidates the objects by instance and aggregate ID.
+// The cache is truncated instead, if any aggregate matches truncate.
+// This is used for objects which depend on aggregates which can't be mapped to a key,
+// for example organization settings falling back to the instance default.
+func cacheInvalidationOrTruncateFunc[I comparable, C interface {
+	invalidator[I]
+	truncater
+}](cache C, index I, truncate func(*eventstore.Aggregate) bool) func(context.Context, []*eventstore.Aggregate) {
+	invalidate := cacheInvalidationFunc(cache, index, getInstanceCacheKey)
+	return func(ctx context.Context, aggregates []*eventstore.Aggregate) {
+		if !slices.ContainsFunc(aggregates, truncate) {
+			invalidate(ctx, aggregates)
+			return
+		}
+		err := cache.Truncate(ctx)
+		logging.OnError(err).Warn("cache truncate failed")
+	}
+}
+
+func cacheTruncateFunc(cache truncater) func(context.Context, []*eventstore.Aggregate) {
+	return func(ctx context.Context, _ []*eventstore.Aggregate) {
+		err := cache.Truncate(ctx)
+		logging.OnError(err).Warn("cache truncate failed")
+	}
+}
+
+// instanceCacheKey returns the key of an object identified by its ID, unique within the instance.
+func instanceCacheKey(instanceID, id string) string {
+	return instanceID + connector.InstanceKeySeparator + id
+}
+
+func getInstanceCacheKey(aggregate *eventstore.Aggregate) string {
+	return instanceCacheKey(aggregate.InstanceID, aggregate.ID)
+}
+
+func isInstanceAggregate(aggregate *eventstore.Aggregate) bool {
+	return aggregate.Type == instance.AggregateType
+}
+
 func getAggregateID(aggregate *eventstore.Aggregate) string {
 	return aggregate.ID
 }
@@ -64,3 +157,134 @@ func getAggregateID(aggregate *eventstore.Aggregate) string {
 func getResourceOwner(aggregate *eventstore.Aggregate) string {
 	return aggregate.ResourceOwner
 }
+
+type orgPolicyIndex int
+
+//go:generate enumer -type orgPolicyIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	orgPolicyIndexUnspecified orgPolicyIndex = iota //
+	orgPolicyIndexByOrgID
+)
+
+// orgPolicy is the cache entry of a policy of an organization.
+// The policy might be the instance default, if the organization has no own policy.
+type orgPolicy[P any] struct {
+	InstanceID string `json:"instance_id,omitempty"`
+	OrgID      string `json:"org_id,omitempty"`
+	Policy     P      `json:"policy,omitempty"`
+}
+
+// Keys implements [cache.Entry]
+func (p *orgPolicy[P]) Keys(index orgPolicyIndex) []string {
+	if index == orgPolicyIndexByOrgID {
+		return []string{instanceCacheKey(p.InstanceID, p.OrgID)}
+	}
+	return nil
+}
+
+// orgPolicyCacheInvalidationFunc invalidates the policy of an organization.
+// The cache is truncated when the default policy of an instance changes,
+// as it is cached for all organizations without own policy.
+func orgPolicyCacheInvalidationFunc[P any](cache cache.Cache[orgPolicyIndex, string, *orgPolicy[P]]) func(context.Context, []*eventstore.Aggregate) {
+	return cacheInvalidationOrTruncateFunc(cache, orgPolicyIndexByOrgID, isInstanceAggregate)
+}
+
+// CacheInfo describes a started cache.
+type CacheInfo struct {
+	Purpose   cache.Purpose
+	Connector cache.Connector
+	// Size is the amount of stored objects.
+	// It is nil if the connector can't count the objects.
+	Size *int64
+}
+
+// ListCaches returns the started caches.
+func (q *Queries) ListCaches(ctx context.Context) (_ []*CacheInfo, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	caches := q.cacheRegistry().Caches()
+	infos := make([]*CacheInfo, len(caches))
+	for i, c := range caches {
+		infos[i] = &CacheInfo{
+			Purpose:   c.Purpose(),
+			Connector: c.Connector(),
+		}
+		size, err := c.Size(ctx)
+		if errors.Is(err, errors.ErrUnsupported) {
+			continue
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Eeg4i", "Errors.Internal")
+		}
+		infos[i].Size = &size
+	}
+	return infos, nil
+}
+
+// CacheInstanceKeys returns up to limit keys of the instance's objects in the cache of the purpose.
+// The keys are sorted and all keys are returned if limit is 0.
+func (q *Queries) CacheInstanceKeys(ctx context.Context, purpose cache.Purpose, instanceID string, limit int) (_ []string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	c, err := q.registeredCache(purpose)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := c.InstanceKeys(ctx, instanceID)
+	if errors.Is(err, errors.ErrUnsupported) {
+		return nil, zerrors.ThrowUnimplemented(err, "QUERY-Ahr5o", "Errors.Cache.InspectionUnsupported")
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ooPh7", "Errors.Internal")
+	}
+	slices.Sort(keys)
+	if limit > 0 && len(keys) > limit {
+		keys = keys[:limit]
+	}
+	return keys, nil
+}
+
+// InvalidateCache invalidates the instance's objects in the cache of the purpose
+// and returns the amount of invalidated keys.
+// The whole cache is truncated if instanceID is empty, in which case the amount is 0.
+func (q *Queries) InvalidateCache(ctx context.Context, purpose cache.Purpose, instanceID string) (_ int, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	c, err := q.registeredCache(purpose)
+	if err != nil {
+		return 0, err
+	}
+	if instanceID == "" {
+		if err = c.Truncate(ctx); err != nil {
+			return 0, zerrors.ThrowInternal(err, "QUERY-ua4Ie", "Errors.Internal")
+		}
+		return 0, nil
+	}
+	invalidated, err := c.InvalidateInstance(ctx, instanceID)
+	if errors.Is(err, errors.ErrUnsupported) {
+		return 0, zerrors.ThrowUnimplemented(err, "QUERY-Ohx3e", "Errors.Cache.InspectionUnsupported")
+	}
+	if err != nil {
+		return 0, zerrors.ThrowInternal(err, "QUERY-ieM4a", "Errors.Internal")
+	}
+	return invalidated, nil
+}
+
+func (q *Queries) registeredCache(purpose cache.Purpose) (connector.RegisteredCache, error) {
+	c, ok := q.cacheRegistry().Cache(purpose)
+	if !ok {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-ae8Ch", "Errors.Cache.NotFound")
+	}
+	return c, nil
+}
+
+func (q *Queries) cacheRegistry() *connector.Registry {
+	if q.caches == nil {
+		return nil
+	}
+	return q.caches.registry
+}
@@ -369,6 +369,7 @@ type ExecutionTarget struct {
 	InterruptOnError bool
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+	RetryPolicy      *domain.TargetRetryPolicy
 }
 
 func (e *ExecutionTarget) GetExecutionID() string {
@@ -392,6 +393,9 @@ func (e *ExecutionTarget) GetTimeout() time.Duration {
 func (e *ExecutionTarget) GetSigningKey() string {
 	return e.SigningKey
 }
+func (e *ExecutionTarget) GetRetryPolicy() *domain.TargetRetryPolicy {
+	return e.RetryPolicy
+}
 
 func (t *ExecutionTarget) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
 	if t.signingKey == nil {
@@ -419,6 +423,7 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			timeout          = &sql.NullInt64{}
 			interruptOnError = &sql.NullBool{}
 			signingKey       = &crypto.CryptoValue{}
+			retryPolicy      []byte
 		)
 
 		err := rows.Scan(
@@ -430,6 +435,7 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 			timeout,
 			interruptOnError,
 			signingKey,
+			&retryPolicy,
 		)
 
 		if err != nil {
@@ -444,6 +450,10 @@ func scanExecutionTargets(rows *sql.Rows) ([]*ExecutionTarget, error) {
 		target.Timeout = time.Duration(timeout.Int64)
 		target.InterruptOnError = interruptOnError.Bool
 		target.signingKey = signingKey
+		target.RetryPolicy, err = unmarshalRetryPolicy(retryPolicy)
+		if err != nil {
+			return nil, err
+		}
 
 		targets = append(targets, target)
 	}
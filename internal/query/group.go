@@ -0,0 +1,619 @@
+package query
+
+import (
+	"context"
+	"database/sql"
+	_ "embed"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	groupTable = table{
+		name:          projection.GroupTable,
+		instanceIDCol: projection.GroupInstanceIDCol,
+	}
+	GroupColumnID = Column{
+		name:  projection.GroupIDCol,
+		table: groupTable,
+	}
+	GroupColumnCreationDate = Column{
+		name:  projection.GroupCreationDateCol,
+		table: groupTable,
+	}
+	GroupColumnChangeDate = Column{
+		name:  projection.GroupChangeDateCol,
+		table: groupTable,
+	}
+	GroupColumnSequence = Column{
+		name:  projection.GroupSequenceCol,
+		table: groupTable,
+	}
+	GroupColumnState = Column{
+		name:  projection.GroupStateCol,
+		table: groupTable,
+	}
+	GroupColumnResourceOwner = Column{
+		name:  projection.GroupResourceOwnerCol,
+		table: groupTable,
+	}
+	GroupColumnInstanceID = Column{
+		name:  projection.GroupInstanceIDCol,
+		table: groupTable,
+	}
+	GroupColumnName = Column{
+		name:           projection.GroupNameCol,
+		table:          groupTable,
+		isOrderByLower: true,
+	}
+	GroupColumnDescription = Column{
+		name:  projection.GroupDescriptionCol,
+		table: groupTable,
+	}
+	GroupColumnExternalID = Column{
+		name:  projection.GroupExternalIDCol,
+		table: groupTable,
+	}
+)
+
+var (
+	groupMemberTable = table{
+		name:          projection.GroupTable + "_" + projection.GroupMemberSuffix,
+		instanceIDCol: projection.GroupMemberInstanceIDCol,
+	}
+	GroupMemberColumnInstanceID = Column{
+		name:  projection.GroupMemberInstanceIDCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnGroupID = Column{
+		name:  projection.GroupMemberGroupIDCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnUserID = Column{
+		name:  projection.GroupMemberUserIDCol,
+		table: groupMemberTable,
+	}
+	GroupMemberColumnCreationDate = Column{
+		name:  projection.GroupMemberCreationDateCol,
+		table: groupMemberTable,
+	}
+)
+
+var (
+	groupNestedGroupTable = table{
+		name:          projection.GroupTable + "_" + projection.GroupNestedGroupSuffix,
+		instanceIDCol: projection.GroupNestedGroupInstanceIDCol,
+	}
+	GroupNestedGroupColumnInstanceID = Column{
+		name:  projection.GroupNestedGroupInstanceIDCol,
+		table: groupNestedGroupTable,
+	}
+	GroupNestedGroupColumnGroupID = Column{
+		name:  projection.GroupNestedGroupGroupIDCol,
+		table: groupNestedGroupTable,
+	}
+	GroupNestedGroupColumnNestedGroupID = Column{
+		name:  projection.GroupNestedGroupNestedIDCol,
+		table: groupNestedGroupTable,
+	}
+	GroupNestedGroupColumnCreationDate = Column{
+		name:  projection.GroupNestedGroupCreationDateCol,
+		table: groupNestedGroupTable,
+	}
+)
+
+var (
+	groupGrantTable = table{
+		name:          projection.GroupTable + "_" + projection.GroupGrantSuffix,
+		instanceIDCol: projection.GroupGrantInstanceIDCol,
+	}
+	GroupGrantColumnInstanceID = Column{
+		name:  projection.GroupGrantInstanceIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnID = Column{
+		name:  projection.GroupGrantIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnGroupID = Column{
+		name:  projection.GroupGrantGroupIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnResourceOwner = Column{
+		name:  projection.GroupGrantResourceOwnerCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnProjectID = Column{
+		name:  projection.GroupGrantProjectIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnProjectGrantID = Column{
+		name:  projection.GroupGrantProjectGrantIDCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnRoles = Column{
+		name:  projection.GroupGrantRolesCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnCreationDate = Column{
+		name:  projection.GroupGrantCreationDateCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnChangeDate = Column{
+		name:  projection.GroupGrantChangeDateCol,
+		table: groupGrantTable,
+	}
+	GroupGrantColumnSequence = Column{
+		name:  projection.GroupGrantSequenceCol,
+		table: groupGrantTable,
+	}
+)
+
+type Groups struct {
+	SearchResponse
+	Groups []*Group
+}
+
+func (g *Groups) SetState(s *State) {
+	g.State = s
+}
+
+type Group struct {
+	domain.ObjectDetails
+
+	State       domain.GroupState
+	Name        string
+	Description string
+	ExternalID  string
+}
+
+type GroupSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+type GroupMembers struct {
+	SearchResponse
+	Members []*GroupMember
+}
+
+type GroupMember struct {
+	GroupID      string
+	UserID       string
+	Username     string
+	CreationDate time.Time
+}
+
+type NestedGroups struct {
+	SearchResponse
+	NestedGroups []*NestedGroup
+}
+
+type NestedGroup struct {
+	GroupID       string
+	NestedGroupID string
+	Name          string
+	CreationDate  time.Time
+}
+
+type GroupGrants struct {
+	SearchResponse
+	GroupGrants []*GroupGrant
+}
+
+func (g *GroupGrants) SetState(s *State) {
+	g.State = s
+}
+
+type GroupGrant struct {
+	domain.ObjectDetails
+
+	GroupID        string
+	ProjectID      string
+	ProjectGrantID string
+	RoleKeys       database.TextArray[string]
+}
+
+type GroupGrantSearchQueries struct {
+	SearchRequest
+	Queries []SearchQuery
+}
+
+func (q *GroupGrantSearchQueries) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
+	query = q.SearchRequest.toQuery(query)
+	for _, q := range q.Queries {
+		query = q.toQuery(query)
+	}
+	return query
+}
+
+func (q *Queries) GetGroupByID(ctx context.Context, id, resourceOwner string) (_ *Group, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		GroupColumnID.identifier():            id,
+		GroupColumnResourceOwner.identifier(): resourceOwner,
+		GroupColumnInstanceID.identifier():    authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareGroupQuery()
+	return genericRowQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+func (q *Queries) SearchGroups(ctx context.Context, queries *GroupSearchQueries) (_ *Groups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		GroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareGroupsQuery()
+	return genericRowsQueryWithState(ctx, q.client, groupTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+// GroupMembersByGroupIDs returns the members of all provided groups.
+func (q *Queries) GroupMembersByGroupIDs(ctx context.Context, groupIDs ...string) (_ *GroupMembers, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(groupIDs) == 0 {
+		return &GroupMembers{Members: []*GroupMember{}}, nil
+	}
+	eq := sq.Eq{
+		GroupMemberColumnGroupID.identifier():    groupIDs,
+		GroupMemberColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareGroupMembersQuery()
+	return genericRowsQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+// NestedGroupsByGroupIDs returns the groups directly nested in the provided groups.
+func (q *Queries) NestedGroupsByGroupIDs(ctx context.Context, groupIDs ...string) (_ *NestedGroups, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if len(groupIDs) == 0 {
+		return &NestedGroups{NestedGroups: []*NestedGroup{}}, nil
+	}
+	eq := sq.Eq{
+		GroupNestedGroupColumnGroupID.identifier():    groupIDs,
+		GroupNestedGroupColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareNestedGroupsQuery()
+	return genericRowsQuery(ctx, q.client, query.Where(eq), scan)
+}
+
+func (q *Queries) SearchGroupGrants(ctx context.Context, queries *GroupGrantSearchQueries) (_ *GroupGrants, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	eq := sq.Eq{
+		GroupGrantColumnInstanceID.identifier(): authz.GetInstance(ctx).InstanceID(),
+	}
+	query, scan := prepareGroupGrantsQuery()
+	return genericRowsQueryWithState(ctx, q.client, groupTable, combineToWhereStmt(query, queries.toQuery, eq), scan)
+}
+
+//go:embed user_group_grants_by_project.sql
+var userGroupGrantsByProjectQuery string
+
+// UserGroupGrantsByProject returns the grants on the project of all groups the user is a direct member of
+// and all groups they are nested in.
+func (q *Queries) UserGroupGrantsByProject(ctx context.Context, userID, projectID string) (grants []*GroupGrant, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	err = q.client.QueryContext(ctx, func(rows *sql.Rows) error {
+		for rows.Next() {
+			grant := new(GroupGrant)
+			if err := rows.Scan(
+				&grant.ID,
+				&grant.CreationDate,
+				&grant.EventDate,
+				&grant.Sequence,
+				&grant.ResourceOwner,
+				&grant.GroupID,
+				&grant.ProjectID,
+				&grant.ProjectGrantID,
+				&grant.RoleKeys,
+			); err != nil {
+				return err
+			}
+			grants = append(grants, grant)
+		}
+		return rows.Err()
+	}, userGroupGrantsByProjectQuery, authz.GetInstance(ctx).InstanceID(), userID, projectID)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-eiV3o", "Errors.Internal")
+	}
+	return grants, nil
+}
+
+func NewGroupGrantGroupIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnGroupID, value, TextEquals)
+}
+
+func NewGroupGrantResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnResourceOwner, value, TextEquals)
+}
+
+func NewGroupGrantProjectIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectID, value, TextEquals)
+}
+
+func NewGroupGrantProjectGrantIDSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnProjectGrantID, value, TextEquals)
+}
+
+func NewGroupGrantRoleKeySearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupGrantColumnRoles, value, TextListContains)
+}
+
+func NewGroupResourceOwnerSearchQuery(value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnResourceOwner, value, TextEquals)
+}
+
+func NewGroupNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
+	return NewTextQuery(GroupColumnName, value, method)
+}
+
+func NewGroupIDsSearchQuery(values []string) (SearchQuery, error) {
+	return NewInTextQuery(GroupColumnID, values)
+}
+
+// NewGroupMemberSearchQuery returns a query for groups the user is a direct member of.
+func NewGroupMemberSearchQuery(userID string) (SearchQuery, error) {
+	// linking queries for the subselect
+	instanceQuery, err := NewColumnComparisonQuery(GroupMemberColumnInstanceID, GroupColumnInstanceID, ColumnEquals)
+	if err != nil {
+		return nil, err
+	}
+	userIDQuery, err := NewTextQuery(GroupMemberColumnUserID, userID, TextEquals)
+	if err != nil {
+		return nil, err
+	}
+	subSelect, err := NewSubSelect(GroupMemberColumnGroupID, []SearchQuery{instanceQuery, userIDQuery})
+	if err != nil {
+		return nil, err
+	}
+	return NewListQuery(GroupColumnID, subSelect, ListIn)
+}
+
+func prepareGroupsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Groups, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			GroupColumnExternalID.identifier(),
+			countColumn.identifier(),
+		).From(groupTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*Groups, error) {
+			groups := make([]*Group, 0)
+			var count uint64
+			for rows.Next() {
+				group := new(Group)
+				err := rows.Scan(
+					&group.ID,
+					&group.CreationDate,
+					&group.EventDate,
+					&group.Sequence,
+					&group.ResourceOwner,
+					&group.State,
+					&group.Name,
+					&group.Description,
+					&group.ExternalID,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				groups = append(groups, group)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-6rTrp", "Errors.Query.CloseRows")
+			}
+
+			return &Groups{
+				Groups: groups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareGroupQuery() (sq.SelectBuilder, func(row *sql.Row) (*Group, error)) {
+	return sq.Select(
+			GroupColumnID.identifier(),
+			GroupColumnCreationDate.identifier(),
+			GroupColumnChangeDate.identifier(),
+			GroupColumnSequence.identifier(),
+			GroupColumnResourceOwner.identifier(),
+			GroupColumnState.identifier(),
+			GroupColumnName.identifier(),
+			GroupColumnDescription.identifier(),
+			GroupColumnExternalID.identifier(),
+		).From(groupTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(row *sql.Row) (*Group, error) {
+			group := new(Group)
+			err := row.Scan(
+				&group.ID,
+				&group.CreationDate,
+				&group.EventDate,
+				&group.Sequence,
+				&group.ResourceOwner,
+				&group.State,
+				&group.Name,
+				&group.Description,
+				&group.ExternalID,
+			)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) {
+					return nil, zerrors.ThrowNotFound(err, "QUERY-fwKBv", "Errors.Group.NotFound")
+				}
+				return nil, zerrors.ThrowInternal(err, "QUERY-G9UnY", "Errors.Internal")
+			}
+			return group, nil
+		}
+}
+
+func prepareGroupMembersQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*GroupMembers, error)) {
+	return sq.Select(
+			GroupMemberColumnGroupID.identifier(),
+			GroupMemberColumnUserID.identifier(),
+			UserUsernameCol.identifier(),
+			GroupMemberColumnCreationDate.identifier(),
+			countColumn.identifier(),
+		).From(groupMemberTable.identifier()).
+			LeftJoin(join(UserIDCol, GroupMemberColumnUserID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupMembers, error) {
+			members := make([]*GroupMember, 0)
+			var count uint64
+			for rows.Next() {
+				member := new(GroupMember)
+				var username sql.NullString
+				err := rows.Scan(
+					&member.GroupID,
+					&member.UserID,
+					&username,
+					&member.CreationDate,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				member.Username = username.String
+				members = append(members, member)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-wTnHf", "Errors.Query.CloseRows")
+			}
+
+			return &GroupMembers{
+				Members: members,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareNestedGroupsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*NestedGroups, error)) {
+	return sq.Select(
+			GroupNestedGroupColumnGroupID.identifier(),
+			GroupNestedGroupColumnNestedGroupID.identifier(),
+			GroupColumnName.identifier(),
+			GroupNestedGroupColumnCreationDate.identifier(),
+			countColumn.identifier(),
+		).From(groupNestedGroupTable.identifier()).
+			LeftJoin(join(GroupColumnID, GroupNestedGroupColumnNestedGroupID)).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*NestedGroups, error) {
+			nestedGroups := make([]*NestedGroup, 0)
+			var count uint64
+			for rows.Next() {
+				nestedGroup := new(NestedGroup)
+				var name sql.NullString
+				err := rows.Scan(
+					&nestedGroup.GroupID,
+					&nestedGroup.NestedGroupID,
+					&name,
+					&nestedGroup.CreationDate,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				nestedGroup.Name = name.String
+				nestedGroups = append(nestedGroups, nestedGroup)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Rk2xN", "Errors.Query.CloseRows")
+			}
+
+			return &NestedGroups{
+				NestedGroups: nestedGroups,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
+
+func prepareGroupGrantsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*GroupGrants, error)) {
+	return sq.Select(
+			GroupGrantColumnID.identifier(),
+			GroupGrantColumnCreationDate.identifier(),
+			GroupGrantColumnChangeDate.identifier(),
+			GroupGrantColumnSequence.identifier(),
+			GroupGrantColumnResourceOwner.identifier(),
+			GroupGrantColumnGroupID.identifier(),
+			GroupGrantColumnProjectID.identifier(),
+			GroupGrantColumnProjectGrantID.identifier(),
+			GroupGrantColumnRoles.identifier(),
+			countColumn.identifier(),
+		).From(groupGrantTable.identifier()).
+			PlaceholderFormat(sq.Dollar),
+		func(rows *sql.Rows) (*GroupGrants, error) {
+			grants := make([]*GroupGrant, 0)
+			var count uint64
+			for rows.Next() {
+				grant := new(GroupGrant)
+				err := rows.Scan(
+					&grant.ID,
+					&grant.CreationDate,
+					&grant.EventDate,
+					&grant.Sequence,
+					&grant.ResourceOwner,
+					&grant.GroupID,
+					&grant.ProjectID,
+					&grant.ProjectGrantID,
+					&grant.RoleKeys,
+					&count,
+				)
+				if err != nil {
+					return nil, err
+				}
+				grants = append(grants, grant)
+			}
+
+			if err := rows.Close(); err != nil {
+				return nil, zerrors.ThrowInternal(err, "QUERY-Jc8nV", "Errors.Query.CloseRows")
+			}
+
+			return &GroupGrants{
+				GroupGrants: grants,
+				SearchResponse: SearchResponse{
+					Count: count,
+				},
+			}, nil
+		}
+}
@@ -0,0 +1,541 @@
+package query
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+var (
+	prepareGroupsStmt = `SELECT projections.groups.id,` +
+		` projections.groups.creation_date,` +
+		` projections.groups.change_date,` +
+		` projections.groups.sequence,` +
+		` projections.groups.resource_owner,` +
+		` projections.groups.state,` +
+		` projections.groups.name,` +
+		` projections.groups.description,` +
+		` projections.groups.external_id,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups`
+	prepareGroupsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"state",
+		"name",
+		"description",
+		"external_id",
+		"count",
+	}
+
+	prepareGroupStmt = `SELECT projections.groups.id,` +
+		` projections.groups.creation_date,` +
+		` projections.groups.change_date,` +
+		` projections.groups.sequence,` +
+		` projections.groups.resource_owner,` +
+		` projections.groups.state,` +
+		` projections.groups.name,` +
+		` projections.groups.description,` +
+		` projections.groups.external_id` +
+		` FROM projections.groups`
+	prepareGroupCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"state",
+		"name",
+		"description",
+		"external_id",
+	}
+
+	prepareGroupMembersStmt = `SELECT projections.groups_members.group_id,` +
+		` projections.groups_members.user_id,` +
+		` projections.users14.username,` +
+		` projections.groups_members.creation_date,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups_members` +
+		` LEFT JOIN projections.users14 ON projections.groups_members.user_id = projections.users14.id AND projections.groups_members.instance_id = projections.users14.instance_id`
+	prepareGroupMembersCols = []string{
+		"group_id",
+		"user_id",
+		"username",
+		"creation_date",
+		"count",
+	}
+
+	prepareNestedGroupsStmt = `SELECT projections.groups_nested_groups.group_id,` +
+		` projections.groups_nested_groups.nested_group_id,` +
+		` projections.groups.name,` +
+		` projections.groups_nested_groups.creation_date,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups_nested_groups` +
+		` LEFT JOIN projections.groups ON projections.groups_nested_groups.nested_group_id = projections.groups.id AND projections.groups_nested_groups.instance_id = projections.groups.instance_id`
+	prepareNestedGroupsCols = []string{
+		"group_id",
+		"nested_group_id",
+		"name",
+		"creation_date",
+		"count",
+	}
+
+	prepareGroupGrantsStmt = `SELECT projections.groups_grants.id,` +
+		` projections.groups_grants.creation_date,` +
+		` projections.groups_grants.change_date,` +
+		` projections.groups_grants.sequence,` +
+		` projections.groups_grants.resource_owner,` +
+		` projections.groups_grants.group_id,` +
+		` projections.groups_grants.project_id,` +
+		` projections.groups_grants.project_grant_id,` +
+		` projections.groups_grants.roles,` +
+		` COUNT(*) OVER ()` +
+		` FROM projections.groups_grants`
+	prepareGroupGrantsCols = []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"group_id",
+		"project_id",
+		"project_grant_id",
+		"roles",
+		"count",
+	}
+)
+
+func Test_GroupPrepares(t *testing.T) {
+	type want struct {
+		sqlExpectations sqlExpectation
+		err             checkErr
+	}
+	tests := []struct {
+		name    string
+		prepare interface{}
+		want    want
+		object  interface{}
+	}{
+		{
+			name:    "prepareGroupsQuery no result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &Groups{Groups: []*Group{}},
+		},
+		{
+			name:    "prepareGroupsQuery multiple result",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					prepareGroupsCols,
+					[][]driver.Value{
+						{
+							"id-1",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							domain.GroupStateActive,
+							"name1",
+							"description1",
+							"external1",
+						},
+						{
+							"id-2",
+							testNow,
+							testNow,
+							uint64(20211109),
+							"ro",
+							domain.GroupStateActive,
+							"name2",
+							"",
+							"",
+						},
+					},
+				),
+			},
+			object: &Groups{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Groups: []*Group{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-1",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							Sequence:      20211109,
+							ResourceOwner: "ro",
+						},
+						State:       domain.GroupStateActive,
+						Name:        "name1",
+						Description: "description1",
+						ExternalID:  "external1",
+					},
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "id-2",
+							EventDate:     testNow,
+							CreationDate:  testNow,
+							Sequence:      20211109,
+							ResourceOwner: "ro",
+						},
+						State: domain.GroupStateActive,
+						Name:  "name2",
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupsQuery sql err",
+			prepare: prepareGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Groups)(nil),
+		},
+		{
+			name:    "prepareGroupQuery no result",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueriesScanErr(
+					regexp.QuoteMeta(prepareGroupStmt),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupQuery found",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(prepareGroupStmt),
+					prepareGroupCols,
+					[]driver.Value{
+						"id",
+						testNow,
+						testNow,
+						uint64(20211109),
+						"ro",
+						domain.GroupStateActive,
+						"name",
+						"description",
+						"external",
+					},
+				),
+			},
+			object: &Group{
+				ObjectDetails: domain.ObjectDetails{
+					ID:            "id",
+					EventDate:     testNow,
+					CreationDate:  testNow,
+					Sequence:      20211109,
+					ResourceOwner: "ro",
+				},
+				State:       domain.GroupStateActive,
+				Name:        "name",
+				Description: "description",
+				ExternalID:  "external",
+			},
+		},
+		{
+			name:    "prepareGroupQuery sql err",
+			prepare: prepareGroupQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*Group)(nil),
+		},
+		{
+			name:    "prepareGroupMembersQuery multiple result",
+			prepare: prepareGroupMembersQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupMembersStmt),
+					prepareGroupMembersCols,
+					[][]driver.Value{
+						{
+							"group-1",
+							"user-1",
+							"username1",
+							testNow,
+						},
+						{
+							"group-1",
+							"user-2",
+							nil,
+							testNow,
+						},
+					},
+				),
+			},
+			object: &GroupMembers{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				Members: []*GroupMember{
+					{
+						GroupID:      "group-1",
+						UserID:       "user-1",
+						Username:     "username1",
+						CreationDate: testNow,
+					},
+					{
+						GroupID:      "group-1",
+						UserID:       "user-2",
+						CreationDate: testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareNestedGroupsQuery multiple result",
+			prepare: prepareNestedGroupsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareNestedGroupsStmt),
+					prepareNestedGroupsCols,
+					[][]driver.Value{
+						{
+							"group-1",
+							"group-2",
+							"name2",
+							testNow,
+						},
+						{
+							"group-1",
+							"group-3",
+							nil,
+							testNow,
+						},
+					},
+				),
+			},
+			object: &NestedGroups{
+				SearchResponse: SearchResponse{
+					Count: 2,
+				},
+				NestedGroups: []*NestedGroup{
+					{
+						GroupID:       "group-1",
+						NestedGroupID: "group-2",
+						Name:          "name2",
+						CreationDate:  testNow,
+					},
+					{
+						GroupID:       "group-1",
+						NestedGroupID: "group-3",
+						CreationDate:  testNow,
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupGrantsQuery no result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					nil,
+					nil,
+				),
+			},
+			object: &GroupGrants{GroupGrants: []*GroupGrant{}},
+		},
+		{
+			name:    "prepareGroupGrantsQuery one result",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueries(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					prepareGroupGrantsCols,
+					[][]driver.Value{
+						{
+							"grant-1",
+							testNow,
+							testNow,
+							uint64(20211108),
+							"ro",
+							"group-1",
+							"project-1",
+							"project-grant-1",
+							database.TextArray[string]{"role1", "role2"},
+						},
+					},
+				),
+			},
+			object: &GroupGrants{
+				SearchResponse: SearchResponse{
+					Count: 1,
+				},
+				GroupGrants: []*GroupGrant{
+					{
+						ObjectDetails: domain.ObjectDetails{
+							ID:            "grant-1",
+							CreationDate:  testNow,
+							EventDate:     testNow,
+							Sequence:      20211108,
+							ResourceOwner: "ro",
+						},
+						GroupID:        "group-1",
+						ProjectID:      "project-1",
+						ProjectGrantID: "project-grant-1",
+						RoleKeys:       database.TextArray[string]{"role1", "role2"},
+					},
+				},
+			},
+		},
+		{
+			name:    "prepareGroupGrantsQuery sql err",
+			prepare: prepareGroupGrantsQuery,
+			want: want{
+				sqlExpectations: mockQueryErr(
+					regexp.QuoteMeta(prepareGroupGrantsStmt),
+					sql.ErrConnDone,
+				),
+				err: func(err error) (error, bool) {
+					if !errors.Is(err, sql.ErrConnDone) {
+						return fmt.Errorf("err should be sql.ErrConnDone got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: (*GroupGrants)(nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertPrepare(t, tt.prepare, tt.object, tt.want.sqlExpectations, tt.want.err)
+		})
+	}
+}
+
+func TestQueries_UserGroupGrantsByProject(t *testing.T) {
+	expQuery := regexp.QuoteMeta(userGroupGrantsByProjectQuery)
+	cols := []string{
+		"id",
+		"creation_date",
+		"change_date",
+		"sequence",
+		"resource_owner",
+		"group_id",
+		"project_id",
+		"project_grant_id",
+		"roles",
+	}
+
+	tests := []struct {
+		name    string
+		mock    sqlExpectation
+		want    []*GroupGrant
+		wantErr error
+	}{
+		{
+			name:    "internal error",
+			mock:    mockQueryErr(expQuery, sql.ErrConnDone, "instanceID", "userID", "projectID"),
+			wantErr: zerrors.ThrowInternal(sql.ErrConnDone, "QUERY-eiV3o", "Errors.Internal"),
+		},
+		{
+			name: "no grants",
+			mock: mockQueries(expQuery, cols, nil, "instanceID", "userID", "projectID"),
+		},
+		{
+			name: "grants of (nested) groups",
+			mock: mockQueries(expQuery, cols,
+				[][]driver.Value{
+					{"grant-1", testNow, testNow, uint64(20211108), "ro", "group-1", "projectID", "", database.TextArray[string]{"role1"}},
+					{"grant-2", testNow, testNow, uint64(20211108), "ro", "parent-group", "projectID", "", database.TextArray[string]{"role2"}},
+				},
+				"instanceID", "userID", "projectID",
+			),
+			want: []*GroupGrant{
+				{
+					ObjectDetails: domain.ObjectDetails{
+						ID:            "grant-1",
+						CreationDate:  testNow,
+						EventDate:     testNow,
+						Sequence:      20211108,
+						ResourceOwner: "ro",
+					},
+					GroupID:   "group-1",
+					ProjectID: "projectID",
+					RoleKeys:  database.TextArray[string]{"role1"},
+				},
+				{
+					ObjectDetails: domain.ObjectDetails{
+						ID:            "grant-2",
+						CreationDate:  testNow,
+						EventDate:     testNow,
+						Sequence:      20211108,
+						ResourceOwner: "ro",
+					},
+					GroupID:   "parent-group",
+					ProjectID: "projectID",
+					RoleKeys:  database.TextArray[string]{"role2"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			execMock(t, tt.mock, func(db *sql.DB) {
+				q := &Queries{
+					client: &database.DB{
+						DB: db,
+					},
+				}
+				ctx := authz.NewMockContext("instanceID", "orgID", "loginClient")
+				got, err := q.UserGroupGrantsByProject(ctx, "userID", "projectID")
+				require.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -21,20 +22,22 @@ import (
 )
 
 type IDPTemplate struct {
-	CreationDate      time.Time
-	ChangeDate        time.Time
-	Sequence          uint64
-	ResourceOwner     string
-	ID                string
-	State             domain.IDPState
-	Name              string
-	Type              domain.IDPType
-	OwnerType         domain.IdentityProviderType
-	IsCreationAllowed bool
-	IsLinkingAllowed  bool
-	IsAutoCreation    bool
-	IsAutoUpdate      bool
-	AutoLinking       domain.AutoLinkingOption
+	CreationDate             time.Time
+	ChangeDate               time.Time
+	Sequence                 uint64
+	ResourceOwner            string
+	ID                       string
+	State                    domain.IDPState
+	Name                     string
+	Type                     domain.IDPType
+	OwnerType                domain.IdentityProviderType
+	IsCreationAllowed        bool
+	IsLinkingAllowed         bool
+	IsAutoCreation           bool
+	IsAutoUpdate             bool
+	AutoLinking              domain.AutoLinkingOption
+	GroupMapping             *domain.IDPGroupMapping
+	IsFederatedLogoutEnabled bool
 	*OAuthIDPTemplate
 	*OIDCIDPTemplate
 	*JWTIDPTemplate
@@ -47,6 +50,10 @@ type IDPTemplate struct {
 	*LDAPIDPTemplate
 	*AppleIDPTemplate
 	*SAMLIDPTemplate
+	*OktaIDPTemplate
+	*Auth0IDPTemplate
+	*KeycloakIDPTemplate
+	*EntraExternalIDIDPTemplate
 }
 
 type IDPTemplates struct {
@@ -64,6 +71,7 @@ type OAuthIDPTemplate struct {
 	Scopes                database.TextArray[string]
 	IDAttribute           string
 	UsePKCE               bool
+	ClaimMapping          *domain.IDPClaimMapping
 }
 
 type OIDCIDPTemplate struct {
@@ -74,6 +82,7 @@ type OIDCIDPTemplate struct {
 	Scopes           database.TextArray[string]
 	IsIDTokenMapping bool
 	UsePKCE          bool
+	ClaimMapping     *domain.IDPClaimMapping
 }
 
 type JWTIDPTemplate struct {
@@ -168,6 +177,41 @@ type SAMLIDPTemplate struct {
 	FederatedLogoutEnabled        bool
 }
 
+type OktaIDPTemplate struct {
+	IDPID                 string
+	Domain                string
+	AuthorizationServerID string
+	ClientID              string
+	ClientSecret          *crypto.CryptoValue
+	Scopes                database.TextArray[string]
+}
+
+type Auth0IDPTemplate struct {
+	IDPID        string
+	Domain       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type KeycloakIDPTemplate struct {
+	IDPID        string
+	BaseURL      string
+	Realm        string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
+type EntraExternalIDIDPTemplate struct {
+	IDPID        string
+	TenantID     string
+	Domain       string
+	ClientID     string
+	ClientSecret *crypto.CryptoValue
+	Scopes       database.TextArray[string]
+}
+
 var (
 	idpTemplateTable = table{
 		name:          projection.IDPTemplateTable,
@@ -237,6 +281,14 @@ var (
 		name:  projection.IDPTemplateAutoLinkingCol,
 		table: idpTemplateTable,
 	}
+	IDPTemplateGroupMappingCol = Column{
+		name:  projection.IDPTemplateGroupMappingCol,
+		table: idpTemplateTable,
+	}
+	IDPTemplateIsFederatedLogoutEnabledCol = Column{
+		name:  projection.IDPTemplateIsFederatedLogoutEnabledCol,
+		table: idpTemplateTable,
+	}
 )
 
 var (
@@ -284,6 +336,10 @@ var (
 		name:  projection.OAuthUsePKCECol,
 		table: oauthIdpTemplateTable,
 	}
+	OAuthClaimMappingCol = Column{
+		name:  projection.OAuthClaimMappingCol,
+		table: oauthIdpTemplateTable,
+	}
 )
 
 var (
@@ -323,6 +379,10 @@ var (
 		name:  projection.OIDCUsePKCECol,
 		table: oidcIdpTemplateTable,
 	}
+	OIDCClaimMappingCol = Column{
+		name:  projection.OIDCClaimMappingCol,
+		table: oidcIdpTemplateTable,
+	}
 )
 
 var (
@@ -731,6 +791,142 @@ var (
 	}
 )
 
+var (
+	oktaIdpTemplateTable = table{
+		name:          projection.IDPTemplateOktaTable,
+		instanceIDCol: projection.OktaInstanceIDCol,
+	}
+	OktaIDCol = Column{
+		name:  projection.OktaIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaInstanceIDCol = Column{
+		name:  projection.OktaInstanceIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaDomainCol = Column{
+		name:  projection.OktaDomainCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaAuthorizationServerIDCol = Column{
+		name:  projection.OktaAuthorizationServerIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaClientIDCol = Column{
+		name:  projection.OktaClientIDCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaClientSecretCol = Column{
+		name:  projection.OktaClientSecretCol,
+		table: oktaIdpTemplateTable,
+	}
+	OktaScopesCol = Column{
+		name:  projection.OktaScopesCol,
+		table: oktaIdpTemplateTable,
+	}
+)
+
+var (
+	auth0IdpTemplateTable = table{
+		name:          projection.IDPTemplateAuth0Table,
+		instanceIDCol: projection.Auth0InstanceIDCol,
+	}
+	Auth0IDCol = Column{
+		name:  projection.Auth0IDCol,
+		table: auth0IdpTemplateTable,
+	}
+	Auth0InstanceIDCol = Column{
+		name:  projection.Auth0InstanceIDCol,
+		table: auth0IdpTemplateTable,
+	}
+	Auth0DomainCol = Column{
+		name:  projection.Auth0DomainCol,
+		table: auth0IdpTemplateTable,
+	}
+	Auth0ClientIDCol = Column{
+		name:  projection.Auth0ClientIDCol,
+		table: auth0IdpTemplateTable,
+	}
+	Auth0ClientSecretCol = Column{
+		name:  projection.Auth0ClientSecretCol,
+		table: auth0IdpTemplateTable,
+	}
+	Auth0ScopesCol = Column{
+		name:  projection.Auth0ScopesCol,
+		table: auth0IdpTemplateTable,
+	}
+)
+
+var (
+	keycloakIdpTemplateTable = table{
+		name:          projection.IDPTemplateKeycloakTable,
+		instanceIDCol: projection.KeycloakInstanceIDCol,
+	}
+	KeycloakIDCol = Column{
+		name:  projection.KeycloakIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakInstanceIDCol = Column{
+		name:  projection.KeycloakInstanceIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakBaseURLCol = Column{
+		name:  projection.KeycloakBaseURLCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakRealmCol = Column{
+		name:  projection.KeycloakRealmCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakClientIDCol = Column{
+		name:  projection.KeycloakClientIDCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakClientSecretCol = Column{
+		name:  projection.KeycloakClientSecretCol,
+		table: keycloakIdpTemplateTable,
+	}
+	KeycloakScopesCol = Column{
+		name:  projection.KeycloakScopesCol,
+		table: keycloakIdpTemplateTable,
+	}
+)
+
+var (
+	entraExternalIDIdpTemplateTable = table{
+		name:          projection.IDPTemplateEntraExternalIDTable,
+		instanceIDCol: projection.EntraExternalIDInstanceIDCol,
+	}
+	EntraExternalIDIDCol = Column{
+		name:  projection.EntraExternalIDIDCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+	EntraExternalIDInstanceIDCol = Column{
+		name:  projection.EntraExternalIDInstanceIDCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+	EntraExternalIDTenantIDCol = Column{
+		name:  projection.EntraExternalIDTenantIDCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+	EntraExternalIDDomainCol = Column{
+		name:  projection.EntraExternalIDDomainCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+	EntraExternalIDClientIDCol = Column{
+		name:  projection.EntraExternalIDClientIDCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+	EntraExternalIDClientSecretCol = Column{
+		name:  projection.EntraExternalIDClientSecretCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+	EntraExternalIDScopesCol = Column{
+		name:  projection.EntraExternalIDScopesCol,
+		table: entraExternalIDIdpTemplateTable,
+	}
+)
+
 // IDPTemplateByID searches for the requested id with permission check if necessary
 func (q *Queries) IDPTemplateByID(ctx context.Context, shouldTriggerBulk bool, id string, withOwnerRemoved bool, permissionCheck domain.PermissionCheck, queries ...SearchQuery) (template *IDPTemplate, err error) {
 	idp, err := q.idpTemplateByID(ctx, shouldTriggerBulk, id, withOwnerRemoved, queries...)
@@ -828,6 +1024,10 @@ func NewIDPTemplateOwnerTypeSearchQuery(ownerType domain.IdentityProviderType) (
 	return NewNumberQuery(IDPTemplateOwnerTypeCol, ownerType, NumberEquals)
 }
 
+func NewIDPTemplateTypeSearchQuery(idpType domain.IDPType) (SearchQuery, error) {
+	return NewNumberQuery(IDPTemplateTypeCol, idpType, NumberEquals)
+}
+
 func NewIDPTemplateNameSearchQuery(method TextComparison, value string) (SearchQuery, error) {
 	return NewTextQuery(IDPTemplateNameCol, value, method)
 }
@@ -884,6 +1084,8 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			IDPTemplateIsAutoCreationCol.identifier(),
 			IDPTemplateIsAutoUpdateCol.identifier(),
 			IDPTemplateAutoLinkingCol.identifier(),
+			IDPTemplateGroupMappingCol.identifier(),
+			IDPTemplateIsFederatedLogoutEnabledCol.identifier(),
 			// oauth
 			OAuthIDCol.identifier(),
 			OAuthClientIDCol.identifier(),
@@ -894,6 +1096,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
 			OAuthUsePKCECol.identifier(),
+			OAuthClaimMappingCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -902,6 +1105,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			OIDCScopesCol.identifier(),
 			OIDCIDTokenMappingCol.identifier(),
 			OIDCUsePKCECol.identifier(),
+			OIDCClaimMappingCol.identifier(),
 			// jwt
 			JWTIDCol.identifier(),
 			JWTIssuerCol.identifier(),
@@ -986,6 +1190,33 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			AppleKeyIDCol.identifier(),
 			ApplePrivateKeyCol.identifier(),
 			AppleScopesCol.identifier(),
+			// okta
+			OktaIDCol.identifier(),
+			OktaDomainCol.identifier(),
+			OktaAuthorizationServerIDCol.identifier(),
+			OktaClientIDCol.identifier(),
+			OktaClientSecretCol.identifier(),
+			OktaScopesCol.identifier(),
+			// auth0
+			Auth0IDCol.identifier(),
+			Auth0DomainCol.identifier(),
+			Auth0ClientIDCol.identifier(),
+			Auth0ClientSecretCol.identifier(),
+			Auth0ScopesCol.identifier(),
+			// keycloak
+			KeycloakIDCol.identifier(),
+			KeycloakBaseURLCol.identifier(),
+			KeycloakRealmCol.identifier(),
+			KeycloakClientIDCol.identifier(),
+			KeycloakClientSecretCol.identifier(),
+			KeycloakScopesCol.identifier(),
+			// entra external id
+			EntraExternalIDIDCol.identifier(),
+			EntraExternalIDTenantIDCol.identifier(),
+			EntraExternalIDDomainCol.identifier(),
+			EntraExternalIDClientIDCol.identifier(),
+			EntraExternalIDClientSecretCol.identifier(),
+			EntraExternalIDScopesCol.identifier(),
 		).From(idpTemplateTable.identifier()).
 			LeftJoin(join(OAuthIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(OIDCIDCol, IDPTemplateIDCol)).
@@ -999,12 +1230,18 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(AppleIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(OktaIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(Auth0IDCol, IDPTemplateIDCol)).
+			LeftJoin(join(KeycloakIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(EntraExternalIDIDCol, IDPTemplateIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*IDPTemplate, error) {
 			idpTemplate := new(IDPTemplate)
 
 			name := sql.NullString{}
 
+			var groupMapping []byte
+
 			oauthID := sql.NullString{}
 			oauthClientID := sql.NullString{}
 			oauthClientSecret := new(crypto.CryptoValue)
@@ -1014,6 +1251,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			oauthScopes := database.TextArray[string]{}
 			oauthIDAttribute := sql.NullString{}
 			oauthUserPKCE := sql.NullBool{}
+			var oauthClaimMapping []byte
 
 			oidcID := sql.NullString{}
 			oidcIssuer := sql.NullString{}
@@ -1022,6 +1260,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			oidcScopes := database.TextArray[string]{}
 			oidcIDTokenMapping := sql.NullBool{}
 			oidcUserPKCE := sql.NullBool{}
+			var oidcClaimMapping []byte
 
 			jwtID := sql.NullString{}
 			jwtIssuer := sql.NullString{}
@@ -1107,6 +1346,33 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			applePrivateKey := new(crypto.CryptoValue)
 			appleScopes := database.TextArray[string]{}
 
+			oktaID := sql.NullString{}
+			oktaDomain := sql.NullString{}
+			oktaAuthorizationServerID := sql.NullString{}
+			oktaClientID := sql.NullString{}
+			oktaClientSecret := new(crypto.CryptoValue)
+			oktaScopes := database.TextArray[string]{}
+
+			auth0ID := sql.NullString{}
+			auth0Domain := sql.NullString{}
+			auth0ClientID := sql.NullString{}
+			auth0ClientSecret := new(crypto.CryptoValue)
+			auth0Scopes := database.TextArray[string]{}
+
+			keycloakID := sql.NullString{}
+			keycloakBaseURL := sql.NullString{}
+			keycloakRealm := sql.NullString{}
+			keycloakClientID := sql.NullString{}
+			keycloakClientSecret := new(crypto.CryptoValue)
+			keycloakScopes := database.TextArray[string]{}
+
+			entraExternalIDID := sql.NullString{}
+			entraExternalIDTenantID := sql.NullString{}
+			entraExternalIDDomain := sql.NullString{}
+			entraExternalIDClientID := sql.NullString{}
+			entraExternalIDClientSecret := new(crypto.CryptoValue)
+			entraExternalIDScopes := database.TextArray[string]{}
+
 			err := row.Scan(
 				&idpTemplate.ID,
 				&idpTemplate.ResourceOwner,
@@ -1122,6 +1388,8 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 				&idpTemplate.IsAutoCreation,
 				&idpTemplate.IsAutoUpdate,
 				&idpTemplate.AutoLinking,
+				&groupMapping,
+				&idpTemplate.IsFederatedLogoutEnabled,
 				// oauth
 				&oauthID,
 				&oauthClientID,
@@ -1132,6 +1400,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 				&oauthScopes,
 				&oauthIDAttribute,
 				&oauthUserPKCE,
+				&oauthClaimMapping,
 				// oidc
 				&oidcID,
 				&oidcIssuer,
@@ -1140,6 +1409,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 				&oidcScopes,
 				&oidcIDTokenMapping,
 				&oidcUserPKCE,
+				&oidcClaimMapping,
 				// jwt
 				&jwtID,
 				&jwtIssuer,
@@ -1224,6 +1494,33 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 				&appleKeyID,
 				&applePrivateKey,
 				&appleScopes,
+				// okta
+				&oktaID,
+				&oktaDomain,
+				&oktaAuthorizationServerID,
+				&oktaClientID,
+				&oktaClientSecret,
+				&oktaScopes,
+				// auth0
+				&auth0ID,
+				&auth0Domain,
+				&auth0ClientID,
+				&auth0ClientSecret,
+				&auth0Scopes,
+				// keycloak
+				&keycloakID,
+				&keycloakBaseURL,
+				&keycloakRealm,
+				&keycloakClientID,
+				&keycloakClientSecret,
+				&keycloakScopes,
+				// entra external id
+				&entraExternalIDID,
+				&entraExternalIDTenantID,
+				&entraExternalIDDomain,
+				&entraExternalIDClientID,
+				&entraExternalIDClientSecret,
+				&entraExternalIDScopes,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -1233,6 +1530,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 			}
 
 			idpTemplate.Name = name.String
+			idpTemplate.GroupMapping = groupMappingFromJSON(groupMapping)
 
 			if oauthID.Valid {
 				idpTemplate.OAuthIDPTemplate = &OAuthIDPTemplate{
@@ -1245,6 +1543,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 					Scopes:                oauthScopes,
 					IDAttribute:           oauthIDAttribute.String,
 					UsePKCE:               oauthUserPKCE.Bool,
+					ClaimMapping:          claimMappingFromJSON(oauthClaimMapping),
 				}
 			}
 			if oidcID.Valid {
@@ -1256,6 +1555,7 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 					Scopes:           oidcScopes,
 					IsIDTokenMapping: oidcIDTokenMapping.Bool,
 					UsePKCE:          oidcUserPKCE.Bool,
+					ClaimMapping:     claimMappingFromJSON(oidcClaimMapping),
 				}
 			}
 			if jwtID.Valid {
@@ -1374,6 +1674,45 @@ func prepareIDPTemplateByIDQuery() (sq.SelectBuilder, func(*sql.Row) (*IDPTempla
 					Scopes:     appleScopes,
 				}
 			}
+			if oktaID.Valid {
+				idpTemplate.OktaIDPTemplate = &OktaIDPTemplate{
+					IDPID:                 oktaID.String,
+					Domain:                oktaDomain.String,
+					AuthorizationServerID: oktaAuthorizationServerID.String,
+					ClientID:              oktaClientID.String,
+					ClientSecret:          oktaClientSecret,
+					Scopes:                oktaScopes,
+				}
+			}
+			if auth0ID.Valid {
+				idpTemplate.Auth0IDPTemplate = &Auth0IDPTemplate{
+					IDPID:        auth0ID.String,
+					Domain:       auth0Domain.String,
+					ClientID:     auth0ClientID.String,
+					ClientSecret: auth0ClientSecret,
+					Scopes:       auth0Scopes,
+				}
+			}
+			if keycloakID.Valid {
+				idpTemplate.KeycloakIDPTemplate = &KeycloakIDPTemplate{
+					IDPID:        keycloakID.String,
+					BaseURL:      keycloakBaseURL.String,
+					Realm:        keycloakRealm.String,
+					ClientID:     keycloakClientID.String,
+					ClientSecret: keycloakClientSecret,
+					Scopes:       keycloakScopes,
+				}
+			}
+			if entraExternalIDID.Valid {
+				idpTemplate.EntraExternalIDIDPTemplate = &EntraExternalIDIDPTemplate{
+					IDPID:        entraExternalIDID.String,
+					TenantID:     entraExternalIDTenantID.String,
+					Domain:       entraExternalIDDomain.String,
+					ClientID:     entraExternalIDClientID.String,
+					ClientSecret: entraExternalIDClientSecret,
+					Scopes:       entraExternalIDScopes,
+				}
+			}
 
 			return idpTemplate, nil
 		}
@@ -1396,6 +1735,8 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			IDPTemplateIsAutoCreationCol.identifier(),
 			IDPTemplateIsAutoUpdateCol.identifier(),
 			IDPTemplateAutoLinkingCol.identifier(),
+			IDPTemplateGroupMappingCol.identifier(),
+			IDPTemplateIsFederatedLogoutEnabledCol.identifier(),
 			// oauth
 			OAuthIDCol.identifier(),
 			OAuthClientIDCol.identifier(),
@@ -1406,6 +1747,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			OAuthScopesCol.identifier(),
 			OAuthIDAttributeCol.identifier(),
 			OAuthUsePKCECol.identifier(),
+			OAuthClaimMappingCol.identifier(),
 			// oidc
 			OIDCIDCol.identifier(),
 			OIDCIssuerCol.identifier(),
@@ -1414,6 +1756,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			OIDCScopesCol.identifier(),
 			OIDCIDTokenMappingCol.identifier(),
 			OIDCUsePKCECol.identifier(),
+			OIDCClaimMappingCol.identifier(),
 			// jwt
 			JWTIDCol.identifier(),
 			JWTIssuerCol.identifier(),
@@ -1498,6 +1841,33 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			AppleKeyIDCol.identifier(),
 			ApplePrivateKeyCol.identifier(),
 			AppleScopesCol.identifier(),
+			// okta
+			OktaIDCol.identifier(),
+			OktaDomainCol.identifier(),
+			OktaAuthorizationServerIDCol.identifier(),
+			OktaClientIDCol.identifier(),
+			OktaClientSecretCol.identifier(),
+			OktaScopesCol.identifier(),
+			// auth0
+			Auth0IDCol.identifier(),
+			Auth0DomainCol.identifier(),
+			Auth0ClientIDCol.identifier(),
+			Auth0ClientSecretCol.identifier(),
+			Auth0ScopesCol.identifier(),
+			// keycloak
+			KeycloakIDCol.identifier(),
+			KeycloakBaseURLCol.identifier(),
+			KeycloakRealmCol.identifier(),
+			KeycloakClientIDCol.identifier(),
+			KeycloakClientSecretCol.identifier(),
+			KeycloakScopesCol.identifier(),
+			// entra external id
+			EntraExternalIDIDCol.identifier(),
+			EntraExternalIDTenantIDCol.identifier(),
+			EntraExternalIDDomainCol.identifier(),
+			EntraExternalIDClientIDCol.identifier(),
+			EntraExternalIDClientSecretCol.identifier(),
+			EntraExternalIDScopesCol.identifier(),
 			// count
 			countColumn.identifier(),
 		).From(idpTemplateTable.identifier()).
@@ -1513,6 +1883,10 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			LeftJoin(join(SAMLIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(LDAPIDCol, IDPTemplateIDCol)).
 			LeftJoin(join(AppleIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(OktaIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(Auth0IDCol, IDPTemplateIDCol)).
+			LeftJoin(join(KeycloakIDCol, IDPTemplateIDCol)).
+			LeftJoin(join(EntraExternalIDIDCol, IDPTemplateIDCol)).
 			PlaceholderFormat(sq.Dollar),
 		func(rows *sql.Rows) (*IDPTemplates, error) {
 			templates := make([]*IDPTemplate, 0)
@@ -1522,6 +1896,8 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 
 				name := sql.NullString{}
 
+				var groupMapping []byte
+
 				oauthID := sql.NullString{}
 				oauthClientID := sql.NullString{}
 				oauthClientSecret := new(crypto.CryptoValue)
@@ -1531,6 +1907,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 				oauthScopes := database.TextArray[string]{}
 				oauthIDAttribute := sql.NullString{}
 				oauthUserPKCE := sql.NullBool{}
+				var oauthClaimMapping []byte
 
 				oidcID := sql.NullString{}
 				oidcIssuer := sql.NullString{}
@@ -1539,6 +1916,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 				oidcScopes := database.TextArray[string]{}
 				oidcIDTokenMapping := sql.NullBool{}
 				oidcUserPKCE := sql.NullBool{}
+				var oidcClaimMapping []byte
 
 				jwtID := sql.NullString{}
 				jwtIssuer := sql.NullString{}
@@ -1624,6 +2002,33 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 				applePrivateKey := new(crypto.CryptoValue)
 				appleScopes := database.TextArray[string]{}
 
+				oktaID := sql.NullString{}
+				oktaDomain := sql.NullString{}
+				oktaAuthorizationServerID := sql.NullString{}
+				oktaClientID := sql.NullString{}
+				oktaClientSecret := new(crypto.CryptoValue)
+				oktaScopes := database.TextArray[string]{}
+
+				auth0ID := sql.NullString{}
+				auth0Domain := sql.NullString{}
+				auth0ClientID := sql.NullString{}
+				auth0ClientSecret := new(crypto.CryptoValue)
+				auth0Scopes := database.TextArray[string]{}
+
+				keycloakID := sql.NullString{}
+				keycloakBaseURL := sql.NullString{}
+				keycloakRealm := sql.NullString{}
+				keycloakClientID := sql.NullString{}
+				keycloakClientSecret := new(crypto.CryptoValue)
+				keycloakScopes := database.TextArray[string]{}
+
+				entraExternalIDID := sql.NullString{}
+				entraExternalIDTenantID := sql.NullString{}
+				entraExternalIDDomain := sql.NullString{}
+				entraExternalIDClientID := sql.NullString{}
+				entraExternalIDClientSecret := new(crypto.CryptoValue)
+				entraExternalIDScopes := database.TextArray[string]{}
+
 				err := rows.Scan(
 					&idpTemplate.ID,
 					&idpTemplate.ResourceOwner,
@@ -1639,6 +2044,8 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 					&idpTemplate.IsAutoCreation,
 					&idpTemplate.IsAutoUpdate,
 					&idpTemplate.AutoLinking,
+					&groupMapping,
+					&idpTemplate.IsFederatedLogoutEnabled,
 					// oauth
 					&oauthID,
 					&oauthClientID,
@@ -1649,6 +2056,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 					&oauthScopes,
 					&oauthIDAttribute,
 					&oauthUserPKCE,
+					&oauthClaimMapping,
 					// oidc
 					&oidcID,
 					&oidcIssuer,
@@ -1657,6 +2065,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 					&oidcScopes,
 					&oidcIDTokenMapping,
 					&oidcUserPKCE,
+					&oidcClaimMapping,
 					// jwt
 					&jwtID,
 					&jwtIssuer,
@@ -1741,6 +2150,33 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 					&appleKeyID,
 					&applePrivateKey,
 					&appleScopes,
+					// okta
+					&oktaID,
+					&oktaDomain,
+					&oktaAuthorizationServerID,
+					&oktaClientID,
+					&oktaClientSecret,
+					&oktaScopes,
+					// auth0
+					&auth0ID,
+					&auth0Domain,
+					&auth0ClientID,
+					&auth0ClientSecret,
+					&auth0Scopes,
+					// keycloak
+					&keycloakID,
+					&keycloakBaseURL,
+					&keycloakRealm,
+					&keycloakClientID,
+					&keycloakClientSecret,
+					&keycloakScopes,
+					// entra external id
+					&entraExternalIDID,
+					&entraExternalIDTenantID,
+					&entraExternalIDDomain,
+					&entraExternalIDClientID,
+					&entraExternalIDClientSecret,
+					&entraExternalIDScopes,
 					&count,
 				)
 
@@ -1749,6 +2185,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 				}
 
 				idpTemplate.Name = name.String
+				idpTemplate.GroupMapping = groupMappingFromJSON(groupMapping)
 
 				if oauthID.Valid {
 					idpTemplate.OAuthIDPTemplate = &OAuthIDPTemplate{
@@ -1761,6 +2198,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 						Scopes:                oauthScopes,
 						IDAttribute:           oauthIDAttribute.String,
 						UsePKCE:               oauthUserPKCE.Bool,
+						ClaimMapping:          claimMappingFromJSON(oauthClaimMapping),
 					}
 				}
 				if oidcID.Valid {
@@ -1772,6 +2210,7 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 						Scopes:           oidcScopes,
 						IsIDTokenMapping: oidcIDTokenMapping.Bool,
 						UsePKCE:          oidcUserPKCE.Bool,
+						ClaimMapping:     claimMappingFromJSON(oidcClaimMapping),
 					}
 				}
 				if jwtID.Valid {
@@ -1890,6 +2329,45 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 						Scopes:     appleScopes,
 					}
 				}
+				if oktaID.Valid {
+					idpTemplate.OktaIDPTemplate = &OktaIDPTemplate{
+						IDPID:                 oktaID.String,
+						Domain:                oktaDomain.String,
+						AuthorizationServerID: oktaAuthorizationServerID.String,
+						ClientID:              oktaClientID.String,
+						ClientSecret:          oktaClientSecret,
+						Scopes:                oktaScopes,
+					}
+				}
+				if auth0ID.Valid {
+					idpTemplate.Auth0IDPTemplate = &Auth0IDPTemplate{
+						IDPID:        auth0ID.String,
+						Domain:       auth0Domain.String,
+						ClientID:     auth0ClientID.String,
+						ClientSecret: auth0ClientSecret,
+						Scopes:       auth0Scopes,
+					}
+				}
+				if keycloakID.Valid {
+					idpTemplate.KeycloakIDPTemplate = &KeycloakIDPTemplate{
+						IDPID:        keycloakID.String,
+						BaseURL:      keycloakBaseURL.String,
+						Realm:        keycloakRealm.String,
+						ClientID:     keycloakClientID.String,
+						ClientSecret: keycloakClientSecret,
+						Scopes:       keycloakScopes,
+					}
+				}
+				if entraExternalIDID.Valid {
+					idpTemplate.EntraExternalIDIDPTemplate = &EntraExternalIDIDPTemplate{
+						IDPID:        entraExternalIDID.String,
+						TenantID:     entraExternalIDTenantID.String,
+						Domain:       entraExternalIDDomain.String,
+						ClientID:     entraExternalIDClientID.String,
+						ClientSecret: entraExternalIDClientSecret,
+						Scopes:       entraExternalIDScopes,
+					}
+				}
 				templates = append(templates, idpTemplate)
 			}
 
@@ -1905,3 +2383,25 @@ func prepareIDPTemplatesQuery() (sq.SelectBuilder, func(*sql.Rows) (*IDPTemplate
 			}, nil
 		}
 }
+
+// claimMappingFromJSON returns the claim mapping stored as JSONB or nil, if none is set.
+func claimMappingFromJSON(data []byte) *domain.IDPClaimMapping {
+	if len(data) == 0 {
+		return nil
+	}
+	var claimMapping *domain.IDPClaimMapping
+	err := json.Unmarshal(data, &claimMapping)
+	logging.OnError(err).Warn("unable to unmarshal idp claim mapping")
+	return claimMapping
+}
+
+// groupMappingFromJSON returns the group mapping stored as JSONB or nil, if none is set.
+func groupMappingFromJSON(data []byte) *domain.IDPGroupMapping {
+	if len(data) == 0 {
+		return nil
+	}
+	var groupMapping *domain.IDPGroupMapping
+	err := json.Unmarshal(data, &groupMapping)
+	logging.OnError(err).Warn("unable to unmarshal idp group mapping")
+	return groupMapping
+}
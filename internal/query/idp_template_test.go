@@ -30,6 +30,8 @@ var (
 		` projections.idp_templates6.is_auto_creation,` +
 		` projections.idp_templates6.is_auto_update,` +
 		` projections.idp_templates6.auto_linking,` +
+		` projections.idp_templates6.group_mapping,` +
+		` projections.idp_templates6.is_federated_logout_enabled,` +
 		// oauth
 		` projections.idp_templates6_oauth2.idp_id,` +
 		` projections.idp_templates6_oauth2.client_id,` +
@@ -40,6 +42,7 @@ var (
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
 		` projections.idp_templates6_oauth2.use_pkce,` +
+		` projections.idp_templates6_oauth2.claim_mapping,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -48,6 +51,7 @@ var (
 		` projections.idp_templates6_oidc.scopes,` +
 		` projections.idp_templates6_oidc.id_token_mapping,` +
 		` projections.idp_templates6_oidc.use_pkce,` +
+		` projections.idp_templates6_oidc.claim_mapping,` +
 		// jwt
 		` projections.idp_templates6_jwt.idp_id,` +
 		` projections.idp_templates6_jwt.issuer,` +
@@ -131,7 +135,34 @@ var (
 		` projections.idp_templates6_apple.team_id,` +
 		` projections.idp_templates6_apple.key_id,` +
 		` projections.idp_templates6_apple.private_key,` +
-		` projections.idp_templates6_apple.scopes` +
+		` projections.idp_templates6_apple.scopes,` +
+		// okta
+		` projections.idp_templates6_okta.idp_id,` +
+		` projections.idp_templates6_okta.domain,` +
+		` projections.idp_templates6_okta.authorization_server_id,` +
+		` projections.idp_templates6_okta.client_id,` +
+		` projections.idp_templates6_okta.client_secret,` +
+		` projections.idp_templates6_okta.scopes,` +
+		// auth0
+		` projections.idp_templates6_auth0.idp_id,` +
+		` projections.idp_templates6_auth0.domain,` +
+		` projections.idp_templates6_auth0.client_id,` +
+		` projections.idp_templates6_auth0.client_secret,` +
+		` projections.idp_templates6_auth0.scopes,` +
+		// keycloak
+		` projections.idp_templates6_keycloak.idp_id,` +
+		` projections.idp_templates6_keycloak.base_url,` +
+		` projections.idp_templates6_keycloak.realm,` +
+		` projections.idp_templates6_keycloak.client_id,` +
+		` projections.idp_templates6_keycloak.client_secret,` +
+		` projections.idp_templates6_keycloak.scopes,` +
+		// entra external id
+		` projections.idp_templates6_entra_external_id.idp_id,` +
+		` projections.idp_templates6_entra_external_id.tenant_id,` +
+		` projections.idp_templates6_entra_external_id.domain,` +
+		` projections.idp_templates6_entra_external_id.client_id,` +
+		` projections.idp_templates6_entra_external_id.client_secret,` +
+		` projections.idp_templates6_entra_external_id.scopes` +
 		` FROM projections.idp_templates6` +
 		` LEFT JOIN projections.idp_templates6_oauth2 ON projections.idp_templates6.id = projections.idp_templates6_oauth2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_oauth2.instance_id` +
 		` LEFT JOIN projections.idp_templates6_oidc ON projections.idp_templates6.id = projections.idp_templates6_oidc.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_oidc.instance_id` +
@@ -144,7 +175,11 @@ var (
 		` LEFT JOIN projections.idp_templates6_google ON projections.idp_templates6.id = projections.idp_templates6_google.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_google.instance_id` +
 		` LEFT JOIN projections.idp_templates6_saml ON projections.idp_templates6.id = projections.idp_templates6_saml.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_saml.instance_id` +
 		` LEFT JOIN projections.idp_templates6_ldap2 ON projections.idp_templates6.id = projections.idp_templates6_ldap2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_ldap2.instance_id` +
-		` LEFT JOIN projections.idp_templates6_apple ON projections.idp_templates6.id = projections.idp_templates6_apple.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_apple.instance_id`
+		` LEFT JOIN projections.idp_templates6_apple ON projections.idp_templates6.id = projections.idp_templates6_apple.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_apple.instance_id` +
+		` LEFT JOIN projections.idp_templates6_okta ON projections.idp_templates6.id = projections.idp_templates6_okta.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_okta.instance_id` +
+		` LEFT JOIN projections.idp_templates6_auth0 ON projections.idp_templates6.id = projections.idp_templates6_auth0.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_auth0.instance_id` +
+		` LEFT JOIN projections.idp_templates6_keycloak ON projections.idp_templates6.id = projections.idp_templates6_keycloak.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_keycloak.instance_id` +
+		` LEFT JOIN projections.idp_templates6_entra_external_id ON projections.idp_templates6.id = projections.idp_templates6_entra_external_id.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_entra_external_id.instance_id`
 	idpTemplateCols = []string{
 		"id",
 		"resource_owner",
@@ -160,6 +195,8 @@ var (
 		"is_auto_creation",
 		"is_auto_update",
 		"auto_linking",
+		"group_mapping",
+		"is_federated_logout_enabled",
 		// oauth config
 		"idp_id",
 		"client_id",
@@ -170,6 +207,7 @@ var (
 		"scopes",
 		"id_attribute",
 		"use_pkce",
+		"claim_mapping",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -178,6 +216,7 @@ var (
 		"scopes",
 		"id_token_mapping",
 		"use_pkce",
+		"claim_mapping",
 		// jwt
 		"idp_id",
 		"issuer",
@@ -262,6 +301,33 @@ var (
 		"key_id",
 		"private_key",
 		"scopes",
+		// okta config
+		"idp_id",
+		"domain",
+		"authorization_server_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// auth0 config
+		"idp_id",
+		"domain",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// keycloak config
+		"idp_id",
+		"base_url",
+		"realm",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// entra external id config
+		"idp_id",
+		"tenant_id",
+		"domain",
+		"client_id",
+		"client_secret",
+		"scopes",
 	}
 	idpTemplatesQuery = `SELECT projections.idp_templates6.id,` +
 		` projections.idp_templates6.resource_owner,` +
@@ -277,6 +343,8 @@ var (
 		` projections.idp_templates6.is_auto_creation,` +
 		` projections.idp_templates6.is_auto_update,` +
 		` projections.idp_templates6.auto_linking,` +
+		` projections.idp_templates6.group_mapping,` +
+		` projections.idp_templates6.is_federated_logout_enabled,` +
 		// oauth
 		` projections.idp_templates6_oauth2.idp_id,` +
 		` projections.idp_templates6_oauth2.client_id,` +
@@ -287,6 +355,7 @@ var (
 		` projections.idp_templates6_oauth2.scopes,` +
 		` projections.idp_templates6_oauth2.id_attribute,` +
 		` projections.idp_templates6_oauth2.use_pkce,` +
+		` projections.idp_templates6_oauth2.claim_mapping,` +
 		// oidc
 		` projections.idp_templates6_oidc.idp_id,` +
 		` projections.idp_templates6_oidc.issuer,` +
@@ -295,6 +364,7 @@ var (
 		` projections.idp_templates6_oidc.scopes,` +
 		` projections.idp_templates6_oidc.id_token_mapping,` +
 		` projections.idp_templates6_oidc.use_pkce,` +
+		` projections.idp_templates6_oidc.claim_mapping,` +
 		// jwt
 		` projections.idp_templates6_jwt.idp_id,` +
 		` projections.idp_templates6_jwt.issuer,` +
@@ -379,6 +449,33 @@ var (
 		` projections.idp_templates6_apple.key_id,` +
 		` projections.idp_templates6_apple.private_key,` +
 		` projections.idp_templates6_apple.scopes,` +
+		// okta
+		` projections.idp_templates6_okta.idp_id,` +
+		` projections.idp_templates6_okta.domain,` +
+		` projections.idp_templates6_okta.authorization_server_id,` +
+		` projections.idp_templates6_okta.client_id,` +
+		` projections.idp_templates6_okta.client_secret,` +
+		` projections.idp_templates6_okta.scopes,` +
+		// auth0
+		` projections.idp_templates6_auth0.idp_id,` +
+		` projections.idp_templates6_auth0.domain,` +
+		` projections.idp_templates6_auth0.client_id,` +
+		` projections.idp_templates6_auth0.client_secret,` +
+		` projections.idp_templates6_auth0.scopes,` +
+		// keycloak
+		` projections.idp_templates6_keycloak.idp_id,` +
+		` projections.idp_templates6_keycloak.base_url,` +
+		` projections.idp_templates6_keycloak.realm,` +
+		` projections.idp_templates6_keycloak.client_id,` +
+		` projections.idp_templates6_keycloak.client_secret,` +
+		` projections.idp_templates6_keycloak.scopes,` +
+		// entra external id
+		` projections.idp_templates6_entra_external_id.idp_id,` +
+		` projections.idp_templates6_entra_external_id.tenant_id,` +
+		` projections.idp_templates6_entra_external_id.domain,` +
+		` projections.idp_templates6_entra_external_id.client_id,` +
+		` projections.idp_templates6_entra_external_id.client_secret,` +
+		` projections.idp_templates6_entra_external_id.scopes,` +
 		` COUNT(*) OVER ()` +
 		` FROM projections.idp_templates6` +
 		` LEFT JOIN projections.idp_templates6_oauth2 ON projections.idp_templates6.id = projections.idp_templates6_oauth2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_oauth2.instance_id` +
@@ -392,7 +489,11 @@ var (
 		` LEFT JOIN projections.idp_templates6_google ON projections.idp_templates6.id = projections.idp_templates6_google.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_google.instance_id` +
 		` LEFT JOIN projections.idp_templates6_saml ON projections.idp_templates6.id = projections.idp_templates6_saml.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_saml.instance_id` +
 		` LEFT JOIN projections.idp_templates6_ldap2 ON projections.idp_templates6.id = projections.idp_templates6_ldap2.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_ldap2.instance_id` +
-		` LEFT JOIN projections.idp_templates6_apple ON projections.idp_templates6.id = projections.idp_templates6_apple.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_apple.instance_id`
+		` LEFT JOIN projections.idp_templates6_apple ON projections.idp_templates6.id = projections.idp_templates6_apple.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_apple.instance_id` +
+		` LEFT JOIN projections.idp_templates6_okta ON projections.idp_templates6.id = projections.idp_templates6_okta.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_okta.instance_id` +
+		` LEFT JOIN projections.idp_templates6_auth0 ON projections.idp_templates6.id = projections.idp_templates6_auth0.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_auth0.instance_id` +
+		` LEFT JOIN projections.idp_templates6_keycloak ON projections.idp_templates6.id = projections.idp_templates6_keycloak.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_keycloak.instance_id` +
+		` LEFT JOIN projections.idp_templates6_entra_external_id ON projections.idp_templates6.id = projections.idp_templates6_entra_external_id.idp_id AND projections.idp_templates6.instance_id = projections.idp_templates6_entra_external_id.instance_id`
 	idpTemplatesCols = []string{
 		"id",
 		"resource_owner",
@@ -408,6 +509,8 @@ var (
 		"is_auto_creation",
 		"is_auto_update",
 		"auto_linking",
+		"group_mapping",
+		"is_federated_logout_enabled",
 		// oauth config
 		"idp_id",
 		"client_id",
@@ -418,6 +521,7 @@ var (
 		"scopes",
 		"id_attribute",
 		"use_pkce",
+		"claim_mapping",
 		// oidc config
 		"id_id",
 		"issuer",
@@ -426,6 +530,7 @@ var (
 		"scopes",
 		"id_token_mapping",
 		"use_pkce",
+		"claim_mapping",
 		// jwt
 		"idp_id",
 		"issuer",
@@ -510,6 +615,33 @@ var (
 		"key_id",
 		"private_key",
 		"scopes",
+		// okta config
+		"idp_id",
+		"domain",
+		"authorization_server_id",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// auth0 config
+		"idp_id",
+		"domain",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// keycloak config
+		"idp_id",
+		"base_url",
+		"realm",
+		"client_id",
+		"client_secret",
+		"scopes",
+		// entra external id config
+		"idp_id",
+		"tenant_id",
+		"domain",
+		"client_id",
+		"client_secret",
+		"scopes",
 		"count",
 	}
 )
@@ -565,6 +697,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						[]byte(`{"claim":"$.groups","mappings":[{"group":"admins","projectId":"project","roleKeys":["admin"]}]}`),
+						true,
 						// oauth
 						"idp-id",
 						"client_id",
@@ -575,6 +709,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						database.TextArray[string]{"profile"},
 						"id-attribute",
 						true,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -583,6 +718,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -667,6 +803,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -685,6 +848,13 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				IsAutoCreation:    true,
 				IsAutoUpdate:      true,
 				AutoLinking:       domain.AutoLinkingOptionUsername,
+				GroupMapping: &domain.IDPGroupMapping{
+					Claim: "$.groups",
+					Mappings: []*domain.IDPGroupRoleMapping{
+						{Group: "admins", ProjectID: "project", RoleKeys: []string{"admin"}},
+					},
+				},
+				IsFederatedLogoutEnabled: true,
 				OAuthIDPTemplate: &OAuthIDPTemplate{
 					IDPID:                 "idp-id",
 					ClientID:              "client_id",
@@ -720,6 +890,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -730,6 +902,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						"idp-id",
 						"issuer",
@@ -738,6 +911,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						database.TextArray[string]{"profile"},
 						true,
 						true,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -822,6 +996,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -873,6 +1074,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -883,6 +1086,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -891,6 +1095,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						"idp-id",
 						"issuer",
@@ -975,6 +1180,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1024,6 +1256,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1034,6 +1268,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1042,6 +1277,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1126,6 +1362,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1174,6 +1437,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1184,6 +1449,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1192,6 +1458,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1276,6 +1543,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1324,6 +1618,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1334,6 +1630,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1342,6 +1639,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1426,6 +1724,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1475,6 +1800,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1485,6 +1812,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1493,6 +1821,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1577,6 +1906,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1625,6 +1981,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1635,6 +1993,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1643,6 +2002,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1727,6 +2087,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -1780,6 +2167,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1790,6 +2179,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1798,6 +2188,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -1882,8 +2273,35 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
-					},
-				),
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
 			},
 			object: &IDPTemplate{
 				CreationDate:      testNow,
@@ -1951,6 +2369,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -1961,6 +2381,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -1969,6 +2390,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -2053,6 +2475,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						"key_id",
 						nil,
 						database.TextArray[string]{"profile"},
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -2081,6 +2530,189 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prepareIDPTemplateByIDQuery okta idp",
+			prepare: prepareIDPTemplateByIDQuery,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(idpTemplateQuery),
+					idpTemplateCols,
+					[]driver.Value{
+						"idp-id",
+						"ro",
+						testNow,
+						testNow,
+						uint64(20211109),
+						domain.IDPConfigStateActive,
+						"idp-name",
+						domain.IDPTypeOkta,
+						domain.IdentityProviderTypeOrg,
+						true,
+						true,
+						true,
+						true,
+						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
+						// oauth
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// oidc
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// jwt
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// azure
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// github
+						nil,
+						nil,
+						nil,
+						nil,
+						// github enterprise
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab
+						nil,
+						nil,
+						nil,
+						nil,
+						// gitlab self hosted
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// google
+						nil,
+						nil,
+						nil,
+						nil,
+						// saml
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// ldap config
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// apple
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// okta
+						"idp-id",
+						"domain",
+						"authorization_server_id",
+						"client_id",
+						nil,
+						database.TextArray[string]{"openid"},
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+					},
+				),
+			},
+			object: &IDPTemplate{
+				CreationDate:      testNow,
+				ChangeDate:        testNow,
+				Sequence:          20211109,
+				ResourceOwner:     "ro",
+				ID:                "idp-id",
+				State:             domain.IDPStateActive,
+				Name:              "idp-name",
+				Type:              domain.IDPTypeOkta,
+				OwnerType:         domain.IdentityProviderTypeOrg,
+				IsCreationAllowed: true,
+				IsLinkingAllowed:  true,
+				IsAutoCreation:    true,
+				IsAutoUpdate:      true,
+				AutoLinking:       domain.AutoLinkingOptionUsername,
+				OktaIDPTemplate: &OktaIDPTemplate{
+					IDPID:                 "idp-id",
+					Domain:                "domain",
+					AuthorizationServerID: "authorization_server_id",
+					ClientID:              "client_id",
+					ClientSecret:          nil,
+					Scopes:                []string{"openid"},
+				},
+			},
+		},
 		{
 			name:    "prepareIDPTemplateByIDQuery no config",
 			prepare: prepareIDPTemplateByIDQuery,
@@ -2103,6 +2735,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						true,
 						true,
 						domain.AutoLinkingOptionUsername,
+						nil,
+						false,
 						// oauth
 						nil,
 						nil,
@@ -2113,6 +2747,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// oidc
 						nil,
 						nil,
@@ -2121,6 +2756,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						nil,
 						// jwt
 						nil,
 						nil,
@@ -2205,6 +2841,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 						nil,
 						nil,
 						nil,
+						// okta
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// auth0
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// keycloak
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						// entra external id
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
+						nil,
 					},
 				),
 			},
@@ -2283,6 +2946,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -2293,6 +2958,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2301,6 +2967,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2385,6 +3052,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -2463,6 +3157,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -2473,6 +3169,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2481,6 +3178,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2565,6 +3263,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -2616,6 +3341,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -2626,6 +3353,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2634,6 +3362,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2718,6 +3447,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-saml",
@@ -2734,6 +3490,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -2744,6 +3502,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2752,6 +3511,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2836,6 +3596,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-google",
@@ -2852,6 +3639,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -2862,6 +3651,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2870,6 +3660,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -2954,6 +3745,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-oauth",
@@ -2970,6 +3788,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							"idp-id-oauth",
 							"client_id",
@@ -2980,6 +3800,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							database.TextArray[string]{"profile"},
 							"id-attribute",
 							true,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -2988,6 +3809,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -3072,6 +3894,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-oidc",
@@ -3088,6 +3937,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -3098,6 +3949,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							"idp-id-oidc",
 							"issuer",
@@ -3106,6 +3958,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							database.TextArray[string]{"profile"},
 							true,
 							true,
+							nil,
 							// jwt
 							nil,
 							nil,
@@ -3190,6 +4043,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 						{
 							"idp-id-jwt",
@@ -3206,6 +4086,8 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							true,
 							true,
 							domain.AutoLinkingOptionUsername,
+							nil,
+							false,
 							// oauth
 							nil,
 							nil,
@@ -3216,6 +4098,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// oidc
 							nil,
 							nil,
@@ -3224,6 +4107,7 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							nil,
 							// jwt
 							"idp-id-jwt",
 							"issuer",
@@ -3308,6 +4192,33 @@ func Test_IDPTemplateTemplatesPrepares(t *testing.T) {
 							nil,
 							nil,
 							nil,
+							// okta
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// auth0
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// keycloak
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							// entra external id
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
+							nil,
 						},
 					},
 				),
@@ -8,6 +8,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
 	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
@@ -33,14 +34,33 @@ const (
 )
 
 type IntrospectionClient struct {
-	AppID                string
-	ClientID             string
-	HashedSecret         string
-	AppType              AppType
-	ProjectID            string
-	ResourceOwner        string
-	ProjectRoleAssertion bool
-	PublicKeys           database.Map[[]byte]
+	AppID                     string
+	ClientID                  string
+	HashedSecret              string
+	AppType                   AppType
+	AuthMethodType            int16
+	TLSClientAuthSubjectDN    string
+	TLSClientAuthPublicKeyPin string
+	ProjectID                 string
+	ResourceOwner             string
+	ProjectRoleAssertion      bool
+	PublicKeys                database.Map[[]byte]
+}
+
+// TLSClientAuth returns if the client authenticates with a client certificate (RFC 8705)
+// and if the certificate is self-signed.
+// The AuthMethodType is interpreted as [domain.APIAuthMethodType] or [domain.OIDCAuthMethodType] depending on the AppType.
+func (c *IntrospectionClient) TLSClientAuth() (tlsClientAuth, selfSigned bool) {
+	switch c.AppType {
+	case AppTypeAPI:
+		method := domain.APIAuthMethodType(c.AuthMethodType)
+		return method.IsTLSClientAuth(), method == domain.APIAuthMethodTypeSelfSignedTLSClientAuth
+	case AppTypeOIDC:
+		method := domain.OIDCAuthMethodType(c.AuthMethodType)
+		return method.IsTLSClientAuth(), method == domain.OIDCAuthMethodTypeSelfSignedTLSClientAuth
+	default:
+		return false, false
+	}
 }
 
 //go:embed introspection_client_by_id.sql
@@ -61,6 +81,9 @@ func (q *Queries) ActiveIntrospectionClientByID(ctx context.Context, clientID st
 			&client.ClientID,
 			&client.HashedSecret,
 			&client.AppType,
+			&client.AuthMethodType,
+			&client.TLSClientAuthSubjectDN,
+			&client.TLSClientAuthPublicKeyPin,
 			&client.ProjectID,
 			&client.ResourceOwner,
 			&client.ProjectRoleAssertion,
@@ -1,10 +1,10 @@
 with config as (
-		select instance_id, app_id, client_id, client_secret, 'api' as app_type
+		select instance_id, app_id, client_id, client_secret, 'api' as app_type, auth_method as auth_method_type, tls_client_auth_subject_dn, tls_client_auth_public_key_pin
 		from projections.apps7_api_configs
 		where instance_id = $1
 			and client_id = $2
 	union
-		select instance_id, app_id, client_id, client_secret, 'oidc' as app_type
+		select instance_id, app_id, client_id, client_secret, 'oidc' as app_type, auth_method_type, tls_client_auth_subject_dn, tls_client_auth_public_key_pin
 		from projections.apps7_oidc_configs
 		where instance_id = $1
 			and client_id = $2
@@ -18,7 +18,7 @@ keys as (
 		and expiration > current_timestamp
 	group by identifier
 )
-select config.app_id, config.client_id, config.client_secret, config.app_type, apps.project_id, apps.resource_owner, p.project_role_assertion, keys.public_keys
+select config.app_id, config.client_id, config.client_secret, config.app_type, config.auth_method_type, config.tls_client_auth_subject_dn, config.tls_client_auth_public_key_pin, apps.project_id, apps.resource_owner, p.project_role_assertion, keys.public_keys
 from config
 join projections.apps7 apps on apps.id = config.app_id and apps.instance_id = config.instance_id and apps.state = 1
 join projections.projects4 p on p.id = apps.project_id and p.instance_id = $1 and p.state = 1
@@ -12,6 +12,7 @@ import (
 
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
 )
 
 func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
@@ -50,14 +51,15 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				getKeys:  false,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "secret", "oidc", "projectID", "orgID", true, nil},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "auth_method_type", "tls_client_auth_subject_dn", "tls_client_auth_public_key_pin", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
+				[]driver.Value{"appID", "clientID", "secret", "oidc", 1, "", "", "projectID", "orgID", true, nil},
 				"instanceID", "clientID", false),
 			want: &IntrospectionClient{
 				AppID:                "appID",
 				ClientID:             "clientID",
 				HashedSecret:         "secret",
 				AppType:              AppTypeOIDC,
+				AuthMethodType:       int16(domain.OIDCAuthMethodTypePost),
 				ProjectID:            "projectID",
 				ResourceOwner:        "orgID",
 				ProjectRoleAssertion: true,
@@ -71,20 +73,44 @@ func TestQueries_ActiveIntrospectionClientByID(t *testing.T) {
 				getKeys:  true,
 			},
 			mock: mockQuery(expQuery,
-				[]string{"app_id", "client_id", "client_secret", "app_type", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
-				[]driver.Value{"appID", "clientID", "", "oidc", "projectID", "orgID", true, encPubkeys},
+				[]string{"app_id", "client_id", "client_secret", "app_type", "auth_method_type", "tls_client_auth_subject_dn", "tls_client_auth_public_key_pin", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
+				[]driver.Value{"appID", "clientID", "", "oidc", 3, "", "", "projectID", "orgID", true, encPubkeys},
 				"instanceID", "clientID", true),
 			want: &IntrospectionClient{
 				AppID:                "appID",
 				ClientID:             "clientID",
 				HashedSecret:         "",
 				AppType:              AppTypeOIDC,
+				AuthMethodType:       int16(domain.OIDCAuthMethodTypePrivateKeyJWT),
 				ProjectID:            "projectID",
 				ResourceOwner:        "orgID",
 				ProjectRoleAssertion: true,
 				PublicKeys:           pubkeys,
 			},
 		},
+		{
+			name: "success, self signed tls client auth",
+			args: args{
+				clientID: "clientID",
+				getKeys:  false,
+			},
+			mock: mockQuery(expQuery,
+				[]string{"app_id", "client_id", "client_secret", "app_type", "auth_method_type", "tls_client_auth_subject_dn", "tls_client_auth_public_key_pin", "project_id", "resource_owner", "project_role_assertion", "public_keys"},
+				[]driver.Value{"appID", "clientID", "", "api", 3, "", "pin", "projectID", "orgID", true, nil},
+				"instanceID", "clientID", false),
+			want: &IntrospectionClient{
+				AppID:                     "appID",
+				ClientID:                  "clientID",
+				HashedSecret:              "",
+				AppType:                   AppTypeAPI,
+				AuthMethodType:            int16(domain.APIAuthMethodTypeSelfSignedTLSClientAuth),
+				TLSClientAuthPublicKeyPin: "pin",
+				ProjectID:                 "projectID",
+				ResourceOwner:             "orgID",
+				ProjectRoleAssertion:      true,
+				PublicKeys:                nil,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
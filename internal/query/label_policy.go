@@ -46,6 +46,19 @@ func (q *Queries) ActiveLabelPolicyByOrg(ctx context.Context, orgID string, with
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	// policies of removed organizations are not cached
+	if !withOwnerRemoved {
+		instanceID := authz.GetInstance(ctx).InstanceID()
+		if cached, ok := q.caches.labelPolicy.Get(ctx, orgPolicyIndexByOrgID, instanceCacheKey(instanceID, orgID)); ok {
+			return cached.Policy, nil
+		}
+		defer func() {
+			if err == nil && policy != nil {
+				q.caches.labelPolicy.Set(ctx, &orgPolicy[*LabelPolicy]{InstanceID: instanceID, OrgID: orgID, Policy: policy})
+			}
+		}()
+	}
+
 	stmt, scan := prepareLabelPolicyQuery()
 	eq := sq.Eq{
 		LabelPolicyColState.identifier():      domain.LabelPolicyStateActive,
@@ -366,3 +379,7 @@ func (p *LabelPolicy) ToDomain() *domain.LabelPolicy {
 		ThemeMode:           p.ThemeMode,
 	}
 }
+
+func (c *Caches) registerLabelPolicyInvalidation() {
+	projection.LabelPolicyProjection.RegisterCacheInvalidation(orgPolicyCacheInvalidationFunc(c.labelPolicy))
+}
@@ -171,6 +171,22 @@ func (q *Queries) LoginPolicyByID(ctx context.Context, shouldTriggerBulk bool, o
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	// policies of removed organizations are not cached.
+	// A triggered read skips the cache, as the latest state is requested.
+	if !withOwnerRemoved {
+		instanceID := authz.GetInstance(ctx).InstanceID()
+		if !shouldTriggerBulk {
+			if cached, ok := q.caches.loginPolicy.Get(ctx, orgPolicyIndexByOrgID, instanceCacheKey(instanceID, orgID)); ok {
+				return cached.Policy, nil
+			}
+		}
+		defer func() {
+			if err == nil && policy != nil {
+				q.caches.loginPolicy.Set(ctx, &orgPolicy[*LoginPolicy]{InstanceID: instanceID, OrgID: orgID, Policy: policy})
+			}
+		}()
+	}
+
 	if shouldTriggerBulk {
 		_, traceSpan := tracing.NewNamedSpan(ctx, "TriggerLoginPolicyProjection")
 		ctx, err = projection.LoginPolicyProjection.Trigger(ctx, handler.WithAwaitRunning())
@@ -470,3 +486,11 @@ func prepareLoginPolicyMFAsQuery() (sq.SelectBuilder, func(*sql.Row) (*MultiFact
 			return p, nil
 		}
 }
+
+func (c *Caches) registerLoginPolicyInvalidation() {
+	invalidate := orgPolicyCacheInvalidationFunc(c.loginPolicy)
+	projection.LoginPolicyProjection.RegisterCacheInvalidation(invalidate)
+	// the policy contains the links to the identity providers
+	projection.IDPLoginPolicyLinkProjection.RegisterCacheInvalidation(invalidate)
+	projection.IDPTemplateProjection.RegisterCacheInvalidation(invalidate)
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
@@ -14,37 +15,46 @@ import (
 	"github.com/zitadel/zitadel/internal/api/ui/console/path"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
 
 type OIDCClient struct {
-	InstanceID               string                     `json:"instance_id,omitempty"`
-	AppID                    string                     `json:"app_id,omitempty"`
-	State                    domain.AppState            `json:"state,omitempty"`
-	ClientID                 string                     `json:"client_id,omitempty"`
-	BackChannelLogoutURI     string                     `json:"back_channel_logout_uri,omitempty"`
-	HashedSecret             string                     `json:"client_secret,omitempty"`
-	RedirectURIs             []string                   `json:"redirect_uris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"response_types,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grant_types,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"application_type,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"auth_method_type,omitempty"`
-	PostLogoutRedirectURIs   []string                   `json:"post_logout_redirect_uris,omitempty"`
-	IsDevMode                bool                       `json:"is_dev_mode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"access_token_type,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"access_token_role_assertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"id_token_role_assertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"id_token_userinfo_assertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clock_skew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additional_origins,omitempty"`
-	PublicKeys               map[string][]byte          `json:"public_keys,omitempty"`
-	ProjectID                string                     `json:"project_id,omitempty"`
-	ProjectRoleAssertion     bool                       `json:"project_role_assertion,omitempty"`
-	LoginVersion             domain.LoginVersion        `json:"login_version,omitempty"`
-	LoginBaseURI             *URL                       `json:"login_base_uri,omitempty"`
-	ProjectRoleKeys          []string                   `json:"project_role_keys,omitempty"`
-	Settings                 *OIDCSettings              `json:"settings,omitempty"`
+	InstanceID                            string                     `json:"instance_id,omitempty"`
+	AppID                                 string                     `json:"app_id,omitempty"`
+	State                                 domain.AppState            `json:"state,omitempty"`
+	ClientID                              string                     `json:"client_id,omitempty"`
+	BackChannelLogoutURI                  string                     `json:"back_channel_logout_uri,omitempty"`
+	HashedSecret                          string                     `json:"client_secret,omitempty"`
+	RedirectURIs                          []string                   `json:"redirect_uris,omitempty"`
+	ResponseTypes                         []domain.OIDCResponseType  `json:"response_types,omitempty"`
+	GrantTypes                            []domain.OIDCGrantType     `json:"grant_types,omitempty"`
+	ApplicationType                       domain.OIDCApplicationType `json:"application_type,omitempty"`
+	AuthMethodType                        domain.OIDCAuthMethodType  `json:"auth_method_type,omitempty"`
+	PostLogoutRedirectURIs                []string                   `json:"post_logout_redirect_uris,omitempty"`
+	IsDevMode                             bool                       `json:"is_dev_mode,omitempty"`
+	AccessTokenType                       domain.OIDCTokenType       `json:"access_token_type,omitempty"`
+	AccessTokenRoleAssertion              bool                       `json:"access_token_role_assertion,omitempty"`
+	IDTokenRoleAssertion                  bool                       `json:"id_token_role_assertion,omitempty"`
+	IDTokenUserinfoAssertion              bool                       `json:"id_token_userinfo_assertion,omitempty"`
+	ClockSkew                             time.Duration              `json:"clock_skew,omitempty"`
+	AdditionalOrigins                     []string                   `json:"additional_origins,omitempty"`
+	PublicKeys                            map[string][]byte          `json:"public_keys,omitempty"`
+	ProjectID                             string                     `json:"project_id,omitempty"`
+	ProjectRoleAssertion                  bool                       `json:"project_role_assertion,omitempty"`
+	LoginVersion                          domain.LoginVersion        `json:"login_version,omitempty"`
+	LoginBaseURI                          *URL                       `json:"login_base_uri,omitempty"`
+	RequirePushedAuthorizationRequests    bool                       `json:"require_pushed_authorization_requests,omitempty"`
+	RequireDPoP                           bool                       `json:"require_dpop,omitempty"`
+	TLSClientAuthSubjectDN                string                     `json:"tls_client_auth_subject_dn,omitempty"`
+	TLSClientAuthPublicKeyPin             string                     `json:"tls_client_auth_public_key_pin,omitempty"`
+	BackChannelClientNotificationEndpoint string                     `json:"back_channel_client_notification_endpoint,omitempty"`
+	AccessTokenLifetime                   time.Duration              `json:"access_token_lifetime,omitempty"`
+	IDTokenLifetime                       time.Duration              `json:"id_token_lifetime,omitempty"`
+	RefreshTokenLifetime                  time.Duration              `json:"refresh_token_lifetime,omitempty"`
+	ProjectRoleKeys                       []string                   `json:"project_role_keys,omitempty"`
+	Settings                              *OIDCSettings              `json:"settings,omitempty"`
 }
 
 type URL url.URL
@@ -67,21 +77,28 @@ func (c *URL) UnmarshalJSON(src []byte) error {
 	return nil
 }
 
+// MarshalJSON encodes the URL as string, so it can be decoded by [URL.UnmarshalJSON].
+func (c URL) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.URL().String())
+}
+
 //go:embed oidc_client_by_id.sql
 var oidcClientQuery string
 
+//go:embed oidc_clients_by_project.sql
+var oidcProjectClientsQuery string
+
 func (q *Queries) ActiveOIDCClientByID(ctx context.Context, clientID string, getKeys bool) (client *OIDCClient, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
-	client, err = database.QueryJSONObject[OIDCClient](ctx, q.client, oidcClientQuery,
-		authz.GetInstance(ctx).InstanceID(), clientID, getKeys,
-	)
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, zerrors.ThrowNotFound(err, "QUERY-wu6Ee", "Errors.App.NotFound")
+	if q.caches != nil && q.caches.oidcClients != nil {
+		client, err = q.cachedOIDCClientByID(ctx, clientID, getKeys)
+	} else {
+		client, err = q.oidcClientByID(ctx, clientID, getKeys)
 	}
 	if err != nil {
-		return nil, zerrors.ThrowInternal(err, "QUERY-ieR7R", "Errors.Internal")
+		return nil, err
 	}
 	instance := authz.GetInstance(ctx)
 	loginV2 := instance.Features().LoginV2
@@ -93,5 +110,147 @@ func (q *Queries) ActiveOIDCClientByID(ctx context.Context, clientID string, get
 		client.RedirectURIs = append(client.RedirectURIs, http_util.DomainContext(ctx).Origin()+path.RedirectPath)
 		client.PostLogoutRedirectURIs = append(client.PostLogoutRedirectURIs, http_util.DomainContext(ctx).Origin()+path.PostLogoutPath)
 	}
-	return client, err
+	return client, nil
+}
+
+func (q *Queries) oidcClientByID(ctx context.Context, clientID string, getKeys bool) (*OIDCClient, error) {
+	client, err := database.QueryJSONObject[OIDCClient](ctx, q.client, oidcClientQuery,
+		authz.GetInstance(ctx).InstanceID(), clientID, getKeys,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, zerrors.ThrowNotFound(err, "QUERY-wu6Ee", "Errors.App.NotFound")
+	}
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-ieR7R", "Errors.Internal")
+	}
+	return client, nil
+}
+
+// cachedOIDCClientByID returns the client from the clients of its project, which are cached together.
+func (q *Queries) cachedOIDCClientByID(ctx context.Context, clientID string, getKeys bool) (_ *OIDCClient, err error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	project, ok := q.caches.oidcClients.Get(ctx, oidcClientIndexByClientID, instanceCacheKey(instanceID, clientID))
+	if ok {
+		// the state of the organization is not part of the cached object
+		if err = q.checkOrgActive(ctx, project.ResourceOwner); err != nil {
+			return nil, err
+		}
+	} else {
+		project, err = database.QueryJSONObject[oidcProjectClients](ctx, q.client, oidcProjectClientsQuery, instanceID, clientID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, zerrors.ThrowNotFound(err, "QUERY-Boo2d", "Errors.App.NotFound")
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-ohw0U", "Errors.Internal")
+		}
+		q.caches.oidcClients.Set(ctx, project)
+	}
+	client := project.client(clientID, getKeys, time.Now())
+	if client == nil {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ug1cu", "Errors.App.NotFound")
+	}
+	return client, nil
+}
+
+// checkOrgActive returns a not found error if the organization of a cached app is not active (anymore).
+func (q *Queries) checkOrgActive(ctx context.Context, orgID string) error {
+	org, err := q.OrgByID(ctx, false, orgID)
+	if zerrors.IsNotFound(err) {
+		return zerrors.ThrowNotFound(err, "QUERY-ahX8i", "Errors.App.NotFound")
+	}
+	if err != nil {
+		return err
+	}
+	if org.State != domain.OrgStateActive {
+		return zerrors.ThrowNotFound(nil, "QUERY-Xe6ie", "Errors.App.NotFound")
+	}
+	return nil
+}
+
+// oidcProjectClients contains the active OIDC clients of a project.
+// The clients are cached together, so events of the project invalidate all its clients.
+type oidcProjectClients struct {
+	InstanceID      string                 `json:"instance_id,omitempty"`
+	ProjectID       string                 `json:"project_id,omitempty"`
+	ResourceOwner   string                 `json:"resource_owner,omitempty"`
+	Clients         []*OIDCClient          `json:"clients,omitempty"`
+	ProjectRoleKeys []string               `json:"project_role_keys,omitempty"`
+	PublicKeys      []*oidcClientPublicKey `json:"public_keys,omitempty"`
+	Settings        *OIDCSettings          `json:"settings,omitempty"`
+}
+
+type oidcClientPublicKey struct {
+	ClientID   string    `json:"client_id,omitempty"`
+	ID         string    `json:"id,omitempty"`
+	PublicKey  []byte    `json:"public_key,omitempty"`
+	Expiration time.Time `json:"expiration,omitempty"`
+}
+
+type oidcClientIndex int
+
+//go:generate enumer -type oidcClientIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	oidcClientIndexUnspecified oidcClientIndex = iota //
+	oidcClientIndexByProjectID
+	oidcClientIndexByClientID
+)
+
+// Keys implements [cache.Entry]
+func (p *oidcProjectClients) Keys(index oidcClientIndex) []string {
+	switch index {
+	case oidcClientIndexByProjectID:
+		return []string{instanceCacheKey(p.InstanceID, p.ProjectID)}
+	case oidcClientIndexByClientID:
+		keys := make([]string, len(p.Clients))
+		for i, client := range p.Clients {
+			keys[i] = instanceCacheKey(p.InstanceID, client.ClientID)
+		}
+		return keys
+	case oidcClientIndexUnspecified:
+	}
+	return nil
+}
+
+// client returns a copy of the client with the project roles, settings and the public keys valid at `now`.
+// It returns nil if the client is not part of the project.
+func (p *oidcProjectClients) client(clientID string, withKeys bool, now time.Time) *OIDCClient {
+	i := slices.IndexFunc(p.Clients, func(client *OIDCClient) bool {
+		return client.ClientID == clientID
+	})
+	if i < 0 {
+		return nil
+	}
+	client := *p.Clients[i]
+	client.RedirectURIs = slices.Clone(client.RedirectURIs)
+	client.PostLogoutRedirectURIs = slices.Clone(client.PostLogoutRedirectURIs)
+	client.ProjectRoleKeys = p.ProjectRoleKeys
+	client.Settings = p.Settings
+	if !withKeys {
+		return &client
+	}
+	for _, key := range p.PublicKeys {
+		if key.ClientID != clientID || !key.Expiration.After(now) {
+			continue
+		}
+		if client.PublicKeys == nil {
+			client.PublicKeys = make(map[string][]byte)
+		}
+		client.PublicKeys[key.ID] = key.PublicKey
+	}
+	return &client
+}
+
+func (c *Caches) registerOIDCClientInvalidation() {
+	if c.oidcClients == nil {
+		return
+	}
+	invalidate := cacheInvalidationFunc(c.oidcClients, oidcClientIndexByProjectID, getInstanceCacheKey)
+	projection.ProjectProjection.RegisterCacheInvalidation(invalidate)
+	projection.AppProjection.RegisterCacheInvalidation(invalidate)
+	projection.ProjectRoleProjection.RegisterCacheInvalidation(invalidate)
+	// keys of apps are added to the project aggregate
+	projection.AuthNKeyProjection.RegisterCacheInvalidation(invalidate)
+	// OIDC settings are part of all cached clients of the instance.
+	projection.OIDCSettingsProjection.RegisterCacheInvalidation(cacheTruncateFunc(c.oidcClients))
 }
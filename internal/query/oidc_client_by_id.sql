@@ -4,7 +4,9 @@ with client as (
 		c.grant_types, c.application_type, c.auth_method_type, c.post_logout_redirect_uris, c.is_dev_mode,
 		c.access_token_type, c.access_token_role_assertion, c.id_token_role_assertion,
 		c.id_token_userinfo_assertion, c.clock_skew, c.additional_origins, a.project_id, p.project_role_assertion,
-		c.login_version, c.login_base_uri
+		c.login_version, c.login_base_uri, c.require_pushed_authorization_requests, c.require_dpop,
+		c.tls_client_auth_subject_dn, c.tls_client_auth_public_key_pin, c.back_channel_client_notification_endpoint,
+		c.access_token_lifetime, c.id_token_lifetime, c.refresh_token_lifetime
 	from projections.apps7_oidc_configs c
 	join projections.apps7 a on a.id = c.app_id and a.instance_id = c.instance_id and a.state = 1
 	join projections.projects4 p on p.id = a.project_id and p.instance_id = a.instance_id and p.state = 1
@@ -4,9 +4,11 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	_ "embed"
+	"encoding/json"
 	"net/url"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -130,6 +132,13 @@ low2kyJov38V4Uk2I8kuXpLcnrpw5Tio2ooiUE27b0vHZqBKOei9Uo88qCrn3EKx
 					retURL := URL(*ret)
 					return &retURL
 				}(),
+				RequirePushedAuthorizationRequests:    true,
+				RequireDPoP:                           true,
+				TLSClientAuthSubjectDN:                "CN=client,O=ZITADEL",
+				BackChannelClientNotificationEndpoint: "https://ciba.ch/notify",
+				AccessTokenLifetime:                   time.Hour,
+				IDTokenLifetime:                       time.Hour,
+				RefreshTokenLifetime:                  24 * time.Hour,
 			},
 		},
 		{
@@ -279,3 +288,67 @@ low2kyJov38V4Uk2I8kuXpLcnrpw5Tio2ooiUE27b0vHZqBKOei9Uo88qCrn3EKx
 		})
 	}
 }
+
+func Test_oidcProjectClients_Keys(t *testing.T) {
+	project := &oidcProjectClients{
+		InstanceID: "instanceID",
+		ProjectID:  "projectID",
+		Clients: []*OIDCClient{
+			{ClientID: "client1"},
+			{ClientID: "client2"},
+		},
+	}
+	assert.Equal(t, []string{"instanceID-projectID"}, project.Keys(oidcClientIndexByProjectID))
+	assert.Equal(t, []string{"instanceID-client1", "instanceID-client2"}, project.Keys(oidcClientIndexByClientID))
+	assert.Nil(t, project.Keys(oidcClientIndexUnspecified))
+}
+
+func Test_oidcProjectClients_client(t *testing.T) {
+	now := time.Now()
+	settings := &OIDCSettings{AccessTokenLifetime: time.Hour}
+	project := &oidcProjectClients{
+		InstanceID: "instanceID",
+		ProjectID:  "projectID",
+		Clients: []*OIDCClient{
+			{ClientID: "client1", RedirectURIs: []string{"https://example.com/callback"}},
+			{ClientID: "client2"},
+		},
+		ProjectRoleKeys: []string{"role1"},
+		PublicKeys: []*oidcClientPublicKey{
+			{ClientID: "client1", ID: "key1", PublicKey: []byte("key1"), Expiration: now.Add(time.Hour)},
+			{ClientID: "client1", ID: "expired", PublicKey: []byte("expired"), Expiration: now.Add(-time.Hour)},
+			{ClientID: "client2", ID: "key2", PublicKey: []byte("key2"), Expiration: now.Add(time.Hour)},
+		},
+		Settings: settings,
+	}
+
+	assert.Nil(t, project.client("unknown", true, now))
+
+	got := project.client("client1", false, now)
+	require.NotNil(t, got)
+	assert.Equal(t, &OIDCClient{
+		ClientID:        "client1",
+		RedirectURIs:    []string{"https://example.com/callback"},
+		ProjectRoleKeys: []string{"role1"},
+		Settings:        settings,
+	}, got)
+
+	got = project.client("client1", true, now)
+	require.NotNil(t, got)
+	assert.Equal(t, map[string][]byte{"key1": []byte("key1")}, got.PublicKeys)
+
+	// modifications must not change the cached object
+	got.RedirectURIs = append(got.RedirectURIs, "https://example.com/other")
+	got.RedirectURIs[0] = "changed"
+	assert.Equal(t, []string{"https://example.com/callback"}, project.Clients[0].RedirectURIs)
+}
+
+func TestURL_JSON(t *testing.T) {
+	u, err := url.Parse("https://example.com/login?foo=bar")
+	require.NoError(t, err)
+	payload, err := json.Marshal(&OIDCClient{LoginBaseURI: (*URL)(u)})
+	require.NoError(t, err)
+	got := new(OIDCClient)
+	require.NoError(t, json.Unmarshal(payload, got))
+	assert.Equal(t, u.String(), got.LoginBaseURI.URL().String())
+}
@@ -0,0 +1,60 @@
+with project as (
+	select p.instance_id, p.id as project_id, p.resource_owner
+	from projections.apps7_oidc_configs c
+	join projections.apps7 a on a.id = c.app_id and a.instance_id = c.instance_id
+	join projections.projects4 p on p.id = a.project_id and p.instance_id = a.instance_id
+	where c.instance_id = $1
+		and c.client_id = $2
+),
+clients as (
+	select c.instance_id,
+		c.app_id, a.state, c.client_id, c.back_channel_logout_uri, c.client_secret, c.redirect_uris, c.response_types,
+		c.grant_types, c.application_type, c.auth_method_type, c.post_logout_redirect_uris, c.is_dev_mode,
+		c.access_token_type, c.access_token_role_assertion, c.id_token_role_assertion,
+		c.id_token_userinfo_assertion, c.clock_skew, c.additional_origins, a.project_id, p.project_role_assertion,
+		c.login_version, c.login_base_uri, c.require_pushed_authorization_requests, c.require_dpop,
+		c.tls_client_auth_subject_dn, c.tls_client_auth_public_key_pin, c.back_channel_client_notification_endpoint,
+		c.access_token_lifetime, c.id_token_lifetime, c.refresh_token_lifetime
+	from project pr
+	join projections.projects4 p on p.id = pr.project_id and p.instance_id = pr.instance_id and p.state = 1
+	join projections.orgs1 o on o.id = p.resource_owner and o.instance_id = p.instance_id and o.org_state = 1
+	join projections.apps7 a on a.project_id = p.id and a.instance_id = p.instance_id and a.state = 1
+	join projections.apps7_oidc_configs c on c.app_id = a.id and c.instance_id = a.instance_id
+),
+roles as (
+	select json_agg(r.role_key) as project_role_keys
+	from project pr
+	join projections.project_roles4 r on r.project_id = pr.project_id and r.instance_id = pr.instance_id
+),
+keys as (
+	select json_agg(json_build_object(
+		'client_id', k.identifier,
+		'id', k.id,
+		'public_key', encode(k.public_key, 'base64'),
+		'expiration', k.expiration
+	)) as public_keys
+	from clients c
+	join projections.authn_keys2 k on k.identifier = c.client_id and k.instance_id = c.instance_id
+	where k.expiration > current_timestamp
+),
+settings as (
+	select instance_id, json_build_object(
+		'access_token_lifetime', access_token_lifetime,
+		'id_token_lifetime', id_token_lifetime,
+		'refresh_token_idle_expiration', refresh_token_idle_expiration,
+		'refresh_token_expiration', refresh_token_expiration
+	) as settings
+	from projections.oidc_settings2
+	where aggregate_id = $1
+		and instance_id = $1
+)
+
+select row_to_json(r) as project from (
+	select pr.instance_id, pr.project_id, pr.resource_owner,
+		(select json_agg(c) from clients c) as clients,
+		r.project_role_keys, k.public_keys, s.settings
+	from project pr
+	cross join roles r
+	cross join keys k
+	left join settings s on s.instance_id = pr.instance_id
+) r;
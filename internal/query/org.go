@@ -78,8 +78,8 @@ type Org struct {
 	ResourceOwner string
 	State         domain_pkg.OrgState
 	Sequence      uint64
-	// instanceID is used to create a unique cache key for the org
-	instanceID string
+	// InstanceID is used to create a unique cache key for the org
+	InstanceID string
 
 	Name   string
 	Domain string
@@ -162,7 +162,7 @@ func (q *Queries) OrgByID(ctx context.Context, shouldTriggerBulk bool, id string
 		ResourceOwner: foundOrg.Owner,
 		State:         domain_pkg.OrgState(foundOrg.State.State),
 		Sequence:      uint64(foundOrg.Sequence),
-		instanceID:    foundOrg.InstanceID,
+		InstanceID:    foundOrg.InstanceID,
 		Name:          foundOrg.Name,
 		Domain:        foundOrg.PrimaryDomain.Domain,
 	}, nil
@@ -449,7 +449,7 @@ func prepareOrgQuery() (sq.SelectBuilder, func(*sql.Row) (*Org, error)) {
 				&o.ResourceOwner,
 				&o.State,
 				&o.Sequence,
-				&o.instanceID,
+				&o.InstanceID,
 				&o.Name,
 				&o.Domain,
 			)
@@ -527,9 +527,9 @@ const (
 func (o *Org) Keys(index orgIndex) []string {
 	switch index {
 	case orgIndexByID:
-		return []string{orgCacheKey(o.instanceID, o.ID)}
+		return []string{orgCacheKey(o.InstanceID, o.ID)}
 	case orgIndexByPrimaryDomain:
-		return []string{orgCacheKey(o.instanceID, o.Domain)}
+		return []string{orgCacheKey(o.InstanceID, o.Domain)}
 	case orgIndexUnspecified:
 	}
 	return nil
@@ -31,36 +31,46 @@ const (
 	AppColumnState         = "state"
 	AppColumnSequence      = "sequence"
 
-	appAPITableSuffix              = "api_configs"
-	AppAPIConfigColumnAppID        = "app_id"
-	AppAPIConfigColumnInstanceID   = "instance_id"
-	AppAPIConfigColumnClientID     = "client_id"
-	AppAPIConfigColumnClientSecret = "client_secret"
-	AppAPIConfigColumnAuthMethod   = "auth_method"
-
-	appOIDCTableSuffix                          = "oidc_configs"
-	AppOIDCConfigColumnAppID                    = "app_id"
-	AppOIDCConfigColumnInstanceID               = "instance_id"
-	AppOIDCConfigColumnVersion                  = "version"
-	AppOIDCConfigColumnClientID                 = "client_id"
-	AppOIDCConfigColumnClientSecret             = "client_secret"
-	AppOIDCConfigColumnRedirectUris             = "redirect_uris"
-	AppOIDCConfigColumnResponseTypes            = "response_types"
-	AppOIDCConfigColumnGrantTypes               = "grant_types"
-	AppOIDCConfigColumnApplicationType          = "application_type"
-	AppOIDCConfigColumnAuthMethodType           = "auth_method_type"
-	AppOIDCConfigColumnPostLogoutRedirectUris   = "post_logout_redirect_uris"
-	AppOIDCConfigColumnDevMode                  = "is_dev_mode"
-	AppOIDCConfigColumnAccessTokenType          = "access_token_type"
-	AppOIDCConfigColumnAccessTokenRoleAssertion = "access_token_role_assertion"
-	AppOIDCConfigColumnIDTokenRoleAssertion     = "id_token_role_assertion"
-	AppOIDCConfigColumnIDTokenUserinfoAssertion = "id_token_userinfo_assertion"
-	AppOIDCConfigColumnClockSkew                = "clock_skew"
-	AppOIDCConfigColumnAdditionalOrigins        = "additional_origins"
-	AppOIDCConfigColumnSkipNativeAppSuccessPage = "skip_native_app_success_page"
-	AppOIDCConfigColumnBackChannelLogoutURI     = "back_channel_logout_uri"
-	AppOIDCConfigColumnLoginVersion             = "login_version"
-	AppOIDCConfigColumnLoginBaseURI             = "login_base_uri"
+	appAPITableSuffix                           = "api_configs"
+	AppAPIConfigColumnAppID                     = "app_id"
+	AppAPIConfigColumnInstanceID                = "instance_id"
+	AppAPIConfigColumnClientID                  = "client_id"
+	AppAPIConfigColumnClientSecret              = "client_secret"
+	AppAPIConfigColumnAuthMethod                = "auth_method"
+	AppAPIConfigColumnTLSClientAuthSubjectDN    = "tls_client_auth_subject_dn"
+	AppAPIConfigColumnTLSClientAuthPublicKeyPin = "tls_client_auth_public_key_pin"
+
+	appOIDCTableSuffix                                       = "oidc_configs"
+	AppOIDCConfigColumnAppID                                 = "app_id"
+	AppOIDCConfigColumnInstanceID                            = "instance_id"
+	AppOIDCConfigColumnVersion                               = "version"
+	AppOIDCConfigColumnClientID                              = "client_id"
+	AppOIDCConfigColumnClientSecret                          = "client_secret"
+	AppOIDCConfigColumnRedirectUris                          = "redirect_uris"
+	AppOIDCConfigColumnResponseTypes                         = "response_types"
+	AppOIDCConfigColumnGrantTypes                            = "grant_types"
+	AppOIDCConfigColumnApplicationType                       = "application_type"
+	AppOIDCConfigColumnAuthMethodType                        = "auth_method_type"
+	AppOIDCConfigColumnPostLogoutRedirectUris                = "post_logout_redirect_uris"
+	AppOIDCConfigColumnDevMode                               = "is_dev_mode"
+	AppOIDCConfigColumnAccessTokenType                       = "access_token_type"
+	AppOIDCConfigColumnAccessTokenRoleAssertion              = "access_token_role_assertion"
+	AppOIDCConfigColumnIDTokenRoleAssertion                  = "id_token_role_assertion"
+	AppOIDCConfigColumnIDTokenUserinfoAssertion              = "id_token_userinfo_assertion"
+	AppOIDCConfigColumnClockSkew                             = "clock_skew"
+	AppOIDCConfigColumnAdditionalOrigins                     = "additional_origins"
+	AppOIDCConfigColumnSkipNativeAppSuccessPage              = "skip_native_app_success_page"
+	AppOIDCConfigColumnBackChannelLogoutURI                  = "back_channel_logout_uri"
+	AppOIDCConfigColumnLoginVersion                          = "login_version"
+	AppOIDCConfigColumnLoginBaseURI                          = "login_base_uri"
+	AppOIDCConfigColumnRequirePushedAuthorizationRequests    = "require_pushed_authorization_requests"
+	AppOIDCConfigColumnRequireDPoP                           = "require_dpop"
+	AppOIDCConfigColumnTLSClientAuthSubjectDN                = "tls_client_auth_subject_dn"
+	AppOIDCConfigColumnTLSClientAuthPublicKeyPin             = "tls_client_auth_public_key_pin"
+	AppOIDCConfigColumnBackChannelClientNotificationEndpoint = "back_channel_client_notification_endpoint"
+	AppOIDCConfigColumnAccessTokenLifetime                   = "access_token_lifetime"
+	AppOIDCConfigColumnIDTokenLifetime                       = "id_token_lifetime"
+	AppOIDCConfigColumnRefreshTokenLifetime                  = "refresh_token_lifetime"
 
 	appSAMLTableSuffix              = "saml_configs"
 	AppSAMLConfigColumnAppID        = "app_id"
@@ -70,6 +80,12 @@ const (
 	AppSAMLConfigColumnMetadataURL  = "metadata_url"
 	AppSAMLConfigColumnLoginVersion = "login_version"
 	AppSAMLConfigColumnLoginBaseURI = "login_base_uri"
+
+	AppSAMLConfigColumnSignatureAlgorithm  = "signature_algorithm"
+	AppSAMLConfigColumnDigestAlgorithm     = "digest_algorithm"
+	AppSAMLConfigColumnAssertionEncryption = "assertion_encryption"
+	AppSAMLConfigColumnNameIDFormat        = "name_id_format"
+	AppSAMLConfigColumnAttributeMapping    = "attribute_mapping"
 )
 
 type appProjection struct{}
@@ -104,6 +120,8 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppAPIConfigColumnClientID, handler.ColumnTypeText),
 			handler.NewColumn(AppAPIConfigColumnClientSecret, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppAPIConfigColumnAuthMethod, handler.ColumnTypeEnum),
+			handler.NewColumn(AppAPIConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppAPIConfigColumnTLSClientAuthPublicKeyPin, handler.ColumnTypeText, handler.Default("")),
 		},
 			handler.NewPrimaryKey(AppAPIConfigColumnInstanceID, AppAPIConfigColumnAppID),
 			appAPITableSuffix,
@@ -133,6 +151,14 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppOIDCConfigColumnBackChannelLogoutURI, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnLoginVersion, handler.ColumnTypeEnum, handler.Nullable()),
 			handler.NewColumn(AppOIDCConfigColumnLoginBaseURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppOIDCConfigColumnRequirePushedAuthorizationRequests, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnRequireDPoP, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthSubjectDN, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnTLSClientAuthPublicKeyPin, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnBackChannelClientNotificationEndpoint, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(AppOIDCConfigColumnAccessTokenLifetime, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(AppOIDCConfigColumnIDTokenLifetime, handler.ColumnTypeInt64, handler.Default(0)),
+			handler.NewColumn(AppOIDCConfigColumnRefreshTokenLifetime, handler.ColumnTypeInt64, handler.Default(0)),
 		},
 			handler.NewPrimaryKey(AppOIDCConfigColumnInstanceID, AppOIDCConfigColumnAppID),
 			appOIDCTableSuffix,
@@ -147,6 +173,11 @@ func (*appProjection) Init() *old_handler.Check {
 			handler.NewColumn(AppSAMLConfigColumnMetadataURL, handler.ColumnTypeText),
 			handler.NewColumn(AppSAMLConfigColumnLoginVersion, handler.ColumnTypeEnum, handler.Nullable()),
 			handler.NewColumn(AppSAMLConfigColumnLoginBaseURI, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AppSAMLConfigColumnSignatureAlgorithm, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(AppSAMLConfigColumnDigestAlgorithm, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(AppSAMLConfigColumnAssertionEncryption, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(AppSAMLConfigColumnNameIDFormat, handler.ColumnTypeEnum, handler.Nullable()),
+			handler.NewColumn(AppSAMLConfigColumnAttributeMapping, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AppSAMLConfigColumnInstanceID, AppSAMLConfigColumnAppID),
 			appSAMLTableSuffix,
@@ -371,6 +402,8 @@ func (p *appProjection) reduceAPIConfigAdded(event eventstore.Event) (*handler.S
 				handler.NewCol(AppAPIConfigColumnClientID, e.ClientID),
 				handler.NewCol(AppAPIConfigColumnClientSecret, crypto.SecretOrEncodedHash(e.ClientSecret, e.HashedSecret)),
 				handler.NewCol(AppAPIConfigColumnAuthMethod, e.AuthMethodType),
+				handler.NewCol(AppAPIConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppAPIConfigColumnTLSClientAuthPublicKeyPin, e.TLSClientAuthPublicKeyPin),
 			},
 			handler.WithTableSuffix(appAPITableSuffix),
 		),
@@ -392,10 +425,16 @@ func (p *appProjection) reduceAPIConfigChanged(event eventstore.Event) (*handler
 	if !ok {
 		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-vnZKi", "reduce.wrong.event.type %s", project.APIConfigChangedType)
 	}
-	cols := make([]handler.Column, 0, 2)
+	cols := make([]handler.Column, 0, 4)
 	if e.AuthMethodType != nil {
 		cols = append(cols, handler.NewCol(AppAPIConfigColumnAuthMethod, *e.AuthMethodType))
 	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppAPIConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.TLSClientAuthPublicKeyPin != nil {
+		cols = append(cols, handler.NewCol(AppAPIConfigColumnTLSClientAuthPublicKeyPin, *e.TLSClientAuthPublicKeyPin))
+	}
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
 	}
@@ -513,6 +552,14 @@ func (p *appProjection) reduceOIDCConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppOIDCConfigColumnBackChannelLogoutURI, e.BackChannelLogoutURI),
 				handler.NewCol(AppOIDCConfigColumnLoginVersion, e.LoginVersion),
 				handler.NewCol(AppOIDCConfigColumnLoginBaseURI, e.LoginBaseURI),
+				handler.NewCol(AppOIDCConfigColumnRequirePushedAuthorizationRequests, e.RequirePushedAuthorizationRequests),
+				handler.NewCol(AppOIDCConfigColumnRequireDPoP, e.RequireDPoP),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, e.TLSClientAuthSubjectDN),
+				handler.NewCol(AppOIDCConfigColumnTLSClientAuthPublicKeyPin, e.TLSClientAuthPublicKeyPin),
+				handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationEndpoint, e.BackChannelClientNotificationEndpoint),
+				handler.NewCol(AppOIDCConfigColumnAccessTokenLifetime, e.AccessTokenLifetime),
+				handler.NewCol(AppOIDCConfigColumnIDTokenLifetime, e.IDTokenLifetime),
+				handler.NewCol(AppOIDCConfigColumnRefreshTokenLifetime, e.RefreshTokenLifetime),
 			},
 			handler.WithTableSuffix(appOIDCTableSuffix),
 		),
@@ -590,6 +637,30 @@ func (p *appProjection) reduceOIDCConfigChanged(event eventstore.Event) (*handle
 	if e.LoginBaseURI != nil {
 		cols = append(cols, handler.NewCol(AppOIDCConfigColumnLoginBaseURI, *e.LoginBaseURI))
 	}
+	if e.RequirePushedAuthorizationRequests != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequirePushedAuthorizationRequests, *e.RequirePushedAuthorizationRequests))
+	}
+	if e.RequireDPoP != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRequireDPoP, *e.RequireDPoP))
+	}
+	if e.TLSClientAuthSubjectDN != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthSubjectDN, *e.TLSClientAuthSubjectDN))
+	}
+	if e.TLSClientAuthPublicKeyPin != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnTLSClientAuthPublicKeyPin, *e.TLSClientAuthPublicKeyPin))
+	}
+	if e.BackChannelClientNotificationEndpoint != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnBackChannelClientNotificationEndpoint, *e.BackChannelClientNotificationEndpoint))
+	}
+	if e.AccessTokenLifetime != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnAccessTokenLifetime, *e.AccessTokenLifetime))
+	}
+	if e.IDTokenLifetime != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnIDTokenLifetime, *e.IDTokenLifetime))
+	}
+	if e.RefreshTokenLifetime != nil {
+		cols = append(cols, handler.NewCol(AppOIDCConfigColumnRefreshTokenLifetime, *e.RefreshTokenLifetime))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
@@ -709,6 +780,11 @@ func (p *appProjection) reduceSAMLConfigAdded(event eventstore.Event) (*handler.
 				handler.NewCol(AppSAMLConfigColumnMetadataURL, e.MetadataURL),
 				handler.NewCol(AppSAMLConfigColumnLoginVersion, e.LoginVersion),
 				handler.NewCol(AppSAMLConfigColumnLoginBaseURI, e.LoginBaseURI),
+				handler.NewCol(AppSAMLConfigColumnSignatureAlgorithm, e.SignatureAlgorithm),
+				handler.NewCol(AppSAMLConfigColumnDigestAlgorithm, e.DigestAlgorithm),
+				handler.NewCol(AppSAMLConfigColumnAssertionEncryption, e.AssertionEncryption),
+				handler.NewCol(AppSAMLConfigColumnNameIDFormat, e.NameIDFormat),
+				handler.NewJSONCol(AppSAMLConfigColumnAttributeMapping, e.AttributeMapping),
 			},
 			handler.WithTableSuffix(appSAMLTableSuffix),
 		),
@@ -747,6 +823,21 @@ func (p *appProjection) reduceSAMLConfigChanged(event eventstore.Event) (*handle
 	if e.LoginBaseURI != nil {
 		cols = append(cols, handler.NewCol(AppSAMLConfigColumnLoginBaseURI, *e.LoginBaseURI))
 	}
+	if e.SignatureAlgorithm != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnSignatureAlgorithm, *e.SignatureAlgorithm))
+	}
+	if e.DigestAlgorithm != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnDigestAlgorithm, *e.DigestAlgorithm))
+	}
+	if e.AssertionEncryption != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnAssertionEncryption, *e.AssertionEncryption))
+	}
+	if e.NameIDFormat != nil {
+		cols = append(cols, handler.NewCol(AppSAMLConfigColumnNameIDFormat, *e.NameIDFormat))
+	}
+	if e.AttributeMapping != nil {
+		cols = append(cols, handler.NewJSONCol(AppSAMLConfigColumnAttributeMapping, *e.AttributeMapping))
+	}
 
 	if len(cols) == 0 {
 		return handler.NewNoOpStatement(e), nil
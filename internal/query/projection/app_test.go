@@ -285,13 +285,15 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method, tls_client_auth_subject_dn, tls_client_auth_public_key_pin) VALUES ($1, $2, $3, $4, $5, $6, $7)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
 								"client-id",
 								"secret",
 								domain.APIAuthMethodTypePrivateKeyJWT,
+								"",
+								"",
 							},
 						},
 						{
@@ -329,13 +331,15 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method) VALUES ($1, $2, $3, $4, $5)",
+							expectedStmt: "INSERT INTO projections.apps7_api_configs (app_id, instance_id, client_id, client_secret, auth_method, tls_client_auth_subject_dn, tls_client_auth_public_key_pin) VALUES ($1, $2, $3, $4, $5, $6, $7)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
 								"client-id",
 								"secret",
 								domain.APIAuthMethodTypePrivateKeyJWT,
+								"",
+								"",
 							},
 						},
 						{
@@ -561,7 +565,11 @@ func TestAppProjection_reduces(t *testing.T) {
 						"skipNativeAppSuccessPage": true,
 						"backChannelLogoutURI": "back.channel.one.ch",
 						"loginVersion": 2,
-						"loginBaseURI": "https://login.ch/"
+						"loginBaseURI": "https://login.ch/",
+						"requirePushedAuthorizationRequests": true,
+						"requireDPoP": true,
+						"tlsClientAuthSubjectDN": "CN=client,O=ZITADEL",
+						"backChannelClientNotificationEndpoint": "https://ciba.ch/notify"
 		}`),
 					), project.OIDCConfigAddedEventMapper),
 			},
@@ -572,7 +580,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, require_pushed_authorization_requests, require_dpop, tls_client_auth_subject_dn, tls_client_auth_public_key_pin, back_channel_client_notification_endpoint, access_token_lifetime, id_token_lifetime, refresh_token_lifetime) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -596,6 +604,14 @@ func TestAppProjection_reduces(t *testing.T) {
 								"back.channel.one.ch",
 								domain.LoginVersion2,
 								"https://login.ch/",
+								true,
+								true,
+								"CN=client,O=ZITADEL",
+								"",
+								"https://ciba.ch/notify",
+								time.Duration(0),
+								time.Duration(0),
+								time.Duration(0),
 							},
 						},
 						{
@@ -650,7 +666,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)",
+							expectedStmt: "INSERT INTO projections.apps7_oidc_configs (app_id, instance_id, version, client_id, client_secret, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, login_base_uri, require_pushed_authorization_requests, require_dpop, tls_client_auth_subject_dn, tls_client_auth_public_key_pin, back_channel_client_notification_endpoint, access_token_lifetime, id_token_lifetime, refresh_token_lifetime) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30)",
 							expectedArgs: []interface{}{
 								"app-id",
 								"instance-id",
@@ -674,6 +690,14 @@ func TestAppProjection_reduces(t *testing.T) {
 								"back.channel.one.ch",
 								domain.LoginVersion2,
 								"https://login.ch/",
+								false,
+								false,
+								"",
+								"",
+								"",
+								time.Duration(0),
+								time.Duration(0),
+								time.Duration(0),
 							},
 						},
 						{
@@ -714,7 +738,10 @@ func TestAppProjection_reduces(t *testing.T) {
                         "additionalOrigins": ["origin.one.ch", "origin.two.ch"],
 						"skipNativeAppSuccessPage": true,
 						"backChannelLogoutURI": "back.channel.one.ch",
-						"loginVersion": 2
+						"loginVersion": 2,
+						"requirePushedAuthorizationRequests": true,
+						"requireDPoP": true,
+						"tlsClientAuthPublicKeyPin": "pin"
 		}`),
 					), project.OIDCConfigChangedEventMapper),
 			},
@@ -725,7 +752,7 @@ func TestAppProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.apps7_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17) WHERE (app_id = $18) AND (instance_id = $19)",
+							expectedStmt: "UPDATE projections.apps7_oidc_configs SET (version, redirect_uris, response_types, grant_types, application_type, auth_method_type, post_logout_redirect_uris, is_dev_mode, access_token_type, access_token_role_assertion, id_token_role_assertion, id_token_userinfo_assertion, clock_skew, additional_origins, skip_native_app_success_page, back_channel_logout_uri, login_version, require_pushed_authorization_requests, require_dpop, tls_client_auth_public_key_pin) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20) WHERE (app_id = $21) AND (instance_id = $22)",
 							expectedArgs: []interface{}{
 								domain.OIDCVersionV1,
 								database.TextArray[string]{"redirect.one.ch", "redirect.two.ch"},
@@ -744,6 +771,9 @@ func TestAppProjection_reduces(t *testing.T) {
 								true,
 								"back.channel.one.ch",
 								domain.LoginVersion2,
+								true,
+								true,
+								"pin",
 								"app-id",
 								"instance-id",
 							},
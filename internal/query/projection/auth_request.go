@@ -14,21 +14,22 @@ import (
 const (
 	AuthRequestsProjectionTable = "projections.auth_requests"
 
-	AuthRequestColumnID            = "id"
-	AuthRequestColumnCreationDate  = "creation_date"
-	AuthRequestColumnChangeDate    = "change_date"
-	AuthRequestColumnSequence      = "sequence"
-	AuthRequestColumnResourceOwner = "resource_owner"
-	AuthRequestColumnInstanceID    = "instance_id"
-	AuthRequestColumnLoginClient   = "login_client"
-	AuthRequestColumnClientID      = "client_id"
-	AuthRequestColumnRedirectURI   = "redirect_uri"
-	AuthRequestColumnScope         = "scope"
-	AuthRequestColumnPrompt        = "prompt"
-	AuthRequestColumnUILocales     = "ui_locales"
-	AuthRequestColumnMaxAge        = "max_age"
-	AuthRequestColumnLoginHint     = "login_hint"
-	AuthRequestColumnHintUserID    = "hint_user_id"
+	AuthRequestColumnID                   = "id"
+	AuthRequestColumnCreationDate         = "creation_date"
+	AuthRequestColumnChangeDate           = "change_date"
+	AuthRequestColumnSequence             = "sequence"
+	AuthRequestColumnResourceOwner        = "resource_owner"
+	AuthRequestColumnInstanceID           = "instance_id"
+	AuthRequestColumnLoginClient          = "login_client"
+	AuthRequestColumnClientID             = "client_id"
+	AuthRequestColumnRedirectURI          = "redirect_uri"
+	AuthRequestColumnScope                = "scope"
+	AuthRequestColumnPrompt               = "prompt"
+	AuthRequestColumnUILocales            = "ui_locales"
+	AuthRequestColumnMaxAge               = "max_age"
+	AuthRequestColumnLoginHint            = "login_hint"
+	AuthRequestColumnHintUserID           = "hint_user_id"
+	AuthRequestColumnAuthorizationDetails = "authorization_details"
 )
 
 type authRequestProjection struct{}
@@ -60,6 +61,7 @@ func (*authRequestProjection) Init() *old_handler.Check {
 			handler.NewColumn(AuthRequestColumnMaxAge, handler.ColumnTypeInt64, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnLoginHint, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(AuthRequestColumnHintUserID, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(AuthRequestColumnAuthorizationDetails, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(AuthRequestColumnInstanceID, AuthRequestColumnID),
 		),
@@ -121,6 +123,7 @@ func (p *authRequestProjection) reduceAuthRequestAdded(event eventstore.Event) (
 			handler.NewCol(AuthRequestColumnMaxAge, e.MaxAge),
 			handler.NewCol(AuthRequestColumnLoginHint, e.LoginHint),
 			handler.NewCol(AuthRequestColumnHintUserID, e.HintUserID),
+			handler.NewJSONCol(AuthRequestColumnAuthorizationDetails, e.AuthorizationDetails),
 		},
 	), nil
 }
@@ -0,0 +1,125 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	BackChannelAuthRequestProjectionTable = "projections.backchannel_auth_requests"
+
+	BackChannelAuthRequestColumnAuthReqID      = "auth_req_id"
+	BackChannelAuthRequestColumnClientID       = "client_id"
+	BackChannelAuthRequestColumnUserID         = "user_id"
+	BackChannelAuthRequestColumnScopes         = "scopes"
+	BackChannelAuthRequestColumnBindingMessage = "binding_message"
+	BackChannelAuthRequestColumnExpires        = "expires"
+	BackChannelAuthRequestColumnCreationDate   = "creation_date"
+	BackChannelAuthRequestColumnChangeDate     = "change_date"
+	BackChannelAuthRequestColumnSequence       = "sequence"
+	BackChannelAuthRequestColumnInstanceID     = "instance_id"
+)
+
+// backChannelAuthRequestProjection holds pending backchannel authentication requests
+// and makes them search-able by the user they were initiated for,
+// so the login UI can present them to the user.
+// Token logic uses the eventstore directly.
+type backChannelAuthRequestProjection struct{}
+
+func newBackChannelAuthProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(backChannelAuthRequestProjection))
+}
+
+func (*backChannelAuthRequestProjection) Name() string {
+	return BackChannelAuthRequestProjectionTable
+}
+
+func (*backChannelAuthRequestProjection) Init() *old_handler.Check {
+	return handler.NewTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(BackChannelAuthRequestColumnAuthReqID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnClientID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnUserID, handler.ColumnTypeText),
+			handler.NewColumn(BackChannelAuthRequestColumnScopes, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(BackChannelAuthRequestColumnBindingMessage, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(BackChannelAuthRequestColumnExpires, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BackChannelAuthRequestColumnCreationDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BackChannelAuthRequestColumnChangeDate, handler.ColumnTypeTimestamp),
+			handler.NewColumn(BackChannelAuthRequestColumnSequence, handler.ColumnTypeInt64),
+			handler.NewColumn(BackChannelAuthRequestColumnInstanceID, handler.ColumnTypeText),
+		},
+			handler.NewPrimaryKey(BackChannelAuthRequestColumnInstanceID, BackChannelAuthRequestColumnAuthReqID),
+			handler.WithIndex(handler.NewIndex("user_id", []string{BackChannelAuthRequestColumnInstanceID, BackChannelAuthRequestColumnUserID})),
+		),
+	)
+}
+
+func (p *backChannelAuthRequestProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: backchannelauth.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  backchannelauth.AddedEventType,
+					Reduce: p.reduceAdded,
+				},
+				{
+					Event:  backchannelauth.ApprovedEventType,
+					Reduce: p.reduceDoneEvents,
+				},
+				{
+					Event:  backchannelauth.CanceledEventType,
+					Reduce: p.reduceDoneEvents,
+				},
+				{
+					Event:  backchannelauth.DoneEventType,
+					Reduce: p.reduceDoneEvents,
+				},
+			},
+		},
+	}
+}
+
+func (p *backChannelAuthRequestProjection) reduceAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, ok := event.(*backchannelauth.AddedEvent)
+	if !ok {
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-Eiph4", "reduce.wrong.event.type %T != %s", event, backchannelauth.AddedEventType)
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(BackChannelAuthRequestColumnAuthReqID, e.Aggregate().ID),
+			handler.NewCol(BackChannelAuthRequestColumnClientID, e.ClientID),
+			handler.NewCol(BackChannelAuthRequestColumnUserID, e.UserID),
+			handler.NewCol(BackChannelAuthRequestColumnScopes, e.Scopes),
+			handler.NewCol(BackChannelAuthRequestColumnBindingMessage, e.BindingMessage),
+			handler.NewCol(BackChannelAuthRequestColumnExpires, e.Expires),
+			handler.NewCol(BackChannelAuthRequestColumnCreationDate, e.CreationDate()),
+			handler.NewCol(BackChannelAuthRequestColumnChangeDate, e.CreationDate()),
+			handler.NewCol(BackChannelAuthRequestColumnSequence, e.Sequence()),
+			handler.NewCol(BackChannelAuthRequestColumnInstanceID, e.Aggregate().InstanceID),
+		},
+	), nil
+}
+
+// reduceDoneEvents removes the backchannel auth request from the projection,
+// as soon as it was handled by the user.
+func (p *backChannelAuthRequestProjection) reduceDoneEvents(event eventstore.Event) (*handler.Statement, error) {
+	switch event.(type) {
+	case *backchannelauth.ApprovedEvent, *backchannelauth.CanceledEvent, *backchannelauth.DoneEvent:
+		return handler.NewDeleteStatement(event,
+			[]handler.Condition{
+				handler.NewCond(BackChannelAuthRequestColumnInstanceID, event.Aggregate().InstanceID),
+				handler.NewCond(BackChannelAuthRequestColumnAuthReqID, event.Aggregate().ID),
+			},
+		), nil
+
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-ooT5a", "reduce.wrong.event.type %T", event)
+	}
+}
@@ -0,0 +1,123 @@
+package projection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/backchannelauth"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestBackChannelAuthRequestProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceAdded",
+			args: args{
+				event: getEvent(testEvent(
+					backchannelauth.AddedEventType,
+					backchannelauth.AggregateType,
+					[]byte(`{"ClientID": "clientID", "UserID": "userID", "Scopes": ["openid"], "BindingMessage": "binding", "Expires": "2024-01-01T00:00:00Z"}`),
+				), eventstore.GenericEventMapper[backchannelauth.AddedEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceAdded,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.backchannel_auth_requests (auth_req_id, client_id, user_id, scopes, binding_message, expires, creation_date, change_date, sequence, instance_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								"clientID",
+								"userID",
+								[]string{"openid"},
+								"binding",
+								time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDoneEvents, approved",
+			args: args{
+				event: getEvent(testEvent(
+					backchannelauth.ApprovedEventType,
+					backchannelauth.AggregateType,
+					[]byte(`{"SessionID": "sessionID"}`),
+				), eventstore.GenericEventMapper[backchannelauth.ApprovedEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceDoneEvents,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.backchannel_auth_requests WHERE (instance_id = $1) AND (auth_req_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceDoneEvents, canceled",
+			args: args{
+				event: getEvent(testEvent(
+					backchannelauth.CanceledEventType,
+					backchannelauth.AggregateType,
+					[]byte(`{"Reason": "denied"}`),
+				), eventstore.GenericEventMapper[backchannelauth.CanceledEvent]),
+			},
+			reduce: (&backChannelAuthRequestProjection{}).reduceDoneEvents,
+			want: wantReduce{
+				aggregateType: backchannelauth.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.backchannel_auth_requests WHERE (instance_id = $1) AND (auth_req_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, BackChannelAuthRequestProjectionTable, tt.want)
+		})
+	}
+}
@@ -0,0 +1,581 @@
+package projection
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+const (
+	GroupTable            = "projections.groups"
+	GroupIDCol            = "id"
+	GroupCreationDateCol  = "creation_date"
+	GroupChangeDateCol    = "change_date"
+	GroupSequenceCol      = "sequence"
+	GroupStateCol         = "state"
+	GroupResourceOwnerCol = "resource_owner"
+	GroupInstanceIDCol    = "instance_id"
+	GroupNameCol          = "name"
+	GroupDescriptionCol   = "description"
+	GroupExternalIDCol    = "external_id"
+
+	GroupMemberSuffix          = "members"
+	GroupMemberInstanceIDCol   = "instance_id"
+	GroupMemberGroupIDCol      = "group_id"
+	GroupMemberUserIDCol       = "user_id"
+	GroupMemberCreationDateCol = "creation_date"
+	GroupMemberSequenceCol     = "sequence"
+
+	GroupNestedGroupSuffix          = "nested_groups"
+	GroupNestedGroupInstanceIDCol   = "instance_id"
+	GroupNestedGroupGroupIDCol      = "group_id"
+	GroupNestedGroupNestedIDCol     = "nested_group_id"
+	GroupNestedGroupCreationDateCol = "creation_date"
+	GroupNestedGroupSequenceCol     = "sequence"
+
+	GroupGrantSuffix            = "grants"
+	GroupGrantInstanceIDCol     = "instance_id"
+	GroupGrantIDCol             = "id"
+	GroupGrantGroupIDCol        = "group_id"
+	GroupGrantResourceOwnerCol  = "resource_owner"
+	GroupGrantProjectIDCol      = "project_id"
+	GroupGrantProjectGrantIDCol = "project_grant_id"
+	GroupGrantRolesCol          = "roles"
+	GroupGrantCreationDateCol   = "creation_date"
+	GroupGrantChangeDateCol     = "change_date"
+	GroupGrantSequenceCol       = "sequence"
+)
+
+type groupProjection struct{}
+
+func newGroupProjection(ctx context.Context, config handler.Config) *handler.Handler {
+	return handler.NewHandler(ctx, &config, new(groupProjection))
+}
+
+func (*groupProjection) Name() string {
+	return GroupTable
+}
+
+func (*groupProjection) Init() *old_handler.Check {
+	return handler.NewMultiTableCheck(
+		handler.NewTable([]*handler.InitColumn{
+			handler.NewColumn(GroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupSequenceCol, handler.ColumnTypeInt64),
+			handler.NewColumn(GroupStateCol, handler.ColumnTypeEnum),
+			handler.NewColumn(GroupResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupNameCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupDescriptionCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupExternalIDCol, handler.ColumnTypeText, handler.Default("")),
+		},
+			handler.NewPrimaryKey(GroupInstanceIDCol, GroupIDCol),
+			handler.WithIndex(handler.NewIndex("resource_owner", []string{GroupResourceOwnerCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupMemberInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberUserIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupMemberCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupMemberSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupMemberInstanceIDCol, GroupMemberGroupIDCol, GroupMemberUserIDCol),
+			GroupMemberSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupMemberInstanceIDCol, GroupMemberGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("user", []string{GroupMemberInstanceIDCol, GroupMemberUserIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupNestedGroupInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupNestedGroupGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupNestedGroupNestedIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupNestedGroupCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupNestedGroupSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupNestedGroupInstanceIDCol, GroupNestedGroupGroupIDCol, GroupNestedGroupNestedIDCol),
+			GroupNestedGroupSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupNestedGroupInstanceIDCol, GroupNestedGroupGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("nested_group", []string{GroupNestedGroupInstanceIDCol, GroupNestedGroupNestedIDCol})),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(GroupGrantInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantGroupIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantResourceOwnerCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantProjectIDCol, handler.ColumnTypeText),
+			handler.NewColumn(GroupGrantProjectGrantIDCol, handler.ColumnTypeText, handler.Default("")),
+			handler.NewColumn(GroupGrantRolesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+			handler.NewColumn(GroupGrantCreationDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantChangeDateCol, handler.ColumnTypeTimestamp),
+			handler.NewColumn(GroupGrantSequenceCol, handler.ColumnTypeInt64),
+		},
+			handler.NewPrimaryKey(GroupGrantInstanceIDCol, GroupGrantIDCol),
+			GroupGrantSuffix,
+			handler.WithForeignKey(handler.NewForeignKey("group", []string{GroupGrantInstanceIDCol, GroupGrantGroupIDCol}, []string{GroupInstanceIDCol, GroupIDCol})),
+			handler.WithIndex(handler.NewIndex("group", []string{GroupGrantInstanceIDCol, GroupGrantGroupIDCol})),
+			handler.WithIndex(handler.NewIndex("project", []string{GroupGrantInstanceIDCol, GroupGrantProjectIDCol})),
+		),
+	)
+}
+
+func (p *groupProjection) Reducers() []handler.AggregateReducer {
+	return []handler.AggregateReducer{
+		{
+			Aggregate: group.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  group.AddedEventType,
+					Reduce: p.reduceGroupAdded,
+				},
+				{
+					Event:  group.ChangedEventType,
+					Reduce: p.reduceGroupChanged,
+				},
+				{
+					Event:  group.RemovedEventType,
+					Reduce: p.reduceGroupRemoved,
+				},
+				{
+					Event:  group.MemberAddedEventType,
+					Reduce: p.reduceMemberAdded,
+				},
+				{
+					Event:  group.MemberRemovedEventType,
+					Reduce: p.reduceMemberRemoved,
+				},
+				{
+					Event:  group.NestedGroupAddedEventType,
+					Reduce: p.reduceNestedGroupAdded,
+				},
+				{
+					Event:  group.NestedGroupRemovedEventType,
+					Reduce: p.reduceNestedGroupRemoved,
+				},
+				{
+					Event:  group.GrantAddedEventType,
+					Reduce: p.reduceGrantAdded,
+				},
+				{
+					Event:  group.GrantChangedEventType,
+					Reduce: p.reduceGrantChanged,
+				},
+				{
+					Event:  group.GrantRemovedEventType,
+					Reduce: p.reduceGrantRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: user.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  user.UserRemovedType,
+					Reduce: p.reduceUserRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: project.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  project.ProjectRemovedType,
+					Reduce: p.reduceProjectRemoved,
+				},
+				{
+					Event:  project.GrantRemovedType,
+					Reduce: p.reduceProjectGrantRemoved,
+				},
+				{
+					Event:  project.RoleRemovedType,
+					Reduce: p.reduceRoleRemoved,
+				},
+				{
+					Event:  project.GrantChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+				{
+					Event:  project.GrantCascadeChangedType,
+					Reduce: p.reduceProjectGrantChanged,
+				},
+			},
+		},
+		{
+			Aggregate: org.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  org.OrgRemovedEventType,
+					Reduce: p.reduceOwnerRemoved,
+				},
+			},
+		},
+		{
+			Aggregate: instance.AggregateType,
+			EventReducers: []handler.EventReducer{
+				{
+					Event:  instance.InstanceRemovedEventType,
+					Reduce: reduceInstanceRemovedHelper(GroupInstanceIDCol),
+				},
+			},
+		},
+	}
+}
+
+func (p *groupProjection) reduceGroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.AddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupIDCol, e.Aggregate().ID),
+			handler.NewCol(GroupCreationDateCol, e.CreationDate()),
+			handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupSequenceCol, e.Sequence()),
+			handler.NewCol(GroupStateCol, domain.GroupStateActive),
+			handler.NewCol(GroupResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(GroupNameCol, e.Name),
+			handler.NewCol(GroupDescriptionCol, e.Description),
+			handler.NewCol(GroupExternalIDCol, e.ExternalID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.ChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	columns := []handler.Column{
+		handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+		handler.NewCol(GroupSequenceCol, e.Sequence()),
+	}
+	if e.Name != nil {
+		columns = append(columns, handler.NewCol(GroupNameCol, *e.Name))
+	}
+	if e.Description != nil {
+		columns = append(columns, handler.NewCol(GroupDescriptionCol, *e.Description))
+	}
+	if e.ExternalID != nil {
+		columns = append(columns, handler.NewCol(GroupExternalIDCol, *e.ExternalID))
+	}
+	return handler.NewUpdateStatement(
+		e,
+		columns,
+		[]handler.Condition{
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupIDCol, e.Aggregate().ID),
+		},
+	), nil
+}
+
+func (p *groupProjection) reduceGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.RemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupNestedGroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupNestedGroupNestedIDCol, e.Aggregate().ID),
+			},
+			handler.WithTableSuffix(GroupNestedGroupSuffix),
+		),
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceMemberAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(GroupMemberGroupIDCol, e.Aggregate().ID),
+				handler.NewCol(GroupMemberUserIDCol, e.UserID),
+				handler.NewCol(GroupMemberCreationDateCol, e.CreationDate()),
+				handler.NewCol(GroupMemberSequenceCol, e.Sequence()),
+			},
+			handler.WithTableSuffix(GroupMemberSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+				handler.NewCol(GroupSequenceCol, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceMemberRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.MemberRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupMemberGroupIDCol, e.Aggregate().ID),
+				handler.NewCond(GroupMemberUserIDCol, e.UserID),
+			},
+			handler.WithTableSuffix(GroupMemberSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+				handler.NewCol(GroupSequenceCol, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceNestedGroupAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.NestedGroupAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupNestedGroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCol(GroupNestedGroupGroupIDCol, e.Aggregate().ID),
+				handler.NewCol(GroupNestedGroupNestedIDCol, e.GroupID),
+				handler.NewCol(GroupNestedGroupCreationDateCol, e.CreationDate()),
+				handler.NewCol(GroupNestedGroupSequenceCol, e.Sequence()),
+			},
+			handler.WithTableSuffix(GroupNestedGroupSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+				handler.NewCol(GroupSequenceCol, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceNestedGroupRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.NestedGroupRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewMultiStatement(
+		e,
+		handler.AddDeleteStatement(
+			[]handler.Condition{
+				handler.NewCond(GroupNestedGroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupNestedGroupGroupIDCol, e.Aggregate().ID),
+				handler.NewCond(GroupNestedGroupNestedIDCol, e.GroupID),
+			},
+			handler.WithTableSuffix(GroupNestedGroupSuffix),
+		),
+		handler.AddUpdateStatement(
+			[]handler.Column{
+				handler.NewCol(GroupChangeDateCol, e.CreationDate()),
+				handler.NewCol(GroupSequenceCol, e.Sequence()),
+			},
+			[]handler.Condition{
+				handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+				handler.NewCond(GroupIDCol, e.Aggregate().ID),
+			},
+		),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantAdded(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantAddedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewCreateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCol(GroupGrantIDCol, e.GrantID),
+			handler.NewCol(GroupGrantGroupIDCol, e.Aggregate().ID),
+			handler.NewCol(GroupGrantResourceOwnerCol, e.Aggregate().ResourceOwner),
+			handler.NewCol(GroupGrantProjectIDCol, e.ProjectID),
+			handler.NewCol(GroupGrantProjectGrantIDCol, e.ProjectGrantID),
+			handler.NewCol(GroupGrantRolesCol, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(GroupGrantCreationDateCol, e.CreationDate()),
+			handler.NewCol(GroupGrantChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupGrantSequenceCol, e.Sequence()),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantChangedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewCol(GroupGrantRolesCol, database.TextArray[string](e.RoleKeys)),
+			handler.NewCol(GroupGrantChangeDateCol, e.CreationDate()),
+			handler.NewCol(GroupGrantSequenceCol, e.Sequence()),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantIDCol, e.GrantID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*group.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantIDCol, e.GrantID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.ProjectRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.GrantRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectGrantIDCol, e.GrantID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceRoleRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*project.RoleRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewUpdateStatement(
+		e,
+		[]handler.Column{
+			handler.NewArrayRemoveCol(GroupGrantRolesCol, e.Key),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceProjectGrantChanged(event eventstore.Event) (*handler.Statement, error) {
+	var grantID string
+	var keys database.TextArray[string]
+	switch e := event.(type) {
+	case *project.GrantChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	case *project.GrantCascadeChangedEvent:
+		grantID = e.GrantID
+		keys = e.RoleKeys
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "PROJE-Gq7tz", "reduce.wrong.event.type %v", []eventstore.EventType{project.GrantChangedType, project.GrantCascadeChangedType})
+	}
+	return handler.NewUpdateStatement(
+		event,
+		[]handler.Column{
+			handler.NewArrayIntersectCol(GroupGrantRolesCol, keys),
+		},
+		[]handler.Condition{
+			handler.NewCond(GroupGrantInstanceIDCol, event.Aggregate().InstanceID),
+			handler.NewCond(GroupGrantProjectGrantIDCol, grantID),
+		},
+		handler.WithTableSuffix(GroupGrantSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceUserRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*user.UserRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupMemberInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupMemberUserIDCol, e.Aggregate().ID),
+		},
+		handler.WithTableSuffix(GroupMemberSuffix),
+	), nil
+}
+
+func (p *groupProjection) reduceOwnerRemoved(event eventstore.Event) (*handler.Statement, error) {
+	e, err := assertEvent[*org.OrgRemovedEvent](event)
+	if err != nil {
+		return nil, err
+	}
+	return handler.NewDeleteStatement(
+		e,
+		[]handler.Condition{
+			handler.NewCond(GroupInstanceIDCol, e.Aggregate().InstanceID),
+			handler.NewCond(GroupResourceOwnerCol, e.Aggregate().ID),
+		},
+	), nil
+}
@@ -0,0 +1,609 @@
+package projection
+
+import (
+	"testing"
+
+	"github.com/zitadel/zitadel/internal/database"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
+	"github.com/zitadel/zitadel/internal/repository/group"
+	"github.com/zitadel/zitadel/internal/repository/instance"
+	"github.com/zitadel/zitadel/internal/repository/org"
+	"github.com/zitadel/zitadel/internal/repository/project"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+func TestGroupProjection_reduces(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "reduceGroupAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.AddedEventType,
+						group.AggregateType,
+						[]byte(`{"name": "name", "description": "description", "externalId": "external"}`),
+					),
+					eventstore.GenericEventMapper[group.AddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups (id, creation_date, change_date, sequence, state, resource_owner, instance_id, name, description, external_id) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"agg-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								domain.GroupStateActive,
+								"ro-id",
+								"instance-id",
+								"name",
+								"description",
+								"external",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGroupChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.ChangedEventType,
+						group.AggregateType,
+						[]byte(`{"name": "name2"}`),
+					),
+					eventstore.GenericEventMapper[group.ChangedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups SET (change_date, sequence, name) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"name2",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGroupRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.RemovedEventType,
+						group.AggregateType,
+						[]byte(`{}`),
+					),
+					eventstore.GenericEventMapper[group.RemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGroupRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_nested_groups WHERE (instance_id = $1) AND (nested_group_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+						{
+							expectedStmt: "DELETE FROM projections.groups WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceMemberAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.MemberAddedEventType,
+						group.AggregateType,
+						[]byte(`{"userId": "user-id"}`),
+					),
+					eventstore.GenericEventMapper[group.MemberAddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceMemberAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups_members (instance_id, group_id, user_id, creation_date, sequence) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+								anyArg{},
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.groups SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceMemberRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.MemberRemovedEventType,
+						group.AggregateType,
+						[]byte(`{"userId": "user-id"}`),
+					),
+					eventstore.GenericEventMapper[group.MemberRemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceMemberRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_members WHERE (instance_id = $1) AND (group_id = $2) AND (user_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"user-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.groups SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceNestedGroupAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.NestedGroupAddedEventType,
+						group.AggregateType,
+						[]byte(`{"groupId": "group-id"}`),
+					),
+					eventstore.GenericEventMapper[group.NestedGroupAddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceNestedGroupAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups_nested_groups (instance_id, group_id, nested_group_id, creation_date, sequence) VALUES ($1, $2, $3, $4, $5)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"group-id",
+								anyArg{},
+								uint64(15),
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.groups SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceNestedGroupRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.NestedGroupRemovedEventType,
+						group.AggregateType,
+						[]byte(`{"groupId": "group-id"}`),
+					),
+					eventstore.GenericEventMapper[group.NestedGroupRemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceNestedGroupRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_nested_groups WHERE (instance_id = $1) AND (group_id = $2) AND (nested_group_id = $3)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+								"group-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.groups SET (change_date, sequence) = ($1, $2) WHERE (instance_id = $3) AND (id = $4)",
+							expectedArgs: []interface{}{
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GrantAddedEventType,
+						group.AggregateType,
+						[]byte(`{"grantId": "grant-id", "projectId": "project-id", "projectGrantId": "project-grant-id", "roleKeys": ["role"]}`),
+					),
+					eventstore.GenericEventMapper[group.GrantAddedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGrantAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "INSERT INTO projections.groups_grants (instance_id, id, group_id, resource_owner, project_id, project_grant_id, roles, creation_date, change_date, sequence) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"grant-id",
+								"agg-id",
+								"ro-id",
+								"project-id",
+								"project-grant-id",
+								database.TextArray[string]{"role"},
+								anyArg{},
+								anyArg{},
+								uint64(15),
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GrantChangedEventType,
+						group.AggregateType,
+						[]byte(`{"grantId": "grant-id", "roleKeys": ["role2"]}`),
+					),
+					eventstore.GenericEventMapper[group.GrantChangedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGrantChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups_grants SET (roles, change_date, sequence) = ($1, $2, $3) WHERE (instance_id = $4) AND (id = $5)",
+							expectedArgs: []interface{}{
+								database.TextArray[string]{"role2"},
+								anyArg{},
+								uint64(15),
+								"instance-id",
+								"grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceGrantRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						group.GrantRemovedEventType,
+						group.AggregateType,
+						[]byte(`{"grantId": "grant-id", "projectId": "project-id"}`),
+					),
+					eventstore.GenericEventMapper[group.GrantRemovedEvent],
+				),
+			},
+			reduce: (&groupProjection{}).reduceGrantRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("group"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_grants WHERE (instance_id = $1) AND (id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.ProjectRemovedType,
+						project.AggregateType,
+						[]byte(`{}`),
+					),
+					project.ProjectRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_grants WHERE (instance_id = $1) AND (project_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectGrantRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantRemovedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "project-grant-id"}`),
+					),
+					project.GrantRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectGrantRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_grants WHERE (instance_id = $1) AND (project_grant_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"project-grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceRoleRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.RoleRemovedType,
+						project.AggregateType,
+						[]byte(`{"key": "role"}`),
+					),
+					project.RoleRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceRoleRemoved,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups_grants SET roles = array_remove(roles, $1) WHERE (instance_id = $2) AND (project_id = $3)",
+							expectedArgs: []interface{}{
+								"role",
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceProjectGrantChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						project.GrantChangedType,
+						project.AggregateType,
+						[]byte(`{"grantId": "project-grant-id", "roleKeys": ["role"]}`),
+					),
+					project.GrantChangedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceProjectGrantChanged,
+			want: wantReduce{
+				aggregateType: project.AggregateType,
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "UPDATE projections.groups_grants SET (roles) = (SELECT ARRAY( SELECT UNNEST(roles) INTERSECT SELECT UNNEST ($1::TEXT[]))) WHERE (instance_id = $2) AND (project_grant_id = $3)",
+							expectedArgs: []interface{}{
+								database.TextArray[string]{"role"},
+								"instance-id",
+								"project-grant-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceUserRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						user.UserRemovedType,
+						user.AggregateType,
+						[]byte(`{}`),
+					),
+					user.UserRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceUserRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("user"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups_members WHERE (instance_id = $1) AND (user_id = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceOwnerRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OrgRemovedEventType,
+						org.AggregateType,
+						[]byte(`{}`),
+					),
+					org.OrgRemovedEventMapper,
+				),
+			},
+			reduce: (&groupProjection{}).reduceOwnerRemoved,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups WHERE (instance_id = $1) AND (resource_owner = $2)",
+							expectedArgs: []interface{}{
+								"instance-id",
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "reduceInstanceRemoved",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.InstanceRemovedEventType,
+						instance.AggregateType,
+						nil,
+					),
+					instance.InstanceRemovedEventMapper,
+				),
+			},
+			reduce: reduceInstanceRemovedHelper(GroupInstanceIDCol),
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: "DELETE FROM projections.groups WHERE (instance_id = $1)",
+							expectedArgs: []interface{}{
+								"agg-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if ok := zerrors.IsErrorInvalidArgument(err); !ok {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, GroupTable, tt.want)
+		})
+	}
+}
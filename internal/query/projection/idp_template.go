@@ -30,6 +30,10 @@ const (
 	IDPTemplateLDAPTable             = IDPTemplateTable + "_" + IDPTemplateLDAPSuffix
 	IDPTemplateAppleTable            = IDPTemplateTable + "_" + IDPTemplateAppleSuffix
 	IDPTemplateSAMLTable             = IDPTemplateTable + "_" + IDPTemplateSAMLSuffix
+	IDPTemplateOktaTable             = IDPTemplateTable + "_" + IDPTemplateOktaSuffix
+	IDPTemplateAuth0Table            = IDPTemplateTable + "_" + IDPTemplateAuth0Suffix
+	IDPTemplateKeycloakTable         = IDPTemplateTable + "_" + IDPTemplateKeycloakSuffix
+	IDPTemplateEntraExternalIDTable  = IDPTemplateTable + "_" + IDPTemplateEntraExternalIDSuffix
 
 	IDPTemplateOAuthSuffix            = "oauth2"
 	IDPTemplateOIDCSuffix             = "oidc"
@@ -43,23 +47,29 @@ const (
 	IDPTemplateLDAPSuffix             = "ldap2"
 	IDPTemplateAppleSuffix            = "apple"
 	IDPTemplateSAMLSuffix             = "saml"
-
-	IDPTemplateIDCol                = "id"
-	IDPTemplateCreationDateCol      = "creation_date"
-	IDPTemplateChangeDateCol        = "change_date"
-	IDPTemplateSequenceCol          = "sequence"
-	IDPTemplateResourceOwnerCol     = "resource_owner"
-	IDPTemplateInstanceIDCol        = "instance_id"
-	IDPTemplateStateCol             = "state"
-	IDPTemplateNameCol              = "name"
-	IDPTemplateOwnerTypeCol         = "owner_type"
-	IDPTemplateTypeCol              = "type"
-	IDPTemplateOwnerRemovedCol      = "owner_removed"
-	IDPTemplateIsCreationAllowedCol = "is_creation_allowed"
-	IDPTemplateIsLinkingAllowedCol  = "is_linking_allowed"
-	IDPTemplateIsAutoCreationCol    = "is_auto_creation"
-	IDPTemplateIsAutoUpdateCol      = "is_auto_update"
-	IDPTemplateAutoLinkingCol       = "auto_linking"
+	IDPTemplateOktaSuffix             = "okta"
+	IDPTemplateAuth0Suffix            = "auth0"
+	IDPTemplateKeycloakSuffix         = "keycloak"
+	IDPTemplateEntraExternalIDSuffix  = "entra_external_id"
+
+	IDPTemplateIDCol                       = "id"
+	IDPTemplateCreationDateCol             = "creation_date"
+	IDPTemplateChangeDateCol               = "change_date"
+	IDPTemplateSequenceCol                 = "sequence"
+	IDPTemplateResourceOwnerCol            = "resource_owner"
+	IDPTemplateInstanceIDCol               = "instance_id"
+	IDPTemplateStateCol                    = "state"
+	IDPTemplateNameCol                     = "name"
+	IDPTemplateOwnerTypeCol                = "owner_type"
+	IDPTemplateTypeCol                     = "type"
+	IDPTemplateOwnerRemovedCol             = "owner_removed"
+	IDPTemplateIsCreationAllowedCol        = "is_creation_allowed"
+	IDPTemplateIsLinkingAllowedCol         = "is_linking_allowed"
+	IDPTemplateIsAutoCreationCol           = "is_auto_creation"
+	IDPTemplateIsAutoUpdateCol             = "is_auto_update"
+	IDPTemplateAutoLinkingCol              = "auto_linking"
+	IDPTemplateGroupMappingCol             = "group_mapping"
+	IDPTemplateIsFederatedLogoutEnabledCol = "is_federated_logout_enabled"
 
 	OAuthIDCol                    = "idp_id"
 	OAuthInstanceIDCol            = "instance_id"
@@ -71,6 +81,7 @@ const (
 	OAuthScopesCol                = "scopes"
 	OAuthIDAttributeCol           = "id_attribute"
 	OAuthUsePKCECol               = "use_pkce"
+	OAuthClaimMappingCol          = "claim_mapping"
 
 	OIDCIDCol             = "idp_id"
 	OIDCInstanceIDCol     = "instance_id"
@@ -80,6 +91,7 @@ const (
 	OIDCScopesCol         = "scopes"
 	OIDCIDTokenMappingCol = "id_token_mapping"
 	OIDCUsePKCECol        = "use_pkce"
+	OIDCClaimMappingCol   = "claim_mapping"
 
 	JWTIDCol           = "idp_id"
 	JWTInstanceIDCol   = "instance_id"
@@ -174,6 +186,37 @@ const (
 	SAMLNameIDFormatCol               = "name_id_format"
 	SAMLTransientMappingAttributeName = "transient_mapping_attribute_name"
 	SAMLFederatedLogoutEnabled        = "federated_logout_enabled"
+
+	OktaIDCol                    = "idp_id"
+	OktaInstanceIDCol            = "instance_id"
+	OktaDomainCol                = "domain"
+	OktaAuthorizationServerIDCol = "authorization_server_id"
+	OktaClientIDCol              = "client_id"
+	OktaClientSecretCol          = "client_secret"
+	OktaScopesCol                = "scopes"
+
+	Auth0IDCol           = "idp_id"
+	Auth0InstanceIDCol   = "instance_id"
+	Auth0DomainCol       = "domain"
+	Auth0ClientIDCol     = "client_id"
+	Auth0ClientSecretCol = "client_secret"
+	Auth0ScopesCol       = "scopes"
+
+	KeycloakIDCol           = "idp_id"
+	KeycloakInstanceIDCol   = "instance_id"
+	KeycloakBaseURLCol      = "base_url"
+	KeycloakRealmCol        = "realm"
+	KeycloakClientIDCol     = "client_id"
+	KeycloakClientSecretCol = "client_secret"
+	KeycloakScopesCol       = "scopes"
+
+	EntraExternalIDIDCol           = "idp_id"
+	EntraExternalIDInstanceIDCol   = "instance_id"
+	EntraExternalIDTenantIDCol     = "tenant_id"
+	EntraExternalIDDomainCol       = "domain"
+	EntraExternalIDClientIDCol     = "client_id"
+	EntraExternalIDClientSecretCol = "client_secret"
+	EntraExternalIDScopesCol       = "scopes"
 )
 
 type idpTemplateProjection struct{}
@@ -205,6 +248,8 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(IDPTemplateIsAutoCreationCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(IDPTemplateIsAutoUpdateCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(IDPTemplateAutoLinkingCol, handler.ColumnTypeEnum, handler.Default(0)),
+			handler.NewColumn(IDPTemplateGroupMappingCol, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(IDPTemplateIsFederatedLogoutEnabledCol, handler.ColumnTypeBool, handler.Default(false)),
 		},
 			handler.NewPrimaryKey(IDPTemplateInstanceIDCol, IDPTemplateIDCol),
 			handler.WithIndex(handler.NewIndex("resource_owner", []string{IDPTemplateResourceOwnerCol})),
@@ -221,6 +266,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(OAuthScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(OAuthIDAttributeCol, handler.ColumnTypeText),
 			handler.NewColumn(OAuthUsePKCECol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OAuthClaimMappingCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(OAuthInstanceIDCol, OAuthIDCol),
 			IDPTemplateOAuthSuffix,
@@ -235,6 +281,7 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			handler.NewColumn(OIDCScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
 			handler.NewColumn(OIDCIDTokenMappingCol, handler.ColumnTypeBool, handler.Default(false)),
 			handler.NewColumn(OIDCUsePKCECol, handler.ColumnTypeBool, handler.Default(false)),
+			handler.NewColumn(OIDCClaimMappingCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(OIDCInstanceIDCol, OIDCIDCol),
 			IDPTemplateOIDCSuffix,
@@ -384,6 +431,57 @@ func (*idpTemplateProjection) Init() *old_handler.Check {
 			IDPTemplateSAMLSuffix,
 			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
 		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(OktaIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaDomainCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaAuthorizationServerIDCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(OktaClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(OktaClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(OktaScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(OktaInstanceIDCol, OktaIDCol),
+			IDPTemplateOktaSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(Auth0IDCol, handler.ColumnTypeText),
+			handler.NewColumn(Auth0InstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(Auth0DomainCol, handler.ColumnTypeText),
+			handler.NewColumn(Auth0ClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(Auth0ClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(Auth0ScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(Auth0InstanceIDCol, Auth0IDCol),
+			IDPTemplateAuth0Suffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(KeycloakIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakBaseURLCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakRealmCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(KeycloakClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(KeycloakScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(KeycloakInstanceIDCol, KeycloakIDCol),
+			IDPTemplateKeycloakSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
+		handler.NewSuffixedTable([]*handler.InitColumn{
+			handler.NewColumn(EntraExternalIDIDCol, handler.ColumnTypeText),
+			handler.NewColumn(EntraExternalIDInstanceIDCol, handler.ColumnTypeText),
+			handler.NewColumn(EntraExternalIDTenantIDCol, handler.ColumnTypeText),
+			handler.NewColumn(EntraExternalIDDomainCol, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(EntraExternalIDClientIDCol, handler.ColumnTypeText),
+			handler.NewColumn(EntraExternalIDClientSecretCol, handler.ColumnTypeJSONB),
+			handler.NewColumn(EntraExternalIDScopesCol, handler.ColumnTypeTextArray, handler.Nullable()),
+		},
+			handler.NewPrimaryKey(EntraExternalIDInstanceIDCol, EntraExternalIDIDCol),
+			IDPTemplateEntraExternalIDSuffix,
+			handler.WithForeignKey(handler.NewForeignKeyOfPublicKeys()),
+		),
 	)
 }
 
@@ -520,6 +618,38 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  instance.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  instance.OktaIDPAddedEventType,
+					Reduce: p.reduceOktaIDPAdded,
+				},
+				{
+					Event:  instance.OktaIDPChangedEventType,
+					Reduce: p.reduceOktaIDPChanged,
+				},
+				{
+					Event:  instance.Auth0IDPAddedEventType,
+					Reduce: p.reduceAuth0IDPAdded,
+				},
+				{
+					Event:  instance.Auth0IDPChangedEventType,
+					Reduce: p.reduceAuth0IDPChanged,
+				},
+				{
+					Event:  instance.KeycloakIDPAddedEventType,
+					Reduce: p.reduceKeycloakIDPAdded,
+				},
+				{
+					Event:  instance.KeycloakIDPChangedEventType,
+					Reduce: p.reduceKeycloakIDPChanged,
+				},
+				{
+					Event:  instance.EntraExternalIDIDPAddedEventType,
+					Reduce: p.reduceEntraExternalIDIDPAdded,
+				},
+				{
+					Event:  instance.EntraExternalIDIDPChangedEventType,
+					Reduce: p.reduceEntraExternalIDIDPChanged,
+				},
 				{
 					Event:  instance.IDPConfigRemovedEventType,
 					Reduce: p.reduceIDPConfigRemoved,
@@ -665,6 +795,38 @@ func (p *idpTemplateProjection) Reducers() []handler.AggregateReducer {
 					Event:  org.SAMLIDPChangedEventType,
 					Reduce: p.reduceSAMLIDPChanged,
 				},
+				{
+					Event:  org.OktaIDPAddedEventType,
+					Reduce: p.reduceOktaIDPAdded,
+				},
+				{
+					Event:  org.OktaIDPChangedEventType,
+					Reduce: p.reduceOktaIDPChanged,
+				},
+				{
+					Event:  org.Auth0IDPAddedEventType,
+					Reduce: p.reduceAuth0IDPAdded,
+				},
+				{
+					Event:  org.Auth0IDPChangedEventType,
+					Reduce: p.reduceAuth0IDPChanged,
+				},
+				{
+					Event:  org.KeycloakIDPAddedEventType,
+					Reduce: p.reduceKeycloakIDPAdded,
+				},
+				{
+					Event:  org.KeycloakIDPChangedEventType,
+					Reduce: p.reduceKeycloakIDPChanged,
+				},
+				{
+					Event:  org.EntraExternalIDIDPAddedEventType,
+					Reduce: p.reduceEntraExternalIDIDPAdded,
+				},
+				{
+					Event:  org.EntraExternalIDIDPChangedEventType,
+					Reduce: p.reduceEntraExternalIDIDPChanged,
+				},
 				{
 					Event:  org.IDPConfigRemovedEventType,
 					Reduce: p.reduceIDPConfigRemoved,
@@ -715,6 +877,8 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -729,6 +893,7 @@ func (p *idpTemplateProjection) reduceOAuthIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(OAuthScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(OAuthIDAttributeCol, idpEvent.IDAttribute),
 				handler.NewCol(OAuthUsePKCECol, idpEvent.UsePKCE),
+				handler.NewJSONCol(OAuthClaimMappingCol, idpEvent.ClaimMapping),
 			},
 			handler.WithTableSuffix(IDPTemplateOAuthSuffix),
 		),
@@ -809,6 +974,8 @@ func (p *idpTemplateProjection) reduceOIDCIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -821,6 +988,7 @@ func (p *idpTemplateProjection) reduceOIDCIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(OIDCScopesCol, database.TextArray[string](idpEvent.Scopes)),
 				handler.NewCol(OIDCIDTokenMappingCol, idpEvent.IsIDTokenMapping),
 				handler.NewCol(OIDCUsePKCECol, idpEvent.UsePKCE),
+				handler.NewJSONCol(OIDCClaimMappingCol, idpEvent.ClaimMapping),
 			},
 			handler.WithTableSuffix(IDPTemplateOIDCSuffix),
 		),
@@ -892,6 +1060,8 @@ func (p *idpTemplateProjection) reduceOIDCIDPMigratedAzureAD(event eventstore.Ev
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 			[]handler.Condition{
 				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
@@ -944,6 +1114,8 @@ func (p *idpTemplateProjection) reduceOIDCIDPMigratedGoogle(event eventstore.Eve
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 			[]handler.Condition{
 				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
@@ -1003,6 +1175,8 @@ func (p *idpTemplateProjection) reduceJWTIDPAdded(event eventstore.Event) (*hand
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1352,6 +1526,8 @@ func (p *idpTemplateProjection) reduceAzureADIDPAdded(event eventstore.Event) (*
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1443,6 +1619,8 @@ func (p *idpTemplateProjection) reduceGitHubIDPAdded(event eventstore.Event) (*h
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1491,6 +1669,8 @@ func (p *idpTemplateProjection) reduceGitHubEnterpriseIDPAdded(event eventstore.
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1624,6 +1804,8 @@ func (p *idpTemplateProjection) reduceGitLabIDPAdded(event eventstore.Event) (*h
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1713,6 +1895,8 @@ func (p *idpTemplateProjection) reduceGitLabSelfHostedIDPAdded(event eventstore.
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1803,6 +1987,8 @@ func (p *idpTemplateProjection) reduceGoogleIDPAdded(event eventstore.Event) (*h
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -1892,6 +2078,8 @@ func (p *idpTemplateProjection) reduceLDAPIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -2017,6 +2205,8 @@ func (p *idpTemplateProjection) reduceSAMLIDPAdded(event eventstore.Event) (*han
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -2101,6 +2291,8 @@ func (p *idpTemplateProjection) reduceAppleIDPAdded(event eventstore.Event) (*ha
 				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
 				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
 				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
 			},
 		),
 		handler.AddCreateStatement(
@@ -2159,6 +2351,377 @@ func (p *idpTemplateProjection) reduceAppleIDPChanged(event eventstore.Event) (*
 	), nil
 }
 
+func (p *idpTemplateProjection) reduceOktaIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.OktaIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.OktaIDPAddedEvent:
+		idpEvent = e.OktaIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.OktaIDPAddedEvent:
+		idpEvent = e.OktaIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-GnOXq", "reduce.wrong.event.type %v", []eventstore.EventType{org.OktaIDPAddedEventType, instance.OktaIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeOkta),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(OktaIDCol, idpEvent.ID),
+				handler.NewCol(OktaInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(OktaDomainCol, idpEvent.Domain),
+				handler.NewCol(OktaAuthorizationServerIDCol, idpEvent.AuthorizationServerID),
+				handler.NewCol(OktaClientIDCol, idpEvent.ClientID),
+				handler.NewCol(OktaClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(OktaScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateOktaSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceOktaIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.OktaIDPChangedEvent
+	switch e := event.(type) {
+	case *org.OktaIDPChangedEvent:
+		idpEvent = e.OktaIDPChangedEvent
+	case *instance.OktaIDPChangedEvent:
+		idpEvent = e.OktaIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-lefUo", "reduce.wrong.event.type %v", []eventstore.EventType{org.OktaIDPChangedEventType, instance.OktaIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	oktaCols := reduceOktaIDPChangedColumns(idpEvent)
+	if len(oktaCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				oktaCols,
+				[]handler.Condition{
+					handler.NewCond(OktaIDCol, idpEvent.ID),
+					handler.NewCond(OktaInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateOktaSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceAuth0IDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.Auth0IDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.Auth0IDPAddedEvent:
+		idpEvent = e.Auth0IDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.Auth0IDPAddedEvent:
+		idpEvent = e.Auth0IDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-GwQSc", "reduce.wrong.event.type %v", []eventstore.EventType{org.Auth0IDPAddedEventType, instance.Auth0IDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeAuth0),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(Auth0IDCol, idpEvent.ID),
+				handler.NewCol(Auth0InstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(Auth0DomainCol, idpEvent.Domain),
+				handler.NewCol(Auth0ClientIDCol, idpEvent.ClientID),
+				handler.NewCol(Auth0ClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(Auth0ScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateAuth0Suffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceAuth0IDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.Auth0IDPChangedEvent
+	switch e := event.(type) {
+	case *org.Auth0IDPChangedEvent:
+		idpEvent = e.Auth0IDPChangedEvent
+	case *instance.Auth0IDPChangedEvent:
+		idpEvent = e.Auth0IDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-xh6dY", "reduce.wrong.event.type %v", []eventstore.EventType{org.Auth0IDPChangedEventType, instance.Auth0IDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	auth0Cols := reduceAuth0IDPChangedColumns(idpEvent)
+	if len(auth0Cols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				auth0Cols,
+				[]handler.Condition{
+					handler.NewCond(Auth0IDCol, idpEvent.ID),
+					handler.NewCond(Auth0InstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateAuth0Suffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceKeycloakIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.KeycloakIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.KeycloakIDPAddedEvent:
+		idpEvent = e.KeycloakIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.KeycloakIDPAddedEvent:
+		idpEvent = e.KeycloakIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-F5Sre", "reduce.wrong.event.type %v", []eventstore.EventType{org.KeycloakIDPAddedEventType, instance.KeycloakIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeKeycloak),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(KeycloakIDCol, idpEvent.ID),
+				handler.NewCol(KeycloakInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(KeycloakBaseURLCol, idpEvent.BaseURL),
+				handler.NewCol(KeycloakRealmCol, idpEvent.Realm),
+				handler.NewCol(KeycloakClientIDCol, idpEvent.ClientID),
+				handler.NewCol(KeycloakClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(KeycloakScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateKeycloakSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceKeycloakIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.KeycloakIDPChangedEvent
+	switch e := event.(type) {
+	case *org.KeycloakIDPChangedEvent:
+		idpEvent = e.KeycloakIDPChangedEvent
+	case *instance.KeycloakIDPChangedEvent:
+		idpEvent = e.KeycloakIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-fL8Ru", "reduce.wrong.event.type %v", []eventstore.EventType{org.KeycloakIDPChangedEventType, instance.KeycloakIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	keycloakCols := reduceKeycloakIDPChangedColumns(idpEvent)
+	if len(keycloakCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				keycloakCols,
+				[]handler.Condition{
+					handler.NewCond(KeycloakIDCol, idpEvent.ID),
+					handler.NewCond(KeycloakInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateKeycloakSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceEntraExternalIDIDPAdded(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.EntraExternalIDIDPAddedEvent
+	var idpOwnerType domain.IdentityProviderType
+	switch e := event.(type) {
+	case *org.EntraExternalIDIDPAddedEvent:
+		idpEvent = e.EntraExternalIDIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeOrg
+	case *instance.EntraExternalIDIDPAddedEvent:
+		idpEvent = e.EntraExternalIDIDPAddedEvent
+		idpOwnerType = domain.IdentityProviderTypeSystem
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-SBscB", "reduce.wrong.event.type %v", []eventstore.EventType{org.EntraExternalIDIDPAddedEventType, instance.EntraExternalIDIDPAddedEventType})
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCol(IDPTemplateCreationDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateChangeDateCol, idpEvent.CreationDate()),
+				handler.NewCol(IDPTemplateSequenceCol, idpEvent.Sequence()),
+				handler.NewCol(IDPTemplateResourceOwnerCol, idpEvent.Aggregate().ResourceOwner),
+				handler.NewCol(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(IDPTemplateStateCol, domain.IDPStateActive),
+				handler.NewCol(IDPTemplateNameCol, idpEvent.Name),
+				handler.NewCol(IDPTemplateOwnerTypeCol, idpOwnerType),
+				handler.NewCol(IDPTemplateTypeCol, domain.IDPTypeEntraExternalID),
+				handler.NewCol(IDPTemplateIsCreationAllowedCol, idpEvent.IsCreationAllowed),
+				handler.NewCol(IDPTemplateIsLinkingAllowedCol, idpEvent.IsLinkingAllowed),
+				handler.NewCol(IDPTemplateIsAutoCreationCol, idpEvent.IsAutoCreation),
+				handler.NewCol(IDPTemplateIsAutoUpdateCol, idpEvent.IsAutoUpdate),
+				handler.NewCol(IDPTemplateAutoLinkingCol, idpEvent.AutoLinkingOption),
+				handler.NewJSONCol(IDPTemplateGroupMappingCol, idpEvent.GroupMapping),
+				handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, idpEvent.IsFederatedLogoutEnabled),
+			},
+		),
+		handler.AddCreateStatement(
+			[]handler.Column{
+				handler.NewCol(EntraExternalIDIDCol, idpEvent.ID),
+				handler.NewCol(EntraExternalIDInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				handler.NewCol(EntraExternalIDTenantIDCol, idpEvent.TenantID),
+				handler.NewCol(EntraExternalIDDomainCol, idpEvent.Domain),
+				handler.NewCol(EntraExternalIDClientIDCol, idpEvent.ClientID),
+				handler.NewCol(EntraExternalIDClientSecretCol, idpEvent.ClientSecret),
+				handler.NewCol(EntraExternalIDScopesCol, database.TextArray[string](idpEvent.Scopes)),
+			},
+			handler.WithTableSuffix(IDPTemplateEntraExternalIDSuffix),
+		),
+	), nil
+}
+
+func (p *idpTemplateProjection) reduceEntraExternalIDIDPChanged(event eventstore.Event) (*handler.Statement, error) {
+	var idpEvent idp.EntraExternalIDIDPChangedEvent
+	switch e := event.(type) {
+	case *org.EntraExternalIDIDPChangedEvent:
+		idpEvent = e.EntraExternalIDIDPChangedEvent
+	case *instance.EntraExternalIDIDPChangedEvent:
+		idpEvent = e.EntraExternalIDIDPChangedEvent
+	default:
+		return nil, zerrors.ThrowInvalidArgumentf(nil, "HANDL-KhqRb", "reduce.wrong.event.type %v", []eventstore.EventType{org.EntraExternalIDIDPChangedEventType, instance.EntraExternalIDIDPChangedEventType})
+	}
+
+	ops := make([]func(eventstore.Event) handler.Exec, 0, 2)
+	ops = append(ops,
+		handler.AddUpdateStatement(
+			reduceIDPChangedTemplateColumns(idpEvent.Name, idpEvent.CreationDate(), idpEvent.Sequence(), idpEvent.OptionChanges),
+			[]handler.Condition{
+				handler.NewCond(IDPTemplateIDCol, idpEvent.ID),
+				handler.NewCond(IDPTemplateInstanceIDCol, idpEvent.Aggregate().InstanceID),
+			},
+		),
+	)
+	entraExternalIDCols := reduceEntraExternalIDIDPChangedColumns(idpEvent)
+	if len(entraExternalIDCols) > 0 {
+		ops = append(ops,
+			handler.AddUpdateStatement(
+				entraExternalIDCols,
+				[]handler.Condition{
+					handler.NewCond(EntraExternalIDIDCol, idpEvent.ID),
+					handler.NewCond(EntraExternalIDInstanceIDCol, idpEvent.Aggregate().InstanceID),
+				},
+				handler.WithTableSuffix(IDPTemplateEntraExternalIDSuffix),
+			),
+		)
+	}
+
+	return handler.NewMultiStatement(
+		&idpEvent,
+		ops...,
+	), nil
+}
+
 func (p *idpTemplateProjection) reduceIDPConfigRemoved(event eventstore.Event) (*handler.Statement, error) {
 	var idpEvent idpconfig.IDPConfigRemovedEvent
 	switch e := event.(type) {
@@ -2234,6 +2797,12 @@ func reduceIDPChangedTemplateColumns(name *string, creationDate time.Time, seque
 	if optionChanges.AutoLinkingOption != nil {
 		cols = append(cols, handler.NewCol(IDPTemplateAutoLinkingCol, *optionChanges.AutoLinkingOption))
 	}
+	if optionChanges.GroupMapping != nil {
+		cols = append(cols, handler.NewJSONCol(IDPTemplateGroupMappingCol, optionChanges.GroupMapping))
+	}
+	if optionChanges.IsFederatedLogoutEnabled != nil {
+		cols = append(cols, handler.NewCol(IDPTemplateIsFederatedLogoutEnabledCol, *optionChanges.IsFederatedLogoutEnabled))
+	}
 	return append(cols,
 		handler.NewCol(IDPTemplateChangeDateCol, creationDate),
 		handler.NewCol(IDPTemplateSequenceCol, sequence),
@@ -2266,6 +2835,9 @@ func reduceOAuthIDPChangedColumns(idpEvent idp.OAuthIDPChangedEvent) []handler.C
 	if idpEvent.UsePKCE != nil {
 		oauthCols = append(oauthCols, handler.NewCol(OAuthUsePKCECol, *idpEvent.UsePKCE))
 	}
+	if idpEvent.ClaimMapping != nil {
+		oauthCols = append(oauthCols, handler.NewJSONCol(OAuthClaimMappingCol, idpEvent.ClaimMapping))
+	}
 	return oauthCols
 }
 
@@ -2289,6 +2861,9 @@ func reduceOIDCIDPChangedColumns(idpEvent idp.OIDCIDPChangedEvent) []handler.Col
 	if idpEvent.UsePKCE != nil {
 		oidcCols = append(oidcCols, handler.NewCol(OIDCUsePKCECol, *idpEvent.UsePKCE))
 	}
+	if idpEvent.ClaimMapping != nil {
+		oidcCols = append(oidcCols, handler.NewJSONCol(OIDCClaimMappingCol, idpEvent.ClaimMapping))
+	}
 	return oidcCols
 }
 
@@ -2533,3 +3108,80 @@ func reduceSAMLIDPChangedColumns(idpEvent idp.SAMLIDPChangedEvent) []handler.Col
 	}
 	return SAMLCols
 }
+
+func reduceOktaIDPChangedColumns(idpEvent idp.OktaIDPChangedEvent) []handler.Column {
+	oktaCols := make([]handler.Column, 0, 5)
+	if idpEvent.Domain != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaDomainCol, *idpEvent.Domain))
+	}
+	if idpEvent.AuthorizationServerID != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaAuthorizationServerIDCol, *idpEvent.AuthorizationServerID))
+	}
+	if idpEvent.ClientID != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		oktaCols = append(oktaCols, handler.NewCol(OktaScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return oktaCols
+}
+
+func reduceAuth0IDPChangedColumns(idpEvent idp.Auth0IDPChangedEvent) []handler.Column {
+	auth0Cols := make([]handler.Column, 0, 4)
+	if idpEvent.Domain != nil {
+		auth0Cols = append(auth0Cols, handler.NewCol(Auth0DomainCol, *idpEvent.Domain))
+	}
+	if idpEvent.ClientID != nil {
+		auth0Cols = append(auth0Cols, handler.NewCol(Auth0ClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		auth0Cols = append(auth0Cols, handler.NewCol(Auth0ClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		auth0Cols = append(auth0Cols, handler.NewCol(Auth0ScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return auth0Cols
+}
+
+func reduceKeycloakIDPChangedColumns(idpEvent idp.KeycloakIDPChangedEvent) []handler.Column {
+	keycloakCols := make([]handler.Column, 0, 5)
+	if idpEvent.BaseURL != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakBaseURLCol, *idpEvent.BaseURL))
+	}
+	if idpEvent.Realm != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakRealmCol, *idpEvent.Realm))
+	}
+	if idpEvent.ClientID != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		keycloakCols = append(keycloakCols, handler.NewCol(KeycloakScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return keycloakCols
+}
+
+func reduceEntraExternalIDIDPChangedColumns(idpEvent idp.EntraExternalIDIDPChangedEvent) []handler.Column {
+	entraExternalIDCols := make([]handler.Column, 0, 5)
+	if idpEvent.TenantID != nil {
+		entraExternalIDCols = append(entraExternalIDCols, handler.NewCol(EntraExternalIDTenantIDCol, *idpEvent.TenantID))
+	}
+	if idpEvent.Domain != nil {
+		entraExternalIDCols = append(entraExternalIDCols, handler.NewCol(EntraExternalIDDomainCol, *idpEvent.Domain))
+	}
+	if idpEvent.ClientID != nil {
+		entraExternalIDCols = append(entraExternalIDCols, handler.NewCol(EntraExternalIDClientIDCol, *idpEvent.ClientID))
+	}
+	if idpEvent.ClientSecret != nil {
+		entraExternalIDCols = append(entraExternalIDCols, handler.NewCol(EntraExternalIDClientSecretCol, *idpEvent.ClientSecret))
+	}
+	if idpEvent.Scopes != nil {
+		entraExternalIDCols = append(entraExternalIDCols, handler.NewCol(EntraExternalIDScopesCol, database.TextArray[string](idpEvent.Scopes)))
+	}
+	return entraExternalIDCols
+}
@@ -16,6 +16,9 @@ import (
 
 var (
 	idpTemplateInsertStmt = `INSERT INTO projections.idp_templates6` +
+		` (id, creation_date, change_date, sequence, resource_owner, instance_id, state, name, owner_type, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking, group_mapping, is_federated_logout_enabled)` +
+		` VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`
+	idpTemplateOldConfigInsertStmt = `INSERT INTO projections.idp_templates6` +
 		` (id, creation_date, change_date, sequence, resource_owner, instance_id, state, name, owner_type, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking)` +
 		` VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
 	idpTemplateUpdateMinimalStmt = `UPDATE projections.idp_templates6 SET (is_creation_allowed, change_date, sequence) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)`
@@ -193,6 +196,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 	"scopes": ["profile"],
 	"idAttribute": "id-attribute",
 	"usePKCE": false,
+	"claimMapping": {"email": "$.mail"},
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -225,10 +229,12 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce, claim_mapping) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -240,6 +246,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								database.TextArray[string]{"profile"},
 								"id-attribute",
 								false,
+								[]byte(`{"email":"$.mail"}`),
 							},
 						},
 					},
@@ -300,10 +307,12 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_oauth2 (idp_id, instance_id, client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce, claim_mapping) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -315,6 +324,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								database.TextArray[string]{"profile"},
 								"id-attribute",
 								true,
+								[]byte("null"),
 							},
 						},
 					},
@@ -385,6 +395,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 	"scopes": ["profile"],
 	"idAttribute": "id-attribute",
 	"usePKCE": true,
+	"claimMapping": {"metadata": {"roles": "$.roles"}},
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -415,7 +426,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_oauth2 SET (client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce) = ($1, $2, $3, $4, $5, $6, $7, $8) WHERE (idp_id = $9) AND (instance_id = $10)",
+							expectedStmt: "UPDATE projections.idp_templates6_oauth2 SET (client_id, client_secret, authorization_endpoint, token_endpoint, user_endpoint, scopes, id_attribute, use_pkce, claim_mapping) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (idp_id = $10) AND (instance_id = $11)",
 							expectedArgs: []interface{}{
 								"client_id",
 								anyArg{},
@@ -425,6 +436,7 @@ func TestIDPTemplateProjection_reducesOAuth(t *testing.T) {
 								database.TextArray[string]{"profile"},
 								"id-attribute",
 								true,
+								[]byte(`{"metadata":{"roles":"$.roles"}}`),
 								"idp-id",
 								"instance-id",
 							},
@@ -502,6 +514,8 @@ func TestIDPTemplateProjection_reducesAzureAD(t *testing.T) {
 								false,
 								false,
 								domain.AutoLinkingOptionUnspecified,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -571,6 +585,8 @@ func TestIDPTemplateProjection_reducesAzureAD(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -640,6 +656,8 @@ func TestIDPTemplateProjection_reducesAzureAD(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -839,6 +857,8 @@ func TestIDPTemplateProjection_reducesGitHub(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -904,6 +924,8 @@ func TestIDPTemplateProjection_reducesGitHub(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1100,6 +1122,8 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1171,6 +1195,8 @@ func TestIDPTemplateProjection_reducesGitHubEnterprise(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1372,6 +1398,8 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1436,6 +1464,8 @@ func TestIDPTemplateProjection_reducesGitLab(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1630,6 +1660,8 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1697,6 +1729,8 @@ func TestIDPTemplateProjection_reducesGitLabSelfHosted(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1892,6 +1926,8 @@ func TestIDPTemplateProjection_reducesGoogle(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -1956,6 +1992,8 @@ func TestIDPTemplateProjection_reducesGoogle(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -2169,6 +2207,8 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -2274,6 +2314,8 @@ func TestIDPTemplateProjection_reducesLDAP(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -2554,6 +2596,8 @@ func TestIDPTemplateProjection_reducesApple(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -2621,6 +2665,8 @@ func TestIDPTemplateProjection_reducesApple(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -2822,6 +2868,8 @@ func TestIDPTemplateProjection_reducesSAML(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -2896,6 +2944,8 @@ func TestIDPTemplateProjection_reducesSAML(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -3094,6 +3144,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 	"scopes": ["profile"],
 	"idTokenMapping": true,
 	"usePKCE": true,
+	"claimMapping": {"id": "$.oid"},
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -3126,10 +3177,12 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_oidc (idp_id, instance_id, issuer, client_id, client_secret, scopes, id_token_mapping, use_pkce) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_oidc (idp_id, instance_id, issuer, client_id, client_secret, scopes, id_token_mapping, use_pkce, claim_mapping) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -3139,6 +3192,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								database.TextArray[string]{"profile"},
 								true,
 								true,
+								[]byte(`{"id":"$.oid"}`),
 							},
 						},
 					},
@@ -3196,10 +3250,12 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
-							expectedStmt: "INSERT INTO projections.idp_templates6_oidc (idp_id, instance_id, issuer, client_id, client_secret, scopes, id_token_mapping, use_pkce) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+							expectedStmt: "INSERT INTO projections.idp_templates6_oidc (idp_id, instance_id, issuer, client_id, client_secret, scopes, id_token_mapping, use_pkce, claim_mapping) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)",
 							expectedArgs: []interface{}{
 								"idp-id",
 								"instance-id",
@@ -3209,6 +3265,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								database.TextArray[string]{"profile"},
 								true,
 								true,
+								[]byte("null"),
 							},
 						},
 					},
@@ -3277,6 +3334,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 	"scopes": ["profile"],
 	"idTokenMapping": true,
 	"usePKCE": true,
+	"claimMapping": {},
 	"isCreationAllowed": true,
 	"isLinkingAllowed": true,
 	"isAutoCreation": true,
@@ -3307,7 +3365,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 							},
 						},
 						{
-							expectedStmt: "UPDATE projections.idp_templates6_oidc SET (client_id, client_secret, issuer, scopes, id_token_mapping, use_pkce) = ($1, $2, $3, $4, $5, $6) WHERE (idp_id = $7) AND (instance_id = $8)",
+							expectedStmt: "UPDATE projections.idp_templates6_oidc SET (client_id, client_secret, issuer, scopes, id_token_mapping, use_pkce, claim_mapping) = ($1, $2, $3, $4, $5, $6, $7) WHERE (idp_id = $8) AND (instance_id = $9)",
 							expectedArgs: []interface{}{
 								"client_id",
 								anyArg{},
@@ -3315,6 +3373,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								database.TextArray[string]{"profile"},
 								true,
 								true,
+								[]byte("{}"),
 								"idp-id",
 								"instance-id",
 							},
@@ -3356,7 +3415,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (id = $10) AND (instance_id = $11)",
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking, group_mapping, is_federated_logout_enabled) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) WHERE (id = $12) AND (instance_id = $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -3367,6 +3426,8 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 								"idp-id",
 								"instance-id",
 							},
@@ -3427,7 +3488,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (id = $10) AND (instance_id = $11)",
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking, group_mapping, is_federated_logout_enabled) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) WHERE (id = $12) AND (instance_id = $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -3438,6 +3499,8 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 								"idp-id",
 								"instance-id",
 							},
@@ -3496,7 +3559,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (id = $10) AND (instance_id = $11)",
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking, group_mapping, is_federated_logout_enabled) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) WHERE (id = $12) AND (instance_id = $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -3507,6 +3570,8 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 								"idp-id",
 								"instance-id",
 							},
@@ -3563,7 +3628,7 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) WHERE (id = $10) AND (instance_id = $11)",
+							expectedStmt: "UPDATE projections.idp_templates6 SET (change_date, sequence, name, type, is_creation_allowed, is_linking_allowed, is_auto_creation, is_auto_update, auto_linking, group_mapping, is_federated_logout_enabled) = ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) WHERE (id = $12) AND (instance_id = $13)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								uint64(15),
@@ -3574,6 +3639,8 @@ func TestIDPTemplateProjection_reducesOIDC(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 								"idp-id",
 								"instance-id",
 							},
@@ -3648,7 +3715,7 @@ func TestIDPTemplateProjection_reducesOldConfig(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: idpTemplateInsertStmt,
+							expectedStmt: idpTemplateOldConfigInsertStmt,
 							expectedArgs: []interface{}{
 								"idp-config-id",
 								anyArg{},
@@ -3694,7 +3761,7 @@ func TestIDPTemplateProjection_reducesOldConfig(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: idpTemplateInsertStmt,
+							expectedStmt: idpTemplateOldConfigInsertStmt,
 							expectedArgs: []interface{}{
 								"idp-config-id",
 								anyArg{},
@@ -4261,6 +4328,8 @@ func TestIDPTemplateProjection_reducesJWT(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -4323,6 +4392,8 @@ func TestIDPTemplateProjection_reducesJWT(t *testing.T) {
 								true,
 								true,
 								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
 							},
 						},
 						{
@@ -4458,3 +4529,1093 @@ func stringToJSONByte(data string) string {
 	jsondata, _ := json.Marshal([]byte(data))
 	return string(jsondata)
 }
+
+func TestIDPTemplateProjection_reducesOkta(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceOktaIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"domain": "domain",
+	"authorization_server_id": "authorization_server_id",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.OktaIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeOkta,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_okta (idp_id, instance_id, domain, authorization_server_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"domain",
+								"authorization_server_id",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceOktaIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.OktaIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"domain": "domain",
+	"authorization_server_id": "authorization_server_id",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.OktaIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeOkta,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_okta (idp_id, instance_id, domain, authorization_server_id, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"domain",
+								"authorization_server_id",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceOktaIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"domain": "domain"
+}`),
+					), instance.OktaIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_okta SET domain = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"domain",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceOktaIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.OktaIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"domain": "domain",
+	"authorization_server_id": "authorization_server_id",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.OktaIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceOktaIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_okta SET (domain, authorization_server_id, client_id, client_secret, scopes) = ($1, $2, $3, $4, $5) WHERE (idp_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								"domain",
+								"authorization_server_id",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesAuth0(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceAuth0IDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.Auth0IDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"domain": "domain",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.Auth0IDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceAuth0IDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeAuth0,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_auth0 (idp_id, instance_id, domain, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"domain",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceAuth0IDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.Auth0IDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"domain": "domain",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.Auth0IDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceAuth0IDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeAuth0,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_auth0 (idp_id, instance_id, domain, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"domain",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceAuth0IDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.Auth0IDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"domain": "domain"
+}`),
+					), instance.Auth0IDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceAuth0IDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_auth0 SET domain = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"domain",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceAuth0IDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.Auth0IDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"domain": "domain",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.Auth0IDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceAuth0IDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_auth0 SET (domain, client_id, client_secret, scopes) = ($1, $2, $3, $4) WHERE (idp_id = $5) AND (instance_id = $6)",
+							expectedArgs: []interface{}{
+								"domain",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesKeycloak(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceKeycloakIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"base_url": "base_url",
+	"realm": "realm",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.KeycloakIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeKeycloak,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_keycloak (idp_id, instance_id, base_url, realm, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"base_url",
+								"realm",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceKeycloakIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.KeycloakIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"base_url": "base_url",
+	"realm": "realm",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.KeycloakIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeKeycloak,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_keycloak (idp_id, instance_id, base_url, realm, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"base_url",
+								"realm",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceKeycloakIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"base_url": "base_url"
+}`),
+					), instance.KeycloakIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_keycloak SET base_url = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"base_url",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceKeycloakIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.KeycloakIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"base_url": "base_url",
+	"realm": "realm",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.KeycloakIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceKeycloakIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_keycloak SET (base_url, realm, client_id, client_secret, scopes) = ($1, $2, $3, $4, $5) WHERE (idp_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								"base_url",
+								"realm",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
+
+func TestIDPTemplateProjection_reducesEntraExternalID(t *testing.T) {
+	type args struct {
+		event func(t *testing.T) eventstore.Event
+	}
+	tests := []struct {
+		name   string
+		args   args
+		reduce func(event eventstore.Event) (*handler.Statement, error)
+		want   wantReduce
+	}{
+		{
+			name: "instance reduceEntraExternalIDIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.EntraExternalIDIDPAddedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"tenant_id": "tenant_id",
+	"domain": "domain",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.EntraExternalIDIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceEntraExternalIDIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeSystem,
+								domain.IDPTypeEntraExternalID,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_entra_external_id (idp_id, instance_id, tenant_id, domain, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"tenant_id",
+								"domain",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "org reduceEntraExternalIDIDPAdded",
+			args: args{
+				event: getEvent(
+					testEvent(
+						org.EntraExternalIDIDPAddedEventType,
+						org.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"tenant_id": "tenant_id",
+	"domain": "domain",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), org.EntraExternalIDIDPAddedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceEntraExternalIDIDPAdded,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("org"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateInsertStmt,
+							expectedArgs: []interface{}{
+								"idp-id",
+								anyArg{},
+								anyArg{},
+								uint64(15),
+								"ro-id",
+								"instance-id",
+								domain.IDPStateActive,
+								"name",
+								domain.IdentityProviderTypeOrg,
+								domain.IDPTypeEntraExternalID,
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								[]byte("null"),
+								false,
+							},
+						},
+						{
+							expectedStmt: "INSERT INTO projections.idp_templates6_entra_external_id (idp_id, instance_id, tenant_id, domain, client_id, client_secret, scopes) VALUES ($1, $2, $3, $4, $5, $6, $7)",
+							expectedArgs: []interface{}{
+								"idp-id",
+								"instance-id",
+								"tenant_id",
+								"domain",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceEntraExternalIDIDPChanged minimal",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.EntraExternalIDIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"isCreationAllowed": true,
+	"tenant_id": "tenant_id"
+}`),
+					), instance.EntraExternalIDIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceEntraExternalIDIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateMinimalStmt,
+							expectedArgs: []interface{}{
+								true,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_entra_external_id SET tenant_id = $1 WHERE (idp_id = $2) AND (instance_id = $3)",
+							expectedArgs: []interface{}{
+								"tenant_id",
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "instance reduceEntraExternalIDIDPChanged",
+			args: args{
+				event: getEvent(
+					testEvent(
+						instance.EntraExternalIDIDPChangedEventType,
+						instance.AggregateType,
+						[]byte(`{
+	"id": "idp-id",
+	"name": "name",
+	"tenant_id": "tenant_id",
+	"domain": "domain",
+	"client_id": "client_id",
+	"client_secret": {
+        "cryptoType": 0,
+        "algorithm": "RSA-265",
+        "keyId": "key-id"
+    },
+	"scopes": ["profile"],
+	"isCreationAllowed": true,
+	"isLinkingAllowed": true,
+	"isAutoCreation": true,
+	"isAutoUpdate": true,
+	"autoLinkingOption": 1
+}`),
+					), instance.EntraExternalIDIDPChangedEventMapper),
+			},
+			reduce: (&idpTemplateProjection{}).reduceEntraExternalIDIDPChanged,
+			want: wantReduce{
+				aggregateType: eventstore.AggregateType("instance"),
+				sequence:      15,
+				executer: &testExecuter{
+					executions: []execution{
+						{
+							expectedStmt: idpTemplateUpdateStmt,
+							expectedArgs: []interface{}{
+								"name",
+								true,
+								true,
+								true,
+								true,
+								domain.AutoLinkingOptionUsername,
+								anyArg{},
+								uint64(15),
+								"idp-id",
+								"instance-id",
+							},
+						},
+						{
+							expectedStmt: "UPDATE projections.idp_templates6_entra_external_id SET (tenant_id, domain, client_id, client_secret, scopes) = ($1, $2, $3, $4, $5) WHERE (idp_id = $6) AND (instance_id = $7)",
+							expectedArgs: []interface{}{
+								"tenant_id",
+								"domain",
+								"client_id",
+								anyArg{},
+								database.TextArray[string]{"profile"},
+								"idp-id",
+								"instance-id",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := baseEvent(t)
+			got, err := tt.reduce(event)
+			if !zerrors.IsErrorInvalidArgument(err) {
+				t.Errorf("no wrong event mapping: %v, got: %v", err, got)
+			}
+
+			event = tt.args.event(t)
+			got, err = tt.reduce(event)
+			assertReduce(t, got, err, IDPTemplateTable, tt.want)
+		})
+	}
+}
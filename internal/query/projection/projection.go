@@ -72,6 +72,7 @@ var (
 	NotificationsQuotaProjection        interface{}
 	TelemetryPusherProjection           interface{}
 	DeviceAuthProjection                *handler.Handler
+	BackChannelAuthProjection           *handler.Handler
 	SessionProjection                   *handler.Handler
 	AuthRequestProjection               *handler.Handler
 	SamlRequestProjection               *handler.Handler
@@ -83,6 +84,7 @@ var (
 	InstanceFeatureProjection           *handler.Handler
 	TargetProjection                    *handler.Handler
 	ExecutionProjection                 *handler.Handler
+	GroupProjection                     *handler.Handler
 	UserSchemaProjection                *handler.Handler
 	WebKeyProjection                    *handler.Handler
 	DebugEventsProjection               *handler.Handler
@@ -166,6 +168,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	SecurityPolicyProjection = newSecurityPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["security_policies"]))
 	NotificationPolicyProjection = newNotificationPolicyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["notification_policies"]))
 	DeviceAuthProjection = newDeviceAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["device_auth"]))
+	BackChannelAuthProjection = newBackChannelAuthProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["backchannel_auth"]))
 	SessionProjection = newSessionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["sessions"]))
 	AuthRequestProjection = newAuthRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["auth_requests"]))
 	SamlRequestProjection = newSamlRequestProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["saml_requests"]))
@@ -177,6 +180,7 @@ func Create(ctx context.Context, sqlClient *database.DB, es handler.EventStore,
 	InstanceFeatureProjection = newInstanceFeatureProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["instance_features"]))
 	TargetProjection = newTargetProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["targets"]))
 	ExecutionProjection = newExecutionProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["executions"]))
+	GroupProjection = newGroupProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["groups"]))
 	UserSchemaProjection = newUserSchemaProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["user_schemas"]))
 	WebKeyProjection = newWebKeyProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["web_keys"]))
 	DebugEventsProjection = newDebugEventsProjection(ctx, applyCustomConfig(projectionConfig, config.Customizations["debug_events"]))
@@ -345,6 +349,7 @@ func newProjectionsList() {
 		SecurityPolicyProjection,
 		NotificationPolicyProjection,
 		DeviceAuthProjection,
+		BackChannelAuthProjection,
 		SessionProjection,
 		AuthRequestProjection,
 		SamlRequestProjection,
@@ -356,6 +361,7 @@ func newProjectionsList() {
 		InstanceFeatureProjection,
 		TargetProjection,
 		ExecutionProjection,
+		GroupProjection,
 		UserSchemaProjection,
 		WebKeyProjection,
 		DebugEventsProjection,
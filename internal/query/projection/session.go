@@ -29,6 +29,8 @@ const (
 	SessionColumnUserCheckedAt          = "user_checked_at"
 	SessionColumnPasswordCheckedAt      = "password_checked_at"
 	SessionColumnIntentCheckedAt        = "intent_checked_at"
+	SessionColumnIntentIDPID            = "intent_idp_id"
+	SessionColumnIntentIDPSessionID     = "intent_idp_session_id"
 	SessionColumnWebAuthNCheckedAt      = "webauthn_checked_at"
 	SessionColumnWebAuthNUserVerified   = "webauthn_user_verified"
 	SessionColumnTOTPCheckedAt          = "totp_checked_at"
@@ -69,6 +71,8 @@ func (*sessionProjection) Init() *old_handler.Check {
 			handler.NewColumn(SessionColumnUserCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnPasswordCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnIntentCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
+			handler.NewColumn(SessionColumnIntentIDPID, handler.ColumnTypeText, handler.Nullable()),
+			handler.NewColumn(SessionColumnIntentIDPSessionID, handler.ColumnTypeText, handler.Nullable()),
 			handler.NewColumn(SessionColumnWebAuthNCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
 			handler.NewColumn(SessionColumnWebAuthNUserVerified, handler.ColumnTypeBool, handler.Nullable()),
 			handler.NewColumn(SessionColumnTOTPCheckedAt, handler.ColumnTypeTimestamp, handler.Nullable()),
@@ -258,6 +262,8 @@ func (p *sessionProjection) reduceIntentChecked(event eventstore.Event) (*handle
 			handler.NewCol(SessionColumnChangeDate, e.CreationDate()),
 			handler.NewCol(SessionColumnSequence, e.Sequence()),
 			handler.NewCol(SessionColumnIntentCheckedAt, e.CheckedAt),
+			handler.NewCol(SessionColumnIntentIDPID, e.IDPID),
+			handler.NewCol(SessionColumnIntentIDPSessionID, e.IDPSessionID),
 		},
 		[]handler.Condition{
 			handler.NewCond(SessionColumnID, e.Aggregate().ID),
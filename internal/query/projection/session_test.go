@@ -177,7 +177,9 @@ func TestSessionProjection_reduces(t *testing.T) {
 					session.AddedType,
 					session.AggregateType,
 					[]byte(`{
-						"checkedAt": "2023-05-04T00:00:00Z"
+						"checkedAt": "2023-05-04T00:00:00Z",
+						"idpId": "idp-id",
+						"idpSessionId": "idp-session-id"
 					}`),
 				), session.IntentCheckedEventMapper),
 			},
@@ -188,11 +190,13 @@ func TestSessionProjection_reduces(t *testing.T) {
 				executer: &testExecuter{
 					executions: []execution{
 						{
-							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, intent_checked_at) = ($1, $2, $3) WHERE (id = $4) AND (instance_id = $5)",
+							expectedStmt: "UPDATE projections.sessions8 SET (change_date, sequence, intent_checked_at, intent_idp_id, intent_idp_session_id) = ($1, $2, $3, $4, $5) WHERE (id = $6) AND (instance_id = $7)",
 							expectedArgs: []interface{}{
 								anyArg{},
 								anyArg{},
 								time.Date(2023, time.May, 4, 0, 0, 0, 0, time.UTC),
+								"idp-id",
+								"idp-session-id",
 								"agg-id",
 								"instance-id",
 							},
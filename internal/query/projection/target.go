@@ -3,6 +3,7 @@ package projection
 import (
 	"context"
 
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	old_handler "github.com/zitadel/zitadel/internal/eventstore/handler"
 	"github.com/zitadel/zitadel/internal/eventstore/handler/v2"
@@ -11,7 +12,7 @@ import (
 )
 
 const (
-	TargetTable               = "projections.targets2"
+	TargetTable               = "projections.targets3"
 	TargetIDCol               = "id"
 	TargetCreationDateCol     = "creation_date"
 	TargetChangeDateCol       = "change_date"
@@ -24,6 +25,7 @@ const (
 	TargetTimeoutCol          = "timeout"
 	TargetInterruptOnErrorCol = "interrupt_on_error"
 	TargetSigningKey          = "signing_key"
+	TargetRetryPolicyCol      = "retry_policy"
 )
 
 type targetProjection struct{}
@@ -51,6 +53,7 @@ func (*targetProjection) Init() *old_handler.Check {
 			handler.NewColumn(TargetTimeoutCol, handler.ColumnTypeInt64),
 			handler.NewColumn(TargetInterruptOnErrorCol, handler.ColumnTypeBool),
 			handler.NewColumn(TargetSigningKey, handler.ColumnTypeJSONB, handler.Nullable()),
+			handler.NewColumn(TargetRetryPolicyCol, handler.ColumnTypeJSONB, handler.Nullable()),
 		},
 			handler.NewPrimaryKey(TargetInstanceIDCol, TargetIDCol),
 		),
@@ -108,6 +111,7 @@ func (p *targetProjection) reduceTargetAdded(event eventstore.Event) (*handler.S
 			handler.NewCol(TargetTimeoutCol, e.Timeout),
 			handler.NewCol(TargetInterruptOnErrorCol, e.InterruptOnError),
 			handler.NewCol(TargetSigningKey, e.SigningKey),
+			handler.NewCol(TargetRetryPolicyCol, e.RetryPolicy),
 		},
 	), nil
 }
@@ -140,6 +144,14 @@ func (p *targetProjection) reduceTargetChanged(event eventstore.Event) (*handler
 	if e.SigningKey != nil {
 		values = append(values, handler.NewCol(TargetSigningKey, e.SigningKey))
 	}
+	if e.RetryPolicy != nil {
+		var retryPolicy *domain.TargetRetryPolicy
+		// an empty retry policy removes the policy
+		if *e.RetryPolicy != (domain.TargetRetryPolicy{}) {
+			retryPolicy = e.RetryPolicy
+		}
+		values = append(values, handler.NewCol(TargetRetryPolicyCol, retryPolicy))
+	}
 	return handler.NewUpdateStatement(
 		e,
 		values,
@@ -4,11 +4,17 @@ import (
 	"context"
 	"database/sql"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"net/url"
+	"slices"
+
+	"github.com/muhlemmer/gu"
 
 	"github.com/zitadel/zitadel/internal/api/authz"
+	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/query/projection"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -24,15 +30,43 @@ type SAMLServiceProvider struct {
 	ProjectRoleAssertion bool                `json:"project_role_assertion,omitempty"`
 	LoginVersion         domain.LoginVersion `json:"login_version,omitempty"`
 	LoginBaseURI         *url.URL            `json:"login_base_uri,omitempty"`
+
+	SignatureAlgorithm  domain.SAMLSignatureAlgorithm  `json:"signature_algorithm,omitempty"`
+	DigestAlgorithm     domain.SAMLDigestAlgorithm     `json:"digest_algorithm,omitempty"`
+	AssertionEncryption domain.SAMLAssertionEncryption `json:"assertion_encryption,omitempty"`
+
+	NameIDFormat     *domain.SAMLNameIDFormat       `json:"name_id_format,omitempty"`
+	AttributeMapping []*domain.SAMLAttributeMapping `json:"attribute_mapping,omitempty"`
 }
 
 //go:embed saml_sp_by_id.sql
 var samlSPQuery string
 
+//go:embed saml_sps_by_project.sql
+var samlProjectServiceProvidersQuery string
+
 func (q *Queries) ActiveSAMLServiceProviderByID(ctx context.Context, entityID string) (sp *SAMLServiceProvider, err error) {
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	if q.caches != nil && q.caches.samlServiceProviders != nil {
+		sp, err = q.cachedSAMLServiceProviderByID(ctx, entityID)
+	} else {
+		sp, err = q.samlServiceProviderByID(ctx, entityID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	instance := authz.GetInstance(ctx)
+	loginV2 := instance.Features().LoginV2
+	if loginV2.Required {
+		sp.LoginVersion = domain.LoginVersion2
+		sp.LoginBaseURI = loginV2.BaseURI
+	}
+	return sp, nil
+}
+
+func (q *Queries) samlServiceProviderByID(ctx context.Context, entityID string) (sp *SAMLServiceProvider, err error) {
 	err = q.client.QueryRowContext(ctx, func(row *sql.Row) error {
 		sp, err = scanSAMLServiceProviderByID(row)
 		return err
@@ -46,13 +80,129 @@ func (q *Queries) ActiveSAMLServiceProviderByID(ctx context.Context, entityID st
 	if err != nil {
 		return nil, zerrors.ThrowInternal(err, "QUERY-OyJx1Rp30z", "Errors.Internal")
 	}
-	instance := authz.GetInstance(ctx)
-	loginV2 := instance.Features().LoginV2
-	if loginV2.Required {
-		sp.LoginVersion = domain.LoginVersion2
-		sp.LoginBaseURI = loginV2.BaseURI
+	return sp, nil
+}
+
+// cachedSAMLServiceProviderByID returns the service provider from the service providers of its project, which are cached together.
+func (q *Queries) cachedSAMLServiceProviderByID(ctx context.Context, entityID string) (_ *SAMLServiceProvider, err error) {
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	project, ok := q.caches.samlServiceProviders.Get(ctx, samlSPIndexByEntityID, instanceCacheKey(instanceID, entityID))
+	if ok {
+		// the state of the organization is not part of the cached object
+		if err = q.checkOrgActive(ctx, project.ResourceOwner); err != nil {
+			return nil, err
+		}
+	} else {
+		project, err = database.QueryJSONObject[samlProjectServiceProviders](ctx, q.client, samlProjectServiceProvidersQuery, instanceID, entityID)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, zerrors.ThrowNotFound(err, "QUERY-aeT5e", "Errors.App.NotFound")
+		}
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Shoo4", "Errors.Internal")
+		}
+		q.caches.samlServiceProviders.Set(ctx, project)
 	}
-	return sp, err
+	return project.serviceProvider(entityID)
+}
+
+// samlProjectServiceProviders contains the active SAML service providers of a project.
+// The service providers are cached together, so events of the project invalidate all its service providers.
+type samlProjectServiceProviders struct {
+	InstanceID       string                       `json:"instance_id,omitempty"`
+	ProjectID        string                       `json:"project_id,omitempty"`
+	ResourceOwner    string                       `json:"resource_owner,omitempty"`
+	ServiceProviders []*samlCachedServiceProvider `json:"service_providers,omitempty"`
+}
+
+// samlCachedServiceProvider is the serializable form of [SAMLServiceProvider].
+type samlCachedServiceProvider struct {
+	InstanceID           string              `json:"instance_id,omitempty"`
+	AppID                string              `json:"app_id,omitempty"`
+	State                domain.AppState     `json:"state,omitempty"`
+	EntityID             string              `json:"entity_id,omitempty"`
+	Metadata             []byte              `json:"metadata,omitempty"`
+	MetadataURL          string              `json:"metadata_url,omitempty"`
+	ProjectID            string              `json:"project_id,omitempty"`
+	ProjectRoleAssertion bool                `json:"project_role_assertion,omitempty"`
+	LoginVersion         domain.LoginVersion `json:"login_version,omitempty"`
+	LoginBaseURI         string              `json:"login_base_uri,omitempty"`
+
+	SignatureAlgorithm  domain.SAMLSignatureAlgorithm  `json:"signature_algorithm,omitempty"`
+	DigestAlgorithm     domain.SAMLDigestAlgorithm     `json:"digest_algorithm,omitempty"`
+	AssertionEncryption domain.SAMLAssertionEncryption `json:"assertion_encryption,omitempty"`
+
+	NameIDFormat     *domain.SAMLNameIDFormat       `json:"name_id_format,omitempty"`
+	AttributeMapping []*domain.SAMLAttributeMapping `json:"attribute_mapping,omitempty"`
+}
+
+type samlSPIndex int
+
+//go:generate enumer -type samlSPIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	samlSPIndexUnspecified samlSPIndex = iota //
+	samlSPIndexByProjectID
+	samlSPIndexByEntityID
+)
+
+// Keys implements [cache.Entry]
+func (p *samlProjectServiceProviders) Keys(index samlSPIndex) []string {
+	switch index {
+	case samlSPIndexByProjectID:
+		return []string{instanceCacheKey(p.InstanceID, p.ProjectID)}
+	case samlSPIndexByEntityID:
+		keys := make([]string, len(p.ServiceProviders))
+		for i, sp := range p.ServiceProviders {
+			keys[i] = instanceCacheKey(p.InstanceID, sp.EntityID)
+		}
+		return keys
+	case samlSPIndexUnspecified:
+	}
+	return nil
+}
+
+// serviceProvider returns a new [SAMLServiceProvider] of the entity ID.
+func (p *samlProjectServiceProviders) serviceProvider(entityID string) (*SAMLServiceProvider, error) {
+	i := slices.IndexFunc(p.ServiceProviders, func(sp *samlCachedServiceProvider) bool {
+		return sp.EntityID == entityID
+	})
+	if i < 0 {
+		return nil, zerrors.ThrowNotFound(nil, "QUERY-Ohd4a", "Errors.App.NotFound")
+	}
+	cached := p.ServiceProviders[i]
+	sp := &SAMLServiceProvider{
+		InstanceID:           cached.InstanceID,
+		AppID:                cached.AppID,
+		State:                cached.State,
+		EntityID:             cached.EntityID,
+		Metadata:             cached.Metadata,
+		MetadataURL:          cached.MetadataURL,
+		ProjectID:            cached.ProjectID,
+		ProjectRoleAssertion: cached.ProjectRoleAssertion,
+		LoginVersion:         cached.LoginVersion,
+		SignatureAlgorithm:   cached.SignatureAlgorithm,
+		DigestAlgorithm:      cached.DigestAlgorithm,
+		AssertionEncryption:  cached.AssertionEncryption,
+		NameIDFormat:         cached.NameIDFormat,
+		AttributeMapping:     cached.AttributeMapping,
+	}
+	if cached.LoginBaseURI != "" {
+		loginBaseURI, err := url.Parse(cached.LoginBaseURI)
+		if err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-ieK4o", "Errors.Internal")
+		}
+		sp.LoginBaseURI = loginBaseURI
+	}
+	return sp, nil
+}
+
+func (c *Caches) registerSAMLServiceProviderInvalidation() {
+	if c.samlServiceProviders == nil {
+		return
+	}
+	invalidate := cacheInvalidationFunc(c.samlServiceProviders, samlSPIndexByProjectID, getInstanceCacheKey)
+	projection.ProjectProjection.RegisterCacheInvalidation(invalidate)
+	projection.AppProjection.RegisterCacheInvalidation(invalidate)
 }
 
 func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
@@ -60,7 +210,9 @@ func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
 	var projectRoleAssertion sql.NullBool
 	var metadata []byte
 	var state, loginVersion sql.NullInt16
+	var signatureAlgorithm, digestAlgorithm, assertionEncryption, nameIDFormat sql.NullInt16
 	var loginBaseURI sql.NullString
+	var attributeMapping []byte
 
 	err := row.Scan(
 		&instanceID,
@@ -73,6 +225,11 @@ func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
 		&projectRoleAssertion,
 		&loginVersion,
 		&loginBaseURI,
+		&signatureAlgorithm,
+		&digestAlgorithm,
+		&assertionEncryption,
+		&nameIDFormat,
+		&attributeMapping,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -89,10 +246,21 @@ func scanSAMLServiceProviderByID(row *sql.Row) (*SAMLServiceProvider, error) {
 		MetadataURL:          metadataURL.String,
 		ProjectID:            projectID.String,
 		ProjectRoleAssertion: projectRoleAssertion.Bool,
+		SignatureAlgorithm:   domain.SAMLSignatureAlgorithm(signatureAlgorithm.Int16),
+		DigestAlgorithm:      domain.SAMLDigestAlgorithm(digestAlgorithm.Int16),
+		AssertionEncryption:  domain.SAMLAssertionEncryption(assertionEncryption.Int16),
 	}
 	if loginVersion.Valid {
 		sp.LoginVersion = domain.LoginVersion(loginVersion.Int16)
 	}
+	if nameIDFormat.Valid {
+		sp.NameIDFormat = gu.Ptr(domain.SAMLNameIDFormat(nameIDFormat.Int16))
+	}
+	if len(attributeMapping) > 0 {
+		if err := json.Unmarshal(attributeMapping, &sp.AttributeMapping); err != nil {
+			return nil, zerrors.ThrowInternal(err, "QUERY-Ahng3", "Errors.Internal")
+		}
+	}
 	if loginBaseURI.Valid && loginBaseURI.String != "" {
 		url, err := url.Parse(loginBaseURI.String)
 		if err != nil {
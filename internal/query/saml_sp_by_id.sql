@@ -7,7 +7,12 @@ select c.instance_id,
        a.project_id,
        p.project_role_assertion,
        c.login_version,
-       c.login_base_uri
+       c.login_base_uri,
+       c.signature_algorithm,
+       c.digest_algorithm,
+       c.assertion_encryption,
+       c.name_id_format,
+       c.attribute_mapping
 from projections.apps7_saml_configs c
          join projections.apps7 a
               on a.id = c.app_id and a.instance_id = c.instance_id and a.state = 1
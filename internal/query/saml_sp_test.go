@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/muhlemmer/gu"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -30,6 +31,11 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 		"project_role_assertion",
 		"login_version",
 		"login_base_uri",
+		"signature_algorithm",
+		"digest_algorithm",
+		"assertion_encryption",
+		"name_id_format",
+		"attribute_mapping",
 	}
 
 	tests := []struct {
@@ -61,6 +67,11 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 				true,
 				domain.LoginVersionUnspecified,
 				"",
+				domain.SAMLSignatureAlgorithmUnspecified,
+				domain.SAMLDigestAlgorithmUnspecified,
+				domain.SAMLAssertionEncryptionNone,
+				nil,
+				nil,
 			}, "instanceID", "entityID"),
 			want: &SAMLServiceProvider{
 				InstanceID:           "230690539048009730",
@@ -86,6 +97,11 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 				true,
 				domain.LoginVersion2,
 				"https://test.com/login",
+				domain.SAMLSignatureAlgorithmRSASHA512,
+				domain.SAMLDigestAlgorithmSHA512,
+				domain.SAMLAssertionEncryptionAES128GCM,
+				domain.SAMLNameIDFormatTransient,
+				[]byte(`[{"name":"roles","source":3}]`),
 			}, "instanceID", "entityID"),
 			want: &SAMLServiceProvider{
 				InstanceID:           "230690539048009730",
@@ -101,6 +117,13 @@ func TestQueries_ActiveSAMLServiceProviderByID(t *testing.T) {
 					ret, _ := url.Parse("https://test.com/login")
 					return ret
 				}(),
+				SignatureAlgorithm:  domain.SAMLSignatureAlgorithmRSASHA512,
+				DigestAlgorithm:     domain.SAMLDigestAlgorithmSHA512,
+				AssertionEncryption: domain.SAMLAssertionEncryptionAES128GCM,
+				NameIDFormat:        gu.Ptr(domain.SAMLNameIDFormatTransient),
+				AttributeMapping: []*domain.SAMLAttributeMapping{
+					{Name: "roles", Source: domain.SAMLAttributeSourceRoles},
+				},
 			},
 		},
 	}
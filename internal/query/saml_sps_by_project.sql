@@ -0,0 +1,36 @@
+with project as (
+	select p.instance_id, p.id as project_id, p.resource_owner
+	from projections.apps7_saml_configs c
+	join projections.apps7 a on a.id = c.app_id and a.instance_id = c.instance_id
+	join projections.projects4 p on p.id = a.project_id and p.instance_id = a.instance_id
+	where c.instance_id = $1
+		and c.entity_id = $2
+),
+service_providers as (
+	select c.instance_id,
+		c.app_id,
+		a.state,
+		c.entity_id,
+		encode(c.metadata, 'base64') as metadata,
+		c.metadata_url,
+		a.project_id,
+		p.project_role_assertion,
+		c.login_version,
+		c.login_base_uri,
+		c.signature_algorithm,
+		c.digest_algorithm,
+		c.assertion_encryption,
+		c.name_id_format,
+		c.attribute_mapping
+	from project pr
+	join projections.projects4 p on p.id = pr.project_id and p.instance_id = pr.instance_id and p.state = 1
+	join projections.orgs1 o on o.id = p.resource_owner and o.instance_id = p.instance_id and o.org_state = 1
+	join projections.apps7 a on a.project_id = p.id and a.instance_id = p.instance_id and a.state = 1
+	join projections.apps7_saml_configs c on c.app_id = a.id and c.instance_id = a.instance_id
+)
+
+select row_to_json(r) as project from (
+	select pr.instance_id, pr.project_id, pr.resource_owner,
+		(select json_agg(sp) from service_providers sp) as service_providers
+	from project pr
+) r;
@@ -23,6 +23,9 @@ type SearchRequest struct {
 	Limit         uint64
 	SortingColumn Column
 	Asc           bool
+	// TieBreakerColumn is ordered after the SortingColumn in the same direction.
+	// It ensures a stable order if the values of the SortingColumn are not unique.
+	TieBreakerColumn Column
 }
 
 func (req *SearchRequest) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
@@ -41,6 +44,14 @@ func (req *SearchRequest) toQuery(query sq.SelectBuilder) sq.SelectBuilder {
 		query = query.OrderByClause(clause)
 	}
 
+	if !req.TieBreakerColumn.isZero() && req.TieBreakerColumn != req.SortingColumn {
+		clause := req.TieBreakerColumn.orderBy()
+		if !req.Asc {
+			clause += " DESC"
+		}
+		query = query.OrderByClause(clause)
+	}
+
 	return query
 }
 
@@ -300,6 +311,8 @@ func NewTextQuery(col Column, value string, compare TextComparison) (*textQuery,
 	case TextEquals,
 		TextListContains,
 		TextNotEquals,
+		TextGreater,
+		TextLess,
 		textCompareMax:
 		// do nothing
 	}
@@ -352,6 +365,10 @@ func (q *textQuery) comp() sq.Sqlizer {
 		return sq.Like{"LOWER(" + q.Column.identifier() + ")": "%" + strings.ToLower(q.Text) + "%"}
 	case TextListContains:
 		return &listContains{col: q.Column, args: []any{q.Text}}
+	case TextGreater:
+		return sq.Gt{q.Column.identifier(): q.Text}
+	case TextLess:
+		return sq.Lt{q.Column.identifier(): q.Text}
 	case textCompareMax:
 		return nil
 	}
@@ -373,6 +390,8 @@ const (
 	TextListContains
 	TextNotEquals
 	TextNotEqualsIgnoreCase
+	TextGreater
+	TextLess
 
 	textCompareMax
 )
@@ -711,6 +730,11 @@ var (
 	countColumn = Column{
 		name: "COUNT(*) OVER ()",
 	}
+	// noCountColumn replaces the countColumn if the total count is not required,
+	// which avoids the window function over all matching rows
+	noCountColumn = Column{
+		name: "0",
+	}
 	// uniqueColumn shows if there are any results
 	uniqueColumn = Column{
 		name: "COUNT(*) = 0",
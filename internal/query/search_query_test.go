@@ -50,10 +50,11 @@ var (
 
 func TestSearchRequest_ToQuery(t *testing.T) {
 	type fields struct {
-		Offset        uint64
-		Limit         uint64
-		SortingColumn Column
-		Asc           bool
+		Offset           uint64
+		Limit            uint64
+		SortingColumn    Column
+		Asc              bool
+		TieBreakerColumn Column
 	}
 	type want struct {
 		stmtAddition string
@@ -117,6 +118,38 @@ func TestSearchRequest_ToQuery(t *testing.T) {
 				stmtAddition: "ORDER BY LOWER(test_table.test_lower_col)",
 			},
 		},
+		{
+			name: "sort tie breaker asc",
+			fields: fields{
+				SortingColumn:    testLowerCol,
+				TieBreakerColumn: testCol,
+				Asc:              true,
+			},
+			want: want{
+				stmtAddition: "ORDER BY LOWER(test_table.test_lower_col), test_table.test_col",
+			},
+		},
+		{
+			name: "sort tie breaker desc",
+			fields: fields{
+				SortingColumn:    testLowerCol,
+				TieBreakerColumn: testCol,
+			},
+			want: want{
+				stmtAddition: "ORDER BY LOWER(test_table.test_lower_col) DESC, test_table.test_col DESC",
+			},
+		},
+		{
+			name: "sort tie breaker same column",
+			fields: fields{
+				SortingColumn:    testCol,
+				TieBreakerColumn: testCol,
+				Asc:              true,
+			},
+			want: want{
+				stmtAddition: "ORDER BY test_table.test_col",
+			},
+		},
 		{
 			name: "all",
 			fields: fields{
@@ -133,10 +166,11 @@ func TestSearchRequest_ToQuery(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			req := &SearchRequest{
-				Offset:        tt.fields.Offset,
-				Limit:         tt.fields.Limit,
-				SortingColumn: tt.fields.SortingColumn,
-				Asc:           tt.fields.Asc,
+				Offset:           tt.fields.Offset,
+				Limit:            tt.fields.Limit,
+				SortingColumn:    tt.fields.SortingColumn,
+				Asc:              tt.fields.Asc,
+				TieBreakerColumn: tt.fields.TieBreakerColumn,
 			}
 
 			query := sq.Select((testCol).identifier()).From(testTable.identifier())
@@ -1229,6 +1263,28 @@ func TestTextQuery_comp(t *testing.T) {
 				query: sq.NotLike{"LOWER(test_table.test_col)": "hurst"},
 			},
 		},
+		{
+			name: "greater",
+			fields: fields{
+				Column:  testCol,
+				Text:    "Hurst",
+				Compare: TextGreater,
+			},
+			want: want{
+				query: sq.Gt{"test_table.test_col": "Hurst"},
+			},
+		},
+		{
+			name: "less",
+			fields: fields{
+				Column:  testCol,
+				Text:    "Hurst",
+				Compare: TextLess,
+			},
+			want: want{
+				query: sq.Lt{"test_table.test_col": "Hurst"},
+			},
+		},
 		{
 			name: "equals ignore case wildcard",
 			fields: fields{
@@ -60,6 +60,9 @@ type SessionPasswordFactor struct {
 
 type SessionIntentFactor struct {
 	IntentCheckedAt time.Time
+	IDPID           string
+	// IDPSessionID is the session ID (sid) at the identity provider, if provided.
+	IDPSessionID string
 }
 
 type SessionWebAuthNFactor struct {
@@ -196,6 +199,14 @@ var (
 		name:  projection.SessionColumnIntentCheckedAt,
 		table: sessionsTable,
 	}
+	SessionColumnIntentIDPID = Column{
+		name:  projection.SessionColumnIntentIDPID,
+		table: sessionsTable,
+	}
+	SessionColumnIntentIDPSessionID = Column{
+		name:  projection.SessionColumnIntentIDPSessionID,
+		table: sessionsTable,
+	}
 	SessionColumnWebAuthNCheckedAt = Column{
 		name:  projection.SessionColumnWebAuthNCheckedAt,
 		table: sessionsTable,
@@ -358,6 +369,14 @@ func NewUserIDSearchQuery(id string) (SearchQuery, error) {
 	return NewTextQuery(SessionColumnUserID, id, TextEquals)
 }
 
+func NewSessionIntentIDPIDSearchQuery(idpID string) (SearchQuery, error) {
+	return NewTextQuery(SessionColumnIntentIDPID, idpID, TextEquals)
+}
+
+func NewSessionIntentIDPSessionIDSearchQuery(idpSessionID string) (SearchQuery, error) {
+	return NewTextQuery(SessionColumnIntentIDPSessionID, idpSessionID, TextEquals)
+}
+
 func NewCreationDateQuery(datetime time.Time, compare TimestampComparison) (SearchQuery, error) {
 	return NewTimestampQuery(SessionColumnCreationDate, datetime, compare)
 }
@@ -378,6 +397,8 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			HumanDisplayNameCol.identifier(),
 			SessionColumnPasswordCheckedAt.identifier(),
 			SessionColumnIntentCheckedAt.identifier(),
+			SessionColumnIntentIDPID.identifier(),
+			SessionColumnIntentIDPSessionID.identifier(),
 			SessionColumnWebAuthNCheckedAt.identifier(),
 			SessionColumnWebAuthNUserVerified.identifier(),
 			SessionColumnTOTPCheckedAt.identifier(),
@@ -405,6 +426,8 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				displayName         sql.NullString
 				passwordCheckedAt   sql.NullTime
 				intentCheckedAt     sql.NullTime
+				intentIDPID         sql.NullString
+				intentIDPSessionID  sql.NullString
 				webAuthNCheckedAt   sql.NullTime
 				webAuthNUserPresent sql.NullBool
 				totpCheckedAt       sql.NullTime
@@ -432,6 +455,8 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 				&displayName,
 				&passwordCheckedAt,
 				&intentCheckedAt,
+				&intentIDPID,
+				&intentIDPSessionID,
 				&webAuthNCheckedAt,
 				&webAuthNUserPresent,
 				&totpCheckedAt,
@@ -460,6 +485,8 @@ func prepareSessionQuery() (sq.SelectBuilder, func(*sql.Row) (*Session, string,
 			session.UserFactor.DisplayName = displayName.String
 			session.PasswordFactor.PasswordCheckedAt = passwordCheckedAt.Time
 			session.IntentFactor.IntentCheckedAt = intentCheckedAt.Time
+			session.IntentFactor.IDPID = intentIDPID.String
+			session.IntentFactor.IDPSessionID = intentIDPSessionID.String
 			session.WebAuthNFactor.WebAuthNCheckedAt = webAuthNCheckedAt.Time
 			session.WebAuthNFactor.UserVerified = webAuthNUserPresent.Bool
 			session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
@@ -491,6 +518,8 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 			HumanDisplayNameCol.identifier(),
 			SessionColumnPasswordCheckedAt.identifier(),
 			SessionColumnIntentCheckedAt.identifier(),
+			SessionColumnIntentIDPID.identifier(),
+			SessionColumnIntentIDPSessionID.identifier(),
 			SessionColumnWebAuthNCheckedAt.identifier(),
 			SessionColumnWebAuthNUserVerified.identifier(),
 			SessionColumnTOTPCheckedAt.identifier(),
@@ -521,6 +550,8 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					displayName         sql.NullString
 					passwordCheckedAt   sql.NullTime
 					intentCheckedAt     sql.NullTime
+					intentIDPID         sql.NullString
+					intentIDPSessionID  sql.NullString
 					webAuthNCheckedAt   sql.NullTime
 					webAuthNUserPresent sql.NullBool
 					totpCheckedAt       sql.NullTime
@@ -547,6 +578,8 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 					&displayName,
 					&passwordCheckedAt,
 					&intentCheckedAt,
+					&intentIDPID,
+					&intentIDPSessionID,
 					&webAuthNCheckedAt,
 					&webAuthNUserPresent,
 					&totpCheckedAt,
@@ -571,6 +604,8 @@ func prepareSessionsQuery() (sq.SelectBuilder, func(*sql.Rows) (*Sessions, error
 				session.UserFactor.DisplayName = displayName.String
 				session.PasswordFactor.PasswordCheckedAt = passwordCheckedAt.Time
 				session.IntentFactor.IntentCheckedAt = intentCheckedAt.Time
+				session.IntentFactor.IDPID = intentIDPID.String
+				session.IntentFactor.IDPSessionID = intentIDPSessionID.String
 				session.WebAuthNFactor.WebAuthNCheckedAt = webAuthNCheckedAt.Time
 				session.WebAuthNFactor.UserVerified = webAuthNUserPresent.Bool
 				session.TOTPFactor.TOTPCheckedAt = totpCheckedAt.Time
@@ -35,6 +35,8 @@ var (
 		` projections.users14_humans.display_name,` +
 		` projections.sessions8.password_checked_at,` +
 		` projections.sessions8.intent_checked_at,` +
+		` projections.sessions8.intent_idp_id,` +
+		` projections.sessions8.intent_idp_session_id,` +
 		` projections.sessions8.webauthn_checked_at,` +
 		` projections.sessions8.webauthn_user_verified,` +
 		` projections.sessions8.totp_checked_at,` +
@@ -65,6 +67,8 @@ var (
 		` projections.users14_humans.display_name,` +
 		` projections.sessions8.password_checked_at,` +
 		` projections.sessions8.intent_checked_at,` +
+		` projections.sessions8.intent_idp_id,` +
+		` projections.sessions8.intent_idp_session_id,` +
 		` projections.sessions8.webauthn_checked_at,` +
 		` projections.sessions8.webauthn_user_verified,` +
 		` projections.sessions8.totp_checked_at,` +
@@ -97,6 +101,8 @@ var (
 		"display_name",
 		"password_checked_at",
 		"intent_checked_at",
+		"intent_idp_id",
+		"intent_idp_session_id",
 		"webauthn_checked_at",
 		"webauthn_user_verified",
 		"totp_checked_at",
@@ -126,6 +132,8 @@ var (
 		"display_name",
 		"password_checked_at",
 		"intent_checked_at",
+		"intent_idp_id",
+		"intent_idp_session_id",
 		"webauthn_checked_at",
 		"webauthn_user_verified",
 		"totp_checked_at",
@@ -187,6 +195,8 @@ func Test_SessionsPrepare(t *testing.T) {
 							"display-name",
 							testNow,
 							testNow,
+							"idp-id",
+							"idp-session-id",
 							testNow,
 							true,
 							testNow,
@@ -227,6 +237,8 @@ func Test_SessionsPrepare(t *testing.T) {
 						},
 						IntentFactor: SessionIntentFactor{
 							IntentCheckedAt: testNow,
+							IDPID:           "idp-id",
+							IDPSessionID:    "idp-session-id",
 						},
 						WebAuthNFactor: SessionWebAuthNFactor{
 							WebAuthNCheckedAt: testNow,
@@ -278,6 +290,8 @@ func Test_SessionsPrepare(t *testing.T) {
 							"display-name",
 							testNow,
 							testNow,
+							"idp-id",
+							"idp-session-id",
 							testNow,
 							true,
 							testNow,
@@ -305,6 +319,8 @@ func Test_SessionsPrepare(t *testing.T) {
 							"display-name2",
 							testNow,
 							testNow,
+							"idp-id",
+							"idp-session-id",
 							testNow,
 							false,
 							testNow,
@@ -345,6 +361,8 @@ func Test_SessionsPrepare(t *testing.T) {
 						},
 						IntentFactor: SessionIntentFactor{
 							IntentCheckedAt: testNow,
+							IDPID:           "idp-id",
+							IDPSessionID:    "idp-session-id",
 						},
 						WebAuthNFactor: SessionWebAuthNFactor{
 							WebAuthNCheckedAt: testNow,
@@ -390,6 +408,8 @@ func Test_SessionsPrepare(t *testing.T) {
 						},
 						IntentFactor: SessionIntentFactor{
 							IntentCheckedAt: testNow,
+							IDPID:           "idp-id",
+							IDPSessionID:    "idp-session-id",
 						},
 						WebAuthNFactor: SessionWebAuthNFactor{
 							WebAuthNCheckedAt: testNow,
@@ -494,6 +514,8 @@ func Test_SessionPrepare(t *testing.T) {
 						"display-name",
 						testNow,
 						testNow,
+						"idp-id",
+						"idp-session-id",
 						testNow,
 						true,
 						testNow,
@@ -529,6 +551,8 @@ func Test_SessionPrepare(t *testing.T) {
 				},
 				IntentFactor: SessionIntentFactor{
 					IntentCheckedAt: testNow,
+					IDPID:           "idp-id",
+					IDPSessionID:    "idp-session-id",
 				},
 				WebAuthNFactor: SessionWebAuthNFactor{
 					WebAuthNCheckedAt: testNow,
@@ -3,6 +3,7 @@ package query
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -64,6 +65,10 @@ var (
 		name:  projection.TargetSigningKey,
 		table: targetTable,
 	}
+	TargetColumnRetryPolicy = Column{
+		name:  projection.TargetRetryPolicyCol,
+		table: targetTable,
+	}
 )
 
 type Targets struct {
@@ -85,6 +90,7 @@ type Target struct {
 	InterruptOnError bool
 	signingKey       *crypto.CryptoValue
 	SigningKey       string
+	RetryPolicy      *domain.TargetRetryPolicy
 }
 
 func (t *Target) decryptSigningKey(alg crypto.EncryptionAlgorithm) error {
@@ -165,6 +171,7 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnRetryPolicy.identifier(),
 			countColumn.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
@@ -173,6 +180,7 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 			var count uint64
 			for rows.Next() {
 				target := new(Target)
+				var retryPolicy []byte
 				err := rows.Scan(
 					&target.ID,
 					&target.CreationDate,
@@ -184,11 +192,15 @@ func prepareTargetsQuery() (sq.SelectBuilder, func(rows *sql.Rows) (*Targets, er
 					&target.Endpoint,
 					&target.InterruptOnError,
 					&target.signingKey,
+					&retryPolicy,
 					&count,
 				)
 				if err != nil {
 					return nil, err
 				}
+				if target.RetryPolicy, err = unmarshalRetryPolicy(retryPolicy); err != nil {
+					return nil, err
+				}
 				targets = append(targets, target)
 			}
 
@@ -217,10 +229,12 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 			TargetColumnURL.identifier(),
 			TargetColumnInterruptOnError.identifier(),
 			TargetColumnSigningKey.identifier(),
+			TargetColumnRetryPolicy.identifier(),
 		).From(targetTable.identifier()).
 			PlaceholderFormat(sq.Dollar),
 		func(row *sql.Row) (*Target, error) {
 			target := new(Target)
+			var retryPolicy []byte
 			err := row.Scan(
 				&target.ID,
 				&target.CreationDate,
@@ -232,6 +246,7 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				&target.Endpoint,
 				&target.InterruptOnError,
 				&target.signingKey,
+				&retryPolicy,
 			)
 			if err != nil {
 				if errors.Is(err, sql.ErrNoRows) {
@@ -239,6 +254,22 @@ func prepareTargetQuery() (sq.SelectBuilder, func(row *sql.Row) (*Target, error)
 				}
 				return nil, zerrors.ThrowInternal(err, "QUERY-5qhc19sc49", "Errors.Internal")
 			}
+			target.RetryPolicy, err = unmarshalRetryPolicy(retryPolicy)
+			if err != nil {
+				return nil, err
+			}
 			return target, nil
 		}
 }
+
+// unmarshalRetryPolicy returns nil if the target has no retry policy.
+func unmarshalRetryPolicy(data []byte) (*domain.TargetRetryPolicy, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	retryPolicy := new(domain.TargetRetryPolicy)
+	if err := json.Unmarshal(data, retryPolicy); err != nil {
+		return nil, zerrors.ThrowInternal(err, "QUERY-w2n6kx8rbt", "Errors.Internal")
+	}
+	return retryPolicy, nil
+}
@@ -15,18 +15,19 @@ import (
 )
 
 var (
-	prepareTargetsStmt = `SELECT projections.targets2.id,` +
-		` projections.targets2.creation_date,` +
-		` projections.targets2.change_date,` +
-		` projections.targets2.resource_owner,` +
-		` projections.targets2.name,` +
-		` projections.targets2.target_type,` +
-		` projections.targets2.timeout,` +
-		` projections.targets2.endpoint,` +
-		` projections.targets2.interrupt_on_error,` +
-		` projections.targets2.signing_key,` +
+	prepareTargetsStmt = `SELECT projections.targets3.id,` +
+		` projections.targets3.creation_date,` +
+		` projections.targets3.change_date,` +
+		` projections.targets3.resource_owner,` +
+		` projections.targets3.name,` +
+		` projections.targets3.target_type,` +
+		` projections.targets3.timeout,` +
+		` projections.targets3.endpoint,` +
+		` projections.targets3.interrupt_on_error,` +
+		` projections.targets3.signing_key,` +
+		` projections.targets3.retry_policy,` +
 		` COUNT(*) OVER ()` +
-		` FROM projections.targets2`
+		` FROM projections.targets3`
 	prepareTargetsCols = []string{
 		"id",
 		"creation_date",
@@ -38,20 +39,22 @@ var (
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"retry_policy",
 		"count",
 	}
 
-	prepareTargetStmt = `SELECT projections.targets2.id,` +
-		` projections.targets2.creation_date,` +
-		` projections.targets2.change_date,` +
-		` projections.targets2.resource_owner,` +
-		` projections.targets2.name,` +
-		` projections.targets2.target_type,` +
-		` projections.targets2.timeout,` +
-		` projections.targets2.endpoint,` +
-		` projections.targets2.interrupt_on_error,` +
-		` projections.targets2.signing_key` +
-		` FROM projections.targets2`
+	prepareTargetStmt = `SELECT projections.targets3.id,` +
+		` projections.targets3.creation_date,` +
+		` projections.targets3.change_date,` +
+		` projections.targets3.resource_owner,` +
+		` projections.targets3.name,` +
+		` projections.targets3.target_type,` +
+		` projections.targets3.timeout,` +
+		` projections.targets3.endpoint,` +
+		` projections.targets3.interrupt_on_error,` +
+		` projections.targets3.signing_key,` +
+		` projections.targets3.retry_policy` +
+		` FROM projections.targets3`
 	prepareTargetCols = []string{
 		"id",
 		"creation_date",
@@ -63,6 +66,7 @@ var (
 		"endpoint",
 		"interrupt_on_error",
 		"signing_key",
+		"retry_policy",
 	}
 )
 
@@ -113,6 +117,7 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
 						},
 					},
 				),
@@ -168,6 +173,7 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
 						},
 						{
 							"id-2",
@@ -185,6 +191,7 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							nil,
 						},
 						{
 							"id-3",
@@ -202,6 +209,7 @@ func Test_TargetPrepares(t *testing.T) {
 								KeyID:      "encKey",
 								Crypted:    []byte("crypted"),
 							},
+							[]byte(`{"maxAttempts":3,"initialBackoff":1000000000}`),
 						},
 					},
 				),
@@ -267,6 +275,10 @@ func Test_TargetPrepares(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						RetryPolicy: &domain.TargetRetryPolicy{
+							MaxAttempts:    3,
+							InitialBackoff: time.Second,
+						},
 					},
 				},
 			},
@@ -329,6 +341,7 @@ func Test_TargetPrepares(t *testing.T) {
 							KeyID:      "encKey",
 							Crypted:    []byte("crypted"),
 						},
+						nil,
 					},
 				),
 			},
@@ -15,7 +15,9 @@ import (
 	"github.com/zitadel/zitadel/internal/api/authz"
 	"github.com/zitadel/zitadel/internal/database"
 	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/query/projection"
+	"github.com/zitadel/zitadel/internal/repository/user"
 	"github.com/zitadel/zitadel/internal/telemetry/tracing"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -38,6 +40,9 @@ type User struct {
 	PreferredLoginName string                     `json:"preferred_login_name,omitempty"`
 	Human              *Human                     `json:"human,omitempty"`
 	Machine            *Machine                   `json:"machine,omitempty"`
+	// InstanceID is used to create a unique cache key for the user
+	// and must be serialized, so the key can be recomputed from a shared cache.
+	InstanceID string `json:"instance_id,omitempty"`
 }
 
 type Human struct {
@@ -153,6 +158,9 @@ func userPermissionCheckV2WithCustomColumns(ctx context.Context, query sq.Select
 type UserSearchQueries struct {
 	SearchRequest
 	Queries []SearchQuery
+	// WithoutTotalCount skips counting all matching users,
+	// the count of the search response is always 0.
+	WithoutTotalCount bool
 }
 
 var (
@@ -386,6 +394,15 @@ func (q *Queries) GetUserByIDWithResourceOwner(ctx context.Context, shouldTrigge
 	ctx, span := tracing.NewSpan(ctx)
 	defer func() { span.EndWithError(err) }()
 
+	instanceID := authz.GetInstance(ctx).InstanceID()
+	// A triggered read skips the cache, as the latest state is requested.
+	if !shouldTriggerBulk {
+		cached, ok := q.caches.user.Get(ctx, userIndexByID, instanceCacheKey(instanceID, userID))
+		// the query returns not found for a user of another resource owner.
+		if ok && (resourceOwner == "" || cached.ResourceOwner == resourceOwner) {
+			return cached, nil
+		}
+	}
 	if shouldTriggerBulk {
 		triggerUserProjections(ctx)
 	}
@@ -398,9 +415,14 @@ func (q *Queries) GetUserByIDWithResourceOwner(ctx context.Context, shouldTrigge
 		userByIDQuery,
 		userID,
 		resourceOwner,
-		authz.GetInstance(ctx).InstanceID(),
+		instanceID,
 	)
-	return user, err
+	if err != nil {
+		return nil, err
+	}
+	user.InstanceID = instanceID
+	q.caches.user.Set(ctx, user)
+	return user, nil
 }
 
 //go:embed user_by_login_name.sql
@@ -635,6 +657,9 @@ func (q *Queries) searchUsers(ctx context.Context, queries *UserSearchQueries, p
 	defer func() { span.EndWithError(err) }()
 
 	query, scan := prepareUsersQuery()
+	if queries.WithoutTotalCount {
+		query, scan = prepareUsersQueryWithoutTotalCount()
+	}
 	query = userPermissionCheckV2(ctx, query, permissionCheckV2, queries.Queries)
 	stmt, args, err := queries.toQuery(query).Where(sq.Eq{
 		UserInstanceIDCol.identifier(): authz.GetInstance(ctx).InstanceID(),
@@ -1291,6 +1316,14 @@ func prepareUserUniqueQuery() (sq.SelectBuilder, func(*sql.Row) (bool, error)) {
 }
 
 func prepareUsersQuery() (sq.SelectBuilder, func(*sql.Rows) (*Users, error)) {
+	return prepareUsersQueryWithCount(countColumn)
+}
+
+func prepareUsersQueryWithoutTotalCount() (sq.SelectBuilder, func(*sql.Rows) (*Users, error)) {
+	return prepareUsersQueryWithCount(noCountColumn)
+}
+
+func prepareUsersQueryWithCount(count Column) (sq.SelectBuilder, func(*sql.Rows) (*Users, error)) {
 	return sq.Select(
 			UserIDCol.identifier(),
 			UserCreationDateCol.identifier(),
@@ -1321,7 +1354,7 @@ func prepareUsersQuery() (sq.SelectBuilder, func(*sql.Rows) (*Users, error)) {
 			MachineDescriptionCol.identifier(),
 			MachineSecretCol.identifier(),
 			MachineAccessTokenTypeCol.identifier(),
-			countColumn.identifier()).
+			count.identifier()).
 			From(userTable.identifier()).
 			LeftJoin(join(HumanUserIDCol, UserIDCol)).
 			LeftJoin(join(MachineUserIDCol, UserIDCol)).
@@ -1437,3 +1470,31 @@ func prepareUsersQuery() (sq.SelectBuilder, func(*sql.Rows) (*Users, error)) {
 			}, nil
 		}
 }
+
+type userIndex int
+
+//go:generate enumer -type userIndex -linecomment
+const (
+	// Empty line comment ensures empty string for unspecified value
+	userIndexUnspecified userIndex = iota //
+	userIndexByID
+)
+
+// Keys implements [cache.Entry]
+func (u *User) Keys(index userIndex) []string {
+	if index == userIndexByID {
+		return []string{instanceCacheKey(u.InstanceID, u.ID)}
+	}
+	return nil
+}
+
+func (c *Caches) registerUserInvalidation() {
+	projection.UserProjection.RegisterCacheInvalidation(cacheInvalidationFunc(c.user, userIndexByID, getInstanceCacheKey))
+	// Login names depend on the domains and domain policy of the organization or instance,
+	// which can't be mapped to the users, so the cache is truncated.
+	projection.LoginNameProjection.RegisterCacheInvalidation(cacheInvalidationOrTruncateFunc(c.user, userIndexByID, isNotUserAggregate))
+}
+
+func isNotUserAggregate(aggregate *eventstore.Aggregate) bool {
+	return aggregate.Type != user.AggregateType
+}
@@ -0,0 +1,17 @@
+-- find the groups the user is a direct member of and all groups they are nested in
+with recursive user_groups as (
+	select group_id
+	from projections.groups_members
+	where instance_id = $1
+	and user_id = $2
+	union
+	select n.group_id
+	from projections.groups_nested_groups n
+	join user_groups g on n.nested_group_id = g.group_id
+	where n.instance_id = $1
+)
+select id, creation_date, change_date, sequence, resource_owner, group_id, project_id, project_grant_id, roles
+from projections.groups_grants
+where instance_id = $1
+and project_id = $3
+and group_id in (select group_id from user_groups);
@@ -0,0 +1,68 @@
+package query
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/repository/user"
+	"github.com/zitadel/zitadel/internal/telemetry/tracing"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+// UserIDPIDTokenReadModel holds the id_token of the last login of a user agent with an identity provider.
+type UserIDPIDTokenReadModel struct {
+	*eventstore.ReadModel
+
+	UserAgentID string
+	IDPConfigID string
+
+	IDToken string
+}
+
+// UserIDPIDToken returns the id_token issued by the identity provider on the last login of the user agent of a (V1) session,
+// which is sent as id_token_hint on the federated logout.
+// The user agent is already signed out when the federated logout is started, so the sign out does not reset the id_token.
+// An empty string is returned, if the identity provider did not issue an id_token.
+func (q *Queries) UserIDPIDToken(ctx context.Context, userID, userAgentID, idpConfigID string) (idToken string, err error) {
+	ctx, span := tracing.NewSpan(ctx)
+	defer func() { span.EndWithError(err) }()
+
+	if userID == "" || userAgentID == "" || idpConfigID == "" {
+		return "", zerrors.ThrowInvalidArgument(nil, "QUERY-aeT4j", "Errors.IDMissing")
+	}
+	readModel := NewUserIDPIDTokenReadModel(userID, userAgentID, idpConfigID)
+	if err = q.eventstore.FilterToQueryReducer(ctx, readModel); err != nil {
+		return "", err
+	}
+	return readModel.IDToken, nil
+}
+
+func NewUserIDPIDTokenReadModel(userID, userAgentID, idpConfigID string) *UserIDPIDTokenReadModel {
+	return &UserIDPIDTokenReadModel{
+		ReadModel: &eventstore.ReadModel{
+			AggregateID: userID,
+		},
+		UserAgentID: userAgentID,
+		IDPConfigID: idpConfigID,
+	}
+}
+
+func (rm *UserIDPIDTokenReadModel) Reduce() error {
+	for _, event := range rm.Events {
+		e, ok := event.(*user.UserIDPCheckSucceededEvent)
+		if !ok || e.AuthRequestInfo == nil || e.UserAgentID != rm.UserAgentID || e.SelectedIDPConfigID != rm.IDPConfigID {
+			continue
+		}
+		rm.IDToken = e.IDPIDToken
+	}
+	return rm.ReadModel.Reduce()
+}
+
+func (rm *UserIDPIDTokenReadModel) Query() *eventstore.SearchQueryBuilder {
+	return eventstore.NewSearchQueryBuilder(eventstore.ColumnsEvent).
+		AddQuery().
+		AggregateTypes(user.AggregateType).
+		AggregateIDs(rm.AggregateID).
+		EventTypes(user.UserIDPLoginCheckSucceededType).
+		Builder()
+}
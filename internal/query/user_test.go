@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -434,10 +436,24 @@ var (
 		"access_token_type",
 		"count",
 	}
-	countUsersQuery = "SELECT COUNT(*) OVER () FROM projections.users14"
-	countUsersCols  = []string{"count"}
+	usersWithoutTotalCountQuery = strings.Replace(usersQuery, "COUNT(*) OVER ()", "0", 1)
+	countUsersQuery             = "SELECT COUNT(*) OVER () FROM projections.users14"
+	countUsersCols              = []string{"count"}
 )
 
+func TestUser_Keys(t *testing.T) {
+	user := &User{ID: "user1", InstanceID: "instance1"}
+	want := []string{instanceCacheKey("instance1", "user1")}
+	assert.Equal(t, want, user.Keys(userIndexByID))
+
+	// the keys are recomputed after decoding the user from a shared cache.
+	data, err := json.Marshal(user)
+	require.NoError(t, err)
+	decoded := new(User)
+	require.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, want, decoded.Keys(userIndexByID))
+}
+
 func Test_UserPrepares(t *testing.T) {
 	type want struct {
 		sqlExpectations sqlExpectation
@@ -1184,6 +1200,24 @@ func Test_UserPrepares(t *testing.T) {
 			},
 			object: (*Users)(nil),
 		},
+		{
+			name:    "prepareUsersQueryWithoutTotalCount no result",
+			prepare: prepareUsersQueryWithoutTotalCount,
+			want: want{
+				sqlExpectations: mockQuery(
+					regexp.QuoteMeta(usersWithoutTotalCountQuery),
+					nil,
+					nil,
+				),
+				err: func(err error) (error, bool) {
+					if !zerrors.IsNotFound(err) {
+						return fmt.Errorf("err should be zitadel.NotFoundError got: %w", err), false
+					}
+					return nil, true
+				},
+			},
+			object: &Users{Users: []*User{}},
+		},
 		{
 			name:    "prepareCountUsersQuery no result",
 			prepare: prepareCountUsersQuery,
@@ -2,6 +2,9 @@ package queue
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
@@ -110,6 +113,21 @@ func WithQueueName(name string) InsertOpt {
 	}
 }
 
+// WithScheduledAt delays the job until the given time.
+func WithScheduledAt(scheduledAt time.Time) InsertOpt {
+	return func(opts *river.InsertOpts) {
+		opts.ScheduledAt = scheduledAt
+	}
+}
+
+// WithMetadata stores the metadata on the job, which allows to filter jobs using [river.JobListParams.Metadata].
+func WithMetadata(metadata map[string]string) InsertOpt {
+	return func(opts *river.InsertOpts) {
+		// marshalling a map of strings can't fail
+		opts.Metadata, _ = json.Marshal(metadata)
+	}
+}
+
 func (q *Queue) Insert(ctx context.Context, args river.JobArgs, opts ...InsertOpt) error {
 	options := new(river.InsertOpts)
 	ctx = WithQueue(ctx)
@@ -120,6 +138,34 @@ func (q *Queue) Insert(ctx context.Context, args river.JobArgs, opts ...InsertOp
 	return err
 }
 
+// ErrNotStarted is returned if jobs are inspected before the queue is started.
+var ErrNotStarted = errors.New("queue is not started")
+
+// ListJobs lists the jobs matching the params.
+func (q *Queue) ListJobs(ctx context.Context, params *river.JobListParams) (*river.JobListResult, error) {
+	if q == nil || q.client == nil {
+		return nil, ErrNotStarted
+	}
+	return q.client.JobList(WithQueue(ctx), params)
+}
+
+// GetJob returns the job with the given id.
+func (q *Queue) GetJob(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	if q == nil || q.client == nil {
+		return nil, ErrNotStarted
+	}
+	return q.client.JobGet(WithQueue(ctx), id)
+}
+
+// RetryJob makes the job immediately available again,
+// discarded jobs get one more attempt.
+func (q *Queue) RetryJob(ctx context.Context, id int64) (*rivertype.JobRow, error) {
+	if q == nil || q.client == nil {
+		return nil, ErrNotStarted
+	}
+	return q.client.JobRetry(WithQueue(ctx), id)
+}
+
 type Worker interface {
 	Register(workers *river.Workers, queues map[string]river.QueueConfig)
 }
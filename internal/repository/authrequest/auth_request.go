@@ -17,28 +17,35 @@ const (
 	SessionLinkedType      = authRequestEventPrefix + "session.linked"
 	CodeExchangedType      = authRequestEventPrefix + "code.exchanged"
 	SucceededType          = authRequestEventPrefix + "succeeded"
+	PushedType             = authRequestEventPrefix + "pushed"
+	PushedConsumedType     = authRequestEventPrefix + "pushed.consumed"
+
+	// UniquePushedConsumed ensures the request_uri of a pushed auth request is only used once, even if it is used concurrently.
+	UniquePushedConsumed    = "auth_request_pushed_consumed"
+	DuplicatePushedConsumed = "Errors.AuthRequest.AlreadyHandled"
 )
 
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	LoginClient      string                    `json:"login_client"`
-	ClientID         string                    `json:"client_id"`
-	RedirectURI      string                    `json:"redirect_uri"`
-	State            string                    `json:"state,omitempty"`
-	Nonce            string                    `json:"nonce,omitempty"`
-	Scope            []string                  `json:"scope,omitempty"`
-	Audience         []string                  `json:"audience,omitempty"`
-	ResponseType     domain.OIDCResponseType   `json:"response_type,omitempty"`
-	ResponseMode     domain.OIDCResponseMode   `json:"response_mode,omitempty"`
-	CodeChallenge    *domain.OIDCCodeChallenge `json:"code_challenge,omitempty"`
-	Prompt           []domain.Prompt           `json:"prompt,omitempty"`
-	UILocales        []string                  `json:"ui_locales,omitempty"`
-	MaxAge           *time.Duration            `json:"max_age,omitempty"`
-	LoginHint        *string                   `json:"login_hint,omitempty"`
-	HintUserID       *string                   `json:"hint_user_id,omitempty"`
-	NeedRefreshToken bool                      `json:"need_refresh_token,omitempty"`
-	Issuer           string                    `json:"issuer,omitempty"`
+	LoginClient          string                      `json:"login_client"`
+	ClientID             string                      `json:"client_id"`
+	RedirectURI          string                      `json:"redirect_uri"`
+	State                string                      `json:"state,omitempty"`
+	Nonce                string                      `json:"nonce,omitempty"`
+	Scope                []string                    `json:"scope,omitempty"`
+	Audience             []string                    `json:"audience,omitempty"`
+	ResponseType         domain.OIDCResponseType     `json:"response_type,omitempty"`
+	ResponseMode         domain.OIDCResponseMode     `json:"response_mode,omitempty"`
+	CodeChallenge        *domain.OIDCCodeChallenge   `json:"code_challenge,omitempty"`
+	Prompt               []domain.Prompt             `json:"prompt,omitempty"`
+	UILocales            []string                    `json:"ui_locales,omitempty"`
+	MaxAge               *time.Duration              `json:"max_age,omitempty"`
+	LoginHint            *string                     `json:"login_hint,omitempty"`
+	HintUserID           *string                     `json:"hint_user_id,omitempty"`
+	NeedRefreshToken     bool                        `json:"need_refresh_token,omitempty"`
+	Issuer               string                      `json:"issuer,omitempty"`
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorization_details,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -68,6 +75,7 @@ func NewAddedEvent(ctx context.Context,
 	hintUserID *string,
 	needRefreshToken bool,
 	issuer string,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -75,23 +83,24 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		LoginClient:      loginClient,
-		ClientID:         clientID,
-		RedirectURI:      redirectURI,
-		State:            state,
-		Nonce:            nonce,
-		Scope:            scope,
-		Audience:         audience,
-		ResponseType:     responseType,
-		ResponseMode:     responseMode,
-		CodeChallenge:    codeChallenge,
-		Prompt:           prompt,
-		UILocales:        uiLocales,
-		MaxAge:           maxAge,
-		LoginHint:        loginHint,
-		HintUserID:       hintUserID,
-		NeedRefreshToken: needRefreshToken,
-		Issuer:           issuer,
+		LoginClient:          loginClient,
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		State:                state,
+		Nonce:                nonce,
+		Scope:                scope,
+		Audience:             audience,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		CodeChallenge:        codeChallenge,
+		Prompt:               prompt,
+		UILocales:            uiLocales,
+		MaxAge:               maxAge,
+		LoginHint:            loginHint,
+		HintUserID:           hintUserID,
+		NeedRefreshToken:     needRefreshToken,
+		Issuer:               issuer,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -292,3 +301,128 @@ func SucceededEventMapper(event eventstore.Event) (eventstore.Event, error) {
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
 	}, nil
 }
+
+// PushedEvent stores the parameters of a Pushed Authorization Request (RFC 9126),
+// so they can be used by the authorization endpoint until they expire.
+type PushedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ClientID             string    `json:"client_id"`
+	RedirectURI          string    `json:"redirect_uri"`
+	State                string    `json:"state,omitempty"`
+	Nonce                string    `json:"nonce,omitempty"`
+	Scope                []string  `json:"scope,omitempty"`
+	ResponseType         string    `json:"response_type,omitempty"`
+	ResponseMode         string    `json:"response_mode,omitempty"`
+	Display              string    `json:"display,omitempty"`
+	Prompt               []string  `json:"prompt,omitempty"`
+	MaxAge               *uint     `json:"max_age,omitempty"`
+	UILocales            []string  `json:"ui_locales,omitempty"`
+	IDTokenHint          string    `json:"id_token_hint,omitempty"`
+	LoginHint            string    `json:"login_hint,omitempty"`
+	ACRValues            []string  `json:"acr_values,omitempty"`
+	CodeChallenge        string    `json:"code_challenge,omitempty"`
+	CodeChallengeMethod  string    `json:"code_challenge_method,omitempty"`
+	Expiration           time.Time `json:"expiration"`
+	AuthorizationDetails string    `json:"authorization_details,omitempty"`
+}
+
+func (e *PushedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *PushedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewPushedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	redirectURI,
+	state,
+	nonce string,
+	scope []string,
+	responseType,
+	responseMode,
+	display string,
+	prompt []string,
+	maxAge *uint,
+	uiLocales []string,
+	idTokenHint,
+	loginHint string,
+	acrValues []string,
+	codeChallenge,
+	codeChallengeMethod string,
+	expiration time.Time,
+	authorizationDetails string,
+) *PushedEvent {
+	return &PushedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedType,
+		),
+		ClientID:             clientID,
+		RedirectURI:          redirectURI,
+		State:                state,
+		Nonce:                nonce,
+		Scope:                scope,
+		ResponseType:         responseType,
+		ResponseMode:         responseMode,
+		Display:              display,
+		Prompt:               prompt,
+		MaxAge:               maxAge,
+		UILocales:            uiLocales,
+		IDTokenHint:          idTokenHint,
+		LoginHint:            loginHint,
+		ACRValues:            acrValues,
+		CodeChallenge:        codeChallenge,
+		CodeChallengeMethod:  codeChallengeMethod,
+		Expiration:           expiration,
+		AuthorizationDetails: authorizationDetails,
+	}
+}
+
+func PushedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	pushed := &PushedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+	err := event.Unmarshal(pushed)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "AUTHR-p3Rq8", "unable to unmarshal pushed auth request")
+	}
+
+	return pushed, nil
+}
+
+type PushedConsumedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *PushedConsumedEvent) Payload() interface{} {
+	return nil
+}
+
+func (e *PushedConsumedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		eventstore.NewAddEventUniqueConstraint(UniquePushedConsumed, e.Aggregate().ID, DuplicatePushedConsumed),
+	}
+}
+
+func NewPushedConsumedEvent(ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *PushedConsumedEvent {
+	return &PushedConsumedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			PushedConsumedType,
+		),
+	}
+}
+
+func PushedConsumedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	return &PushedConsumedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}, nil
+}
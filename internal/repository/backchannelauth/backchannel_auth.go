@@ -0,0 +1,171 @@
+package backchannelauth
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/text/language"
+
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix   eventstore.EventType = "backchannel.authentication."
+	AddedEventType                         = eventTypePrefix + "added"
+	ApprovedEventType                      = eventTypePrefix + "approved"
+	CanceledEventType                      = eventTypePrefix + "canceled"
+	DoneEventType                          = eventTypePrefix + "done"
+
+	// UniqueDone ensures the auth_req_id is only redeemed once, even if it is redeemed concurrently.
+	UniqueDone    = "backchannel_auth_done"
+	DuplicateDone = "Errors.BackChannelAuth.AlreadyHandled"
+)
+
+type AddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	ClientID                   string
+	UserID                     string
+	UserOrgID                  string
+	Scopes                     []string
+	Audience                   []string
+	BindingMessage             string `json:",omitempty"`
+	Expires                    time.Time
+	NeedRefreshToken           bool
+	ClientNotificationEndpoint string              `json:",omitempty"`
+	ClientNotificationToken    *crypto.CryptoValue `json:",omitempty"`
+	State                      domain.BackChannelAuthState
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	clientID,
+	userID,
+	userOrgID string,
+	scopes,
+	audience []string,
+	bindingMessage string,
+	expires time.Time,
+	needRefreshToken bool,
+	clientNotificationEndpoint string,
+	clientNotificationToken *crypto.CryptoValue,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		ClientID:                   clientID,
+		UserID:                     userID,
+		UserOrgID:                  userOrgID,
+		Scopes:                     scopes,
+		Audience:                   audience,
+		BindingMessage:             bindingMessage,
+		Expires:                    expires,
+		NeedRefreshToken:           needRefreshToken,
+		ClientNotificationEndpoint: clientNotificationEndpoint,
+		ClientNotificationToken:    clientNotificationToken,
+		State:                      domain.BackChannelAuthStateInitiated,
+	}
+}
+
+type ApprovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	UserAuthMethods   []domain.UserAuthMethodType
+	AuthTime          time.Time
+	PreferredLanguage *language.Tag
+	UserAgent         *domain.UserAgent
+	SessionID         string
+}
+
+func (e *ApprovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *ApprovedEvent) Payload() any {
+	return e
+}
+
+func (e *ApprovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewApprovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	userAuthMethods []domain.UserAuthMethodType,
+	authTime time.Time,
+	preferredLanguage *language.Tag,
+	userAgent *domain.UserAgent,
+	sessionID string,
+) *ApprovedEvent {
+	return &ApprovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx, aggregate, ApprovedEventType,
+		),
+		UserAuthMethods:   userAuthMethods,
+		AuthTime:          authTime,
+		PreferredLanguage: preferredLanguage,
+		UserAgent:         userAgent,
+		SessionID:         sessionID,
+	}
+}
+
+type CanceledEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	Reason domain.BackChannelAuthCanceled
+}
+
+func (e *CanceledEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *CanceledEvent) Payload() any {
+	return e
+}
+
+func (e *CanceledEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewCanceledEvent(ctx context.Context, aggregate *eventstore.Aggregate, reason domain.BackChannelAuthCanceled) *CanceledEvent {
+	return &CanceledEvent{eventstore.NewBaseEventForPush(ctx, aggregate, CanceledEventType), reason}
+}
+
+type DoneEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+}
+
+func (e *DoneEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *DoneEvent) Payload() any {
+	return e
+}
+
+func (e *DoneEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		eventstore.NewAddEventUniqueConstraint(UniqueDone, e.Aggregate().ID, DuplicateDone),
+	}
+}
+
+func NewDoneEvent(ctx context.Context, aggregate *eventstore.Aggregate) *DoneEvent {
+	return &DoneEvent{eventstore.NewBaseEventForPush(ctx, aggregate, DoneEventType)}
+}
@@ -8,7 +8,8 @@ import (
 )
 
 const (
-	QueueName = "execution"
+	QueueName         = "execution"
+	DeliveryQueueName = "execution_delivery"
 )
 
 type Request struct {
@@ -25,6 +26,26 @@ func (e *Request) Kind() string {
 	return "execution_request"
 }
 
+// Delivery is the call of a single target,
+// which is retried according to the retry policy of the target.
+type Delivery struct {
+	InstanceID    string `json:"instanceID"`
+	ResourceOwner string `json:"resourceOwner"`
+	TargetData    []byte `json:"targetData"`
+	Body          []byte `json:"body"`
+	// PreviousAttempts is the amount of calls to the target before the delivery was queued.
+	PreviousAttempts int `json:"previousAttempts,omitempty"`
+}
+
+func (d *Delivery) Kind() string {
+	return "execution_delivery"
+}
+
+// GetHTTPRequestBody implements the ContextInfoRequest interface of the execution package.
+func (d *Delivery) GetHTTPRequestBody() []byte {
+	return d.Body
+}
+
 func ContextInfoFromRequest(e *Request) *ContextInfoEvent {
 	return &ContextInfoEvent{
 		AggregateID:   e.Aggregate.ID,
@@ -0,0 +1,44 @@
+package group
+
+import (
+	"fmt"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	UniqueGroupName     = "group_name"
+	DuplicateGroup      = "Errors.Group.AlreadyExists"
+	UniqueGroupGrant    = "group_grant"
+	DuplicateGroupGrant = "Errors.Group.Grant.AlreadyExists"
+)
+
+func NewAddGroupNameUniqueConstraint(name, resourceOwner string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupName,
+		fmt.Sprintf("%s:%s", resourceOwner, name),
+		DuplicateGroup,
+	)
+}
+
+func NewRemoveGroupNameUniqueConstraint(name, resourceOwner string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupName,
+		fmt.Sprintf("%s:%s", resourceOwner, name),
+	)
+}
+
+func NewAddGroupGrantUniqueConstraint(resourceOwner, groupID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
+	return eventstore.NewAddEventUniqueConstraint(
+		UniqueGroupGrant,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, groupID, projectID, projectGrantID),
+		DuplicateGroupGrant,
+	)
+}
+
+func NewRemoveGroupGrantUniqueConstraint(resourceOwner, groupID, projectID, projectGrantID string) *eventstore.UniqueConstraint {
+	return eventstore.NewRemoveUniqueConstraint(
+		UniqueGroupGrant,
+		fmt.Sprintf("%s:%s:%s:%s", resourceOwner, groupID, projectID, projectGrantID),
+	)
+}
@@ -0,0 +1,119 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	grantEventTypePrefix  = eventTypePrefix + "grant."
+	GrantAddedEventType   = grantEventTypePrefix + "added"
+	GrantChangedEventType = grantEventTypePrefix + "changed"
+	GrantRemovedEventType = grantEventTypePrefix + "removed"
+)
+
+// GrantAddedEvent grants the roles of a project to all effective members of the group.
+type GrantAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID        string   `json:"grantId"`
+	ProjectID      string   `json:"projectId"`
+	ProjectGrantID string   `json:"projectGrantId,omitempty"`
+	RoleKeys       []string `json:"roleKeys,omitempty"`
+}
+
+func (e *GrantAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantAddedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		NewAddGroupGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.Aggregate().ID, e.ProjectID, e.ProjectGrantID),
+	}
+}
+
+func NewGrantAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	grantID,
+	projectID,
+	projectGrantID string,
+	roleKeys []string,
+) *GrantAddedEvent {
+	return &GrantAddedEvent{
+		BaseEvent:      *eventstore.NewBaseEventForPush(ctx, aggregate, GrantAddedEventType),
+		GrantID:        grantID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+		RoleKeys:       roleKeys,
+	}
+}
+
+type GrantChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID  string   `json:"grantId"`
+	RoleKeys []string `json:"roleKeys"`
+}
+
+func (e *GrantChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantChangedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewGrantChangedEvent(ctx context.Context, aggregate *eventstore.Aggregate, grantID string, roleKeys []string) *GrantChangedEvent {
+	return &GrantChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, GrantChangedEventType),
+		GrantID:   grantID,
+		RoleKeys:  roleKeys,
+	}
+}
+
+type GrantRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GrantID        string `json:"grantId"`
+	ProjectID      string `json:"projectId"`
+	ProjectGrantID string `json:"projectGrantId,omitempty"`
+}
+
+func (e *GrantRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *GrantRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *GrantRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{
+		NewRemoveGroupGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.Aggregate().ID, e.ProjectID, e.ProjectGrantID),
+	}
+}
+
+func NewGrantRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	grantID,
+	projectID,
+	projectGrantID string,
+) *GrantRemovedEvent {
+	return &GrantRemovedEvent{
+		BaseEvent:      *eventstore.NewBaseEventForPush(ctx, aggregate, GrantRemovedEventType),
+		GrantID:        grantID,
+		ProjectID:      projectID,
+		ProjectGrantID: projectGrantID,
+	}
+}
@@ -0,0 +1,156 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	eventTypePrefix  eventstore.EventType = "group."
+	AddedEventType                        = eventTypePrefix + "added"
+	ChangedEventType                      = eventTypePrefix + "changed"
+	RemovedEventType                      = eventTypePrefix + "removed"
+)
+
+type AddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	ExternalID  string `json:"externalId,omitempty"`
+}
+
+func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *AddedEvent) Payload() any {
+	return e
+}
+
+func (e *AddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return []*eventstore.UniqueConstraint{NewAddGroupNameUniqueConstraint(e.Name, e.Aggregate().ResourceOwner)}
+}
+
+func NewAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	name,
+	description,
+	externalID string,
+) *AddedEvent {
+	return &AddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx, aggregate, AddedEventType,
+		),
+		Name:        name,
+		Description: description,
+		ExternalID:  externalID,
+	}
+}
+
+type ChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ExternalID  *string `json:"externalId,omitempty"`
+
+	oldName string
+}
+
+func (e *ChangedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *ChangedEvent) Payload() any {
+	return e
+}
+
+func (e *ChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	if e.oldName == "" {
+		return nil
+	}
+	return []*eventstore.UniqueConstraint{
+		NewRemoveGroupNameUniqueConstraint(e.oldName, e.Aggregate().ResourceOwner),
+		NewAddGroupNameUniqueConstraint(*e.Name, e.Aggregate().ResourceOwner),
+	}
+}
+
+func NewChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	changes []Changes,
+) *ChangedEvent {
+	changeEvent := &ChangedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			ChangedEventType,
+		),
+	}
+	for _, change := range changes {
+		change(changeEvent)
+	}
+	return changeEvent
+}
+
+type Changes func(event *ChangedEvent)
+
+func ChangeName(oldName, name string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Name = &name
+		e.oldName = oldName
+	}
+}
+
+func ChangeDescription(description string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.Description = &description
+	}
+}
+
+func ChangeExternalID(externalID string) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.ExternalID = &externalID
+	}
+}
+
+type RemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	name   string
+	grants []*RemovedGrant
+}
+
+// RemovedGrant identifies a grant of the removed group to release its unique constraint.
+type RemovedGrant struct {
+	ProjectID      string
+	ProjectGrantID string
+}
+
+func (e *RemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *RemovedEvent) Payload() any {
+	return e
+}
+
+func (e *RemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	constraints := make([]*eventstore.UniqueConstraint, 0, len(e.grants)+1)
+	constraints = append(constraints, NewRemoveGroupNameUniqueConstraint(e.name, e.Aggregate().ResourceOwner))
+	for _, grant := range e.grants {
+		constraints = append(constraints, NewRemoveGroupGrantUniqueConstraint(e.Aggregate().ResourceOwner, e.Aggregate().ID, grant.ProjectID, grant.ProjectGrantID))
+	}
+	return constraints
+}
+
+func NewRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, name string, grants []*RemovedGrant) *RemovedEvent {
+	return &RemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, RemovedEventType),
+		name:      name,
+		grants:    grants,
+	}
+}
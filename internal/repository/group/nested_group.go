@@ -0,0 +1,65 @@
+package group
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	nestedGroupEventTypePrefix  = eventTypePrefix + "nested."
+	NestedGroupAddedEventType   = nestedGroupEventTypePrefix + "added"
+	NestedGroupRemovedEventType = nestedGroupEventTypePrefix + "removed"
+)
+
+// NestedGroupAddedEvent adds the group as member of the aggregate's group.
+// The members of the nested group are effective members of the aggregate's group.
+type NestedGroupAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID string `json:"groupId"`
+}
+
+func (e *NestedGroupAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *NestedGroupAddedEvent) Payload() any {
+	return e
+}
+
+func (e *NestedGroupAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNestedGroupAddedEvent(ctx context.Context, aggregate *eventstore.Aggregate, groupID string) *NestedGroupAddedEvent {
+	return &NestedGroupAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, NestedGroupAddedEventType),
+		GroupID:   groupID,
+	}
+}
+
+type NestedGroupRemovedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	GroupID string `json:"groupId"`
+}
+
+func (e *NestedGroupRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = *b
+}
+
+func (e *NestedGroupRemovedEvent) Payload() any {
+	return e
+}
+
+func (e *NestedGroupRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewNestedGroupRemovedEvent(ctx context.Context, aggregate *eventstore.Aggregate, groupID string) *NestedGroupRemovedEvent {
+	return &NestedGroupRemovedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(ctx, aggregate, NestedGroupRemovedEventType),
+		GroupID:   groupID,
+	}
+}
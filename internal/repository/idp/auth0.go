@@ -0,0 +1,151 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type Auth0IDPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	Domain       string              `json:"domain"`
+	ClientID     string              `json:"client_id"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	Options
+}
+
+func NewAuth0IDPAddedEvent(
+	base *eventstore.BaseEvent,
+	id,
+	name,
+	domain,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options Options,
+) *Auth0IDPAddedEvent {
+	return &Auth0IDPAddedEvent{
+		BaseEvent:    *base,
+		ID:           id,
+		Name:         name,
+		Domain:       domain,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+		Options:      options,
+	}
+}
+
+func (e *Auth0IDPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *Auth0IDPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func Auth0IDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &Auth0IDPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Eb956", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type Auth0IDPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         *string             `json:"name,omitempty"`
+	Domain       *string             `json:"domain,omitempty"`
+	ClientID     *string             `json:"client_id,omitempty"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret,omitempty"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	OptionChanges
+}
+
+func NewAuth0IDPChangedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	changes []Auth0IDPChanges,
+) (*Auth0IDPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IDP-N2Yhs", "Errors.NoChangesFound")
+	}
+	changedEvent := &Auth0IDPChangedEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+	for _, change := range changes {
+		change(changedEvent)
+	}
+	return changedEvent, nil
+}
+
+type Auth0IDPChanges func(*Auth0IDPChangedEvent)
+
+func ChangeAuth0Name(name string) func(*Auth0IDPChangedEvent) {
+	return func(e *Auth0IDPChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeAuth0Domain(domain string) func(*Auth0IDPChangedEvent) {
+	return func(e *Auth0IDPChangedEvent) {
+		e.Domain = &domain
+	}
+}
+
+func ChangeAuth0ClientID(clientID string) func(*Auth0IDPChangedEvent) {
+	return func(e *Auth0IDPChangedEvent) {
+		e.ClientID = &clientID
+	}
+}
+
+func ChangeAuth0ClientSecret(clientSecret *crypto.CryptoValue) func(*Auth0IDPChangedEvent) {
+	return func(e *Auth0IDPChangedEvent) {
+		e.ClientSecret = clientSecret
+	}
+}
+
+func ChangeAuth0Scopes(scopes []string) func(*Auth0IDPChangedEvent) {
+	return func(e *Auth0IDPChangedEvent) {
+		e.Scopes = scopes
+	}
+}
+
+func ChangeAuth0Options(options OptionChanges) func(*Auth0IDPChangedEvent) {
+	return func(e *Auth0IDPChangedEvent) {
+		e.OptionChanges = options
+	}
+}
+
+func (e *Auth0IDPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *Auth0IDPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func Auth0IDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &Auth0IDPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-IqsBI", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -0,0 +1,161 @@
+package idp
+
+import (
+	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/eventstore"
+	"github.com/zitadel/zitadel/internal/zerrors"
+)
+
+type EntraExternalIDIDPAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	TenantID     string              `json:"tenant_id"`
+	Domain       string              `json:"domain,omitempty"`
+	ClientID     string              `json:"client_id"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	Options
+}
+
+func NewEntraExternalIDIDPAddedEvent(
+	base *eventstore.BaseEvent,
+	id,
+	name,
+	tenantID,
+	domain,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options Options,
+) *EntraExternalIDIDPAddedEvent {
+	return &EntraExternalIDIDPAddedEvent{
+		BaseEvent:    *base,
+		ID:           id,
+		Name:         name,
+		TenantID:     tenantID,
+		Domain:       domain,
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Scopes:       scopes,
+		Options:      options,
+	}
+}
+
+func (e *EntraExternalIDIDPAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *EntraExternalIDIDPAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func EntraExternalIDIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &EntraExternalIDIDPAddedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-Y9kpT", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
+
+type EntraExternalIDIDPChangedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	ID           string              `json:"id"`
+	Name         *string             `json:"name,omitempty"`
+	TenantID     *string             `json:"tenant_id,omitempty"`
+	Domain       *string             `json:"domain,omitempty"`
+	ClientID     *string             `json:"client_id,omitempty"`
+	ClientSecret *crypto.CryptoValue `json:"client_secret,omitempty"`
+	Scopes       []string            `json:"scopes,omitempty"`
+	OptionChanges
+}
+
+func NewEntraExternalIDIDPChangedEvent(
+	base *eventstore.BaseEvent,
+	id string,
+	changes []EntraExternalIDIDPChanges,
+) (*EntraExternalIDIDPChangedEvent, error) {
+	if len(changes) == 0 {
+		return nil, zerrors.ThrowPreconditionFailed(nil, "IDP-JebKn", "Errors.NoChangesFound")
+	}
+	changedEvent := &EntraExternalIDIDPChangedEvent{
+		BaseEvent: *base,
+		ID:        id,
+	}
+	for _, change := range changes {
+		change(changedEvent)
+	}
+	return changedEvent, nil
+}
+
+type EntraExternalIDIDPChanges func(*EntraExternalIDIDPChangedEvent)
+
+func ChangeEntraExternalIDName(name string) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.Name = &name
+	}
+}
+
+func ChangeEntraExternalIDTenantID(tenantID string) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.TenantID = &tenantID
+	}
+}
+
+func ChangeEntraExternalIDDomain(domain string) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.Domain = &domain
+	}
+}
+
+func ChangeEntraExternalIDClientID(clientID string) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.ClientID = &clientID
+	}
+}
+
+func ChangeEntraExternalIDClientSecret(clientSecret *crypto.CryptoValue) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.ClientSecret = clientSecret
+	}
+}
+
+func ChangeEntraExternalIDScopes(scopes []string) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.Scopes = scopes
+	}
+}
+
+func ChangeEntraExternalIDOptions(options OptionChanges) func(*EntraExternalIDIDPChangedEvent) {
+	return func(e *EntraExternalIDIDPChangedEvent) {
+		e.OptionChanges = options
+	}
+}
+
+func (e *EntraExternalIDIDPChangedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *EntraExternalIDIDPChangedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func EntraExternalIDIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e := &EntraExternalIDIDPChangedEvent{
+		BaseEvent: *eventstore.BaseEventFromRepo(event),
+	}
+
+	err := event.Unmarshal(e)
+	if err != nil {
+		return nil, zerrors.ThrowInternal(err, "IDP-BaZNs", "unable to unmarshal event")
+	}
+
+	return e, nil
+}
@@ -7,19 +7,23 @@ import (
 )
 
 type Options struct {
-	IsCreationAllowed bool                     `json:"isCreationAllowed,omitempty"`
-	IsLinkingAllowed  bool                     `json:"isLinkingAllowed,omitempty"`
-	IsAutoCreation    bool                     `json:"isAutoCreation,omitempty"`
-	IsAutoUpdate      bool                     `json:"isAutoUpdate,omitempty"`
-	AutoLinkingOption domain.AutoLinkingOption `json:"autoLinkingOption,omitempty"`
+	IsCreationAllowed        bool                     `json:"isCreationAllowed,omitempty"`
+	IsLinkingAllowed         bool                     `json:"isLinkingAllowed,omitempty"`
+	IsAutoCreation           bool                     `json:"isAutoCreation,omitempty"`
+	IsAutoUpdate             bool                     `json:"isAutoUpdate,omitempty"`
+	AutoLinkingOption        domain.AutoLinkingOption `json:"autoLinkingOption,omitempty"`
+	GroupMapping             *domain.IDPGroupMapping  `json:"groupMapping,omitempty"`
+	IsFederatedLogoutEnabled bool                     `json:"isFederatedLogoutEnabled,omitempty"`
 }
 
 type OptionChanges struct {
-	IsCreationAllowed *bool                     `json:"isCreationAllowed,omitempty"`
-	IsLinkingAllowed  *bool                     `json:"isLinkingAllowed,omitempty"`
-	IsAutoCreation    *bool                     `json:"isAutoCreation,omitempty"`
-	IsAutoUpdate      *bool                     `json:"isAutoUpdate,omitempty"`
-	AutoLinkingOption *domain.AutoLinkingOption `json:"autoLinkingOption,omitempty"`
+	IsCreationAllowed        *bool                     `json:"isCreationAllowed,omitempty"`
+	IsLinkingAllowed         *bool                     `json:"isLinkingAllowed,omitempty"`
+	IsAutoCreation           *bool                     `json:"isAutoCreation,omitempty"`
+	IsAutoUpdate             *bool                     `json:"isAutoUpdate,omitempty"`
+	AutoLinkingOption        *domain.AutoLinkingOption `json:"autoLinkingOption,omitempty"`
+	GroupMapping             *domain.IDPGroupMapping   `json:"groupMapping,omitempty"`
+	IsFederatedLogoutEnabled *bool                     `json:"isFederatedLogoutEnabled,omitempty"`
 }
 
 func (o *Options) Changes(options Options) OptionChanges {
@@ -39,6 +43,16 @@ func (o *Options) Changes(options Options) OptionChanges {
 	if o.AutoLinkingOption != options.AutoLinkingOption {
 		opts.AutoLinkingOption = &options.AutoLinkingOption
 	}
+	if !o.GroupMapping.Equal(options.GroupMapping) {
+		// an empty mapping is used to remove the mapping
+		opts.GroupMapping = options.GroupMapping
+		if opts.GroupMapping == nil {
+			opts.GroupMapping = new(domain.IDPGroupMapping)
+		}
+	}
+	if o.IsFederatedLogoutEnabled != options.IsFederatedLogoutEnabled {
+		opts.IsFederatedLogoutEnabled = &options.IsFederatedLogoutEnabled
+	}
 	return opts
 }
 
@@ -58,10 +72,16 @@ func (o *Options) ReduceChanges(changes OptionChanges) {
 	if changes.AutoLinkingOption != nil {
 		o.AutoLinkingOption = *changes.AutoLinkingOption
 	}
+	if changes.GroupMapping != nil {
+		o.GroupMapping = changes.GroupMapping
+	}
+	if changes.IsFederatedLogoutEnabled != nil {
+		o.IsFederatedLogoutEnabled = *changes.IsFederatedLogoutEnabled
+	}
 }
 
 func (o *OptionChanges) IsZero() bool {
-	return o.IsCreationAllowed == nil && o.IsLinkingAllowed == nil && o.IsAutoCreation == nil && o.IsAutoUpdate == nil && o.AutoLinkingOption == nil
+	return o.IsCreationAllowed == nil && o.IsLinkingAllowed == nil && o.IsAutoCreation == nil && o.IsAutoUpdate == nil && o.AutoLinkingOption == nil && o.GroupMapping == nil && o.IsFederatedLogoutEnabled == nil
 }
 
 type RemovedEvent struct {
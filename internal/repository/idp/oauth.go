@@ -2,6 +2,7 @@ package idp
 
 import (
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -9,16 +10,17 @@ import (
 type OAuthIDPAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID                    string              `json:"id"`
-	Name                  string              `json:"name,omitempty"`
-	ClientID              string              `json:"clientId,omitempty"`
-	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	AuthorizationEndpoint string              `json:"authorizationEndpoint,omitempty"`
-	TokenEndpoint         string              `json:"tokenEndpoint,omitempty"`
-	UserEndpoint          string              `json:"userEndpoint,omitempty"`
-	Scopes                []string            `json:"scopes,omitempty"`
-	IDAttribute           string              `json:"idAttribute,omitempty"`
-	UsePKCE               bool                `json:"usePKCE,omitempty"`
+	ID                    string                  `json:"id"`
+	Name                  string                  `json:"name,omitempty"`
+	ClientID              string                  `json:"clientId,omitempty"`
+	ClientSecret          *crypto.CryptoValue     `json:"clientSecret,omitempty"`
+	AuthorizationEndpoint string                  `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         string                  `json:"tokenEndpoint,omitempty"`
+	UserEndpoint          string                  `json:"userEndpoint,omitempty"`
+	Scopes                []string                `json:"scopes,omitempty"`
+	IDAttribute           string                  `json:"idAttribute,omitempty"`
+	UsePKCE               bool                    `json:"usePKCE,omitempty"`
+	ClaimMapping          *domain.IDPClaimMapping `json:"claimMapping,omitempty"`
 	Options
 }
 
@@ -34,6 +36,7 @@ func NewOAuthIDPAddedEvent(
 	idAttribute string,
 	scopes []string,
 	usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options Options,
 ) *OAuthIDPAddedEvent {
 	return &OAuthIDPAddedEvent{
@@ -48,6 +51,7 @@ func NewOAuthIDPAddedEvent(
 		Scopes:                scopes,
 		IDAttribute:           idAttribute,
 		UsePKCE:               usePKCE,
+		ClaimMapping:          claimMapping,
 		Options:               options,
 	}
 }
@@ -76,16 +80,17 @@ func OAuthIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 type OAuthIDPChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID                    string              `json:"id"`
-	Name                  *string             `json:"name,omitempty"`
-	ClientID              *string             `json:"clientId,omitempty"`
-	ClientSecret          *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	AuthorizationEndpoint *string             `json:"authorizationEndpoint,omitempty"`
-	TokenEndpoint         *string             `json:"tokenEndpoint,omitempty"`
-	UserEndpoint          *string             `json:"userEndpoint,omitempty"`
-	Scopes                []string            `json:"scopes,omitempty"`
-	IDAttribute           *string             `json:"idAttribute,omitempty"`
-	UsePKCE               *bool               `json:"usePKCE,omitempty"`
+	ID                    string                  `json:"id"`
+	Name                  *string                 `json:"name,omitempty"`
+	ClientID              *string                 `json:"clientId,omitempty"`
+	ClientSecret          *crypto.CryptoValue     `json:"clientSecret,omitempty"`
+	AuthorizationEndpoint *string                 `json:"authorizationEndpoint,omitempty"`
+	TokenEndpoint         *string                 `json:"tokenEndpoint,omitempty"`
+	UserEndpoint          *string                 `json:"userEndpoint,omitempty"`
+	Scopes                []string                `json:"scopes,omitempty"`
+	IDAttribute           *string                 `json:"idAttribute,omitempty"`
+	UsePKCE               *bool                   `json:"usePKCE,omitempty"`
+	ClaimMapping          *domain.IDPClaimMapping `json:"claimMapping,omitempty"`
 	OptionChanges
 }
 
@@ -168,6 +173,15 @@ func ChangeOAuthUsePKCE(usePKCE bool) func(*OAuthIDPChangedEvent) {
 	}
 }
 
+func ChangeOAuthClaimMapping(claimMapping *domain.IDPClaimMapping) func(*OAuthIDPChangedEvent) {
+	return func(e *OAuthIDPChangedEvent) {
+		if claimMapping == nil {
+			claimMapping = new(domain.IDPClaimMapping)
+		}
+		e.ClaimMapping = claimMapping
+	}
+}
+
 func (e *OAuthIDPChangedEvent) Payload() interface{} {
 	return e
 }
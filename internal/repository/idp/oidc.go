@@ -2,6 +2,7 @@ package idp
 
 import (
 	"github.com/zitadel/zitadel/internal/crypto"
+	"github.com/zitadel/zitadel/internal/domain"
 	"github.com/zitadel/zitadel/internal/eventstore"
 	"github.com/zitadel/zitadel/internal/zerrors"
 )
@@ -9,14 +10,15 @@ import (
 type OIDCIDPAddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID               string              `json:"id"`
-	Name             string              `json:"name"`
-	Issuer           string              `json:"issuer"`
-	ClientID         string              `json:"clientId"`
-	ClientSecret     *crypto.CryptoValue `json:"clientSecret"`
-	Scopes           []string            `json:"scopes,omitempty"`
-	IsIDTokenMapping bool                `json:"idTokenMapping,omitempty"`
-	UsePKCE          bool                `json:"usePKCE,omitempty"`
+	ID               string                  `json:"id"`
+	Name             string                  `json:"name"`
+	Issuer           string                  `json:"issuer"`
+	ClientID         string                  `json:"clientId"`
+	ClientSecret     *crypto.CryptoValue     `json:"clientSecret"`
+	Scopes           []string                `json:"scopes,omitempty"`
+	IsIDTokenMapping bool                    `json:"idTokenMapping,omitempty"`
+	UsePKCE          bool                    `json:"usePKCE,omitempty"`
+	ClaimMapping     *domain.IDPClaimMapping `json:"claimMapping,omitempty"`
 	Options
 }
 
@@ -29,6 +31,7 @@ func NewOIDCIDPAddedEvent(
 	clientSecret *crypto.CryptoValue,
 	scopes []string,
 	isIDTokenMapping, usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options Options,
 ) *OIDCIDPAddedEvent {
 	return &OIDCIDPAddedEvent{
@@ -41,6 +44,7 @@ func NewOIDCIDPAddedEvent(
 		Scopes:           scopes,
 		IsIDTokenMapping: isIDTokenMapping,
 		UsePKCE:          usePKCE,
+		ClaimMapping:     claimMapping,
 		Options:          options,
 	}
 }
@@ -69,14 +73,15 @@ func OIDCIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 type OIDCIDPChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	ID               string              `json:"id"`
-	Name             *string             `json:"name,omitempty"`
-	Issuer           *string             `json:"issuer,omitempty"`
-	ClientID         *string             `json:"clientId,omitempty"`
-	ClientSecret     *crypto.CryptoValue `json:"clientSecret,omitempty"`
-	Scopes           []string            `json:"scopes,omitempty"`
-	IsIDTokenMapping *bool               `json:"idTokenMapping,omitempty"`
-	UsePKCE          *bool               `json:"usePKCE,omitempty"`
+	ID               string                  `json:"id"`
+	Name             *string                 `json:"name,omitempty"`
+	Issuer           *string                 `json:"issuer,omitempty"`
+	ClientID         *string                 `json:"clientId,omitempty"`
+	ClientSecret     *crypto.CryptoValue     `json:"clientSecret,omitempty"`
+	Scopes           []string                `json:"scopes,omitempty"`
+	IsIDTokenMapping *bool                   `json:"idTokenMapping,omitempty"`
+	UsePKCE          *bool                   `json:"usePKCE,omitempty"`
+	ClaimMapping     *domain.IDPClaimMapping `json:"claimMapping,omitempty"`
 	OptionChanges
 }
 
@@ -148,6 +153,15 @@ func ChangeOIDCUsePKCE(usePKCE bool) func(*OIDCIDPChangedEvent) {
 	}
 }
 
+func ChangeOIDCClaimMapping(claimMapping *domain.IDPClaimMapping) func(*OIDCIDPChangedEvent) {
+	return func(e *OIDCIDPChangedEvent) {
+		if claimMapping == nil {
+			claimMapping = new(domain.IDPClaimMapping)
+		}
+		e.ClaimMapping = claimMapping
+	}
+}
+
 func (e *OIDCIDPChangedEvent) Payload() interface{} {
 	return e
 }
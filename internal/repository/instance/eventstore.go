@@ -105,6 +105,14 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPChangedEventType, AppleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPChangedEventType, SAMLIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OktaIDPAddedEventType, OktaIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OktaIDPChangedEventType, OktaIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, Auth0IDPAddedEventType, Auth0IDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, Auth0IDPChangedEventType, Auth0IDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, KeycloakIDPAddedEventType, KeycloakIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, KeycloakIDPChangedEventType, KeycloakIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, EntraExternalIDIDPAddedEventType, EntraExternalIDIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, EntraExternalIDIDPChangedEventType, EntraExternalIDIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, IDPRemovedEventType, IDPRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderAddedEventType, IdentityProviderAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, LoginPolicyIDPProviderRemovedEventType, IdentityProviderRemovedEventMapper)
@@ -11,5 +11,7 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenAddedType, eventstore.GenericEventMapper[RefreshTokenAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenRenewedType, eventstore.GenericEventMapper[RefreshTokenRenewedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, RefreshTokenRevokedType, eventstore.GenericEventMapper[RefreshTokenRevokedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, DPoPBoundType, eventstore.GenericEventMapper[DPoPBoundEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, CertificateBoundType, eventstore.GenericEventMapper[CertificateBoundEvent])
 
 }
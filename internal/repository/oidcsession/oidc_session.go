@@ -18,22 +18,25 @@ const (
 	RefreshTokenAddedType   = oidcSessionEventPrefix + "refresh_token.added"
 	RefreshTokenRenewedType = oidcSessionEventPrefix + "refresh_token.renewed"
 	RefreshTokenRevokedType = oidcSessionEventPrefix + "refresh_token.revoked"
+	DPoPBoundType           = oidcSessionEventPrefix + "dpop.bound"
+	CertificateBoundType    = oidcSessionEventPrefix + "certificate.bound"
 )
 
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	UserID            string                      `json:"userID"`
-	UserResourceOwner string                      `json:"userResourceOwner"`
-	SessionID         string                      `json:"sessionID"`
-	ClientID          string                      `json:"clientID"`
-	Audience          []string                    `json:"audience"`
-	Scope             []string                    `json:"scope"`
-	AuthMethods       []domain.UserAuthMethodType `json:"authMethods"`
-	AuthTime          time.Time                   `json:"authTime"`
-	Nonce             string                      `json:"nonce,omitempty"`
-	PreferredLanguage *language.Tag               `json:"preferredLanguage,omitempty"`
-	UserAgent         *domain.UserAgent           `json:"userAgent,omitempty"`
+	UserID               string                      `json:"userID"`
+	UserResourceOwner    string                      `json:"userResourceOwner"`
+	SessionID            string                      `json:"sessionID"`
+	ClientID             string                      `json:"clientID"`
+	Audience             []string                    `json:"audience"`
+	Scope                []string                    `json:"scope"`
+	AuthMethods          []domain.UserAuthMethodType `json:"authMethods"`
+	AuthTime             time.Time                   `json:"authTime"`
+	Nonce                string                      `json:"nonce,omitempty"`
+	PreferredLanguage    *language.Tag               `json:"preferredLanguage,omitempty"`
+	UserAgent            *domain.UserAgent           `json:"userAgent,omitempty"`
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AddedEvent) Payload() interface{} {
@@ -61,6 +64,7 @@ func NewAddedEvent(ctx context.Context,
 	nonce string,
 	preferredLanguage *language.Tag,
 	userAgent *domain.UserAgent,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AddedEvent {
 	return &AddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -68,17 +72,18 @@ func NewAddedEvent(ctx context.Context,
 			aggregate,
 			AddedType,
 		),
-		UserID:            userID,
-		UserResourceOwner: userResourceOwner,
-		SessionID:         sessionID,
-		ClientID:          clientID,
-		Audience:          audience,
-		Scope:             scope,
-		AuthMethods:       authMethods,
-		AuthTime:          authTime,
-		Nonce:             nonce,
-		PreferredLanguage: preferredLanguage,
-		UserAgent:         userAgent,
+		UserID:               userID,
+		UserResourceOwner:    userResourceOwner,
+		SessionID:            sessionID,
+		ClientID:             clientID,
+		Audience:             audience,
+		Scope:                scope,
+		AuthMethods:          authMethods,
+		AuthTime:             authTime,
+		Nonce:                nonce,
+		PreferredLanguage:    preferredLanguage,
+		UserAgent:            userAgent,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -90,6 +95,8 @@ type AccessTokenAddedEvent struct {
 	Lifetime time.Duration      `json:"lifetime,omitempty"`
 	Reason   domain.TokenReason `json:"reason,omitempty"`
 	Actor    *domain.TokenActor `json:"actor,omitempty"`
+	// AuthorizationDetails are only set if the access token was narrowed to a subset of the granted authorization details of the session.
+	AuthorizationDetails domain.AuthorizationDetails `json:"authorizationDetails,omitempty"`
 }
 
 func (e *AccessTokenAddedEvent) Payload() interface{} {
@@ -112,6 +119,7 @@ func NewAccessTokenAddedEvent(
 	lifetime time.Duration,
 	reason domain.TokenReason,
 	actor *domain.TokenActor,
+	authorizationDetails domain.AuthorizationDetails,
 ) *AccessTokenAddedEvent {
 	return &AccessTokenAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -119,11 +127,12 @@ func NewAccessTokenAddedEvent(
 			aggregate,
 			AccessTokenAddedType,
 		),
-		ID:       id,
-		Scope:    scope,
-		Lifetime: lifetime,
-		Reason:   reason,
-		Actor:    actor,
+		ID:                   id,
+		Scope:                scope,
+		Lifetime:             lifetime,
+		Reason:               reason,
+		Actor:                actor,
+		AuthorizationDetails: authorizationDetails,
 	}
 }
 
@@ -259,3 +268,71 @@ func NewRefreshTokenRevokedEvent(
 		),
 	}
 }
+
+type DPoPBoundEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// JKT is the JWK SHA-256 thumbprint (RFC 7638) of the public key the session's tokens are bound to.
+	JKT string `json:"jkt"`
+}
+
+func (e *DPoPBoundEvent) Payload() interface{} {
+	return e
+}
+
+func (e *DPoPBoundEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *DPoPBoundEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewDPoPBoundEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	jkt string,
+) *DPoPBoundEvent {
+	return &DPoPBoundEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			DPoPBoundType,
+		),
+		JKT: jkt,
+	}
+}
+
+type CertificateBoundEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	// Thumbprint is the base64url encoded SHA-256 thumbprint (x5t#S256, RFC 8705) of the client certificate the session's tokens are bound to.
+	Thumbprint string `json:"x5tS256"`
+}
+
+func (e *CertificateBoundEvent) Payload() interface{} {
+	return e
+}
+
+func (e *CertificateBoundEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *CertificateBoundEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewCertificateBoundEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	thumbprint string,
+) *CertificateBoundEvent {
+	return &CertificateBoundEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			CertificateBoundType,
+		),
+		Thumbprint: thumbprint,
+	}
+}
@@ -0,0 +1,99 @@
+package org
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/domain"
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	initialAccessTokenEventTypePrefix = orgEventTypePrefix + "initial_access_token."
+	InitialAccessTokenAddedType       = initialAccessTokenEventTypePrefix + "added"
+	InitialAccessTokenRemovedType     = initialAccessTokenEventTypePrefix + "removed"
+)
+
+// InitialAccessTokenAddedEvent is pushed when an initial access token is created,
+// which allows the holder to register OIDC clients in the organization (RFC 7591).
+type InitialAccessTokenAddedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	TokenID                         string                 `json:"tokenId"`
+	ExpirationDate                  time.Time              `json:"expirationDate"`
+	AllowedGrantTypes               []domain.OIDCGrantType `json:"allowedGrantTypes,omitempty"`
+	RedirectURIPatterns             []string               `json:"redirectUriPatterns,omitempty"`
+	RegistrationAccessTokenLifetime time.Duration          `json:"registrationAccessTokenLifetime,omitempty"`
+	MaxAccessTokenLifetime          time.Duration          `json:"maxAccessTokenLifetime,omitempty"`
+	MaxIDTokenLifetime              time.Duration          `json:"maxIdTokenLifetime,omitempty"`
+	MaxRefreshTokenLifetime         time.Duration          `json:"maxRefreshTokenLifetime,omitempty"`
+}
+
+func NewInitialAccessTokenAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+	expirationDate time.Time,
+	policy *domain.ClientRegistrationPolicy,
+) *InitialAccessTokenAddedEvent {
+	return &InitialAccessTokenAddedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InitialAccessTokenAddedType,
+		),
+		TokenID:                         tokenID,
+		ExpirationDate:                  expirationDate,
+		AllowedGrantTypes:               policy.AllowedGrantTypes,
+		RedirectURIPatterns:             policy.RedirectURIPatterns,
+		RegistrationAccessTokenLifetime: policy.RegistrationAccessTokenLifetime,
+		MaxAccessTokenLifetime:          policy.MaxAccessTokenLifetime,
+		MaxIDTokenLifetime:              policy.MaxIDTokenLifetime,
+		MaxRefreshTokenLifetime:         policy.MaxRefreshTokenLifetime,
+	}
+}
+
+func (e *InitialAccessTokenAddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InitialAccessTokenAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InitialAccessTokenAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+type InitialAccessTokenRemovedEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	TokenID string `json:"tokenId"`
+}
+
+func NewInitialAccessTokenRemovedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	tokenID string,
+) *InitialAccessTokenRemovedEvent {
+	return &InitialAccessTokenRemovedEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			InitialAccessTokenRemovedType,
+		),
+		TokenID: tokenID,
+	}
+}
+
+func (e *InitialAccessTokenRemovedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *InitialAccessTokenRemovedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *InitialAccessTokenRemovedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
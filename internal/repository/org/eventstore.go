@@ -104,6 +104,14 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AppleIDPChangedEventType, AppleIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPAddedEventType, SAMLIDPAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLIDPChangedEventType, SAMLIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OktaIDPAddedEventType, OktaIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OktaIDPChangedEventType, OktaIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, Auth0IDPAddedEventType, Auth0IDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, Auth0IDPChangedEventType, Auth0IDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, KeycloakIDPAddedEventType, KeycloakIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, KeycloakIDPChangedEventType, KeycloakIDPChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, EntraExternalIDIDPAddedEventType, EntraExternalIDIDPAddedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, EntraExternalIDIDPChangedEventType, EntraExternalIDIDPChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, IDPRemovedEventType, IDPRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TriggerActionsSetEventType, TriggerActionsSetEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, TriggerActionsCascadeRemovedEventType, TriggerActionsCascadeRemovedEventMapper)
@@ -115,4 +123,6 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyChangedEventType, NotificationPolicyChangedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, NotificationPolicyRemovedEventType, NotificationPolicyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, HostedLoginTranslationSet, HostedLoginTranslationSetEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, InitialAccessTokenAddedType, eventstore.GenericEventMapper[InitialAccessTokenAddedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, InitialAccessTokenRemovedType, eventstore.GenericEventMapper[InitialAccessTokenRemovedEvent])
 }
@@ -37,6 +37,14 @@ const (
 	AppleIDPChangedEventType            eventstore.EventType = "org.idp.apple.changed"
 	SAMLIDPAddedEventType               eventstore.EventType = "org.idp.saml.added"
 	SAMLIDPChangedEventType             eventstore.EventType = "org.idp.saml.changed"
+	OktaIDPAddedEventType               eventstore.EventType = "org.idp.okta.added"
+	OktaIDPChangedEventType             eventstore.EventType = "org.idp.okta.changed"
+	Auth0IDPAddedEventType              eventstore.EventType = "org.idp.auth0.added"
+	Auth0IDPChangedEventType            eventstore.EventType = "org.idp.auth0.changed"
+	KeycloakIDPAddedEventType           eventstore.EventType = "org.idp.keycloak.added"
+	KeycloakIDPChangedEventType         eventstore.EventType = "org.idp.keycloak.changed"
+	EntraExternalIDIDPAddedEventType    eventstore.EventType = "org.idp.entra_external_id.added"
+	EntraExternalIDIDPChangedEventType  eventstore.EventType = "org.idp.entra_external_id.changed"
 	IDPRemovedEventType                 eventstore.EventType = "org.idp.removed"
 )
 
@@ -57,6 +65,7 @@ func NewOAuthIDPAddedEvent(
 	idAttribute string,
 	scopes []string,
 	usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) *OAuthIDPAddedEvent {
 
@@ -77,6 +86,7 @@ func NewOAuthIDPAddedEvent(
 			idAttribute,
 			scopes,
 			usePKCE,
+			claimMapping,
 			options,
 		),
 	}
@@ -140,6 +150,7 @@ func NewOIDCIDPAddedEvent(
 	clientSecret *crypto.CryptoValue,
 	scopes []string,
 	isIDTokenMapping, usePKCE bool,
+	claimMapping *domain.IDPClaimMapping,
 	options idp.Options,
 ) *OIDCIDPAddedEvent {
 
@@ -158,6 +169,7 @@ func NewOIDCIDPAddedEvent(
 			scopes,
 			isIDTokenMapping,
 			usePKCE,
+			claimMapping,
 			options,
 		),
 	}
@@ -1094,6 +1106,324 @@ func SAMLIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error)
 	return &SAMLIDPChangedEvent{SAMLIDPChangedEvent: *e.(*idp.SAMLIDPChangedEvent)}, nil
 }
 
+type OktaIDPAddedEvent struct {
+	idp.OktaIDPAddedEvent
+}
+
+func NewOktaIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	domain,
+	authorizationServerID,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *OktaIDPAddedEvent {
+
+	return &OktaIDPAddedEvent{
+		OktaIDPAddedEvent: *idp.NewOktaIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				OktaIDPAddedEventType,
+			),
+			id,
+			name,
+			domain,
+			authorizationServerID,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func OktaIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.OktaIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OktaIDPAddedEvent{OktaIDPAddedEvent: *e.(*idp.OktaIDPAddedEvent)}, nil
+}
+
+type OktaIDPChangedEvent struct {
+	idp.OktaIDPChangedEvent
+}
+
+func NewOktaIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.OktaIDPChanges,
+) (*OktaIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewOktaIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OktaIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &OktaIDPChangedEvent{OktaIDPChangedEvent: *changedEvent}, nil
+}
+
+func OktaIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.OktaIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OktaIDPChangedEvent{OktaIDPChangedEvent: *e.(*idp.OktaIDPChangedEvent)}, nil
+}
+
+type Auth0IDPAddedEvent struct {
+	idp.Auth0IDPAddedEvent
+}
+
+func NewAuth0IDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	domain,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *Auth0IDPAddedEvent {
+
+	return &Auth0IDPAddedEvent{
+		Auth0IDPAddedEvent: *idp.NewAuth0IDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				Auth0IDPAddedEventType,
+			),
+			id,
+			name,
+			domain,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func Auth0IDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.Auth0IDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Auth0IDPAddedEvent{Auth0IDPAddedEvent: *e.(*idp.Auth0IDPAddedEvent)}, nil
+}
+
+type Auth0IDPChangedEvent struct {
+	idp.Auth0IDPChangedEvent
+}
+
+func NewAuth0IDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.Auth0IDPChanges,
+) (*Auth0IDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewAuth0IDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			Auth0IDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Auth0IDPChangedEvent{Auth0IDPChangedEvent: *changedEvent}, nil
+}
+
+func Auth0IDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.Auth0IDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Auth0IDPChangedEvent{Auth0IDPChangedEvent: *e.(*idp.Auth0IDPChangedEvent)}, nil
+}
+
+type KeycloakIDPAddedEvent struct {
+	idp.KeycloakIDPAddedEvent
+}
+
+func NewKeycloakIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	baseURL,
+	realm,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *KeycloakIDPAddedEvent {
+
+	return &KeycloakIDPAddedEvent{
+		KeycloakIDPAddedEvent: *idp.NewKeycloakIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				KeycloakIDPAddedEventType,
+			),
+			id,
+			name,
+			baseURL,
+			realm,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func KeycloakIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.KeycloakIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeycloakIDPAddedEvent{KeycloakIDPAddedEvent: *e.(*idp.KeycloakIDPAddedEvent)}, nil
+}
+
+type KeycloakIDPChangedEvent struct {
+	idp.KeycloakIDPChangedEvent
+}
+
+func NewKeycloakIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.KeycloakIDPChanges,
+) (*KeycloakIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewKeycloakIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			KeycloakIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &KeycloakIDPChangedEvent{KeycloakIDPChangedEvent: *changedEvent}, nil
+}
+
+func KeycloakIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.KeycloakIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeycloakIDPChangedEvent{KeycloakIDPChangedEvent: *e.(*idp.KeycloakIDPChangedEvent)}, nil
+}
+
+type EntraExternalIDIDPAddedEvent struct {
+	idp.EntraExternalIDIDPAddedEvent
+}
+
+func NewEntraExternalIDIDPAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id,
+	name,
+	tenantID,
+	domain,
+	clientID string,
+	clientSecret *crypto.CryptoValue,
+	scopes []string,
+	options idp.Options,
+) *EntraExternalIDIDPAddedEvent {
+
+	return &EntraExternalIDIDPAddedEvent{
+		EntraExternalIDIDPAddedEvent: *idp.NewEntraExternalIDIDPAddedEvent(
+			eventstore.NewBaseEventForPush(
+				ctx,
+				aggregate,
+				EntraExternalIDIDPAddedEventType,
+			),
+			id,
+			name,
+			tenantID,
+			domain,
+			clientID,
+			clientSecret,
+			scopes,
+			options,
+		),
+	}
+}
+
+func EntraExternalIDIDPAddedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.EntraExternalIDIDPAddedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntraExternalIDIDPAddedEvent{EntraExternalIDIDPAddedEvent: *e.(*idp.EntraExternalIDIDPAddedEvent)}, nil
+}
+
+type EntraExternalIDIDPChangedEvent struct {
+	idp.EntraExternalIDIDPChangedEvent
+}
+
+func NewEntraExternalIDIDPChangedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	id string,
+	changes []idp.EntraExternalIDIDPChanges,
+) (*EntraExternalIDIDPChangedEvent, error) {
+
+	changedEvent, err := idp.NewEntraExternalIDIDPChangedEvent(
+		eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			EntraExternalIDIDPChangedEventType,
+		),
+		id,
+		changes,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &EntraExternalIDIDPChangedEvent{EntraExternalIDIDPChangedEvent: *changedEvent}, nil
+}
+
+func EntraExternalIDIDPChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
+	e, err := idp.EntraExternalIDIDPChangedEventMapper(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntraExternalIDIDPChangedEvent{EntraExternalIDIDPChangedEvent: *e.(*idp.EntraExternalIDIDPChangedEvent)}, nil
+}
+
 type IDPRemovedEvent struct {
 	idp.RemovedEvent
 }
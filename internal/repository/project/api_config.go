@@ -28,6 +28,9 @@ type APIConfigAddedEvent struct {
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
 	AuthMethodType domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+
+	TLSClientAuthSubjectDN    string `json:"tlsClientAuthSubjectDN,omitempty"`
+	TLSClientAuthPublicKeyPin string `json:"tlsClientAuthPublicKeyPin,omitempty"`
 }
 
 func (e *APIConfigAddedEvent) Payload() interface{} {
@@ -45,6 +48,8 @@ func NewAPIConfigAddedEvent(
 	clientID string,
 	hashedSecret string,
 	authMethodType domain.APIAuthMethodType,
+	tlsClientAuthSubjectDN string,
+	tlsClientAuthPublicKeyPin string,
 ) *APIConfigAddedEvent {
 	return &APIConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -56,6 +61,9 @@ func NewAPIConfigAddedEvent(
 		ClientID:       clientID,
 		HashedSecret:   hashedSecret,
 		AuthMethodType: authMethodType,
+
+		TLSClientAuthSubjectDN:    tlsClientAuthSubjectDN,
+		TLSClientAuthPublicKeyPin: tlsClientAuthPublicKeyPin,
 	}
 }
 
@@ -74,6 +82,12 @@ func (e *APIConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.AuthMethodType != c.AuthMethodType {
 		return false
 	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if e.TLSClientAuthPublicKeyPin != c.TLSClientAuthPublicKeyPin {
+		return false
+	}
 
 	return true
 }
@@ -94,8 +108,10 @@ func APIConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error)
 type APIConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	AppID          string                    `json:"appId"`
-	AuthMethodType *domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	AppID                     string                    `json:"appId"`
+	AuthMethodType            *domain.APIAuthMethodType `json:"authMethodType,omitempty"`
+	TLSClientAuthSubjectDN    *string                   `json:"tlsClientAuthSubjectDN,omitempty"`
+	TLSClientAuthPublicKeyPin *string                   `json:"tlsClientAuthPublicKeyPin,omitempty"`
 }
 
 func (e *APIConfigChangedEvent) Payload() interface{} {
@@ -138,6 +154,18 @@ func ChangeAPIAuthMethodType(authMethodType domain.APIAuthMethodType) func(event
 	}
 }
 
+func ChangeAPITLSClientAuthSubjectDN(subjectDN string) func(event *APIConfigChangedEvent) {
+	return func(e *APIConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeAPITLSClientAuthPublicKeyPin(publicKeyPin string) func(event *APIConfigChangedEvent) {
+	return func(e *APIConfigChangedEvent) {
+		e.TLSClientAuthPublicKeyPin = &publicKeyPin
+	}
+}
+
 func APIConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &APIConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
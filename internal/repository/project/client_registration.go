@@ -0,0 +1,56 @@
+package project
+
+import (
+	"context"
+	"time"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	OIDCConfigRegisteredType = applicationEventTypePrefix + "config.oidc.registered"
+)
+
+// OIDCConfigRegisteredEvent is pushed when an OIDC application was registered dynamically (RFC 7591) in the project created for it
+// and references the registration access token (RFC 7592), which allows the client to manage its registration.
+type OIDCConfigRegisteredEvent struct {
+	*eventstore.BaseEvent `json:"-"`
+
+	AppID                             string    `json:"appId"`
+	InitialAccessTokenID              string    `json:"initialAccessTokenId"`
+	RegistrationAccessTokenID         string    `json:"registrationAccessTokenId"`
+	RegistrationAccessTokenExpiration time.Time `json:"registrationAccessTokenExpiration,omitempty"`
+}
+
+func NewOIDCConfigRegisteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	appID,
+	initialAccessTokenID,
+	registrationAccessTokenID string,
+	registrationAccessTokenExpiration time.Time,
+) *OIDCConfigRegisteredEvent {
+	return &OIDCConfigRegisteredEvent{
+		BaseEvent: eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			OIDCConfigRegisteredType,
+		),
+		AppID:                             appID,
+		InitialAccessTokenID:              initialAccessTokenID,
+		RegistrationAccessTokenID:         registrationAccessTokenID,
+		RegistrationAccessTokenExpiration: registrationAccessTokenExpiration,
+	}
+}
+
+func (e *OIDCConfigRegisteredEvent) SetBaseEvent(b *eventstore.BaseEvent) {
+	e.BaseEvent = b
+}
+
+func (e *OIDCConfigRegisteredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *OIDCConfigRegisteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
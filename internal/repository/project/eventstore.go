@@ -44,4 +44,5 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, ApplicationKeyRemovedEventType, ApplicationKeyRemovedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigAddedType, SAMLConfigAddedEventMapper)
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLConfigChangedType, SAMLConfigChangedEventMapper)
+	eventstore.RegisterFilterEventMapper(AggregateType, OIDCConfigRegisteredType, eventstore.GenericEventMapper[OIDCConfigRegisteredEvent])
 }
@@ -29,23 +29,31 @@ type OIDCConfigAddedEvent struct {
 	ClientSecret *crypto.CryptoValue `json:"clientSecret,omitempty"`
 	HashedSecret string              `json:"hashedSecret,omitempty"`
 
-	RedirectUris             []string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   []string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  bool                       `json:"devMode,omitempty"`
-	AccessTokenType          domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        []string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI     string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion             domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI             string                     `json:"loginBaseURI,omitempty"`
+	RedirectUris                          []string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                         []domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                            []domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                       domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                        domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris                []string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                               bool                       `json:"devMode,omitempty"`
+	AccessTokenType                       domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion              bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion                  bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion              bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                             time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                     []string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage              bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI                  string                     `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                          domain.LoginVersion        `json:"loginVersion,omitempty"`
+	LoginBaseURI                          string                     `json:"loginBaseURI,omitempty"`
+	RequirePushedAuthorizationRequests    bool                       `json:"requirePushedAuthorizationRequests,omitempty"`
+	RequireDPoP                           bool                       `json:"requireDPoP,omitempty"`
+	TLSClientAuthSubjectDN                string                     `json:"tlsClientAuthSubjectDN,omitempty"`
+	TLSClientAuthPublicKeyPin             string                     `json:"tlsClientAuthPublicKeyPin,omitempty"`
+	BackChannelClientNotificationEndpoint string                     `json:"backChannelClientNotificationEndpoint,omitempty"`
+	AccessTokenLifetime                   time.Duration              `json:"accessTokenLifetime,omitempty"`
+	IDTokenLifetime                       time.Duration              `json:"idTokenLifetime,omitempty"`
+	RefreshTokenLifetime                  time.Duration              `json:"refreshTokenLifetime,omitempty"`
 }
 
 func (e *OIDCConfigAddedEvent) Payload() interface{} {
@@ -80,6 +88,14 @@ func NewOIDCConfigAddedEvent(
 	backChannelLogoutURI string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	requirePushedAuthorizationRequests bool,
+	requireDPoP bool,
+	tlsClientAuthSubjectDN string,
+	tlsClientAuthPublicKeyPin string,
+	backChannelClientNotificationEndpoint string,
+	accessTokenLifetime time.Duration,
+	idTokenLifetime time.Duration,
+	refreshTokenLifetime time.Duration,
 ) *OIDCConfigAddedEvent {
 	return &OIDCConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -87,27 +103,35 @@ func NewOIDCConfigAddedEvent(
 			aggregate,
 			OIDCConfigAddedType,
 		),
-		Version:                  version,
-		AppID:                    appID,
-		ClientID:                 clientID,
-		HashedSecret:             hashedSecret,
-		RedirectUris:             redirectUris,
-		ResponseTypes:            responseTypes,
-		GrantTypes:               grantTypes,
-		ApplicationType:          applicationType,
-		AuthMethodType:           authMethodType,
-		PostLogoutRedirectUris:   postLogoutRedirectUris,
-		DevMode:                  devMode,
-		AccessTokenType:          accessTokenType,
-		AccessTokenRoleAssertion: accessTokenRoleAssertion,
-		IDTokenRoleAssertion:     idTokenRoleAssertion,
-		IDTokenUserinfoAssertion: idTokenUserinfoAssertion,
-		ClockSkew:                clockSkew,
-		AdditionalOrigins:        additionalOrigins,
-		SkipNativeAppSuccessPage: skipNativeAppSuccessPage,
-		BackChannelLogoutURI:     backChannelLogoutURI,
-		LoginVersion:             loginVersion,
-		LoginBaseURI:             loginBaseURI,
+		Version:                               version,
+		AppID:                                 appID,
+		ClientID:                              clientID,
+		HashedSecret:                          hashedSecret,
+		RedirectUris:                          redirectUris,
+		ResponseTypes:                         responseTypes,
+		GrantTypes:                            grantTypes,
+		ApplicationType:                       applicationType,
+		AuthMethodType:                        authMethodType,
+		PostLogoutRedirectUris:                postLogoutRedirectUris,
+		DevMode:                               devMode,
+		AccessTokenType:                       accessTokenType,
+		AccessTokenRoleAssertion:              accessTokenRoleAssertion,
+		IDTokenRoleAssertion:                  idTokenRoleAssertion,
+		IDTokenUserinfoAssertion:              idTokenUserinfoAssertion,
+		ClockSkew:                             clockSkew,
+		AdditionalOrigins:                     additionalOrigins,
+		SkipNativeAppSuccessPage:              skipNativeAppSuccessPage,
+		BackChannelLogoutURI:                  backChannelLogoutURI,
+		LoginVersion:                          loginVersion,
+		LoginBaseURI:                          loginBaseURI,
+		RequirePushedAuthorizationRequests:    requirePushedAuthorizationRequests,
+		RequireDPoP:                           requireDPoP,
+		TLSClientAuthSubjectDN:                tlsClientAuthSubjectDN,
+		TLSClientAuthPublicKeyPin:             tlsClientAuthPublicKeyPin,
+		BackChannelClientNotificationEndpoint: backChannelClientNotificationEndpoint,
+		AccessTokenLifetime:                   accessTokenLifetime,
+		IDTokenLifetime:                       idTokenLifetime,
+		RefreshTokenLifetime:                  refreshTokenLifetime,
 	}
 }
 
@@ -202,6 +226,24 @@ func (e *OIDCConfigAddedEvent) Validate(cmd eventstore.Command) bool {
 	if e.LoginVersion != c.LoginVersion {
 		return false
 	}
+	if e.RequirePushedAuthorizationRequests != c.RequirePushedAuthorizationRequests {
+		return false
+	}
+	if e.RequireDPoP != c.RequireDPoP {
+		return false
+	}
+	if e.TLSClientAuthSubjectDN != c.TLSClientAuthSubjectDN {
+		return false
+	}
+	if e.TLSClientAuthPublicKeyPin != c.TLSClientAuthPublicKeyPin {
+		return false
+	}
+	if e.BackChannelClientNotificationEndpoint != c.BackChannelClientNotificationEndpoint {
+		return false
+	}
+	if e.AccessTokenLifetime != c.AccessTokenLifetime || e.IDTokenLifetime != c.IDTokenLifetime || e.RefreshTokenLifetime != c.RefreshTokenLifetime {
+		return false
+	}
 	return e.LoginBaseURI == c.LoginBaseURI
 }
 
@@ -221,25 +263,33 @@ func OIDCConfigAddedEventMapper(event eventstore.Event) (eventstore.Event, error
 type OIDCConfigChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Version                  *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
-	AppID                    string                      `json:"appId"`
-	RedirectUris             *[]string                   `json:"redirectUris,omitempty"`
-	ResponseTypes            *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
-	GrantTypes               *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
-	ApplicationType          *domain.OIDCApplicationType `json:"applicationType,omitempty"`
-	AuthMethodType           *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
-	PostLogoutRedirectUris   *[]string                   `json:"postLogoutRedirectUris,omitempty"`
-	DevMode                  *bool                       `json:"devMode,omitempty"`
-	AccessTokenType          *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
-	AccessTokenRoleAssertion *bool                       `json:"accessTokenRoleAssertion,omitempty"`
-	IDTokenRoleAssertion     *bool                       `json:"idTokenRoleAssertion,omitempty"`
-	IDTokenUserinfoAssertion *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
-	ClockSkew                *time.Duration              `json:"clockSkew,omitempty"`
-	AdditionalOrigins        *[]string                   `json:"additionalOrigins,omitempty"`
-	SkipNativeAppSuccessPage *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
-	BackChannelLogoutURI     *string                     `json:"backChannelLogoutURI,omitempty"`
-	LoginVersion             *domain.LoginVersion        `json:"loginVersion,omitempty"`
-	LoginBaseURI             *string                     `json:"loginBaseURI,omitempty"`
+	Version                               *domain.OIDCVersion         `json:"oidcVersion,omitempty"`
+	AppID                                 string                      `json:"appId"`
+	RedirectUris                          *[]string                   `json:"redirectUris,omitempty"`
+	ResponseTypes                         *[]domain.OIDCResponseType  `json:"responseTypes,omitempty"`
+	GrantTypes                            *[]domain.OIDCGrantType     `json:"grantTypes,omitempty"`
+	ApplicationType                       *domain.OIDCApplicationType `json:"applicationType,omitempty"`
+	AuthMethodType                        *domain.OIDCAuthMethodType  `json:"authMethodType,omitempty"`
+	PostLogoutRedirectUris                *[]string                   `json:"postLogoutRedirectUris,omitempty"`
+	DevMode                               *bool                       `json:"devMode,omitempty"`
+	AccessTokenType                       *domain.OIDCTokenType       `json:"accessTokenType,omitempty"`
+	AccessTokenRoleAssertion              *bool                       `json:"accessTokenRoleAssertion,omitempty"`
+	IDTokenRoleAssertion                  *bool                       `json:"idTokenRoleAssertion,omitempty"`
+	IDTokenUserinfoAssertion              *bool                       `json:"idTokenUserinfoAssertion,omitempty"`
+	ClockSkew                             *time.Duration              `json:"clockSkew,omitempty"`
+	AdditionalOrigins                     *[]string                   `json:"additionalOrigins,omitempty"`
+	SkipNativeAppSuccessPage              *bool                       `json:"skipNativeAppSuccessPage,omitempty"`
+	BackChannelLogoutURI                  *string                     `json:"backChannelLogoutURI,omitempty"`
+	LoginVersion                          *domain.LoginVersion        `json:"loginVersion,omitempty"`
+	LoginBaseURI                          *string                     `json:"loginBaseURI,omitempty"`
+	RequirePushedAuthorizationRequests    *bool                       `json:"requirePushedAuthorizationRequests,omitempty"`
+	RequireDPoP                           *bool                       `json:"requireDPoP,omitempty"`
+	TLSClientAuthSubjectDN                *string                     `json:"tlsClientAuthSubjectDN,omitempty"`
+	TLSClientAuthPublicKeyPin             *string                     `json:"tlsClientAuthPublicKeyPin,omitempty"`
+	BackChannelClientNotificationEndpoint *string                     `json:"backChannelClientNotificationEndpoint,omitempty"`
+	AccessTokenLifetime                   *time.Duration              `json:"accessTokenLifetime,omitempty"`
+	IDTokenLifetime                       *time.Duration              `json:"idTokenLifetime,omitempty"`
+	RefreshTokenLifetime                  *time.Duration              `json:"refreshTokenLifetime,omitempty"`
 }
 
 func (e *OIDCConfigChangedEvent) Payload() interface{} {
@@ -396,6 +446,54 @@ func ChangeOIDCLoginBaseURI(loginBaseURI string) func(event *OIDCConfigChangedEv
 	}
 }
 
+func ChangeRequirePushedAuthorizationRequests(requirePushedAuthorizationRequests bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequirePushedAuthorizationRequests = &requirePushedAuthorizationRequests
+	}
+}
+
+func ChangeRequireDPoP(requireDPoP bool) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RequireDPoP = &requireDPoP
+	}
+}
+
+func ChangeTLSClientAuthSubjectDN(subjectDN string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthSubjectDN = &subjectDN
+	}
+}
+
+func ChangeTLSClientAuthPublicKeyPin(publicKeyPin string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.TLSClientAuthPublicKeyPin = &publicKeyPin
+	}
+}
+
+func ChangeBackChannelClientNotificationEndpoint(endpoint string) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.BackChannelClientNotificationEndpoint = &endpoint
+	}
+}
+
+func ChangeAccessTokenLifetime(lifetime time.Duration) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.AccessTokenLifetime = &lifetime
+	}
+}
+
+func ChangeIDTokenLifetime(lifetime time.Duration) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.IDTokenLifetime = &lifetime
+	}
+}
+
+func ChangeRefreshTokenLifetime(lifetime time.Duration) func(event *OIDCConfigChangedEvent) {
+	return func(e *OIDCConfigChangedEvent) {
+		e.RefreshTokenLifetime = &lifetime
+	}
+}
+
 func OIDCConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &OIDCConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -23,6 +23,13 @@ type SAMLConfigAddedEvent struct {
 	MetadataURL  string              `json:"metadata_url,omitempty"`
 	LoginVersion domain.LoginVersion `json:"loginVersion,omitempty"`
 	LoginBaseURI string              `json:"loginBaseURI,omitempty"`
+
+	SignatureAlgorithm  domain.SAMLSignatureAlgorithm  `json:"signatureAlgorithm,omitempty"`
+	DigestAlgorithm     domain.SAMLDigestAlgorithm     `json:"digestAlgorithm,omitempty"`
+	AssertionEncryption domain.SAMLAssertionEncryption `json:"assertionEncryption,omitempty"`
+
+	NameIDFormat     *domain.SAMLNameIDFormat       `json:"nameIdFormat,omitempty"`
+	AttributeMapping []*domain.SAMLAttributeMapping `json:"attributeMapping,omitempty"`
 }
 
 func (e *SAMLConfigAddedEvent) Payload() interface{} {
@@ -55,6 +62,11 @@ func NewSAMLConfigAddedEvent(
 	metadataURL string,
 	loginVersion domain.LoginVersion,
 	loginBaseURI string,
+	signatureAlgorithm domain.SAMLSignatureAlgorithm,
+	digestAlgorithm domain.SAMLDigestAlgorithm,
+	assertionEncryption domain.SAMLAssertionEncryption,
+	nameIDFormat *domain.SAMLNameIDFormat,
+	attributeMapping []*domain.SAMLAttributeMapping,
 ) *SAMLConfigAddedEvent {
 	return &SAMLConfigAddedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -68,6 +80,13 @@ func NewSAMLConfigAddedEvent(
 		MetadataURL:  metadataURL,
 		LoginVersion: loginVersion,
 		LoginBaseURI: loginBaseURI,
+
+		SignatureAlgorithm:  signatureAlgorithm,
+		DigestAlgorithm:     digestAlgorithm,
+		AssertionEncryption: assertionEncryption,
+
+		NameIDFormat:     nameIDFormat,
+		AttributeMapping: attributeMapping,
 	}
 }
 
@@ -93,7 +112,15 @@ type SAMLConfigChangedEvent struct {
 	MetadataURL  *string              `json:"metadata_url,omitempty"`
 	LoginVersion *domain.LoginVersion `json:"loginVersion,omitempty"`
 	LoginBaseURI *string              `json:"loginBaseURI,omitempty"`
-	oldEntityID  string
+
+	SignatureAlgorithm  *domain.SAMLSignatureAlgorithm  `json:"signatureAlgorithm,omitempty"`
+	DigestAlgorithm     *domain.SAMLDigestAlgorithm     `json:"digestAlgorithm,omitempty"`
+	AssertionEncryption *domain.SAMLAssertionEncryption `json:"assertionEncryption,omitempty"`
+
+	NameIDFormat     *domain.SAMLNameIDFormat        `json:"nameIdFormat,omitempty"`
+	AttributeMapping *[]*domain.SAMLAttributeMapping `json:"attributeMapping,omitempty"`
+
+	oldEntityID string
 }
 
 func (e *SAMLConfigChangedEvent) Payload() interface{} {
@@ -167,6 +194,36 @@ func ChangeSAMLLoginBaseURI(loginBaseURI string) func(event *SAMLConfigChangedEv
 	}
 }
 
+func ChangeSAMLSignatureAlgorithm(signatureAlgorithm domain.SAMLSignatureAlgorithm) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.SignatureAlgorithm = &signatureAlgorithm
+	}
+}
+
+func ChangeSAMLDigestAlgorithm(digestAlgorithm domain.SAMLDigestAlgorithm) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.DigestAlgorithm = &digestAlgorithm
+	}
+}
+
+func ChangeSAMLAssertionEncryption(assertionEncryption domain.SAMLAssertionEncryption) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.AssertionEncryption = &assertionEncryption
+	}
+}
+
+func ChangeSAMLNameIDFormat(nameIDFormat domain.SAMLNameIDFormat) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.NameIDFormat = &nameIDFormat
+	}
+}
+
+func ChangeSAMLAttributeMapping(attributeMapping []*domain.SAMLAttributeMapping) func(event *SAMLConfigChangedEvent) {
+	return func(e *SAMLConfigChangedEvent) {
+		e.AttributeMapping = &attributeMapping
+	}
+}
+
 func SAMLConfigChangedEventMapper(event eventstore.Event) (eventstore.Event, error) {
 	e := &SAMLConfigChangedEvent{
 		BaseEvent: *eventstore.BaseEventFromRepo(event),
@@ -8,5 +8,8 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, AddedType, eventstore.GenericEventMapper[AddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLResponseAddedType, eventstore.GenericEventMapper[SAMLResponseAddedEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, SAMLResponseRevokedType, eventstore.GenericEventMapper[SAMLResponseRevokedEvent])
-
+	eventstore.RegisterFilterEventMapper(AggregateType, LogoutRegisteredType, eventstore.GenericEventMapper[LogoutRegisteredEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LogoutInitiatedType, eventstore.GenericEventMapper[LogoutInitiatedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LogoutRequestedType, eventstore.GenericEventMapper[LogoutRequestedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, LogoutCompletedType, eventstore.GenericEventMapper[LogoutCompletedEvent])
 }
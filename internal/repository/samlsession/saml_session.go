@@ -15,6 +15,10 @@ const (
 	AddedType               = samlSessionEventPrefix + "added"
 	SAMLResponseAddedType   = samlSessionEventPrefix + "saml_response.added"
 	SAMLResponseRevokedType = samlSessionEventPrefix + "saml_response.revoked"
+	LogoutRegisteredType    = samlSessionEventPrefix + "logout.registered"
+	LogoutInitiatedType     = samlSessionEventPrefix + "logout.initiated"
+	LogoutRequestedType     = samlSessionEventPrefix + "logout.requested"
+	LogoutCompletedType     = samlSessionEventPrefix + "logout.completed"
 )
 
 type AddedEvent struct {
@@ -137,3 +141,169 @@ func NewSAMLResponseRevokedEvent(
 		),
 	}
 }
+
+// LogoutRegisteredEvent registers the Single Logout (SLO) endpoint of the service provider,
+// so the user can be logged out of it when the session is terminated.
+type LogoutRegisteredEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	SessionID    string `json:"sessionID"`
+	UserID       string `json:"userID"`
+	EntityID     string `json:"entityID"`
+	NameID       string `json:"nameID"`
+	NameIDFormat string `json:"nameIDFormat,omitempty"`
+	SessionIndex string `json:"sessionIndex,omitempty"`
+	LogoutURL    string `json:"logoutURL"`
+	ResponseURL  string `json:"responseURL,omitempty"`
+	Binding      string `json:"binding"`
+}
+
+func (e *LogoutRegisteredEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LogoutRegisteredEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *LogoutRegisteredEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLogoutRegisteredEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	sessionID,
+	userID,
+	entityID,
+	nameID,
+	nameIDFormat,
+	sessionIndex,
+	logoutURL,
+	responseURL,
+	binding string,
+) *LogoutRegisteredEvent {
+	return &LogoutRegisteredEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LogoutRegisteredType,
+		),
+		SessionID:    sessionID,
+		UserID:       userID,
+		EntityID:     entityID,
+		NameID:       nameID,
+		NameIDFormat: nameIDFormat,
+		SessionIndex: sessionIndex,
+		LogoutURL:    logoutURL,
+		ResponseURL:  responseURL,
+		Binding:      binding,
+	}
+}
+
+// LogoutInitiatedEvent is pushed when the Single Logout of the session was started.
+// If the service provider requested the logout (SP-initiated SLO), the RequestID is set
+// and the LogoutResponse is sent once all other service providers were logged out.
+// Otherwise, the user agent is redirected to the ReturnURL.
+type LogoutInitiatedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RequestID  string `json:"requestID,omitempty"`
+	RelayState string `json:"relayState,omitempty"`
+	ReturnURL  string `json:"returnURL,omitempty"`
+}
+
+func (e *LogoutInitiatedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LogoutInitiatedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *LogoutInitiatedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLogoutInitiatedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	requestID,
+	relayState,
+	returnURL string,
+) *LogoutInitiatedEvent {
+	return &LogoutInitiatedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LogoutInitiatedType,
+		),
+		RequestID:  requestID,
+		RelayState: relayState,
+		ReturnURL:  returnURL,
+	}
+}
+
+// LogoutRequestedEvent is pushed when a LogoutRequest was sent to the service provider.
+type LogoutRequestedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	RequestID string `json:"requestID"`
+}
+
+func (e *LogoutRequestedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LogoutRequestedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *LogoutRequestedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLogoutRequestedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	requestID string,
+) *LogoutRequestedEvent {
+	return &LogoutRequestedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LogoutRequestedType,
+		),
+		RequestID: requestID,
+	}
+}
+
+// LogoutCompletedEvent is pushed when the user was logged out of the service provider.
+type LogoutCompletedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+}
+
+func (e *LogoutCompletedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *LogoutCompletedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func (e *LogoutCompletedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func NewLogoutCompletedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+) *LogoutCompletedEvent {
+	return &LogoutCompletedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			LogoutCompletedType,
+		),
+	}
+}
@@ -170,6 +170,9 @@ type IntentCheckedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
 	CheckedAt time.Time `json:"checkedAt"`
+	IDPID     string    `json:"idpId,omitempty"`
+	// IDPSessionID is the session ID (sid) at the identity provider, if it was provided in its id_token.
+	IDPSessionID string `json:"idpSessionId,omitempty"`
 }
 
 func (e *IntentCheckedEvent) Payload() interface{} {
@@ -184,6 +187,8 @@ func NewIntentCheckedEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
 	checkedAt time.Time,
+	idpID,
+	idpSessionID string,
 ) *IntentCheckedEvent {
 	return &IntentCheckedEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
@@ -191,7 +196,9 @@ func NewIntentCheckedEvent(
 			aggregate,
 			IntentCheckedType,
 		),
-		CheckedAt: checkedAt,
+		CheckedAt:    checkedAt,
+		IDPID:        idpID,
+		IDPSessionID: idpSessionID,
 	}
 }
 
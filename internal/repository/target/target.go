@@ -19,12 +19,13 @@ const (
 type AddedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name             string              `json:"name"`
-	TargetType       domain.TargetType   `json:"targetType"`
-	Endpoint         string              `json:"endpoint"`
-	Timeout          time.Duration       `json:"timeout"`
-	InterruptOnError bool                `json:"interruptOnError"`
-	SigningKey       *crypto.CryptoValue `json:"signingKey"`
+	Name             string                    `json:"name"`
+	TargetType       domain.TargetType         `json:"targetType"`
+	Endpoint         string                    `json:"endpoint"`
+	Timeout          time.Duration             `json:"timeout"`
+	InterruptOnError bool                      `json:"interruptOnError"`
+	SigningKey       *crypto.CryptoValue       `json:"signingKey"`
+	RetryPolicy      *domain.TargetRetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 func (e *AddedEvent) SetBaseEvent(b *eventstore.BaseEvent) {
@@ -48,23 +49,25 @@ func NewAddedEvent(
 	timeout time.Duration,
 	interruptOnError bool,
 	signingKey *crypto.CryptoValue,
+	retryPolicy *domain.TargetRetryPolicy,
 ) *AddedEvent {
 	return &AddedEvent{
 		*eventstore.NewBaseEventForPush(
 			ctx, aggregate, AddedEventType,
 		),
-		name, targetType, endpoint, timeout, interruptOnError, signingKey}
+		name, targetType, endpoint, timeout, interruptOnError, signingKey, retryPolicy}
 }
 
 type ChangedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
-	Name             *string             `json:"name,omitempty"`
-	TargetType       *domain.TargetType  `json:"targetType,omitempty"`
-	Endpoint         *string             `json:"endpoint,omitempty"`
-	Timeout          *time.Duration      `json:"timeout,omitempty"`
-	InterruptOnError *bool               `json:"interruptOnError,omitempty"`
-	SigningKey       *crypto.CryptoValue `json:"signingKey,omitempty"`
+	Name             *string                   `json:"name,omitempty"`
+	TargetType       *domain.TargetType        `json:"targetType,omitempty"`
+	Endpoint         *string                   `json:"endpoint,omitempty"`
+	Timeout          *time.Duration            `json:"timeout,omitempty"`
+	InterruptOnError *bool                     `json:"interruptOnError,omitempty"`
+	SigningKey       *crypto.CryptoValue       `json:"signingKey,omitempty"`
+	RetryPolicy      *domain.TargetRetryPolicy `json:"retryPolicy,omitempty"`
 
 	oldName string
 }
@@ -144,6 +147,14 @@ func ChangeSigningKey(signingKey *crypto.CryptoValue) func(event *ChangedEvent)
 	}
 }
 
+// ChangeRetryPolicy sets the retry policy of the target,
+// an empty policy removes it and the target is called once.
+func ChangeRetryPolicy(retryPolicy domain.TargetRetryPolicy) func(event *ChangedEvent) {
+	return func(e *ChangedEvent) {
+		e.RetryPolicy = &retryPolicy
+	}
+}
+
 type RemovedEvent struct {
 	eventstore.BaseEvent `json:"-"`
 
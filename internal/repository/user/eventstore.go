@@ -141,4 +141,5 @@ func init() {
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCodeSentType, eventstore.GenericEventMapper[HumanInviteCodeSentEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckSucceededType, eventstore.GenericEventMapper[HumanInviteCheckSucceededEvent])
 	eventstore.RegisterFilterEventMapper(AggregateType, HumanInviteCheckFailedType, eventstore.GenericEventMapper[HumanInviteCheckFailedEvent])
+	eventstore.RegisterFilterEventMapper(AggregateType, SAMLNameIDAddedType, eventstore.GenericEventMapper[SAMLNameIDAddedEvent])
 }
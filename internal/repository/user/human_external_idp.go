@@ -175,6 +175,10 @@ func UserIDPLinkCascadeRemovedEventMapper(event eventstore.Event) (eventstore.Ev
 type UserIDPCheckSucceededEvent struct {
 	eventstore.BaseEvent `json:"-"`
 	*AuthRequestInfo
+	// IDPIDToken is the id_token issued by the identity provider, used for the federated logout.
+	IDPIDToken string `json:"idpIdToken,omitempty"`
+	// IDPSessionID is the session ID (sid) at the identity provider, if it was provided in its id_token.
+	IDPSessionID string `json:"idpSessionId,omitempty"`
 }
 
 func (e *UserIDPCheckSucceededEvent) Payload() interface{} {
@@ -188,7 +192,10 @@ func (e *UserIDPCheckSucceededEvent) UniqueConstraints() []*eventstore.UniqueCon
 func NewUserIDPCheckSucceededEvent(
 	ctx context.Context,
 	aggregate *eventstore.Aggregate,
-	info *AuthRequestInfo) *UserIDPCheckSucceededEvent {
+	info *AuthRequestInfo,
+	idpIDToken,
+	idpSessionID string,
+) *UserIDPCheckSucceededEvent {
 	return &UserIDPCheckSucceededEvent{
 		BaseEvent: *eventstore.NewBaseEventForPush(
 			ctx,
@@ -196,6 +203,8 @@ func NewUserIDPCheckSucceededEvent(
 			UserIDPLoginCheckSucceededType,
 		),
 		AuthRequestInfo: info,
+		IDPIDToken:      idpIDToken,
+		IDPSessionID:    idpSessionID,
 	}
 }
 
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"context"
+
+	"github.com/zitadel/zitadel/internal/eventstore"
+)
+
+const (
+	samlNameIDPrefix    = userEventTypePrefix + "saml.name_id."
+	SAMLNameIDAddedType = samlNameIDPrefix + "added"
+)
+
+// SAMLNameIDAddedEvent stores the persistent NameID generated for the user and a SAML service provider,
+// so it stays stable for the service provider without depending on a key which might be rotated.
+type SAMLNameIDAddedEvent struct {
+	eventstore.BaseEvent `json:"-"`
+
+	EntityID string `json:"entityId"`
+	NameID   string `json:"nameId"`
+}
+
+func (e *SAMLNameIDAddedEvent) SetBaseEvent(event *eventstore.BaseEvent) {
+	e.BaseEvent = *event
+}
+
+func (e *SAMLNameIDAddedEvent) Payload() interface{} {
+	return e
+}
+
+func (e *SAMLNameIDAddedEvent) UniqueConstraints() []*eventstore.UniqueConstraint {
+	return nil
+}
+
+func NewSAMLNameIDAddedEvent(
+	ctx context.Context,
+	aggregate *eventstore.Aggregate,
+	entityID,
+	nameID string,
+) *SAMLNameIDAddedEvent {
+	return &SAMLNameIDAddedEvent{
+		BaseEvent: *eventstore.NewBaseEventForPush(
+			ctx,
+			aggregate,
+			SAMLNameIDAddedType,
+		),
+		EntityID: entityID,
+		NameID:   nameID,
+	}
+}